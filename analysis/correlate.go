@@ -0,0 +1,180 @@
+package analysis
+
+import (
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// MatchKind is how CorrelatePackages paired two packages.
+type MatchKind string
+
+// Kinds of PackagePair, from the most to the least certain.
+const (
+	// MatchPURL pairs packages with the same package URL, once normalized.
+	MatchPURL MatchKind = "purl"
+	// MatchPURLBase pairs packages with the same package URL type,
+	// namespace and name, in any version.
+	MatchPURLBase MatchKind = "purlBase"
+	// MatchName pairs packages with similar names, such as "Django_Rest"
+	// and "django-rest", that are not of different ecosystems.
+	MatchName MatchKind = "name"
+)
+
+// Correlation pairs the packages of two documents, which have different
+// SPDX IDs for the same packages when they are produced by different tools
+// or runs. It is built by CorrelatePackages.
+type Correlation struct {
+	// Matched are the pairs of packages, in the order of the packages of
+	// the first document.
+	Matched []PackagePair
+
+	// OnlyFrom are the packages of the first document that have no match
+	// in the second, and OnlyTo those of the second with no match in the
+	// first, in document order.
+	OnlyFrom []*spdx.Package
+	OnlyTo   []*spdx.Package
+}
+
+// PackagePair is a package of the first document and its match in the
+// second.
+type PackagePair struct {
+	From *spdx.Package
+	To   *spdx.Package
+	Kind MatchKind
+}
+
+// correlationStage is a pass of CorrelatePackages over the packages left
+// unmatched by the passes before it.
+type correlationStage struct {
+	kind MatchKind
+	key  func(*spdx.Package) string
+}
+
+var correlationStages = []correlationStage{
+	{MatchPURL, exactPURLKey},
+	{MatchPURLBase, purlBaseKey},
+	{MatchName, nameKey},
+}
+
+// CorrelatePackages pairs the packages of two documents, including their
+// AI and dataset packages: first by package URL, then by package URL
+// without version, then by name. Each package is paired at most once, and
+// packages of the same version are paired first when several match.
+//
+//	c := analysis.CorrelatePackages(before, after)
+//	for _, p := range c.Matched {
+//	    if p.From.PackageVersion != p.To.PackageVersion {
+//	        fmt.Printf("%s: %s -> %s\n", p.To.Name, p.From.PackageVersion, p.To.PackageVersion)
+//	    }
+//	}
+func CorrelatePackages(from, to *parse.Document) *Correlation {
+	a, b := allPackages(from), allPackages(to)
+	match := make([]int, len(a))
+	kinds := make([]MatchKind, len(a))
+	for i := range match {
+		match[i] = -1
+	}
+	taken := make([]bool, len(b))
+
+	for _, stage := range correlationStages {
+		candidates := make(map[string][]int)
+		for j, pkg := range b {
+			if k := stage.key(pkg); k != "" && !taken[j] {
+				candidates[k] = append(candidates[k], j)
+			}
+		}
+		for _, sameVersion := range []bool{true, false} {
+			for i, pkg := range a {
+				if match[i] >= 0 {
+					continue
+				}
+				k := stage.key(pkg)
+				if k == "" {
+					continue
+				}
+				for _, j := range candidates[k] {
+					if taken[j] || sameVersion && b[j].PackageVersion != pkg.PackageVersion {
+						continue
+					}
+					if stage.kind == MatchName && !sameEcosystem(pkg, b[j]) {
+						continue
+					}
+					match[i], kinds[i], taken[j] = j, stage.kind, true
+					break
+				}
+			}
+		}
+	}
+
+	c := &Correlation{}
+	for i, pkg := range a {
+		if match[i] < 0 {
+			c.OnlyFrom = append(c.OnlyFrom, pkg)
+			continue
+		}
+		c.Matched = append(c.Matched, PackagePair{From: pkg, To: b[match[i]], Kind: kinds[i]})
+	}
+	for j, pkg := range b {
+		if !taken[j] {
+			c.OnlyTo = append(c.OnlyTo, pkg)
+		}
+	}
+	return c
+}
+
+// allPackages returns the packages of a document, including its AI and
+// dataset packages.
+func allPackages(doc *parse.Document) []*spdx.Package {
+	pkgs := append([]*spdx.Package(nil), doc.Packages...)
+	for _, pkg := range doc.AiPackages {
+		pkgs = append(pkgs, &pkg.Package)
+	}
+	for _, pkg := range doc.DatasetPackages {
+		pkgs = append(pkgs, &pkg.Package)
+	}
+	return pkgs
+}
+
+// exactPURLKey returns the normalized package URL of a package.
+func exactPURLKey(pkg *spdx.Package) string {
+	p, ok := pkg.PURLComponents()
+	if !ok {
+		return pkg.PURL()
+	}
+	return p.String()
+}
+
+// purlBaseKey returns the package URL of a package without its version,
+// qualifiers and subpath.
+func purlBaseKey(pkg *spdx.Package) string {
+	p, ok := pkg.PURLComponents()
+	if !ok {
+		return ""
+	}
+	return spdx.PURL{Type: p.Type, Namespace: p.Namespace, Name: p.Name}.String()
+}
+
+// nameKey returns the name of a package without a group or scope prefix,
+// in lower case and without separators.
+func nameKey(pkg *spdx.Package) string {
+	name := strings.ToLower(pkg.Name)
+	if i := strings.LastIndexAny(name, "/:"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune("-_. ", r) {
+			return -1
+		}
+		return r
+	}, name)
+}
+
+// sameEcosystem reports whether two packages are not known to be of
+// different ecosystems.
+func sameEcosystem(a, b *spdx.Package) bool {
+	pa, okA := a.PURLComponents()
+	pb, okB := b.PURLComponents()
+	return !okA || !okB || pa.Type == pb.Type
+}
@@ -0,0 +1,73 @@
+package analysis_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/analysis"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func correlationDoc(t *testing.T, packages ...string) *parse.Document {
+	t.Helper()
+	data := `{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [` + strings.Join(packages, ",") + `]}`
+	doc, err := parse.NewReader().Read([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestCorrelatePackages(t *testing.T) {
+	from := correlationDoc(t,
+		`{"type": "software_Package", "spdxId": "urn:a:lodash", "name": "lodash", "software_packageVersion": "4.17.20", "software_packageUrl": "pkg:npm/lodash@4.17.20"}`,
+		`{"type": "software_Package", "spdxId": "urn:a:django", "name": "Django_Rest", "software_packageVersion": "3.0", "software_packageUrl": "pkg:PyPI/Django_Rest@3.0"}`,
+		`{"type": "software_Package", "spdxId": "urn:a:guava", "name": "com.google.guava:guava", "software_packageVersion": "33.0"}`,
+		`{"type": "software_Package", "spdxId": "urn:a:zlib-1", "name": "zlib", "software_packageVersion": "1.2", "software_packageUrl": "pkg:generic/zlib@1.2"}`,
+		`{"type": "software_Package", "spdxId": "urn:a:zlib-2", "name": "zlib", "software_packageVersion": "1.3", "software_packageUrl": "pkg:generic/zlib@1.3"}`,
+		`{"type": "software_Package", "spdxId": "urn:a:leaf", "name": "leaf", "software_packageUrl": "pkg:npm/leaf@1.0"}`,
+		`{"type": "software_Package", "spdxId": "urn:a:yaml", "name": "yaml", "software_packageUrl": "pkg:npm/yaml@2.0"}`,
+	)
+	to := correlationDoc(t,
+		`{"type": "software_Package", "spdxId": "urn:b:lodash", "name": "lodash", "software_packageVersion": "4.17.21", "software_packageUrl": "pkg:npm/lodash@4.17.21"}`,
+		`{"type": "software_Package", "spdxId": "urn:b:django", "name": "django-rest", "software_packageVersion": "3.0",
+		  "externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "packageUrl", "identifier": "pkg:pypi/django-rest@3.0"}]}`,
+		`{"type": "software_Package", "spdxId": "urn:b:guava", "name": "Guava", "software_packageVersion": "33.1", "software_packageUrl": "pkg:maven/com.google.guava/guava@33.1"}`,
+		`{"type": "software_Package", "spdxId": "urn:b:zlib-3", "name": "zlib", "software_packageVersion": "1.3", "software_packageUrl": "pkg:generic/zlib@1.3.0"}`,
+		`{"type": "software_Package", "spdxId": "urn:b:zlib-1", "name": "zlib", "software_packageVersion": "1.2", "software_packageUrl": "pkg:generic/zlib@1.2"}`,
+		`{"type": "software_Package", "spdxId": "urn:b:yaml", "name": "yaml", "software_packageUrl": "pkg:pypi/yaml@6.0"}`,
+		`{"type": "ai_AIPackage", "spdxId": "urn:b:model", "name": "model"}`,
+	)
+
+	c := analysis.CorrelatePackages(from, to)
+	var matched []string
+	for _, p := range c.Matched {
+		matched = append(matched, p.From.SpdxID+" "+p.To.SpdxID+" "+string(p.Kind))
+	}
+	want := []string{
+		"urn:a:lodash urn:b:lodash purlBase",
+		"urn:a:django urn:b:django purl",
+		"urn:a:guava urn:b:guava name",
+		"urn:a:zlib-1 urn:b:zlib-1 purl",
+		"urn:a:zlib-2 urn:b:zlib-3 purlBase",
+	}
+	if !slices.Equal(matched, want) {
+		t.Errorf("matched = %q\nwant %q", matched, want)
+	}
+
+	ids := func(pkgs []*spdx.Package) []string {
+		var ids []string
+		for _, pkg := range pkgs {
+			ids = append(ids, pkg.SpdxID)
+		}
+		return ids
+	}
+	if got := ids(c.OnlyFrom); !slices.Equal(got, []string{"urn:a:leaf", "urn:a:yaml"}) {
+		t.Errorf("only from = %v", got)
+	}
+	if got := ids(c.OnlyTo); !slices.Equal(got, []string{"urn:b:yaml", "urn:b:model"}) {
+		t.Errorf("only to = %v", got)
+	}
+}
@@ -0,0 +1,197 @@
+package analysis
+
+import (
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// GraphStats measures the complexity of the dependency graph of a
+// document, whose nodes are its packages and whose edges are the
+// dependency relationships between them, for SBOM complexity dashboards.
+type GraphStats struct {
+	Packages     int `json:"packages"`
+	Dependencies int `json:"dependencies"`
+
+	// Roots is the number of packages no other package depends on.
+	Roots int `json:"roots"`
+
+	// MaxDepth is the number of edges on the longest dependency chain,
+	// counting the packages of a cycle as one.
+	MaxDepth int `json:"maxDepth"`
+
+	// AverageFanOut is the mean number of direct dependencies of a
+	// package, and Widest the package with the most, or nil without
+	// packages.
+	AverageFanOut float64        `json:"averageFanOut"`
+	Widest        *PackageFanOut `json:"widest,omitempty"`
+
+	// Components is the number of strongly connected components, and
+	// Cycles the number of those that are dependency cycles: of several
+	// packages, or of one depending on itself.
+	Components int `json:"components"`
+	Cycles     int `json:"cycles"`
+}
+
+// PackageFanOut is a package and its number of direct dependencies.
+type PackageFanOut struct {
+	SpdxID string `json:"spdxId"`
+	Name   string `json:"name,omitempty"`
+	FanOut int    `json:"fanOut"`
+}
+
+// DependencyGraphStats computes the statistics of the dependency graph of
+// a document from its relationship indexes. Dependencies are the
+// relationships for which IsDependency holds between two packages of the
+// document, including AI and dataset packages; each pair of packages is
+// counted once.
+func DependencyGraphStats(doc *parse.Document) *GraphStats {
+	g := newDependencyGraph(doc)
+	stats := &GraphStats{Packages: len(g.nodes)}
+
+	dependedOn := make([]bool, len(g.nodes))
+	for i, edges := range g.edges {
+		stats.Dependencies += len(edges)
+		for _, j := range edges {
+			if j != i {
+				dependedOn[j] = true
+			}
+		}
+		if stats.Widest == nil || len(edges) > stats.Widest.FanOut {
+			stats.Widest = &PackageFanOut{SpdxID: g.nodes[i].SpdxID, Name: g.nodes[i].Name, FanOut: len(edges)}
+		}
+	}
+	for _, d := range dependedOn {
+		if !d {
+			stats.Roots++
+		}
+	}
+	if stats.Packages > 0 {
+		stats.AverageFanOut = float64(stats.Dependencies) / float64(stats.Packages)
+	}
+
+	components := g.components()
+	stats.Components = len(components)
+	// Tarjan's algorithm finds a component after all those it reaches, so
+	// the depths of its dependencies are known when it is reached.
+	component := make([]int, len(g.nodes))
+	for c, members := range components {
+		for _, i := range members {
+			component[i] = c
+		}
+	}
+	depth := make([]int, len(components))
+	for c, members := range components {
+		cycle := len(members) > 1
+		for _, i := range members {
+			for _, j := range g.edges[i] {
+				if d := component[j]; d != c {
+					depth[c] = max(depth[c], depth[d]+1)
+				} else if i == j {
+					cycle = true
+				}
+			}
+		}
+		if cycle {
+			stats.Cycles++
+		}
+		stats.MaxDepth = max(stats.MaxDepth, depth[c])
+	}
+	return stats
+}
+
+// dependencyGraph is the dependency graph of the packages of a document,
+// with the edges of each package as indexes into nodes.
+type dependencyGraph struct {
+	nodes []*spdx.Package
+	edges [][]int
+}
+
+func newDependencyGraph(doc *parse.Document) *dependencyGraph {
+	g := &dependencyGraph{}
+	index := make(map[string]int)
+	add := func(pkg *spdx.Package) {
+		if _, ok := index[pkg.SpdxID]; ok {
+			return
+		}
+		index[pkg.SpdxID] = len(g.nodes)
+		g.nodes = append(g.nodes, pkg)
+	}
+	for _, pkg := range doc.Packages {
+		add(pkg)
+	}
+	for _, pkg := range doc.AiPackages {
+		add(&pkg.Package)
+	}
+	for _, pkg := range doc.DatasetPackages {
+		add(&pkg.Package)
+	}
+
+	g.edges = make([][]int, len(g.nodes))
+	for i, pkg := range g.nodes {
+		seen := make(map[int]bool)
+		for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+			if !rel.IsDependency() {
+				continue
+			}
+			for _, to := range rel.To {
+				if j, ok := index[to.GetSpdxID()]; ok && !seen[j] {
+					seen[j] = true
+					g.edges[i] = append(g.edges[i], j)
+				}
+			}
+		}
+	}
+	return g
+}
+
+// components returns the strongly connected components of the graph, by
+// Tarjan's algorithm, each after all the components it reaches.
+func (g *dependencyGraph) components() [][]int {
+	const unvisited = -1
+	index := make([]int, len(g.nodes))
+	low := make([]int, len(g.nodes))
+	onStack := make([]bool, len(g.nodes))
+	for i := range index {
+		index[i] = unvisited
+	}
+	var stack []int
+	var components [][]int
+	next := 0
+
+	var visit func(int)
+	visit = func(i int) {
+		index[i], low[i] = next, next
+		next++
+		stack = append(stack, i)
+		onStack[i] = true
+		for _, j := range g.edges[i] {
+			switch {
+			case index[j] == unvisited:
+				visit(j)
+				low[i] = min(low[i], low[j])
+			case onStack[j]:
+				low[i] = min(low[i], index[j])
+			}
+		}
+		if low[i] != index[i] {
+			return
+		}
+		var members []int
+		for {
+			j := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[j] = false
+			members = append(members, j)
+			if j == i {
+				break
+			}
+		}
+		components = append(components, members)
+	}
+	for i := range g.nodes {
+		if index[i] == unvisited {
+			visit(i)
+		}
+	}
+	return components
+}
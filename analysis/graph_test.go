@@ -0,0 +1,97 @@
+package analysis_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/analysis"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// graphDoc returns a document with the given packages and a dependsOn
+// relationship for each "from>to" edge.
+func graphDoc(packages []string, edges ...string) []byte {
+	var entries []string
+	for _, p := range packages {
+		entries = append(entries, fmt.Sprintf(`{"type": "software_Package", "spdxId": "urn:spdx:%s", "name": %q}`, p, p))
+	}
+	for i, e := range edges {
+		from, to, _ := strings.Cut(e, ">")
+		entries = append(entries, fmt.Sprintf(`{"type": "Relationship", "spdxId": "urn:spdx:rel-%d", "from": "urn:spdx:%s", "to": ["urn:spdx:%s"], "relationshipType": "dependsOn"}`, i, from, to))
+	}
+	return []byte(`{"@graph": [` + strings.Join(entries, ",") + `]}`)
+}
+
+func TestDependencyGraphStats(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  analysis.GraphStats
+	}{
+		{
+			"empty",
+			graphDoc(nil),
+			analysis.GraphStats{},
+		},
+		{
+			"chain and cycle",
+			graphDoc([]string{"app", "lib1", "lib2", "lib3", "lib4", "lib5"},
+				"app>lib1", "app>lib2", "lib1>lib3", "lib3>lib1", "lib2>lib4", "lib4>lib5", "app>lib2", "lib5>missing"),
+			analysis.GraphStats{
+				Packages: 6, Dependencies: 6, Roots: 1, MaxDepth: 3, AverageFanOut: 1,
+				Widest:     &analysis.PackageFanOut{SpdxID: "urn:spdx:app", Name: "app", FanOut: 2},
+				Components: 5, Cycles: 1,
+			},
+		},
+		{
+			"self dependency",
+			graphDoc([]string{"a", "b"}, "a>a", "b>a"),
+			analysis.GraphStats{
+				Packages: 2, Dependencies: 2, Roots: 1, MaxDepth: 1, AverageFanOut: 1,
+				Widest:     &analysis.PackageFanOut{SpdxID: "urn:spdx:a", Name: "a", FanOut: 1},
+				Components: 2, Cycles: 1,
+			},
+		},
+		{
+			"no dependencies",
+			graphDoc([]string{"a", "b"}),
+			analysis.GraphStats{
+				Packages: 2, Roots: 2,
+				Widest:     &analysis.PackageFanOut{SpdxID: "urn:spdx:a", Name: "a"},
+				Components: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, opts := range [][]parse.Option{nil, {parse.WithIndexes()}} {
+				doc, err := parse.NewReader(opts...).Read(tt.input)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := analysis.DependencyGraphStats(doc); !reflect.DeepEqual(*got, tt.want) {
+					t.Errorf("DependencyGraphStats() = %+v, want %+v", *got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDependencyGraphStats(b *testing.B) {
+	var packages, edges []string
+	for i := range 5000 {
+		packages = append(packages, fmt.Sprintf("p%d", i))
+		if i > 0 {
+			edges = append(edges, fmt.Sprintf("p%d>p%d", i-1, i), fmt.Sprintf("p%d>p%d", i/2, i))
+		}
+	}
+	doc, err := parse.NewReader().Read(graphDoc(packages, edges...))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for b.Loop() {
+		analysis.DependencyGraphStats(doc)
+	}
+}
@@ -0,0 +1,177 @@
+// Package analysis computes reports on the contents of SBOMs, such as the
+// completeness of their supplier data.
+//
+//	doc, err := parse.NewReader().ReadFile("sbom.spdx.json")
+//	...
+//	report := analysis.Suppliers(doc)
+//	err = report.WriteMarkdown(os.Stdout)
+package analysis
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/interlynk-io/spdx-zen/internal/markdown"
+	"github.com/interlynk-io/spdx-zen/internal/purl"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// UnknownEcosystem is the ecosystem of the packages without a package URL.
+const UnknownEcosystem = "unknown"
+
+// SupplierReport lists the packages of a document lacking supplier data,
+// grouped by ecosystem, so that the missing suppliers can be chased down.
+// It is built by Suppliers and rendered with WriteJSON or WriteMarkdown.
+type SupplierReport struct {
+	DocumentID   string `json:"documentId,omitempty"`
+	DocumentName string `json:"documentName,omitempty"`
+
+	// Packages is the number of packages, and Complete the number of
+	// those with both a supplier and an originator.
+	Packages int `json:"packages"`
+	Complete int `json:"complete"`
+
+	// Ecosystems holds the packages of each ecosystem, by purl type, in
+	// the order of their names.
+	Ecosystems []EcosystemSuppliers `json:"ecosystems"`
+}
+
+// EcosystemSuppliers is the supplier completeness of the packages of an
+// ecosystem.
+type EcosystemSuppliers struct {
+	Ecosystem string `json:"ecosystem"`
+	Packages  int    `json:"packages"`
+	Complete  int    `json:"complete"`
+
+	// Incomplete lists the packages missing a supplier or an originator.
+	Incomplete []SupplierGap `json:"incomplete"`
+}
+
+// SupplierGap is a package missing supplier data.
+type SupplierGap struct {
+	SpdxID     string `json:"spdxId"`
+	Name       string `json:"name,omitempty"`
+	Version    string `json:"version,omitempty"`
+	PackageURL string `json:"packageUrl,omitempty"`
+
+	// MissingSuppliedBy and MissingOriginatedBy report which of the
+	// suppliedBy and originatedBy properties the package lacks.
+	MissingSuppliedBy   bool `json:"missingSuppliedBy"`
+	MissingOriginatedBy bool `json:"missingOriginatedBy"`
+}
+
+// Suppliers builds the supplier completeness report of the packages of a
+// document, including its AI and dataset packages.
+func Suppliers(doc *parse.Document) *SupplierReport {
+	r := &SupplierReport{
+		DocumentID:   doc.GetSpdxID(),
+		DocumentName: doc.GetName(),
+		Ecosystems:   []EcosystemSuppliers{},
+	}
+	ecosystems := make(map[string]*EcosystemSuppliers)
+	add := func(pkg *spdx.Package) {
+		pkgURL := pkg.PURL()
+		name := purl.Type(pkgURL)
+		if name == "" {
+			name = UnknownEcosystem
+		}
+		eco, ok := ecosystems[name]
+		if !ok {
+			eco = &EcosystemSuppliers{Ecosystem: name, Incomplete: []SupplierGap{}}
+			ecosystems[name] = eco
+		}
+
+		r.Packages++
+		eco.Packages++
+		gap := SupplierGap{
+			SpdxID:              pkg.SpdxID,
+			Name:                pkg.Name,
+			Version:             pkg.PackageVersion,
+			PackageURL:          pkgURL,
+			MissingSuppliedBy:   pkg.SuppliedBy == nil || pkg.SuppliedBy.SpdxID == "",
+			MissingOriginatedBy: len(pkg.OriginatedBy) == 0,
+		}
+		if !gap.MissingSuppliedBy && !gap.MissingOriginatedBy {
+			r.Complete++
+			eco.Complete++
+			return
+		}
+		eco.Incomplete = append(eco.Incomplete, gap)
+	}
+	for _, pkg := range doc.Packages {
+		add(pkg)
+	}
+	for _, pkg := range doc.AiPackages {
+		add(&pkg.Package)
+	}
+	for _, pkg := range doc.DatasetPackages {
+		add(&pkg.Package)
+	}
+
+	for _, eco := range ecosystems {
+		r.Ecosystems = append(r.Ecosystems, *eco)
+	}
+	slices.SortFunc(r.Ecosystems, func(a, b EcosystemSuppliers) int {
+		return cmp.Compare(a.Ecosystem, b.Ecosystem)
+	})
+	return r
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *SupplierReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+	return nil
+}
+
+// WriteMarkdown writes the report as a Markdown document, with a table of
+// the incomplete packages of each ecosystem.
+func (r *SupplierReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	title := r.DocumentName
+	if title == "" {
+		title = r.DocumentID
+	}
+	fmt.Fprintf(&b, "# Supplier completeness: %s\n\n", title)
+	fmt.Fprintf(&b, "%d of %d packages have a supplier and an originator.\n\n", r.Complete, r.Packages)
+
+	b.WriteString("| Ecosystem | Packages | Complete |\n| --- | ---: | ---: |\n")
+	for _, eco := range r.Ecosystems {
+		fmt.Fprintf(&b, "| %s | %d | %d |\n", markdown.Cell(eco.Ecosystem), eco.Packages, eco.Complete)
+	}
+
+	for _, eco := range r.Ecosystems {
+		if len(eco.Incomplete) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n| Package | Version | Supplier | Originator |\n| --- | --- | --- | --- |\n", eco.Ecosystem)
+		for _, gap := range eco.Incomplete {
+			name := gap.Name
+			if name == "" {
+				name = gap.SpdxID
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", markdown.Cell(name), markdown.Cell(gap.Version),
+				missing(gap.MissingSuppliedBy), missing(gap.MissingOriginatedBy))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+func missing(m bool) string {
+	if m {
+		return "missing"
+	}
+	return "present"
+}
@@ -0,0 +1,98 @@
+package analysis_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/analysis"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const suppliersDoc = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]},
+		{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme"},
+		{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "name": "app-sbom"},
+		{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0",
+		 "software_packageUrl": "pkg:npm/app@1.0", "suppliedBy": "urn:spdx:acme", "originatedBy": ["urn:spdx:acme"]},
+		{"type": "software_Package", "spdxId": "urn:spdx:left-pad", "name": "left-pad", "software_packageVersion": "1.3.0",
+		 "software_packageUrl": "pkg:npm/left-pad@1.3.0", "originatedBy": ["urn:spdx:acme"]},
+		{"type": "software_Package", "spdxId": "urn:spdx:guava", "name": "guava",
+		 "externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "packageUrl", "identifier": "pkg:maven/com.google/guava@33.0"}],
+		 "suppliedBy": "urn:spdx:acme"},
+		{"type": "software_Package", "spdxId": "urn:spdx:vendored", "name": "vendored"},
+		{"type": "ai_AIPackage", "spdxId": "urn:spdx:model", "name": "model", "software_packageUrl": "pkg:huggingface/acme/model"}
+	]
+}`
+
+func TestSuppliers(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(suppliersDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := analysis.Suppliers(doc)
+
+	if r.DocumentName != "app-sbom" || r.Packages != 5 || r.Complete != 1 {
+		t.Errorf("Suppliers() = %q with %d packages, %d complete, want app-sbom with 5, 1 complete", r.DocumentName, r.Packages, r.Complete)
+	}
+	want := []analysis.EcosystemSuppliers{
+		{Ecosystem: "huggingface", Packages: 1, Incomplete: []analysis.SupplierGap{
+			{SpdxID: "urn:spdx:model", Name: "model", PackageURL: "pkg:huggingface/acme/model", MissingSuppliedBy: true, MissingOriginatedBy: true},
+		}},
+		{Ecosystem: "maven", Packages: 1, Incomplete: []analysis.SupplierGap{
+			{SpdxID: "urn:spdx:guava", Name: "guava", PackageURL: "pkg:maven/com.google/guava@33.0", MissingOriginatedBy: true},
+		}},
+		{Ecosystem: "npm", Packages: 2, Complete: 1, Incomplete: []analysis.SupplierGap{
+			{SpdxID: "urn:spdx:left-pad", Name: "left-pad", Version: "1.3.0", PackageURL: "pkg:npm/left-pad@1.3.0", MissingSuppliedBy: true},
+		}},
+		{Ecosystem: analysis.UnknownEcosystem, Packages: 1, Incomplete: []analysis.SupplierGap{
+			{SpdxID: "urn:spdx:vendored", Name: "vendored", MissingSuppliedBy: true, MissingOriginatedBy: true},
+		}},
+	}
+	if !reflect.DeepEqual(r.Ecosystems, want) {
+		t.Errorf("Ecosystems = %+v, want %+v", r.Ecosystems, want)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+	var decoded analysis.SupplierReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || !reflect.DeepEqual(&decoded, r) {
+		t.Errorf("WriteJSON() does not decode to the report: %v\n%s", err, buf.String())
+	}
+
+	buf.Reset()
+	if err := r.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown() error = %v", err)
+	}
+	for _, s := range []string{
+		"# Supplier completeness: app-sbom",
+		"1 of 5 packages",
+		"| npm | 2 | 1 |",
+		"## npm",
+		"| left-pad | 1.3.0 | missing | present |",
+	} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("WriteMarkdown() lacks %q:\n%s", s, buf.String())
+		}
+	}
+	if strings.Contains(buf.String(), "| app |") {
+		t.Errorf("WriteMarkdown() lists a complete package:\n%s", buf.String())
+	}
+}
+
+func TestSuppliers_Empty(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(`{"@graph": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := analysis.Suppliers(doc)
+	if r.Packages != 0 || r.Ecosystems == nil || len(r.Ecosystems) != 0 {
+		t.Errorf("Suppliers() of an empty document = %+v", r)
+	}
+}
@@ -16,39 +16,111 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/interlynk-io/spdx-zen/internal/gen"
 )
 
+// specFiles collects the values of a repeatable -spec flag.
+type specFiles []string
+
+func (s *specFiles) String() string { return strings.Join(*s, ",") }
+
+func (s *specFiles) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
 func main() {
 	var (
-		specFile string
-		outDir   string
-		pkgName  string
-		version  string
+		specs      specFiles
+		extensions specFiles
+		outDir     string
+		pkgName    string
+		version    string
+
+		parserDir   string
+		modelImport string
+		fixturesDir string
+		schemaPath  string
+		protoPath   string
+		profiles    bool
+
+		sharedDir string
+		sharedPkg string
+
+		diff  bool
+		check bool
 	)
 
-	flag.StringVar(&specFile, "spec", "", "Path to SPDX model JSON-LD file")
-	flag.StringVar(&outDir, "out", "", "Output directory for generated code")
+	flag.Var(&specs, "spec", "Path to SPDX model JSON-LD file; repeat to generate several versions")
+	flag.Var(&extensions, "extension", "Path to a JSON-LD model of additional classes and properties to generate with the spec; repeatable")
+	flag.StringVar(&outDir, "out", "", "Output directory for generated code; with several specs, the parent of the v<version> directories")
 	flag.StringVar(&pkgName, "pkg", "spdx", "Package name for generated code")
 	flag.StringVar(&version, "version", "", "SPDX version (e.g., 3.1.0)")
+	flag.StringVar(&parserDir, "parser-out", "", "Output directory for generated element parsers (optional)")
+	flag.StringVar(&modelImport, "model-import", "", "Import path of the generated model, required with -parser-out and -profile-packages")
+	flag.StringVar(&fixturesDir, "fixtures-out", "", "Output directory for generated example documents (optional)")
+	flag.StringVar(&schemaPath, "schema-out", "", "Output file for a JSON Schema of SPDX documents (optional)")
+	flag.StringVar(&protoPath, "proto-out", "", "Output file for Protocol Buffers definitions of the model (optional)")
+	flag.BoolVar(&profiles, "profile-packages", false, "Generate each profile into its own subpackage of -out instead of a single package")
+	flag.StringVar(&sharedDir, "shared-out", "", "Output directory for the interfaces shared by all versions (optional)")
+	flag.StringVar(&sharedPkg, "shared-pkg", "model", "Package name for the shared interfaces")
+	flag.BoolVar(&diff, "diff", false, "Print the differences between the two spec files given as arguments as JSON")
+	flag.BoolVar(&check, "check", false, "Only check the spec files for problems that would break generation")
 	flag.Parse()
 
-	if specFile == "" || outDir == "" {
+	if diff {
+		if flag.NArg() != 2 {
+			log.Fatal("-diff takes two spec files: spdx-gen -diff old.json-ld new.json-ld")
+		}
+		diffSpecs(flag.Arg(0), flag.Arg(1))
+		return
+	}
+
+	if check {
+		if len(specs) == 0 {
+			flag.Usage()
+			os.Exit(1)
+		}
+		checkSpecs(specs)
+		return
+	}
+
+	if len(specs) == 0 || outDir == "" || ((parserDir != "" || profiles) && modelImport == "") {
 		flag.Usage()
 		os.Exit(1)
 	}
 
+	if profiles && parserDir != "" {
+		log.Fatal("-parser-out requires a single package and cannot be used with -profile-packages")
+	}
+
+	if len(specs) > 1 || sharedDir != "" {
+		if version != "" || parserDir != "" || fixturesDir != "" || schemaPath != "" || protoPath != "" || profiles || len(extensions) > 0 {
+			log.Fatal("-version, -parser-out, -fixtures-out, -schema-out, -proto-out, -profile-packages and -extension apply to a single spec and cannot be used with several specs or -shared-out")
+		}
+		generateVersions(specs, pkgName, outDir, sharedDir, sharedPkg)
+		return
+	}
+
 	// Parse the model
 	parser := gen.NewParser()
-	model, err := parser.ParseFile(specFile)
+	model, err := parser.ParseFile(specs[0])
 	if err != nil {
 		log.Fatalf("Failed to parse model: %v", err)
 	}
+	for _, ext := range extensions {
+		if err := gen.NewParser().ParseExtensionFile(ext, model); err != nil {
+			log.Fatalf("Failed to parse extension model: %v", err)
+		}
+	}
 
 	// Set version if provided
 	if version != "" {
@@ -57,9 +129,94 @@ func main() {
 
 	// Generate code
 	generator := gen.NewGenerator(model, pkgName, outDir)
+	if parserDir != "" {
+		generator.WithParser(parserDir, modelImport)
+	}
+	if fixturesDir != "" {
+		generator.WithFixtures(fixturesDir)
+	}
+	if schemaPath != "" {
+		generator.WithSchema(schemaPath)
+	}
+	if protoPath != "" {
+		generator.WithProto(protoPath)
+	}
+	if profiles {
+		generator.WithProfilePackages(modelImport)
+	}
 	if err := generator.Generate(); err != nil {
 		log.Fatalf("Failed to generate code: %v", err)
 	}
 
 	fmt.Printf("Successfully generated code in %s\n", outDir)
 }
+
+// generateVersions generates one package per spec version under outDir,
+// and the shared interfaces package if sharedDir is set.
+func generateVersions(specs []string, pkgName, outDir, sharedDir, sharedPkg string) {
+	models := make([]*gen.Model, 0, len(specs))
+	for _, spec := range specs {
+		model, err := gen.NewParser().ParseFile(spec)
+		if err != nil {
+			log.Fatalf("Failed to parse model %s: %v", spec, err)
+		}
+		models = append(models, model)
+	}
+
+	generator := gen.NewMultiGenerator(models, pkgName, outDir)
+	if sharedDir != "" {
+		generator.WithShared(sharedDir, sharedPkg)
+	}
+	if err := generator.Generate(); err != nil {
+		log.Fatalf("Failed to generate code: %v", err)
+	}
+
+	for _, model := range models {
+		fmt.Printf("Successfully generated SPDX %s in %s\n", model.SpecVersion, filepath.Join(outDir, "v"+model.SpecVersion))
+	}
+	if sharedDir != "" {
+		fmt.Printf("Successfully generated shared interfaces in %s\n", sharedDir)
+	}
+}
+
+// checkSpecs prints the problems found in the spec files and exits with
+// status 1 if there are any.
+func checkSpecs(specs []string) {
+	failed := false
+	for _, spec := range specs {
+		model, err := gen.NewParser().ParseFile(spec)
+		if err != nil {
+			log.Fatalf("Failed to parse model %s: %v", spec, err)
+		}
+		diags := gen.Check(model)
+		for _, d := range diags {
+			fmt.Printf("%s: %s\n", spec, d)
+		}
+		if len(diags) > 0 {
+			failed = true
+			continue
+		}
+		fmt.Printf("%s: no problems found\n", spec)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// diffSpecs writes the differences between two spec versions to stdout.
+func diffSpecs(oldSpec, newSpec string) {
+	from, err := gen.NewParser().ParseFile(oldSpec)
+	if err != nil {
+		log.Fatalf("Failed to parse model %s: %v", oldSpec, err)
+	}
+	to, err := gen.NewParser().ParseFile(newSpec)
+	if err != nil {
+		log.Fatalf("Failed to parse model %s: %v", newSpec, err)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(gen.Diff(from, to)); err != nil {
+		log.Fatalf("Failed to write diff: %v", err)
+	}
+}
@@ -0,0 +1,344 @@
+// Package enrich adds vulnerability information from public databases to
+// SPDX 3.0 documents read with the parse package.
+//
+//	doc, err := parse.NewReader().ReadFile("sbom.spdx.json")
+//	...
+//	summary, err := enrich.NewOSVClient().Enrich(ctx, doc)
+//
+// Enrichment is optional: nothing in the parse package contacts these
+// services.
+package enrich
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Option configures a client.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	httpClient *http.Client
+	baseURL    string
+	idPrefix   string
+	apiKey     string
+	interval   time.Duration
+	limit      *limiter
+	now        func() time.Time
+}
+
+func newConfig(baseURL string, opts []Option) config {
+	c := config{
+		httpClient: http.DefaultClient,
+		baseURL:    baseURL,
+		now:        time.Now,
+	}
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+	return c
+}
+
+// startLimiter spaces the requests of the client by the configured
+// interval, or by defaultInterval if none is.
+func (c *config) startLimiter(defaultInterval time.Duration) {
+	if c.interval == 0 {
+		c.interval = defaultInterval
+	}
+	c.limit = &limiter{interval: c.interval}
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(c *config) {
+		c.httpClient = client
+	})
+}
+
+// WithBaseURL sets the base URL of the service, e.g. for a mirror or a test
+// server.
+func WithBaseURL(baseURL string) Option {
+	return optionFunc(func(c *config) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	})
+}
+
+// WithIDPrefix sets the prefix of the SPDX IDs of added elements. By
+// default they are fragments of the SPDX document ID, e.g.
+// "https://acme.example/sbom#vuln-CVE-2024-0001".
+func WithIDPrefix(prefix string) Option {
+	return optionFunc(func(c *config) {
+		c.idPrefix = prefix
+	})
+}
+
+// WithAPIKey sets the API key sent to services that accept one, such as
+// NVD, which allows a higher request rate with a key.
+func WithAPIKey(key string) Option {
+	return optionFunc(func(c *config) {
+		c.apiKey = key
+	})
+}
+
+// WithRateLimit limits the client to the given number of requests per
+// period, overriding the limit documented by the service. A requests value
+// of zero or less removes the limit.
+func WithRateLimit(requests int, per time.Duration) Option {
+	return optionFunc(func(c *config) {
+		if requests <= 0 {
+			c.interval = -1
+			return
+		}
+		c.interval = per / time.Duration(requests)
+	})
+}
+
+// Summary counts what an enrichment added to a document.
+type Summary struct {
+	// Packages is the number of packages looked up.
+	Packages int
+
+	// Vulnerabilities is the number of Vulnerability elements added.
+	Vulnerabilities int
+
+	// Assessments is the number of assessment relationships added.
+	Assessments int
+
+	// Updated is the number of existing assessment relationships refreshed
+	// with current values.
+	Updated int
+}
+
+// newID returns the SPDX ID of an added element, e.g. "vuln" and
+// "CVE-2024-0001" give "<prefix>vuln-CVE-2024-0001".
+func (c *config) newID(doc *parse.Document, kind string, parts ...string) string {
+	prefix := c.idPrefix
+	if prefix == "" {
+		prefix = doc.GetSpdxID() + "#"
+		if prefix == "#" {
+			prefix = "urn:spdx-zen:enrich:"
+		}
+	}
+	return prefix + kind + "-" + strings.Join(parts, "-")
+}
+
+// shortHash returns a short digest of an SPDX ID, for IDs derived from it.
+func shortHash(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// creationInfo returns the creation info of added elements: that of the
+// document, created now.
+func (c *config) creationInfo(doc *parse.Document) (spdx.CreationInfo, error) {
+	var ci *spdx.CreationInfo
+	switch {
+	case doc.SpdxDocument != nil && !doc.SpdxDocument.CreationInfo.Created.IsZero():
+		ci = doc.SpdxDocument.CreationInfo.Copy()
+	case doc.CreationInfo != nil:
+		ci = doc.CreationInfo.Copy()
+	default:
+		return spdx.CreationInfo{}, fmt.Errorf("document has no creation info")
+	}
+	ci.Created = c.now().UTC().Truncate(time.Second)
+	return *ci, nil
+}
+
+// doJSON sends a request with an optional JSON body and decodes the JSON
+// response into out.
+func (c *config) doJSON(ctx context.Context, method, url string, header http.Header, body, out interface{}) error {
+	_, err := c.doJSONHeader(ctx, method, url, header, body, out)
+	return err
+}
+
+// doJSONHeader is doJSON returning the response header, e.g. for links to
+// further pages.
+func (c *config) doJSONHeader(ctx context.Context, method, url string, header http.Header, body, out interface{}) (http.Header, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("encoding request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	if err := c.limit.wait(ctx); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(msg))}
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	return resp.Header, nil
+}
+
+// limiter spaces requests at least interval apart.
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next request may be sent or ctx is done.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil || l.interval <= 0 {
+		return nil
+	}
+	l.mu.Lock()
+	at := l.next
+	if now := time.Now(); at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	d := time.Until(at)
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// StatusError is returned when a service responds with an unexpected HTTP
+// status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// vulnerabilityIndex finds the vulnerabilities of a document by their name
+// and external identifiers, e.g. CVE IDs.
+type vulnerabilityIndex map[string]*spdx.Vulnerability
+
+func newVulnerabilityIndex(doc *parse.Document) vulnerabilityIndex {
+	idx := make(vulnerabilityIndex)
+	for _, v := range doc.Vulnerabilities {
+		idx.add(v)
+	}
+	return idx
+}
+
+func (idx vulnerabilityIndex) add(v *spdx.Vulnerability) {
+	if v.Name != "" {
+		idx[strings.ToUpper(v.Name)] = v
+	}
+	for _, ei := range v.ExternalIdentifier {
+		idx[strings.ToUpper(ei.Identifier)] = v
+	}
+}
+
+// lookup returns the vulnerability known by any of the given IDs.
+func (idx vulnerabilityIndex) lookup(ids ...string) *spdx.Vulnerability {
+	for _, id := range ids {
+		if v := idx[strings.ToUpper(id)]; v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// vulnerabilityIdentifier returns the external identifier of a vulnerability
+// ID, typed as a CVE when it is one.
+func vulnerabilityIdentifier(id, locator string) spdx.ExternalIdentifier {
+	ei := spdx.ExternalIdentifier{
+		ExternalIdentifierType: spdx.ExternalIdentifierTypeSecurityOther,
+		Identifier:             id,
+	}
+	if strings.HasPrefix(strings.ToUpper(id), "CVE-") {
+		ei.ExternalIdentifierType = spdx.ExternalIdentifierTypeCve
+	}
+	if locator != "" {
+		ei.IdentifierLocator = []string{locator}
+	}
+	return ei
+}
+
+// hasVEXAssessment reports whether the document has a VEX assessment of the
+// vulnerability for the element.
+func hasVEXAssessment(doc *parse.Document, vulnID, elemID string) bool {
+	covers := func(rel *spdx.VulnAssessmentRelationship) bool {
+		if rel.From.SpdxID != vulnID {
+			return false
+		}
+		for _, to := range rel.To {
+			if to.SpdxID == elemID {
+				return true
+			}
+		}
+		return false
+	}
+	for _, a := range doc.VexAffectedVulnAssessments {
+		if covers(&a.VulnAssessmentRelationship) {
+			return true
+		}
+	}
+	for _, a := range doc.VexFixedVulnAssessments {
+		if covers(&a.VulnAssessmentRelationship) {
+			return true
+		}
+	}
+	for _, a := range doc.VexNotAffectedVulnAssessments {
+		if covers(&a.VulnAssessmentRelationship) {
+			return true
+		}
+	}
+	for _, a := range doc.VexUnderInvestigationVulnAssessments {
+		if covers(&a.VulnAssessmentRelationship) {
+			return true
+		}
+	}
+	return false
+}
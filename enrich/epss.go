@@ -0,0 +1,163 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultEPSSURL is the URL of the FIRST EPSS API.
+const DefaultEPSSURL = "https://api.first.org/data/v1/epss"
+
+// epssBatchSize is the number of CVEs asked for in one request, keeping the
+// query string well under the length the API accepts.
+const epssBatchSize = 50
+
+// EPSSClient looks up Exploit Prediction Scoring System scores of CVEs in
+// the FIRST EPSS API.
+type EPSSClient struct {
+	config
+}
+
+// NewEPSSClient creates an EPSS client with the given options.
+func NewEPSSClient(opts ...Option) *EPSSClient {
+	c := &EPSSClient{config: newConfig(DefaultEPSSURL, opts)}
+	c.startLimiter(0)
+	return c
+}
+
+// EPSSScore is the EPSS score of a CVE on a given day.
+type EPSSScore struct {
+	CVE         string
+	Probability float64   // probability of exploitation in the next 30 days
+	Percentile  float64   // share of CVEs with a lower or equal probability
+	Date        time.Time // day the score was computed
+}
+
+type epssResponse struct {
+	Data []struct {
+		CVE        string `json:"cve"`
+		EPSS       string `json:"epss"`
+		Percentile string `json:"percentile"`
+		Date       string `json:"date"`
+	} `json:"data"`
+}
+
+// Scores returns the current EPSS scores of the given CVEs, keyed by upper
+// case CVE ID. CVEs that EPSS does not score are missing from the map.
+func (c *EPSSClient) Scores(ctx context.Context, cveIDs ...string) (map[string]EPSSScore, error) {
+	scores := make(map[string]EPSSScore, len(cveIDs))
+	for start := 0; start < len(cveIDs); start += epssBatchSize {
+		batch := cveIDs[start:min(start+epssBatchSize, len(cveIDs))]
+		query := url.Values{"cve": {strings.Join(batch, ",")}}
+		var resp epssResponse
+		if err := c.doJSON(ctx, http.MethodGet, c.baseURL+"?"+query.Encode(), nil, nil, &resp); err != nil {
+			return nil, fmt.Errorf("querying EPSS: %w", err)
+		}
+		for _, d := range resp.Data {
+			score := EPSSScore{CVE: strings.ToUpper(d.CVE)}
+			var err error
+			if score.Probability, err = strconv.ParseFloat(d.EPSS, 64); err != nil {
+				return nil, fmt.Errorf("EPSS score of %s: %w", d.CVE, err)
+			}
+			if score.Percentile, err = strconv.ParseFloat(d.Percentile, 64); err != nil {
+				return nil, fmt.Errorf("EPSS percentile of %s: %w", d.CVE, err)
+			}
+			if score.Date, err = time.Parse(time.DateOnly, d.Date); err != nil {
+				return nil, fmt.Errorf("EPSS date of %s: %w", d.CVE, err)
+			}
+			scores[score.CVE] = score
+		}
+	}
+	return scores, nil
+}
+
+// Enrich sets current EPSS scores on the vulnerabilities of the document
+// that are CVEs. Existing EPSS assessments of a vulnerability are updated in
+// place with the new probability, percentile and published time, the day
+// the score was computed. Vulnerabilities without one get a new assessment
+// for the elements associated with the vulnerability, as for
+// NVDClient.Enrich; those not associated with any element are skipped.
+//
+// The added elements take the creation info of the document. The document
+// is only changed once all scores have been fetched.
+func (c *EPSSClient) Enrich(ctx context.Context, doc *parse.Document) (*Summary, error) {
+	ci, err := c.creationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string][]*spdx.EpssVulnAssessmentRelationship)
+	for _, a := range doc.EpssVulnAssessments {
+		existing[a.From.SpdxID] = append(existing[a.From.SpdxID], a)
+	}
+	var cves []string
+	vulnCVE := make(map[string]string)
+	for _, vuln := range doc.Vulnerabilities {
+		cve := strings.ToUpper(cveID(vuln))
+		if cve == "" {
+			continue
+		}
+		if len(existing[vuln.SpdxID]) == 0 && len(associatedElements(doc, vuln.SpdxID)) == 0 {
+			continue
+		}
+		vulnCVE[vuln.SpdxID] = cve
+		cves = append(cves, cve)
+	}
+	if len(cves) == 0 {
+		return &Summary{}, nil
+	}
+
+	scores, err := c.Scores(ctx, cves...)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{}
+	var added, updated []spdx.AnyElement
+	for _, vuln := range doc.Vulnerabilities {
+		score, ok := scores[vulnCVE[vuln.SpdxID]]
+		if !ok {
+			continue
+		}
+		if as := existing[vuln.SpdxID]; len(as) > 0 {
+			for _, a := range as {
+				a.Probability = score.Probability
+				a.Percentile = score.Percentile
+				a.PublishedTime = score.Date
+				updated = append(updated, a)
+			}
+			continue
+		}
+
+		a := &spdx.EpssVulnAssessmentRelationship{
+			Probability: score.Probability,
+			Percentile:  score.Percentile,
+		}
+		a.SpdxID = c.newID(doc, "epss", score.CVE)
+		a.CreationInfo = ci
+		a.Comment = "EPSS score published by FIRST"
+		a.From = spdx.Element{SpdxID: vuln.SpdxID}
+		a.To = associatedElements(doc, vuln.SpdxID)
+		a.RelationshipType = spdx.RelationshipTypeHasAssessmentFor
+		a.PublishedTime = score.Date
+		added = append(added, a)
+	}
+
+	if err := doc.UpdateElements(updated...); err != nil {
+		return nil, err
+	}
+	if err := doc.AddElements(added...); err != nil {
+		return nil, err
+	}
+	summary.Assessments = len(added)
+	summary.Updated = len(updated)
+	return summary, nil
+}
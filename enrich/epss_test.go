@@ -0,0 +1,94 @@
+package enrich_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func epssServer(t *testing.T, queried *[]string) *httptest.Server {
+	t.Helper()
+	scores := map[string][2]string{
+		"CVE-2021-23337": {"0.01234", "0.85000"},
+		"CVE-2021-44228": {"0.97565", "0.99995"},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cves := r.URL.Query().Get("cve")
+		*queried = append(*queried, cves)
+
+		var data []interface{}
+		for _, cve := range strings.Split(cves, ",") {
+			if s, ok := scores[cve]; ok {
+				data = append(data, map[string]string{"cve": cve, "epss": s[0], "percentile": s[1], "date": "2024-06-01"})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"status": "OK", "data": data})
+	}))
+}
+
+func TestEPSSClient_Enrich(t *testing.T) {
+	var queried []string
+	srv := epssServer(t, &queried)
+	defer srv.Close()
+
+	// Log4Shell has a stale EPSS assessment; lodash has none.
+	sbom := strings.Replace(sbomWithCVEs, `"@graph": [`, `"@graph": [
+		{
+			"type": "security_EpssVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:epss-log4shell",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-log4shell",
+			"to": ["urn:spdx:pkg-log4j"],
+			"relationshipType": "hasAssessmentFor",
+			"security_probability": 0.5,
+			"security_percentile": 0.9,
+			"security_publishedTime": "2023-01-01T00:00:00Z"
+		},`, 1)
+	doc, err := parse.NewReader().Read([]byte(sbom))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	summary, err := enrich.NewEPSSClient(enrich.WithBaseURL(srv.URL)).Enrich(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if summary.Assessments != 1 || summary.Updated != 1 {
+		t.Errorf("summary = %+v, want 1 added and 1 updated", summary)
+	}
+	// The unassociated CVE is not looked up; the others share a request.
+	if len(queried) != 1 || queried[0] != "CVE-2021-23337,CVE-2021-44228" {
+		t.Errorf("queried %v, want one request for the associated CVEs", queried)
+	}
+
+	published := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	refreshed := doc.EpssVulnAssessmentsByID["urn:spdx:epss-log4shell"]
+	if refreshed.Probability != 0.97565 || refreshed.Percentile != 0.99995 || !refreshed.PublishedTime.Equal(published) {
+		t.Errorf("refreshed assessment = %+v", refreshed)
+	}
+	raw, _ := doc.GetElementByID(refreshed.SpdxID).(map[string]interface{})
+	if raw["security_probability"] != 0.97565 {
+		t.Errorf("raw probability = %v, want 0.97565", raw["security_probability"])
+	}
+
+	if len(doc.EpssVulnAssessments) != 2 {
+		t.Fatalf("EPSS assessments = %d, want 2", len(doc.EpssVulnAssessments))
+	}
+	added := doc.EpssVulnAssessments[1]
+	if added.SpdxID != "https://acme.example/sbom#epss-CVE-2021-23337" || added.From.SpdxID != "urn:spdx:vuln-lodash" {
+		t.Errorf("added assessment = %+v", added)
+	}
+	if added.Probability != 0.01234 || added.Percentile != 0.85 || !added.PublishedTime.Equal(published) {
+		t.Errorf("added scores = %v/%v at %v", added.Probability, added.Percentile, added.PublishedTime)
+	}
+	if len(added.To) != 1 || added.To[0].SpdxID != "urn:spdx:pkg-lodash" {
+		t.Errorf("added assessment for %v, want lodash", added.To)
+	}
+}
@@ -0,0 +1,111 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultGitHubURL is the base URL of the GitHub REST API.
+const DefaultGitHubURL = "https://api.github.com"
+
+// GitHub allows 60 requests an hour without a token and 5,000 with one.
+const (
+	gitHubInterval      = time.Hour / 60
+	gitHubTokenInterval = time.Hour / 5000
+)
+
+// GitHubClient looks up the GitHub Advisory Database through the global
+// security advisories API. An access token set with WithAPIKey raises the
+// rate limit.
+type GitHubClient struct {
+	config
+}
+
+// NewGitHubClient creates a GitHub client with the given options.
+func NewGitHubClient(opts ...Option) *GitHubClient {
+	c := &GitHubClient{config: newConfig(DefaultGitHubURL, opts)}
+	if c.apiKey != "" {
+		c.startLimiter(gitHubTokenInterval)
+	} else {
+		c.startLimiter(gitHubInterval)
+	}
+	return c
+}
+
+// GitHubAdvisory is a reviewed advisory of the GitHub Advisory Database.
+type GitHubAdvisory struct {
+	GHSAID          string                        `json:"ghsa_id"`
+	CVEID           string                        `json:"cve_id,omitempty"`
+	HTMLURL         string                        `json:"html_url,omitempty"`
+	Summary         string                        `json:"summary,omitempty"`
+	Description     string                        `json:"description,omitempty"`
+	Severity        string                        `json:"severity,omitempty"` // e.g. "critical"
+	Identifiers     []GitHubAdvisoryIdentifier    `json:"identifiers,omitempty"`
+	References      []string                      `json:"references,omitempty"`
+	PublishedAt     time.Time                     `json:"published_at,omitempty"`
+	UpdatedAt       time.Time                     `json:"updated_at,omitempty"`
+	WithdrawnAt     *time.Time                    `json:"withdrawn_at,omitempty"`
+	Vulnerabilities []GitHubAdvisoryVulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// GitHubAdvisoryIdentifier is an ID of an advisory, of type "GHSA" or
+// "CVE".
+type GitHubAdvisoryIdentifier struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// GitHubAdvisoryVulnerability is a package affected by an advisory.
+type GitHubAdvisoryVulnerability struct {
+	Package struct {
+		Ecosystem string `json:"ecosystem"`
+		Name      string `json:"name"`
+	} `json:"package"`
+	VulnerableVersionRange string `json:"vulnerable_version_range,omitempty"` // e.g. "< 4.17.21"
+	FirstPatchedVersion    string `json:"first_patched_version,omitempty"`
+}
+
+// Advisories returns the advisories affecting any of the given packages of
+// an ecosystem, such as "npm" or "pip". Packages are given as "name" or
+// "name@version".
+func (c *GitHubClient) Advisories(ctx context.Context, ecosystem string, affects []string) ([]GitHubAdvisory, error) {
+	var header http.Header
+	if c.apiKey != "" {
+		header = http.Header{"Authorization": {"Bearer " + c.apiKey}}
+	}
+	query := url.Values{
+		"ecosystem": {ecosystem},
+		"affects":   {strings.Join(affects, ",")},
+		"per_page":  {"100"},
+	}
+	next := c.baseURL + "/advisories?" + query.Encode()
+
+	var advisories []GitHubAdvisory
+	for next != "" {
+		var page []GitHubAdvisory
+		respHeader, err := c.doJSONHeader(ctx, http.MethodGet, next, header, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf("querying GitHub advisories: %w", err)
+		}
+		advisories = append(advisories, page...)
+		next = nextLink(respHeader.Get("Link"))
+	}
+	return advisories, nil
+}
+
+// nextLink returns the URL of the next page from a Link header, or "" if
+// there is none.
+func nextLink(link string) string {
+	for _, part := range strings.Split(link, ",") {
+		target, params, ok := strings.Cut(strings.TrimSpace(part), ";")
+		if !ok || !strings.Contains(params, `rel="next"`) {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(target), "<>")
+	}
+	return ""
+}
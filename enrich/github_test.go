@@ -0,0 +1,46 @@
+package enrich_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+)
+
+func TestGitHubClient_Advisories(t *testing.T) {
+	var auth []string
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = append(auth, r.Header.Get("Authorization"))
+		q := r.URL.Query()
+		if q.Get("ecosystem") != "npm" || q.Get("affects") != "lodash@4.17.20,minimist@1.2.0" {
+			t.Errorf("query = %v", q)
+		}
+		// Two pages, linked as GitHub does.
+		id := "GHSA-35jh-r3h4-6jhm"
+		if q.Get("after") == "" {
+			w.Header().Set("Link", `<`+srv.URL+`/advisories?ecosystem=npm&affects=lodash%404.17.20%2Cminimist%401.2.0&after=abc>; rel="next"`)
+		} else {
+			id = "GHSA-xvch-5gv4-984h"
+		}
+		_ = json.NewEncoder(w).Encode([]map[string]string{{"ghsa_id": id, "severity": "high"}})
+	}))
+	defer srv.Close()
+
+	client := enrich.NewGitHubClient(enrich.WithBaseURL(srv.URL), enrich.WithAPIKey("token"), enrich.WithRateLimit(0, 0))
+	advisories, err := client.Advisories(context.Background(), "npm", []string{"lodash@4.17.20", "minimist@1.2.0"})
+	if err != nil {
+		t.Fatalf("Advisories: %v", err)
+	}
+	if len(advisories) != 2 || advisories[0].GHSAID != "GHSA-35jh-r3h4-6jhm" || advisories[1].GHSAID != "GHSA-xvch-5gv4-984h" {
+		t.Errorf("advisories = %+v", advisories)
+	}
+	for _, a := range auth {
+		if a != "Bearer token" {
+			t.Errorf("Authorization = %q, want %q", a, "Bearer token")
+		}
+	}
+}
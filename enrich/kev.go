@@ -0,0 +1,240 @@
+package enrich
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultKEVURL is the URL of the JSON feed of the CISA Known Exploited
+// Vulnerabilities catalog.
+const DefaultKEVURL = "https://www.cisa.gov/sites/default/files/feeds/known_exploited_vulnerabilities.json"
+
+// KEVLocator is the locator of the CISA KEV catalog set on the exploit
+// catalog assessments added from it.
+const KEVLocator = "https://www.cisa.gov/known-exploited-vulnerabilities-catalog"
+
+// KEVCatalog is a snapshot of the CISA Known Exploited Vulnerabilities
+// catalog, fetched with KEVClient.Catalog or read from a copy of the feed
+// with ParseKEVCatalog, e.g. one bundled with an offline deployment.
+type KEVCatalog struct {
+	Version         string
+	Released        time.Time
+	Vulnerabilities []KEVEntry
+
+	byCVE map[string]int
+}
+
+// KEVEntry is a vulnerability listed in the KEV catalog.
+type KEVEntry struct {
+	CVE              string
+	VendorProject    string
+	Product          string
+	Name             string
+	ShortDescription string
+	RequiredAction   string
+	DateAdded        time.Time
+	DueDate          time.Time
+
+	// KnownRansomwareCampaignUse is "Known" or "Unknown".
+	KnownRansomwareCampaignUse string
+}
+
+type kevFeed struct {
+	CatalogVersion  string `json:"catalogVersion"`
+	DateReleased    string `json:"dateReleased"`
+	Vulnerabilities []struct {
+		CVEID                      string `json:"cveID"`
+		VendorProject              string `json:"vendorProject"`
+		Product                    string `json:"product"`
+		VulnerabilityName          string `json:"vulnerabilityName"`
+		DateAdded                  string `json:"dateAdded"`
+		ShortDescription           string `json:"shortDescription"`
+		RequiredAction             string `json:"requiredAction"`
+		DueDate                    string `json:"dueDate"`
+		KnownRansomwareCampaignUse string `json:"knownRansomwareCampaignUse"`
+	} `json:"vulnerabilities"`
+}
+
+// ParseKEVCatalog reads a KEV catalog in the format of the CISA JSON feed.
+func ParseKEVCatalog(r io.Reader) (*KEVCatalog, error) {
+	var feed kevFeed
+	if err := json.NewDecoder(r).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("decoding KEV catalog: %w", err)
+	}
+	return newKEVCatalog(&feed)
+}
+
+func newKEVCatalog(feed *kevFeed) (*KEVCatalog, error) {
+	k := &KEVCatalog{
+		Version:         feed.CatalogVersion,
+		Vulnerabilities: make([]KEVEntry, 0, len(feed.Vulnerabilities)),
+		byCVE:           make(map[string]int, len(feed.Vulnerabilities)),
+	}
+	if feed.DateReleased != "" {
+		released, err := time.Parse(time.RFC3339, feed.DateReleased)
+		if err != nil {
+			return nil, fmt.Errorf("KEV catalog release date: %w", err)
+		}
+		k.Released = released
+	}
+	for _, v := range feed.Vulnerabilities {
+		e := KEVEntry{
+			CVE:                        strings.ToUpper(v.CVEID),
+			VendorProject:              v.VendorProject,
+			Product:                    v.Product,
+			Name:                       v.VulnerabilityName,
+			ShortDescription:           v.ShortDescription,
+			RequiredAction:             v.RequiredAction,
+			KnownRansomwareCampaignUse: v.KnownRansomwareCampaignUse,
+		}
+		var err error
+		if e.DateAdded, err = parseKEVDate(v.DateAdded); err != nil {
+			return nil, fmt.Errorf("KEV date added of %s: %w", v.CVEID, err)
+		}
+		if e.DueDate, err = parseKEVDate(v.DueDate); err != nil {
+			return nil, fmt.Errorf("KEV due date of %s: %w", v.CVEID, err)
+		}
+		k.byCVE[e.CVE] = len(k.Vulnerabilities)
+		k.Vulnerabilities = append(k.Vulnerabilities, e)
+	}
+	return k, nil
+}
+
+func parseKEVDate(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.DateOnly, s)
+}
+
+// Lookup returns the catalog entry of a CVE.
+func (k *KEVCatalog) Lookup(cveID string) (KEVEntry, bool) {
+	i, ok := k.byCVE[strings.ToUpper(cveID)]
+	if !ok {
+		return KEVEntry{}, false
+	}
+	return k.Vulnerabilities[i], true
+}
+
+// Enrich marks the vulnerabilities of the document that are listed in the
+// catalog as exploited, with a KEV exploit catalog assessment for the
+// elements associated with the vulnerability, as for NVDClient.Enrich.
+// Existing KEV assessments that are not marked exploited are updated;
+// vulnerabilities not associated with any element are skipped. Only the
+// WithIDPrefix option applies.
+//
+// The added elements take the creation info of the document.
+func (k *KEVCatalog) Enrich(doc *parse.Document, opts ...Option) (*Summary, error) {
+	c := newConfig("", opts)
+	ci, err := c.creationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{}
+	var added, updated []spdx.AnyElement
+	for _, vuln := range doc.Vulnerabilities {
+		entry, ok := k.Lookup(cveID(vuln))
+		if !ok {
+			continue
+		}
+
+		var found bool
+		for _, a := range doc.ExploitCatalogVulnAssessments {
+			if a.From.SpdxID != vuln.SpdxID || a.CatalogType != spdx.ExploitCatalogTypeKev {
+				continue
+			}
+			found = true
+			if !a.Exploited {
+				a.Exploited = true
+				a.Locator = KEVLocator
+				updated = append(updated, a)
+			}
+		}
+		if found {
+			continue
+		}
+		targets := associatedElements(doc, vuln.SpdxID)
+		if len(targets) == 0 {
+			continue
+		}
+
+		a := &spdx.ExploitCatalogVulnAssessmentRelationship{
+			CatalogType: spdx.ExploitCatalogTypeKev,
+			Exploited:   true,
+			Locator:     KEVLocator,
+		}
+		a.SpdxID = c.newID(doc, "kev", entry.CVE)
+		a.CreationInfo = ci
+		a.Comment = kevComment(entry)
+		a.From = spdx.Element{SpdxID: vuln.SpdxID}
+		a.To = targets
+		a.RelationshipType = spdx.RelationshipTypeHasAssessmentFor
+		a.PublishedTime = entry.DateAdded
+		added = append(added, a)
+	}
+
+	if err := doc.UpdateElements(updated...); err != nil {
+		return nil, err
+	}
+	if err := doc.AddElements(added...); err != nil {
+		return nil, err
+	}
+	summary.Assessments = len(added)
+	summary.Updated = len(updated)
+	return summary, nil
+}
+
+func kevComment(e KEVEntry) string {
+	var b strings.Builder
+	b.WriteString("Listed in the CISA KEV catalog")
+	if e.RequiredAction != "" {
+		fmt.Fprintf(&b, "; required action: %s", e.RequiredAction)
+	}
+	if !e.DueDate.IsZero() {
+		fmt.Fprintf(&b, " (due %s)", e.DueDate.Format(time.DateOnly))
+	}
+	if e.KnownRansomwareCampaignUse == "Known" {
+		b.WriteString("; known to be used in ransomware campaigns")
+	}
+	return b.String()
+}
+
+// KEVClient fetches the CISA KEV catalog.
+type KEVClient struct {
+	config
+}
+
+// NewKEVClient creates a KEV client with the given options.
+func NewKEVClient(opts ...Option) *KEVClient {
+	c := &KEVClient{config: newConfig(DefaultKEVURL, opts)}
+	c.startLimiter(0)
+	return c
+}
+
+// Catalog fetches the current KEV catalog.
+func (c *KEVClient) Catalog(ctx context.Context) (*KEVCatalog, error) {
+	var feed kevFeed
+	if err := c.doJSON(ctx, http.MethodGet, c.baseURL, nil, nil, &feed); err != nil {
+		return nil, fmt.Errorf("fetching KEV catalog: %w", err)
+	}
+	return newKEVCatalog(&feed)
+}
+
+// Enrich fetches the current KEV catalog and marks the vulnerabilities of
+// the document listed in it, as KEVCatalog.Enrich does.
+func (c *KEVClient) Enrich(ctx context.Context, doc *parse.Document) (*Summary, error) {
+	catalog, err := c.Catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return catalog.Enrich(doc, WithIDPrefix(c.idPrefix))
+}
@@ -0,0 +1,124 @@
+package enrich_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const kevFeed = `{
+	"title": "CISA Catalog of Known Exploited Vulnerabilities",
+	"catalogVersion": "2024.06.01",
+	"dateReleased": "2024-06-01T15:00:01.0000Z",
+	"count": 2,
+	"vulnerabilities": [
+		{
+			"cveID": "CVE-2021-44228",
+			"vendorProject": "Apache",
+			"product": "Log4j2",
+			"vulnerabilityName": "Apache Log4j2 Remote Code Execution Vulnerability",
+			"dateAdded": "2021-12-10",
+			"shortDescription": "Apache Log4j2 contains a vulnerability where JNDI features do not protect against attacker-controlled JNDI-related endpoints.",
+			"requiredAction": "Apply updates per vendor instructions.",
+			"dueDate": "2021-12-24",
+			"knownRansomwareCampaignUse": "Known"
+		},
+		{
+			"cveID": "CVE-2021-23337",
+			"vendorProject": "Lodash",
+			"product": "Lodash",
+			"vulnerabilityName": "Lodash Command Injection Vulnerability",
+			"dateAdded": "2024-05-01",
+			"requiredAction": "Apply updates per vendor instructions.",
+			"dueDate": "2024-05-22",
+			"knownRansomwareCampaignUse": "Unknown"
+		}
+	]
+}`
+
+func TestParseKEVCatalog(t *testing.T) {
+	catalog, err := enrich.ParseKEVCatalog(strings.NewReader(kevFeed))
+	if err != nil {
+		t.Fatalf("ParseKEVCatalog: %v", err)
+	}
+	if catalog.Version != "2024.06.01" || catalog.Released.IsZero() || len(catalog.Vulnerabilities) != 2 {
+		t.Errorf("catalog = %+v", catalog)
+	}
+	entry, ok := catalog.Lookup("cve-2021-44228")
+	if !ok || entry.Product != "Log4j2" || !entry.DateAdded.Equal(time.Date(2021, 12, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Lookup = %+v, %v", entry, ok)
+	}
+	if _, ok := catalog.Lookup("CVE-2024-0001"); ok {
+		t.Error("Lookup found a CVE not in the catalog")
+	}
+
+	if _, err := enrich.ParseKEVCatalog(strings.NewReader(`{"vulnerabilities": [{"cveID": "CVE-1", "dateAdded": "10/12/2021"}]}`)); err == nil {
+		t.Error("ParseKEVCatalog accepted an invalid date")
+	}
+}
+
+func TestKEVClient_Enrich(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(kevFeed))
+	}))
+	defer srv.Close()
+
+	// Log4Shell has a KEV assessment that is not marked exploited yet.
+	sbom := strings.Replace(sbomWithCVEs, `"@graph": [`, `"@graph": [
+		{
+			"type": "security_ExploitCatalogVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:kev-log4shell",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-log4shell",
+			"to": ["urn:spdx:pkg-log4j"],
+			"relationshipType": "hasAssessmentFor",
+			"security_catalogType": "kev",
+			"security_exploited": false,
+			"security_locator": "https://example.com/kev"
+		},`, 1)
+	doc, err := parse.NewReader().Read([]byte(sbom))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	summary, err := enrich.NewKEVClient(enrich.WithBaseURL(srv.URL)).Enrich(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if summary.Assessments != 1 || summary.Updated != 1 {
+		t.Errorf("summary = %+v, want 1 added and 1 updated", summary)
+	}
+
+	updated := doc.ExploitCatalogVulnAssessmentsByID["urn:spdx:kev-log4shell"]
+	if !updated.Exploited || updated.Locator != enrich.KEVLocator {
+		t.Errorf("updated assessment = %+v", updated)
+	}
+
+	if len(doc.ExploitCatalogVulnAssessments) != 2 {
+		t.Fatalf("exploit catalog assessments = %d, want 2", len(doc.ExploitCatalogVulnAssessments))
+	}
+	added := doc.ExploitCatalogVulnAssessments[1]
+	if added.SpdxID != "https://acme.example/sbom#kev-CVE-2021-23337" || added.From.SpdxID != "urn:spdx:vuln-lodash" {
+		t.Errorf("added assessment = %+v", added)
+	}
+	if added.CatalogType != spdx.ExploitCatalogTypeKev || !added.Exploited || added.Locator != enrich.KEVLocator {
+		t.Errorf("added assessment = %+v", added)
+	}
+	if len(added.To) != 1 || added.To[0].SpdxID != "urn:spdx:pkg-lodash" {
+		t.Errorf("added assessment for %v, want lodash", added.To)
+	}
+
+	// A second run finds nothing left to do.
+	catalog, _ := enrich.ParseKEVCatalog(strings.NewReader(kevFeed))
+	summary, err = catalog.Enrich(doc)
+	if err != nil || summary.Assessments != 0 || summary.Updated != 0 {
+		t.Errorf("second Enrich = %+v, %v", summary, err)
+	}
+}
@@ -0,0 +1,247 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultNVDURL is the URL of the NVD CVE API 2.0.
+const DefaultNVDURL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+// NVD allows 5 requests in a rolling 30 second window without an API key
+// and 50 with one.
+const (
+	nvdInterval       = 30 * time.Second / 5
+	nvdAPIKeyInterval = 30 * time.Second / 50
+)
+
+// NVDClient looks up CVSS scores of CVEs in the NVD. Requests are spaced to
+// stay within the NVD rate limits, which are higher with an API key set by
+// WithAPIKey.
+type NVDClient struct {
+	config
+}
+
+// NewNVDClient creates an NVD client with the given options.
+func NewNVDClient(opts ...Option) *NVDClient {
+	c := &NVDClient{config: newConfig(DefaultNVDURL, opts)}
+	if c.apiKey != "" {
+		c.startLimiter(nvdAPIKeyInterval)
+	} else {
+		c.startLimiter(nvdInterval)
+	}
+	return c
+}
+
+// NVDScore is a CVSS score of a CVE published by the NVD.
+type NVDScore struct {
+	Source       string  // e.g. "nvd@nist.gov"
+	Version      string  // "3.0", "3.1" or "4.0"
+	VectorString string  // e.g. "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	BaseScore    float64 // 0.0 to 10.0
+	BaseSeverity string  // e.g. "CRITICAL"
+}
+
+type nvdMetric struct {
+	Source   string `json:"source"`
+	Type     string `json:"type"` // "Primary" or "Secondary"
+	CVSSData struct {
+		Version      string  `json:"version"`
+		VectorString string  `json:"vectorString"`
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+	} `json:"cvssData"`
+}
+
+type nvdResponse struct {
+	Vulnerabilities []struct {
+		CVE struct {
+			ID      string `json:"id"`
+			Metrics struct {
+				V40 []nvdMetric `json:"cvssMetricV40"`
+				V31 []nvdMetric `json:"cvssMetricV31"`
+				V30 []nvdMetric `json:"cvssMetricV30"`
+			} `json:"metrics"`
+		} `json:"cve"`
+	} `json:"vulnerabilities"`
+}
+
+// Scores returns the CVSS v3 and v4 scores of a CVE, preferring the primary
+// score of each version and CVSS 3.1 over 3.0. Either is nil if the NVD has
+// no score of that version, and both are if it does not know the CVE.
+func (c *NVDClient) Scores(ctx context.Context, cveID string) (v3, v4 *NVDScore, err error) {
+	var header http.Header
+	if c.apiKey != "" {
+		header = http.Header{"apiKey": {c.apiKey}}
+	}
+	var resp nvdResponse
+	if err := c.doJSON(ctx, http.MethodGet, c.baseURL+"?cveId="+url.QueryEscape(cveID), header, nil, &resp); err != nil {
+		return nil, nil, fmt.Errorf("querying NVD for %s: %w", cveID, err)
+	}
+	for _, vuln := range resp.Vulnerabilities {
+		if !strings.EqualFold(vuln.CVE.ID, cveID) {
+			continue
+		}
+		metrics := vuln.CVE.Metrics
+		v3 = primaryScore(metrics.V31)
+		if v3 == nil {
+			v3 = primaryScore(metrics.V30)
+		}
+		return v3, primaryScore(metrics.V40), nil
+	}
+	return nil, nil, nil
+}
+
+// primaryScore returns the primary score among metrics, or the first one if
+// none is primary.
+func primaryScore(metrics []nvdMetric) *NVDScore {
+	if len(metrics) == 0 {
+		return nil
+	}
+	m := metrics[0]
+	for _, metric := range metrics {
+		if metric.Type == "Primary" {
+			m = metric
+			break
+		}
+	}
+	return &NVDScore{
+		Source:       m.Source,
+		Version:      m.CVSSData.Version,
+		VectorString: m.CVSSData.VectorString,
+		BaseScore:    m.CVSSData.BaseScore,
+		BaseSeverity: m.CVSSData.BaseSeverity,
+	}
+}
+
+// Enrich adds CVSS v3 and v4 assessments from the NVD to the
+// vulnerabilities of the document that are CVEs and have no assessment of
+// that CVSS version yet. The assessments are for the elements associated
+// with the vulnerability: the sources of its hasAssociatedVulnerability
+// relationships and the products of its VEX affected and under
+// investigation assessments. Vulnerabilities not associated with any
+// element are skipped.
+//
+// The added elements take the creation info of the document. On error, the
+// elements added so far remain in the document.
+func (c *NVDClient) Enrich(ctx context.Context, doc *parse.Document) (*Summary, error) {
+	ci, err := c.creationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{}
+	for _, vuln := range doc.Vulnerabilities {
+		cve := cveID(vuln)
+		if cve == "" {
+			continue
+		}
+		needV3 := !slices.ContainsFunc(doc.CvssV3VulnAssessments, func(a *spdx.CvssV3VulnAssessmentRelationship) bool {
+			return a.From.SpdxID == vuln.SpdxID
+		})
+		needV4 := !slices.ContainsFunc(doc.CvssV4VulnAssessments, func(a *spdx.CvssV4VulnAssessmentRelationship) bool {
+			return a.From.SpdxID == vuln.SpdxID
+		})
+		targets := associatedElements(doc, vuln.SpdxID)
+		if (!needV3 && !needV4) || len(targets) == 0 {
+			continue
+		}
+
+		v3, v4, err := c.Scores(ctx, cve)
+		if err != nil {
+			return summary, err
+		}
+
+		var elems []spdx.AnyElement
+		if needV3 && v3 != nil {
+			a := &spdx.CvssV3VulnAssessmentRelationship{
+				Score:        v3.BaseScore,
+				Severity:     spdx.NormalizeCvssSeverityType(v3.BaseSeverity),
+				VectorString: v3.VectorString,
+			}
+			c.initAssessment(doc, &a.VulnAssessmentRelationship, "cvssv3", cve, vuln, targets, v3, ci)
+			elems = append(elems, a)
+		}
+		if needV4 && v4 != nil {
+			a := &spdx.CvssV4VulnAssessmentRelationship{
+				Score:        v4.BaseScore,
+				Severity:     spdx.NormalizeCvssSeverityType(v4.BaseSeverity),
+				VectorString: v4.VectorString,
+			}
+			c.initAssessment(doc, &a.VulnAssessmentRelationship, "cvssv4", cve, vuln, targets, v4, ci)
+			elems = append(elems, a)
+		}
+		if err := doc.AddElements(elems...); err != nil {
+			return summary, err
+		}
+		summary.Assessments += len(elems)
+	}
+	return summary, nil
+}
+
+func (c *NVDClient) initAssessment(doc *parse.Document, a *spdx.VulnAssessmentRelationship, kind, cve string, vuln *spdx.Vulnerability, targets []spdx.Element, score *NVDScore, ci spdx.CreationInfo) {
+	a.SpdxID = c.newID(doc, kind, cve)
+	a.CreationInfo = ci
+	a.Comment = fmt.Sprintf("CVSS %s score published by the NVD (source %s)", score.Version, score.Source)
+	a.From = spdx.Element{SpdxID: vuln.SpdxID}
+	a.To = targets
+	a.RelationshipType = spdx.RelationshipTypeHasAssessmentFor
+}
+
+// cveID returns the CVE ID of a vulnerability, from a cve external
+// identifier or its name.
+func cveID(v *spdx.Vulnerability) string {
+	for _, ei := range v.ExternalIdentifier {
+		if ei.ExternalIdentifierType == spdx.ExternalIdentifierTypeCve {
+			return ei.Identifier
+		}
+	}
+	if strings.HasPrefix(strings.ToUpper(v.Name), "CVE-") {
+		return v.Name
+	}
+	return ""
+}
+
+// associatedElements returns the element references that a vulnerability
+// is associated with or may affect, without duplicates.
+func associatedElements(doc *parse.Document, vulnID string) []spdx.Element {
+	var ids []string
+	add := func(id string) {
+		if id != "" && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	for _, rel := range doc.GetRelationshipsTo(vulnID) {
+		if rel.RelationshipType == spdx.RelationshipTypeHasAssociatedVulnerability {
+			add(rel.From.SpdxID)
+		}
+	}
+	for _, a := range doc.VexAffectedVulnAssessments {
+		if a.From.SpdxID == vulnID {
+			for _, to := range a.To {
+				add(to.SpdxID)
+			}
+		}
+	}
+	for _, a := range doc.VexUnderInvestigationVulnAssessments {
+		if a.From.SpdxID == vulnID {
+			for _, to := range a.To {
+				add(to.SpdxID)
+			}
+		}
+	}
+
+	elems := make([]spdx.Element, len(ids))
+	for i, id := range ids {
+		elems[i] = spdx.Element{SpdxID: id}
+	}
+	return elems
+}
@@ -0,0 +1,186 @@
+package enrich_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const sbomWithCVEs = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{
+			"type": "CreationInfo",
+			"@id": "_:creationinfo",
+			"specVersion": "3.0.1",
+			"created": "2024-01-01T00:00:00Z",
+			"createdBy": ["urn:spdx:org-acme"]
+		},
+		{"type": "SpdxDocument", "spdxId": "https://acme.example/sbom", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-lodash", "name": "lodash", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-log4j", "name": "log4j-core", "creationInfo": "_:creationinfo"},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-lodash", "name": "CVE-2021-23337", "creationInfo": "_:creationinfo"},
+		{
+			"type": "security_Vulnerability",
+			"spdxId": "urn:spdx:vuln-log4shell",
+			"name": "Log4Shell",
+			"creationInfo": "_:creationinfo",
+			"externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "cve", "identifier": "CVE-2021-44228"}]
+		},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-unassociated", "name": "CVE-2024-0001", "creationInfo": "_:creationinfo"},
+		{
+			"type": "Relationship",
+			"spdxId": "urn:spdx:rel-lodash",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:pkg-lodash",
+			"to": ["urn:spdx:vuln-lodash"],
+			"relationshipType": "hasAssociatedVulnerability"
+		},
+		{
+			"type": "security_VexAffectedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-log4shell",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-log4shell",
+			"to": ["urn:spdx:pkg-log4j"],
+			"relationshipType": "affects",
+			"security_actionStatement": "Upgrade to 2.17.1"
+		},
+		{
+			"type": "security_CvssV3VulnAssessmentRelationship",
+			"spdxId": "urn:spdx:cvss-log4shell",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-log4shell",
+			"to": ["urn:spdx:pkg-log4j"],
+			"relationshipType": "hasAssessmentFor",
+			"security_score": 10.0,
+			"security_severity": "critical",
+			"security_vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H"
+		}
+	]
+}`
+
+func nvdMetric(source, typ, version, vector string, score float64, severity string) map[string]interface{} {
+	return map[string]interface{}{
+		"source": source,
+		"type":   typ,
+		"cvssData": map[string]interface{}{
+			"version":      version,
+			"vectorString": vector,
+			"baseScore":    score,
+			"baseSeverity": severity,
+		},
+	}
+}
+
+func nvdServer(t *testing.T, queried *[]string, apiKeys *[]string) *httptest.Server {
+	t.Helper()
+	metrics := map[string]map[string]interface{}{
+		"CVE-2021-23337": {
+			"cvssMetricV31": []interface{}{
+				nvdMetric("security-advisories@github.com", "Secondary", "3.1", "CVSS:3.1/AV:N/AC:L/PR:H/UI:N/S:U/C:H/I:H/A:H", 7.2, "HIGH"),
+				nvdMetric("nvd@nist.gov", "Primary", "3.1", "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", 9.8, "CRITICAL"),
+			},
+		},
+		"CVE-2021-44228": {
+			"cvssMetricV40": []interface{}{
+				nvdMetric("nvd@nist.gov", "Primary", "4.0", "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:H/SI:H/SA:H", 10, "CRITICAL"),
+			},
+		},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cve := r.URL.Query().Get("cveId")
+		*queried = append(*queried, cve)
+		*apiKeys = append(*apiKeys, r.Header.Get("apiKey"))
+
+		resp := map[string]interface{}{"vulnerabilities": []interface{}{}}
+		if m, ok := metrics[cve]; ok {
+			resp["vulnerabilities"] = []interface{}{
+				map[string]interface{}{"cve": map[string]interface{}{"id": cve, "metrics": m}},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestNVDClient_Enrich(t *testing.T) {
+	var queried, apiKeys []string
+	srv := nvdServer(t, &queried, &apiKeys)
+	defer srv.Close()
+
+	doc, err := parse.NewReader().Read([]byte(sbomWithCVEs))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	client := enrich.NewNVDClient(enrich.WithBaseURL(srv.URL), enrich.WithAPIKey("secret"), enrich.WithRateLimit(0, 0))
+	summary, err := client.Enrich(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+	if summary.Assessments != 2 {
+		t.Errorf("assessments added = %d, want 2", summary.Assessments)
+	}
+	if len(queried) != 2 || queried[0] != "CVE-2021-23337" || queried[1] != "CVE-2021-44228" {
+		t.Errorf("queried %v, want the two associated CVEs", queried)
+	}
+	for _, key := range apiKeys {
+		if key != "secret" {
+			t.Errorf("API key = %q, want %q", key, "secret")
+		}
+	}
+
+	// The primary v3.1 score is added for lodash, which had none.
+	if len(doc.CvssV3VulnAssessments) != 2 {
+		t.Fatalf("CVSS v3 assessments = %d, want 2", len(doc.CvssV3VulnAssessments))
+	}
+	v3 := doc.CvssV3VulnAssessments[1]
+	if v3.From.SpdxID != "urn:spdx:vuln-lodash" || v3.Score != 9.8 || v3.Severity != spdx.CvssSeverityTypeCritical {
+		t.Errorf("CVSS v3 assessment = %+v", v3)
+	}
+	if len(v3.To) != 1 || v3.To[0].SpdxID != "urn:spdx:pkg-lodash" {
+		t.Errorf("CVSS v3 assessment for %v, want lodash", v3.To)
+	}
+
+	// Log4Shell keeps its v3 score and gains a v4 one.
+	if len(doc.CvssV4VulnAssessments) != 1 {
+		t.Fatalf("CVSS v4 assessments = %d, want 1", len(doc.CvssV4VulnAssessments))
+	}
+	v4 := doc.CvssV4VulnAssessments[0]
+	if v4.From.SpdxID != "urn:spdx:vuln-log4shell" || v4.RelationshipType != spdx.RelationshipTypeHasAssessmentFor {
+		t.Errorf("CVSS v4 assessment = %+v", v4)
+	}
+	if len(v4.To) != 1 || v4.To[0].SpdxID != "urn:spdx:pkg-log4j" {
+		t.Errorf("CVSS v4 assessment for %v, want log4j-core", v4.To)
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	var queried, apiKeys []string
+	srv := nvdServer(t, &queried, &apiKeys)
+	defer srv.Close()
+
+	client := enrich.NewNVDClient(enrich.WithBaseURL(srv.URL), enrich.WithRateLimit(1, 50*time.Millisecond))
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, _, err := client.Scores(context.Background(), "CVE-2021-23337"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests took %v, want at least 100ms at 1 per 50ms", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := client.Scores(ctx, "CVE-2021-23337"); err == nil {
+		t.Error("Scores succeeded with a canceled context")
+	}
+}
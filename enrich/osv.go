@@ -0,0 +1,341 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultOSVURL is the base URL of the OSV.dev API.
+const DefaultOSVURL = "https://api.osv.dev"
+
+// OSVClient looks up vulnerabilities in the OSV.dev database by package URL.
+type OSVClient struct {
+	config
+}
+
+// NewOSVClient creates an OSV.dev client with the given options.
+func NewOSVClient(opts ...Option) *OSVClient {
+	c := &OSVClient{config: newConfig(DefaultOSVURL, opts)}
+	c.startLimiter(0)
+	return c
+}
+
+// OSVVulnerability is an entry of the OSV database, in the OSV schema.
+type OSVVulnerability struct {
+	ID         string         `json:"id"`
+	Summary    string         `json:"summary,omitempty"`
+	Details    string         `json:"details,omitempty"`
+	Aliases    []string       `json:"aliases,omitempty"`
+	Published  time.Time      `json:"published,omitempty"`
+	Modified   time.Time      `json:"modified,omitempty"`
+	Withdrawn  time.Time      `json:"withdrawn,omitempty"`
+	References []OSVReference `json:"references,omitempty"`
+	Severity   []OSVSeverity  `json:"severity,omitempty"`
+	Affected   []OSVAffected  `json:"affected,omitempty"`
+}
+
+// OSVReference is a link to more information on a vulnerability.
+type OSVReference struct {
+	Type string `json:"type"` // e.g. "ADVISORY", "FIX", "WEB"
+	URL  string `json:"url"`
+}
+
+// OSVSeverity is a severity score of a vulnerability.
+type OSVSeverity struct {
+	Type  string `json:"type"`  // e.g. "CVSS_V3"
+	Score string `json:"score"` // e.g. a CVSS vector string
+}
+
+// OSVAffected lists the affected versions of a package.
+type OSVAffected struct {
+	Package OSVPackage `json:"package"`
+	Ranges  []OSVRange `json:"ranges,omitempty"`
+}
+
+// OSVPackage identifies a package in an ecosystem.
+type OSVPackage struct {
+	Ecosystem string `json:"ecosystem,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Purl      string `json:"purl,omitempty"`
+}
+
+// OSVRange is a range of affected versions, given by events such as
+// {"introduced": "0"} and {"fixed": "1.2.3"}.
+type OSVRange struct {
+	Type   string              `json:"type"`
+	Events []map[string]string `json:"events"`
+}
+
+type osvQuery struct {
+	Package   OSVPackage `json:"package"`
+	PageToken string     `json:"page_token,omitempty"`
+}
+
+type osvQueryResponse struct {
+	Vulns         []OSVVulnerability `json:"vulns"`
+	NextPageToken string             `json:"next_page_token"`
+}
+
+// Query returns the vulnerabilities affecting the package with the given
+// package URL, which should include the version.
+func (c *OSVClient) Query(ctx context.Context, purl string) ([]OSVVulnerability, error) {
+	var vulns []OSVVulnerability
+	query := osvQuery{Package: OSVPackage{Purl: purl}}
+	for {
+		var resp osvQueryResponse
+		if err := c.doJSON(ctx, http.MethodPost, c.baseURL+"/v1/query", nil, query, &resp); err != nil {
+			return nil, fmt.Errorf("querying OSV for %s: %w", purl, err)
+		}
+		vulns = append(vulns, resp.Vulns...)
+		if resp.NextPageToken == "" {
+			return vulns, nil
+		}
+		query.PageToken = resp.NextPageToken
+	}
+}
+
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+		NextPageToken string `json:"next_page_token"`
+	} `json:"results"`
+}
+
+// QueryBatch returns the vulnerabilities affecting each of the packages
+// with the given package URLs, in the order of purls. It asks OSV for the
+// IDs of all packages in one request, then fetches each vulnerability once
+// with Get.
+func (c *OSVClient) QueryBatch(ctx context.Context, purls []string) ([][]OSVVulnerability, error) {
+	// pending holds the queries of the next request, and index the position
+	// of each in purls.
+	pending := make([]osvQuery, len(purls))
+	index := make([]int, len(purls))
+	for i, purl := range purls {
+		pending[i] = osvQuery{Package: OSVPackage{Purl: purl}}
+		index[i] = i
+	}
+	ids := make([][]string, len(purls))
+	for len(pending) > 0 {
+		var resp osvBatchResponse
+		if err := c.doJSON(ctx, http.MethodPost, c.baseURL+"/v1/querybatch", nil, map[string]interface{}{"queries": pending}, &resp); err != nil {
+			return nil, fmt.Errorf("querying OSV: %w", err)
+		}
+		if len(resp.Results) != len(pending) {
+			return nil, fmt.Errorf("querying OSV: %d results for %d queries", len(resp.Results), len(pending))
+		}
+		// Ask again for the packages with more pages of results.
+		var nextPending []osvQuery
+		var nextIndex []int
+		for i, result := range resp.Results {
+			for _, v := range result.Vulns {
+				ids[index[i]] = append(ids[index[i]], v.ID)
+			}
+			if result.NextPageToken != "" {
+				q := pending[i]
+				q.PageToken = result.NextPageToken
+				nextPending = append(nextPending, q)
+				nextIndex = append(nextIndex, index[i])
+			}
+		}
+		pending, index = nextPending, nextIndex
+	}
+
+	fetched := make(map[string]*OSVVulnerability)
+	results := make([][]OSVVulnerability, len(purls))
+	for i := range purls {
+		for _, id := range ids[i] {
+			v, ok := fetched[id]
+			if !ok {
+				var err error
+				if v, err = c.Get(ctx, id); err != nil {
+					return nil, err
+				}
+				fetched[id] = v
+			}
+			results[i] = append(results[i], *v)
+		}
+	}
+	return results, nil
+}
+
+// Get returns the OSV entry with the given ID, e.g. "GHSA-35jh-r3h4-6jhm".
+func (c *OSVClient) Get(ctx context.Context, id string) (*OSVVulnerability, error) {
+	var v OSVVulnerability
+	if err := c.doJSON(ctx, http.MethodGet, c.baseURL+"/v1/vulns/"+url.PathEscape(id), nil, nil, &v); err != nil {
+		return nil, fmt.Errorf("fetching OSV entry %s: %w", id, err)
+	}
+	return &v, nil
+}
+
+// Enrich looks up every package of the document that has a versioned
+// package URL and adds what OSV reports for it: a Vulnerability per
+// advisory, unless the document already has one known by the advisory's ID
+// or an alias, and for each affected package a hasAssociatedVulnerability
+// relationship and a VEX affected assessment whose action statement names
+// the fixed versions. Withdrawn advisories and packages already assessed
+// for a vulnerability are skipped.
+//
+// The added elements take the creation info of the document. On error, the
+// elements added so far remain in the document.
+func (c *OSVClient) Enrich(ctx context.Context, doc *parse.Document) (*Summary, error) {
+	ci, err := c.creationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &Summary{}
+	known := newVulnerabilityIndex(doc)
+	results := make(map[string][]OSVVulnerability)
+	for _, pkg := range doc.Packages {
+		purl := pkg.PURL()
+		if purl == "" || !strings.Contains(purl, "@") {
+			continue
+		}
+		summary.Packages++
+
+		vulns, ok := results[purl]
+		if !ok {
+			if vulns, err = c.Query(ctx, purl); err != nil {
+				return summary, err
+			}
+			results[purl] = vulns
+		}
+
+		for i := range vulns {
+			ov := &vulns[i]
+			if !ov.Withdrawn.IsZero() {
+				continue
+			}
+			vuln := known.lookup(append([]string{ov.ID}, ov.Aliases...)...)
+			if vuln == nil {
+				vuln = c.vulnerability(doc, ov, ci)
+				if err := doc.AddElements(vuln); err != nil {
+					return summary, err
+				}
+				known.add(vuln)
+				summary.Vulnerabilities++
+			}
+			added, err := c.assess(doc, ov, vuln, pkg, purl, ci)
+			if err != nil {
+				return summary, err
+			}
+			if added {
+				summary.Assessments++
+			}
+		}
+	}
+	return summary, nil
+}
+
+// vulnerability converts an OSV entry.
+func (c *OSVClient) vulnerability(doc *parse.Document, ov *OSVVulnerability, ci spdx.CreationInfo) *spdx.Vulnerability {
+	v := &spdx.Vulnerability{
+		PublishedTime: ov.Published,
+		ModifiedTime:  ov.Modified,
+	}
+	v.SpdxID = c.newID(doc, "vuln", ov.ID)
+	v.Name = ov.ID
+	v.Summary = ov.Summary
+	v.Description = ov.Details
+	v.CreationInfo = ci
+	v.ExternalIdentifier = append(v.ExternalIdentifier, vulnerabilityIdentifier(ov.ID, "https://osv.dev/vulnerability/"+ov.ID))
+	for _, alias := range ov.Aliases {
+		v.ExternalIdentifier = append(v.ExternalIdentifier, vulnerabilityIdentifier(alias, ""))
+	}
+	for _, ref := range ov.References {
+		refType := spdx.ExternalRefTypeSecurityOther
+		switch ref.Type {
+		case "ADVISORY":
+			refType = spdx.ExternalRefTypeSecurityAdvisory
+		case "FIX":
+			refType = spdx.ExternalRefTypeSecurityFix
+		}
+		v.ExternalRef = append(v.ExternalRef, spdx.ExternalRef{ExternalRefType: refType, Locator: []string{ref.URL}})
+	}
+	return v
+}
+
+// assess adds the relationship and VEX assessment between a vulnerability
+// and a package it affects, unless the package is already assessed for it.
+func (c *OSVClient) assess(doc *parse.Document, ov *OSVVulnerability, vuln *spdx.Vulnerability, pkg *spdx.Package, purl string, ci spdx.CreationInfo) (bool, error) {
+	if hasVEXAssessment(doc, vuln.SpdxID, pkg.SpdxID) {
+		return false, nil
+	}
+	var elems []spdx.AnyElement
+
+	associated := false
+	for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+		if rel.RelationshipType != spdx.RelationshipTypeHasAssociatedVulnerability {
+			continue
+		}
+		for _, to := range rel.To {
+			associated = associated || to.SpdxID == vuln.SpdxID
+		}
+	}
+	if !associated {
+		rel := &spdx.Relationship{
+			From:             spdx.Element{SpdxID: pkg.SpdxID},
+			To:               []spdx.Element{{SpdxID: vuln.SpdxID}},
+			RelationshipType: spdx.RelationshipTypeHasAssociatedVulnerability,
+		}
+		rel.SpdxID = c.newID(doc, "rel", ov.ID, shortHash(pkg.SpdxID))
+		rel.CreationInfo = ci
+		elems = append(elems, rel)
+	}
+
+	action := "No fixed version is known; see the advisory for mitigations."
+	if fixed := ov.FixedVersions(purl); len(fixed) > 0 {
+		action = "Upgrade to version " + strings.Join(fixed, " or ") + " or later."
+	}
+	a := &spdx.VexAffectedVulnAssessmentRelationship{ActionStatement: action}
+	a.SpdxID = c.newID(doc, "vex", ov.ID, shortHash(pkg.SpdxID))
+	a.CreationInfo = ci
+	a.From = spdx.Element{SpdxID: vuln.SpdxID}
+	a.To = []spdx.Element{{SpdxID: pkg.SpdxID}}
+	a.RelationshipType = spdx.RelationshipTypeAffects
+	a.StatusNotes = "Reported by OSV.dev for " + purl
+	elems = append(elems, a)
+
+	return true, doc.AddElements(elems...)
+}
+
+// FixedVersions returns the versions fixing the vulnerability in the
+// package with the given package URL, in the order OSV lists them.
+func (v *OSVVulnerability) FixedVersions(purl string) []string {
+	base := purlBase(purl)
+	var fixed []string
+	for _, affected := range v.Affected {
+		if affected.Package.Purl != "" && !strings.EqualFold(purlBase(affected.Package.Purl), base) {
+			continue
+		}
+		for _, r := range affected.Ranges {
+			for _, event := range r.Events {
+				if f := event["fixed"]; f != "" && !slices.Contains(fixed, f) {
+					fixed = append(fixed, f)
+				}
+			}
+		}
+	}
+	return fixed
+}
+
+// purlBase strips the version, qualifiers and subpath from a package URL.
+func purlBase(purl string) string {
+	if i := strings.IndexAny(purl, "?#"); i >= 0 {
+		purl = purl[:i]
+	}
+	if i := strings.LastIndex(purl, "@"); i >= 0 {
+		purl = purl[:i]
+	}
+	return purl
+}
@@ -0,0 +1,202 @@
+package enrich_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const sbom = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{
+			"type": "CreationInfo",
+			"@id": "_:creationinfo",
+			"specVersion": "3.0.1",
+			"created": "2024-01-01T00:00:00Z",
+			"createdBy": ["urn:spdx:org-acme"]
+		},
+		{"type": "Organization", "spdxId": "urn:spdx:org-acme", "name": "Acme", "creationInfo": "_:creationinfo"},
+		{
+			"type": "SpdxDocument",
+			"spdxId": "https://acme.example/sbom",
+			"creationInfo": "_:creationinfo",
+			"rootElement": ["urn:spdx:pkg-app"]
+		},
+		{
+			"type": "software_Package",
+			"spdxId": "urn:spdx:pkg-app",
+			"name": "app",
+			"creationInfo": "_:creationinfo",
+			"software_packageUrl": "pkg:npm/app@1.0.0"
+		},
+		{
+			"type": "software_Package",
+			"spdxId": "urn:spdx:pkg-lodash",
+			"name": "lodash",
+			"creationInfo": "_:creationinfo",
+			"externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "packageUrl", "identifier": "pkg:npm/lodash@4.17.20"}]
+		},
+		{
+			"type": "software_Package",
+			"spdxId": "urn:spdx:pkg-unversioned",
+			"name": "left-pad",
+			"creationInfo": "_:creationinfo",
+			"software_packageUrl": "pkg:npm/left-pad"
+		},
+		{
+			"type": "security_Vulnerability",
+			"spdxId": "urn:spdx:vuln-known",
+			"name": "CVE-2021-23337",
+			"creationInfo": "_:creationinfo"
+		}
+	]
+}`
+
+// osvServer answers OSV queries from a table of vulnerabilities by package
+// URL, returning the results for lodash in two pages.
+func osvServer(t *testing.T, queried *[]string) *httptest.Server {
+	t.Helper()
+	vulns := map[string][]enrich.OSVVulnerability{
+		"pkg:npm/lodash@4.17.20": {
+			{
+				ID:         "GHSA-35jh-r3h4-6jhm",
+				Summary:    "Command injection in lodash",
+				Aliases:    []string{"CVE-2021-23337"},
+				References: []enrich.OSVReference{{Type: "ADVISORY", URL: "https://nvd.nist.gov/vuln/detail/CVE-2021-23337"}},
+				Affected: []enrich.OSVAffected{{
+					Package: enrich.OSVPackage{Ecosystem: "npm", Name: "lodash", Purl: "pkg:npm/lodash"},
+					Ranges:  []enrich.OSVRange{{Type: "SEMVER", Events: []map[string]string{{"introduced": "0"}, {"fixed": "4.17.21"}}}},
+				}},
+			},
+			{
+				ID:      "GHSA-29mw-wpgm-hmr9",
+				Summary: "Regular expression denial of service in lodash",
+				Aliases: []string{"CVE-2020-28500"},
+				Affected: []enrich.OSVAffected{{
+					Package: enrich.OSVPackage{Purl: "pkg:npm/lodash"},
+					Ranges:  []enrich.OSVRange{{Type: "SEMVER", Events: []map[string]string{{"introduced": "4.0.0"}, {"fixed": "4.17.21"}}}},
+				}},
+			},
+		},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/query" {
+			http.NotFound(w, r)
+			return
+		}
+		var query struct {
+			Package struct {
+				Purl string `json:"purl"`
+			} `json:"package"`
+			PageToken string `json:"page_token"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		*queried = append(*queried, query.Package.Purl)
+
+		found := vulns[query.Package.Purl]
+		resp := map[string]interface{}{}
+		switch {
+		case len(found) > 1 && query.PageToken == "":
+			resp["vulns"] = found[:1]
+			resp["next_page_token"] = "page-2"
+		case len(found) > 1:
+			resp["vulns"] = found[1:]
+		default:
+			resp["vulns"] = found
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestOSVClient_Enrich(t *testing.T) {
+	var queried []string
+	srv := osvServer(t, &queried)
+	defer srv.Close()
+
+	doc, err := parse.NewReader().Read([]byte(sbom))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	client := enrich.NewOSVClient(enrich.WithBaseURL(srv.URL))
+	summary, err := client.Enrich(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("Enrich: %v", err)
+	}
+
+	if want := (enrich.Summary{Packages: 2, Vulnerabilities: 1, Assessments: 2}); *summary != want {
+		t.Errorf("summary = %+v, want %+v", *summary, want)
+	}
+	if len(queried) != 3 {
+		t.Errorf("queries = %v, want app once and lodash in two pages", queried)
+	}
+
+	// The known CVE is reused, the other advisory is added.
+	if len(doc.Vulnerabilities) != 2 {
+		t.Fatalf("vulnerabilities = %d, want 2", len(doc.Vulnerabilities))
+	}
+	added := doc.VulnerabilitiesByID["https://acme.example/sbom#vuln-GHSA-29mw-wpgm-hmr9"]
+	if added == nil {
+		t.Fatal("OSV advisory not added")
+	}
+	if len(added.ExternalIdentifier) != 2 || added.ExternalIdentifier[1].ExternalIdentifierType != spdx.ExternalIdentifierTypeCve {
+		t.Errorf("external identifiers = %+v, want the OSV ID and the CVE alias", added.ExternalIdentifier)
+	}
+
+	if len(doc.VexAffectedVulnAssessments) != 2 {
+		t.Fatalf("assessments = %d, want 2", len(doc.VexAffectedVulnAssessments))
+	}
+	for _, a := range doc.VexAffectedVulnAssessments {
+		if a.To[0].SpdxID != "urn:spdx:pkg-lodash" {
+			t.Errorf("assessment of %s, want lodash", a.To[0].SpdxID)
+		}
+		if !strings.Contains(a.ActionStatement, "4.17.21") {
+			t.Errorf("action statement = %q, want the fixed version", a.ActionStatement)
+		}
+	}
+	var assessedKnown bool
+	for _, a := range doc.VexAffectedVulnAssessments {
+		assessedKnown = assessedKnown || a.From.SpdxID == "urn:spdx:vuln-known"
+	}
+	if !assessedKnown {
+		t.Error("the vulnerability already in the document was not assessed")
+	}
+	if got := len(doc.GetSecurityInfoFor("urn:spdx:pkg-lodash").Relationships); got != 2 {
+		t.Errorf("security relationships of lodash = %d, want 2", got)
+	}
+
+	// Enriching again adds nothing.
+	again, err := client.Enrich(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("second Enrich: %v", err)
+	}
+	if again.Vulnerabilities != 0 || again.Assessments != 0 {
+		t.Errorf("second summary = %+v, want nothing added", *again)
+	}
+}
+
+func TestOSVClient_Query_Error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := enrich.NewOSVClient(enrich.WithBaseURL(srv.URL)).Query(context.Background(), "pkg:npm/lodash@4.17.20")
+	var statusErr *enrich.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("error = %v, want status 429", err)
+	}
+}
@@ -124,12 +124,12 @@ func printCreationInfo(doc *parse.Document) {
 	}
 
 	if len(doc.CreationInfo.CreatedBy) > 0 {
-		names := resolveAgentNames(doc, doc.CreationInfo.CreatedBy)
+		names := creatorNames(doc.GetCreators(doc.CreationInfo))
 		fmt.Printf("  Created By:   %s\n", strings.Join(names, ", "))
 	}
 
 	if len(doc.CreationInfo.CreatedUsing) > 0 {
-		names := resolveToolNames(doc, doc.CreationInfo.CreatedUsing)
+		names := toolNames(doc.GetCreationTools(doc.CreationInfo))
 		fmt.Printf("  Created Using: %s\n", strings.Join(names, ", "))
 	}
 	fmt.Println()
@@ -211,7 +211,7 @@ func printPackageBasicInfo(pkg *spdx.Package) {
 
 func printPackageAgents(doc *parse.Document, pkg *spdx.Package) {
 	if len(pkg.CreationInfo.CreatedBy) > 0 {
-		names := resolveAgentNames(doc, pkg.CreationInfo.CreatedBy)
+		names := creatorNames(doc.GetCreators(&pkg.CreationInfo))
 		fmt.Printf("      Created By: %s\n", strings.Join(names, ", "))
 	}
 
@@ -631,12 +631,30 @@ func resolveAgentName(doc *parse.Document, agent *spdx.Agent) string {
 	return agent.SpdxID
 }
 
-func resolveToolNames(doc *parse.Document, tools []spdx.Tool) []string {
+func creatorNames(agents []spdx.AgentInterface) []string {
+	names := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		name := agent.GetName()
+		if name == "" {
+			name = agent.GetSpdxID()
+		}
+		switch agent.(type) {
+		case *spdx.Organization:
+			name += " [" + string(parse.AgentTypeOrganization) + "]"
+		case *spdx.Person:
+			name += " [" + string(parse.AgentTypePerson) + "]"
+		case *spdx.SoftwareAgent:
+			name += " [" + string(parse.AgentTypeSoftwareAgent) + "]"
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func toolNames(tools []*spdx.Tool) []string {
 	names := make([]string, 0, len(tools))
 	for _, tool := range tools {
-		if resolved := doc.GetToolByID(tool.SpdxID); resolved != nil && resolved.Name != "" {
-			names = append(names, resolved.Name)
-		} else if tool.Name != "" {
+		if tool.Name != "" {
 			names = append(names, tool.Name)
 		} else {
 			names = append(names, tool.SpdxID)
@@ -0,0 +1,81 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Option configures an export.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+// config holds the settings of an export.
+type config struct {
+	columns []Column
+	header  bool
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{columns: DefaultColumns, header: true}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	return c
+}
+
+// WithColumns sets the columns of the package inventory, in order.
+func WithColumns(columns ...Column) Option {
+	return optionFunc(func(c *config) {
+		c.columns = columns
+	})
+}
+
+// WithoutHeader leaves out the header row of the package inventory.
+func WithoutHeader() Option {
+	return optionFunc(func(c *config) {
+		c.header = false
+	})
+}
+
+// WriteCSV writes the package inventory of a document as CSV, one row per
+// package, for spreadsheet-driven compliance reviews. The columns are
+// DefaultColumns unless set WithColumns, under a header row unless
+// WithoutHeader.
+func WriteCSV(w io.Writer, doc *parse.Document, opts ...Option) error {
+	c := newConfig(opts)
+	if err := checkColumns(c.columns); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if c.header {
+		header := make([]string, len(c.columns))
+		for i, col := range c.columns {
+			header[i] = col.Header()
+		}
+		if err := cw.Write(header); err != nil {
+			return fmt.Errorf("writing CSV: %w", err)
+		}
+	}
+	for _, row := range Inventory(doc) {
+		record := make([]string, len(c.columns))
+		for i, col := range c.columns {
+			record[i] = row.Value(col)
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("writing CSV: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	return nil
+}
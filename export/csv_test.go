@@ -0,0 +1,106 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/export"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const exportDoc = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]},
+		{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme, Inc."},
+		{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "name": "app-sbom", "rootElement": ["urn:spdx:app"]},
+		{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0",
+		 "software_packageUrl": "pkg:npm/app@1.0", "suppliedBy": "urn:spdx:acme", "software_primaryPurpose": "application",
+		 "verifiedUsing": [{"type": "Hash", "algorithm": "sha256", "hashValue": "ab12"}, {"type": "Hash", "algorithm": "sha1", "hashValue": "cd34"}]},
+		{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib", "software_packageVersion": "2.0",
+		 "externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "packageUrl", "identifier": "pkg:npm/lib@2.0"}]},
+		{"type": "simplelicensing_LicenseExpression", "spdxId": "urn:spdx:mit", "simplelicensing_licenseExpression": "MIT"},
+		{"type": "simplelicensing_LicenseExpression", "spdxId": "urn:spdx:apache", "simplelicensing_licenseExpression": "Apache-2.0"},
+		{"type": "Relationship", "spdxId": "urn:spdx:rel-decl", "from": "urn:spdx:app", "to": ["urn:spdx:mit"], "relationshipType": "hasDeclaredLicense"},
+		{"type": "Relationship", "spdxId": "urn:spdx:rel-conc", "from": "urn:spdx:app", "to": ["urn:spdx:mit", "urn:spdx:apache"], "relationshipType": "hasConcludedLicense"},
+		{"type": "Relationship", "spdxId": "urn:spdx:rel-lib", "from": "urn:spdx:lib", "to": ["urn:spdx:apache"], "relationshipType": "hasDeclaredLicense"},
+		{"type": "Relationship", "spdxId": "urn:spdx:rel-dep", "from": "urn:spdx:app", "to": ["urn:spdx:lib"], "relationshipType": "dependsOn"},
+		{"type": "software_File", "spdxId": "urn:spdx:main", "name": "main.go"},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln", "name": "CVE-2024-0001"},
+		{"type": "Relationship", "spdxId": "urn:spdx:rel-vuln", "from": "urn:spdx:lib", "to": ["urn:spdx:vuln"], "relationshipType": "hasAssociatedVulnerability"},
+		{"type": "security_CvssV3VulnAssessmentRelationship", "spdxId": "urn:spdx:cvss", "security_score": 9.8, "security_severity": "critical",
+		 "from": "urn:spdx:vuln", "to": ["urn:spdx:lib"], "relationshipType": "hasAssessmentFor"}
+	]
+}`
+
+func readExportDoc(t testing.TB) *parse.Document {
+	t.Helper()
+	doc, err := parse.NewReader().Read([]byte(exportDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestWriteCSV(t *testing.T) {
+	doc := readExportDoc(t)
+	tests := []struct {
+		name    string
+		opts    []export.Option
+		want    [][]string
+		wantErr bool
+	}{
+		{
+			name: "default columns",
+			want: [][]string{
+				{"Name", "Version", "PURL", "Supplier", "Declared License", "Concluded License", "Hashes"},
+				{"app", "1.0", "pkg:npm/app@1.0", "Acme, Inc.", "MIT", "MIT AND Apache-2.0", "sha256:ab12 sha1:cd34"},
+				{"lib", "2.0", "pkg:npm/lib@2.0", "", "Apache-2.0", "", ""},
+			},
+		},
+		{
+			name: "custom columns",
+			opts: []export.Option{export.WithColumns(export.ColumnSpdxID, export.ColumnPrimaryPurpose)},
+			want: [][]string{
+				{"SPDX ID", "Primary Purpose"},
+				{"urn:spdx:app", "application"},
+				{"urn:spdx:lib", ""},
+			},
+		},
+		{
+			name: "without header",
+			opts: []export.Option{export.WithColumns(export.ColumnName), export.WithoutHeader()},
+			want: [][]string{{"app"}, {"lib"}},
+		},
+		{
+			name:    "unknown column",
+			opts:    []export.Option{export.WithColumns("color")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := export.WriteCSV(&buf, doc, tt.opts...)
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "color") {
+					t.Errorf("WriteCSV() error = %v, want unknown column", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("WriteCSV() error = %v", err)
+			}
+			got, err := csv.NewReader(&buf).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WriteCSV() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// htmlReport is the data of the HTML report template.
+type htmlReport struct {
+	*summary
+	Title   string
+	Columns []Column
+	Rows    [][]string
+	Open    int
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"join": strings.Join,
+	"date": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.UTC().Format("2006-01-02 15:04 MST")
+	},
+	"score":    vulnerabilityRow.scoreText,
+	"severity": vulnerabilityRow.severityName,
+	"percent":  func(p float64) string { return fmt.Sprintf("%.1f", p) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 72rem; padding: 0 1rem; color: #1f2328; }
+h1 { margin-bottom: .25rem; }
+.meta { color: #59636e; margin-top: 0; }
+.cards { display: flex; flex-wrap: wrap; gap: 1rem; margin: 1.5rem 0; }
+.card { border: 1px solid #d1d9e0; border-radius: 6px; padding: .75rem 1.25rem; min-width: 8rem; }
+.card strong { display: block; font-size: 1.75rem; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 1rem; font-size: .9rem; }
+th, td { border-bottom: 1px solid #d1d9e0; padding: .4rem .6rem; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+td.num, th.num { text-align: right; }
+input[type=search] { width: 100%; max-width: 24rem; padding: .4rem; margin-bottom: .5rem; }
+.bar { background: #0969da; height: .6rem; border-radius: 3px; }
+.tree ul { list-style: none; padding-left: 1.25rem; border-left: 1px dotted #d1d9e0; }
+.tree > ul { border-left: none; padding-left: 0; }
+.tree summary { cursor: pointer; }
+.repeated { color: #59636e; font-style: italic; }
+.sev { border-radius: 3px; padding: 0 .4rem; color: #fff; background: #59636e; }
+.sev-critical { background: #a40e26; }
+.sev-high { background: #d1242f; }
+.sev-medium { background: #bc4c00; }
+.sev-low { background: #9a6700; }
+.open { color: #d1242f; font-weight: bold; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="meta">{{with .ID}}{{.}}{{end}}{{with .SpecVersion}} &middot; SPDX {{.}}{{end}}{{with date .Created}} &middot; created {{.}}{{end}}{{with .CreatedBy}} by {{join . ", "}}{{end}}</p>
+
+<div class="cards">
+<div class="card"><strong>{{len .Packages}}</strong>packages</div>
+<div class="card"><strong>{{.Files}}</strong>files</div>
+<div class="card"><strong>{{.Relationships}}</strong>relationships</div>
+<div class="card"><strong>{{len .Licenses}}</strong>licenses</div>
+<div class="card"><strong>{{len .Vulnerabilities}}</strong>vulnerabilities</div>
+<div class="card"><strong>{{.Open}}</strong>open vulnerabilities</div>
+</div>
+
+<h2>Packages</h2>
+<input type="search" id="package-search" placeholder="Search packages" aria-label="Search packages">
+<table id="packages">
+<thead><tr>{{range .Columns}}<th>{{.Header}}</th>{{end}}</tr></thead>
+<tbody>
+{{range .Rows}}<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{end}}</tbody>
+</table>
+
+<h2>Dependency Tree</h2>
+{{if .Tree}}<div class="tree"><ul>
+{{range .Tree}}{{template "node" .}}{{end}}</ul></div>
+{{else}}<p>No dependencies.</p>
+{{end}}
+<h2>Licenses</h2>
+<table>
+<thead><tr><th>License</th><th class="num">Packages</th><th class="num">Share</th><th></th></tr></thead>
+<tbody>
+{{range .Licenses}}<tr><td>{{.License}}</td><td class="num">{{.Packages}}</td><td class="num">{{percent .Percent}}%</td><td style="width: 30%"><div class="bar" style="width: {{percent .Percent}}%"></div></td></tr>
+{{end}}</tbody>
+</table>
+
+<h2>Vulnerabilities</h2>
+{{if .Vulnerabilities}}<p>{{range $i, $s := .Severity}}{{if $i}} &middot; {{end}}{{$s.Count}} {{$s.Severity}}{{end}}</p>
+<table>
+<thead><tr><th>ID</th><th>Severity</th><th class="num">Score</th><th>Packages</th><th>Status</th></tr></thead>
+<tbody>
+{{range .Vulnerabilities}}<tr><td>{{or .Name .SpdxID}}</td><td><span class="sev sev-{{severity .}}">{{severity .}}</span></td><td class="num">{{score .}}</td><td>{{join .Packages ", "}}</td><td>{{if .Open}}<span class="open">open</span>{{else}}resolved{{end}}</td></tr>
+{{end}}</tbody>
+</table>
+{{else}}<p>No vulnerabilities.</p>
+{{end}}
+<script>
+document.getElementById("package-search").addEventListener("input", function () {
+  var q = this.value.toLowerCase();
+  document.querySelectorAll("#packages tbody tr").forEach(function (tr) {
+    tr.hidden = q !== "" && tr.textContent.toLowerCase().indexOf(q) < 0;
+  });
+});
+</script>
+</body>
+</html>
+{{define "node"}}<li>{{if .Children}}<details open><summary>{{template "label" .}}</summary><ul>
+{{range .Children}}{{template "node" .}}{{end}}</ul></details>{{else}}{{template "label" .}}{{end}}</li>
+{{end}}{{define "label"}}{{or .Package.Name .Package.SpdxID}}{{with .Package.Version}} {{.}}{{end}}{{if .Repeated}} <span class="repeated">(shown above)</span>{{end}}{{end}}`))
+
+// WriteHTML writes a document as a single-file HTML report for sharing
+// with people who do not read SBOMs: a summary, a searchable table of the
+// packages, the dependency tree from the top-level components, the
+// licenses by number of packages and the vulnerabilities by severity. The
+// report needs no network access; its styles and search script are
+// inline. The package table has the columns DefaultColumns unless set
+// WithColumns.
+//
+// Vulnerabilities are open unless their VEX assessments leave no product
+// affected or under investigation.
+func WriteHTML(w io.Writer, doc *parse.Document, opts ...Option) error {
+	c := newConfig(opts)
+	if err := checkColumns(c.columns); err != nil {
+		return err
+	}
+	s := summarize(doc)
+	report := &htmlReport{
+		summary: s,
+		Title:   "SBOM Report: " + reportTitle(s),
+		Columns: c.columns,
+		Open:    len(s.openVulnerabilities()),
+	}
+	for _, row := range s.Packages {
+		cells := make([]string, len(c.columns))
+		for i, col := range c.columns {
+			cells[i] = row.Value(col)
+		}
+		report.Rows = append(report.Rows, cells)
+	}
+	if err := htmlTemplate.Execute(w, report); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+// reportTitle returns the name of the document of a report, or else its
+// SPDX ID.
+func reportTitle(s *summary) string {
+	return cmp.Or(s.Name, s.ID, "untitled")
+}
@@ -0,0 +1,66 @@
+package export_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/export"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestWriteHTML(t *testing.T) {
+	doc := readExportDoc(t)
+	var buf bytes.Buffer
+	if err := export.WriteHTML(&buf, doc); err != nil {
+		t.Fatalf("WriteHTML() error = %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"<title>SBOM Report: app-sbom</title>",
+		"created 2024-05-01 00:00 UTC by Acme, Inc.",
+		`<strong>2</strong>packages`,
+		`<strong>1</strong>open vulnerabilities`,
+		`<td>pkg:npm/lib@2.0</td>`,
+		`<details open><summary>app 1.0</summary><ul>`,
+		`<li>lib 2.0</li>`,
+		`<tr><td>MIT AND Apache-2.0</td><td class="num">1</td><td class="num">50.0%</td>`,
+		`<span class="sev sev-critical">critical</span></td><td class="num">9.8</td><td>lib</td><td><span class="open">open</span>`,
+		`id="package-search"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("WriteHTML() missing %q", want)
+		}
+	}
+
+	if err := export.WriteHTML(&buf, doc, export.WithColumns("color")); err == nil {
+		t.Error("WriteHTML() with unknown column: want error")
+	}
+}
+
+func TestWriteHTML_Escaping(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(`{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+		"@graph": [
+			{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "name": "<b>sbom</b>"},
+			{"type": "software_Package", "spdxId": "urn:spdx:x", "name": "<script>alert(1)</script>"}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := export.WriteHTML(&buf, doc); err != nil {
+		t.Fatalf("WriteHTML() error = %v", err)
+	}
+	got := buf.String()
+	if strings.Contains(got, "<script>alert") || strings.Contains(got, "<b>sbom") {
+		t.Error("WriteHTML() did not escape document content")
+	}
+	if !strings.Contains(got, "&lt;script&gt;alert(1)&lt;/script&gt;") {
+		t.Error("WriteHTML() missing escaped package name")
+	}
+	if !strings.Contains(got, "No vulnerabilities.") {
+		t.Error("WriteHTML() missing empty vulnerability section")
+	}
+}
@@ -0,0 +1,201 @@
+// Package export renders the contents of SBOMs in formats for people and
+// spreadsheets, such as a CSV package inventory, Excel workbooks, HTML
+// reports and Markdown summaries.
+//
+//	doc, err := parse.NewReader().ReadFile("sbom.spdx.json")
+//	...
+//	err = export.WriteCSV(os.Stdout, doc, export.WithColumns(export.ColumnName, export.ColumnVersion))
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Column is a column of the package inventory.
+type Column string
+
+// Columns of the package inventory.
+const (
+	ColumnSpdxID           Column = "spdxId"
+	ColumnName             Column = "name"
+	ColumnVersion          Column = "version"
+	ColumnPURL             Column = "purl"
+	ColumnSupplier         Column = "supplier"
+	ColumnOriginator       Column = "originator"
+	ColumnDeclaredLicense  Column = "declaredLicense"
+	ColumnConcludedLicense Column = "concludedLicense"
+	ColumnHashes           Column = "hashes"
+	ColumnDownloadLocation Column = "downloadLocation"
+	ColumnCopyright        Column = "copyright"
+	ColumnPrimaryPurpose   Column = "primaryPurpose"
+)
+
+// DefaultColumns are the columns of the inventory unless set WithColumns.
+var DefaultColumns = []Column{
+	ColumnName, ColumnVersion, ColumnPURL, ColumnSupplier,
+	ColumnDeclaredLicense, ColumnConcludedLicense, ColumnHashes,
+}
+
+// columnHeaders are the header cells of the columns.
+var columnHeaders = map[Column]string{
+	ColumnSpdxID:           "SPDX ID",
+	ColumnName:             "Name",
+	ColumnVersion:          "Version",
+	ColumnPURL:             "PURL",
+	ColumnSupplier:         "Supplier",
+	ColumnOriginator:       "Originator",
+	ColumnDeclaredLicense:  "Declared License",
+	ColumnConcludedLicense: "Concluded License",
+	ColumnHashes:           "Hashes",
+	ColumnDownloadLocation: "Download Location",
+	ColumnCopyright:        "Copyright",
+	ColumnPrimaryPurpose:   "Primary Purpose",
+}
+
+// Header returns the header cell of the column.
+func (c Column) Header() string {
+	if h, ok := columnHeaders[c]; ok {
+		return h
+	}
+	return string(c)
+}
+
+// PackageRow is a package of the inventory, with its references resolved
+// to names.
+type PackageRow struct {
+	SpdxID           string
+	Name             string
+	Version          string
+	PURL             string
+	Supplier         string
+	Originators      []string
+	DeclaredLicense  string
+	ConcludedLicense string
+	// Hashes are the hashes of the package as "algorithm:value".
+	Hashes           []string
+	DownloadLocation string
+	Copyright        string
+	PrimaryPurpose   string
+}
+
+// Inventory returns the rows of the packages of a document, including its
+// AI and dataset packages. Several licenses of a package are joined with
+// AND.
+func Inventory(doc *parse.Document) []PackageRow {
+	var rows []PackageRow
+	add := func(pkg *spdx.Package) {
+		row := PackageRow{
+			SpdxID:           pkg.SpdxID,
+			Name:             pkg.Name,
+			Version:          pkg.PackageVersion,
+			PURL:             pkg.PackageUrl,
+			DownloadLocation: pkg.DownloadLocation,
+			Copyright:        pkg.CopyrightText,
+			PrimaryPurpose:   string(pkg.PrimaryPurpose),
+		}
+		if row.PURL == "" {
+			row.PURL = pkg.GetPURL()
+		}
+		if pkg.SuppliedBy != nil {
+			row.Supplier = agentName(doc, pkg.SuppliedBy)
+		}
+		for i := range pkg.OriginatedBy {
+			row.Originators = append(row.Originators, agentName(doc, &pkg.OriginatedBy[i]))
+		}
+		licenses := doc.GetLicensesFor(pkg.SpdxID)
+		row.DeclaredLicense = licenseNames(licenses.DeclaredLicenses)
+		row.ConcludedLicense = licenseNames(licenses.ConcludedLicenses)
+		row.Hashes = hashValues(pkg.Hashes())
+		rows = append(rows, row)
+	}
+	for _, pkg := range doc.Packages {
+		add(pkg)
+	}
+	for _, pkg := range doc.AiPackages {
+		add(&pkg.Package)
+	}
+	for _, pkg := range doc.DatasetPackages {
+		add(&pkg.Package)
+	}
+	return rows
+}
+
+// Value returns the cell of the row in a column, or "" for an unknown
+// column. Lists are joined with spaces.
+func (r *PackageRow) Value(c Column) string {
+	switch c {
+	case ColumnSpdxID:
+		return r.SpdxID
+	case ColumnName:
+		return r.Name
+	case ColumnVersion:
+		return r.Version
+	case ColumnPURL:
+		return r.PURL
+	case ColumnSupplier:
+		return r.Supplier
+	case ColumnOriginator:
+		return strings.Join(r.Originators, " ")
+	case ColumnDeclaredLicense:
+		return r.DeclaredLicense
+	case ColumnConcludedLicense:
+		return r.ConcludedLicense
+	case ColumnHashes:
+		return strings.Join(r.Hashes, " ")
+	case ColumnDownloadLocation:
+		return r.DownloadLocation
+	case ColumnCopyright:
+		return r.Copyright
+	case ColumnPrimaryPurpose:
+		return r.PrimaryPurpose
+	}
+	return ""
+}
+
+// checkColumns returns an error for the first unknown column.
+func checkColumns(columns []Column) error {
+	for _, c := range columns {
+		if _, ok := columnHeaders[c]; !ok {
+			return fmt.Errorf("unknown column %q", c)
+		}
+	}
+	return nil
+}
+
+// agentName returns the name of an agent of the document, or its SPDX ID
+// if it has none.
+func agentName(doc *parse.Document, ref *spdx.Agent) string {
+	if agent := doc.GetAgentByID(ref.SpdxID); agent != nil && agent.Name != "" {
+		return agent.Name
+	}
+	if ref.Name != "" {
+		return ref.Name
+	}
+	return ref.SpdxID
+}
+
+// hashValues returns hashes as "algorithm:value".
+func hashValues(hashes []*spdx.Hash) []string {
+	var values []string
+	for _, h := range hashes {
+		values = append(values, fmt.Sprintf("%s:%s", h.Algorithm, h.HashValue))
+	}
+	return values
+}
+
+// licenseNames joins the names of licenses with AND.
+func licenseNames(licenses []*spdx.AnyLicenseInfo) string {
+	var names []string
+	for _, lic := range licenses {
+		name := lic.Name
+		if name == "" {
+			name = lic.SpdxID
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, " AND ")
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/interlynk-io/spdx-zen/internal/markdown"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// histogramWidth is the number of blocks of the bar of the most used
+// license in the license histogram of the Markdown report.
+const histogramWidth = 20
+
+// WriteMarkdown writes a compact Markdown summary of a document, to paste
+// into release notes and pull requests: its metadata, a table of the
+// top-level components, a histogram of the licenses by number of packages
+// and a table of the open vulnerabilities, most severe first.
+// Vulnerabilities are open as in WriteHTML.
+func WriteMarkdown(w io.Writer, doc *parse.Document) error {
+	s := summarize(doc)
+	var b strings.Builder
+	fmt.Fprintf(&b, "# SBOM summary: %s\n\n", reportTitle(s))
+	if s.ID != "" {
+		fmt.Fprintf(&b, "- Document: `%s`\n", s.ID)
+	}
+	if s.SpecVersion != "" {
+		fmt.Fprintf(&b, "- SPDX version: %s\n", s.SpecVersion)
+	}
+	if !s.Created.IsZero() {
+		fmt.Fprintf(&b, "- Created: %s\n", s.Created.UTC().Format("2006-01-02"))
+	}
+	if len(s.CreatedBy) > 0 {
+		fmt.Fprintf(&b, "- Created by: %s\n", strings.Join(s.CreatedBy, ", "))
+	}
+	fmt.Fprintf(&b, "- %d packages, %d files, %d relationships\n", len(s.Packages), s.Files, s.Relationships)
+
+	if len(s.Components) > 0 {
+		b.WriteString("\n## Components\n\n| Name | Version | PURL | License |\n| --- | --- | --- | --- |\n")
+		for _, c := range s.Components {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", markdown.Cell(cmp.Or(c.Name, c.SpdxID)), markdown.Cell(c.Version),
+				markdown.Cell(c.PURL), markdown.Cell(cmp.Or(c.ConcludedLicense, c.DeclaredLicense, NoLicense)))
+		}
+	}
+
+	if len(s.Licenses) > 0 {
+		b.WriteString("\n## Licenses\n\n| License | Packages | |\n| --- | ---: | --- |\n")
+		most := s.Licenses[0].Packages
+		for _, l := range s.Licenses {
+			bar := strings.Repeat("█", max(1, l.Packages*histogramWidth/most))
+			fmt.Fprintf(&b, "| %s | %d | %s |\n", markdown.Cell(l.License), l.Packages, bar)
+		}
+	}
+
+	open := s.openVulnerabilities()
+	b.WriteString("\n## Open vulnerabilities\n\n")
+	if len(open) == 0 {
+		fmt.Fprintf(&b, "None of %d vulnerabilities are open.\n", len(s.Vulnerabilities))
+	} else {
+		b.WriteString("| Vulnerability | Severity | Score | Packages |\n| --- | --- | ---: | --- |\n")
+		for _, v := range open {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", markdown.Cell(cmp.Or(v.Name, v.SpdxID)), v.severityName(), v.scoreText(),
+				markdown.Cell(strings.Join(v.Packages, ", ")))
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
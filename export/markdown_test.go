@@ -0,0 +1,99 @@
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/export"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestWriteMarkdown(t *testing.T) {
+	fixed, err := parse.NewReader().Read([]byte(`{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+		"@graph": [
+			{"type": "software_Package", "spdxId": "urn:spdx:a", "name": "a"},
+			{"type": "software_Package", "spdxId": "urn:spdx:b", "name": "b|c"},
+			{"type": "Relationship", "spdxId": "urn:spdx:dep", "from": "urn:spdx:a", "to": ["urn:spdx:b"], "relationshipType": "dependsOn"},
+			{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln", "name": "CVE-2024-0002"},
+			{"type": "security_VexFixedVulnAssessmentRelationship", "spdxId": "urn:spdx:vex", "from": "urn:spdx:vuln", "to": ["urn:spdx:b"],
+			 "relationshipType": "fixedIn", "security_publishedTime": "2024-06-01T00:00:00Z"}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		doc  *parse.Document
+		want string
+	}{
+		{
+			name: "open vulnerability",
+			doc:  readExportDoc(t),
+			want: `# SBOM summary: app-sbom
+
+- Document: ` + "`urn:spdx:doc`" + `
+- SPDX version: 3.0.1
+- Created: 2024-05-01
+- Created by: Acme, Inc.
+- 2 packages, 1 files, 5 relationships
+
+## Components
+
+| Name | Version | PURL | License |
+| --- | --- | --- | --- |
+| app | 1.0 | pkg:npm/app@1.0 | MIT AND Apache-2.0 |
+
+## Licenses
+
+| License | Packages | |
+| --- | ---: | --- |
+| Apache-2.0 | 1 | ████████████████████ |
+| MIT AND Apache-2.0 | 1 | ████████████████████ |
+
+## Open vulnerabilities
+
+| Vulnerability | Severity | Score | Packages |
+| --- | --- | ---: | --- |
+| CVE-2024-0001 | critical | 9.8 | lib |
+`,
+		},
+		{
+			name: "fixed vulnerability without roots",
+			doc:  fixed,
+			want: `# SBOM summary: untitled
+
+- 2 packages, 0 files, 1 relationships
+
+## Components
+
+| Name | Version | PURL | License |
+| --- | --- | --- | --- |
+| a |  |  | (none) |
+
+## Licenses
+
+| License | Packages | |
+| --- | ---: | --- |
+| (none) | 2 | ████████████████████ |
+
+## Open vulnerabilities
+
+None of 1 vulnerabilities are open.
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := export.WriteMarkdown(&buf, tt.doc); err != nil {
+				t.Fatalf("WriteMarkdown() error = %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("WriteMarkdown() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
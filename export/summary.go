@@ -0,0 +1,260 @@
+package export
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+// NoLicense is the license of the packages without a concluded or
+// declared license in the license breakdown of a report.
+const NoLicense = "(none)"
+
+// summary is what the reports show of a document.
+type summary struct {
+	ID          string
+	Name        string
+	SpecVersion string
+	Created     time.Time
+	CreatedBy   []string
+
+	Packages      []PackageRow
+	Files         int
+	Relationships int
+
+	// Components are the top-level packages: the root packages of the
+	// document, or else those no other package depends on.
+	Components []PackageRow
+
+	// Tree is the dependency tree from the components. Packages already
+	// shown elsewhere in the tree are not expanded again.
+	Tree []*treeNode
+
+	// Licenses counts the packages by license, most used first.
+	Licenses []licenseCount
+
+	Severity        []severityCount
+	Vulnerabilities []vulnerabilityRow
+}
+
+type licenseCount struct {
+	License  string
+	Packages int
+	Percent  float64
+}
+
+type severityCount struct {
+	Severity string
+	Count    int
+}
+
+type vulnerabilityRow struct {
+	SpdxID   string
+	Name     string
+	Severity spdx.CvssSeverityType
+	Score    float64
+	Scored   bool
+	// Packages are the names of the packages the vulnerability is
+	// associated with or assessed for.
+	Packages []string
+	// Open reports whether the vulnerability has no VEX assessment or a
+	// product still affected or under investigation.
+	Open bool
+}
+
+// severityName returns the severity of the vulnerability, or "unknown"
+// if it has no score.
+func (v vulnerabilityRow) severityName() string {
+	if v.Severity == "" {
+		return "unknown"
+	}
+	return string(v.Severity)
+}
+
+// scoreText returns the highest score of the vulnerability with one
+// decimal, or "" if it has none.
+func (v vulnerabilityRow) scoreText() string {
+	if !v.Scored {
+		return ""
+	}
+	return fmt.Sprintf("%.1f", v.Score)
+}
+
+type treeNode struct {
+	Package  PackageRow
+	Children []*treeNode
+	Repeated bool
+}
+
+// severities are the severities of the reports, in order.
+var severities = []spdx.CvssSeverityType{
+	spdx.CvssSeverityTypeCritical,
+	spdx.CvssSeverityTypeHigh,
+	spdx.CvssSeverityTypeMedium,
+	spdx.CvssSeverityTypeLow,
+	spdx.CvssSeverityTypeNone,
+	"",
+}
+
+func summarize(doc *parse.Document) *summary {
+	s := &summary{
+		ID:            doc.GetSpdxID(),
+		Name:          doc.GetName(),
+		Packages:      Inventory(doc),
+		Files:         len(doc.Files),
+		Relationships: len(doc.Relationships),
+	}
+	if ci := doc.CreationInfo; ci != nil {
+		s.SpecVersion, s.Created = ci.SpecVersion, ci.Created
+		for i := range ci.CreatedBy {
+			s.CreatedBy = append(s.CreatedBy, agentName(doc, &ci.CreatedBy[i]))
+		}
+	}
+
+	rows := make(map[string]PackageRow, len(s.Packages))
+	for _, row := range s.Packages {
+		rows[row.SpdxID] = row
+	}
+	s.summarizeComponents(doc, rows)
+	s.summarizeLicenses()
+	s.summarizeVulnerabilities(doc, rows)
+	return s
+}
+
+func (s *summary) summarizeComponents(doc *parse.Document, rows map[string]PackageRow) {
+	var roots []string
+	addRoots := func(pkgs []*spdx.Package) {
+		for _, pkg := range pkgs {
+			roots = append(roots, pkg.SpdxID)
+		}
+	}
+	for _, bom := range doc.Boms {
+		addRoots(doc.GetRootPackages(&bom.ElementCollection))
+	}
+	if doc.SpdxDocument != nil {
+		addRoots(doc.GetRootPackages(&doc.SpdxDocument.ElementCollection))
+	}
+	if len(roots) == 0 {
+		dependedOn := make(map[string]bool)
+		for _, row := range s.Packages {
+			for _, dep := range doc.GetDependenciesFor(row.SpdxID) {
+				if dep.SpdxID != row.SpdxID {
+					dependedOn[dep.SpdxID] = true
+				}
+			}
+		}
+		for _, row := range s.Packages {
+			if !dependedOn[row.SpdxID] {
+				roots = append(roots, row.SpdxID)
+			}
+		}
+	}
+
+	expanded := make(map[string]bool)
+	var grow func(id string) *treeNode
+	grow = func(id string) *treeNode {
+		node := &treeNode{Package: rows[id]}
+		if expanded[id] {
+			node.Repeated = true
+			return node
+		}
+		expanded[id] = true
+		for _, dep := range doc.GetDependenciesFor(id) {
+			if _, ok := rows[dep.SpdxID]; ok {
+				node.Children = append(node.Children, grow(dep.SpdxID))
+			}
+		}
+		return node
+	}
+	seen := make(map[string]bool)
+	for _, id := range roots {
+		row, ok := rows[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		s.Components = append(s.Components, row)
+		s.Tree = append(s.Tree, grow(id))
+	}
+}
+
+func (s *summary) summarizeLicenses() {
+	counts := make(map[string]int)
+	for _, row := range s.Packages {
+		license := cmp.Or(row.ConcludedLicense, row.DeclaredLicense, NoLicense)
+		counts[license]++
+	}
+	for license, n := range counts {
+		s.Licenses = append(s.Licenses, licenseCount{License: license, Packages: n, Percent: 100 * float64(n) / float64(len(s.Packages))})
+	}
+	slices.SortFunc(s.Licenses, func(a, b licenseCount) int {
+		return cmp.Or(cmp.Compare(b.Packages, a.Packages), cmp.Compare(a.License, b.License))
+	})
+}
+
+func (s *summary) summarizeVulnerabilities(doc *parse.Document, rows map[string]PackageRow) {
+	counts := make(map[spdx.CvssSeverityType]int)
+	for _, v := range doc.Vulnerabilities {
+		row := vulnerabilityRow{SpdxID: v.SpdxID, Name: v.Name, Severity: doc.GetVulnerabilitySeverity(v.SpdxID)}
+		row.Score, row.Scored = doc.GetVulnerabilityScore(v.SpdxID)
+		counts[row.Severity]++
+
+		var packages []string
+		addPackage := func(id string) {
+			if pkg, ok := rows[id]; ok && !slices.Contains(packages, id) {
+				packages = append(packages, id)
+				row.Packages = append(row.Packages, cmp.Or(pkg.Name, pkg.SpdxID))
+			}
+		}
+		for _, rel := range doc.GetRelationshipsTo(v.SpdxID) {
+			if rel.RelationshipType == spdx.RelationshipTypeHasAssociatedVulnerability {
+				addPackage(rel.From.GetSpdxID())
+			}
+		}
+		for _, rel := range doc.GetRelationshipsFrom(v.SpdxID) {
+			if rel.RelationshipType == spdx.RelationshipTypeAffects {
+				for _, to := range rel.To {
+					addPackage(to.GetSpdxID())
+				}
+			}
+		}
+
+		row.Open = true
+		if tl := security.VulnerabilityTimeline(doc, v.SpdxID); tl != nil && len(tl.Transitions) > 0 {
+			row.Open = false
+			for _, tr := range tl.Transitions {
+				addPackage(tr.Product)
+				if status := tl.Status(tr.Product); status == security.VEXStatusAffected || status == security.VEXStatusUnderInvestigation {
+					row.Open = true
+				}
+			}
+		}
+		s.Vulnerabilities = append(s.Vulnerabilities, row)
+	}
+	slices.SortStableFunc(s.Vulnerabilities, func(a, b vulnerabilityRow) int {
+		return cmp.Compare(slices.Index(severities, a.Severity), slices.Index(severities, b.Severity))
+	})
+	for _, sev := range severities {
+		name := string(sev)
+		if sev == "" {
+			name = "unknown"
+		}
+		s.Severity = append(s.Severity, severityCount{Severity: name, Count: counts[sev]})
+	}
+}
+
+// openVulnerabilities returns the open vulnerabilities of the summary.
+func (s *summary) openVulnerabilities() []vulnerabilityRow {
+	var open []vulnerabilityRow
+	for _, v := range s.Vulnerabilities {
+		if v.Open {
+			open = append(open, v)
+		}
+	}
+	return open
+}
@@ -0,0 +1,225 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// sheet is a worksheet of a workbook: a header row and rows of cells,
+// each a string or a number.
+type sheet struct {
+	name   string
+	header []string
+	rows   [][]any
+}
+
+// WriteXLSX writes a document as an Excel workbook, for reviews that
+// require Excel deliverables. It has a sheet each for the packages, files,
+// relationships, vulnerabilities and licenses, each under a frozen header
+// row. The package sheet has the columns DefaultColumns unless set
+// WithColumns, and the vulnerabilities and licenses are those of
+// WriteHTML.
+//
+// The workbook is written with inline strings and no shared string table,
+// which Excel, LibreOffice and the common XLSX libraries all read.
+func WriteXLSX(w io.Writer, doc *parse.Document, opts ...Option) error {
+	c := newConfig(opts)
+	if err := checkColumns(c.columns); err != nil {
+		return err
+	}
+	sheets := workbookSheets(doc, c.columns)
+
+	zw := zip.NewWriter(w)
+	write := func(name, content string) error {
+		f, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(f, content)
+		return err
+	}
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypesXML(len(sheets))},
+		{"_rels/.rels", rootRelsXML},
+		{"xl/workbook.xml", workbookXML(sheets)},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML(len(sheets))},
+		{"xl/styles.xml", stylesXML},
+	}
+	for i, s := range sheets {
+		parts = append(parts, struct{ name, content string }{fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), s.worksheetXML()})
+	}
+	for _, p := range parts {
+		if err := write(p.name, p.content); err != nil {
+			return fmt.Errorf("writing workbook: %w", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("writing workbook: %w", err)
+	}
+	return nil
+}
+
+func workbookSheets(doc *parse.Document, columns []Column) []*sheet {
+	s := summarize(doc)
+
+	packages := &sheet{name: "Packages"}
+	for _, col := range columns {
+		packages.header = append(packages.header, col.Header())
+	}
+	for _, row := range s.Packages {
+		cells := make([]any, len(columns))
+		for i, col := range columns {
+			cells[i] = row.Value(col)
+		}
+		packages.rows = append(packages.rows, cells)
+	}
+
+	files := &sheet{name: "Files", header: []string{"SPDX ID", "Name", "Content Type", "File Kind", "Concluded License", "Hashes", "Copyright"}}
+	for _, f := range doc.Files {
+		files.rows = append(files.rows, []any{f.SpdxID, f.Name, f.ContentType, string(f.FileKind),
+			licenseNames(doc.GetLicensesFor(f.SpdxID).ConcludedLicenses), strings.Join(hashValues(f.Hashes()), " "), f.CopyrightText})
+	}
+
+	relationships := &sheet{name: "Relationships", header: []string{"SPDX ID", "From", "Type", "To", "Completeness"}}
+	for _, rel := range doc.Relationships {
+		to := make([]string, len(rel.To))
+		for i := range rel.To {
+			to[i] = rel.To[i].GetSpdxID()
+		}
+		relationships.rows = append(relationships.rows, []any{rel.SpdxID, rel.From.GetSpdxID(), string(rel.RelationshipType),
+			strings.Join(to, " "), string(rel.Completeness)})
+	}
+
+	vulnerabilities := &sheet{name: "Vulnerabilities", header: []string{"SPDX ID", "Name", "Severity", "Score", "Packages", "Status"}}
+	for _, v := range s.Vulnerabilities {
+		var score any = ""
+		if v.Scored {
+			score = v.Score
+		}
+		status := "resolved"
+		if v.Open {
+			status = "open"
+		}
+		vulnerabilities.rows = append(vulnerabilities.rows, []any{v.SpdxID, v.Name, v.severityName(), score,
+			strings.Join(v.Packages, ", "), status})
+	}
+
+	licenses := &sheet{name: "Licenses", header: []string{"License", "Packages", "Share (%)"}}
+	for _, l := range s.Licenses {
+		licenses.rows = append(licenses.rows, []any{l.License, l.Packages, l.Percent})
+	}
+	return []*sheet{packages, files, relationships, vulnerabilities, licenses}
+}
+
+// worksheetXML returns the worksheet part of the sheet. The header row has the
+// bold style of stylesXML.
+func (s *sheet) worksheetXML() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	b.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	b.WriteString(`<sheetData>`)
+	header := make([]any, len(s.header))
+	for i, h := range s.header {
+		header[i] = h
+	}
+	writeRow(&b, 1, header, ` s="1"`)
+	for i, row := range s.rows {
+		writeRow(&b, i+2, row, "")
+	}
+	b.WriteString(`</sheetData></worksheet>`)
+	return b.String()
+}
+
+func writeRow(b *strings.Builder, r int, cells []any, style string) {
+	fmt.Fprintf(b, `<row r="%d">`, r)
+	for i, v := range cells {
+		ref := columnName(i) + strconv.Itoa(r)
+		switch v := v.(type) {
+		case int:
+			fmt.Fprintf(b, `<c r="%s"%s><v>%d</v></c>`, ref, style, v)
+		case float64:
+			fmt.Fprintf(b, `<c r="%s"%s><v>%s</v></c>`, ref, style, strconv.FormatFloat(v, 'f', -1, 64))
+		case string:
+			if v == "" {
+				continue
+			}
+			fmt.Fprintf(b, `<c r="%s" t="inlineStr"%s><is><t xml:space="preserve">`, ref, style)
+			xml.EscapeText(b, []byte(v))
+			b.WriteString(`</t></is></c>`)
+		}
+	}
+	b.WriteString(`</row>`)
+}
+
+// columnName returns the name of the column with the zero-based index i:
+// A to Z, then AA, AB and so on.
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+func contentTypesXML(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`)
+	b.WriteString(`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>`)
+	b.WriteString(`<Default Extension="xml" ContentType="application/xml"/>`)
+	b.WriteString(`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	b.WriteString(`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	b.WriteString(`</Types>`)
+	return b.String()
+}
+
+const rootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func workbookXML(sheets []*sheet) string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	for i, s := range sheets {
+		fmt.Fprintf(&b, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, s.name, i+1, i+1)
+	}
+	b.WriteString(`</sheets></workbook>`)
+	return b.String()
+}
+
+// workbookRelsXML returns the relationships of the workbook: rId1 to
+// rIdN for the sheets, then the styles.
+func workbookRelsXML(sheets int) string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i := 1; i <= sheets; i++ {
+		fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	fmt.Fprintf(&b, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheets+1)
+	b.WriteString(`</Relationships>`)
+	return b.String()
+}
+
+// stylesXML defines the cell formats of the workbook: 0 is the default and
+// 1 is bold, for header rows.
+const stylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="2"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/></cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
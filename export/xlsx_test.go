@@ -0,0 +1,108 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/export"
+)
+
+// readSheets returns the names of the sheets of a workbook and the cells of
+// each, by row, with empty cells left out.
+func readSheets(t *testing.T, data []byte) ([]string, map[string][][]string) {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(name string, v any) {
+		f, err := zr.Open(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		b, err := io.ReadAll(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := xml.Unmarshal(b, v); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		var part struct{}
+		parse(name, &part)
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	parse("xl/workbook.xml", &workbook)
+	var names []string
+	sheets := make(map[string][][]string)
+	for i, s := range workbook.Sheets {
+		var ws struct {
+			Rows []struct {
+				Cells []struct {
+					Value  string `xml:"v"`
+					Inline string `xml:"is>t"`
+				} `xml:"c"`
+			} `xml:"sheetData>row"`
+		}
+		parse("xl/worksheets/sheet"+string(rune('1'+i))+".xml", &ws)
+		var rows [][]string
+		for _, r := range ws.Rows {
+			var cells []string
+			for _, c := range r.Cells {
+				cells = append(cells, c.Value+c.Inline)
+			}
+			rows = append(rows, cells)
+		}
+		names = append(names, s.Name)
+		sheets[s.Name] = rows
+	}
+	return names, sheets
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := export.WriteXLSX(&buf, readExportDoc(t), export.WithColumns(export.ColumnName, export.ColumnHashes)); err != nil {
+		t.Fatalf("WriteXLSX() error = %v", err)
+	}
+	names, sheets := readSheets(t, buf.Bytes())
+	if want := []string{"Packages", "Files", "Relationships", "Vulnerabilities", "Licenses"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("sheets = %q, want %q", names, want)
+	}
+
+	tests := []struct {
+		sheet string
+		want  [][]string
+	}{
+		{"Packages", [][]string{{"Name", "Hashes"}, {"app", "sha256:ab12 sha1:cd34"}, {"lib"}}},
+		{"Files", [][]string{{"SPDX ID", "Name", "Content Type", "File Kind", "Concluded License", "Hashes", "Copyright"}, {"urn:spdx:main", "main.go"}}},
+		{"Vulnerabilities", [][]string{{"SPDX ID", "Name", "Severity", "Score", "Packages", "Status"},
+			{"urn:spdx:vuln", "CVE-2024-0001", "critical", "9.8", "lib", "open"}}},
+		{"Licenses", [][]string{{"License", "Packages", "Share (%)"}, {"Apache-2.0", "1", "50"}, {"MIT AND Apache-2.0", "1", "50"}}},
+	}
+	for _, tt := range tests {
+		if got := sheets[tt.sheet]; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("sheet %s = %q, want %q", tt.sheet, got, tt.want)
+		}
+	}
+	if got := len(sheets["Relationships"]); got != 6 {
+		t.Errorf("Relationships has %d rows, want 6", got)
+	}
+	if got := sheets["Relationships"][4]; !reflect.DeepEqual(got, []string{"urn:spdx:rel-dep", "urn:spdx:app", "dependsOn", "urn:spdx:lib"}) {
+		t.Errorf("Relationships row = %q", got)
+	}
+
+	if err := export.WriteXLSX(&buf, readExportDoc(t), export.WithColumns("color")); err == nil {
+		t.Error("WriteXLSX() with unknown column: want error")
+	}
+}
@@ -0,0 +1,540 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// executor validates and executes an operation of a request document.
+type executor struct {
+	ctx    context.Context
+	schema *schema
+	query  *queryDocument
+	op     *operation
+	vars   map[string]interface{}
+	r      *resolver
+	errors []Error
+}
+
+// selectOperation picks the operation of the request to execute.
+func (e *executor) selectOperation(name string) error {
+	if name == "" {
+		if len(e.query.operations) != 1 {
+			return fmt.Errorf("must provide operation name if query contains multiple operations")
+		}
+		e.op = e.query.operations[0]
+	} else {
+		for _, op := range e.query.operations {
+			if op.name == name {
+				e.op = op
+			}
+		}
+		if e.op == nil {
+			return fmt.Errorf("unknown operation named %q", name)
+		}
+	}
+	if e.op.kind != "query" {
+		return fmt.Errorf("%s operations are not supported", e.op.kind)
+	}
+	return nil
+}
+
+// addError records an error at the location of a selection and, for
+// execution errors, at a path of the response.
+func (e *executor) addError(err error, sel *selection, path []interface{}) {
+	e.errors = append(e.errors, Error{
+		Message:   err.Error(),
+		Locations: []Location{{Line: sel.line, Column: sel.col}},
+		Path:      path,
+	})
+}
+
+// validate checks the operation and the fragments it uses against the
+// schema, recording the errors it finds.
+func (e *executor) validate() {
+	defined := make(map[string]bool)
+	for _, v := range e.op.variables {
+		if defined[v.name] {
+			e.errors = append(e.errors, Error{Message: fmt.Sprintf("variable $%s is defined more than once", v.name), Locations: []Location{{Line: e.op.line, Column: e.op.col}}})
+		}
+		defined[v.name] = true
+		if t, ok := e.schema.types[v.typ.named()]; !ok || t.kind != scalarKind {
+			e.errors = append(e.errors, Error{Message: fmt.Sprintf("variable $%s has unknown input type %q", v.name, v.typ.named()), Locations: []Location{{Line: e.op.line, Column: e.op.col}}})
+		}
+	}
+	e.validateSelections("Query", e.op.selections, defined, map[string]bool{}, 1)
+}
+
+// validateSelections validates the selections of a selection set on a type,
+// nested depth fields deep.
+func (e *executor) validateSelections(typeName string, sels []*selection, defined, spreading map[string]bool, depth int) {
+	if depth > maxDepth && len(sels) > 0 {
+		e.addError(fmt.Errorf("query selects fields more than %d levels deep", maxDepth), sels[0], nil)
+		return
+	}
+	checkValue := func(sel *selection, v *value) {
+		for _, name := range variablesOf(v) {
+			if !defined[name] {
+				e.addError(fmt.Errorf("variable $%s is not defined", name), sel, nil)
+			}
+		}
+	}
+	for _, sel := range sels {
+		for _, d := range sel.directives {
+			if d.name != "skip" && d.name != "include" {
+				e.addError(fmt.Errorf("unknown directive @%s", d.name), sel, nil)
+				continue
+			}
+			if len(d.arguments) != 1 || d.arguments[0].name != "if" {
+				e.addError(fmt.Errorf("directive @%s takes exactly the argument if", d.name), sel, nil)
+				continue
+			}
+			checkValue(sel, d.arguments[0].value)
+		}
+
+		switch {
+		case sel.spread != "":
+			f, ok := e.query.fragments[sel.spread]
+			switch {
+			case !ok:
+				e.addError(fmt.Errorf("unknown fragment %q", sel.spread), sel, nil)
+			case spreading[sel.spread]:
+				e.addError(fmt.Errorf("cannot spread fragment %q within itself", sel.spread), sel, nil)
+			case e.compositeType(f.typeCondition) == nil:
+				e.addError(fmt.Errorf("unknown type %q", f.typeCondition), sel, nil)
+			default:
+				spreading[sel.spread] = true
+				e.validateSelections(f.typeCondition, f.selections, defined, spreading, depth)
+				delete(spreading, sel.spread)
+			}
+
+		case sel.inline:
+			cond := typeName
+			if sel.typeCondition != "" {
+				cond = sel.typeCondition
+			}
+			if e.compositeType(cond) == nil {
+				e.addError(fmt.Errorf("unknown type %q", cond), sel, nil)
+				continue
+			}
+			e.validateSelections(cond, sel.selections, defined, spreading, depth)
+
+		case sel.name == "__typename":
+			if len(sel.arguments) > 0 || len(sel.selections) > 0 {
+				e.addError(fmt.Errorf("field __typename takes no arguments or subfields"), sel, nil)
+			}
+
+		default:
+			f := e.schema.types[typeName].byName[sel.name]
+			if f == nil {
+				e.addError(fmt.Errorf("cannot query field %q on type %q", sel.name, typeName), sel, nil)
+				continue
+			}
+			given := make(map[string]bool)
+			for _, a := range sel.arguments {
+				if !slicesContainsArg(f.args, a.name) {
+					e.addError(fmt.Errorf("unknown argument %q on field %s.%s", a.name, typeName, f.name), sel, nil)
+				}
+				given[a.name] = true
+				checkValue(sel, a.value)
+			}
+			for _, a := range f.args {
+				if a.typ.nonNull && !given[a.name] {
+					e.addError(fmt.Errorf("field %s.%s argument %q of type %s is required", typeName, f.name, a.name, a.typ), sel, nil)
+				}
+			}
+			named := e.schema.types[f.typ.named()]
+			switch {
+			case named.kind == scalarKind && len(sel.selections) > 0:
+				e.addError(fmt.Errorf("field %q of type %s must not have a selection of subfields", sel.name, f.typ), sel, nil)
+			case named.kind != scalarKind && len(sel.selections) == 0:
+				e.addError(fmt.Errorf("field %q of type %s must have a selection of subfields", sel.name, f.typ), sel, nil)
+			case named.kind != scalarKind:
+				e.validateSelections(named.name, sel.selections, defined, spreading, depth+1)
+			}
+		}
+	}
+}
+
+// compositeType returns the object or interface type with the given name,
+// or nil.
+func (e *executor) compositeType(name string) *namedType {
+	if t := e.schema.types[name]; t != nil && t.kind != scalarKind {
+		return t
+	}
+	return nil
+}
+
+func slicesContainsArg(args []*argument, name string) bool {
+	for _, a := range args {
+		if a.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// variablesOf returns the names of the variables a value refers to.
+func variablesOf(v *value) []string {
+	switch v.kind {
+	case variableValue:
+		return []string{v.raw}
+	case listValue:
+		var names []string
+		for _, item := range v.list {
+			names = append(names, variablesOf(item)...)
+		}
+		return names
+	case objectValue:
+		var names []string
+		for _, f := range v.fields {
+			names = append(names, variablesOf(f.value)...)
+		}
+		return names
+	}
+	return nil
+}
+
+// coerceVariables coerces the provided variable values to the types the
+// operation declares, applying the defaults of those not provided.
+func (e *executor) coerceVariables(provided map[string]interface{}) error {
+	e.vars = make(map[string]interface{})
+	for _, def := range e.op.variables {
+		v, ok := provided[def.name]
+		if !ok && def.defaultValue != nil {
+			v, ok = e.valueOf(def.defaultValue), true
+		}
+		if !ok {
+			if def.typ.nonNull {
+				return fmt.Errorf("variable $%s of required type %s was not provided", def.name, def.typ)
+			}
+			continue
+		}
+		c, err := coerceInput(def.typ, v)
+		if err != nil {
+			return fmt.Errorf("variable $%s: %w", def.name, err)
+		}
+		e.vars[def.name] = c
+	}
+	return nil
+}
+
+// valueOf returns the Go value of a literal, with variables replaced by
+// their values.
+func (e *executor) valueOf(v *value) interface{} {
+	switch v.kind {
+	case variableValue:
+		return e.vars[v.raw]
+	case intValue:
+		if n, err := strconv.Atoi(v.raw); err == nil {
+			return n
+		}
+		f, _ := strconv.ParseFloat(v.raw, 64)
+		return f
+	case floatValue:
+		f, _ := strconv.ParseFloat(v.raw, 64)
+		return f
+	case stringValue, enumValue:
+		return v.raw
+	case booleanValue:
+		return v.raw == "true"
+	case listValue:
+		list := make([]interface{}, len(v.list))
+		for i, item := range v.list {
+			list[i] = e.valueOf(item)
+		}
+		return list
+	case objectValue:
+		m := make(map[string]interface{}, len(v.fields))
+		for _, f := range v.fields {
+			m[f.name] = e.valueOf(f.value)
+		}
+		return m
+	}
+	return nil
+}
+
+// coerceInput coerces an input value, from a literal or from the JSON
+// variables of a request, to a type of scalars or lists of scalars.
+func coerceInput(t *typeRef, v interface{}) (interface{}, error) {
+	if t.nonNull {
+		if v == nil {
+			return nil, fmt.Errorf("expected a value of type %s, found null", t)
+		}
+		return coerceInput(t.of, v)
+	}
+	if v == nil {
+		return nil, nil
+	}
+	if t.of != nil {
+		items, ok := v.([]interface{})
+		if !ok {
+			items = []interface{}{v}
+		}
+		list := make([]interface{}, len(items))
+		for i, item := range items {
+			c, err := coerceInput(t.of, item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = c
+		}
+		return list, nil
+	}
+
+	switch t.name {
+	case "String":
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case "ID":
+		switch n := v.(type) {
+		case string:
+			return n, nil
+		case int:
+			return strconv.Itoa(n), nil
+		}
+	case "Int":
+		switch n := v.(type) {
+		case int:
+			if n >= math.MinInt32 && n <= math.MaxInt32 {
+				return n, nil
+			}
+		case float64:
+			if n == math.Trunc(n) && n >= math.MinInt32 && n <= math.MaxInt32 {
+				return int(n), nil
+			}
+		}
+	case "Float":
+		switch n := v.(type) {
+		case int:
+			return float64(n), nil
+		case float64:
+			return n, nil
+		}
+	case "Boolean":
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("expected a value of type %s, found %v", t, v)
+}
+
+// coerceArguments returns the values of the arguments of a field.
+func (e *executor) coerceArguments(f *field, sel *selection) (map[string]interface{}, error) {
+	args := make(map[string]interface{})
+	for _, def := range f.args {
+		var v interface{}
+		ok := false
+		for _, a := range sel.arguments {
+			if a.name != def.name {
+				continue
+			}
+			if a.value.kind == variableValue {
+				v, ok = e.vars[a.value.raw]
+			} else {
+				v, ok = e.valueOf(a.value), true
+			}
+		}
+		if !ok {
+			if def.typ.nonNull {
+				return nil, fmt.Errorf("argument %q of type %s was not provided", def.name, def.typ)
+			}
+			continue
+		}
+		c, err := coerceInput(def.typ, v)
+		if err != nil {
+			return nil, fmt.Errorf("argument %q: %w", def.name, err)
+		}
+		args[def.name] = c
+	}
+	return args, nil
+}
+
+// included reports whether the @skip and @include directives of a
+// selection keep it.
+func (e *executor) included(dirs []*directive) bool {
+	for _, d := range dirs {
+		cond, _ := e.valueOf(d.arguments[0].value).(bool)
+		if d.name == "skip" && cond || d.name == "include" && !cond {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldGroup is the fields of a selection set with the same response key.
+type fieldGroup struct {
+	key    string
+	fields []*selection
+}
+
+// collectFields groups the fields of a selection set that apply to an
+// object type by their response keys, in order, expanding fragments.
+func (e *executor) collectFields(objType string, sels []*selection, groups []*fieldGroup, visited map[string]bool) []*fieldGroup {
+	for _, sel := range sels {
+		if !e.included(sel.directives) {
+			continue
+		}
+		switch {
+		case sel.spread != "":
+			f := e.query.fragments[sel.spread]
+			if visited[sel.spread] || !e.included(f.directives) || !e.schema.implements(objType, f.typeCondition) {
+				continue
+			}
+			visited[sel.spread] = true
+			groups = e.collectFields(objType, f.selections, groups, visited)
+		case sel.inline:
+			if sel.typeCondition == "" || e.schema.implements(objType, sel.typeCondition) {
+				groups = e.collectFields(objType, sel.selections, groups, visited)
+			}
+		default:
+			key := sel.responseKey()
+			found := false
+			for _, g := range groups {
+				if g.key == key {
+					g.fields = append(g.fields, sel)
+					found = true
+				}
+			}
+			if !found {
+				groups = append(groups, &fieldGroup{key: key, fields: []*selection{sel}})
+			}
+		}
+	}
+	return groups
+}
+
+// executeSelections executes a selection set on a value of an object
+// type. It returns false if a non-null field of the selection set is null,
+// which makes the object null.
+func (e *executor) executeSelections(objType string, source interface{}, sels []*selection, path []interface{}) (*object, bool) {
+	obj := &object{}
+	for _, g := range e.collectFields(objType, sels, nil, make(map[string]bool)) {
+		v, ok := e.executeField(objType, source, g.fields, appendPath(path, g.key))
+		if !ok {
+			return nil, false
+		}
+		obj.keys = append(obj.keys, g.key)
+		obj.values = append(obj.values, v)
+	}
+	return obj, true
+}
+
+// executeField resolves a field and completes its value. It returns false
+// if the field is non-null and its value null.
+func (e *executor) executeField(objType string, source interface{}, fields []*selection, path []interface{}) (interface{}, bool) {
+	sel := fields[0]
+	if sel.name == "__typename" {
+		return objType, true
+	}
+	f := e.schema.types[objType].byName[sel.name]
+	args, err := e.coerceArguments(f, sel)
+	if err == nil {
+		err = e.ctx.Err()
+	}
+	var v interface{}
+	if err == nil {
+		v, err = f.resolve(e.r, source, args)
+	}
+	if err != nil {
+		e.addError(err, sel, path)
+		return nil, !f.typ.nonNull
+	}
+	return e.completeValue(f.typ, v, fields, path)
+}
+
+// completeValue converts a resolved value to the response value of a type.
+// It returns false if the type is non-null and the value null.
+func (e *executor) completeValue(t *typeRef, v interface{}, fields []*selection, path []interface{}) (interface{}, bool) {
+	if t.nonNull {
+		c, ok := e.completeValue(t.of, v, fields, path)
+		if ok && c == nil {
+			e.addError(fmt.Errorf("cannot return null for non-null field"), fields[0], path)
+		}
+		return c, ok && c != nil
+	}
+	if v == nil {
+		return nil, true
+	}
+	rv := reflect.ValueOf(v)
+	if t.of != nil {
+		if rv.Kind() != reflect.Slice {
+			e.addError(fmt.Errorf("expected a list, resolved %T", v), fields[0], path)
+			return nil, true
+		}
+		items := make([]interface{}, rv.Len())
+		for i := range items {
+			c, ok := e.completeValue(t.of, rv.Index(i).Interface(), fields, appendPath(path, i))
+			if !ok {
+				return nil, true
+			}
+			items[i] = c
+		}
+		return items, true
+	}
+	if (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) && rv.IsNil() {
+		return nil, true
+	}
+
+	named := e.schema.types[t.name]
+	if named.kind == scalarKind {
+		switch t.name {
+		case "String", "ID":
+			if s, ok := v.(string); ok {
+				return s, true
+			}
+			return fmt.Sprint(v), true
+		}
+		return v, true
+	}
+	objType := t.name
+	if named.kind == interfaceKind {
+		objType, v = typeOf(v)
+	}
+	var sels []*selection
+	for _, f := range fields {
+		sels = append(sels, f.selections...)
+	}
+	obj, ok := e.executeSelections(objType, v, sels, path)
+	if !ok {
+		return nil, true
+	}
+	return obj, true
+}
+
+// appendPath returns a copy of a response path with an element added.
+func appendPath(path []interface{}, elem interface{}) []interface{} {
+	return append(path[:len(path):len(path)], elem)
+}
+
+// object is a response object, which keeps the order of its fields.
+type object struct {
+	keys   []string
+	values []interface{}
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,199 @@
+// Package graphql provides a GraphQL API over a parsed SPDX 3.0 document,
+// so that clients can select the fields and follow the relationships they
+// need in one request instead of walking the JSON-LD graph themselves.
+//
+//	resp := graphql.Execute(ctx, doc, graphql.Request{Query: `{
+//	    packages(purl: "pkg:golang/golang.org/x/net") {
+//	        name version
+//	        dependents { name }
+//	        vulnerabilities { status vulnerability { identifiers severity } }
+//	    }
+//	}`})
+//
+// Schema returns the schema in the GraphQL schema definition language. The
+// root Query type has fields for the document, its packages, files,
+// relationships, vulnerabilities and licenses, and for any element by its
+// SPDX ID; every element type implements the Element interface, whose
+// relationships and incomingRelationships fields follow the graph in both
+// directions.
+//
+// Queries may use variables, aliases, fragments and the @skip and @include
+// directives, and may nest selections up to 64 levels deep. Mutations,
+// subscriptions and introspection are not supported.
+//
+// NewHandler serves the API over HTTP, as the server package does at
+// /documents/{id}/graphql.
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Request is a GraphQL request.
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Response is the response to a Request. Data is absent if the request
+// failed before execution, because the query was invalid.
+type Response struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors []Error         `json:"errors,omitempty"`
+}
+
+// Error is an error of a request, with the locations in the query and the
+// path of the response field it occurred at.
+type Error struct {
+	Message   string        `json:"message"`
+	Locations []Location    `json:"locations,omitempty"`
+	Path      []interface{} `json:"path,omitempty"`
+}
+
+func (e Error) Error() string {
+	return e.Message
+}
+
+// Location is a position in the query, counted from 1.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// Schema returns the schema of the API in the GraphQL schema definition
+// language.
+func Schema() string {
+	return documentSchema.sdl()
+}
+
+// Execute parses, validates and executes a request against a document.
+// Errors resolving a field make it null, and its parent null if it is
+// non-null, and are reported in the Errors of the response alongside the
+// data of the rest of the query.
+func Execute(ctx context.Context, doc *parse.Document, req Request) *Response {
+	query, err := parseQuery(req.Query)
+	if err != nil {
+		var se *syntaxError
+		if errors.As(err, &se) {
+			return &Response{Errors: []Error{{Message: se.msg, Locations: []Location{{Line: se.line, Column: se.col}}}}}
+		}
+		return &Response{Errors: []Error{{Message: err.Error()}}}
+	}
+
+	e := &executor{ctx: ctx, schema: documentSchema, query: query, r: &resolver{doc: doc}}
+	if err := e.selectOperation(req.OperationName); err != nil {
+		return &Response{Errors: []Error{{Message: err.Error()}}}
+	}
+	if e.validate(); len(e.errors) > 0 {
+		return &Response{Errors: e.errors}
+	}
+	if err := e.coerceVariables(req.Variables); err != nil {
+		return &Response{Errors: []Error{{Message: err.Error(), Locations: []Location{{Line: e.op.line, Column: e.op.col}}}}}
+	}
+
+	resp := &Response{}
+	obj, ok := e.executeSelections("Query", nil, e.op.selections, nil)
+	data := []byte("null")
+	if ok {
+		if data, err = json.Marshal(obj); err != nil {
+			e.errors = append(e.errors, Error{Message: err.Error()})
+			data = []byte("null")
+		}
+	}
+	resp.Data = data
+	resp.Errors = e.errors
+	return resp
+}
+
+// DefaultMaxRequestSize is the largest POST request body a handler
+// accepts, in bytes, unless it is given WithMaxRequestSize.
+const DefaultMaxRequestSize = 1 << 20
+
+// HandlerOption configures a handler returned by NewHandler.
+type HandlerOption interface {
+	apply(*handler)
+}
+
+type handlerOptionFunc func(*handler)
+
+func (f handlerOptionFunc) apply(h *handler) { f(h) }
+
+// WithMaxRequestSize sets the largest POST request body the handler
+// accepts, in bytes. Larger requests are refused with status 413.
+func WithMaxRequestSize(n int64) HandlerOption {
+	return handlerOptionFunc(func(h *handler) {
+		if n > 0 {
+			h.maxSize = n
+		}
+	})
+}
+
+// NewHandler returns an HTTP handler serving the API over a document. It
+// takes GET requests with query, operationName and variables parameters
+// and POST requests with a JSON Request body.
+func NewHandler(doc *parse.Document, opts ...HandlerOption) http.Handler {
+	h := &handler{doc: doc, maxSize: DefaultMaxRequestSize}
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+	return h
+}
+
+type handler struct {
+	doc     *parse.Document
+	maxSize int64
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				writeResponse(w, http.StatusBadRequest, &Response{Errors: []Error{{Message: "invalid variables: " + err.Error()}}})
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, h.maxSize)).Decode(&req); err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				writeResponse(w, http.StatusRequestEntityTooLarge, &Response{Errors: []Error{{Message: fmt.Sprintf("request larger than %d bytes", tooLarge.Limit)}}})
+				return
+			}
+			writeResponse(w, http.StatusBadRequest, &Response{Errors: []Error{{Message: "invalid request: " + err.Error()}}})
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeResponse(w, http.StatusMethodNotAllowed, &Response{Errors: []Error{{Message: "method not allowed"}}})
+		return
+	}
+	if req.Query == "" {
+		writeResponse(w, http.StatusBadRequest, &Response{Errors: []Error{{Message: "missing query"}}})
+		return
+	}
+
+	resp := Execute(r.Context(), h.doc, req)
+	status := http.StatusOK
+	if resp.Data == nil {
+		status = http.StatusBadRequest
+	}
+	writeResponse(w, status, resp)
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp *Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,298 @@
+package graphql_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/graphql"
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+)
+
+// compact returns JSON without insignificant whitespace.
+func compact(s string) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, []byte(s)); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func TestExecute(t *testing.T) {
+	doc := testsbom.Document(t, "1.0.0")
+
+	tests := []struct {
+		name      string
+		req       graphql.Request
+		want      string
+		wantError string
+	}{
+		{
+			name: "document",
+			req:  graphql.Request{Query: `{ document { name created primaryComponent { name } } }`},
+			want: `{"document":{"name":"app","created":"2024-05-01T00:00:00Z","primaryComponent":{"name":"app"}}}`,
+		},
+		{
+			name: "packages by purl with aliases",
+			req:  graphql.Request{Query: `{ packages(purl: "pkg:npm/lib") { name v: version deps: dependencies { name } dependents { name } } }`},
+			want: `{"packages":[{"name":"lib","v":"2.1.0","deps":[{"name":"leaf"}],"dependents":[{"name":"app"}]}]}`,
+		},
+		{
+			name: "first",
+			req:  graphql.Request{Query: `{ packages(first: 2) { name } }`},
+			want: `{"packages":[{"name":"app"},{"name":"lib"}]}`,
+		},
+		{
+			name: "vulnerabilities",
+			req:  graphql.Request{Query: `{ vulnerabilities(id: "cve-2024-1234") { identifiers affects { status package { name } } } }`},
+			want: `{"vulnerabilities":[{"identifiers":["CVE-2024-1234"],"affects":[{"status":"affects","package":{"name":"leaf"}}]}]}`,
+		},
+		{
+			name: "package vulnerabilities",
+			req:  graphql.Request{Query: `{ packages(name: "leaf") { vulnerabilities { vulnerability { name } } } }`},
+			want: `{"packages":[{"vulnerabilities":[{"vulnerability":{"name":"CVE-2024-1234"}}]}]}`,
+		},
+		{
+			name: "relationships and interface fragments",
+			req: graphql.Request{Query: `
+				query Deps($type: String = "dependsOn") {
+					relationships(type: $type) {
+						from { __typename ...pkg }
+						to { ... on Package { version } }
+					}
+				}
+				fragment pkg on Package { name }`},
+			want: `{"relationships":[{"from":{"__typename":"Package","name":"app"},"to":[{"version":"2.1.0"}]},{"from":{"__typename":"Package","name":"lib"},"to":[{"version":"0.3.0"}]}]}`,
+		},
+		{
+			name: "variables and directives",
+			req: graphql.Request{
+				Query:     `query($name: String!, $deep: Boolean!) { packages(name: $name) { name incomingRelationships @include(if: $deep) { relationshipType } } }`,
+				Variables: map[string]interface{}{"name": "leaf", "deep": true},
+			},
+			want: `{"packages":[{"name":"leaf","incomingRelationships":[{"relationshipType":"dependsOn"},{"relationshipType":"affects"}]}]}`,
+		},
+		{
+			name: "missing element",
+			req:  graphql.Request{Query: `{ element(spdxId: "urn:missing") { spdxId } }`},
+			want: `{"element":null}`,
+		},
+		{
+			name:      "unknown field",
+			req:       graphql.Request{Query: `{ packages { nme } }`},
+			wantError: `cannot query field "nme" on type "Package"`,
+		},
+		{
+			name:      "missing subfields",
+			req:       graphql.Request{Query: `{ packages }`},
+			wantError: "must have a selection of subfields",
+		},
+		{
+			name:      "required argument",
+			req:       graphql.Request{Query: `{ package { name } }`},
+			wantError: `argument "spdxId" of type ID! is required`,
+		},
+		{
+			name:      "undefined variable",
+			req:       graphql.Request{Query: `{ packages(name: $name) { name } }`},
+			wantError: "variable $name is not defined",
+		},
+		{
+			name:      "missing variable",
+			req:       graphql.Request{Query: `query($name: String!) { packages(name: $name) { name } }`},
+			wantError: "variable $name of required type String! was not provided",
+		},
+		{
+			name:      "fragment cycle",
+			req:       graphql.Request{Query: `{ document { ...a } } fragment a on Document { ...a }`},
+			wantError: `cannot spread fragment "a" within itself`,
+		},
+		{
+			name:      "syntax error",
+			req:       graphql.Request{Query: `{ packages { name }`},
+			wantError: "expected",
+		},
+		{
+			name:      "mutation",
+			req:       graphql.Request{Query: `mutation { packages { name } }`},
+			wantError: "mutation operations are not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := graphql.Execute(context.Background(), doc, tt.req)
+			if tt.wantError != "" {
+				if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0].Message, tt.wantError) {
+					t.Fatalf("Errors = %v, want an error containing %q", resp.Errors, tt.wantError)
+				}
+				if resp.Data != nil {
+					t.Errorf("Data = %s, want none", resp.Data)
+				}
+				return
+			}
+			if len(resp.Errors) > 0 {
+				t.Fatalf("Errors = %v", resp.Errors)
+			}
+			if got := string(resp.Data); got != compact(tt.want) {
+				t.Errorf("Data = %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecute_FieldErrors(t *testing.T) {
+	doc := testsbom.Document(t, "1.0.0")
+	// packages is non-null, so its error makes the whole data null.
+	resp := graphql.Execute(context.Background(), doc, graphql.Request{Query: `{ document { name } a: packages(first: -1) { name } }`})
+	if got := string(resp.Data); got != "null" {
+		t.Errorf("Data = %s, want null", got)
+	}
+	if len(resp.Errors) != 1 {
+		t.Fatalf("Errors = %v, want one", resp.Errors)
+	}
+	got := resp.Errors[0]
+	if !reflect.DeepEqual(got.Path, []interface{}{"a"}) || len(got.Locations) != 1 || got.Locations[0] != (graphql.Location{Line: 1, Column: 21}) {
+		t.Errorf("Error = %+v, want it at path [a], 1:21", got)
+	}
+}
+
+func TestExecute_Depth(t *testing.T) {
+	doc := testsbom.Document(t, "1.0.0")
+	// A query of fragments each selecting one level deeper than the last.
+	var chain strings.Builder
+	chain.WriteString(`{ packages { ...f0 } }`)
+	for i := 0; i < 70; i++ {
+		fmt.Fprintf(&chain, ` fragment f%d on Package { dependencies { ...f%d } }`, i, i+1)
+	}
+	chain.WriteString(` fragment f70 on Package { name }`)
+
+	tests := []struct {
+		name      string
+		query     string
+		wantError string
+	}{
+		{
+			name:      "nested selection sets",
+			query:     strings.Repeat("{a", 1<<20) + strings.Repeat("}", 1<<20),
+			wantError: "nested more than 64 levels deep",
+		},
+		{
+			name:      "nested values",
+			query:     `{ packages(name: ` + strings.Repeat("[", 1<<20) + `) { name } }`,
+			wantError: "nested more than 64 levels deep",
+		},
+		{
+			name:      "nested list types",
+			query:     `query($n: ` + strings.Repeat("[", 1<<20) + `) { document { name } }`,
+			wantError: "nested more than 64 levels deep",
+		},
+		{
+			name:      "fragment chain",
+			query:     chain.String(),
+			wantError: "more than 64 levels deep",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := graphql.Execute(context.Background(), doc, graphql.Request{Query: tt.query})
+			if len(resp.Errors) == 0 || !strings.Contains(resp.Errors[0].Message, tt.wantError) {
+				t.Errorf("Errors = %v, want an error containing %q", resp.Errors, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestExecute_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp := graphql.Execute(ctx, testsbom.Document(t, "1.0.0"), graphql.Request{Query: `{ document { name } }`})
+	if string(resp.Data) != "null" || len(resp.Errors) == 0 {
+		t.Errorf("Execute = %s %v, want null data and an error", resp.Data, resp.Errors)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	sdl := graphql.Schema()
+	for _, want := range []string{
+		"type Query {",
+		"interface Element {",
+		"type Package implements Element {",
+		"  dependencies: [Package!]!\n",
+		"package(spdxId: ID!): Package\n",
+	} {
+		if !strings.Contains(sdl, want) {
+			t.Errorf("Schema() does not contain %q", want)
+		}
+	}
+}
+
+func TestNewHandler(t *testing.T) {
+	doc := testsbom.Document(t, "1.0.0")
+	query := `{ package: packages(name: "app") { name } }`
+
+	tests := []struct {
+		name       string
+		req        *http.Request
+		wantStatus int
+		wantData   string
+	}{
+		{
+			name:       "get",
+			req:        httptest.NewRequest(http.MethodGet, "/?query="+url.QueryEscape(query), nil),
+			wantStatus: http.StatusOK,
+			wantData:   `{"package":[{"name":"app"}]}`,
+		},
+		{
+			name:       "post",
+			req:        httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"query":"query($n: String) { packages(name: $n) { version } }","variables":{"n":"lib"}}`)),
+			wantStatus: http.StatusOK,
+			wantData:   `{"packages":[{"version":"2.1.0"}]}`,
+		},
+		{
+			name:       "invalid query",
+			req:        httptest.NewRequest(http.MethodGet, "/?query="+url.QueryEscape("{ nope }"), nil),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing query",
+			req:        httptest.NewRequest(http.MethodGet, "/", nil),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "method",
+			req:        httptest.NewRequest(http.MethodPut, "/", nil),
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "too large",
+			req:        httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"query":"`+strings.Repeat(" ", 1<<10)+`{ document { name } }"}`)),
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := graphql.NewHandler(doc, graphql.WithMaxRequestSize(512))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tt.req)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			var resp graphql.Response
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantData != "" && string(resp.Data) != tt.wantData {
+				t.Errorf("data = %s, want %s", resp.Data, tt.wantData)
+			}
+		})
+	}
+}
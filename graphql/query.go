@@ -0,0 +1,698 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// queryDocument is a parsed GraphQL request document.
+type queryDocument struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+// operation is an operation definition of a request document.
+type operation struct {
+	kind       string // query, mutation or subscription
+	name       string
+	variables  []*variableDefinition
+	selections []*selection
+	line, col  int
+}
+
+// variableDefinition declares a variable of an operation.
+type variableDefinition struct {
+	name         string
+	typ          *typeRef
+	defaultValue *value
+}
+
+// fragment is a named fragment definition.
+type fragment struct {
+	name          string
+	typeCondition string
+	directives    []*directive
+	selections    []*selection
+	line, col     int
+}
+
+// selection is a field, a fragment spread or an inline fragment of a
+// selection set.
+type selection struct {
+	// Fields have a name, and an alias if it is not their response key.
+	alias, name string
+	arguments   []*argumentValue
+
+	// Fragment spreads name their fragment; inline fragments have an
+	// optional type condition.
+	spread        string
+	inline        bool
+	typeCondition string
+
+	directives []*directive
+	selections []*selection
+	line, col  int
+}
+
+// responseKey returns the key of a field in the response.
+func (s *selection) responseKey() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.name
+}
+
+// directive is a directive of a selection, such as @skip(if: true).
+type directive struct {
+	name      string
+	arguments []*argumentValue
+	line, col int
+}
+
+// argumentValue is an argument of a field or directive.
+type argumentValue struct {
+	name  string
+	value *value
+}
+
+// valueKind is the kind of a literal value.
+type valueKind int
+
+const (
+	variableValue valueKind = iota
+	intValue
+	floatValue
+	stringValue
+	booleanValue
+	nullValue
+	enumValue
+	listValue
+	objectValue
+)
+
+// value is a value literal or variable reference.
+type value struct {
+	kind   valueKind
+	raw    string // the name of variables and enum values, or the literal
+	list   []*value
+	fields []*argumentValue
+}
+
+// tokenKind is the kind of a lexical token.
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	punctuatorToken
+	nameToken
+	intToken
+	floatToken
+	stringToken
+)
+
+type token struct {
+	kind      tokenKind
+	value     string
+	line, col int
+}
+
+// syntaxError is an error of a request document at a location.
+type syntaxError struct {
+	msg       string
+	line, col int
+}
+
+func (e *syntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %d:%d: %s", e.line, e.col, e.msg)
+}
+
+// lex splits a request document into tokens, ending with an EOF token.
+func lex(src string) ([]token, error) {
+	var tokens []token
+	line, lineStart := 1, 0
+	for i := 0; i < len(src); {
+		c := src[i]
+		col := i - lineStart + 1
+		switch {
+		case c == '\n':
+			line, lineStart = line+1, i+1
+			i++
+		case c == ' ' || c == '\t' || c == '\r' || c == ',':
+			i++
+		case strings.HasPrefix(src[i:], "\uFEFF"):
+			i += len("\uFEFF")
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, token{punctuatorToken, "...", line, col})
+			i += 3
+		case strings.IndexByte("!$&()[]{}:=@|", c) >= 0:
+			tokens = append(tokens, token{punctuatorToken, string(c), line, col})
+			i++
+		case c == '_' || isLetter(c):
+			j := i + 1
+			for j < len(src) && (src[j] == '_' || isLetter(src[j]) || isDigit(src[j])) {
+				j++
+			}
+			tokens = append(tokens, token{nameToken, src[i:j], line, col})
+			i = j
+		case c == '-' || isDigit(c):
+			j, kind, err := lexNumber(src, i)
+			if err != nil {
+				return nil, &syntaxError{err.Error(), line, col}
+			}
+			tokens = append(tokens, token{kind, src[i:j], line, col})
+			i = j
+		case c == '"':
+			if strings.HasPrefix(src[i:], `"""`) {
+				return nil, &syntaxError{"block strings are not supported", line, col}
+			}
+			s, j, err := lexString(src, i)
+			if err != nil {
+				return nil, &syntaxError{err.Error(), line, col}
+			}
+			tokens = append(tokens, token{stringToken, s, line, col})
+			i = j
+		default:
+			r, _ := utf8.DecodeRuneInString(src[i:])
+			return nil, &syntaxError{fmt.Sprintf("unexpected character %q", r), line, col}
+		}
+	}
+	return append(tokens, token{eofToken, "", line, len(src) - lineStart + 1}), nil
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+// lexNumber returns the end of the number starting at src[i] and whether
+// it is an integer or a float.
+func lexNumber(src string, i int) (int, tokenKind, error) {
+	j := i
+	if src[j] == '-' {
+		j++
+	}
+	start := j
+	for j < len(src) && isDigit(src[j]) {
+		j++
+	}
+	if j == start {
+		return 0, 0, fmt.Errorf("invalid number %q", src[i:j])
+	}
+	if j-start > 1 && src[start] == '0' {
+		return 0, 0, fmt.Errorf("invalid number %q: leading zero", src[i:j])
+	}
+	kind := intToken
+	if j < len(src) && src[j] == '.' {
+		kind = floatToken
+		j++
+		frac := j
+		for j < len(src) && isDigit(src[j]) {
+			j++
+		}
+		if j == frac {
+			return 0, 0, fmt.Errorf("invalid number %q", src[i:j])
+		}
+	}
+	if j < len(src) && (src[j] == 'e' || src[j] == 'E') {
+		kind = floatToken
+		j++
+		if j < len(src) && (src[j] == '+' || src[j] == '-') {
+			j++
+		}
+		exp := j
+		for j < len(src) && isDigit(src[j]) {
+			j++
+		}
+		if j == exp {
+			return 0, 0, fmt.Errorf("invalid number %q", src[i:j])
+		}
+	}
+	if j < len(src) && (src[j] == '_' || src[j] == '.' || isLetter(src[j])) {
+		return 0, 0, fmt.Errorf("invalid number %q", src[i:j+1])
+	}
+	return j, kind, nil
+}
+
+// lexString returns the value of the string starting at src[i] and its
+// end.
+func lexString(src string, i int) (string, int, error) {
+	var b strings.Builder
+	for j := i + 1; j < len(src); {
+		c := src[j]
+		switch {
+		case c == '"':
+			return b.String(), j + 1, nil
+		case c == '\n' || c == '\r':
+			return "", 0, fmt.Errorf("unterminated string")
+		case c == '\\':
+			if j+1 >= len(src) {
+				return "", 0, fmt.Errorf("unterminated string")
+			}
+			switch e := src[j+1]; e {
+			case '"', '\\', '/':
+				b.WriteByte(e)
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u':
+				if j+6 > len(src) {
+					return "", 0, fmt.Errorf("invalid unicode escape")
+				}
+				r, err := strconv.ParseUint(src[j+2:j+6], 16, 32)
+				if err != nil {
+					return "", 0, fmt.Errorf("invalid unicode escape %q", src[j:j+6])
+				}
+				b.WriteRune(rune(r))
+				j += 4
+			default:
+				return "", 0, fmt.Errorf("invalid escape %q", src[j:j+2])
+			}
+			j += 2
+		default:
+			b.WriteByte(c)
+			j++
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// maxDepth is the deepest nesting of selection sets, list types and
+// input values that a request document may have, and of fields that a
+// query may select through its fragments.
+const maxDepth = 64
+
+// parser parses the tokens of a request document.
+type parser struct {
+	tokens []token
+	pos    int
+	// depth is the nesting of the selection set, type or value parsed.
+	depth int
+}
+
+// parseQuery parses a request document.
+func parseQuery(src string) (*queryDocument, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	doc := &queryDocument{fragments: make(map[string]*fragment)}
+	for p.peek().kind != eofToken {
+		t := p.peek()
+		switch {
+		case p.peekPunct("{"):
+			sels, err := p.selectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, &operation{kind: "query", selections: sels, line: t.line, col: t.col})
+		case t.kind == nameToken && (t.value == "query" || t.value == "mutation" || t.value == "subscription"):
+			op, err := p.operation()
+			if err != nil {
+				return nil, err
+			}
+			doc.operations = append(doc.operations, op)
+		case t.kind == nameToken && t.value == "fragment":
+			f, err := p.fragment()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := doc.fragments[f.name]; ok {
+				return nil, &syntaxError{fmt.Sprintf("fragment %q is defined more than once", f.name), f.line, f.col}
+			}
+			doc.fragments[f.name] = f
+		default:
+			return nil, p.unexpected()
+		}
+	}
+	if len(doc.operations) == 0 {
+		return nil, &syntaxError{"document has no operation", 1, 1}
+	}
+	return doc, nil
+}
+
+func (p *parser) peek() token { return p.tokens[p.pos] }
+
+// enter enters a nested selection set, type or value, which leave must
+// end. It fails if the document is nested more than maxDepth levels.
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxDepth {
+		t := p.peek()
+		return &syntaxError{fmt.Sprintf("document is nested more than %d levels deep", maxDepth), t.line, t.col}
+	}
+	return nil
+}
+
+func (p *parser) leave() { p.depth-- }
+
+func (p *parser) advance() token {
+	t := p.tokens[p.pos]
+	if t.kind != eofToken {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) peekPunct(s string) bool {
+	t := p.peek()
+	return t.kind == punctuatorToken && t.value == s
+}
+
+// skipPunct consumes the punctuator s if it is next.
+func (p *parser) skipPunct(s string) bool {
+	if p.peekPunct(s) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expectPunct(s string) error {
+	if !p.skipPunct(s) {
+		return p.unexpected()
+	}
+	return nil
+}
+
+func (p *parser) name() (string, error) {
+	if p.peek().kind != nameToken {
+		return "", p.unexpected()
+	}
+	return p.advance().value, nil
+}
+
+func (p *parser) unexpected() error {
+	t := p.peek()
+	if t.kind == eofToken {
+		return &syntaxError{"unexpected end of document", t.line, t.col}
+	}
+	return &syntaxError{fmt.Sprintf("unexpected %q", t.value), t.line, t.col}
+}
+
+func (p *parser) operation() (*operation, error) {
+	t := p.advance()
+	op := &operation{kind: t.value, line: t.line, col: t.col}
+	if p.peek().kind == nameToken {
+		op.name = p.advance().value
+	}
+	if p.skipPunct("(") {
+		for !p.skipPunct(")") {
+			v, err := p.variableDefinition()
+			if err != nil {
+				return nil, err
+			}
+			op.variables = append(op.variables, v)
+		}
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+	sels, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = sels
+	return op, nil
+}
+
+func (p *parser) variableDefinition() (*variableDefinition, error) {
+	if err := p.expectPunct("$"); err != nil {
+		return nil, err
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectPunct(":"); err != nil {
+		return nil, err
+	}
+	typ, err := p.typeRef()
+	if err != nil {
+		return nil, err
+	}
+	v := &variableDefinition{name: name, typ: typ}
+	if p.skipPunct("=") {
+		if v.defaultValue, err = p.value(true); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := p.directives(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (p *parser) typeRef() (*typeRef, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+	var t *typeRef
+	if p.skipPunct("[") {
+		of, err := p.typeRef()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		t = &typeRef{of: of}
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		t = &typeRef{name: name}
+	}
+	if p.skipPunct("!") {
+		t = &typeRef{nonNull: true, of: t}
+	}
+	return t, nil
+}
+
+func (p *parser) fragment() (*fragment, error) {
+	t := p.advance()
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if name == "on" {
+		return nil, &syntaxError{`fragment cannot be named "on"`, t.line, t.col}
+	}
+	if on, err := p.name(); err != nil || on != "on" {
+		return nil, &syntaxError{"expected type condition", t.line, t.col}
+	}
+	cond, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	f := &fragment{name: name, typeCondition: cond, line: t.line, col: t.col}
+	if f.directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if f.selections, err = p.selectionSet(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (p *parser) selectionSet() ([]*selection, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var sels []*selection
+	for !p.skipPunct("}") {
+		sel, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+	}
+	if len(sels) == 0 {
+		t := p.tokens[p.pos-1]
+		return nil, &syntaxError{"empty selection set", t.line, t.col}
+	}
+	return sels, nil
+}
+
+func (p *parser) selection() (*selection, error) {
+	t := p.peek()
+	sel := &selection{line: t.line, col: t.col}
+	var err error
+	if p.skipPunct("...") {
+		switch next := p.peek(); {
+		case next.kind == nameToken && next.value == "on":
+			p.advance()
+			sel.inline = true
+			if sel.typeCondition, err = p.name(); err != nil {
+				return nil, err
+			}
+		case next.kind == nameToken:
+			sel.spread = p.advance().value
+			sel.directives, err = p.directives()
+			return sel, err
+		default:
+			sel.inline = true
+		}
+		if sel.directives, err = p.directives(); err != nil {
+			return nil, err
+		}
+		sel.selections, err = p.selectionSet()
+		return sel, err
+	}
+
+	if sel.name, err = p.name(); err != nil {
+		return nil, err
+	}
+	if p.skipPunct(":") {
+		sel.alias = sel.name
+		if sel.name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peekPunct("(") {
+		if sel.arguments, err = p.arguments(false); err != nil {
+			return nil, err
+		}
+	}
+	if sel.directives, err = p.directives(); err != nil {
+		return nil, err
+	}
+	if p.peekPunct("{") {
+		if sel.selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+func (p *parser) arguments(constant bool) ([]*argumentValue, error) {
+	if err := p.expectPunct("("); err != nil {
+		return nil, err
+	}
+	var args []*argumentValue
+	for !p.skipPunct(")") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		v, err := p.value(constant)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &argumentValue{name: name, value: v})
+	}
+	if len(args) == 0 {
+		return nil, p.unexpected()
+	}
+	return args, nil
+}
+
+func (p *parser) directives() ([]*directive, error) {
+	var dirs []*directive
+	for p.peekPunct("@") {
+		t := p.advance()
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		d := &directive{name: name, line: t.line, col: t.col}
+		if p.peekPunct("(") {
+			if d.arguments, err = p.arguments(false); err != nil {
+				return nil, err
+			}
+		}
+		dirs = append(dirs, d)
+	}
+	return dirs, nil
+}
+
+// value parses a value; constant values, such as variable defaults, may
+// not refer to variables.
+func (p *parser) value(constant bool) (*value, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+	t := p.peek()
+	switch t.kind {
+	case intToken:
+		p.advance()
+		return &value{kind: intValue, raw: t.value}, nil
+	case floatToken:
+		p.advance()
+		return &value{kind: floatValue, raw: t.value}, nil
+	case stringToken:
+		p.advance()
+		return &value{kind: stringValue, raw: t.value}, nil
+	case nameToken:
+		p.advance()
+		switch t.value {
+		case "true", "false":
+			return &value{kind: booleanValue, raw: t.value}, nil
+		case "null":
+			return &value{kind: nullValue}, nil
+		}
+		return &value{kind: enumValue, raw: t.value}, nil
+	}
+
+	switch {
+	case p.skipPunct("$"):
+		if constant {
+			return nil, &syntaxError{"unexpected variable in constant value", t.line, t.col}
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		return &value{kind: variableValue, raw: name}, nil
+	case p.skipPunct("["):
+		v := &value{kind: listValue}
+		for !p.skipPunct("]") {
+			item, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			v.list = append(v.list, item)
+		}
+		return v, nil
+	case p.skipPunct("{"):
+		v := &value{kind: objectValue}
+		for !p.skipPunct("}") {
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct(":"); err != nil {
+				return nil, err
+			}
+			fv, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			v.fields = append(v.fields, &argumentValue{name: name, value: fv})
+		}
+		return v, nil
+	}
+	return nil, p.unexpected()
+}
@@ -0,0 +1,572 @@
+package graphql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/purl"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// resolver resolves the fields of a query against a document.
+type resolver struct {
+	doc  *parse.Document
+	byID map[string]spdx.ElementInterface
+}
+
+// element returns the typed element of the document with the given SPDX
+// ID, or nil.
+func (r *resolver) element(id string) spdx.ElementInterface {
+	if r.byID == nil {
+		r.byID = make(map[string]spdx.ElementInterface)
+		for elem := range r.doc.AllElements() {
+			if _, ok := r.byID[elem.GetSpdxID()]; !ok {
+				r.byID[elem.GetSpdxID()] = elem
+			}
+		}
+	}
+	if elem, ok := r.byID[id]; ok {
+		return elem
+	}
+	if lic := r.doc.GetAnyLicenseInfoByID(id); lic != nil {
+		return lic
+	}
+	return nil
+}
+
+// reference returns the element a reference refers to, or the reference
+// itself if the document does not hold the element.
+func (r *resolver) reference(ref *spdx.Element) spdx.ElementInterface {
+	if elem := r.element(ref.SpdxID); elem != nil {
+		return elem
+	}
+	return ref
+}
+
+// license returns the most specific element of a license the document
+// holds, such as its LicenseExpression.
+func (r *resolver) license(lic *spdx.AnyLicenseInfo) spdx.ElementInterface {
+	if elem, ok := r.element(lic.SpdxID).(spdx.AnyElement); ok {
+		if _, ok := spdx.AsAnyLicenseInfo(elem); ok {
+			return elem
+		}
+	}
+	return lic
+}
+
+// creationInfo returns the creation information of the SpdxDocument
+// element, else of the document, or nil.
+func (r *resolver) creationInfo() *spdx.CreationInfo {
+	if r.doc.SpdxDocument != nil {
+		return &r.doc.SpdxDocument.CreationInfo
+	}
+	return r.doc.CreationInfo
+}
+
+// packages returns every package of the document, including AI and
+// dataset packages.
+func (r *resolver) packages() []*spdx.Package {
+	pkgs := append([]*spdx.Package(nil), r.doc.Packages...)
+	for _, p := range r.doc.AiPackages {
+		pkgs = append(pkgs, &p.Package)
+	}
+	for _, p := range r.doc.DatasetPackages {
+		pkgs = append(pkgs, &p.Package)
+	}
+	return pkgs
+}
+
+// typeOf returns the name of the object type of an element and the value
+// the fields of that type resolve against.
+func typeOf(v interface{}) (string, interface{}) {
+	elem, ok := v.(spdx.AnyElement)
+	if !ok {
+		return "OtherElement", v
+	}
+	if rel, ok := spdx.AsRelationship(elem); ok {
+		return "Relationship", rel
+	}
+	if vuln, ok := spdx.AsVulnerability(elem); ok {
+		return "Vulnerability", vuln
+	}
+	if pkg, ok := spdx.AsPackage(elem); ok {
+		return "Package", pkg
+	}
+	if f, ok := spdx.AsFile(elem); ok {
+		return "File", f
+	}
+	if _, ok := spdx.AsAnyLicenseInfo(elem); ok {
+		return "License", elem
+	}
+	return "OtherElement", v
+}
+
+// optional returns s, or nil for the empty string, which GraphQL returns
+// as null.
+func optional[S ~string](s S) interface{} {
+	if s == "" {
+		return nil
+	}
+	return string(s)
+}
+
+// timestamp returns t in RFC 3339 format, or nil for the zero time.
+func timestamp(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t.Format(time.RFC3339)
+}
+
+// first returns the first elements of a list, as many as the first
+// argument asks for.
+func first[T any](list []T, args map[string]interface{}) ([]T, error) {
+	n, ok := args["first"].(int)
+	if !ok {
+		return list, nil
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("argument first must not be negative")
+	}
+	if n < len(list) {
+		list = list[:n]
+	}
+	return list, nil
+}
+
+func newField(name, typ, description string, resolve resolveFunc, args ...*argument) *field {
+	return &field{name: name, typ: mustType(typ), description: description, resolve: resolve, args: args}
+}
+
+func newArgument(name, typ, description string) *argument {
+	return &argument{name: name, typ: mustType(typ), description: description}
+}
+
+var firstArgument = newArgument("first", "Int", "The maximum number of results.")
+
+// relationshipsOf returns relationships, keeping those of the type
+// argument if it is given.
+func relationshipsOf(rels []*spdx.Relationship, args map[string]interface{}) []*spdx.Relationship {
+	typ, ok := args["type"].(string)
+	if !ok {
+		return rels
+	}
+	want := spdx.NormalizeRelationshipType(typ)
+	var result []*spdx.Relationship
+	for _, rel := range rels {
+		if rel.RelationshipType == want {
+			result = append(result, rel)
+		}
+	}
+	return result
+}
+
+// elementFields returns the fields of the Element interface, which every
+// element type has.
+func elementFields() []*field {
+	str := func(get func(spdx.ElementInterface) string) resolveFunc {
+		return func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(get(source.(spdx.ElementInterface))), nil
+		}
+	}
+	typeArgument := newArgument("type", "String", "Keep only relationships of this type, such as dependsOn.")
+	return []*field{
+		newField("spdxId", "ID!", "The SPDX ID of the element.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return source.(spdx.ElementInterface).GetSpdxID(), nil
+		}),
+		newField("type", "String!", "The SPDX type of the element, such as software_Package.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			if info, ok := spdx.TypeOf(source); ok {
+				return info.Name, nil
+			}
+			return "Element", nil
+		}),
+		newField("name", "String", "", str(spdx.ElementInterface.GetName)),
+		newField("summary", "String", "", str(spdx.ElementInterface.GetSummary)),
+		newField("description", "String", "", str(spdx.ElementInterface.GetDescription)),
+		newField("comment", "String", "", str(spdx.ElementInterface.GetComment)),
+		newField("externalIdentifiers", "[ExternalIdentifier!]!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return source.(spdx.ElementInterface).GetExternalIdentifier(), nil
+		}),
+		newField("relationships", "[Relationship!]!", "The relationships from the element.", func(r *resolver, source interface{}, args map[string]interface{}) (interface{}, error) {
+			return relationshipsOf(r.doc.GetRelationshipsFrom(source.(spdx.ElementInterface).GetSpdxID()), args), nil
+		}, typeArgument),
+		newField("incomingRelationships", "[Relationship!]!", "The relationships to the element.", func(r *resolver, source interface{}, args map[string]interface{}) (interface{}, error) {
+			return relationshipsOf(r.doc.GetRelationshipsTo(source.(spdx.ElementInterface).GetSpdxID()), args), nil
+		}, typeArgument),
+	}
+}
+
+// objectType returns an object type implementing Element, with the fields
+// of Element followed by its own.
+func objectType(name, description string, fields ...*field) *namedType {
+	return &namedType{
+		name:        name,
+		kind:        objectKind,
+		description: description,
+		interfaces:  []string{"Element"},
+		fields:      append(elementFields(), fields...),
+	}
+}
+
+// licenseFields returns the fields resolving to the concluded and declared
+// licenses of an element.
+func licenseFields() []*field {
+	licenses := func(concluded bool) resolveFunc {
+		return func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			info := r.doc.GetLicensesFor(source.(spdx.ElementInterface).GetSpdxID())
+			lics := info.DeclaredLicenses
+			if concluded {
+				lics = info.ConcludedLicenses
+			}
+			result := make([]spdx.ElementInterface, len(lics))
+			for i, lic := range lics {
+				result[i] = r.license(lic)
+			}
+			return result, nil
+		}
+	}
+	return []*field{
+		newField("concludedLicenses", "[License!]!", "", licenses(true)),
+		newField("declaredLicenses", "[License!]!", "", licenses(false)),
+	}
+}
+
+func hashesField() *field {
+	return newField("hashes", "[Hash!]!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+		return spdx.AsElement(source.(spdx.AnyElement)).Hashes(), nil
+	})
+}
+
+// documentSchema is the schema of the GraphQL API.
+var documentSchema = newSchema(
+	&namedType{
+		name:        "Query",
+		kind:        objectKind,
+		description: "The root of every query, over one SPDX document.",
+		fields: []*field{
+			newField("document", "Document!", "The document itself.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				return r.doc, nil
+			}),
+			newField("packages", "[Package!]!", "The packages of the document, including AI and dataset packages.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				name, byName := args["name"].(string)
+				query, byPURL := args["purl"].(string)
+				var result []*spdx.Package
+				for _, pkg := range r.packages() {
+					if byName && pkg.Name != name || byPURL && !purl.Match(query, pkg.PURL()) {
+						continue
+					}
+					result = append(result, pkg)
+				}
+				return first(result, args)
+			},
+				newArgument("name", "String", "Keep only packages with this name."),
+				newArgument("purl", "String", "Keep only packages whose package URL matches this one, ignoring the parts it leaves out."),
+				firstArgument),
+			newField("package", "Package", "The package with the given SPDX ID.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				id := args["spdxId"].(string)
+				for _, pkg := range r.packages() {
+					if pkg.SpdxID == id {
+						return pkg, nil
+					}
+				}
+				return nil, nil
+			}, newArgument("spdxId", "ID!", "")),
+			newField("files", "[File!]!", "The files of the document.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				name, byName := args["name"].(string)
+				var result []*spdx.File
+				for _, f := range r.doc.Files {
+					if !byName || f.Name == name {
+						result = append(result, f)
+					}
+				}
+				return first(result, args)
+			}, newArgument("name", "String", "Keep only files with this name."), firstArgument),
+			newField("relationships", "[Relationship!]!", "The relationships of the document.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				from, byFrom := args["from"].(string)
+				to, byTo := args["to"].(string)
+				var result []*spdx.Relationship
+				for _, rel := range relationshipsOf(r.doc.Relationships, args) {
+					if byFrom && rel.From.SpdxID != from || byTo && !relatesTo(rel, to) {
+						continue
+					}
+					result = append(result, rel)
+				}
+				return first(result, args)
+			},
+				newArgument("type", "String", "Keep only relationships of this type, such as dependsOn."),
+				newArgument("from", "ID", "Keep only relationships from the element with this SPDX ID."),
+				newArgument("to", "ID", "Keep only relationships to the element with this SPDX ID."),
+				firstArgument),
+			newField("vulnerabilities", "[Vulnerability!]!", "The vulnerabilities of the document.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				vulns := r.doc.Vulnerabilities
+				if id, ok := args["id"].(string); ok {
+					vulns = r.doc.GetVulnerabilitiesByIdentifier(id)
+				}
+				return first(vulns, args)
+			}, newArgument("id", "String", "Keep only vulnerabilities known by this identifier, such as CVE-2024-1234."), firstArgument),
+			newField("licenses", "[License!]!", "The licenses of the document.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				var result []spdx.ElementInterface
+				for elem := range r.doc.AllElements() {
+					if e, ok := elem.(spdx.AnyElement); ok {
+						if _, ok := spdx.AsAnyLicenseInfo(e); ok {
+							result = append(result, elem)
+						}
+					}
+				}
+				return first(result, args)
+			}, firstArgument),
+			newField("element", "Element", "The element with the given SPDX ID.", func(r *resolver, _ interface{}, args map[string]interface{}) (interface{}, error) {
+				return r.element(args["spdxId"].(string)), nil
+			}, newArgument("spdxId", "ID!", "")),
+		},
+	},
+	&namedType{
+		name:        "Element",
+		kind:        interfaceKind,
+		description: "An SPDX element.",
+		fields:      elementFields(),
+	},
+	&namedType{
+		name:        "Document",
+		kind:        objectKind,
+		description: "An SPDX document.",
+		fields: []*field{
+			newField("spdxId", "ID", "The SPDX ID of the SpdxDocument element.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				return optional(r.doc.GetSpdxID()), nil
+			}),
+			newField("name", "String", "", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				return optional(r.doc.GetName()), nil
+			}),
+			newField("specVersion", "String", "", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				if ci := r.creationInfo(); ci != nil {
+					return optional(ci.SpecVersion), nil
+				}
+				return nil, nil
+			}),
+			newField("created", "String", "When the document was created, in RFC 3339 format.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				if ci := r.creationInfo(); ci != nil {
+					return timestamp(ci.Created), nil
+				}
+				return nil, nil
+			}),
+			newField("createdBy", "[Element!]!", "The agents that created the document.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				return r.doc.GetCreators(r.creationInfo()), nil
+			}),
+			newField("profiles", "[String!]!", "The profiles the document conforms to.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				return r.doc.GetProfiles(), nil
+			}),
+			newField("dataLicense", "License", "", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				if lic := r.doc.GetDataLicense(); lic != nil {
+					return r.license(lic), nil
+				}
+				return nil, nil
+			}),
+			newField("primaryComponent", "Package", "The package the document is an SBOM of.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				return r.doc.GetPrimaryComponent(), nil
+			}),
+			newField("rootElements", "[Element!]!", "The root elements of the SpdxDocument element.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				if r.doc.SpdxDocument == nil {
+					return nil, nil
+				}
+				return r.doc.GetRootElements(&r.doc.SpdxDocument.ElementCollection), nil
+			}),
+			newField("elementCount", "Int!", "The number of elements of the document.", func(r *resolver, _ interface{}, _ map[string]interface{}) (interface{}, error) {
+				n := 0
+				for range r.doc.AllElements() {
+					n++
+				}
+				return n, nil
+			}),
+		},
+	},
+	objectType("Package", "A software package.", append([]*field{
+		newField("version", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Package).PackageVersion), nil
+		}),
+		newField("purl", "String", "The package URL of the package.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Package).PURL()), nil
+		}),
+		newField("downloadLocation", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Package).DownloadLocation), nil
+		}),
+		newField("homePage", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Package).HomePage), nil
+		}),
+		newField("primaryPurpose", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Package).PrimaryPurpose), nil
+		}),
+		newField("copyrightText", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Package).CopyrightText), nil
+		}),
+		newField("supplier", "Element", "The agent that supplied the package.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			if by := source.(*spdx.Package).SuppliedBy; by != nil {
+				return r.reference(&by.Element), nil
+			}
+			return nil, nil
+		}),
+		hashesField(),
+	}, append(licenseFields(),
+		newField("dependencies", "[Package!]!", "The packages the package depends on.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return r.doc.GetDependenciesFor(source.(*spdx.Package).SpdxID), nil
+		}),
+		newField("dependents", "[Package!]!", "The packages that depend on the package.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return r.doc.GetDependentsOf(source.(*spdx.Package).SpdxID), nil
+		}),
+		newField("files", "[File!]!", "The files the package contains.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return r.doc.GetContainedFilesFor(source.(*spdx.Package).SpdxID), nil
+		}),
+		newField("vulnerabilities", "[AffectedPackage!]!", "The vulnerabilities that affect, do not affect or are fixed in the package.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			pkg := source.(*spdx.Package)
+			var result []*parse.AffectedPackage
+			for _, v := range r.doc.Vulnerabilities {
+				for _, ap := range r.doc.GetAffectedPackagesByVulnID(v.SpdxID) {
+					if ap.Vulnerability == v && ap.Package.SpdxID == pkg.SpdxID {
+						result = append(result, ap)
+					}
+				}
+			}
+			return result, nil
+		}),
+	)...)...),
+	objectType("File", "A file.", append([]*field{
+		newField("contentType", "String", "The media type of the file.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.File).ContentType), nil
+		}),
+		newField("primaryPurpose", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.File).PrimaryPurpose), nil
+		}),
+		newField("copyrightText", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.File).CopyrightText), nil
+		}),
+		hashesField(),
+	}, append(licenseFields(),
+		newField("containedBy", "[Element!]!", "The elements that contain the file, such as its package.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return r.doc.GetContainersOf(source.(*spdx.File).SpdxID), nil
+		}),
+	)...)...),
+	objectType("Relationship", "A relationship from one element to others.",
+		newField("relationshipType", "String!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return string(source.(*spdx.Relationship).RelationshipType), nil
+		}),
+		newField("from", "Element!", "", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return r.reference(&source.(*spdx.Relationship).From), nil
+		}),
+		newField("to", "[Element!]!", "", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			rel := source.(*spdx.Relationship)
+			result := make([]spdx.ElementInterface, len(rel.To))
+			for i := range rel.To {
+				result[i] = r.reference(&rel.To[i])
+			}
+			return result, nil
+		}),
+		newField("completeness", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(source.(*spdx.Relationship).Completeness), nil
+		}),
+		newField("startTime", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return timestamp(source.(*spdx.Relationship).StartTime), nil
+		}),
+		newField("endTime", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return timestamp(source.(*spdx.Relationship).EndTime), nil
+		}),
+	),
+	objectType("Vulnerability", "A vulnerability.",
+		newField("identifiers", "[String!]!", "The external identifiers of the vulnerability, such as its CVE ID.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			var ids []string
+			for _, ei := range source.(*spdx.Vulnerability).ExternalIdentifier {
+				ids = append(ids, ei.Identifier)
+			}
+			return ids, nil
+		}),
+		newField("severity", "String", "The highest CVSS severity of the vulnerability.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return optional(r.doc.GetVulnerabilitySeverity(source.(*spdx.Vulnerability).SpdxID)), nil
+		}),
+		newField("score", "Float", "The highest CVSS score of the vulnerability.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			if score, ok := r.doc.GetVulnerabilityScore(source.(*spdx.Vulnerability).SpdxID); ok {
+				return score, nil
+			}
+			return nil, nil
+		}),
+		newField("publishedTime", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return timestamp(source.(*spdx.Vulnerability).PublishedTime), nil
+		}),
+		newField("modifiedTime", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return timestamp(source.(*spdx.Vulnerability).ModifiedTime), nil
+		}),
+		newField("withdrawnTime", "String", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			return timestamp(source.(*spdx.Vulnerability).WithdrawnTime), nil
+		}),
+		newField("affects", "[AffectedPackage!]!", "The packages the vulnerability affects, does not affect or is fixed in.", func(r *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			v := source.(*spdx.Vulnerability)
+			var result []*parse.AffectedPackage
+			for _, ap := range r.doc.GetAffectedPackagesByVulnID(v.SpdxID) {
+				if ap.Vulnerability == v {
+					result = append(result, ap)
+				}
+			}
+			return result, nil
+		}),
+	),
+	&namedType{
+		name:        "AffectedPackage",
+		kind:        objectKind,
+		description: "A package a vulnerability affects, does not affect or is fixed in.",
+		fields: []*field{
+			newField("package", "Package!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return source.(*parse.AffectedPackage).Package, nil
+			}),
+			newField("vulnerability", "Vulnerability!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return source.(*parse.AffectedPackage).Vulnerability, nil
+			}),
+			newField("status", "String!", "The type of the relationship: affects, doesNotAffect or fixedIn.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return string(source.(*parse.AffectedPackage).RelationshipType), nil
+			}),
+		},
+	},
+	objectType("License", "A license, license expression or set of licenses.",
+		newField("expression", "String", "The license expression, for license expressions.", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+			if expr, ok := source.(*spdx.LicenseExpression); ok {
+				return optional(expr.LicenseExpression), nil
+			}
+			return nil, nil
+		}),
+	),
+	objectType("OtherElement", "An element of a type the schema has no object type for."),
+	&namedType{
+		name:        "Hash",
+		kind:        objectKind,
+		description: "A hash of the content of an element.",
+		fields: []*field{
+			newField("algorithm", "String!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return string(source.(*spdx.Hash).Algorithm), nil
+			}),
+			newField("value", "String!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return source.(*spdx.Hash).HashValue, nil
+			}),
+		},
+	},
+	&namedType{
+		name:        "ExternalIdentifier",
+		kind:        objectKind,
+		description: "An identifier of an element defined outside SPDX, such as a package URL or CVE ID.",
+		fields: []*field{
+			newField("type", "String!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return string(source.(spdx.ExternalIdentifier).ExternalIdentifierType), nil
+			}),
+			newField("identifier", "String!", "", func(_ *resolver, source interface{}, _ map[string]interface{}) (interface{}, error) {
+				return source.(spdx.ExternalIdentifier).Identifier, nil
+			}),
+		},
+	},
+)
+
+// relatesTo reports whether a relationship is to the element with the
+// given SPDX ID.
+func relatesTo(rel *spdx.Relationship, id string) bool {
+	for _, to := range rel.To {
+		if to.SpdxID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// typeRef is a reference to a type: a named type, a list of a type, or a
+// non-null type.
+type typeRef struct {
+	name    string
+	of      *typeRef
+	nonNull bool
+}
+
+// mustType parses a type reference of the schema, such as "[Package!]!".
+func mustType(s string) *typeRef {
+	p := &parser{}
+	tokens, err := lex(s)
+	if err == nil {
+		p.tokens = tokens
+		var t *typeRef
+		if t, err = p.typeRef(); err == nil && p.peek().kind == eofToken {
+			return t
+		}
+	}
+	panic(fmt.Sprintf("graphql: invalid type %q", s))
+}
+
+// named returns the named type t refers to, through lists and non-null
+// types.
+func (t *typeRef) named() string {
+	for t.name == "" {
+		t = t.of
+	}
+	return t.name
+}
+
+func (t *typeRef) String() string {
+	switch {
+	case t.nonNull:
+		return t.of.String() + "!"
+	case t.of != nil:
+		return "[" + t.of.String() + "]"
+	}
+	return t.name
+}
+
+// typeKind is the kind of a named type.
+type typeKind int
+
+const (
+	scalarKind typeKind = iota
+	objectKind
+	interfaceKind
+)
+
+// namedType is a scalar, object or interface type of the schema.
+type namedType struct {
+	name        string
+	kind        typeKind
+	description string
+	interfaces  []string
+	fields      []*field
+	byName      map[string]*field
+}
+
+// field is a field of an object or interface type.
+type field struct {
+	name        string
+	description string
+	typ         *typeRef
+	args        []*argument
+	resolve     resolveFunc
+}
+
+// argument is an argument of a field.
+type argument struct {
+	name        string
+	description string
+	typ         *typeRef
+}
+
+// resolveFunc returns the value of a field of the source value, given the
+// coerced arguments of the field.
+type resolveFunc func(r *resolver, source interface{}, args map[string]interface{}) (interface{}, error)
+
+// schema is the set of types queries are validated and executed against.
+type schema struct {
+	types map[string]*namedType
+	order []string
+}
+
+// builtinScalars are the scalar types of GraphQL.
+var builtinScalars = []string{"Boolean", "Float", "ID", "Int", "String"}
+
+func newSchema(types ...*namedType) *schema {
+	s := &schema{types: make(map[string]*namedType)}
+	for _, name := range builtinScalars {
+		s.types[name] = &namedType{name: name, kind: scalarKind}
+	}
+	for _, t := range types {
+		t.byName = make(map[string]*field, len(t.fields))
+		for _, f := range t.fields {
+			t.byName[f.name] = f
+		}
+		s.types[t.name] = t
+		s.order = append(s.order, t.name)
+	}
+	return s
+}
+
+// implements reports whether the object type named obj is, or implements,
+// the type named abstract.
+func (s *schema) implements(obj, abstract string) bool {
+	if obj == abstract {
+		return true
+	}
+	t := s.types[obj]
+	if t == nil {
+		return false
+	}
+	for _, i := range t.interfaces {
+		if i == abstract {
+			return true
+		}
+	}
+	return false
+}
+
+// sdl prints the schema in the GraphQL schema definition language.
+func (s *schema) sdl() string {
+	var b strings.Builder
+	for i, name := range s.order {
+		t := s.types[name]
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		if t.description != "" {
+			fmt.Fprintf(&b, "%s\n", strconv.Quote(t.description))
+		}
+		keyword := "type"
+		if t.kind == interfaceKind {
+			keyword = "interface"
+		}
+		b.WriteString(keyword + " " + t.name)
+		if len(t.interfaces) > 0 {
+			b.WriteString(" implements " + strings.Join(t.interfaces, " & "))
+		}
+		b.WriteString(" {\n")
+		for _, f := range t.fields {
+			if f.description != "" {
+				fmt.Fprintf(&b, "  %s\n", strconv.Quote(f.description))
+			}
+			b.WriteString("  " + f.name)
+			if len(f.args) > 0 {
+				var args []string
+				for _, a := range f.args {
+					arg := a.name + ": " + a.typ.String()
+					if a.description != "" {
+						arg = strconv.Quote(a.description) + " " + arg
+					}
+					args = append(args, arg)
+				}
+				b.WriteString("(" + strings.Join(args, ", ") + ")")
+			}
+			b.WriteString(": " + f.typ.String() + "\n")
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
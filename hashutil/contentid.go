@@ -0,0 +1,116 @@
+package hashutil
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"slices"
+	"strconv"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// Gitoid returns the gitoid of size bytes of content read from r, the
+// identifier of the content as a git blob, as in
+// "gitoid:blob:sha1:<hex>". The algorithm is spdx.HashAlgorithmSha1 or
+// spdx.HashAlgorithmSha256, as for git repositories of either object
+// format.
+func Gitoid(r io.Reader, size int64, algorithm spdx.HashAlgorithm) (string, error) {
+	var h hash.Hash
+	switch algorithm {
+	case spdx.HashAlgorithmSha1:
+		h = sha1.New()
+	case spdx.HashAlgorithmSha256:
+		h = sha256.New()
+	default:
+		return "", fmt.Errorf("unsupported gitoid algorithm %q", algorithm)
+	}
+	if err := hashBlob(r, size, h); err != nil {
+		return "", err
+	}
+	return "gitoid:blob:" + string(algorithm) + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// SWHID returns the Software Heritage identifier of size bytes of content
+// read from r, as in "swh:1:cnt:<hex>".
+func SWHID(r io.Reader, size int64) (string, error) {
+	h := sha1.New()
+	if err := hashBlob(r, size, h); err != nil {
+		return "", err
+	}
+	return "swh:1:cnt:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ContentIdentifiers returns the SHA-1 and SHA-256 gitoids and the SWHID of
+// size bytes of content read from r, reading it once.
+func ContentIdentifiers(r io.Reader, size int64) ([]spdx.ContentIdentifier, error) {
+	h1, h256 := sha1.New(), sha256.New()
+	if err := hashBlob(r, size, io.MultiWriter(h1, h256)); err != nil {
+		return nil, err
+	}
+	sum1 := hex.EncodeToString(h1.Sum(nil))
+	return []spdx.ContentIdentifier{
+		{ContentIdentifierType: spdx.ContentIdentifierTypeGitoid, ContentIdentifierValue: "gitoid:blob:sha1:" + sum1},
+		{ContentIdentifierType: spdx.ContentIdentifierTypeGitoid, ContentIdentifierValue: "gitoid:blob:sha256:" + hex.EncodeToString(h256.Sum(nil))},
+		{ContentIdentifierType: spdx.ContentIdentifierTypeSwhid, ContentIdentifierValue: "swh:1:cnt:" + sum1},
+	}, nil
+}
+
+// FileContentIdentifiers returns the content identifiers of the file of
+// fsys with the given name, as ContentIdentifiers does.
+//
+//	ids, err := hashutil.FileContentIdentifiers(os.DirFS("."), "bin/app")
+//	...
+//	err = hashutil.SetContentIdentifiers(file, ids...)
+func FileContentIdentifiers(fsys fs.FS, name string) ([]spdx.ContentIdentifier, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	ids, err := ContentIdentifiers(f, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("hashing %s: %w", name, err)
+	}
+	return ids, nil
+}
+
+// SetContentIdentifiers sets the content identifiers of a File, Package or
+// Snippet, replacing those it has of the same types.
+func SetContentIdentifiers(elem spdx.AnyElement, ids ...spdx.ContentIdentifier) error {
+	artifact, ok := spdx.AsSoftwareArtifact(elem)
+	if !ok {
+		return fmt.Errorf("element %q is not a software artifact", elem.GetSpdxID())
+	}
+	artifact.ContentIdentifier = slices.DeleteFunc(artifact.ContentIdentifier, func(old spdx.ContentIdentifier) bool {
+		return slices.ContainsFunc(ids, func(id spdx.ContentIdentifier) bool {
+			return id.ContentIdentifierType == old.ContentIdentifierType
+		})
+	})
+	artifact.ContentIdentifier = append(artifact.ContentIdentifier, ids...)
+	return nil
+}
+
+// hashBlob writes the git blob object of size bytes of content read from r
+// to w: its "blob <size>" header with a NUL terminator, then the content.
+func hashBlob(r io.Reader, size int64, w io.Writer) error {
+	if _, err := io.WriteString(w, "blob "+strconv.FormatInt(size, 10)+"\x00"); err != nil {
+		return err
+	}
+	n, err := io.Copy(w, io.LimitReader(r, size+1))
+	if err != nil {
+		return err
+	}
+	if n != size {
+		return fmt.Errorf("content is %d bytes, not %d", n, size)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package hashutil_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/interlynk-io/spdx-zen/hashutil"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+const (
+	helloSHA1   = "3b18e512dba79e4c8300dd08aeb37f8e728b8dad"
+	helloSHA256 = "0bd69098bd9b9cc5934a610ab65da429b525361147faa7b5b922919e9a23143d"
+)
+
+func TestGitoid(t *testing.T) {
+	const content = "hello world\n"
+	tests := []struct {
+		algorithm spdx.HashAlgorithm
+		want      string
+		wantErr   bool
+	}{
+		{spdx.HashAlgorithmSha1, "gitoid:blob:sha1:" + helloSHA1, false},
+		{spdx.HashAlgorithmSha256, "gitoid:blob:sha256:" + helloSHA256, false},
+		{spdx.HashAlgorithmMd5, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.algorithm), func(t *testing.T) {
+			got, err := hashutil.Gitoid(strings.NewReader(content), int64(len(content)), tt.algorithm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Gitoid error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Gitoid = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := hashutil.SWHID(strings.NewReader(content), int64(len(content))); err != nil || got != "swh:1:cnt:"+helloSHA1 {
+		t.Errorf("SWHID = %q, %v", got, err)
+	}
+	if _, err := hashutil.SWHID(strings.NewReader(content), 5); err == nil {
+		t.Error("SWHID accepted content longer than its size")
+	}
+	if _, err := hashutil.SWHID(strings.NewReader(content), 50); err == nil {
+		t.Error("SWHID accepted content shorter than its size")
+	}
+}
+
+func TestSetContentIdentifiers(t *testing.T) {
+	fsys := fstest.MapFS{"hello.txt": {Data: []byte("hello world\n")}}
+	ids, err := hashutil.FileContentIdentifiers(fsys, "hello.txt")
+	if err != nil {
+		t.Fatalf("FileContentIdentifiers: %v", err)
+	}
+	want := []string{"gitoid:blob:sha1:" + helloSHA1, "gitoid:blob:sha256:" + helloSHA256, "swh:1:cnt:" + helloSHA1}
+	if len(ids) != len(want) {
+		t.Fatalf("identifiers = %v", ids)
+	}
+	for i, id := range ids {
+		if id.ContentIdentifierValue != want[i] {
+			t.Errorf("identifier %d = %q, want %q", i, id.ContentIdentifierValue, want[i])
+		}
+	}
+
+	file := spdx.NewFile("urn:spdx:hello", "hello.txt", spdx.NewCreationInfo(nil))
+	file.ContentIdentifier = []spdx.ContentIdentifier{{ContentIdentifierType: spdx.ContentIdentifierTypeSwhid, ContentIdentifierValue: "swh:1:cnt:stale"}}
+	if err := hashutil.SetContentIdentifiers(file, ids...); err != nil {
+		t.Fatalf("SetContentIdentifiers: %v", err)
+	}
+	if len(file.ContentIdentifier) != 3 || file.ContentIdentifier[2].ContentIdentifierValue != want[2] {
+		t.Errorf("content identifiers = %v", file.ContentIdentifier)
+	}
+
+	person := &spdx.Person{Agent: spdx.Agent{Element: spdx.Element{SpdxID: "urn:spdx:jane"}}}
+	if err := hashutil.SetContentIdentifiers(person, ids...); err == nil {
+		t.Error("SetContentIdentifiers accepted a person")
+	}
+}
@@ -0,0 +1,259 @@
+// Package hashutil computes the digests that SBOM producers attach to
+// elements: the hashes of files, the package verification codes of
+// packages and the gitoids and SWHIDs of their content.
+//
+//	hashes, err := hashutil.HashFile("dist/app.tar.gz")
+//	...
+//	err = hashutil.SetHashes(pkg, hashes...)
+package hashutil
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha3"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"hash/adler32"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// DefaultAlgorithms are the algorithms files are hashed with if none are
+// given, the same as the sbom package hashes the files it adds with.
+var DefaultAlgorithms = []spdx.HashAlgorithm{spdx.HashAlgorithmSha256, spdx.HashAlgorithmSha512}
+
+// hashFuncs are the algorithms that can be computed, by their SPDX names.
+var hashFuncs = map[spdx.HashAlgorithm]func() hash.Hash{
+	spdx.HashAlgorithmAdler32: func() hash.Hash { return adler32.New() },
+	spdx.HashAlgorithmMd5:     md5.New,
+	spdx.HashAlgorithmSha1:    sha1.New,
+	spdx.HashAlgorithmSha224:  sha256.New224,
+	spdx.HashAlgorithmSha256:  sha256.New,
+	spdx.HashAlgorithmSha384:  sha512.New384,
+	spdx.HashAlgorithmSha512:  sha512.New,
+	spdx.HashAlgorithmSha3224: func() hash.Hash { return sha3.New224() },
+	spdx.HashAlgorithmSha3256: func() hash.Hash { return sha3.New256() },
+	spdx.HashAlgorithmSha3384: func() hash.Hash { return sha3.New384() },
+	spdx.HashAlgorithmSha3512: func() hash.Hash { return sha3.New512() },
+}
+
+// HashReader returns the hashes of the content read from r with the given
+// algorithms, or DefaultAlgorithms if none are given, reading it once. The
+// SHA-1, SHA-2 and SHA-3 families, MD5 and Adler-32 are supported.
+func HashReader(r io.Reader, algorithms ...spdx.HashAlgorithm) ([]*spdx.Hash, error) {
+	if len(algorithms) == 0 {
+		algorithms = DefaultAlgorithms
+	}
+	hs := make([]hash.Hash, len(algorithms))
+	ws := make([]io.Writer, len(algorithms))
+	for i, alg := range algorithms {
+		newHash, ok := hashFuncs[alg]
+		if !ok {
+			return nil, fmt.Errorf("unsupported hash algorithm %q", alg)
+		}
+		hs[i] = newHash()
+		ws[i] = hs[i]
+	}
+	if _, err := io.Copy(io.MultiWriter(ws...), r); err != nil {
+		return nil, err
+	}
+	hashes := make([]*spdx.Hash, len(algorithms))
+	for i, alg := range algorithms {
+		h := spdx.NewHash(alg, hex.EncodeToString(hs[i].Sum(nil)))
+		hashes[i] = &h
+	}
+	return hashes, nil
+}
+
+// HashFile returns the hashes of the file at path, as HashReader does,
+// ready to be set on the File or Package with SetHashes.
+//
+//	hashes, err := hashutil.HashFile("dist/app.tar.gz", spdx.HashAlgorithmSha256)
+//	...
+//	err = hashutil.SetHashes(pkg, hashes...)
+func HashFile(path string, algorithms ...spdx.HashAlgorithm) ([]*spdx.Hash, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	hashes, err := HashReader(f, algorithms...)
+	if err != nil {
+		return nil, fmt.Errorf("hashing %s: %w", path, err)
+	}
+	return hashes, nil
+}
+
+// SetHashes sets hashes among the verification methods of an element,
+// replacing those it has with the same algorithms.
+func SetHashes(elem spdx.AnyElement, hashes ...*spdx.Hash) error {
+	e := spdx.AsElement(elem)
+	if e == nil {
+		return fmt.Errorf("element is nil")
+	}
+	e.VerifiedUsing = slices.DeleteFunc(e.VerifiedUsing, func(m spdx.IntegrityMethodInterface) bool {
+		old, ok := m.(*spdx.Hash)
+		return ok && slices.ContainsFunc(hashes, func(h *spdx.Hash) bool { return h.Algorithm == old.Algorithm })
+	})
+	for _, h := range hashes {
+		e.VerifiedUsing = append(e.VerifiedUsing, h)
+	}
+	return nil
+}
+
+// Option configures HashFS.
+type Option interface {
+	apply(*hasher)
+}
+
+type optionFunc func(*hasher)
+
+func (f optionFunc) apply(h *hasher) {
+	f(h)
+}
+
+// hasher holds the settings of HashFS.
+type hasher struct {
+	algorithms  []spdx.HashAlgorithm
+	concurrency int
+}
+
+// WithAlgorithms sets the algorithms HashFS hashes files with, instead of
+// DefaultAlgorithms.
+func WithAlgorithms(algorithms ...spdx.HashAlgorithm) Option {
+	return optionFunc(func(h *hasher) {
+		h.algorithms = algorithms
+	})
+}
+
+// WithConcurrency sets the number of files HashFS hashes at once. It
+// defaults to GOMAXPROCS.
+func WithConcurrency(n int) Option {
+	return optionFunc(func(h *hasher) {
+		if n > 0 {
+			h.concurrency = n
+		}
+	})
+}
+
+// FileHashes are the hashes of a file hashed by HashFS.
+type FileHashes struct {
+	// Name is the slash-separated path of the file relative to the root,
+	// or its base name if the root is the file.
+	Name   string
+	Hashes []*spdx.Hash
+}
+
+// HashFS hashes the regular files in the tree of fsys rooted at root, as
+// HashReader does, several at once, and returns their hashes in lexical
+// order of their names. It stops at the first file that cannot be read.
+//
+//	files, err := hashutil.HashFS(os.DirFS("dist"), ".", hashutil.WithConcurrency(8))
+//	...
+//	for _, f := range files {
+//	    fmt.Println(f.Name, f.Hashes[0].HashValue)
+//	}
+func HashFS(fsys fs.FS, root string, opts ...Option) ([]FileHashes, error) {
+	h := &hasher{algorithms: DefaultAlgorithms, concurrency: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+	for _, alg := range h.algorithms {
+		if _, ok := hashFuncs[alg]; !ok {
+			return nil, fmt.Errorf("unsupported hash algorithm %q", alg)
+		}
+	}
+
+	var names []string
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("hashing files: %w", err)
+	}
+
+	files := make([]FileHashes, len(names))
+	jobs := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	failed := func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return firstErr != nil
+	}
+	for range min(h.concurrency, len(names)) {
+		wg.Go(func() {
+			for i := range jobs {
+				hashes, err := hashFSFile(fsys, names[i], h.algorithms)
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					continue
+				}
+				files[i] = FileHashes{Name: relativeName(root, names[i]), Hashes: hashes}
+			}
+		})
+	}
+	for i := range names {
+		if failed() {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, fmt.Errorf("hashing files: %w", firstErr)
+	}
+	return files, nil
+}
+
+// relativeName returns the slash-separated path of the file name of the
+// tree rooted at root relative to root, or the base name of root if name
+// is root itself, a regular file.
+func relativeName(root, name string) string {
+	switch {
+	case name == root:
+		return path.Base(name)
+	case root == ".":
+		return name
+	}
+	return strings.TrimPrefix(name, root+"/")
+}
+
+// hashFSFile returns the hashes of the file of fsys with the given name.
+func hashFSFile(fsys fs.FS, name string, algorithms []spdx.HashAlgorithm) ([]*spdx.Hash, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	hashes, err := HashReader(f, algorithms...)
+	if err != nil {
+		return nil, fmt.Errorf("hashing %s: %w", name, err)
+	}
+	return hashes, nil
+}
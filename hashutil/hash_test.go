@@ -0,0 +1,108 @@
+package hashutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/interlynk-io/spdx-zen/hashutil"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+func TestHashReader(t *testing.T) {
+	tests := []struct {
+		algorithm spdx.HashAlgorithm
+		want      string
+	}{
+		{spdx.HashAlgorithmMd5, "6f5902ac237024bdd0c176cb93063dc4"},
+		{spdx.HashAlgorithmSha1, "22596363b3de40b06f981fb85d82312e8c0ed511"},
+		{spdx.HashAlgorithmSha256, "a948904f2f0f479b8f8197694b30184b0d2ed1c1cd2a1ec0fb85d299a192a447"},
+		{spdx.HashAlgorithmSha3256, "a8009a7a528d87778c356da3a55d964719e818666a04e4f960c9e2439e35f138"},
+		{spdx.HashAlgorithmAdler32, "1e720467"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.algorithm), func(t *testing.T) {
+			hashes, err := hashutil.HashReader(strings.NewReader("hello world\n"), tt.algorithm)
+			if err != nil {
+				t.Fatalf("HashReader: %v", err)
+			}
+			if len(hashes) != 1 || hashes[0].Algorithm != tt.algorithm || hashes[0].HashValue != tt.want {
+				t.Errorf("hashes = %+v, want %s", hashes, tt.want)
+			}
+		})
+	}
+
+	if _, err := hashutil.HashReader(strings.NewReader(""), spdx.HashAlgorithmBlake3); err == nil {
+		t.Error("HashReader accepted an unsupported algorithm")
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.tar.gz")
+	if err := os.WriteFile(path, []byte("hello world\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	hashes, err := hashutil.HashFile(path)
+	if err != nil {
+		t.Fatalf("HashFile: %v", err)
+	}
+	if len(hashes) != 2 || hashes[0].Algorithm != spdx.HashAlgorithmSha256 || hashes[1].Algorithm != spdx.HashAlgorithmSha512 {
+		t.Fatalf("hashes = %+v, want the default algorithms", hashes)
+	}
+	if _, err := hashutil.HashFile(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("HashFile accepted a missing file")
+	}
+
+	pkg := spdx.NewPackage("urn:spdx:app", "app", "1.0.0", spdx.NewCreationInfo(nil))
+	pkg.AddHash(spdx.HashAlgorithmSha256, "stale")
+	pkg.AddHash(spdx.HashAlgorithmMd5, "kept")
+	if err := hashutil.SetHashes(pkg, hashes...); err != nil {
+		t.Fatalf("SetHashes: %v", err)
+	}
+	got := pkg.Hashes()
+	if len(got) != 3 || got[0].HashValue != "kept" || got[1] != hashes[0] || got[2] != hashes[1] {
+		t.Errorf("package hashes = %+v", got)
+	}
+}
+
+func TestHashFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dist/b.txt":     {Data: []byte("hello world\n")},
+		"dist/a.txt":     {Data: []byte("hello world\n")},
+		"dist/sub/c.txt": {Data: []byte("")},
+		"other.txt":      {Data: []byte("other")},
+	}
+	for _, concurrency := range []int{1, 2, 8} {
+		files, err := hashutil.HashFS(fsys, "dist", hashutil.WithAlgorithms(spdx.HashAlgorithmSha1), hashutil.WithConcurrency(concurrency))
+		if err != nil {
+			t.Fatalf("HashFS: %v", err)
+		}
+		var names []string
+		for _, f := range files {
+			names = append(names, f.Name)
+		}
+		if strings.Join(names, ",") != "a.txt,b.txt,sub/c.txt" {
+			t.Fatalf("names = %v", names)
+		}
+		if files[1].Hashes[0].HashValue != "22596363b3de40b06f981fb85d82312e8c0ed511" || files[2].Hashes[0].HashValue != "da39a3ee5e6b4b0d3255bfef95601890afd80709" {
+			t.Errorf("concurrency %d: hashes = %+v %+v", concurrency, files[1].Hashes[0], files[2].Hashes[0])
+		}
+	}
+
+	files, err := hashutil.HashFS(fsys, "dist/sub/c.txt", hashutil.WithAlgorithms(spdx.HashAlgorithmSha1))
+	if err != nil {
+		t.Fatalf("HashFS of a file: %v", err)
+	}
+	if len(files) != 1 || files[0].Name != "c.txt" || files[0].Hashes[0].HashValue != "da39a3ee5e6b4b0d3255bfef95601890afd80709" {
+		t.Errorf("HashFS of a file = %+v", files)
+	}
+
+	if _, err := hashutil.HashFS(fsys, ".", hashutil.WithAlgorithms(spdx.HashAlgorithmBlake3)); err == nil {
+		t.Error("HashFS accepted an unsupported algorithm")
+	}
+	if _, err := hashutil.HashFS(fsys, "missing"); err == nil {
+		t.Error("HashFS accepted a missing root")
+	}
+}
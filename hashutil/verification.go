@@ -0,0 +1,152 @@
+package hashutil
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// VerificationCode computes the package verification code of the regular
+// files in the tree of fsys rooted at root, as the SPDX specification
+// defines it: the SHA-1 of the concatenation of the sorted, lowercase
+// hexadecimal SHA-1 values of the files.
+//
+// Files are named by their slash-separated path relative to root, or by
+// their base name if root is a regular file itself. Those
+// matching an excluded name, exactly or as a path.Match pattern, are left
+// out, such as the SPDX document of the package itself, and listed in
+// PackageVerificationCodeExcludedFile in lexical order.
+func VerificationCode(fsys fs.FS, root string, excluded ...string) (*spdx.PackageVerificationCode, error) {
+	var sums, skipped []string
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel := relativeName(root, name)
+		if isExcluded(rel, excluded) {
+			skipped = append(skipped, rel)
+			return nil
+		}
+		hashes, err := hashFSFile(fsys, name, []spdx.HashAlgorithm{spdx.HashAlgorithmSha1})
+		if err != nil {
+			return err
+		}
+		sums = append(sums, hashes[0].HashValue)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("computing verification code: %w", err)
+	}
+	code := VerificationCodeOf(sums)
+	code.PackageVerificationCodeExcludedFile = skipped
+	return code, nil
+}
+
+// VerificationCodeOf computes the package verification code of files with
+// the given hexadecimal SHA-1 values, in any order and case.
+func VerificationCodeOf(sha1s []string) *spdx.PackageVerificationCode {
+	sorted := make([]string, len(sha1s))
+	for i, s := range sha1s {
+		sorted[i] = strings.ToLower(s)
+	}
+	slices.Sort(sorted)
+	sum := sha1.Sum([]byte(strings.Join(sorted, "")))
+	return &spdx.PackageVerificationCode{
+		Algorithm: spdx.HashAlgorithmSha1,
+		HashValue: hex.EncodeToString(sum[:]),
+	}
+}
+
+// VerificationCodeOfFiles computes the package verification code of the
+// File elements of a package from their SHA-1 hashes, leaving out those
+// whose names match an excluded name as for VerificationCode. It fails
+// for files without a SHA-1 hash.
+func VerificationCodeOfFiles(files []*spdx.File, excluded ...string) (*spdx.PackageVerificationCode, error) {
+	var sums, skipped []string
+	for _, f := range files {
+		if isExcluded(f.Name, excluded) {
+			skipped = append(skipped, f.Name)
+			continue
+		}
+		i := slices.IndexFunc(f.Hashes(), func(h *spdx.Hash) bool {
+			return h.Algorithm == spdx.HashAlgorithmSha1
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("file %q has no SHA-1 hash", f.Name)
+		}
+		sums = append(sums, f.Hashes()[i].HashValue)
+	}
+	slices.Sort(skipped)
+	code := VerificationCodeOf(sums)
+	code.PackageVerificationCodeExcludedFile = skipped
+	return code, nil
+}
+
+// GetVerificationCode returns the package verification code among the
+// verification methods of pkg, or nil if it has none.
+func GetVerificationCode(pkg *spdx.Package) *spdx.PackageVerificationCode {
+	for _, m := range pkg.VerifiedUsing {
+		if code, ok := m.(*spdx.PackageVerificationCode); ok {
+			return code
+		}
+	}
+	return nil
+}
+
+// SetVerificationCode sets the package verification code of pkg,
+// replacing any it has among its verification methods.
+func SetVerificationCode(pkg *spdx.Package, code *spdx.PackageVerificationCode) {
+	pkg.VerifiedUsing = slices.DeleteFunc(pkg.VerifiedUsing, func(m spdx.IntegrityMethodInterface) bool {
+		_, ok := m.(*spdx.PackageVerificationCode)
+		return ok
+	})
+	pkg.VerifiedUsing = append(pkg.VerifiedUsing, code)
+}
+
+// VerifyPackage recomputes the package verification code of pkg over the
+// tree of fsys rooted at root, leaving out the files it lists as excluded,
+// and reports an error if it has none or it does not match.
+//
+//	if err := hashutil.VerifyPackage(pkg, os.DirFS("vendor/lib"), "."); err != nil {
+//	    log.Fatal(err)
+//	}
+func VerifyPackage(pkg *spdx.Package, fsys fs.FS, root string) error {
+	code := GetVerificationCode(pkg)
+	if code == nil {
+		return fmt.Errorf("package %q has no verification code", pkg.SpdxID)
+	}
+	if code.Algorithm != spdx.HashAlgorithmSha1 {
+		return fmt.Errorf("package %q has a verification code with unsupported algorithm %q", pkg.SpdxID, code.Algorithm)
+	}
+	got, err := VerificationCode(fsys, root, code.PackageVerificationCodeExcludedFile...)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(got.HashValue, code.HashValue) {
+		return fmt.Errorf("package %q has verification code %s, files have %s", pkg.SpdxID, code.HashValue, got.HashValue)
+	}
+	return nil
+}
+
+// isExcluded reports whether name is one of excluded or matches one as a
+// path.Match pattern.
+func isExcluded(name string, excluded []string) bool {
+	for _, pattern := range excluded {
+		if name == pattern {
+			return true
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
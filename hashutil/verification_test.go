@@ -0,0 +1,125 @@
+package hashutil_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/interlynk-io/spdx-zen/hashutil"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+func TestVerificationCode(t *testing.T) {
+	fsys := fstest.MapFS{
+		"pkg/main.go":          {Data: []byte("package main\n")},
+		"pkg/lib/lib.txt":      {Data: []byte("lib\n")},
+		"pkg/app.spdx.json":    {Data: []byte("{}")},
+		"pkg/docs/notes.spdx3": {Data: []byte("notes")},
+	}
+	const want = "0fc3583d69588ba41248930d788c8d9e3bbe64e1"
+
+	tests := []struct {
+		name     string
+		excluded []string
+		want     string
+		skipped  []string
+	}{
+		{"exact and pattern", []string{"app.spdx.json", "docs/*.spdx3"}, want, []string{"app.spdx.json", "docs/notes.spdx3"}},
+		{"nothing excluded", nil, "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := hashutil.VerificationCode(fsys, "pkg", tt.excluded...)
+			if err != nil {
+				t.Fatalf("VerificationCode: %v", err)
+			}
+			if code.Algorithm != spdx.HashAlgorithmSha1 {
+				t.Errorf("Algorithm = %q", code.Algorithm)
+			}
+			if tt.want != "" && code.HashValue != tt.want {
+				t.Errorf("HashValue = %s, want %s", code.HashValue, tt.want)
+			}
+			if tt.want == "" && code.HashValue == want {
+				t.Error("HashValue does not cover the excluded files")
+			}
+			if strings.Join(code.PackageVerificationCodeExcludedFile, ",") != strings.Join(tt.skipped, ",") {
+				t.Errorf("excluded files = %v, want %v", code.PackageVerificationCodeExcludedFile, tt.skipped)
+			}
+		})
+	}
+
+	single, err := hashutil.VerificationCode(fsys, "pkg/main.go")
+	if err != nil {
+		t.Fatalf("VerificationCode of a file: %v", err)
+	}
+	if single.HashValue != hashutil.VerificationCodeOf([]string{"af96a5c06ec8bf0b99b61196b464b2f70533fe93"}).HashValue {
+		t.Errorf("HashValue of a file = %s", single.HashValue)
+	}
+	single, err = hashutil.VerificationCode(fsys, "pkg/app.spdx.json", "app.spdx.json")
+	if err != nil {
+		t.Fatalf("VerificationCode of an excluded file: %v", err)
+	}
+	if strings.Join(single.PackageVerificationCodeExcludedFile, ",") != "app.spdx.json" {
+		t.Errorf("excluded files of a file = %v", single.PackageVerificationCodeExcludedFile)
+	}
+
+	if got := hashutil.VerificationCodeOf(nil).HashValue; got != "da39a3ee5e6b4b0d3255bfef95601890afd80709" {
+		t.Errorf("code of no files = %s", got)
+	}
+	upper := hashutil.VerificationCodeOf([]string{"AF96A5C06EC8BF0B99B61196B464B2F70533FE93", "376456435d4ceec3acb6ab963107280ef80aca1b"})
+	if upper.HashValue != want {
+		t.Errorf("code of mixed-case values = %s, want %s", upper.HashValue, want)
+	}
+
+	main := spdx.NewFile("urn:spdx:main", "main.go", spdx.NewCreationInfo(nil))
+	main.AddHash(spdx.HashAlgorithmSha1, "376456435d4ceec3acb6ab963107280ef80aca1b")
+	lib := spdx.NewFile("urn:spdx:lib", "lib/lib.txt", spdx.NewCreationInfo(nil))
+	lib.AddHash(spdx.HashAlgorithmSha1, "af96a5c06ec8bf0b99b61196b464b2f70533fe93")
+	doc := spdx.NewFile("urn:spdx:doc", "app.spdx.json", spdx.NewCreationInfo(nil))
+	code, err := hashutil.VerificationCodeOfFiles([]*spdx.File{main, lib, doc}, "app.spdx.json")
+	if err != nil {
+		t.Fatalf("VerificationCodeOfFiles: %v", err)
+	}
+	if code.HashValue != want || len(code.PackageVerificationCodeExcludedFile) != 1 {
+		t.Errorf("code of files = %+v", code)
+	}
+	if _, err := hashutil.VerificationCodeOfFiles([]*spdx.File{main, doc}); err == nil {
+		t.Error("VerificationCodeOfFiles accepted a file without a SHA-1 hash")
+	}
+}
+
+func TestVerifyPackage(t *testing.T) {
+	fsys := fstest.MapFS{
+		"main.go":       {Data: []byte("package main\n")},
+		"lib/lib.txt":   {Data: []byte("lib\n")},
+		"app.spdx.json": {Data: []byte("{}")},
+	}
+	pkg := spdx.NewPackage("urn:spdx:app", "app", "1.0.0", spdx.NewCreationInfo(nil))
+	if err := hashutil.VerifyPackage(pkg, fsys, "."); err == nil {
+		t.Error("VerifyPackage accepted a package without a verification code")
+	}
+
+	pkg.AddHash(spdx.HashAlgorithmSha256, "abc")
+	code, err := hashutil.VerificationCode(fsys, ".", "app.spdx.json")
+	if err != nil {
+		t.Fatalf("VerificationCode: %v", err)
+	}
+	hashutil.SetVerificationCode(pkg, &spdx.PackageVerificationCode{Algorithm: spdx.HashAlgorithmSha1, HashValue: "stale"})
+	hashutil.SetVerificationCode(pkg, code)
+	if len(pkg.VerifiedUsing) != 2 || hashutil.GetVerificationCode(pkg) != code {
+		t.Fatalf("verification methods = %v, want the hash and the code", pkg.VerifiedUsing)
+	}
+	if err := hashutil.VerifyPackage(pkg, fsys, "."); err != nil {
+		t.Errorf("VerifyPackage: %v", err)
+	}
+
+	fsys["main.go"] = &fstest.MapFile{Data: []byte("package changed\n")}
+	if err := hashutil.VerifyPackage(pkg, fsys, "."); err == nil {
+		t.Error("VerifyPackage accepted changed files")
+	}
+	fsys["main.go"] = &fstest.MapFile{Data: []byte("package main\n")}
+	fsys["app.spdx.json"] = &fstest.MapFile{Data: []byte(`{"changed": true}`)}
+	if err := hashutil.VerifyPackage(pkg, fsys, "."); err != nil {
+		t.Errorf("VerifyPackage of a changed excluded file: %v", err)
+	}
+}
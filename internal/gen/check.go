@@ -0,0 +1,145 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Diagnostic describes a problem in a model that would make the generated
+// code incomplete or wrong.
+type Diagnostic struct {
+	Term    string // path of the offending term below the base URI, e.g. "Software/Package", or the IRI of an extension term
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	return d.Term + ": " + d.Message
+}
+
+// CheckError is returned by Generate when the model fails Check.
+type CheckError struct {
+	Diagnostics []Diagnostic
+}
+
+func (e *CheckError) Error() string {
+	lines := make([]string, len(e.Diagnostics))
+	for i, d := range e.Diagnostics {
+		lines[i] = "\t" + d.String()
+	}
+	return fmt.Sprintf("invalid model: %d problem(s):\n%s", len(e.Diagnostics), strings.Join(lines, "\n"))
+}
+
+// Check reports the problems in a model that generation cannot recover from:
+// references to classes, properties or datatypes the model does not define,
+// properties without a range, enumerations without values and cycles in the
+// class hierarchy. The diagnostics are sorted by term.
+func Check(m *Model) []Diagnostic {
+	var diags []Diagnostic
+	report := func(iri, format string, args ...interface{}) {
+		diags = append(diags, Diagnostic{Term: relPath(m, iri), Message: fmt.Sprintf(format, args...)})
+	}
+
+	for id, class := range m.Classes {
+		if class.Parent != "" && !m.definesType(class.Parent) {
+			report(id, "parent class %s is not defined", relPath(m, class.Parent))
+		}
+		for _, prop := range class.Properties {
+			if _, ok := m.Properties[prop.Path]; !ok {
+				report(id, "property %s is not defined", relPath(m, prop.Path))
+			}
+			if prop.ClassRef != "" && !m.definesType(prop.ClassRef) {
+				report(id, "property %s refers to undefined class %s", prop.Name, relPath(m, prop.ClassRef))
+			}
+			if isTermIRI(prop.DataType) && !m.definesType(prop.DataType) {
+				report(id, "property %s refers to undefined datatype %s", prop.Name, relPath(m, prop.DataType))
+			}
+			if prop.ClassRef == "" && prop.DataType == "" && len(prop.InValues) == 0 {
+				report(id, "property %s has no sh:class, sh:datatype or sh:in", prop.Name)
+			}
+		}
+		if cycle := m.subclassCycle(id); cycle != nil && cycle[0] == minString(cycle[:len(cycle)-1]) {
+			// Report each cycle once, at its smallest member.
+			paths := make([]string, len(cycle))
+			for i, c := range cycle {
+				paths[i] = relPath(m, c)
+			}
+			report(id, "subclass cycle %s", strings.Join(paths, " -> "))
+		}
+	}
+
+	for id, prop := range m.Properties {
+		switch {
+		case prop.Range == "":
+			report(id, "property has no rdfs:range")
+		case isTermIRI(prop.Range) && !m.definesType(prop.Range):
+			report(id, "range %s is not defined", relPath(m, prop.Range))
+		}
+	}
+
+	for id, enum := range m.Enums {
+		if len(enum.Values) == 0 {
+			report(id, "enumeration has no values")
+		}
+	}
+
+	sort.Slice(diags, func(i, j int) bool {
+		if diags[i].Term != diags[j].Term {
+			return diags[i].Term < diags[j].Term
+		}
+		return diags[i].Message < diags[j].Message
+	})
+	return diags
+}
+
+// definesType reports whether the model defines a class or enumeration.
+func (m *Model) definesType(iri string) bool {
+	if _, ok := m.Classes[iri]; ok {
+		return true
+	}
+	_, ok := m.Enums[iri]
+	return ok
+}
+
+// subclassCycle returns the cycle through the given class, starting and
+// ending with it, if following its parents leads back to it.
+func (m *Model) subclassCycle(id string) []string {
+	path := []string{id}
+	seen := map[string]bool{id: true}
+	for class := m.Classes[id]; class != nil && class.Parent != ""; class = m.Classes[class.Parent] {
+		path = append(path, class.Parent)
+		if class.Parent == id {
+			return path
+		}
+		if seen[class.Parent] {
+			// A cycle further up, reported for its own members.
+			return nil
+		}
+		seen[class.Parent] = true
+	}
+	return nil
+}
+
+func minString(s []string) string {
+	result := s[0]
+	for _, v := range s[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// generateContext writes context_gen.go, which declares the JSON-LD context
+// of the compact terms the generated JSON methods use, so that documents can
+// be expanded to RDF without fetching the published context.
+//
+// Classes map to their IRI. Object properties are coerced to IRIs, those
+// ranging over an enumeration resolving their values against the IRI of the
+// enumeration; datatype properties are coerced to their datatype. Terms of
+// merged extensions are left out: extension documents use full IRIs.
+func (g *Generator) generateContext() error {
+	terms := map[string]interface{}{
+		"@version": 1.1,
+		"spdxId":   "@id",
+		"type":     "@type",
+	}
+	add := func(iri string, def interface{}) error {
+		if !strings.HasPrefix(iri, g.model.BaseURI) {
+			return nil
+		}
+		name := compactName(iri)
+		if _, ok := terms[name]; ok {
+			return fmt.Errorf("%s and another term both map to %q", iri, name)
+		}
+		terms[name] = def
+		return nil
+	}
+
+	for _, class := range g.sortedClasses() {
+		if err := add(class.ID, class.ID); err != nil {
+			return err
+		}
+	}
+	ids := make([]string, 0, len(g.model.Properties))
+	for id := range g.model.Properties {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if err := add(id, g.contextTerm(g.model.Properties[id])); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(map[string]interface{}{"@context": terms}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding context: %w", err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName)
+	buf.WriteString("// Context is the JSON-LD context document of ContextURL, generated from the\n")
+	buf.WriteString("// model: it maps the compact terms of SPDX documents to the IRIs of their\n")
+	buf.WriteString("// classes and properties.\n")
+	fmt.Fprintf(&buf, "const Context = `%s\n`\n", data)
+	return g.writeFile("context_gen.go", buf.Bytes())
+}
+
+// contextTerm returns the term definition of a property.
+func (g *Generator) contextTerm(prop *Property) map[string]interface{} {
+	def := map[string]interface{}{"@id": prop.ID}
+	switch {
+	case g.model.Enums[prop.Range] != nil:
+		def["@type"] = "@vocab"
+		def["@context"] = map[string]interface{}{"@vocab": prop.Range + "/"}
+	case prop.IsObject:
+		def["@type"] = "@vocab"
+	case prop.Range != "":
+		def["@type"] = prop.Range
+	}
+	return def
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// generateCopy writes copy_gen.go, which gives every class a Copy method
+// returning a deep copy and a Clone method returning the same through the
+// Cloner interface. Like the JSON methods, each class copies the fields it
+// declares and delegates the inherited ones to its embedded parent.
+func (g *Generator) generateCopy() error {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
+
+	for _, class := range g.sortedClasses() {
+		g.writeCopy(&buf, class)
+	}
+
+	return g.writeFile("copy_gen.go", buf.Bytes())
+}
+
+func (g *Generator) writeCopy(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+
+	fmt.Fprintf(buf, "// Copy returns a deep copy of the %s that shares no slices or nested\n// objects with it, or nil if o is nil.\n", typeName)
+	fmt.Fprintf(buf, "func (o *%s) Copy() *%s {\n", typeName, typeName)
+	buf.WriteString("\tif o == nil {\n\t\treturn nil\n\t}\n\tc := *o\n\to.copyFields(&c)\n\treturn &c\n}\n\n")
+
+	fmt.Fprintf(buf, "// Clone returns a deep copy of the %s as a *%s.\n", typeName, typeName)
+	fmt.Fprintf(buf, "func (o *%s) Clone() interface{} {\n\treturn o.Copy()\n}\n\n", typeName)
+
+	// copyFields replaces the slices and nested objects that the shallow
+	// copy c shares with o by copies of their own.
+	fmt.Fprintf(buf, "func (o *%s) copyFields(c *%s) {\n", typeName, typeName)
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		parent := toGoName(extractName(class.Parent))
+		fmt.Fprintf(buf, "\to.%s.copyFields(&c.%s)\n", parent, parent)
+	}
+	for _, f := range g.classFields(class) {
+		isObject := !g.isEnumType(f.BaseType) && g.classByGoName(f.BaseType) != nil
+		switch {
+		case f.IsInterface() && f.IsSlice():
+			fmt.Fprintf(buf, "\tc.%s = cloneSlice(o.%s)\n", f.Name, f.Name)
+		case f.IsInterface():
+			fmt.Fprintf(buf, "\tc.%s = cloneValue(o.%s)\n", f.Name, f.Name)
+		case f.IsSlice() && isObject:
+			fmt.Fprintf(buf, "\tc.%s = copyObjects(o.%s, (*%s).copyFields)\n", f.Name, f.Name, f.BaseType)
+		case f.IsSlice():
+			fmt.Fprintf(buf, "\tc.%s = copySlice(o.%s)\n", f.Name, f.Name)
+		case f.IsPointer() && isObject:
+			fmt.Fprintf(buf, "\tc.%s = o.%s.Copy()\n", f.Name, f.Name)
+		case isObject:
+			fmt.Fprintf(buf, "\to.%s.copyFields(&c.%s)\n", f.Name, f.Name)
+		}
+	}
+	buf.WriteString("}\n\n")
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// objectGetters maps the Go types read directly from a JSON object to the
+// object method that reads them, as helperGetters does for JSON maps.
+var objectGetters = map[string]string{
+	stringType:        "getString",
+	"[]" + stringType: "getStringSlice",
+	"bool":            "getBool",
+	"int":             "getInt",
+	"float64":         "getFloat",
+	"time.Time":       "getTime",
+}
+
+// generateDecoders writes decode_gen.go into the parser package. It mirrors
+// parse_gen.go for raw JSON objects: a decode<Class> method for every class
+// reads the same properties with the same leniency as Parse<Class>, from
+// the members of an object split out of the JSON text instead of from a
+// map, so that no interface{} tree is built in between.
+//
+// The generated code calls into decode.go: the object getters, decodeNode
+// for element references, decodeID for identifiers and object for nested
+// objects.
+func (g *Generator) generateDecoders() error {
+	classes := g.sortedClasses()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage parser\n\n")
+	fmt.Fprintf(&buf, "import (\n\t%s %q\n)\n\n", g.pkgName, g.modelImport)
+
+	buf.WriteString("// decodeType decodes obj as the class with the given compact JSON-LD\n")
+	buf.WriteString("// type name, like parseType does for JSON maps.\n")
+	buf.WriteString("func (p *ElementParser) decodeType(typ string, obj *object) (interface{}, bool) {\n\tswitch typ {\n")
+	for _, class := range classes {
+		fmt.Fprintf(&buf, "\tcase %q:\n\t\treturn p.decode%s(obj), true\n", compactName(class.ID), toGoName(class.Name))
+	}
+	buf.WriteString("\t}\n\treturn nil, false\n}\n\n")
+
+	for _, class := range classes {
+		if err := g.writeDecoder(&buf, class); err != nil {
+			return fmt.Errorf("class %s: %w", class.Name, err)
+		}
+	}
+	for _, class := range classes {
+		if class.IsAbstract && !g.isElementClass(class.ID) {
+			g.writeAbstractDecoder(&buf, class)
+		}
+	}
+
+	return writeFileIn(g.parserDir, "decode_gen.go", buf.Bytes())
+}
+
+func (g *Generator) writeDecoder(buf *bytes.Buffer, class *Class) error {
+	typeName := toGoName(class.Name)
+	qualified := g.pkgName + "." + typeName
+
+	fmt.Fprintf(buf, "func (p *ElementParser) decode%s(obj *object) *%s {\n", typeName, qualified)
+	fmt.Fprintf(buf, "\to := &%s{}\n\tp.fill%sFrom(obj, o)\n", qualified, typeName)
+	if g.isElementClass(class.ID) {
+		buf.WriteString("\tp.normalize(o)\n")
+	}
+	buf.WriteString("\treturn o\n}\n\n")
+
+	fmt.Fprintf(buf, "func (p *ElementParser) fill%sFrom(obj *object, o *%s) {\n", typeName, qualified)
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		parent := toGoName(extractName(class.Parent))
+		fmt.Fprintf(buf, "\tp.fill%sFrom(obj, &o.%s)\n", parent, parent)
+	}
+	if class.Name == "Element" {
+		buf.WriteString("\to.SpdxID = p.decodeID(obj)\n")
+	}
+	for _, f := range g.classFields(class) {
+		if err := g.writeFieldDecoder(buf, f); err != nil {
+			return fmt.Errorf("property %s: %w", f.Prop.Name, err)
+		}
+	}
+	buf.WriteString("}\n\n")
+	return nil
+}
+
+// writeAbstractDecoder writes the decoder for objects of an abstract class
+// that is not an element, which dispatches on their type.
+func (g *Generator) writeAbstractDecoder(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+	iface := g.pkgName + "." + typeName + "Interface"
+
+	fmt.Fprintf(buf, "func (p *ElementParser) decode%sInterface(obj *object) %s {\n", typeName, iface)
+	buf.WriteString("\tif v, ok := p.decodeType(obj.getString(\"type\"), obj); ok {\n")
+	fmt.Fprintf(buf, "\t\tif x, ok := v.(%s); ok {\n\t\t\treturn x\n\t\t}\n\t}\n", iface)
+	fmt.Fprintf(buf, "\treturn p.decode%s(obj)\n}\n\n", typeName)
+}
+
+// writeFieldDecoder is the counterpart of writeFieldParser.
+func (g *Generator) writeFieldDecoder(buf *bytes.Buffer, f field) error {
+	key := compactName(f.Prop.Path)
+	ref := "o." + f.Name
+	elemType := g.pkgName + "." + f.BaseType
+	fill := "p.fill" + f.BaseType + "From"
+
+	switch {
+	case g.isEnumType(f.BaseType):
+		if f.IsSlice() {
+			fmt.Fprintf(buf, "\tfor _, s := range obj.getStringSlice(%q) {\n\t\t%s = append(%s, %s.Normalize%s(s))\n\t}\n", key, ref, ref, g.pkgName, f.BaseType)
+		} else {
+			fmt.Fprintf(buf, "\t%s = %s.Normalize%s(obj.getString(%q))\n", ref, g.pkgName, f.BaseType, key)
+		}
+
+	case g.isElementRef(f):
+		normalizer := g.pkgName + ".NormalizeElementRef"
+		if g.isSubclassOf(f.Prop.ClassRef, g.model.IRI("SimpleLicensing/AnyLicenseInfo")) {
+			normalizer = g.pkgName + ".NormalizeLicenseRef"
+		}
+		switch {
+		case f.IsSlice():
+			fmt.Fprintf(buf, "\tfor v := range obj.getSlice(%q) {\n", key)
+			fmt.Fprintf(buf, "\t\tif n, ok := p.decodeNode(obj, v, %s); ok {\n\t\t\tvar x %s\n\t\t\t%s(&n, &x)\n\t\t\t%s = append(%s, x)\n\t\t}\n\t}\n",
+				normalizer, elemType, fill, ref, ref)
+		case f.IsPointer():
+			fmt.Fprintf(buf, "\tif n, ok := p.decodeNode(obj, obj.get(%q), %s); ok {\n\t\t%s = &%s{}\n\t\t%s(&n, %s)\n\t}\n",
+				key, normalizer, ref, elemType, fill, ref)
+		default:
+			fmt.Fprintf(buf, "\tif n, ok := p.decodeNode(obj, obj.get(%q), %s); ok {\n\t\t%s(&n, &%s)\n\t}\n",
+				key, normalizer, fill, ref)
+		}
+
+	case f.IsInterface():
+		decode := "p.decode" + f.BaseType + "Interface"
+		if !f.IsSlice() {
+			return fmt.Errorf("single-valued property of abstract class %s", f.BaseType)
+		}
+		fmt.Fprintf(buf, "\tfor v := range obj.getSlice(%q) {\n", key)
+		fmt.Fprintf(buf, "\t\tif n, ok := obj.object(v); ok {\n\t\t\t%s = append(%s, %s(&n))\n\t\t}\n\t}\n", ref, ref, decode)
+
+	case f.Prop.ClassRef != "":
+		switch {
+		case f.IsSlice():
+			fmt.Fprintf(buf, "\tfor v := range obj.getSlice(%q) {\n", key)
+			fmt.Fprintf(buf, "\t\tif n, ok := obj.object(v); ok {\n\t\t\tvar x %s\n\t\t\t%s(&n, &x)\n\t\t\t%s = append(%s, x)\n\t\t}\n\t}\n",
+				elemType, fill, ref, ref)
+		case f.IsPointer():
+			fmt.Fprintf(buf, "\tif n, ok := obj.getMap(%q); ok {\n\t\t%s = &%s{}\n\t\t%s(&n, %s)\n\t}\n",
+				key, ref, elemType, fill, ref)
+		default:
+			fmt.Fprintf(buf, "\tif n, ok := obj.getMap(%q); ok {\n\t\t%s(&n, &%s)\n\t}\n", key, fill, ref)
+		}
+
+	default:
+		getter, ok := objectGetters[f.Type]
+		if !ok {
+			return fmt.Errorf("no decoder for Go type %s", f.Type)
+		}
+		fmt.Fprintf(buf, "\t%s = obj.%s(%q)\n", ref, getter, key)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Kinds of Change.
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// ModelDiff lists the differences between two versions of the SPDX model.
+// Terms are identified by their path below the base URI, e.g.
+// "Software/Package", so that they compare equal across versions.
+type ModelDiff struct {
+	From       string   `json:"from"`
+	To         string   `json:"to"`
+	Classes    []Change `json:"classes"`
+	Properties []Change `json:"properties"`
+	Enums      []Change `json:"enums"`
+	EnumValues []Change `json:"enumValues"`
+}
+
+// Change describes a term that was added, removed or changed.
+type Change struct {
+	Kind   string        `json:"kind"`
+	Name   string        `json:"name"`
+	Fields []FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange describes a changed attribute of a term. An empty Old or New
+// value means the attribute was added or removed.
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+// Diff compares two models.
+func Diff(from, to *Model) *ModelDiff {
+	d := &ModelDiff{
+		From:       from.SpecVersion,
+		To:         to.SpecVersion,
+		Classes:    []Change{},
+		Properties: []Change{},
+		Enums:      []Change{},
+		EnumValues: []Change{},
+	}
+
+	oldClasses, newClasses := classesByPath(from), classesByPath(to)
+	d.Classes = diffTerms(oldClasses, newClasses, func(o, n *Class) []FieldChange {
+		return diffClass(from, to, o, n)
+	})
+
+	d.Properties = diffTerms(termsByPath(from, from.Properties), termsByPath(to, to.Properties), func(o, n *Property) []FieldChange {
+		var fields []FieldChange
+		fields = appendField(fields, "range", relPath(from, o.Range), relPath(to, n.Range))
+		fields = appendField(fields, "objectProperty", strconv.FormatBool(o.IsObject), strconv.FormatBool(n.IsObject))
+		return fields
+	})
+
+	d.Enums = diffTerms(termsByPath(from, from.Enums), termsByPath(to, to.Enums), func(_, _ *Enum) []FieldChange {
+		return nil
+	})
+
+	d.EnumValues = diffTerms(enumValuesByPath(from), enumValuesByPath(to), func(_, _ *EnumValue) []FieldChange {
+		return nil
+	})
+
+	return d
+}
+
+// diffTerms reports the terms only in before as removed, those only in after
+// as added, and those in both for which fields returns differences as
+// changed, sorted by name.
+func diffTerms[T any](before, after map[string]T, fields func(o, n T) []FieldChange) []Change {
+	changes := []Change{}
+	for name, o := range before {
+		n, ok := after[name]
+		if !ok {
+			changes = append(changes, Change{Kind: ChangeRemoved, Name: name})
+			continue
+		}
+		if f := fields(o, n); len(f) > 0 {
+			changes = append(changes, Change{Kind: ChangeChanged, Name: name, Fields: f})
+		}
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			changes = append(changes, Change{Kind: ChangeAdded, Name: name})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	return changes
+}
+
+// diffClass compares a class and the constraints on its properties.
+func diffClass(from, to *Model, o, n *Class) []FieldChange {
+	var fields []FieldChange
+	fields = appendField(fields, "parent", relPath(from, o.Parent), relPath(to, n.Parent))
+	fields = appendField(fields, "abstract", strconv.FormatBool(o.IsAbstract), strconv.FormatBool(n.IsAbstract))
+	fields = appendField(fields, "nodeKind", o.NodeKind, n.NodeKind)
+
+	oldProps, newProps := propertyRefsByPath(from, o), propertyRefsByPath(to, n)
+	paths := make([]string, 0, len(oldProps)+len(newProps))
+	for path := range oldProps {
+		paths = append(paths, path)
+	}
+	for path := range newProps {
+		if _, ok := oldProps[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		op, np := oldProps[path], newProps[path]
+		switch {
+		case op == nil:
+			fields = append(fields, FieldChange{Field: "property " + path, New: propertyRefSummary(to, np)})
+		case np == nil:
+			fields = append(fields, FieldChange{Field: "property " + path, Old: propertyRefSummary(from, op)})
+		default:
+			prefix := "property " + path + " "
+			fields = appendField(fields, prefix+"minCount", strconv.Itoa(op.MinCount), strconv.Itoa(np.MinCount))
+			fields = appendField(fields, prefix+"maxCount", strconv.Itoa(op.MaxCount), strconv.Itoa(np.MaxCount))
+			fields = appendField(fields, prefix+"datatype", relPath(from, op.DataType), relPath(to, np.DataType))
+			fields = appendField(fields, prefix+"class", relPath(from, op.ClassRef), relPath(to, np.ClassRef))
+			fields = appendField(fields, prefix+"pattern", op.Pattern, np.Pattern)
+		}
+	}
+	return fields
+}
+
+// propertyRefSummary describes the constraints on a property of a class,
+// e.g. "Core/Element [0..1]".
+func propertyRefSummary(m *Model, p *PropertyRef) string {
+	typ := relPath(m, p.ClassRef)
+	if typ == "" {
+		typ = relPath(m, p.DataType)
+	}
+	upper := "*"
+	if p.MaxCount >= 0 {
+		upper = strconv.Itoa(p.MaxCount)
+	}
+	return strings.TrimSpace(typ + " [" + strconv.Itoa(p.MinCount) + ".." + upper + "]")
+}
+
+func appendField(fields []FieldChange, field, before, after string) []FieldChange {
+	if before == after {
+		return fields
+	}
+	return append(fields, FieldChange{Field: field, Old: before, New: after})
+}
+
+// relPath returns the path of an SPDX IRI below the model's base URI.
+// Other IRIs, such as XSD datatypes, are returned unchanged.
+func relPath(m *Model, iri string) string {
+	return strings.TrimPrefix(iri, m.BaseURI)
+}
+
+// classesByPath returns the classes of a model that are not enumerations.
+func classesByPath(m *Model) map[string]*Class {
+	result := make(map[string]*Class)
+	for id, class := range m.Classes {
+		if _, isEnum := m.Enums[id]; !isEnum {
+			result[relPath(m, id)] = class
+		}
+	}
+	return result
+}
+
+func termsByPath[T any](m *Model, terms map[string]T) map[string]T {
+	result := make(map[string]T, len(terms))
+	for id, t := range terms {
+		result[relPath(m, id)] = t
+	}
+	return result
+}
+
+func enumValuesByPath(m *Model) map[string]*EnumValue {
+	result := make(map[string]*EnumValue)
+	for _, enum := range m.Enums {
+		for _, v := range enum.Values {
+			result[relPath(m, v.ID)] = v
+		}
+	}
+	return result
+}
+
+func propertyRefsByPath(m *Model, class *Class) map[string]*PropertyRef {
+	result := make(map[string]*PropertyRef, len(class.Properties))
+	for _, p := range class.Properties {
+		result[relPath(m, p.Path)] = p
+	}
+	return result
+}
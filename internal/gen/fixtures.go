@@ -0,0 +1,258 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// fixtureBase is the IRI prefix of the elements in the generated fixtures.
+const fixtureBase = "https://example.com/spdx/"
+
+// patternSamples are the candidate values for string properties restricted
+// by sh:pattern, after the spec version; the first one matching the pattern
+// is used.
+var patternSamples = []string{"text/plain", "1.0.0", "2024-01-01T00:00:00Z"}
+
+// generateFixtures writes two example documents per concrete element class
+// into the fixtures directory: <type>.minimal.json sets only the required
+// properties and <type>.maximal.json sets all of them, inherited ones
+// included. Referenced elements are added to the graph as minimal instances
+// of the closest concrete class, so every document satisfies the SHACL
+// shapes of the model on its own. Extension classes are left out, as the
+// SPDX context does not define their names.
+func (g *Generator) generateFixtures() error {
+	if err := os.MkdirAll(g.fixturesDir, 0750); err != nil {
+		return fmt.Errorf("create fixtures directory: %w", err)
+	}
+
+	context := strings.TrimSuffix(g.model.BaseURI, "terms/") + "spdx-context.jsonld"
+	for _, class := range g.sortedClasses() {
+		if class.IsAbstract || !g.isElementClass(class.ID) || g.isExtension(class.ID) {
+			continue
+		}
+		for _, variant := range []string{"minimal", "maximal"} {
+			b := &fixtureBuilder{g: g, refs: make(map[string]string)}
+			target, err := b.object(class, variant == "maximal")
+			if err != nil {
+				return fmt.Errorf("class %s: %w", class.Name, err)
+			}
+			target["spdxId"] = fixtureBase + class.Name
+
+			doc := map[string]interface{}{
+				"@context": context,
+				"@graph":   append([]interface{}{target}, b.graph...),
+			}
+			data, err := json.MarshalIndent(doc, "", "  ")
+			if err != nil {
+				return fmt.Errorf("class %s: %w", class.Name, err)
+			}
+			path := filepath.Join(g.fixturesDir, compactName(class.ID)+"."+variant+".json")
+			if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+				return fmt.Errorf("write file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// fixtureBuilder builds the graph of one fixture document.
+type fixtureBuilder struct {
+	g     *Generator
+	graph []interface{}
+	refs  map[string]string // referenced element IRIs by class ID and index
+}
+
+// object returns a node of the given class holding its required properties,
+// or all of them if maximal is set.
+func (b *fixtureBuilder) object(class *Class, maximal bool) (map[string]interface{}, error) {
+	obj := map[string]interface{}{"type": compactName(class.ID)}
+	for _, prop := range b.g.inheritedProperties(class) {
+		if prop.MinCount == 0 && !maximal {
+			continue
+		}
+		n := max(prop.MinCount, 1)
+		values := make([]interface{}, n)
+		for i := range values {
+			v, err := b.value(prop, i, maximal)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", prop.Name, err)
+			}
+			values[i] = v
+		}
+		if prop.MaxCount == 1 {
+			obj[compactName(prop.Path)] = values[0]
+		} else {
+			obj[compactName(prop.Path)] = values
+		}
+	}
+	return obj, nil
+}
+
+// value returns the i-th example value of a property. Distinct indexes give
+// distinct values, as SHACL counts distinct values only.
+func (b *fixtureBuilder) value(prop *PropertyRef, i int, maximal bool) (interface{}, error) {
+	if values := b.g.enumValues(prop); len(values) > 0 {
+		return values[i%len(values)], nil
+	}
+
+	if prop.ClassRef != "" {
+		class := b.g.concreteClass(prop.ClassRef)
+		if class == nil {
+			return nil, fmt.Errorf("no concrete class for %s", prop.ClassRef)
+		}
+		if b.g.isElementClass(class.ID) {
+			return b.ref(class, i)
+		}
+		return b.object(class, maximal)
+	}
+
+	suffix := ""
+	if i > 0 {
+		suffix = fmt.Sprintf("-%d", i+1)
+	}
+	switch prop.DataType {
+	case "http://www.w3.org/2001/XMLSchema#string":
+		if prop.Pattern == "" {
+			return "example " + prop.Name + suffix, nil
+		}
+		re, err := regexp.Compile(prop.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compile pattern: %w", err)
+		}
+		for _, s := range append([]string{b.g.model.SpecVersion}, patternSamples...) {
+			if re.MatchString(s) {
+				return s, nil
+			}
+		}
+		return nil, fmt.Errorf("no sample value matches pattern %s", prop.Pattern)
+	case "http://www.w3.org/2001/XMLSchema#anyURI":
+		return "https://example.com/" + prop.Name + suffix, nil
+	case "http://www.w3.org/2001/XMLSchema#boolean":
+		return i%2 == 0, nil
+	case "http://www.w3.org/2001/XMLSchema#integer", "http://www.w3.org/2001/XMLSchema#positiveInteger", "http://www.w3.org/2001/XMLSchema#nonNegativeInteger":
+		return i + 1, nil
+	case "http://www.w3.org/2001/XMLSchema#decimal":
+		return float64(i) + 0.5, nil
+	case "http://www.w3.org/2001/XMLSchema#dateTimeStamp":
+		return fmt.Sprintf("2024-01-%02dT00:00:00Z", i+1), nil
+	}
+	return nil, fmt.Errorf("no example value for datatype %q", prop.DataType)
+}
+
+// ref returns the IRI of the i-th referenced element of the given class,
+// adding a minimal instance of it to the graph on first use.
+func (b *fixtureBuilder) ref(class *Class, i int) (string, error) {
+	key := fmt.Sprintf("%s#%d", class.ID, i)
+	if iri, ok := b.refs[key]; ok {
+		return iri, nil
+	}
+
+	iri := fixtureBase + "ref/" + class.Name
+	if i > 0 {
+		iri += fmt.Sprintf("-%d", i+1)
+	}
+	// Register before building, so that cyclic references resolve.
+	b.refs[key] = iri
+	obj, err := b.object(class, false)
+	if err != nil {
+		return "", err
+	}
+	obj["spdxId"] = iri
+	b.graph = append(b.graph, obj)
+	return iri, nil
+}
+
+// inheritedProperties returns the properties of a class and its ancestors,
+// the most general class first, without duplicates.
+func (g *Generator) inheritedProperties(class *Class) []*PropertyRef {
+	var chain []*Class
+	for c := class; c != nil; c = g.model.Classes[c.Parent] {
+		chain = append(chain, c)
+	}
+
+	var result []*PropertyRef
+	seen := make(map[string]bool)
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, prop := range chain[i].Properties {
+			if !seen[prop.Path] {
+				seen[prop.Path] = true
+				result = append(result, prop)
+			}
+		}
+	}
+	return result
+}
+
+// isExtension reports whether a term comes from a merged extension model.
+func (g *Generator) isExtension(id string) bool {
+	return !strings.HasPrefix(id, g.model.BaseURI)
+}
+
+// enumValues returns the names of the values a property may take if it is
+// restricted to an enumeration, or nil otherwise.
+func (g *Generator) enumValues(prop *PropertyRef) []string {
+	var values []string
+	for _, v := range prop.InValues {
+		values = append(values, extractName(v))
+	}
+	if len(values) == 0 {
+		if enum, ok := g.model.Enums[prop.ClassRef]; ok {
+			for _, v := range enum.Values {
+				values = append(values, v.Name)
+			}
+		}
+	}
+	sort.Strings(values)
+	return values
+}
+
+// concreteClass returns the class with the given ID if it is concrete, and
+// otherwise the concrete subclass with the fewest required properties, the
+// closest one on a tie, so that references to abstract classes get the
+// simplest possible target.
+func (g *Generator) concreteClass(id string) *Class {
+	if class, ok := g.model.Classes[id]; ok && !class.IsAbstract {
+		return class
+	}
+
+	var best *Class
+	bestRequired, bestDepth := 0, 0
+	for _, class := range g.sortedClasses() {
+		if class.IsAbstract || g.isExtension(class.ID) || !g.isSubclassOf(class.ID, id) {
+			continue
+		}
+		required := 0
+		for _, prop := range g.inheritedProperties(class) {
+			if prop.MinCount > 0 {
+				required++
+			}
+		}
+		depth := 0
+		for c := class; c.ID != id; c = g.model.Classes[c.Parent] {
+			depth++
+		}
+		if best == nil || required < bestRequired || (required == bestRequired && depth < bestDepth) {
+			best, bestRequired, bestDepth = class, required, depth
+		}
+	}
+	return best
+}
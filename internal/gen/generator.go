@@ -22,7 +22,6 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
-	"text/template"
 	"unicode"
 )
 
@@ -35,6 +34,32 @@ type Generator struct {
 	model   *Model
 	pkgName string
 	outDir  string
+
+	// parserDir and modelImport configure the optional generation of the
+	// element parsers; see WithParser.
+	parserDir   string
+	modelImport string
+
+	// fixturesDir configures the optional generation of example documents;
+	// see WithFixtures.
+	fixturesDir string
+
+	// schemaPath configures the optional generation of a JSON Schema; see
+	// WithSchema.
+	schemaPath string
+
+	// protoPath configures the optional generation of Protocol Buffers
+	// definitions; see WithProto.
+	protoPath string
+
+	// profileImport configures the generation of one package per profile;
+	// see WithProfilePackages. profile is the package being generated,
+	// typePackages the package of every type and deps the profile packages
+	// it refers to.
+	profileImport string
+	profile       string
+	typePackages  map[string]string
+	deps          map[string]bool
 }
 
 // NewGenerator creates a new Generator.
@@ -46,12 +71,36 @@ func NewGenerator(model *Model, pkgName, outDir string) *Generator {
 	}
 }
 
-// Generate generates all Go source files.
+// WithParser additionally generates the element parsers into the parser
+// package at dir, which imports the generated model as modelImport.
+func (g *Generator) WithParser(dir, modelImport string) *Generator {
+	g.parserDir = dir
+	g.modelImport = modelImport
+	return g
+}
+
+// WithFixtures additionally writes a minimal and a maximal example JSON-LD
+// document for every concrete element class into dir.
+func (g *Generator) WithFixtures(dir string) *Generator {
+	g.fixturesDir = dir
+	return g
+}
+
+// Generate generates all Go source files. It fails with a *CheckError
+// before writing anything if the model does not pass Check.
 func (g *Generator) Generate() error {
+	if diags := Check(g.model); len(diags) > 0 {
+		return &CheckError{Diagnostics: diags}
+	}
+
 	if err := os.MkdirAll(g.outDir, 0750); err != nil {
 		return fmt.Errorf("create output directory: %w", err)
 	}
 
+	if g.profileImport != "" {
+		return g.generateProfiles()
+	}
+
 	if err := g.generateEnums(); err != nil {
 		return fmt.Errorf("generate enums: %w", err)
 	}
@@ -60,6 +109,69 @@ func (g *Generator) Generate() error {
 		return fmt.Errorf("generate types: %w", err)
 	}
 
+	if err := g.generateValidators(); err != nil {
+		return fmt.Errorf("generate validators: %w", err)
+	}
+
+	if err := g.generateJSON(); err != nil {
+		return fmt.Errorf("generate JSON: %w", err)
+	}
+
+	if err := g.generateInterfaces(); err != nil {
+		return fmt.Errorf("generate interfaces: %w", err)
+	}
+
+	if err := g.generateCopy(); err != nil {
+		return fmt.Errorf("generate copy methods: %w", err)
+	}
+
+	if err := g.generateVisitor(); err != nil {
+		return fmt.Errorf("generate visitor: %w", err)
+	}
+
+	if err := g.generateIRIs(); err != nil {
+		return fmt.Errorf("generate IRIs: %w", err)
+	}
+
+	if err := g.generateContext(); err != nil {
+		return fmt.Errorf("generate context: %w", err)
+	}
+
+	if err := g.generateRegistry(); err != nil {
+		return fmt.Errorf("generate registry: %w", err)
+	}
+
+	if err := g.generateRuntime(); err != nil {
+		return fmt.Errorf("generate runtime: %w", err)
+	}
+
+	if g.parserDir != "" {
+		if err := g.generateParsers(); err != nil {
+			return fmt.Errorf("generate parsers: %w", err)
+		}
+		if err := g.generateDecoders(); err != nil {
+			return fmt.Errorf("generate decoders: %w", err)
+		}
+	}
+
+	if g.fixturesDir != "" {
+		if err := g.generateFixtures(); err != nil {
+			return fmt.Errorf("generate fixtures: %w", err)
+		}
+	}
+
+	if g.schemaPath != "" {
+		if err := g.generateSchema(); err != nil {
+			return fmt.Errorf("generate schema: %w", err)
+		}
+	}
+
+	if g.protoPath != "" {
+		if err := g.generateProto(); err != nil {
+			return fmt.Errorf("generate proto: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -71,8 +183,14 @@ func (g *Generator) generateEnums() error {
 		if ns == "" {
 			ns = "Core"
 		}
+		if !g.inPackage(toGoName(enum.Name)) {
+			continue
+		}
 		enumsByNS[ns] = append(enumsByNS[ns], enum)
 	}
+	if len(enumsByNS) == 0 {
+		return nil
+	}
 
 	// Sort namespaces for deterministic output
 	namespaces := make([]string, 0, len(enumsByNS))
@@ -81,9 +199,7 @@ func (g *Generator) generateEnums() error {
 	}
 	sort.Strings(namespaces)
 
-	var buf bytes.Buffer
-	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
-
+	var body bytes.Buffer
 	for _, ns := range namespaces {
 		enums := enumsByNS[ns]
 		sort.Slice(enums, func(i, j int) bool {
@@ -91,13 +207,13 @@ func (g *Generator) generateEnums() error {
 		})
 
 		for _, enum := range enums {
-			if err := g.writeEnum(&buf, enum); err != nil {
+			if err := g.writeEnum(&body, enum); err != nil {
 				return err
 			}
 		}
 	}
 
-	return g.writeFile("enums_gen.go", buf.Bytes())
+	return g.writeSource("enums_gen.go", body.Bytes())
 }
 
 func (g *Generator) writeEnum(buf *bytes.Buffer, enum *Enum) error {
@@ -141,13 +257,81 @@ func (g *Generator) writeEnum(buf *bytes.Buffer, enum *Enum) error {
 	}
 	buf.WriteString(":\n\t\treturn true\n\tdefault:\n\t\treturn false\n\t}\n}\n\n")
 
+	return g.writeEnumNormalizer(buf, enum)
+}
+
+// writeEnumNormalizer writes Normalize<Enum>, which maps the spellings of an
+// enum value seen in the wild to the canonical camelCase value: the full IRI
+// and the UPPER_SNAKE form of SPDX 2 (e.g. "DEPENDS_ON"), the latter matched
+// case-insensitively and with hyphens accepted for underscores.
+func (g *Generator) writeEnumNormalizer(buf *bytes.Buffer, enum *Enum) error {
+	typeName := toGoName(enum.Name)
+	mapName := strings.ToLower(typeName[:1]) + typeName[1:] + "Spellings"
+
+	seen := make(map[string]string)
+	fmt.Fprintf(buf, "// %s maps the IRI and UPPER_SNAKE spellings of\n// %s values to the values.\n", mapName, typeName)
+	fmt.Fprintf(buf, "var %s = map[string]%s{\n", mapName, typeName)
+	for _, val := range enum.Values {
+		constName := typeName + toGoName(val.Name)
+		for _, key := range []string{val.ID, upperSnake(val.Name)} {
+			if key == "" || key == val.Name {
+				continue
+			}
+			if other, ok := seen[key]; ok {
+				return fmt.Errorf("enum %s: values %s and %s are both spelled %q", enum.Name, other, val.Name, key)
+			}
+			seen[key] = val.Name
+			fmt.Fprintf(buf, "\t%q: %s,\n", key, constName)
+		}
+	}
+	buf.WriteString("}\n\n")
+
+	fmt.Fprintf(buf, "// Normalize%s returns the canonical %s for its camelCase,\n", typeName, typeName)
+	buf.WriteString("// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.\n")
+	fmt.Fprintf(buf, "func Normalize%s(s string) %s {\n", typeName, typeName)
+	fmt.Fprintf(buf, "\tif v := %s(s); v.IsValid() {\n\t\treturn v\n\t}\n", typeName)
+	fmt.Fprintf(buf, "\tif v, ok := %s[s]; ok {\n\t\treturn v\n\t}\n", mapName)
+	fmt.Fprintf(buf, "\tif v, ok := %s[strings.ToUpper(strings.ReplaceAll(s, \"-\", \"_\"))]; ok {\n\t\treturn v\n\t}\n", mapName)
+	fmt.Fprintf(buf, "\treturn %s(s)\n}\n\n", typeName)
+
+	fmt.Fprintf(buf, "// UnmarshalText decodes the value with Normalize%s.\n", typeName)
+	fmt.Fprintf(buf, "func (v *%s) UnmarshalText(text []byte) error {\n\t*v = Normalize%s(string(text))\n\treturn nil\n}\n\n", typeName, typeName)
 	return nil
 }
 
+// upperSnake converts a camelCase enum value to UPPER_SNAKE, e.g.
+// "hasDeclaredLicense" to "HAS_DECLARED_LICENSE".
+func upperSnake(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := rune(name[i-1])
+			if !unicode.IsUpper(prev) && prev != '_' {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
 func (g *Generator) generateTypes() error {
 	// Build class hierarchy
 	hierarchy := g.buildHierarchy()
 
+	var body bytes.Buffer
+	for _, class := range g.profileClasses() {
+		if err := g.writeClass(&body, class, hierarchy); err != nil {
+			return err
+		}
+	}
+
+	return g.writeSource("types_gen.go", body.Bytes())
+}
+
+// sortedClasses returns the classes to generate, ordered by namespace and
+// then by name. Classes that are enum types are skipped.
+func (g *Generator) sortedClasses() []*Class {
 	// Group classes by namespace, filtering out enum types
 	classesByNS := make(map[string][]*Class)
 	for id, class := range g.model.Classes {
@@ -169,24 +353,15 @@ func (g *Generator) generateTypes() error {
 	}
 	sort.Strings(namespaces)
 
-	var buf bytes.Buffer
-	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
-	buf.WriteString("import (\n\t\"time\"\n)\n\n")
-
+	var sorted []*Class
 	for _, ns := range namespaces {
 		classes := classesByNS[ns]
 		sort.Slice(classes, func(i, j int) bool {
 			return classes[i].Name < classes[j].Name
 		})
-
-		for _, class := range classes {
-			if err := g.writeClass(&buf, class, hierarchy); err != nil {
-				return err
-			}
-		}
+		sorted = append(sorted, classes...)
 	}
-
-	return g.writeFile("types_gen.go", buf.Bytes())
+	return sorted
 }
 
 func (g *Generator) writeClass(buf *bytes.Buffer, class *Class, _ map[string][]string) error {
@@ -203,14 +378,8 @@ func (g *Generator) writeClass(buf *bytes.Buffer, class *Class, _ map[string][]s
 	fmt.Fprintf(buf, "type %s struct {\n", typeName)
 
 	// Embed parent type if exists
-	parentFields := make(map[string]bool) // track fields from parent to avoid duplicates
-	if class.Parent != "" {
-		parentName := extractName(class.Parent)
-		if strings.HasPrefix(class.Parent, spdxBaseURI) {
-			fmt.Fprintf(buf, "\t%s\n", toGoName(parentName))
-			// Collect parent fields
-			g.collectParentFields(class.Parent, parentFields)
-		}
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		fmt.Fprintf(buf, "\t%s\n", g.qualify(toGoName(extractName(class.Parent))))
 	}
 
 	// Add spdxId for Element base type
@@ -218,13 +387,64 @@ func (g *Generator) writeClass(buf *bytes.Buffer, class *Class, _ map[string][]s
 		buf.WriteString("\tSpdxID string `json:\"spdxId\"`\n")
 	}
 
-	// Track embedded type name
+	// Write properties
+	for _, f := range g.classFields(class) {
+		prop := f.Prop
+		jsonTag := prop.Name
+
+		// Add omitempty for optional fields
+		omitempty := ""
+		if prop.MinCount == 0 {
+			omitempty = ",omitempty"
+		}
+
+		// Build validation tag
+		validateTag := g.buildValidateTag(prop)
+
+		if validateTag != "" {
+			fmt.Fprintf(buf, "\t%s %s `json:\"%s%s\" validate:\"%s\"`\n", f.Name, f.Type, jsonTag, omitempty, validateTag)
+		} else {
+			fmt.Fprintf(buf, "\t%s %s `json:\"%s%s\"`\n", f.Name, f.Type, jsonTag, omitempty)
+		}
+	}
+
+	buf.WriteString("}\n\n")
+
+	return nil
+}
+
+// field is a struct field generated for a class property.
+type field struct {
+	Name     string       // Go field name
+	Type     string       // Go type, including any slice or pointer prefix
+	BaseType string       // Go type without slice or pointer prefix
+	Prop     *PropertyRef // Property the field was generated from
+}
+
+// IsSlice reports whether the field holds multiple values.
+func (f field) IsSlice() bool { return strings.HasPrefix(f.Type, "[]") }
+
+// IsPointer reports whether the field is an optional reference.
+func (f field) IsPointer() bool { return strings.HasPrefix(f.Type, "*") }
+
+// IsInterface reports whether the field holds values of an abstract nested
+// class by its interface.
+func (f field) IsInterface() bool { return strings.HasSuffix(f.Type, "Interface") }
+
+// classFields returns the fields declared directly on a class, excluding
+// those inherited from its parents.
+func (g *Generator) classFields(class *Class) []field {
+	// Track fields from parent to avoid duplicates
+	parentFields := make(map[string]bool)
 	embeddedTypeName := ""
 	if class.Parent != "" {
 		embeddedTypeName = toGoName(extractName(class.Parent))
+		if isTermIRI(class.Parent) {
+			g.collectParentFields(class.Parent, parentFields)
+		}
 	}
 
-	// Write properties
+	var fields []field
 	seenFields := make(map[string]bool)
 	for _, prop := range class.Properties {
 		fieldName := toGoName(prop.Name)
@@ -245,13 +465,15 @@ func (g *Generator) writeClass(buf *bytes.Buffer, class *Class, _ map[string][]s
 			fieldName += "s" // pluralize to avoid collision
 		}
 
-		fieldType := g.resolveType(prop)
-		jsonTag := prop.Name
+		baseType := g.resolveType(prop)
+		fieldType := g.qualify(baseType)
 
-		// Add omitempty for optional fields
-		omitempty := ""
-		if prop.MinCount == 0 {
-			omitempty = ",omitempty"
+		// Values of abstract nested classes are held by the interface of
+		// the class, so that they keep their concrete type (e.g. a Hash
+		// in verifiedUsing).
+		polymorphic := g.isAbstractObject(prop)
+		if polymorphic {
+			fieldType += "Interface"
 		}
 
 		// Determine if it's a slice
@@ -260,23 +482,19 @@ func (g *Generator) writeClass(buf *bytes.Buffer, class *Class, _ map[string][]s
 		}
 
 		// Add pointer for optional non-slice reference types
-		if prop.MinCount == 0 && prop.MaxCount == 1 && g.isReferenceType(fieldType) {
+		if prop.MinCount == 0 && prop.MaxCount == 1 && g.isReferenceType(baseType) && !polymorphic {
 			fieldType = "*" + fieldType
 		}
 
-		// Build validation tag
-		validateTag := g.buildValidateTag(prop)
-
-		if validateTag != "" {
-			fmt.Fprintf(buf, "\t%s %s `json:\"%s%s\" validate:\"%s\"`\n", fieldName, fieldType, jsonTag, omitempty, validateTag)
-		} else {
-			fmt.Fprintf(buf, "\t%s %s `json:\"%s%s\"`\n", fieldName, fieldType, jsonTag, omitempty)
-		}
+		fields = append(fields, field{
+			Name:     fieldName,
+			Type:     fieldType,
+			BaseType: baseType,
+			Prop:     prop,
+		})
 	}
 
-	buf.WriteString("}\n\n")
-
-	return nil
+	return fields
 }
 
 // buildValidateTag creates validation tags for a property.
@@ -356,8 +574,8 @@ func (g *Generator) resolveType(prop *PropertyRef) string {
 	case "http://www.w3.org/2001/XMLSchema#anyURI":
 		return stringType
 	default:
-		// Check if it's an SPDX type
-		if strings.HasPrefix(prop.DataType, spdxBaseURI) {
+		// Check if it's an SPDX or extension type
+		if isTermIRI(prop.DataType) {
 			return toGoName(extractName(prop.DataType))
 		}
 		return "interface{}"
@@ -375,18 +593,22 @@ func (g *Generator) buildHierarchy() map[string][]string {
 }
 
 func (g *Generator) writeFile(filename string, content []byte) error {
+	return writeFileIn(g.outDir, filename, content)
+}
+
+func writeFileIn(dir, filename string, content []byte) error {
 	// Format the generated code
 	formatted, err := format.Source(content)
 	if err != nil {
 		// Write unformatted content for debugging
-		path := filepath.Join(g.outDir, filename)
+		path := filepath.Join(dir, filename)
 		if writeErr := os.WriteFile(path, content, 0600); writeErr != nil {
 			return fmt.Errorf("write unformatted file: %w", writeErr)
 		}
 		return fmt.Errorf("format source: %w (unformatted written to %s)", err, path)
 	}
 
-	path := filepath.Join(g.outDir, filename)
+	path := filepath.Join(dir, filename)
 	if err := os.WriteFile(path, formatted, 0600); err != nil {
 		return fmt.Errorf("write file: %w", err)
 	}
@@ -443,31 +665,7 @@ func formatComment(comment string) string {
 // isReferenceType returns true if the type should be a pointer when optional.
 func (g *Generator) isReferenceType(typeName string) bool {
 	// Enums are generated as string types, so they are not reference types.
-	if _, isEnum := g.model.Enums[spdxBaseURI+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"Core/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"Software/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"Security/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"Licensing/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"ExpandedLicensing/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"Dataset/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"AI/"+typeName]; isEnum {
-		return false
-	}
-	if _, isEnum := g.model.Enums[spdxBaseURI+"Build/"+typeName]; isEnum {
+	if g.isEnumType(typeName) {
 		return false
 	}
 
@@ -478,5 +676,3 @@ func (g *Generator) isReferenceType(typeName string) bool {
 		return true
 	}
 }
-
-var _ = template.New // Ensure template package is imported for future use
@@ -0,0 +1,128 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// generateInterfaces writes interfaces_gen.go, which declares a getter
+// interface per class (e.g. PackageInterface) that embeds the interface of
+// its parent, together with the getter methods that implement it.
+func (g *Generator) generateInterfaces() error {
+	var body bytes.Buffer
+	for _, class := range g.profileClasses() {
+		g.writeInterface(&body, class)
+	}
+
+	return g.writeSource("interfaces_gen.go", body.Bytes())
+}
+
+// getter describes an accessor generated for a field.
+type getter struct {
+	Name       string
+	ReturnType string
+	Expr       string
+}
+
+// getters returns the accessors for the fields declared directly on a class.
+// Nested objects that are not elements are returned by pointer so callers
+// can modify them in place; everything else is returned as stored.
+func (g *Generator) getters(class *Class) []getter {
+	var result []getter
+	if class.Name == "Element" {
+		result = append(result, getter{Name: "GetSpdxID", ReturnType: stringType, Expr: "o.SpdxID"})
+	}
+	for _, f := range g.classFields(class) {
+		gt := getter{
+			Name:       "Get" + goInitialisms(f.Name),
+			ReturnType: f.Type,
+			Expr:       "o." + f.Name,
+		}
+		if g.isNestedObject(f) {
+			gt.ReturnType = "*" + f.Type
+			gt.Expr = "&o." + f.Name
+		}
+		result = append(result, gt)
+	}
+	return result
+}
+
+func (g *Generator) writeInterface(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+	ifaceName := typeName + "Interface"
+
+	fmt.Fprintf(buf, "// %s is implemented by %s and the classes derived from it.\n", ifaceName, typeName)
+	fmt.Fprintf(buf, "type %s interface {\n", ifaceName)
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		fmt.Fprintf(buf, "\t%sInterface\n", g.qualify(toGoName(extractName(class.Parent))))
+	}
+	getters := g.getters(class)
+	for _, gt := range getters {
+		fmt.Fprintf(buf, "\t%s() %s\n", gt.Name, gt.ReturnType)
+	}
+	buf.WriteString("}\n\n")
+
+	for _, gt := range getters {
+		fmt.Fprintf(buf, "// %s returns the %s property of the %s.\n", gt.Name, strings.TrimPrefix(gt.Name, "Get"), typeName)
+		fmt.Fprintf(buf, "func (o *%s) %s() %s {\n\treturn %s\n}\n\n", typeName, gt.Name, gt.ReturnType, gt.Expr)
+	}
+}
+
+// isNestedObject returns true if the field holds a single inline object of
+// a non-element class by value.
+func (g *Generator) isNestedObject(f field) bool {
+	if f.IsSlice() || f.IsPointer() || f.IsInterface() || f.Prop.ClassRef == "" || g.isEnumType(f.BaseType) {
+		return false
+	}
+	return !g.isElementClass(f.Prop.ClassRef)
+}
+
+// isAbstractObject returns true if the property holds inline objects of an
+// abstract class that is not an element, such as IntegrityMethod.
+func (g *Generator) isAbstractObject(prop *PropertyRef) bool {
+	if prop.ClassRef == "" || g.isElementClass(prop.ClassRef) {
+		return false
+	}
+	class, ok := g.model.Classes[prop.ClassRef]
+	return ok && class.IsAbstract
+}
+
+// goInitialisms upper-cases the Url and Id words of a Go name, so that a
+// field named PackageUrl gets a GetPackageURL accessor.
+func goInitialisms(name string) string {
+	var words []string
+	start := 0
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			words = append(words, name[start:i])
+			start = i
+		}
+	}
+	words = append(words, name[start:])
+
+	for i, w := range words {
+		switch w {
+		case "Url":
+			words[i] = "URL"
+		case "Id":
+			words[i] = "ID"
+		}
+	}
+	return strings.Join(words, "")
+}
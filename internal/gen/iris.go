@@ -0,0 +1,124 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// iriConst is a generated IRI constant.
+type iriConst struct {
+	Name string
+	IRI  string
+	Term string // "class" or "property"
+	Spec string // the term's name in the spec
+}
+
+// generateIRIs writes iris_gen.go, which declares the full IRI of every
+// class and property of the model, e.g. IRIPackage and IRIPackageVersion.
+func (g *Generator) generateIRIs() error {
+	classes, properties, err := g.iriConsts()
+	if err != nil {
+		return err
+	}
+	classes, properties = g.profileIRIs(classes), g.profileIRIs(properties)
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
+	buf.WriteString("// IRIs of the SPDX classes, enumeration types included.\nconst (\n")
+	writeIRIConsts(&buf, classes)
+	buf.WriteString(")\n\n")
+	buf.WriteString("// IRIs of the SPDX properties.\nconst (\n")
+	writeIRIConsts(&buf, properties)
+	buf.WriteString(")\n")
+
+	return g.writeFile("iris_gen.go", buf.Bytes())
+}
+
+func writeIRIConsts(buf *bytes.Buffer, consts []iriConst) {
+	for _, c := range consts {
+		fmt.Fprintf(buf, "\t// %s is the IRI of the %s %s.\n", c.Name, c.Spec, c.Term)
+		fmt.Fprintf(buf, "\t%s = %q\n", c.Name, c.IRI)
+	}
+}
+
+// iriConsts names the IRI constants of the classes and properties, sorted by
+// name. Class constants are named after the class. Property constants are
+// named after the property, qualified with the profile when several profiles
+// declare a property of the same name (the Core one stays unqualified), and
+// suffixed with "Property" when they would clash with a class constant.
+func (g *Generator) iriConsts() (classes, properties []iriConst, err error) {
+	taken := make(map[string]string)
+	for _, class := range g.model.Classes {
+		name := "IRI" + toGoName(class.Name)
+		if other, ok := taken[name]; ok {
+			return nil, nil, fmt.Errorf("classes %s and %s both map to %s", other, class.ID, name)
+		}
+		taken[name] = class.ID
+		classes = append(classes, iriConst{Name: name, IRI: class.ID, Term: "class", Spec: class.Name})
+	}
+
+	byName := make(map[string][]*Property)
+	for _, prop := range g.model.Properties {
+		name := toGoName(prop.Name)
+		byName[name] = append(byName[name], prop)
+	}
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		props := byName[name]
+		sort.Slice(props, func(i, j int) bool {
+			if (props[i].Namespace == "Core") != (props[j].Namespace == "Core") {
+				return props[i].Namespace == "Core"
+			}
+			return props[i].ID < props[j].ID
+		})
+		for i, prop := range props {
+			constName := "IRI" + name
+			if i > 0 {
+				constName = "IRI" + toGoName(prop.Namespace) + name
+			}
+			if _, ok := taken[constName]; ok {
+				constName += "Property"
+			}
+			if other, ok := taken[constName]; ok {
+				return nil, nil, fmt.Errorf("%s and %s both map to %s", other, prop.ID, constName)
+			}
+			taken[constName] = prop.ID
+			properties = append(properties, iriConst{Name: constName, IRI: prop.ID, Term: "property", Spec: prop.Name})
+		}
+	}
+
+	sort.Slice(classes, func(i, j int) bool { return classes[i].Name < classes[j].Name })
+	sort.Slice(properties, func(i, j int) bool { return properties[i].Name < properties[j].Name })
+	return classes, properties, nil
+}
+
+// profileIRIs returns the constants of the package being generated. The
+// constants keep the names they have in a single package.
+func (g *Generator) profileIRIs(consts []iriConst) []iriConst {
+	var result []iriConst
+	for _, c := range consts {
+		if c.Term == "class" && g.inPackage(toGoName(c.Spec)) || c.Term == "property" && g.inProfile(extractNamespace(c.IRI)) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// generateJSON writes json_gen.go, which (de)serializes every class using
+// the compact JSON-LD names of the SPDX context: profile-prefixed property
+// and type names, with inherited properties flattened into one object and
+// element references written as IRIs.
+func (g *Generator) generateJSON() error {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
+
+	for _, class := range g.sortedClasses() {
+		g.writeMarshal(&buf, class)
+		g.writeUnmarshal(&buf, class)
+	}
+
+	return g.writeFile("json_gen.go", buf.Bytes())
+}
+
+func (g *Generator) writeMarshal(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+
+	fmt.Fprintf(buf, "// MarshalJSON encodes the %s as a compact SPDX JSON-LD object.\n", typeName)
+	fmt.Fprintf(buf, "func (o %s) MarshalJSON() ([]byte, error) {\n", typeName)
+	fmt.Fprintf(buf, "\tw := newJSONObject(%q)\n\to.marshalFields(w)\n\treturn w.bytes()\n}\n\n", compactName(class.ID))
+
+	fmt.Fprintf(buf, "func (o *%s) marshalFields(w *jsonObject) {\n", typeName)
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		fmt.Fprintf(buf, "\to.%s.marshalFields(w)\n", toGoName(extractName(class.Parent)))
+	}
+	if class.Name == "Element" {
+		buf.WriteString("\tw.put(\"spdxId\", o.SpdxID)\n")
+	}
+	for _, f := range g.classFields(class) {
+		name := compactName(f.Prop.Path)
+		ref := "o." + f.Name
+
+		if g.isElementRef(f) {
+			switch {
+			case f.IsSlice():
+				fmt.Fprintf(buf, "\tw.refs(%q, refIDs(%s))\n", name, ref)
+			case f.IsPointer():
+				fmt.Fprintf(buf, "\tif %s != nil {\n\t\tw.ref(%q, %s.SpdxID)\n\t}\n", ref, name, ref)
+			default:
+				fmt.Fprintf(buf, "\tw.ref(%q, %s.SpdxID)\n", name, ref)
+			}
+			continue
+		}
+		fmt.Fprintf(buf, "\tw.put(%q, %s)\n", name, ref)
+	}
+	buf.WriteString("}\n\n")
+}
+
+func (g *Generator) writeUnmarshal(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+
+	fmt.Fprintf(buf, "// UnmarshalJSON decodes the %s from a compact SPDX JSON-LD object.\n", typeName)
+	if g.isElementClass(class.ID) {
+		buf.WriteString("// A bare string is decoded as a reference to the element with that ID.\n")
+	}
+	fmt.Fprintf(buf, "func (o *%s) UnmarshalJSON(data []byte) error {\n", typeName)
+	if g.isElementClass(class.ID) {
+		fmt.Fprintf(buf, "\tif id, ok := jsonRef(data); ok {\n\t\t*o = %s{}\n\t\to.SpdxID = id\n\t\treturn nil\n\t}\n", typeName)
+	} else {
+		// References to blank nodes cannot be resolved without the graph.
+		buf.WriteString("\tif _, ok := jsonRef(data); ok {\n\t\treturn nil\n\t}\n")
+	}
+	buf.WriteString("\tn, err := decodeJSONNode(data)\n\tif err != nil {\n\t\treturn err\n\t}\n\treturn o.unmarshalFields(n)\n}\n\n")
+
+	fmt.Fprintf(buf, "func (o *%s) unmarshalFields(n jsonNode) error {\n", typeName)
+	buf.WriteString("\treturn firstError(\n")
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		fmt.Fprintf(buf, "\t\to.%s.unmarshalFields(n),\n", toGoName(extractName(class.Parent)))
+	}
+	if class.Name == "Element" {
+		buf.WriteString("\t\tn.id(&o.SpdxID),\n")
+	}
+	for _, f := range g.classFields(class) {
+		if f.IsInterface() {
+			// Decoded as the class named by their type property.
+			fmt.Fprintf(buf, "\t\tgetTyped(n, %q, %q, &o.%s),\n", compactName(f.Prop.Path), compactName(f.Prop.ClassRef), f.Name)
+			continue
+		}
+		fmt.Fprintf(buf, "\t\tn.get(%q, &o.%s),\n", compactName(f.Prop.Path), f.Name)
+	}
+	buf.WriteString("\t)\n}\n\n")
+}
+
+// isElementRef returns true if the field references elements, which are
+// serialized as IRIs rather than inline objects.
+func (g *Generator) isElementRef(f field) bool {
+	return f.Prop.ClassRef != "" && !g.isEnumType(f.BaseType) && g.isElementClass(f.Prop.ClassRef)
+}
+
+// compactName returns the compact JSON-LD term for an SPDX class or
+// property IRI. Core terms are unprefixed; terms from other profiles are
+// prefixed with the lowercased profile name, e.g. "software_packageVersion".
+func compactName(iri string) string {
+	name := extractName(iri)
+	ns := extractNamespace(iri)
+	if ns == "" || ns == "Core" {
+		return name
+	}
+	return strings.ToLower(ns) + "_" + name
+}
@@ -15,12 +15,18 @@
 // Package gen provides types and functions for parsing SPDX model specifications.
 package gen
 
+import (
+	"fmt"
+)
+
 // Model represents the parsed SPDX specification model.
 type Model struct {
 	SpecVersion string // e.g., "3.0.1"
+	BaseURI     string // e.g., "https://spdx.org/rdf/3.0.1/terms/"
 	Classes     map[string]*Class
 	Properties  map[string]*Property
 	Enums       map[string]*Enum
+	Extensions  []string // IRI prefixes of the merged extension models
 }
 
 // Class represents an SPDX class definition.
@@ -55,6 +61,7 @@ type PropertyRef struct {
 	NodeKind string
 	ClassRef string   // If referencing another class
 	InValues []string // For enums with sh:in
+	Pattern  string   // Regular expression values must match (sh:pattern)
 }
 
 // Enum represents an enumeration type.
@@ -74,6 +81,12 @@ type EnumValue struct {
 	Comment string
 }
 
+// IRI returns the full IRI of a term given its path below the base URI,
+// e.g. "Core/Element".
+func (m *Model) IRI(path string) string {
+	return m.BaseURI + path
+}
+
 // NewModel creates a new empty Model.
 func NewModel() *Model {
 	return &Model{
@@ -82,3 +95,37 @@ func NewModel() *Model {
 		Enums:      make(map[string]*Enum),
 	}
 }
+
+// merge adds the terms of an extension model to m.
+func (m *Model) merge(ext *Model) error {
+	goNames := make(map[string]string)
+	for id, class := range m.Classes {
+		goNames[toGoName(class.Name)] = id
+	}
+	for id, class := range ext.Classes {
+		if _, ok := m.Classes[id]; ok {
+			return fmt.Errorf("class %s is already defined", id)
+		}
+		if other, ok := goNames[toGoName(class.Name)]; ok {
+			return fmt.Errorf("classes %s and %s both map to Go type %s", other, id, toGoName(class.Name))
+		}
+		goNames[toGoName(class.Name)] = id
+	}
+	for id := range ext.Properties {
+		if _, ok := m.Properties[id]; ok {
+			return fmt.Errorf("property %s is already defined", id)
+		}
+	}
+
+	for id, class := range ext.Classes {
+		m.Classes[id] = class
+	}
+	for id, prop := range ext.Properties {
+		m.Properties[id] = prop
+	}
+	for id, enum := range ext.Enums {
+		m.Enums[id] = enum
+	}
+	m.Extensions = append(m.Extensions, ext.BaseURI)
+	return nil
+}
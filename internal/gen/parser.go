@@ -31,7 +31,6 @@ const (
 	rdfsComment         = "http://www.w3.org/2000/01/rdf-schema#comment"
 	rdfsLabel           = "http://www.w3.org/2000/01/rdf-schema#label"
 	rdfsRange           = "http://www.w3.org/2000/01/rdf-schema#range"
-	shaclNodeShape      = "http://www.w3.org/ns/shacl#NodeShape"
 	shaclProperty       = "http://www.w3.org/ns/shacl#property"
 	shaclPath           = "http://www.w3.org/ns/shacl#path"
 	shaclDatatype       = "http://www.w3.org/ns/shacl#datatype"
@@ -40,8 +39,12 @@ const (
 	shaclMaxCount       = "http://www.w3.org/ns/shacl#maxCount"
 	shaclNodeKind       = "http://www.w3.org/ns/shacl#nodeKind"
 	shaclIn             = "http://www.w3.org/ns/shacl#in"
+	shaclPattern        = "http://www.w3.org/ns/shacl#pattern"
 	shaclMessage        = "http://www.w3.org/ns/shacl#message"
-	spdxBaseURI         = "https://spdx.org/rdf/3.0.1/terms/"
+
+	// SPDX IRIs have the form https://spdx.org/rdf/<version>/terms/<path>.
+	spdxIRIPrefix = "https://spdx.org/rdf/"
+	spdxTermsPath = "/terms/"
 )
 
 // RDFNode represents a node in the JSON-LD graph.
@@ -62,20 +65,80 @@ func NewParser() *Parser {
 }
 
 // ParseFile parses an SPDX model JSON-LD file.
-//
-//nolint:gocognit // Code generator with multiple parsing passes; complexity is acceptable for tooling.
 func (p *Parser) ParseFile(path string) (*Model, error) {
+	if err := p.load(path); err != nil {
+		return nil, err
+	}
+
+	model := NewModel()
+
+	// The spec version is taken from the IRIs of the classes it defines.
+	for id, node := range p.nodes {
+		if version, _, ok := splitSpdxIRI(id); ok && p.containsType(p.getTypes(node), owlClass) {
+			model.SpecVersion = version
+			model.BaseURI = spdxIRIPrefix + version + spdxTermsPath
+			break
+		}
+	}
+	if model.BaseURI == "" {
+		return nil, fmt.Errorf("no SPDX classes found in %s", path)
+	}
+
+	p.collect(model, model.BaseURI)
+	return model, nil
+}
+
+// ParseExtensionFile parses a JSON-LD model file of organization-specific
+// classes and properties, written like the SPDX model, and merges it into
+// base. The terms of the extension must have IRIs of the form
+// <prefix>/terms/<Namespace>/<Name>, with the same prefix throughout, and
+// may refer to and subclass the terms of base. Terms that base already
+// defines, and classes that would get the same Go name as one in base, are
+// rejected. Like ParseFile, it must be called on a new Parser.
+func (p *Parser) ParseExtensionFile(path string, base *Model) error {
+	if err := p.load(path); err != nil {
+		return err
+	}
+
+	termBase := ""
+	for id, node := range p.nodes {
+		if !p.containsType(p.getTypes(node), owlClass) || isSpdxIRI(id) {
+			continue
+		}
+		b, rest, ok := splitTermIRI(id)
+		if !ok || !strings.Contains(rest, "/") {
+			return fmt.Errorf("extension class %s is not of the form <prefix>/terms/<Namespace>/<Name>", id)
+		}
+		if termBase != "" && b != termBase {
+			return fmt.Errorf("extension classes have different prefixes: %s and %s", termBase, b)
+		}
+		termBase = b
+	}
+	if termBase == "" {
+		return fmt.Errorf("no extension classes found in %s", path)
+	}
+
+	ext := NewModel()
+	ext.BaseURI = termBase
+	p.collect(ext, termBase)
+	if err := base.merge(ext); err != nil {
+		return fmt.Errorf("merge %s: %w", path, err)
+	}
+	return nil
+}
+
+// load reads a JSON-LD file and indexes its nodes by @id.
+func (p *Parser) load(path string) error {
 	data, err := os.ReadFile(filepath.Clean(path))
 	if err != nil {
-		return nil, fmt.Errorf("read file: %w", err)
+		return fmt.Errorf("read file: %w", err)
 	}
 
 	var nodes []RDFNode
 	if err := json.Unmarshal(data, &nodes); err != nil {
-		return nil, fmt.Errorf("unmarshal JSON-LD: %w", err)
+		return fmt.Errorf("unmarshal JSON-LD: %w", err)
 	}
 
-	// Index all nodes by their @id
 	for _, node := range nodes {
 		id := p.getString(node, "@id")
 		if id == "" {
@@ -87,9 +150,15 @@ func (p *Parser) ParseFile(path string) (*Model, error) {
 			p.nodes[id] = node
 		}
 	}
+	return nil
+}
 
-	model := NewModel()
-
+// collect adds the classes, properties and enumerations of the loaded nodes
+// to the model. Enumerations are the classes below termBase that named
+// individuals are typed with.
+//
+//nolint:gocognit // Code generator with multiple parsing passes; complexity is acceptable for tooling.
+func (p *Parser) collect(model *Model, termBase string) {
 	// First pass: collect all classes, properties, and enum types
 	for id, node := range p.nodes {
 		types := p.getTypes(node)
@@ -123,7 +192,7 @@ func (p *Parser) ParseFile(path string) (*Model, error) {
 		if p.containsType(types, owlNamedIndividual) {
 			// Find which enum type this belongs to
 			for _, t := range types {
-				if strings.HasPrefix(t, spdxBaseURI) && t != owlNamedIndividual {
+				if strings.HasPrefix(t, termBase) && t != owlNamedIndividual {
 					enumID := t
 					enum, exists := model.Enums[enumID]
 					if !exists {
@@ -160,11 +229,10 @@ func (p *Parser) ParseFile(path string) (*Model, error) {
 		}
 	}
 
-	// Third pass: parse SHACL property shapes for classes
+	// Third pass: parse SHACL property shapes for classes. Some classes that
+	// only carry the abstract marker are not typed sh:NodeShape.
 	for id, node := range p.nodes {
-		types := p.getTypes(node)
-
-		if p.containsType(types, shaclNodeShape) {
+		if len(p.getArray(node, shaclProperty)) > 0 {
 			class, exists := model.Classes[id]
 			if !exists {
 				continue
@@ -196,8 +264,6 @@ func (p *Parser) ParseFile(path string) (*Model, error) {
 			}
 		}
 	}
-
-	return model, nil
 }
 
 func (p *Parser) parseClass(id string, node RDFNode) *Class {
@@ -330,6 +396,17 @@ func (p *Parser) parsePropertyRef(node RDFNode) *PropertyRef {
 		}
 	}
 
+	// Get pattern
+	patternArr := p.getArray(node, shaclPattern)
+	if len(patternArr) > 0 {
+		var val struct {
+			Value string `json:"@value"`
+		}
+		if err := json.Unmarshal(patternArr[0], &val); err == nil {
+			pr.Pattern = val.Value
+		}
+	}
+
 	// Get sh:in values for enums
 	inArr := p.getArray(node, shaclIn)
 	if len(inArr) > 0 {
@@ -457,18 +534,56 @@ func extractName(iri string) string {
 	return iri
 }
 
-// extractNamespace extracts the namespace from an SPDX IRI.
+// extractNamespace extracts the namespace from an SPDX or extension IRI.
 func extractNamespace(iri string) string {
-	if !strings.HasPrefix(iri, spdxBaseURI) {
+	_, rest, ok := splitTermIRI(iri)
+	if !ok {
 		return ""
 	}
-	rest := strings.TrimPrefix(iri, spdxBaseURI)
 	if idx := strings.Index(rest, "/"); idx >= 0 {
 		return rest[:idx]
 	}
 	return rest
 }
 
+// splitSpdxIRI splits an SPDX IRI into the spec version and the path below
+// terms/, e.g. "3.0.1" and "Core/Element".
+func splitSpdxIRI(iri string) (version, path string, ok bool) {
+	rest, ok := strings.CutPrefix(iri, spdxIRIPrefix)
+	if !ok {
+		return "", "", false
+	}
+	version, path, ok = strings.Cut(rest, spdxTermsPath)
+	if !ok || version == "" {
+		return "", "", false
+	}
+	return version, path, true
+}
+
+// splitTermIRI splits the IRI of an SPDX or extension term into the prefix
+// up to and including /terms/ and the path below it, e.g.
+// "https://spdx.org/rdf/3.0.1/terms/" and "Core/Element".
+func splitTermIRI(iri string) (base, path string, ok bool) {
+	idx := strings.Index(iri, spdxTermsPath)
+	if idx < 0 || !strings.Contains(iri[:idx], "://") || idx+len(spdxTermsPath) == len(iri) {
+		return "", "", false
+	}
+	return iri[:idx+len(spdxTermsPath)], iri[idx+len(spdxTermsPath):], true
+}
+
+// isTermIRI returns true if iri is a term of an SPDX spec version or of an
+// extension model.
+func isTermIRI(iri string) bool {
+	_, _, ok := splitTermIRI(iri)
+	return ok
+}
+
+// isSpdxIRI returns true if iri is a term of any SPDX spec version.
+func isSpdxIRI(iri string) bool {
+	_, _, ok := splitSpdxIRI(iri)
+	return ok
+}
+
 // extractEnumValueName extracts the enum value name from an IRI.
 // e.g., "https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha256" -> "sha256"
 func extractEnumValueName(iri string) string {
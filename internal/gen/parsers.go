@@ -0,0 +1,183 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+)
+
+// helperGetters maps the Go types read directly from a JSON map to the
+// Helpers method that reads them.
+var helperGetters = map[string]string{
+	stringType:        "GetString",
+	"[]" + stringType: "GetStringSlice",
+	"bool":            "GetBool",
+	"int":             "GetInt",
+	"float64":         "GetFloat",
+	"time.Time":       "GetTime",
+}
+
+// generateParsers writes parse_gen.go into the parser package. It declares
+// a Parse<Class> method for every class that reads all of the class's
+// properties, inherited ones included, from a raw JSON-LD map, and a
+// dispatch from compact type names to those methods.
+//
+// The generated code calls into the handwritten parts of the parser package:
+// the Helpers getters, node for element references and normalize for the
+// normalization the model does not describe.
+func (g *Generator) generateParsers() error {
+	if err := os.MkdirAll(g.parserDir, 0750); err != nil {
+		return fmt.Errorf("create parser directory: %w", err)
+	}
+
+	classes := g.sortedClasses()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage parser\n\n")
+	fmt.Fprintf(&buf, "import (\n\t%s %q\n)\n\n", g.pkgName, g.modelImport)
+
+	buf.WriteString("// parseType parses elemMap as the class with the given compact JSON-LD\n")
+	buf.WriteString("// type name. Abstract classes are included so that documents using them\n")
+	buf.WriteString("// still parse into the closest available type.\n")
+	buf.WriteString("func (p *ElementParser) parseType(typ string, elemMap map[string]interface{}) (interface{}, bool) {\n\tswitch typ {\n")
+	for _, class := range classes {
+		fmt.Fprintf(&buf, "\tcase %q:\n\t\treturn p.Parse%s(elemMap), true\n", compactName(class.ID), toGoName(class.Name))
+	}
+	buf.WriteString("\t}\n\treturn nil, false\n}\n\n")
+
+	for _, class := range classes {
+		if err := g.writeParser(&buf, class); err != nil {
+			return fmt.Errorf("class %s: %w", class.Name, err)
+		}
+	}
+	for _, class := range classes {
+		if class.IsAbstract && !g.isElementClass(class.ID) {
+			g.writeAbstractParser(&buf, class)
+		}
+	}
+
+	return writeFileIn(g.parserDir, "parse_gen.go", buf.Bytes())
+}
+
+func (g *Generator) writeParser(buf *bytes.Buffer, class *Class) error {
+	typeName := toGoName(class.Name)
+	qualified := g.pkgName + "." + typeName
+
+	fmt.Fprintf(buf, "// Parse%s parses a %s from a JSON map.\n", typeName, typeName)
+	fmt.Fprintf(buf, "func (p *ElementParser) Parse%s(elemMap map[string]interface{}) *%s {\n", typeName, qualified)
+	fmt.Fprintf(buf, "\tif elemMap == nil {\n\t\treturn nil\n\t}\n\to := &%s{}\n\tp.fill%s(elemMap, o)\n", qualified, typeName)
+	if g.isElementClass(class.ID) {
+		buf.WriteString("\tp.normalize(o)\n")
+	}
+	buf.WriteString("\treturn o\n}\n\n")
+
+	fmt.Fprintf(buf, "func (p *ElementParser) fill%s(elemMap map[string]interface{}, o *%s) {\n", typeName, qualified)
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		parent := toGoName(extractName(class.Parent))
+		fmt.Fprintf(buf, "\tp.fill%s(elemMap, &o.%s)\n", parent, parent)
+	}
+	if class.Name == "Element" {
+		buf.WriteString("\to.SpdxID = p.id(elemMap)\n")
+	}
+	for _, f := range g.classFields(class) {
+		if err := g.writeFieldParser(buf, f); err != nil {
+			return fmt.Errorf("property %s: %w", f.Prop.Name, err)
+		}
+	}
+	buf.WriteString("}\n\n")
+	return nil
+}
+
+// writeAbstractParser writes the parser for objects of an abstract class
+// that is not an element, which dispatches on their type.
+func (g *Generator) writeAbstractParser(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+	iface := g.pkgName + "." + typeName + "Interface"
+
+	fmt.Fprintf(buf, "// parse%sInterface parses elemMap as the class derived from %s that\n// its type names, or as %s itself.\n", typeName, typeName, typeName)
+	fmt.Fprintf(buf, "func (p *ElementParser) parse%sInterface(elemMap map[string]interface{}) %s {\n", typeName, iface)
+	buf.WriteString("\tif v, ok := p.parseType(p.H.GetString(elemMap, \"type\"), elemMap); ok {\n")
+	fmt.Fprintf(buf, "\t\tif x, ok := v.(%s); ok {\n\t\t\treturn x\n\t\t}\n\t}\n", iface)
+	fmt.Fprintf(buf, "\treturn p.Parse%s(elemMap)\n}\n\n", typeName)
+}
+
+func (g *Generator) writeFieldParser(buf *bytes.Buffer, f field) error {
+	key := compactName(f.Prop.Path)
+	ref := "o." + f.Name
+	elemType := g.pkgName + "." + f.BaseType
+	fill := "p.fill" + f.BaseType
+
+	switch {
+	case g.isEnumType(f.BaseType):
+		if f.IsSlice() {
+			fmt.Fprintf(buf, "\tfor _, s := range p.H.GetStringSlice(elemMap, %q) {\n\t\t%s = append(%s, %s.Normalize%s(s))\n\t}\n", key, ref, ref, g.pkgName, f.BaseType)
+		} else {
+			fmt.Fprintf(buf, "\t%s = %s.Normalize%s(p.H.GetString(elemMap, %q))\n", ref, g.pkgName, f.BaseType, key)
+		}
+
+	case g.isElementRef(f):
+		// Element references are IRIs or, less commonly, inline objects.
+		normalizer := g.pkgName + ".NormalizeElementRef"
+		if g.isSubclassOf(f.Prop.ClassRef, g.model.IRI("SimpleLicensing/AnyLicenseInfo")) {
+			normalizer = g.pkgName + ".NormalizeLicenseRef"
+		}
+		switch {
+		case f.IsSlice():
+			fmt.Fprintf(buf, "\tfor _, v := range p.H.GetSlice(elemMap, %q) {\n", key)
+			fmt.Fprintf(buf, "\t\tif n := p.node(v, %s); n != nil {\n\t\t\tvar x %s\n\t\t\t%s(n, &x)\n\t\t\t%s = append(%s, x)\n\t\t}\n\t}\n",
+				normalizer, elemType, fill, ref, ref)
+		case f.IsPointer():
+			fmt.Fprintf(buf, "\tif n := p.node(p.H.Get(elemMap, %q), %s); n != nil {\n\t\t%s = &%s{}\n\t\t%s(n, %s)\n\t}\n",
+				key, normalizer, ref, elemType, fill, ref)
+		default:
+			fmt.Fprintf(buf, "\tif n := p.node(p.H.Get(elemMap, %q), %s); n != nil {\n\t\t%s(n, &%s)\n\t}\n",
+				key, normalizer, fill, ref)
+		}
+
+	case f.IsInterface():
+		// Objects of abstract classes are parsed as the class named by
+		// their type, falling back to the abstract class itself.
+		parse := "p.parse" + f.BaseType + "Interface"
+		if !f.IsSlice() {
+			return fmt.Errorf("single-valued property of abstract class %s", f.BaseType)
+		}
+		fmt.Fprintf(buf, "\tfor _, v := range p.H.GetSlice(elemMap, %q) {\n", key)
+		fmt.Fprintf(buf, "\t\tif n, ok := v.(map[string]interface{}); ok {\n\t\t\t%s = append(%s, %s(n))\n\t\t}\n\t}\n", ref, ref, parse)
+
+	case f.Prop.ClassRef != "":
+		// Nested objects; references to blank nodes cannot be resolved here.
+		switch {
+		case f.IsSlice():
+			fmt.Fprintf(buf, "\tfor _, v := range p.H.GetSlice(elemMap, %q) {\n", key)
+			fmt.Fprintf(buf, "\t\tif n, ok := v.(map[string]interface{}); ok {\n\t\t\tvar x %s\n\t\t\t%s(n, &x)\n\t\t\t%s = append(%s, x)\n\t\t}\n\t}\n",
+				elemType, fill, ref, ref)
+		case f.IsPointer():
+			fmt.Fprintf(buf, "\tif n := p.H.GetMap(elemMap, %q); n != nil {\n\t\t%s = &%s{}\n\t\t%s(n, %s)\n\t}\n",
+				key, ref, elemType, fill, ref)
+		default:
+			fmt.Fprintf(buf, "\tif n := p.H.GetMap(elemMap, %q); n != nil {\n\t\t%s(n, &%s)\n\t}\n", key, fill, ref)
+		}
+
+	default:
+		getter, ok := helperGetters[f.Type]
+		if !ok {
+			return fmt.Errorf("no parser for Go type %s", f.Type)
+		}
+		fmt.Fprintf(buf, "\t%s = p.H.%s(elemMap, %q)\n", ref, getter, key)
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// WithProfilePackages generates each profile into its own subpackage of the
+// output directory (core, software, security, licensing, ai, dataset, build
+// and extension) instead of a single package, with references between
+// profiles qualified by package. importPath is the import path of the
+// output directory.
+//
+// Every profile builds on Core, so core must not import the other
+// packages: the types of other profiles that Core classes refer to, such
+// as AnyLicenseInfo and Extension, are generated into core instead.
+//
+// The profile packages hold the types, enumerations, getter interfaces and
+// IRI constants of the model; the validators, JSON and copy methods,
+// visitor, type registry and element parsers are only generated for a
+// single package.
+func (g *Generator) WithProfilePackages(importPath string) *Generator {
+	g.profileImport = importPath
+	return g
+}
+
+// profilePackage returns the name of the package a namespace is generated
+// into. Both licensing profiles share the licensing package, as the
+// expanded license expressions build on the simple ones.
+func profilePackage(ns string) string {
+	switch ns {
+	case "":
+		return "core"
+	case "SimpleLicensing", "ExpandedLicensing":
+		return "licensing"
+	}
+	return strings.ToLower(ns)
+}
+
+// generateProfiles writes one package per profile below the output
+// directory.
+func (g *Generator) generateProfiles() error {
+	if g.parserDir != "" {
+		return fmt.Errorf("element parsers cannot be generated for profile packages")
+	}
+
+	typePackages := make(map[string]string)
+	namespaces := make(map[string][]string)
+	for _, class := range g.model.Classes {
+		ns := class.Namespace
+		if ns == "" {
+			ns = "Core"
+		}
+		pkg := profilePackage(ns)
+		typePackages[toGoName(class.Name)] = pkg
+		namespaces[pkg] = appendUnique(namespaces[pkg], ns)
+	}
+	for _, enum := range g.model.Enums {
+		typePackages[toGoName(enum.Name)] = profilePackage(enum.Namespace)
+	}
+	g.hoistIntoCore(typePackages)
+
+	pkgs := make([]string, 0, len(namespaces))
+	for pkg := range namespaces {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+
+	deps := make(map[string][]string)
+	for _, pkg := range pkgs {
+		sub := &Generator{
+			model:         g.model,
+			pkgName:       pkg,
+			outDir:        filepath.Join(g.outDir, pkg),
+			profileImport: g.profileImport,
+			profile:       pkg,
+			typePackages:  typePackages,
+			deps:          make(map[string]bool),
+		}
+		if err := os.MkdirAll(sub.outDir, 0750); err != nil {
+			return fmt.Errorf("create output directory: %w", err)
+		}
+		if err := sub.generateProfile(namespaces[pkg]); err != nil {
+			return fmt.Errorf("package %s: %w", pkg, err)
+		}
+		for dep := range sub.deps {
+			deps[pkg] = append(deps[pkg], dep)
+		}
+		sort.Strings(deps[pkg])
+	}
+
+	if cycle := importCycle(pkgs, deps); cycle != nil {
+		return fmt.Errorf("profile packages import each other: %s", strings.Join(cycle, " -> "))
+	}
+
+	if g.fixturesDir != "" {
+		if err := g.generateFixtures(); err != nil {
+			return fmt.Errorf("generate fixtures: %w", err)
+		}
+	}
+	if g.schemaPath != "" {
+		if err := g.generateSchema(); err != nil {
+			return fmt.Errorf("generate schema: %w", err)
+		}
+	}
+	if g.protoPath != "" {
+		if err := g.generateProto(); err != nil {
+			return fmt.Errorf("generate proto: %w", err)
+		}
+	}
+	return nil
+}
+
+// generateProfile writes the package of a profile, holding the given
+// namespaces.
+func (g *Generator) generateProfile(namespaces []string) error {
+	sort.Strings(namespaces)
+	profile := "profile"
+	if len(namespaces) > 1 {
+		profile = "profiles"
+	}
+	doc := fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\n// Package %s holds the %s %s of the SPDX %s model.\npackage %s\n",
+		g.pkgName, strings.Join(namespaces, " and "), profile, g.model.SpecVersion, g.pkgName)
+	if err := g.writeFile("doc_gen.go", []byte(doc)); err != nil {
+		return err
+	}
+
+	if err := g.generateEnums(); err != nil {
+		return fmt.Errorf("generate enums: %w", err)
+	}
+	if err := g.generateTypes(); err != nil {
+		return fmt.Errorf("generate types: %w", err)
+	}
+	if err := g.generateInterfaces(); err != nil {
+		return fmt.Errorf("generate interfaces: %w", err)
+	}
+	if err := g.generateIRIs(); err != nil {
+		return fmt.Errorf("generate IRIs: %w", err)
+	}
+	return nil
+}
+
+// hoistIntoCore moves the types that the classes in core refer to, directly
+// or through other moved types, into core.
+func (g *Generator) hoistIntoCore(typePackages map[string]string) {
+	var queue []*Class
+	for _, class := range g.sortedClasses() {
+		if typePackages[toGoName(class.Name)] == "core" {
+			queue = append(queue, class)
+		}
+	}
+	for len(queue) > 0 {
+		class := queue[0]
+		queue = queue[1:]
+		for _, prop := range class.Properties {
+			typeName := g.resolveType(prop)
+			if pkg, ok := typePackages[typeName]; !ok || pkg == "core" {
+				continue
+			}
+			typePackages[typeName] = "core"
+			if ref, ok := g.model.Classes[prop.ClassRef]; ok {
+				queue = append(queue, ref)
+			}
+		}
+	}
+}
+
+// inProfile reports whether the properties of a namespace belong to the
+// package being generated.
+func (g *Generator) inProfile(ns string) bool {
+	if ns == "" {
+		ns = "Core"
+	}
+	return g.profile == "" || profilePackage(ns) == g.profile
+}
+
+// inPackage reports whether a class or enumeration belongs to the package
+// being generated.
+func (g *Generator) inPackage(typeName string) bool {
+	return g.profile == "" || g.typePackages[typeName] == g.profile
+}
+
+// profileClasses returns the classes of the package being generated, in the
+// order of sortedClasses.
+func (g *Generator) profileClasses() []*Class {
+	var classes []*Class
+	for _, class := range g.sortedClasses() {
+		if g.inPackage(toGoName(class.Name)) {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// qualify returns the Go type name as seen from the package being
+// generated, e.g. "core.Element" from the software package.
+func (g *Generator) qualify(typeName string) string {
+	pkg, ok := g.typePackages[typeName]
+	if g.profile == "" || !ok || pkg == g.profile {
+		return typeName
+	}
+	g.deps[pkg] = true
+	return pkg + "." + typeName
+}
+
+// stdImports are the standard packages generated code may refer to.
+var stdImports = []string{"strings", "time"}
+
+// writeSource writes a generated file with the given body, importing the
+// standard and profile packages the body refers to.
+func (g *Generator) writeSource(filename string, body []byte) error {
+	var std, profiles []string
+	for _, pkg := range stdImports {
+		if refersTo(body, pkg) {
+			std = append(std, pkg)
+		}
+	}
+	if g.profile != "" {
+		var deps []string
+		for dep := range g.deps {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if refersTo(body, dep) {
+				profiles = append(profiles, dep)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName)
+	if len(std)+len(profiles) > 0 {
+		buf.WriteString("import (\n")
+		for _, pkg := range std {
+			fmt.Fprintf(&buf, "\t%q\n", pkg)
+		}
+		if len(std) > 0 && len(profiles) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, pkg := range profiles {
+			fmt.Fprintf(&buf, "\t%q\n", g.profileImport+"/"+pkg)
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(body)
+
+	return g.writeFile(filename, buf.Bytes())
+}
+
+// refersTo reports whether Go source refers to an exported name of the
+// package pkg, e.g. time.Time.
+func refersTo(src []byte, pkg string) bool {
+	return regexp.MustCompile(`(^|[^\w.])` + pkg + `\.[A-Z]`).Match(src)
+}
+
+// importCycle returns a cycle in the imports between packages, or nil if
+// there is none.
+func importCycle(pkgs []string, deps map[string][]string) []string {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int)
+	var path []string
+
+	var visit func(pkg string) []string
+	visit = func(pkg string) []string {
+		switch state[pkg] {
+		case visiting:
+			for i, p := range path {
+				if p == pkg {
+					return append(append([]string{}, path[i:]...), pkg)
+				}
+			}
+		case done:
+			return nil
+		}
+		state[pkg] = visiting
+		path = append(path, pkg)
+		for _, dep := range deps[pkg] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[pkg] = done
+		return nil
+	}
+
+	for _, pkg := range pkgs {
+		if cycle := visit(pkg); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func appendUnique(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
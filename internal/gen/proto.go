@@ -0,0 +1,253 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// WithProto additionally writes Protocol Buffers (proto3) definitions of
+// the model to path.
+func (g *Generator) WithProto(path string) *Generator {
+	g.protoPath = path
+	return g
+}
+
+// protoField is a field of a generated message.
+type protoField struct {
+	Label string // "repeated", "optional" or ""
+	Type  string
+	Name  string
+}
+
+// generateProto writes a .proto file with a message per concrete class and
+// an enum per enumeration. Protocol Buffers have no inheritance, so each
+// message declares the properties it inherits, the most general first.
+// Element references are IRI strings; nested objects of an abstract class
+// are wrapped in a message of that name holding one of its concrete
+// subclasses. A Document message holds the graph of a document.
+//
+// Field and enum value numbers follow the order of the properties and
+// values in the spec, so they are stable for a given spec version but not
+// across versions.
+func (g *Generator) generateProto() error {
+	var body bytes.Buffer
+	usesTimestamp := false
+
+	for _, enum := range g.sortedEnums() {
+		if err := g.writeProtoEnum(&body, enum); err != nil {
+			return fmt.Errorf("enum %s: %w", enum.Name, err)
+		}
+	}
+
+	var concrete []*Class
+	for _, class := range g.sortedClasses() {
+		if class.IsAbstract {
+			if !g.isElementClass(class.ID) {
+				if err := g.writeProtoWrapper(&body, class); err != nil {
+					return fmt.Errorf("class %s: %w", class.Name, err)
+				}
+			}
+			continue
+		}
+		if name := toGoName(class.Name); name == "Node" || name == "Document" {
+			return fmt.Errorf("class %s: name %s is reserved for the document messages", class.Name, name)
+		}
+		concrete = append(concrete, class)
+
+		fields := []protoField{}
+		if g.isElementClass(class.ID) {
+			fields = append(fields, protoField{Type: stringType, Name: "spdx_id"})
+		}
+		for _, prop := range g.inheritedProperties(class) {
+			f := protoField{Type: g.protoType(prop), Name: protoName(compactName(prop.Path))}
+			switch {
+			case prop.MaxCount != 1:
+				f.Label = "repeated"
+			case prop.MinCount == 0 && !g.isMessageType(prop):
+				f.Label = "optional"
+			}
+			usesTimestamp = usesTimestamp || f.Type == "google.protobuf.Timestamp"
+			fields = append(fields, f)
+		}
+		if err := writeProtoMessage(&body, toGoName(class.Name), class.Comment, fields); err != nil {
+			return fmt.Errorf("class %s: %w", class.Name, err)
+		}
+	}
+
+	nodes := make([]protoField, 0, len(concrete))
+	for _, class := range concrete {
+		nodes = append(nodes, protoField{Type: toGoName(class.Name), Name: protoName(compactName(class.ID))})
+	}
+	body.WriteString("// Node is an object of the graph of a document.\nmessage Node {\n  oneof value {\n")
+	if err := writeProtoFields(&body, "    ", nodes); err != nil {
+		return fmt.Errorf("message Node: %w", err)
+	}
+	body.WriteString("  }\n}\n\n")
+	body.WriteString("// Document is an SPDX document: the JSON-LD context and the graph of the\n// objects it describes.\n")
+	body.WriteString("message Document {\n  string context = 1;\n  repeated Node graph = 2;\n}\n")
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by spdx-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "// Protocol Buffers definitions of the SPDX %s model.\n\n", g.model.SpecVersion)
+	buf.WriteString("syntax = \"proto3\";\n\n")
+	fmt.Fprintf(&buf, "package spdx.v%s;\n\n", strings.ReplaceAll(g.model.SpecVersion, ".", "_"))
+	if usesTimestamp {
+		buf.WriteString("import \"google/protobuf/timestamp.proto\";\n\n")
+	}
+	buf.Write(body.Bytes())
+
+	if err := os.MkdirAll(filepath.Dir(g.protoPath), 0750); err != nil {
+		return fmt.Errorf("create proto directory: %w", err)
+	}
+	if err := os.WriteFile(g.protoPath, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
+
+// sortedEnums returns the enumerations of the model ordered by name.
+func (g *Generator) sortedEnums() []*Enum {
+	enums := make([]*Enum, 0, len(g.model.Enums))
+	for _, enum := range g.model.Enums {
+		enums = append(enums, enum)
+	}
+	sort.Slice(enums, func(i, j int) bool { return toGoName(enums[i].Name) < toGoName(enums[j].Name) })
+	return enums
+}
+
+// writeProtoEnum writes an enumeration. Value names are prefixed with the
+// enum name, as proto3 scopes them to the package, and the zero value is
+// reserved for an unset field.
+func (g *Generator) writeProtoEnum(buf *bytes.Buffer, enum *Enum) error {
+	typeName := toGoName(enum.Name)
+	prefix := upperSnake(typeName) + "_"
+
+	writeProtoComment(buf, "", enum.Comment)
+	fmt.Fprintf(buf, "enum %s {\n  %sUNSPECIFIED = 0;\n", typeName, prefix)
+	seen := map[string]bool{prefix + "UNSPECIFIED": true}
+	for i, val := range enum.Values {
+		name := prefix + upperSnake(val.Name)
+		if seen[name] {
+			return fmt.Errorf("value %s maps to %s twice", val.Name, name)
+		}
+		seen[name] = true
+		fmt.Fprintf(buf, "  %s = %d;\n", name, i+1)
+	}
+	buf.WriteString("}\n\n")
+	return nil
+}
+
+// writeProtoWrapper writes the message standing for an abstract class in
+// nested objects, holding one of its concrete subclasses.
+func (g *Generator) writeProtoWrapper(buf *bytes.Buffer, class *Class) error {
+	var fields []protoField
+	for _, sub := range g.sortedClasses() {
+		if !sub.IsAbstract && g.isSubclassOf(sub.ID, class.ID) {
+			fields = append(fields, protoField{Type: toGoName(sub.Name), Name: protoName(compactName(sub.ID))})
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	writeProtoComment(buf, "", class.Comment)
+	fmt.Fprintf(buf, "message %s {\n  oneof value {\n", toGoName(class.Name))
+	if err := writeProtoFields(buf, "    ", fields); err != nil {
+		return err
+	}
+	buf.WriteString("  }\n}\n\n")
+	return nil
+}
+
+func writeProtoMessage(buf *bytes.Buffer, name, comment string, fields []protoField) error {
+	writeProtoComment(buf, "", comment)
+	fmt.Fprintf(buf, "message %s {\n", name)
+	if err := writeProtoFields(buf, "  ", fields); err != nil {
+		return err
+	}
+	buf.WriteString("}\n\n")
+	return nil
+}
+
+// writeProtoFields numbers and writes fields, which must have distinct
+// names.
+func writeProtoFields(buf *bytes.Buffer, indent string, fields []protoField) error {
+	seen := make(map[string]bool)
+	for i, f := range fields {
+		if seen[f.Name] {
+			return fmt.Errorf("field name %s used twice", f.Name)
+		}
+		seen[f.Name] = true
+		label := ""
+		if f.Label != "" {
+			label = f.Label + " "
+		}
+		fmt.Fprintf(buf, "%s%s%s %s = %d;\n", indent, label, f.Type, f.Name, i+1)
+	}
+	return nil
+}
+
+func writeProtoComment(buf *bytes.Buffer, indent, comment string) {
+	if comment == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(comment), "\n") {
+		fmt.Fprintf(buf, "%s// %s\n", indent, strings.TrimSpace(line))
+	}
+}
+
+// protoType returns the type of a field holding values of a property.
+func (g *Generator) protoType(prop *PropertyRef) string {
+	if prop.ClassRef != "" && g.isElementClass(prop.ClassRef) {
+		return stringType // IRI of the element
+	}
+	if prop.ClassRef != "" || len(prop.InValues) > 0 {
+		return g.resolveType(prop)
+	}
+	switch prop.DataType {
+	case "http://www.w3.org/2001/XMLSchema#boolean":
+		return "bool"
+	case "http://www.w3.org/2001/XMLSchema#integer":
+		return "int64"
+	case "http://www.w3.org/2001/XMLSchema#positiveInteger", "http://www.w3.org/2001/XMLSchema#nonNegativeInteger":
+		return "uint64"
+	case "http://www.w3.org/2001/XMLSchema#decimal":
+		return "double"
+	case "http://www.w3.org/2001/XMLSchema#dateTimeStamp":
+		return "google.protobuf.Timestamp"
+	}
+	return stringType
+}
+
+// isMessageType reports whether a property holds nested messages, which
+// track presence without the optional label.
+func (g *Generator) isMessageType(prop *PropertyRef) bool {
+	if prop.DataType == "http://www.w3.org/2001/XMLSchema#dateTimeStamp" {
+		return true
+	}
+	return prop.ClassRef != "" && !g.isElementClass(prop.ClassRef) && !g.isEnumType(g.resolveType(prop))
+}
+
+// protoName converts a compact JSON-LD name to a proto field name, e.g.
+// "software_packageVersion" to "software_package_version".
+func protoName(name string) string {
+	return strings.ToLower(upperSnake(name))
+}
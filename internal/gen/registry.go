@@ -0,0 +1,49 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// generateRegistry writes registry_gen.go, which describes every class of
+// the model by its compact JSON-LD type name. The lookup functions over it
+// are part of the registry runtime template.
+func (g *Generator) generateRegistry() error {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
+	buf.WriteString("import (\n\t\"reflect\"\n)\n\n")
+
+	buf.WriteString("// typeRegistry holds the classes of the model by compact JSON-LD type\n// name.\n")
+	buf.WriteString("var typeRegistry = map[string]TypeInfo{\n")
+	for _, class := range g.sortedClasses() {
+		typeName := toGoName(class.Name)
+		profile := extractNamespace(class.ID)
+		if profile == "" {
+			profile = "Core"
+		}
+
+		fmt.Fprintf(&buf, "\t%q: {\n", compactName(class.ID))
+		fmt.Fprintf(&buf, "\t\tName: %q, IRI: IRI%s, Profile: %q,\n", compactName(class.ID), typeName, profile)
+		fmt.Fprintf(&buf, "\t\tAbstract: %t, Element: %t,\n", class.IsAbstract, g.isElementClass(class.ID))
+		fmt.Fprintf(&buf, "\t\tType: reflect.TypeOf(%s{}),\n", typeName)
+		fmt.Fprintf(&buf, "\t\tNew:  func() interface{} { return &%s{} },\n", typeName)
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+
+	return g.writeFile("registry_gen.go", buf.Bytes())
+}
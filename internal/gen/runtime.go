@@ -0,0 +1,57 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+)
+
+// runtimeFS holds the support code the generated validators, JSON and copy
+// methods and type registry call into. It is written into every generated package
+// so that each is self-contained.
+//
+//go:embed runtime/*.go.tmpl
+var runtimeFS embed.FS
+
+// generateRuntime writes <name>_runtime_gen.go for each runtime template.
+func (g *Generator) generateRuntime() error {
+	names, err := runtimeFS.ReadDir("runtime")
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range names {
+		tmpl, err := template.ParseFS(runtimeFS, path.Join("runtime", entry.Name()))
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", entry.Name(), err)
+		}
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, struct{ Package string }{g.pkgName}); err != nil {
+			return fmt.Errorf("execute %s: %w", entry.Name(), err)
+		}
+
+		filename := strings.TrimSuffix(entry.Name(), ".go.tmpl") + "_runtime_gen.go"
+		if err := g.writeFile(filename, buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// schemaObject is a JSON Schema object.
+type schemaObject = map[string]interface{}
+
+// WithSchema additionally writes a JSON Schema (draft 2020-12) for SPDX
+// JSON-LD documents of the model to path.
+func (g *Generator) WithSchema(path string) *Generator {
+	g.schemaPath = path
+	return g
+}
+
+// generateSchema writes the JSON Schema of a document: an object with the
+// SPDX context and a graph of instances of the concrete classes. Each class
+// is described under $defs by its compact type name, with its inherited
+// properties, their cardinalities and the values of enumerations. Element
+// references are IRI strings and nested objects may also be given as
+// blank node IDs, as in documents written by the generated MarshalJSON.
+func (g *Generator) generateSchema() error {
+	defs := make(schemaObject)
+	var graphItems []interface{}
+	for _, class := range g.sortedClasses() {
+		if class.IsAbstract {
+			continue
+		}
+		def, err := g.classSchema(class)
+		if err != nil {
+			return fmt.Errorf("class %s: %w", class.Name, err)
+		}
+		defs[compactName(class.ID)] = def
+		graphItems = append(graphItems, schemaRef(class))
+	}
+
+	schema := schemaObject{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"title":       "SPDX " + g.model.SpecVersion + " JSON-LD document",
+		"description": "Generated by spdx-gen from the SPDX " + g.model.SpecVersion + " model. DO NOT EDIT.",
+		"type":        "object",
+		"properties": schemaObject{
+			"@context": schemaObject{"const": strings.TrimSuffix(g.model.BaseURI, "terms/") + "spdx-context.jsonld"},
+			"@graph": schemaObject{
+				"type":  "array",
+				"items": schemaObject{"anyOf": graphItems},
+			},
+		},
+		"required": []string{"@context", "@graph"},
+		"$defs":    defs,
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal schema: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(g.schemaPath), 0750); err != nil {
+		return fmt.Errorf("create schema directory: %w", err)
+	}
+	if err := os.WriteFile(g.schemaPath, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
+
+// classSchema describes the objects of a concrete class.
+func (g *Generator) classSchema(class *Class) (schemaObject, error) {
+	properties := schemaObject{
+		"type": schemaObject{"const": compactName(class.ID)},
+	}
+	required := []string{"type"}
+	if g.isElementClass(class.ID) {
+		properties["spdxId"] = schemaObject{"type": "string"}
+		if class.NodeKind == shaclIRI {
+			required = append(required, "spdxId")
+		}
+	} else {
+		properties["@id"] = schemaObject{"type": "string"}
+	}
+
+	for _, prop := range g.inheritedProperties(class) {
+		value, err := g.valueSchema(prop)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: %w", prop.Name, err)
+		}
+		name := compactName(prop.Path)
+		if prop.MaxCount == 1 {
+			properties[name] = value
+		} else {
+			array := schemaObject{"type": "array", "items": value}
+			if prop.MinCount > 0 {
+				array["minItems"] = prop.MinCount
+			}
+			if prop.MaxCount > 1 {
+				array["maxItems"] = prop.MaxCount
+			}
+			properties[name] = array
+		}
+		if prop.MinCount > 0 {
+			required = append(required, name)
+		}
+	}
+
+	def := schemaObject{
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
+	if class.Comment != "" {
+		def["description"] = class.Comment
+	}
+	return def, nil
+}
+
+// valueSchema describes a single value of a property.
+func (g *Generator) valueSchema(prop *PropertyRef) (schemaObject, error) {
+	if values := g.enumValues(prop); len(values) > 0 {
+		return schemaObject{"enum": values}, nil
+	}
+
+	if prop.ClassRef != "" {
+		if g.isElementClass(prop.ClassRef) {
+			return schemaObject{"type": "string", "description": "IRI of an element of class " + compactName(prop.ClassRef)}, nil
+		}
+		alternatives := []interface{}{schemaObject{"type": "string", "description": "ID of a blank node"}}
+		for _, class := range g.sortedClasses() {
+			if !class.IsAbstract && g.isSubclassOf(class.ID, prop.ClassRef) {
+				alternatives = append(alternatives, schemaRef(class))
+			}
+		}
+		if len(alternatives) == 1 {
+			return nil, fmt.Errorf("no concrete class for %s", prop.ClassRef)
+		}
+		return schemaObject{"anyOf": alternatives}, nil
+	}
+
+	var value schemaObject
+	switch prop.DataType {
+	case "http://www.w3.org/2001/XMLSchema#string":
+		value = schemaObject{"type": "string"}
+	case "http://www.w3.org/2001/XMLSchema#anyURI":
+		value = schemaObject{"type": "string", "format": "uri"}
+	case "http://www.w3.org/2001/XMLSchema#boolean":
+		value = schemaObject{"type": "boolean"}
+	case "http://www.w3.org/2001/XMLSchema#integer":
+		value = schemaObject{"type": "integer"}
+	case "http://www.w3.org/2001/XMLSchema#positiveInteger":
+		value = schemaObject{"type": "integer", "minimum": 1}
+	case "http://www.w3.org/2001/XMLSchema#nonNegativeInteger":
+		value = schemaObject{"type": "integer", "minimum": 0}
+	case "http://www.w3.org/2001/XMLSchema#decimal":
+		value = schemaObject{"type": "number"}
+	case "http://www.w3.org/2001/XMLSchema#dateTimeStamp":
+		value = schemaObject{"type": "string", "format": "date-time"}
+	default:
+		return nil, fmt.Errorf("no JSON Schema type for datatype %q", prop.DataType)
+	}
+	if prop.Pattern != "" {
+		value["pattern"] = prop.Pattern
+	}
+	return value, nil
+}
+
+func schemaRef(class *Class) schemaObject {
+	return schemaObject{"$ref": "#/$defs/" + compactName(class.ID)}
+}
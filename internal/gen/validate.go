@@ -0,0 +1,167 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const shaclIRI = "http://www.w3.org/ns/shacl#IRI"
+
+// integerMinimum is the least value of the XSD integer datatypes with a
+// lower bound.
+var integerMinimum = map[string]int{
+	"http://www.w3.org/2001/XMLSchema#positiveInteger":    1,
+	"http://www.w3.org/2001/XMLSchema#nonNegativeInteger": 0,
+}
+
+// generateValidators writes validate_gen.go, which enforces the SHACL
+// constraints of every class: sh:minCount, sh:maxCount, sh:in, sh:nodeKind
+// and the value spaces of the integer datatypes.
+// The validator runtime it calls into lives in the target package.
+func (g *Generator) generateValidators() error {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
+
+	for _, class := range g.sortedClasses() {
+		g.writeValidator(&buf, class)
+	}
+
+	return g.writeFile("validate_gen.go", buf.Bytes())
+}
+
+func (g *Generator) writeValidator(buf *bytes.Buffer, class *Class) {
+	typeName := toGoName(class.Name)
+
+	fmt.Fprintf(buf, "// Validate checks the %s against the constraints of the SPDX model.\n", typeName)
+	fmt.Fprintf(buf, "func (o *%s) Validate() error {\n\tv := &validator{}\n\to.validate(v)\n\treturn v.err()\n}\n\n", typeName)
+
+	fmt.Fprintf(buf, "func (o *%s) validate(v *validator) {\n", typeName)
+	if class.Parent != "" && isTermIRI(class.Parent) {
+		fmt.Fprintf(buf, "\to.%s.validate(v)\n", toGoName(extractName(class.Parent)))
+	}
+	for _, f := range g.classFields(class) {
+		g.writeFieldChecks(buf, class.Name, f)
+	}
+	buf.WriteString("}\n\n")
+}
+
+func (g *Generator) writeFieldChecks(buf *bytes.Buffer, className string, f field) {
+	prop := f.Prop
+	ref := "o." + f.Name
+	args := fmt.Sprintf("%q, %q", className, prop.Name)
+
+	// sh:minCount and sh:maxCount
+	switch {
+	case f.IsSlice():
+		if prop.MinCount > 0 {
+			fmt.Fprintf(buf, "\tv.minCount(%s, len(%s), %d)\n", args, ref, prop.MinCount)
+		}
+		if prop.MaxCount > 1 {
+			fmt.Fprintf(buf, "\tv.maxCount(%s, len(%s), %d)\n", args, ref, prop.MaxCount)
+		}
+	case prop.MinCount > 0:
+		if cond := g.presenceCheck(f, ref); cond != "" {
+			fmt.Fprintf(buf, "\tv.required(%s, %s)\n", args, cond)
+		}
+	}
+
+	// xsd:positiveInteger and xsd:nonNegativeInteger. An optional integer
+	// of zero is unset.
+	if limit, ok := integerMinimum[prop.DataType]; ok && !f.IsSlice() && !f.IsPointer() {
+		if prop.MinCount > 0 || limit == 0 {
+			fmt.Fprintf(buf, "\tv.minInclusive(%s, int(%s), %d)\n", args, ref, limit)
+		} else {
+			fmt.Fprintf(buf, "\tif %s != 0 {\n\t\tv.minInclusive(%s, int(%s), %d)\n\t}\n", ref, args, ref, limit)
+		}
+	}
+
+	// sh:in
+	if g.isEnumType(f.BaseType) {
+		if f.IsSlice() {
+			fmt.Fprintf(buf, "\tfor _, x := range %s {\n\t\tv.enum(%s, string(x), x.IsValid())\n\t}\n", ref, args)
+		} else {
+			fmt.Fprintf(buf, "\tv.enum(%s, string(%s), %s.IsValid())\n", args, ref, ref)
+		}
+		return
+	}
+
+	// sh:nodeKind sh:IRI on element references
+	if prop.NodeKind == shaclIRI && prop.ClassRef != "" && g.isElementClass(prop.ClassRef) {
+		switch {
+		case f.IsSlice():
+			fmt.Fprintf(buf, "\tfor _, x := range %s {\n\t\tv.iri(%s, x.SpdxID)\n\t}\n", ref, args)
+		case f.IsPointer():
+			fmt.Fprintf(buf, "\tif %s != nil {\n\t\tv.iri(%s, %s.SpdxID)\n\t}\n", ref, args, ref)
+		default:
+			fmt.Fprintf(buf, "\tv.iri(%s, %s.SpdxID)\n", args, ref)
+		}
+	}
+}
+
+// presenceCheck returns a Go expression that is true when a required
+// single-valued field is set. Booleans and numbers have no unset state
+// distinguishable from their zero value, so they are not checked.
+func (g *Generator) presenceCheck(f field, ref string) string {
+	if f.IsPointer() {
+		return ref + " != nil"
+	}
+	if g.isEnumType(f.BaseType) {
+		return ref + ` != ""`
+	}
+	switch f.BaseType {
+	case stringType:
+		return ref + ` != ""`
+	case "time.Time":
+		return "!" + ref + ".IsZero()"
+	case "interface{}":
+		return ref + " != nil"
+	case "bool", "int", "int64", "float64":
+		return ""
+	default:
+		return "!isZero(" + ref + ")"
+	}
+}
+
+// isEnumType returns true if the Go type name is a generated enum.
+func (g *Generator) isEnumType(typeName string) bool {
+	for _, enum := range g.model.Enums {
+		if toGoName(enum.Name) == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// isElementClass returns true if the class is Element or inherits from it.
+func (g *Generator) isElementClass(classID string) bool {
+	return g.isSubclassOf(classID, g.model.IRI("Core/Element"))
+}
+
+// isSubclassOf returns true if the class is baseID or inherits from it.
+func (g *Generator) isSubclassOf(classID, baseID string) bool {
+	for classID != "" {
+		if classID == baseID {
+			return true
+		}
+		class, ok := g.model.Classes[classID]
+		if !ok {
+			return false
+		}
+		classID = class.Parent
+	}
+	return false
+}
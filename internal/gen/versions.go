@@ -0,0 +1,194 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// versionIndependentTypes are the getter return types that mean the same in
+// every generated model version, so methods returning them can be shared.
+var versionIndependentTypes = map[string]bool{
+	stringType:        true,
+	"[]" + stringType: true,
+	"bool":            true,
+	"int":             true,
+	"float64":         true,
+	"time.Time":       true,
+}
+
+// MultiGenerator generates several SPDX model versions side by side, each
+// into its own package named after the spec version (e.g. v3.0.1).
+type MultiGenerator struct {
+	models  []*Model
+	pkgName string
+	outRoot string
+
+	sharedDir string
+	sharedPkg string
+}
+
+// NewMultiGenerator creates a MultiGenerator that writes each model to
+// outRoot/v<SpecVersion> as package pkgName.
+func NewMultiGenerator(models []*Model, pkgName, outRoot string) *MultiGenerator {
+	return &MultiGenerator{
+		models:  models,
+		pkgName: pkgName,
+		outRoot: outRoot,
+	}
+}
+
+// WithShared additionally generates package pkg at dir, declaring for every
+// class the getter interface shared by all versions.
+func (m *MultiGenerator) WithShared(dir, pkg string) *MultiGenerator {
+	m.sharedDir = dir
+	m.sharedPkg = pkg
+	return m
+}
+
+// Generate generates all model versions and the shared package.
+func (m *MultiGenerator) Generate() error {
+	generators := make([]*Generator, 0, len(m.models))
+	seen := make(map[string]bool)
+	for _, model := range m.models {
+		if model.SpecVersion == "" {
+			return fmt.Errorf("model has no spec version")
+		}
+		if seen[model.SpecVersion] {
+			return fmt.Errorf("spec version %s given more than once", model.SpecVersion)
+		}
+		seen[model.SpecVersion] = true
+
+		g := NewGenerator(model, m.pkgName, filepath.Join(m.outRoot, "v"+model.SpecVersion))
+		if err := g.Generate(); err != nil {
+			return fmt.Errorf("version %s: %w", model.SpecVersion, err)
+		}
+		generators = append(generators, g)
+	}
+
+	if m.sharedDir != "" {
+		if err := m.generateShared(generators); err != nil {
+			return fmt.Errorf("generate shared interfaces: %w", err)
+		}
+	}
+	return nil
+}
+
+// generateShared writes interfaces_gen.go into the shared package. Each
+// interface lists the getters, inherited ones included, that every version's
+// type of the same name has with the same version-independent return type,
+// so it is satisfied by the type of every version.
+func (m *MultiGenerator) generateShared(generators []*Generator) error {
+	if err := os.MkdirAll(m.sharedDir, 0750); err != nil {
+		return fmt.Errorf("create shared directory: %w", err)
+	}
+
+	versions := make([]string, len(generators))
+	for i, g := range generators {
+		versions[i] = "v" + g.model.SpecVersion
+	}
+
+	var body bytes.Buffer
+	for _, class := range generators[0].sortedClasses() {
+		typeName := toGoName(class.Name)
+		getters, ok := sharedGetters(generators, typeName)
+		if !ok {
+			continue
+		}
+
+		fmt.Fprintf(&body, "// %s is implemented by the %s type of every model version.\n", typeName, typeName)
+		fmt.Fprintf(&body, "type %s interface {\n", typeName)
+		for _, gt := range getters {
+			fmt.Fprintf(&body, "\t%s() %s\n", gt.Name, gt.ReturnType)
+		}
+		body.WriteString("}\n\n")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by spdx-gen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "// Package %s declares the getter interfaces shared by the SPDX model\n", m.sharedPkg)
+	fmt.Fprintf(&buf, "// versions %s.\n", strings.Join(versions, ", "))
+	fmt.Fprintf(&buf, "package %s\n\n", m.sharedPkg)
+	if bytes.Contains(body.Bytes(), []byte("time.Time")) {
+		buf.WriteString("import (\n\t\"time\"\n)\n\n")
+	}
+	buf.Write(body.Bytes())
+
+	return writeFileIn(m.sharedDir, "interfaces_gen.go", buf.Bytes())
+}
+
+// sharedGetters returns the getters common to the class named typeName in
+// every generator's model, in the order of the first model. It returns false
+// if a model lacks the class.
+func sharedGetters(generators []*Generator, typeName string) ([]getter, bool) {
+	var common []getter
+	for i, g := range generators {
+		class := g.classByGoName(typeName)
+		if class == nil {
+			return nil, false
+		}
+		getters := g.inheritedGetters(class)
+		if i == 0 {
+			for _, gt := range getters {
+				if versionIndependentTypes[gt.ReturnType] {
+					common = append(common, gt)
+				}
+			}
+			continue
+		}
+
+		have := make(map[string]string, len(getters))
+		for _, gt := range getters {
+			have[gt.Name] = gt.ReturnType
+		}
+		kept := common[:0]
+		for _, gt := range common {
+			if have[gt.Name] == gt.ReturnType {
+				kept = append(kept, gt)
+			}
+		}
+		common = kept
+	}
+	return common, true
+}
+
+// inheritedGetters returns the getters of a class and its ancestors, the
+// most general class first.
+func (g *Generator) inheritedGetters(class *Class) []getter {
+	var chain []*Class
+	for c := class; c != nil; c = g.model.Classes[c.Parent] {
+		chain = append(chain, c)
+	}
+
+	var result []getter
+	for i := len(chain) - 1; i >= 0; i-- {
+		result = append(result, g.getters(chain[i])...)
+	}
+	return result
+}
+
+// classByGoName returns the class generated as the Go type typeName.
+func (g *Generator) classByGoName(typeName string) *Class {
+	for _, class := range g.sortedClasses() {
+		if toGoName(class.Name) == typeName {
+			return class
+		}
+	}
+	return nil
+}
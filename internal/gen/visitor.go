@@ -0,0 +1,66 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// generateVisitor writes visitor_gen.go, which declares a Visitor with a
+// Visit method per concrete class and an Accept method on each concrete
+// class that calls the method for its own type. Abstract classes have no
+// Visit method: values of them are always visited as their concrete class.
+func (g *Generator) generateVisitor() error {
+	var concrete []*Class
+	for _, class := range g.sortedClasses() {
+		if !class.IsAbstract {
+			concrete = append(concrete, class)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("// Code generated by spdx-gen. DO NOT EDIT.\n\npackage %s\n\n", g.pkgName))
+
+	buf.WriteString("// Visitor has a method per concrete class of the model. Accept calls the\n")
+	buf.WriteString("// method for the type of the value it is called on, so a Visitor handles a\n")
+	buf.WriteString("// value by its concrete class without a type switch. Embed BaseVisitor to\n")
+	buf.WriteString("// implement only the methods of the classes of interest.\n")
+	buf.WriteString("type Visitor interface {\n")
+	for _, class := range concrete {
+		typeName := toGoName(class.Name)
+		fmt.Fprintf(&buf, "\tVisit%s(*%s) error\n", typeName, typeName)
+	}
+	buf.WriteString("}\n\n")
+
+	buf.WriteString("// Visitable is implemented by every concrete class of the model.\n")
+	buf.WriteString("type Visitable interface {\n\tAccept(Visitor) error\n}\n\n")
+
+	buf.WriteString("// BaseVisitor implements Visitor with methods that do nothing and return\n// nil.\n")
+	buf.WriteString("type BaseVisitor struct{}\n\n")
+	for _, class := range concrete {
+		typeName := toGoName(class.Name)
+		fmt.Fprintf(&buf, "// Visit%s does nothing.\n", typeName)
+		fmt.Fprintf(&buf, "func (BaseVisitor) Visit%s(*%s) error {\n\treturn nil\n}\n\n", typeName, typeName)
+	}
+
+	for _, class := range concrete {
+		typeName := toGoName(class.Name)
+		fmt.Fprintf(&buf, "// Accept calls the Visit%s method of v.\n", typeName)
+		fmt.Fprintf(&buf, "func (o *%s) Accept(v Visitor) error {\n\treturn v.Visit%s(o)\n}\n\n", typeName, typeName)
+	}
+
+	return g.writeFile("visitor_gen.go", buf.Bytes())
+}
@@ -0,0 +1,12 @@
+// Package markdown formats the Markdown tables of the reports of the
+// analysis, export and security packages.
+package markdown
+
+import "strings"
+
+var cellReplacer = strings.NewReplacer("|", `\|`, "\n", " ")
+
+// Cell escapes the characters of s that would break a table cell.
+func Cell(s string) string {
+	return cellReplacer.Replace(s)
+}
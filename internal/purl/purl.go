@@ -0,0 +1,76 @@
+// Package purl matches package URLs, as queried by the server and storage
+// packages.
+package purl
+
+import (
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// Match reports whether purl is the package URL of the query, ignoring the
+// version if the query has none, and the qualifiers and subpath if it has
+// none. Both are compared in the canonical form spdx.NormalizePURL returns,
+// if they can be parsed.
+func Match(query, purl string) bool {
+	if purl == "" {
+		return false
+	}
+	query, purl = normalize(query), normalize(purl)
+	if !strings.ContainsAny(query, "?#") {
+		purl = stripQualifiers(purl)
+	}
+	if !hasVersion(stripQualifiers(query)) {
+		purl = stripVersion(purl)
+	}
+	return purl == query
+}
+
+// Base returns a package URL without its version, qualifiers and subpath.
+// Package URLs matching a query have the base of the query.
+func Base(purl string) string {
+	return stripVersion(stripQualifiers(normalize(purl)))
+}
+
+// normalize returns the canonical form of a package URL, or purl itself if
+// it cannot be parsed.
+func normalize(purl string) string {
+	if n, err := spdx.NormalizePURL(purl); err == nil {
+		return n
+	}
+	return purl
+}
+
+func stripQualifiers(purl string) string {
+	purl, _, _ = strings.Cut(purl, "#")
+	purl, _, _ = strings.Cut(purl, "?")
+	return purl
+}
+
+// hasVersion reports whether a package URL without qualifiers has a
+// version. An "@" before the last "/" starts an unencoded npm scope.
+func hasVersion(purl string) bool {
+	return strings.LastIndex(purl, "@") > strings.LastIndex(purl, "/")
+}
+
+func stripVersion(purl string) string {
+	if hasVersion(purl) {
+		purl = purl[:strings.LastIndex(purl, "@")]
+	}
+	return purl
+}
+
+// Type returns the type of a package URL, such as "npm" or "maven", in
+// lower case, or "" if purl is not a package URL.
+func Type(purl string) string {
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return ""
+	}
+	rest = strings.TrimLeft(rest, "/")
+	typ, _, ok := strings.Cut(rest, "/")
+	if !ok {
+		return ""
+	}
+	return strings.ToLower(typ)
+}
@@ -0,0 +1,112 @@
+// Package testsbom builds the SBOMs that the tests of the storage, transport
+// and server packages share, so that they agree on what a typical document
+// holds.
+package testsbom
+
+import (
+	"testing"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// Created is the creation time of the SBOMs.
+var Created = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+// JSON returns the JSON-LD of the SBOM https://acme.example/sbom/app-<version>
+// of app at version, which depends on lib 2.1.0 under the MIT license and,
+// for tests only, on mock 1.0.0. lib depends on leaf 0.3.0, which
+// CVE-2024-1234 affects.
+func JSON(t testing.TB, version string) []byte {
+	t.Helper()
+	b := sbom.NewBuilder("https://acme.example/sbom/app-"+version, "app", sbom.WithCreated(Created))
+	app := b.AddPackage("app", version, "pkg:golang/acme.example/app@v"+version)
+	b.AddRoot(app)
+	lib := b.AddPackage("lib", "2.1.0", "pkg:npm/lib@2.1.0?arch=x64")
+	lib.ExternalIdentifier = append(lib.ExternalIdentifier, spdx.ExternalIdentifier{
+		ExternalIdentifierType: spdx.ExternalIdentifierTypeCpe23,
+		Identifier:             "cpe:2.3:a:acme:lib:2.1.0:*:*:*:*:*:*:*",
+	})
+	leaf := b.AddPackage("leaf", "0.3.0", "pkg:npm/leaf@0.3.0")
+	mock := b.AddPackage("mock", "1.0.0", "pkg:npm/mock@1.0.0")
+	b.Relate(app, spdx.RelationshipTypeDependsOn, lib)
+	b.RelateScoped(app, spdx.RelationshipTypeDependsOn, spdx.LifecycleScopeTypeTest, mock)
+	b.Relate(lib, spdx.RelationshipTypeDependsOn, leaf)
+	b.Relate(lib, spdx.RelationshipTypeHasDeclaredLicense, &spdx.AnyLicenseInfo{Element: spdx.Element{SpdxID: "https://spdx.org/licenses/MIT"}})
+
+	vuln := &spdx.Vulnerability{}
+	vuln.SpdxID = b.ID("vuln", "CVE-2024-1234")
+	vuln.Name = "CVE-2024-1234"
+	vuln.CreationInfo = b.CreationInfo()
+	vuln.ExternalIdentifier = []spdx.ExternalIdentifier{{ExternalIdentifierType: spdx.ExternalIdentifierTypeCve, Identifier: "CVE-2024-1234"}}
+	b.Add(vuln)
+	b.Relate(vuln, spdx.RelationshipTypeAffects, leaf)
+
+	data, err := b.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Upgrade returns the JSON-LD of the SBOM https://acme.example/sbom/app-1.1.0,
+// created a month after those of JSON, of app 1.1.0, which depends on lib
+// 2.2.0 and on parser 1.0.0, and in which CVE-2024-1234 was assessed as
+// affecting lib on 2024-05-01 and as fixed in it on 2024-05-20, and as not
+// affecting app.
+func Upgrade(t testing.TB) []byte {
+	t.Helper()
+	b := sbom.NewBuilder("https://acme.example/sbom/app-1.1.0", "app", sbom.WithCreated(Created.AddDate(0, 1, 0)))
+	app := b.AddPackage("app", "1.1.0", "pkg:golang/acme.example/app@v1.1.0")
+	b.AddRoot(app)
+	lib := b.AddPackage("lib", "2.2.0", "pkg:npm/lib@2.2.0")
+	parser := b.AddPackage("parser", "1.0.0", "pkg:npm/parser@1.0.0")
+	b.Relate(app, spdx.RelationshipTypeDependsOn, lib, parser)
+
+	vuln := &spdx.Vulnerability{}
+	vuln.SpdxID = b.ID("vuln", "CVE-2024-1234")
+	vuln.Name = "CVE-2024-1234"
+	vuln.CreationInfo = b.CreationInfo()
+	b.Add(vuln)
+
+	affected := &spdx.VexAffectedVulnAssessmentRelationship{}
+	affected.SpdxID = b.ID("vex", "affected")
+	affected.CreationInfo = b.CreationInfo()
+	affected.RelationshipType = spdx.RelationshipTypeAffects
+	affected.From = spdx.Element{SpdxID: vuln.SpdxID}
+	affected.To = []spdx.Element{{SpdxID: lib.SpdxID}}
+	affected.PublishedTime = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	fixed := &spdx.VexFixedVulnAssessmentRelationship{}
+	fixed.SpdxID = b.ID("vex", "fixed")
+	fixed.CreationInfo = b.CreationInfo()
+	fixed.RelationshipType = spdx.RelationshipTypeFixedIn
+	fixed.From = spdx.Element{SpdxID: vuln.SpdxID}
+	fixed.To = []spdx.Element{{SpdxID: lib.SpdxID}}
+	fixed.PublishedTime = time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)
+	notAffected := &spdx.VexNotAffectedVulnAssessmentRelationship{}
+	notAffected.SpdxID = b.ID("vex", "not-affected")
+	notAffected.CreationInfo = b.CreationInfo()
+	notAffected.RelationshipType = spdx.RelationshipTypeDoesNotAffect
+	notAffected.From = spdx.Element{SpdxID: vuln.SpdxID}
+	notAffected.To = []spdx.Element{{SpdxID: app.SpdxID}}
+	notAffected.JustificationType = spdx.VexJustificationTypeVulnerableCodeNotInExecutePath
+	b.Add(affected, fixed, notAffected)
+
+	data, err := b.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// Document returns the SBOM of JSON, parsed.
+func Document(t testing.TB, version string) *parse.Document {
+	t.Helper()
+	doc, err := parse.NewReader().Read(JSON(t, version))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
@@ -0,0 +1,211 @@
+package license
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Copyright is a copyright statement reduced to its holder and years.
+type Copyright struct {
+	Holder string
+	// FirstYear and LastYear are the earliest and latest years of the
+	// statement, or 0 if it has none.
+	FirstYear int
+	LastYear  int
+}
+
+// String returns the statement without years, as in "Copyright Acme, Inc.".
+func (c Copyright) String() string {
+	return "Copyright " + c.Holder
+}
+
+var (
+	// commentLeader matches the comment markers before a statement in
+	// source files.
+	commentLeader = regexp.MustCompile(`^[\s/#*;!%-]*`)
+	// copyrightMark matches a leading copyright sign or word.
+	copyrightMark = regexp.MustCompile(`(?i)^(?:copyright\b|copr\.|\(c\)|©)[\s,:]*`)
+	// years matches years and year ranges, as in "2001-2004, 2010".
+	years = regexp.MustCompile(`(?i)\b(\d{4})(?:\s*[-–]\s*(\d{4}|\d{2}|present)\b)?[\s,]*`)
+	// by matches the word introducing the holder.
+	by = regexp.MustCompile(`(?i)^by\s+`)
+	// rightsReserved matches "all rights reserved" and what follows.
+	rightsReserved = regexp.MustCompile(`(?i)[\s,;-]*all\s+rights\s+reserved.*$`)
+	// emails matches email addresses, bracketed or not.
+	emails = regexp.MustCompile(`\s*[<(]?[\w.+-]+@[\w-]+(?:\.[\w-]+)+[>)]?`)
+	// holderKey matches what is ignored of holders when merging them.
+	holderKey = regexp.MustCompile(`[^\pL\pN]+`)
+)
+
+// ParseCopyright parses a copyright statement, such as
+// "Copyright (c) 2019-2021, 2023 Acme, Inc. All rights reserved.", into
+// its holder and years, and reports whether it is one: the statement must
+// start with "Copyright", "Copr.", "(c)" or "©", after any comment markers,
+// and name a holder. Emails and the trailing "All rights reserved" are
+// dropped.
+func ParseCopyright(statement string) (Copyright, bool) {
+	s := commentLeader.ReplaceAllString(statement, "")
+	if !copyrightMark.MatchString(s) {
+		return Copyright{}, false
+	}
+	for copyrightMark.MatchString(s) {
+		s = copyrightMark.ReplaceAllString(s, "")
+	}
+
+	var c Copyright
+	s = years.ReplaceAllStringFunc(s, func(m string) string {
+		sub := years.FindStringSubmatch(m)
+		first, _ := strconv.Atoi(sub[1])
+		last := first
+		switch end := strings.ToLower(sub[2]); {
+		case end == "present" || end == "":
+		case len(end) == 2:
+			n, _ := strconv.Atoi(end)
+			if last = first/100*100 + n; last < first {
+				last += 100
+			}
+		default:
+			last, _ = strconv.Atoi(end)
+		}
+		if c.FirstYear == 0 || first < c.FirstYear {
+			c.FirstYear = first
+		}
+		c.LastYear = max(c.LastYear, last)
+		return " "
+	})
+	s = rightsReserved.ReplaceAllString(s, "")
+	s = emails.ReplaceAllString(s, "")
+	s = by.ReplaceAllString(strings.TrimSpace(s), "")
+	c.Holder = strings.Join(strings.Fields(strings.Trim(s, " \t,;:-")), " ")
+	if c.Holder == "" || strings.HasPrefix(c.Holder, "<") {
+		return Copyright{}, false
+	}
+	return c, true
+}
+
+// ExtractCopyrights returns the copyright statements of a text, one per
+// line, such as the copyrightText of a scanned file or the contents of a
+// source file. Lines that are not statements are skipped.
+func ExtractCopyrights(text string) []Copyright {
+	var cs []Copyright
+	for _, line := range strings.Split(text, "\n") {
+		if c, ok := ParseCopyright(line); ok {
+			cs = append(cs, c)
+		}
+	}
+	return cs
+}
+
+// MergeCopyrights merges the statements of the same holder, ignoring
+// case, white space and punctuation, as in "Acme, Inc." and "ACME Inc",
+// and returns them by holder. A merged statement spans the years of all
+// and has the holder as first spelled.
+func MergeCopyrights(cs []Copyright) []Copyright {
+	var merged []Copyright
+	index := make(map[string]int)
+	for _, c := range cs {
+		key := strings.ToLower(holderKey.ReplaceAllString(c.Holder, ""))
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, c)
+			continue
+		}
+		m := &merged[i]
+		if c.FirstYear != 0 && (m.FirstYear == 0 || c.FirstYear < m.FirstYear) {
+			m.FirstYear = c.FirstYear
+		}
+		m.LastYear = max(m.LastYear, c.LastYear)
+	}
+	slices.SortStableFunc(merged, func(a, b Copyright) int {
+		return cmp.Compare(strings.ToLower(a.Holder), strings.ToLower(b.Holder))
+	})
+	return merged
+}
+
+// NormalizeCopyrightText extracts, merges and rewrites the copyright
+// statements of a text, one per line without years, or returns "" if it
+// has none.
+func NormalizeCopyrightText(text string) string {
+	return joinCopyrights(MergeCopyrights(ExtractCopyrights(text)))
+}
+
+func joinCopyrights(cs []Copyright) string {
+	lines := make([]string, len(cs))
+	for i, c := range cs {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// CopyrightRollup is the copyright rolled up to a package.
+type CopyrightRollup struct {
+	PackageID string
+	// Files is the number of files whose copyright was rolled up.
+	Files      int
+	Copyrights []Copyright
+}
+
+// RollUpCopyrights sets the copyrightText of each package of a document
+// that has file or package copyrights to their normalized statements: those
+// of its own copyrightText and of the files it contains, merged by holder
+// as MergeCopyrights does. NOASSERTION, NONE and texts without statements
+// are ignored, and packages without statements are left as they are. The
+// raw elements in ElementsByID are updated to match.
+//
+//	rollups, err := license.RollUpCopyrights(doc)
+//	for _, r := range rollups {
+//	    fmt.Printf("%s: %d holders from %d files\n", r.PackageID, len(r.Copyrights), r.Files)
+//	}
+func RollUpCopyrights(doc *parse.Document) ([]CopyrightRollup, error) {
+	var rollups []CopyrightRollup
+	var changed []spdx.AnyElement
+	rollUp := func(pkg *spdx.Package, elem spdx.AnyElement) {
+		r := CopyrightRollup{PackageID: pkg.SpdxID}
+		cs := ExtractCopyrights(pkg.CopyrightText)
+		for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+			if rel.RelationshipType != spdx.RelationshipTypeContains {
+				continue
+			}
+			for _, to := range rel.To {
+				f := doc.GetFileByID(to.GetSpdxID())
+				if f == nil {
+					continue
+				}
+				if fcs := ExtractCopyrights(f.CopyrightText); len(fcs) > 0 {
+					cs = append(cs, fcs...)
+					r.Files++
+				}
+			}
+		}
+		if len(cs) == 0 {
+			return
+		}
+		r.Copyrights = MergeCopyrights(cs)
+		if text := joinCopyrights(r.Copyrights); text != pkg.CopyrightText {
+			pkg.CopyrightText = text
+			changed = append(changed, elem)
+		}
+		rollups = append(rollups, r)
+	}
+	for _, pkg := range doc.Packages {
+		rollUp(pkg, pkg)
+	}
+	for _, pkg := range doc.AiPackages {
+		rollUp(&pkg.Package, pkg)
+	}
+	for _, pkg := range doc.DatasetPackages {
+		rollUp(&pkg.Package, pkg)
+	}
+	if err := doc.UpdateElements(changed...); err != nil {
+		return nil, fmt.Errorf("updating packages: %w", err)
+	}
+	return rollups, nil
+}
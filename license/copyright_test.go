@@ -0,0 +1,98 @@
+package license_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/license"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestParseCopyright(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      license.Copyright
+		wantOK    bool
+	}{
+		{"Copyright (c) 2019-2021, 2023 Acme, Inc. All rights reserved.", license.Copyright{Holder: "Acme, Inc.", FirstYear: 2019, LastYear: 2023}, true},
+		{" * Copyright © 2010 Jane Doe <jane@example.com>", license.Copyright{Holder: "Jane Doe", FirstYear: 2010, LastYear: 2010}, true},
+		{"// (C) Copyright 1998-05 by The Foo Project", license.Copyright{Holder: "The Foo Project", FirstYear: 1998, LastYear: 2005}, true},
+		{"# Copyright 2015-present Example Authors", license.Copyright{Holder: "Example Authors", FirstYear: 2015, LastYear: 2015}, true},
+		{"Copyright: Bob Smith", license.Copyright{Holder: "Bob Smith"}, true},
+		{"Copyright (C) <year> <name of author>", license.Copyright{}, false},
+		{"Copyright 2020", license.Copyright{}, false},
+		{"The above copyright notice shall be included", license.Copyright{}, false},
+		{"NOASSERTION", license.Copyright{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := license.ParseCopyright(tt.statement)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("ParseCopyright(%q) = %+v, %v, want %+v, %v", tt.statement, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestNormalizeCopyrightText(t *testing.T) {
+	text := `Copyright (c) 2020 ACME Inc
+Copyright 2018, 2019 Acme, Inc.
+some scanner noise
+Copyright 2021 Jane Doe
+copyright (c) 2022 jane doe.`
+	if got, want := license.NormalizeCopyrightText(text), "Copyright ACME Inc\nCopyright Jane Doe"; got != want {
+		t.Errorf("NormalizeCopyrightText() = %q, want %q", got, want)
+	}
+
+	merged := license.MergeCopyrights(license.ExtractCopyrights(text))
+	want := []license.Copyright{{Holder: "ACME Inc", FirstYear: 2018, LastYear: 2020}, {Holder: "Jane Doe", FirstYear: 2021, LastYear: 2022}}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("MergeCopyrights() = %+v, want %+v", merged, want)
+	}
+	if got := license.NormalizeCopyrightText("NOASSERTION"); got != "" {
+		t.Errorf("NormalizeCopyrightText(NOASSERTION) = %q, want empty", got)
+	}
+}
+
+func TestRollUpCopyrights(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(`{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+		"@graph": [
+			{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_copyrightText": "Copyright 2024 Zed Corp"},
+			{"type": "software_Package", "spdxId": "urn:spdx:bare", "name": "bare", "software_copyrightText": "NOASSERTION"},
+			{"type": "software_File", "spdxId": "urn:spdx:a", "name": "a.go", "software_copyrightText": "Copyright (c) 2020 Acme, Inc.\nCopyright 2021 Jane Doe"},
+			{"type": "software_File", "spdxId": "urn:spdx:b", "name": "b.go", "software_copyrightText": "(c) 2022 ACME Inc. All rights reserved."},
+			{"type": "software_File", "spdxId": "urn:spdx:c", "name": "c.go", "software_copyrightText": "NOASSERTION"},
+			{"type": "Relationship", "spdxId": "urn:spdx:rel", "from": "urn:spdx:app", "to": ["urn:spdx:a", "urn:spdx:b", "urn:spdx:c"], "relationshipType": "contains"}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rollups, err := license.RollUpCopyrights(doc)
+	if err != nil {
+		t.Fatalf("RollUpCopyrights() error = %v", err)
+	}
+	want := []license.CopyrightRollup{{
+		PackageID: "urn:spdx:app",
+		Files:     2,
+		Copyrights: []license.Copyright{
+			{Holder: "Acme, Inc.", FirstYear: 2020, LastYear: 2022},
+			{Holder: "Jane Doe", FirstYear: 2021, LastYear: 2021},
+			{Holder: "Zed Corp", FirstYear: 2024, LastYear: 2024},
+		},
+	}}
+	if !reflect.DeepEqual(rollups, want) {
+		t.Errorf("RollUpCopyrights() = %+v, want %+v", rollups, want)
+	}
+
+	wantText := "Copyright Acme, Inc.\nCopyright Jane Doe\nCopyright Zed Corp"
+	if got := doc.GetPackageByID("urn:spdx:app").CopyrightText; got != wantText {
+		t.Errorf("copyrightText = %q, want %q", got, wantText)
+	}
+	raw := doc.GetElementByID("urn:spdx:app").(map[string]interface{})
+	if got := raw["software_copyrightText"]; got != wantText {
+		t.Errorf("raw copyrightText = %v, want %q", got, wantText)
+	}
+	if got := doc.GetPackageByID("urn:spdx:bare").CopyrightText; got != "NOASSERTION" {
+		t.Errorf("bare copyrightText = %q, want NOASSERTION", got)
+	}
+}
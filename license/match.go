@@ -0,0 +1,248 @@
+// Package license identifies the licenses of raw license texts, such as
+// those of SimpleLicensingText elements or of files, by matching them
+// against the templates of the SPDX License List, and normalizes the
+// copyright statements of packages and files.
+//
+//	m := license.NewMatcher()
+//	if match, ok := m.Best(text); ok && !match.NeedsReview {
+//	    fmt.Println(match.LicenseID)
+//	}
+package license
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Defaults of a Matcher.
+const (
+	DefaultMinConfidence   = 0.6
+	DefaultReviewThreshold = 0.9
+)
+
+// Option configures a Matcher.
+type Option interface {
+	apply(*Matcher)
+}
+
+type optionFunc func(*Matcher)
+
+func (f optionFunc) apply(m *Matcher) { f(m) }
+
+// WithTemplates sets the templates to match texts against, replacing
+// DefaultTemplates.
+func WithTemplates(templates ...*Template) Option {
+	return optionFunc(func(m *Matcher) {
+		m.templates = templates
+	})
+}
+
+// WithMinConfidence sets the confidence below which a template is not a
+// match, DefaultMinConfidence by default.
+func WithMinConfidence(confidence float64) Option {
+	return optionFunc(func(m *Matcher) {
+		m.minConfidence = confidence
+	})
+}
+
+// WithReviewThreshold sets the confidence below which a match needs
+// review, DefaultReviewThreshold by default.
+func WithReviewThreshold(confidence float64) Option {
+	return optionFunc(func(m *Matcher) {
+		m.reviewThreshold = confidence
+	})
+}
+
+// Matcher matches license texts against license templates.
+type Matcher struct {
+	templates       []*Template
+	minConfidence   float64
+	reviewThreshold float64
+}
+
+// NewMatcher creates a Matcher with the given options.
+func NewMatcher(opts ...Option) *Matcher {
+	m := &Matcher{
+		templates:       defaultTemplates(),
+		minConfidence:   DefaultMinConfidence,
+		reviewThreshold: DefaultReviewThreshold,
+	}
+	for _, opt := range opts {
+		opt.apply(m)
+	}
+	return m
+}
+
+// Match is a license a text matches.
+type Match struct {
+	LicenseID string
+	Name      string
+
+	// Confidence is 1 if the text matches the template per the SPDX
+	// matching guidelines, and otherwise the Sørensen-Dice coefficient of
+	// the word pairs of the text and of the fixed text of the template.
+	Confidence float64
+	Exact      bool
+
+	// NeedsReview reports whether a match that is not exact has a
+	// confidence below the review threshold, such as for a modified
+	// license or one with added terms.
+	NeedsReview bool
+}
+
+// Match returns the licenses a text matches, most confident first.
+func (m *Matcher) Match(text string) []Match {
+	w := words(text)
+	normalized := strings.Join(w, " ") + " "
+	bigrams, size := wordBigrams(w)
+
+	var matches []Match
+	for _, t := range m.templates {
+		match := Match{LicenseID: t.ID, Name: t.Name}
+		if t.pattern.MatchString(normalized) {
+			match.Confidence, match.Exact = 1, true
+		} else {
+			match.Confidence = dice(bigrams, size, t.bigrams, t.size)
+		}
+		if match.Confidence < m.minConfidence {
+			continue
+		}
+		match.NeedsReview = !match.Exact && match.Confidence < m.reviewThreshold
+		matches = append(matches, match)
+	}
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		return cmp.Compare(b.Confidence, a.Confidence)
+	})
+	return matches
+}
+
+// Best returns the license a text matches most confidently, or false if
+// it matches none.
+func (m *Matcher) Best(text string) (Match, bool) {
+	matches := m.Match(text)
+	if len(matches) == 0 {
+		return Match{}, false
+	}
+	return matches[0], true
+}
+
+func dice(a map[string]int, sizeA int, b map[string]int, sizeB int) float64 {
+	if sizeA+sizeB == 0 {
+		return 0
+	}
+	common := 0
+	for bigram, n := range a {
+		common += min(n, b[bigram])
+	}
+	return 2 * float64(common) / float64(sizeA+sizeB)
+}
+
+// Suggestion is the listed license identified for a license text element
+// of a document.
+type Suggestion struct {
+	// SpdxID is the ID of the SimpleLicensingText or CustomLicense.
+	SpdxID string
+	Match
+
+	// Expressions are the SPDX IDs of the license expressions Assign
+	// rewrote to use the listed license.
+	Expressions []string
+}
+
+// Identify matches the license texts of the SimpleLicensingText and
+// CustomLicense elements of a document and returns the best match of
+// each that matches a license.
+func (m *Matcher) Identify(doc *parse.Document) []Suggestion {
+	var suggestions []Suggestion
+	suggest := func(spdxID, text string) {
+		if match, ok := m.Best(text); ok {
+			suggestions = append(suggestions, Suggestion{SpdxID: spdxID, Match: match})
+		}
+	}
+	for _, t := range doc.SimpleLicensingTexts {
+		suggest(t.SpdxID, t.LicenseText)
+	}
+	for _, l := range doc.CustomLicenses {
+		suggest(l.SpdxID, l.LicenseText)
+	}
+	return suggestions
+}
+
+// Assign identifies the license texts of a document as Identify does and
+// replaces the references to those matched without needing review in
+// its license expressions by the listed license IDs: a LicenseRef whose
+// customIdToUri entry is the text element becomes the license ID, and the
+// entry is removed. The raw elements in ElementsByID are updated to
+// match. The text elements themselves are kept.
+//
+//	suggestions, err := license.NewMatcher().Assign(doc)
+//	for _, s := range suggestions {
+//	    if s.NeedsReview {
+//	        log.Printf("%s looks like %s (%.0f%%)", s.SpdxID, s.LicenseID, 100*s.Confidence)
+//	    }
+//	}
+func (m *Matcher) Assign(doc *parse.Document) ([]Suggestion, error) {
+	suggestions := m.Identify(doc)
+	var changed []spdx.AnyElement
+	for i := range suggestions {
+		s := &suggestions[i]
+		if s.NeedsReview {
+			continue
+		}
+		for _, le := range doc.LicenseExpressions {
+			rewritten := false
+			le.CustomIdToUri = slices.DeleteFunc(le.CustomIdToUri, func(e spdx.DictionaryEntry) bool {
+				if e.Value != s.SpdxID {
+					return false
+				}
+				le.LicenseExpression = replaceLicenseRef(le.LicenseExpression, e.Key, s.LicenseID)
+				rewritten = true
+				return true
+			})
+			if rewritten {
+				s.Expressions = append(s.Expressions, le.SpdxID)
+				if !slices.Contains(changed, spdx.AnyElement(le)) {
+					changed = append(changed, le)
+				}
+			}
+		}
+	}
+	if err := doc.UpdateElements(changed...); err != nil {
+		return nil, fmt.Errorf("updating expressions: %w", err)
+	}
+	return suggestions, nil
+}
+
+// replaceLicenseRef replaces the license reference ref of a license
+// expression by id.
+func replaceLicenseRef(expr, ref, id string) string {
+	var b strings.Builder
+	for i := 0; i < len(expr); {
+		j := i
+		for j < len(expr) && isIDByte(expr[j]) {
+			j++
+		}
+		if j == i {
+			b.WriteByte(expr[i])
+			i++
+			continue
+		}
+		if expr[i:j] == ref {
+			b.WriteString(id)
+		} else {
+			b.WriteString(expr[i:j])
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// isIDByte reports whether c can be part of a license ID or reference.
+func isIDByte(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-' || c == '.' || c == ':'
+}
@@ -0,0 +1,193 @@
+package license_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/license"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const mitText = `MIT License
+
+Copyright (c) 2024 Jane Doe
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+`
+
+const bsd3Text = `Copyright (c) 2020, Acme Corp.
+All rights reserved.
+
+Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are met:
+
+* Redistributions of source code must retain the above copyright notice, this
+  list of conditions and the following disclaimer.
+
+* Redistributions in binary form must reproduce the above copyright notice,
+  this list of conditions and the following disclaimer in the documentation
+  and/or other materials provided with the distribution.
+
+* Neither the name of Acme Corp. nor the names of its
+  contributors may be used to endorse or promote products derived from
+  this software without specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS "AS IS"
+AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT LIMITED TO, THE
+IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE
+FOR ANY DIRECT, INDIRECT, INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL
+DAMAGES (INCLUDING, BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR
+SERVICES; LOSS OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER
+CAUSED AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY,
+OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+`
+
+const iscText = `Copyright (c) 2015, The Authors
+
+Permission to use, copy, modify, and distribute this software for any
+purpose with or without fee is hereby granted, provided that the above
+copyright notice and this permission notice appear in all copies.
+
+THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+`
+
+// modifiedMIT is the MIT license with a clause added, as some projects do.
+var modifiedMIT = strings.Replace(mitText, "The above copyright notice",
+	"The Software shall be used for Good, not Evil. The above copyright notice", 1)
+
+func TestMatcher_Best(t *testing.T) {
+	m := license.NewMatcher()
+	tests := []struct {
+		name        string
+		text        string
+		wantID      string
+		wantExact   bool
+		wantReview  bool
+		wantNoMatch bool
+	}{
+		{name: "MIT", text: mitText, wantID: "MIT", wantExact: true},
+		{name: "BSD-3-Clause", text: bsd3Text, wantID: "BSD-3-Clause", wantExact: true},
+		{name: "ISC", text: iscText, wantID: "ISC", wantExact: true},
+		{name: "modified MIT", text: modifiedMIT, wantID: "MIT", wantReview: true},
+		{name: "truncated BSD-3-Clause", text: bsd3Text[:len(bsd3Text)/2], wantID: "BSD-3-Clause", wantReview: true},
+		{name: "unrelated", text: "All rights reserved. Do not copy.", wantNoMatch: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := m.Best(tt.text)
+			if tt.wantNoMatch {
+				if ok {
+					t.Errorf("Best() = %+v, want no match", got)
+				}
+				return
+			}
+			if !ok {
+				t.Fatal("Best() found no match")
+			}
+			if got.LicenseID != tt.wantID || got.Exact != tt.wantExact || got.NeedsReview != tt.wantReview {
+				t.Errorf("Best() = %+v, want %s exact %v review %v", got, tt.wantID, tt.wantExact, tt.wantReview)
+			}
+			if got.Exact != (got.Confidence == 1) {
+				t.Errorf("Best() confidence = %v, exact %v", got.Confidence, got.Exact)
+			}
+		})
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	matches := license.NewMatcher(license.WithMinConfidence(0)).Match(mitText)
+	if len(matches) != 6 {
+		t.Fatalf("Match() returned %d matches, want 6", len(matches))
+	}
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Confidence > matches[i-1].Confidence {
+			t.Errorf("Match() not sorted by confidence: %+v", matches)
+		}
+		if matches[i].Exact {
+			t.Errorf("Match() %s matched MIT text exactly", matches[i].LicenseID)
+		}
+	}
+
+	strict := license.NewMatcher(license.WithReviewThreshold(1.01))
+	if got, _ := strict.Best(mitText); got.NeedsReview {
+		t.Errorf("exact match needs review below a threshold over 1: %+v", got)
+	}
+}
+
+func TestMatcher_Assign(t *testing.T) {
+	quote := func(s string) string {
+		data, err := json.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	doc, err := parse.NewReader().Read([]byte(fmt.Sprintf(`{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+		"@graph": [
+			{"type": "simplelicensing_SimpleLicensingText", "spdxId": "urn:spdx:text-mit", "simplelicensing_licenseText": %s},
+			{"type": "simplelicensing_SimpleLicensingText", "spdxId": "urn:spdx:text-evil", "simplelicensing_licenseText": %s},
+			{"type": "simplelicensing_LicenseExpression", "spdxId": "urn:spdx:expr",
+			 "simplelicensing_licenseExpression": "(LicenseRef-mit OR Apache-2.0) AND LicenseRef-evil AND LicenseRef-mitigated",
+			 "simplelicensing_customIdToUri": [
+				{"type": "DictionaryEntry", "key": "LicenseRef-mit", "value": "urn:spdx:text-mit"},
+				{"type": "DictionaryEntry", "key": "LicenseRef-evil", "value": "urn:spdx:text-evil"}
+			 ]}
+		]
+	}`, quote(mitText), quote(modifiedMIT))))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	suggestions, err := license.NewMatcher().Assign(doc)
+	if err != nil {
+		t.Fatalf("Assign() error = %v", err)
+	}
+	if len(suggestions) != 2 {
+		t.Fatalf("Assign() returned %d suggestions, want 2", len(suggestions))
+	}
+	if s := suggestions[0]; s.SpdxID != "urn:spdx:text-mit" || s.LicenseID != "MIT" || len(s.Expressions) != 1 {
+		t.Errorf("suggestion = %+v, want MIT assigned to urn:spdx:expr", s)
+	}
+	if s := suggestions[1]; s.LicenseID != "MIT" || !s.NeedsReview || s.Expressions != nil {
+		t.Errorf("suggestion = %+v, want MIT for review, unassigned", s)
+	}
+
+	le := doc.LicenseExpressionsByID["urn:spdx:expr"]
+	if want := "(MIT OR Apache-2.0) AND LicenseRef-evil AND LicenseRef-mitigated"; le.LicenseExpression != want {
+		t.Errorf("expression = %q, want %q", le.LicenseExpression, want)
+	}
+	if len(le.CustomIdToUri) != 1 || le.CustomIdToUri[0].Key != "LicenseRef-evil" {
+		t.Errorf("customIdToUri = %+v, want only LicenseRef-evil", le.CustomIdToUri)
+	}
+	raw := doc.GetElementByID("urn:spdx:expr").(map[string]interface{})
+	if got := raw["simplelicensing_licenseExpression"]; got != le.LicenseExpression {
+		t.Errorf("raw expression = %v, want %q", got, le.LicenseExpression)
+	}
+}
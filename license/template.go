@@ -0,0 +1,297 @@
+package license
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// TemplateSuffix is the file name suffix of the license templates of the
+// SPDX license-list-data repository, as in "MIT.template.txt".
+const TemplateSuffix = ".template.txt"
+
+// Template is a license of the SPDX License List in the SPDX license
+// template format, in which <<var;...>> marks text that may vary and
+// <<beginOptional>> and <<endOptional>> enclose text that may be left
+// out.
+type Template struct {
+	// ID is the SPDX license ID, such as "MIT".
+	ID   string
+	Name string
+
+	// pattern matches the normalized words of the texts of the license.
+	pattern *regexp.Regexp
+	// bigrams counts the pairs of consecutive words of the fixed text,
+	// optional text included, for scoring texts that do not match.
+	bigrams map[string]int
+	size    int // the sum of bigrams
+}
+
+//go:embed templates/*.template.txt
+var templatesFS embed.FS
+
+// defaultNames are the names of the default templates.
+var defaultNames = map[string]string{
+	"0BSD":         "BSD Zero Clause License",
+	"BSD-2-Clause": `BSD 2-Clause "Simplified" License`,
+	"BSD-3-Clause": `BSD 3-Clause "New" or "Revised" License`,
+	"ISC":          "ISC License",
+	"MIT":          "MIT License",
+	"Unlicense":    "The Unlicense",
+}
+
+var defaultTemplates = sync.OnceValue(func() []*Template {
+	sub, err := fs.Sub(templatesFS, "templates")
+	if err != nil {
+		panic(err)
+	}
+	templates, err := LoadTemplates(sub)
+	if err != nil {
+		panic(err)
+	}
+	for _, t := range templates {
+		t.Name = defaultNames[t.ID]
+	}
+	return templates
+})
+
+// DefaultTemplates returns the templates a Matcher uses unless set
+// WithTemplates: those of the common short permissive licenses 0BSD,
+// BSD-2-Clause, BSD-3-Clause, ISC, MIT and the Unlicense. Load the
+// complete list with LoadTemplates.
+func DefaultTemplates() []*Template {
+	return slices.Clone(defaultTemplates())
+}
+
+// LoadTemplates parses the license templates of a directory, the files
+// ending in TemplateSuffix, such as the template directory of a checkout
+// of https://github.com/spdx/license-list-data. The license ID of each
+// template is its file name without the suffix; the templates have no
+// names.
+//
+//	templates, err := license.LoadTemplates(os.DirFS("license-list-data/template"))
+//	...
+//	m := license.NewMatcher(license.WithTemplates(templates...))
+func LoadTemplates(fsys fs.FS) ([]*Template, error) {
+	names, err := fs.Glob(fsys, "*"+TemplateSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("listing templates: %w", err)
+	}
+	templates := make([]*Template, 0, len(names))
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("reading template: %w", err)
+		}
+		t, err := ParseTemplate(strings.TrimSuffix(path.Base(name), TemplateSuffix), string(data))
+		if err != nil {
+			return nil, fmt.Errorf("template %s: %w", name, err)
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+// ParseTemplate parses the license template of a license, such as the
+// standardLicenseTemplate of a ListedLicense.
+//
+// A variable whose match pattern is a list of alternative phrases, such
+// as "and/or|and", matches one of them; any other variable matches any
+// words, since its pattern is written for the raw text rather than
+// normalized words.
+func ParseTemplate(id, text string) (*Template, error) {
+	nodes, _, err := parseNodes(text, false)
+	if err != nil {
+		return nil, err
+	}
+	var pattern strings.Builder
+	var fixed []string
+	pattern.WriteString("^")
+	compileNodes(nodes, &pattern, &fixed)
+	pattern.WriteString("$")
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, fmt.Errorf("compiling template: %w", err)
+	}
+	bigrams, size := wordBigrams(fixed)
+	return &Template{ID: id, pattern: re, bigrams: bigrams, size: size}, nil
+}
+
+// node is a part of a template: fixed text, a variable or optional text.
+type node struct {
+	text     string
+	variable bool
+	// alternatives are the phrases a variable matches, as normalized
+	// words, or nil if it matches any words.
+	alternatives [][]string
+	optional     []node
+}
+
+// parseNodes parses a template up to the end of the text or, in optional
+// text, the matching <<endOptional>>, and returns the text after it.
+func parseNodes(text string, optional bool) ([]node, string, error) {
+	var nodes []node
+	for {
+		start := strings.Index(text, "<<")
+		if start < 0 {
+			if optional {
+				return nil, "", fmt.Errorf("missing <<endOptional>>")
+			}
+			return append(nodes, node{text: text}), "", nil
+		}
+		nodes = append(nodes, node{text: text[:start]})
+		end := strings.Index(text[start:], ">>")
+		if end < 0 {
+			return nil, "", fmt.Errorf("unterminated tag at %q", abbreviate(text[start:]))
+		}
+		tag := text[start+2 : start+end]
+		text = text[start+end+2:]
+
+		name, attrs, err := parseTag(tag)
+		if err != nil {
+			return nil, "", err
+		}
+		switch name {
+		case "var":
+			nodes = append(nodes, node{variable: true, alternatives: alternatives(attrs["match"])})
+		case "beginOptional":
+			children, rest, err := parseNodes(text, true)
+			if err != nil {
+				return nil, "", err
+			}
+			nodes = append(nodes, node{optional: children})
+			text = rest
+		case "endOptional":
+			if !optional {
+				return nil, "", fmt.Errorf("unexpected <<endOptional>>")
+			}
+			return nodes, text, nil
+		default:
+			return nil, "", fmt.Errorf("unknown tag %q", name)
+		}
+	}
+}
+
+// parseTag returns the name and the attributes of a tag, as in
+// var;name="copyright";match=".{0,5000}".
+func parseTag(tag string) (string, map[string]string, error) {
+	attrs := make(map[string]string)
+	name, rest, _ := strings.Cut(tag, ";")
+	for rest != "" {
+		key, value, ok := strings.Cut(rest, "=")
+		if !ok || !strings.HasPrefix(value, `"`) {
+			return "", nil, fmt.Errorf("malformed attribute in tag %q", abbreviate(tag))
+		}
+		var b strings.Builder
+		i := 1
+		for ; i < len(value) && value[i] != '"'; i++ {
+			if value[i] == '\\' && i+1 < len(value) {
+				i++
+			}
+			b.WriteByte(value[i])
+		}
+		if i == len(value) {
+			return "", nil, fmt.Errorf("unterminated attribute in tag %q", abbreviate(tag))
+		}
+		attrs[strings.TrimSpace(key)] = b.String()
+		rest = strings.TrimPrefix(value[i+1:], ";")
+	}
+	return strings.TrimSpace(name), attrs, nil
+}
+
+// phrases matches the match patterns that are lists of phrases.
+var phrases = regexp.MustCompile(`^[\pL\pN \t|/-]+$`)
+
+func alternatives(match string) [][]string {
+	if !phrases.MatchString(match) {
+		return nil
+	}
+	var alts [][]string
+	for _, alt := range strings.Split(match, "|") {
+		alts = append(alts, words(alt))
+	}
+	return alts
+}
+
+// compileNodes writes the pattern of nodes, over normalized words each
+// followed by a space, and appends their fixed words.
+func compileNodes(nodes []node, pattern *strings.Builder, fixed *[]string) {
+	for _, n := range nodes {
+		switch {
+		case n.variable && n.alternatives == nil:
+			pattern.WriteString(`(?:\S+ )*?`)
+		case n.variable:
+			pattern.WriteString("(?:")
+			for i, alt := range n.alternatives {
+				if i > 0 {
+					pattern.WriteString("|")
+				}
+				for _, w := range alt {
+					pattern.WriteString(regexp.QuoteMeta(w) + " ")
+				}
+			}
+			pattern.WriteString(")")
+		case n.optional != nil:
+			pattern.WriteString("(?:")
+			compileNodes(n.optional, pattern, fixed)
+			pattern.WriteString(")?")
+		default:
+			for _, w := range words(n.text) {
+				pattern.WriteString(regexp.QuoteMeta(w) + " ")
+				*fixed = append(*fixed, w)
+			}
+		}
+	}
+}
+
+// equivalentWords are the words the SPDX matching guidelines treat as the
+// same, by their normalized form.
+var equivalentWords = map[string]string{
+	"acknowledgement": "acknowledgment",
+	"analogue":        "analog",
+	"authorisation":   "authorization",
+	"authorised":      "authorized",
+	"https":           "http",
+	"licence":         "license",
+	"licences":        "licenses",
+	"organisation":    "organization",
+}
+
+// words returns the normalized words of a text, per the SPDX matching
+// guidelines: case, white space and punctuation are ignored, the
+// copyright sign is "c" as in "(c)" and equivalent spellings are the same.
+func words(text string) []string {
+	text = strings.ReplaceAll(strings.ToLower(text), "©", " c ")
+	fields := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range fields {
+		if e, ok := equivalentWords[w]; ok {
+			fields[i] = e
+		}
+	}
+	return fields
+}
+
+// wordBigrams counts the pairs of consecutive words and returns their
+// number.
+func wordBigrams(words []string) (map[string]int, int) {
+	bigrams := make(map[string]int)
+	for i := 1; i < len(words); i++ {
+		bigrams[words[i-1]+" "+words[i]]++
+	}
+	return bigrams, max(len(words)-1, 0)
+}
+
+func abbreviate(s string) string {
+	if len(s) > 40 {
+		return s[:40] + "..."
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+package license_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/interlynk-io/spdx-zen/license"
+)
+
+func TestParseTemplate(t *testing.T) {
+	tmpl, err := license.ParseTemplate("Test", `<<beginOptional>>Test License<<endOptional>>
+<<var;name="copyright";original="Copyright (c) <year>";match=".{0,5000}">>
+You may <<var;name="verb";original="use";match="use|copy">> this, "as is".`)
+	if err != nil {
+		t.Fatalf("ParseTemplate() error = %v", err)
+	}
+	m := license.NewMatcher(license.WithTemplates(tmpl), license.WithMinConfidence(0))
+	tests := []struct {
+		text  string
+		exact bool
+	}{
+		{"Test License\nCopyright 2024 Acme\nYou may use this, 'as is'.", true},
+		{"YOU  MAY COPY THIS AS IS", true},
+		{"Copyright © Acme. You may use this as is.", true},
+		{"You may sell this as is.", false},
+		{"You may use this as is, and more.", false},
+	}
+	for _, tt := range tests {
+		match, ok := m.Best(tt.text)
+		if !ok || match.Exact != tt.exact {
+			t.Errorf("Best(%q) = %+v, want exact %v", tt.text, match, tt.exact)
+		}
+	}
+}
+
+func TestParseTemplate_Errors(t *testing.T) {
+	for _, text := range []string{
+		"<<beginOptional>>never closed",
+		"stray<<endOptional>>",
+		"<<var;name=\"x\"",
+		"<<var;name=x>>",
+		"<<var;name=\"x>>",
+		"<<bold>>",
+	} {
+		if _, err := license.ParseTemplate("X", text); err == nil {
+			t.Errorf("ParseTemplate(%q): want error", text)
+		}
+	}
+}
+
+func TestLoadTemplates(t *testing.T) {
+	fsys := fstest.MapFS{
+		"Foo.template.txt": {Data: []byte("The foo license.")},
+		"Bar.template.txt": {Data: []byte("The <<var;name=\"x\";original=\"bar\";match=\"bar|baz\">> license.")},
+		"README.md":        {Data: []byte("<<beginOptional>>")},
+	}
+	templates, err := license.LoadTemplates(fsys)
+	if err != nil {
+		t.Fatalf("LoadTemplates() error = %v", err)
+	}
+	var ids []string
+	for _, tmpl := range templates {
+		ids = append(ids, tmpl.ID)
+	}
+	if got := strings.Join(ids, ","); got != "Bar,Foo" {
+		t.Errorf("LoadTemplates() IDs = %s, want Bar,Foo", got)
+	}
+
+	fsys["Bad.template.txt"] = &fstest.MapFile{Data: []byte("<<endOptional>>")}
+	if _, err := license.LoadTemplates(fsys); err == nil || !strings.Contains(err.Error(), "Bad.template.txt") {
+		t.Errorf("LoadTemplates() error = %v, want error naming Bad.template.txt", err)
+	}
+}
+
+func TestDefaultTemplates(t *testing.T) {
+	templates := license.DefaultTemplates()
+	if len(templates) != 6 {
+		t.Fatalf("DefaultTemplates() has %d templates, want 6", len(templates))
+	}
+	for _, tmpl := range templates {
+		if tmpl.Name == "" {
+			t.Errorf("template %s has no name", tmpl.ID)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Types of SPDX 2 agent strings.
+const (
+	SPDX2Person       = "Person"
+	SPDX2Organization = "Organization"
+	SPDX2Tool         = "Tool"
+)
+
+// SPDX2Agent is an agent as SPDX 2 documents write it in their creators,
+// suppliers and originators, and as documents converted from them often
+// still do: "Person: Jane Doe (jane@example.com)", "Organization: ACME" or
+// "Tool: scanner-1.0".
+type SPDX2Agent struct {
+	// Type is SPDX2Person, SPDX2Organization or SPDX2Tool.
+	Type  string
+	Name  string
+	Email string
+}
+
+// ParseSPDX2Agent parses an SPDX 2 agent string. The type is matched
+// regardless of case, and the email is the parenthesized text at the end
+// of a person or organization, if it holds an @ or is empty; other
+// parentheses are part of the name.
+func ParseSPDX2Agent(s string) (SPDX2Agent, error) {
+	kind, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return SPDX2Agent{}, fmt.Errorf("agent %q has no type", s)
+	}
+	var a SPDX2Agent
+	switch t := strings.TrimSpace(kind); {
+	case strings.EqualFold(t, SPDX2Person):
+		a.Type = SPDX2Person
+	case strings.EqualFold(t, SPDX2Organization):
+		a.Type = SPDX2Organization
+	case strings.EqualFold(t, SPDX2Tool):
+		a.Type = SPDX2Tool
+	default:
+		return SPDX2Agent{}, fmt.Errorf("agent %q has unknown type %q", s, t)
+	}
+
+	a.Name = strings.TrimSpace(rest)
+	if a.Type != SPDX2Tool && strings.HasSuffix(a.Name, ")") {
+		if open := strings.LastIndex(a.Name, "("); open >= 0 {
+			email := strings.TrimSpace(a.Name[open+1 : len(a.Name)-1])
+			if email == "" || strings.Contains(email, "@") {
+				a.Name, a.Email = strings.TrimSpace(a.Name[:open]), email
+			}
+		}
+	}
+	if a.Name == "" {
+		return SPDX2Agent{}, fmt.Errorf("agent %q has no name", s)
+	}
+	return a, nil
+}
+
+// String returns the agent string of the agent.
+func (a SPDX2Agent) String() string {
+	if a.Type == SPDX2Tool || a.Email == "" {
+		return a.Type + ": " + a.Name
+	}
+	return fmt.Sprintf("%s: %s (%s)", a.Type, a.Name, a.Email)
+}
+
+// Element returns the agent as a *Person, *Organization or *Tool with the
+// given SPDX ID. The email of a person or organization becomes an email
+// ExternalIdentifier.
+func (a SPDX2Agent) Element(spdxID string, creationInfo CreationInfo) AnyElement {
+	elem := NewElement(spdxID, a.Name, creationInfo)
+	if a.Email != "" && a.Type != SPDX2Tool {
+		elem.ExternalIdentifier = append(elem.ExternalIdentifier, NewExternalIdentifier(ExternalIdentifierTypeEmail, a.Email))
+	}
+	switch a.Type {
+	case SPDX2Person:
+		return &Person{Agent: Agent{Element: elem}}
+	case SPDX2Organization:
+		return &Organization{Agent: Agent{Element: elem}}
+	}
+	return &Tool{Element: elem}
+}
+
+// NewAgentFromSPDX2 parses an SPDX 2 agent string and returns it as a
+// *Person, *Organization or *Tool with the given SPDX ID.
+//
+//	elem, err := spdx.NewAgentFromSPDX2("urn:spdx:jane", "Person: Jane Doe (jane@example.com)", ci)
+//	person := elem.(*spdx.Person)
+func NewAgentFromSPDX2(spdxID, s string, creationInfo CreationInfo) (AnyElement, error) {
+	a, err := ParseSPDX2Agent(s)
+	if err != nil {
+		return nil, err
+	}
+	return a.Element(spdxID, creationInfo), nil
+}
@@ -0,0 +1,82 @@
+package spdx_test
+
+import (
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+func TestParseSPDX2Agent(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    spdx.SPDX2Agent
+		wantErr bool
+	}{
+		{in: "Person: Jane Doe (jane@example.com)", want: spdx.SPDX2Agent{Type: spdx.SPDX2Person, Name: "Jane Doe", Email: "jane@example.com"}},
+		{in: "Organization: ACME", want: spdx.SPDX2Agent{Type: spdx.SPDX2Organization, Name: "ACME"}},
+		{in: "organization:ACME Corp ()", want: spdx.SPDX2Agent{Type: spdx.SPDX2Organization, Name: "ACME Corp"}},
+		{in: "Organization: Foo (Europe) GmbH", want: spdx.SPDX2Agent{Type: spdx.SPDX2Organization, Name: "Foo (Europe) GmbH"}},
+		{in: "Person: Bob (Builder) (bob@example.com)", want: spdx.SPDX2Agent{Type: spdx.SPDX2Person, Name: "Bob (Builder)", Email: "bob@example.com"}},
+		{in: "Tool: scanner-1.0 (beta)", want: spdx.SPDX2Agent{Type: spdx.SPDX2Tool, Name: "scanner-1.0 (beta)"}},
+		{in: "Jane Doe", wantErr: true},
+		{in: "Robot: R2", wantErr: true},
+		{in: "Person:  (jane@example.com)", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := spdx.ParseSPDX2Agent(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSPDX2Agent(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSPDX2Agent(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSPDX2Agent_String(t *testing.T) {
+	for _, s := range []string{"Person: Jane Doe (jane@example.com)", "Organization: ACME", "Tool: scanner-1.0"} {
+		a, err := spdx.ParseSPDX2Agent(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := a.String(); got != s {
+			t.Errorf("String() = %q, want %q", got, s)
+		}
+	}
+}
+
+func TestNewAgentFromSPDX2(t *testing.T) {
+	ci := spdx.NewCreationInfo(nil)
+	elem, err := spdx.NewAgentFromSPDX2("urn:spdx:jane", "Person: Jane Doe (jane@example.com)", ci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	person, ok := elem.(*spdx.Person)
+	if !ok {
+		t.Fatalf("NewAgentFromSPDX2() = %T, want *spdx.Person", elem)
+	}
+	if person.SpdxID != "urn:spdx:jane" || person.Name != "Jane Doe" {
+		t.Errorf("person = %s %q", person.SpdxID, person.Name)
+	}
+	if ids := person.ExternalIdentifier; len(ids) != 1 || ids[0].ExternalIdentifierType != spdx.ExternalIdentifierTypeEmail ||
+		ids[0].Identifier != "jane@example.com" {
+		t.Errorf("externalIdentifier = %+v, want email jane@example.com", ids)
+	}
+
+	elem, err = spdx.NewAgentFromSPDX2("urn:spdx:acme", "Organization: ACME", ci)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if org, ok := elem.(*spdx.Organization); !ok || org.Name != "ACME" || len(org.ExternalIdentifier) != 0 {
+		t.Errorf("NewAgentFromSPDX2() = %+v, want organization ACME", elem)
+	}
+	if elem, err := spdx.NewAgentFromSPDX2("urn:spdx:scanner", "Tool: scanner-1.0", ci); err != nil {
+		t.Fatal(err)
+	} else if _, ok := elem.(*spdx.Tool); !ok {
+		t.Errorf("NewAgentFromSPDX2() = %T, want *spdx.Tool", elem)
+	}
+	if _, err := spdx.NewAgentFromSPDX2("urn:spdx:x", "NOASSERTION", ci); err == nil {
+		t.Error("NewAgentFromSPDX2(NOASSERTION): want error")
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+// AnyElement is a sealed interface implemented by every element type in this
+// package. It is intended for heterogeneous collections such as merge or
+// query results. Use the As* helpers to recover a concrete type:
+//
+//	if pkg, ok := spdx.AsPackage(e); ok {
+//	    fmt.Println(pkg.PackageVersion)
+//	}
+//
+// The As* helpers follow the class hierarchy, so AsPackage also succeeds for
+// AIPackage and DatasetPackage and returns their embedded Package.
+type AnyElement interface {
+	ElementInterface
+	asElement() *Element
+}
+
+// Unexported accessors are promoted through struct embedding, which is what
+// makes the As* helpers hierarchy-aware without a type switch per subclass.
+
+func (e *Element) asElement() *Element                                              { return e }
+func (a *Artifact) asArtifact() *Artifact                                           { return a }
+func (sa *SoftwareArtifact) asSoftwareArtifact() *SoftwareArtifact                  { return sa }
+func (p *Package) asPackage() *Package                                              { return p }
+func (f *File) asFile() *File                                                       { return f }
+func (s *Snippet) asSnippet() *Snippet                                              { return s }
+func (r *Relationship) asRelationship() *Relationship                               { return r }
+func (a *Annotation) asAnnotation() *Annotation                                     { return a }
+func (a *Agent) asAgent() *Agent                                                    { return a }
+func (p *Person) asPerson() *Person                                                 { return p }
+func (o *Organization) asOrganization() *Organization                               { return o }
+func (sa *SoftwareAgent) asSoftwareAgent() *SoftwareAgent                           { return sa }
+func (t *Tool) asTool() *Tool                                                       { return t }
+func (ec *ElementCollection) asElementCollection() *ElementCollection               { return ec }
+func (b *Bundle) asBundle() *Bundle                                                 { return b }
+func (b *Bom) asBom() *Bom                                                          { return b }
+func (s *Sbom) asSbom() *Sbom                                                       { return s }
+func (d *SpdxDocument) asSpdxDocument() *SpdxDocument                               { return d }
+func (l *AnyLicenseInfo) asAnyLicenseInfo() *AnyLicenseInfo                         { return l }
+func (v *Vulnerability) asVulnerability() *Vulnerability                            { return v }
+func (v *VulnAssessmentRelationship) asVulnAssessment() *VulnAssessmentRelationship { return v }
+func (b *Build) asBuild() *Build                                                    { return b }
+func (p *AIPackage) asAIPackage() *AIPackage                                        { return p }
+func (p *DatasetPackage) asDatasetPackage() *DatasetPackage                         { return p }
+
+// AsElement returns the core Element of e.
+func AsElement(e AnyElement) *Element {
+	if e == nil {
+		return nil
+	}
+	return e.asElement()
+}
+
+// AsArtifact returns e as an Artifact if it is one.
+func AsArtifact(e AnyElement) (*Artifact, bool) {
+	v, ok := e.(interface{ asArtifact() *Artifact })
+	if !ok {
+		return nil, false
+	}
+	return v.asArtifact(), true
+}
+
+// AsSoftwareArtifact returns e as a SoftwareArtifact if it is one.
+func AsSoftwareArtifact(e AnyElement) (*SoftwareArtifact, bool) {
+	v, ok := e.(interface{ asSoftwareArtifact() *SoftwareArtifact })
+	if !ok {
+		return nil, false
+	}
+	return v.asSoftwareArtifact(), true
+}
+
+// AsPackage returns e as a Package if it is one.
+func AsPackage(e AnyElement) (*Package, bool) {
+	v, ok := e.(interface{ asPackage() *Package })
+	if !ok {
+		return nil, false
+	}
+	return v.asPackage(), true
+}
+
+// AsFile returns e as a File if it is one.
+func AsFile(e AnyElement) (*File, bool) {
+	v, ok := e.(interface{ asFile() *File })
+	if !ok {
+		return nil, false
+	}
+	return v.asFile(), true
+}
+
+// AsSnippet returns e as a Snippet if it is one.
+func AsSnippet(e AnyElement) (*Snippet, bool) {
+	v, ok := e.(interface{ asSnippet() *Snippet })
+	if !ok {
+		return nil, false
+	}
+	return v.asSnippet(), true
+}
+
+// AsRelationship returns e as a Relationship if it is one. This includes
+// lifecycle-scoped relationships and vulnerability assessments.
+func AsRelationship(e AnyElement) (*Relationship, bool) {
+	v, ok := e.(interface{ asRelationship() *Relationship })
+	if !ok {
+		return nil, false
+	}
+	return v.asRelationship(), true
+}
+
+// AsAnnotation returns e as an Annotation if it is one.
+func AsAnnotation(e AnyElement) (*Annotation, bool) {
+	v, ok := e.(interface{ asAnnotation() *Annotation })
+	if !ok {
+		return nil, false
+	}
+	return v.asAnnotation(), true
+}
+
+// AsAgent returns e as an Agent if it is one (Person, Organization, SoftwareAgent).
+func AsAgent(e AnyElement) (*Agent, bool) {
+	v, ok := e.(interface{ asAgent() *Agent })
+	if !ok {
+		return nil, false
+	}
+	return v.asAgent(), true
+}
+
+// AsPerson returns e as a Person if it is one.
+func AsPerson(e AnyElement) (*Person, bool) {
+	v, ok := e.(interface{ asPerson() *Person })
+	if !ok {
+		return nil, false
+	}
+	return v.asPerson(), true
+}
+
+// AsOrganization returns e as an Organization if it is one.
+func AsOrganization(e AnyElement) (*Organization, bool) {
+	v, ok := e.(interface{ asOrganization() *Organization })
+	if !ok {
+		return nil, false
+	}
+	return v.asOrganization(), true
+}
+
+// AsSoftwareAgent returns e as a SoftwareAgent if it is one.
+func AsSoftwareAgent(e AnyElement) (*SoftwareAgent, bool) {
+	v, ok := e.(interface{ asSoftwareAgent() *SoftwareAgent })
+	if !ok {
+		return nil, false
+	}
+	return v.asSoftwareAgent(), true
+}
+
+// AsTool returns e as a Tool if it is one.
+func AsTool(e AnyElement) (*Tool, bool) {
+	v, ok := e.(interface{ asTool() *Tool })
+	if !ok {
+		return nil, false
+	}
+	return v.asTool(), true
+}
+
+// AsElementCollection returns e as an ElementCollection if it is one
+// (SpdxDocument, Bundle, Bom, Sbom).
+func AsElementCollection(e AnyElement) (*ElementCollection, bool) {
+	v, ok := e.(interface{ asElementCollection() *ElementCollection })
+	if !ok {
+		return nil, false
+	}
+	return v.asElementCollection(), true
+}
+
+// AsBundle returns e as a Bundle if it is one.
+func AsBundle(e AnyElement) (*Bundle, bool) {
+	v, ok := e.(interface{ asBundle() *Bundle })
+	if !ok {
+		return nil, false
+	}
+	return v.asBundle(), true
+}
+
+// AsBom returns e as a Bom if it is one.
+func AsBom(e AnyElement) (*Bom, bool) {
+	v, ok := e.(interface{ asBom() *Bom })
+	if !ok {
+		return nil, false
+	}
+	return v.asBom(), true
+}
+
+// AsSbom returns e as an Sbom if it is one.
+func AsSbom(e AnyElement) (*Sbom, bool) {
+	v, ok := e.(interface{ asSbom() *Sbom })
+	if !ok {
+		return nil, false
+	}
+	return v.asSbom(), true
+}
+
+// AsSpdxDocument returns e as an SpdxDocument if it is one.
+func AsSpdxDocument(e AnyElement) (*SpdxDocument, bool) {
+	v, ok := e.(interface{ asSpdxDocument() *SpdxDocument })
+	if !ok {
+		return nil, false
+	}
+	return v.asSpdxDocument(), true
+}
+
+// AsAnyLicenseInfo returns e as an AnyLicenseInfo if it is one.
+func AsAnyLicenseInfo(e AnyElement) (*AnyLicenseInfo, bool) {
+	v, ok := e.(interface{ asAnyLicenseInfo() *AnyLicenseInfo })
+	if !ok {
+		return nil, false
+	}
+	return v.asAnyLicenseInfo(), true
+}
+
+// AsVulnerability returns e as a Vulnerability if it is one.
+func AsVulnerability(e AnyElement) (*Vulnerability, bool) {
+	v, ok := e.(interface{ asVulnerability() *Vulnerability })
+	if !ok {
+		return nil, false
+	}
+	return v.asVulnerability(), true
+}
+
+// AsVulnAssessment returns e as a VulnAssessmentRelationship if it is one
+// (CVSS, EPSS, SSVC, exploit catalog and VEX assessments).
+func AsVulnAssessment(e AnyElement) (*VulnAssessmentRelationship, bool) {
+	v, ok := e.(interface {
+		asVulnAssessment() *VulnAssessmentRelationship
+	})
+	if !ok {
+		return nil, false
+	}
+	return v.asVulnAssessment(), true
+}
+
+// AsBuild returns e as a Build if it is one.
+func AsBuild(e AnyElement) (*Build, bool) {
+	v, ok := e.(interface{ asBuild() *Build })
+	if !ok {
+		return nil, false
+	}
+	return v.asBuild(), true
+}
+
+// AsAIPackage returns e as an AIPackage if it is one.
+func AsAIPackage(e AnyElement) (*AIPackage, bool) {
+	v, ok := e.(interface{ asAIPackage() *AIPackage })
+	if !ok {
+		return nil, false
+	}
+	return v.asAIPackage(), true
+}
+
+// AsDatasetPackage returns e as a DatasetPackage if it is one.
+func AsDatasetPackage(e AnyElement) (*DatasetPackage, bool) {
+	v, ok := e.(interface{ asDatasetPackage() *DatasetPackage })
+	if !ok {
+		return nil, false
+	}
+	return v.asDatasetPackage(), true
+}
@@ -0,0 +1,1395 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+// Copy returns a deep copy of the AIPackage that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *AIPackage) Copy() *AIPackage {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the AIPackage as a *AIPackage.
+func (o *AIPackage) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *AIPackage) copyFields(c *AIPackage) {
+	o.Package.copyFields(&c.Package)
+	c.Domain = copySlice(o.Domain)
+	c.EnergyConsumption = o.EnergyConsumption.Copy()
+	c.Hyperparameter = copyObjects(o.Hyperparameter, (*DictionaryEntry).copyFields)
+	c.Metric = copyObjects(o.Metric, (*DictionaryEntry).copyFields)
+	c.MetricDecisionThreshold = copyObjects(o.MetricDecisionThreshold, (*DictionaryEntry).copyFields)
+	c.ModelDataPreprocessing = copySlice(o.ModelDataPreprocessing)
+	c.ModelExplainability = copySlice(o.ModelExplainability)
+	c.StandardCompliance = copySlice(o.StandardCompliance)
+	c.TypeOfModel = copySlice(o.TypeOfModel)
+}
+
+// Copy returns a deep copy of the EnergyConsumption that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *EnergyConsumption) Copy() *EnergyConsumption {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the EnergyConsumption as a *EnergyConsumption.
+func (o *EnergyConsumption) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *EnergyConsumption) copyFields(c *EnergyConsumption) {
+	c.FinetuningEnergyConsumption = copyObjects(o.FinetuningEnergyConsumption, (*EnergyConsumptionDescription).copyFields)
+	c.InferenceEnergyConsumption = copyObjects(o.InferenceEnergyConsumption, (*EnergyConsumptionDescription).copyFields)
+	c.TrainingEnergyConsumption = copyObjects(o.TrainingEnergyConsumption, (*EnergyConsumptionDescription).copyFields)
+}
+
+// Copy returns a deep copy of the EnergyConsumptionDescription that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *EnergyConsumptionDescription) Copy() *EnergyConsumptionDescription {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the EnergyConsumptionDescription as a *EnergyConsumptionDescription.
+func (o *EnergyConsumptionDescription) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *EnergyConsumptionDescription) copyFields(c *EnergyConsumptionDescription) {
+}
+
+// Copy returns a deep copy of the Build that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Build) Copy() *Build {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Build as a *Build.
+func (o *Build) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Build) copyFields(c *Build) {
+	o.Element.copyFields(&c.Element)
+	c.ConfigSourceEntrypoint = copySlice(o.ConfigSourceEntrypoint)
+	c.ConfigSourceUri = copySlice(o.ConfigSourceUri)
+	c.ConfigSourceDigest = copyObjects(o.ConfigSourceDigest, (*Hash).copyFields)
+	c.Parameter = copyObjects(o.Parameter, (*DictionaryEntry).copyFields)
+	c.Environment = copyObjects(o.Environment, (*DictionaryEntry).copyFields)
+}
+
+// Copy returns a deep copy of the Agent that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Agent) Copy() *Agent {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Agent as a *Agent.
+func (o *Agent) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Agent) copyFields(c *Agent) {
+	o.Element.copyFields(&c.Element)
+}
+
+// Copy returns a deep copy of the Annotation that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Annotation) Copy() *Annotation {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Annotation as a *Annotation.
+func (o *Annotation) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Annotation) copyFields(c *Annotation) {
+	o.Element.copyFields(&c.Element)
+	o.Subject.copyFields(&c.Subject)
+}
+
+// Copy returns a deep copy of the Artifact that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Artifact) Copy() *Artifact {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Artifact as a *Artifact.
+func (o *Artifact) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Artifact) copyFields(c *Artifact) {
+	o.Element.copyFields(&c.Element)
+	c.OriginatedBy = copyObjects(o.OriginatedBy, (*Agent).copyFields)
+	c.SuppliedBy = o.SuppliedBy.Copy()
+	c.StandardName = copySlice(o.StandardName)
+	c.SupportLevel = copySlice(o.SupportLevel)
+}
+
+// Copy returns a deep copy of the Bom that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Bom) Copy() *Bom {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Bom as a *Bom.
+func (o *Bom) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Bom) copyFields(c *Bom) {
+	o.Bundle.copyFields(&c.Bundle)
+}
+
+// Copy returns a deep copy of the Bundle that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Bundle) Copy() *Bundle {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Bundle as a *Bundle.
+func (o *Bundle) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Bundle) copyFields(c *Bundle) {
+	o.ElementCollection.copyFields(&c.ElementCollection)
+}
+
+// Copy returns a deep copy of the CreationInfo that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CreationInfo) Copy() *CreationInfo {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CreationInfo as a *CreationInfo.
+func (o *CreationInfo) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CreationInfo) copyFields(c *CreationInfo) {
+	c.CreatedBy = copyObjects(o.CreatedBy, (*Agent).copyFields)
+	c.CreatedUsing = copyObjects(o.CreatedUsing, (*Tool).copyFields)
+}
+
+// Copy returns a deep copy of the DictionaryEntry that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *DictionaryEntry) Copy() *DictionaryEntry {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the DictionaryEntry as a *DictionaryEntry.
+func (o *DictionaryEntry) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *DictionaryEntry) copyFields(c *DictionaryEntry) {
+}
+
+// Copy returns a deep copy of the Element that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Element) Copy() *Element {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Element as a *Element.
+func (o *Element) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Element) copyFields(c *Element) {
+	o.CreationInfo.copyFields(&c.CreationInfo)
+	c.VerifiedUsing = cloneSlice(o.VerifiedUsing)
+	c.ExternalRef = copyObjects(o.ExternalRef, (*ExternalRef).copyFields)
+	c.ExternalIdentifier = copyObjects(o.ExternalIdentifier, (*ExternalIdentifier).copyFields)
+	c.Extension = cloneSlice(o.Extension)
+}
+
+// Copy returns a deep copy of the ElementCollection that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ElementCollection) Copy() *ElementCollection {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ElementCollection as a *ElementCollection.
+func (o *ElementCollection) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ElementCollection) copyFields(c *ElementCollection) {
+	o.Element.copyFields(&c.Element)
+	c.Elements = copyObjects(o.Elements, (*Element).copyFields)
+	c.RootElement = copyObjects(o.RootElement, (*Element).copyFields)
+	c.ProfileConformance = copySlice(o.ProfileConformance)
+}
+
+// Copy returns a deep copy of the ExternalIdentifier that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ExternalIdentifier) Copy() *ExternalIdentifier {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ExternalIdentifier as a *ExternalIdentifier.
+func (o *ExternalIdentifier) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ExternalIdentifier) copyFields(c *ExternalIdentifier) {
+	c.IdentifierLocator = copySlice(o.IdentifierLocator)
+}
+
+// Copy returns a deep copy of the ExternalMap that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ExternalMap) Copy() *ExternalMap {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ExternalMap as a *ExternalMap.
+func (o *ExternalMap) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ExternalMap) copyFields(c *ExternalMap) {
+	c.VerifiedUsing = cloneSlice(o.VerifiedUsing)
+	c.DefiningArtifact = o.DefiningArtifact.Copy()
+}
+
+// Copy returns a deep copy of the ExternalRef that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ExternalRef) Copy() *ExternalRef {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ExternalRef as a *ExternalRef.
+func (o *ExternalRef) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ExternalRef) copyFields(c *ExternalRef) {
+	c.Locator = copySlice(o.Locator)
+}
+
+// Copy returns a deep copy of the Hash that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Hash) Copy() *Hash {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Hash as a *Hash.
+func (o *Hash) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Hash) copyFields(c *Hash) {
+	o.IntegrityMethod.copyFields(&c.IntegrityMethod)
+}
+
+// Copy returns a deep copy of the IndividualElement that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *IndividualElement) Copy() *IndividualElement {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the IndividualElement as a *IndividualElement.
+func (o *IndividualElement) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *IndividualElement) copyFields(c *IndividualElement) {
+	o.Element.copyFields(&c.Element)
+}
+
+// Copy returns a deep copy of the IntegrityMethod that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *IntegrityMethod) Copy() *IntegrityMethod {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the IntegrityMethod as a *IntegrityMethod.
+func (o *IntegrityMethod) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *IntegrityMethod) copyFields(c *IntegrityMethod) {
+}
+
+// Copy returns a deep copy of the LifecycleScopedRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *LifecycleScopedRelationship) Copy() *LifecycleScopedRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the LifecycleScopedRelationship as a *LifecycleScopedRelationship.
+func (o *LifecycleScopedRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *LifecycleScopedRelationship) copyFields(c *LifecycleScopedRelationship) {
+	o.Relationship.copyFields(&c.Relationship)
+}
+
+// Copy returns a deep copy of the NamespaceMap that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *NamespaceMap) Copy() *NamespaceMap {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the NamespaceMap as a *NamespaceMap.
+func (o *NamespaceMap) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *NamespaceMap) copyFields(c *NamespaceMap) {
+}
+
+// Copy returns a deep copy of the Organization that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Organization) Copy() *Organization {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Organization as a *Organization.
+func (o *Organization) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Organization) copyFields(c *Organization) {
+	o.Agent.copyFields(&c.Agent)
+}
+
+// Copy returns a deep copy of the PackageVerificationCode that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *PackageVerificationCode) Copy() *PackageVerificationCode {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the PackageVerificationCode as a *PackageVerificationCode.
+func (o *PackageVerificationCode) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *PackageVerificationCode) copyFields(c *PackageVerificationCode) {
+	o.IntegrityMethod.copyFields(&c.IntegrityMethod)
+	c.PackageVerificationCodeExcludedFile = copySlice(o.PackageVerificationCodeExcludedFile)
+}
+
+// Copy returns a deep copy of the Person that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Person) Copy() *Person {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Person as a *Person.
+func (o *Person) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Person) copyFields(c *Person) {
+	o.Agent.copyFields(&c.Agent)
+}
+
+// Copy returns a deep copy of the PositiveIntegerRange that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *PositiveIntegerRange) Copy() *PositiveIntegerRange {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the PositiveIntegerRange as a *PositiveIntegerRange.
+func (o *PositiveIntegerRange) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *PositiveIntegerRange) copyFields(c *PositiveIntegerRange) {
+}
+
+// Copy returns a deep copy of the Relationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Relationship) Copy() *Relationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Relationship as a *Relationship.
+func (o *Relationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Relationship) copyFields(c *Relationship) {
+	o.Element.copyFields(&c.Element)
+	o.From.copyFields(&c.From)
+	c.To = copyObjects(o.To, (*Element).copyFields)
+}
+
+// Copy returns a deep copy of the SoftwareAgent that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *SoftwareAgent) Copy() *SoftwareAgent {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the SoftwareAgent as a *SoftwareAgent.
+func (o *SoftwareAgent) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *SoftwareAgent) copyFields(c *SoftwareAgent) {
+	o.Agent.copyFields(&c.Agent)
+}
+
+// Copy returns a deep copy of the SpdxDocument that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *SpdxDocument) Copy() *SpdxDocument {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the SpdxDocument as a *SpdxDocument.
+func (o *SpdxDocument) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *SpdxDocument) copyFields(c *SpdxDocument) {
+	o.ElementCollection.copyFields(&c.ElementCollection)
+	c.Import = copyObjects(o.Import, (*ExternalMap).copyFields)
+	c.NamespaceMap = copyObjects(o.NamespaceMap, (*NamespaceMap).copyFields)
+	c.DataLicense = o.DataLicense.Copy()
+}
+
+// Copy returns a deep copy of the Tool that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Tool) Copy() *Tool {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Tool as a *Tool.
+func (o *Tool) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Tool) copyFields(c *Tool) {
+	o.Element.copyFields(&c.Element)
+}
+
+// Copy returns a deep copy of the DatasetPackage that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *DatasetPackage) Copy() *DatasetPackage {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the DatasetPackage as a *DatasetPackage.
+func (o *DatasetPackage) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *DatasetPackage) copyFields(c *DatasetPackage) {
+	o.Package.copyFields(&c.Package)
+	c.AnonymizationMethodUsed = copySlice(o.AnonymizationMethodUsed)
+	c.DataPreprocessing = copySlice(o.DataPreprocessing)
+	c.DatasetType = copySlice(o.DatasetType)
+	c.KnownBias = copySlice(o.KnownBias)
+	c.Sensor = copyObjects(o.Sensor, (*DictionaryEntry).copyFields)
+}
+
+// Copy returns a deep copy of the ConjunctiveLicenseSet that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ConjunctiveLicenseSet) Copy() *ConjunctiveLicenseSet {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ConjunctiveLicenseSet as a *ConjunctiveLicenseSet.
+func (o *ConjunctiveLicenseSet) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ConjunctiveLicenseSet) copyFields(c *ConjunctiveLicenseSet) {
+	o.AnyLicenseInfo.copyFields(&c.AnyLicenseInfo)
+	c.Member = copyObjects(o.Member, (*AnyLicenseInfo).copyFields)
+}
+
+// Copy returns a deep copy of the CustomLicense that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CustomLicense) Copy() *CustomLicense {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CustomLicense as a *CustomLicense.
+func (o *CustomLicense) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CustomLicense) copyFields(c *CustomLicense) {
+	o.License.copyFields(&c.License)
+}
+
+// Copy returns a deep copy of the CustomLicenseAddition that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CustomLicenseAddition) Copy() *CustomLicenseAddition {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CustomLicenseAddition as a *CustomLicenseAddition.
+func (o *CustomLicenseAddition) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CustomLicenseAddition) copyFields(c *CustomLicenseAddition) {
+	o.LicenseAddition.copyFields(&c.LicenseAddition)
+}
+
+// Copy returns a deep copy of the DisjunctiveLicenseSet that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *DisjunctiveLicenseSet) Copy() *DisjunctiveLicenseSet {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the DisjunctiveLicenseSet as a *DisjunctiveLicenseSet.
+func (o *DisjunctiveLicenseSet) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *DisjunctiveLicenseSet) copyFields(c *DisjunctiveLicenseSet) {
+	o.AnyLicenseInfo.copyFields(&c.AnyLicenseInfo)
+	c.Member = copyObjects(o.Member, (*AnyLicenseInfo).copyFields)
+}
+
+// Copy returns a deep copy of the ExtendableLicense that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ExtendableLicense) Copy() *ExtendableLicense {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ExtendableLicense as a *ExtendableLicense.
+func (o *ExtendableLicense) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ExtendableLicense) copyFields(c *ExtendableLicense) {
+	o.AnyLicenseInfo.copyFields(&c.AnyLicenseInfo)
+}
+
+// Copy returns a deep copy of the IndividualLicensingInfo that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *IndividualLicensingInfo) Copy() *IndividualLicensingInfo {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the IndividualLicensingInfo as a *IndividualLicensingInfo.
+func (o *IndividualLicensingInfo) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *IndividualLicensingInfo) copyFields(c *IndividualLicensingInfo) {
+	o.AnyLicenseInfo.copyFields(&c.AnyLicenseInfo)
+}
+
+// Copy returns a deep copy of the License that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *License) Copy() *License {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the License as a *License.
+func (o *License) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *License) copyFields(c *License) {
+	o.ExtendableLicense.copyFields(&c.ExtendableLicense)
+	c.SeeAlso = copySlice(o.SeeAlso)
+}
+
+// Copy returns a deep copy of the LicenseAddition that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *LicenseAddition) Copy() *LicenseAddition {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the LicenseAddition as a *LicenseAddition.
+func (o *LicenseAddition) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *LicenseAddition) copyFields(c *LicenseAddition) {
+	o.Element.copyFields(&c.Element)
+	c.SeeAlso = copySlice(o.SeeAlso)
+}
+
+// Copy returns a deep copy of the ListedLicense that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ListedLicense) Copy() *ListedLicense {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ListedLicense as a *ListedLicense.
+func (o *ListedLicense) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ListedLicense) copyFields(c *ListedLicense) {
+	o.License.copyFields(&c.License)
+}
+
+// Copy returns a deep copy of the ListedLicenseException that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ListedLicenseException) Copy() *ListedLicenseException {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ListedLicenseException as a *ListedLicenseException.
+func (o *ListedLicenseException) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ListedLicenseException) copyFields(c *ListedLicenseException) {
+	o.LicenseAddition.copyFields(&c.LicenseAddition)
+}
+
+// Copy returns a deep copy of the OrLaterOperator that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *OrLaterOperator) Copy() *OrLaterOperator {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the OrLaterOperator as a *OrLaterOperator.
+func (o *OrLaterOperator) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *OrLaterOperator) copyFields(c *OrLaterOperator) {
+	o.ExtendableLicense.copyFields(&c.ExtendableLicense)
+	o.SubjectLicense.copyFields(&c.SubjectLicense)
+}
+
+// Copy returns a deep copy of the WithAdditionOperator that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *WithAdditionOperator) Copy() *WithAdditionOperator {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the WithAdditionOperator as a *WithAdditionOperator.
+func (o *WithAdditionOperator) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *WithAdditionOperator) copyFields(c *WithAdditionOperator) {
+	o.AnyLicenseInfo.copyFields(&c.AnyLicenseInfo)
+	o.SubjectAddition.copyFields(&c.SubjectAddition)
+	o.SubjectExtendableLicense.copyFields(&c.SubjectExtendableLicense)
+}
+
+// Copy returns a deep copy of the CdxPropertiesExtension that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CdxPropertiesExtension) Copy() *CdxPropertiesExtension {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CdxPropertiesExtension as a *CdxPropertiesExtension.
+func (o *CdxPropertiesExtension) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CdxPropertiesExtension) copyFields(c *CdxPropertiesExtension) {
+	o.Extension.copyFields(&c.Extension)
+	c.CdxProperty = copyObjects(o.CdxProperty, (*CdxPropertyEntry).copyFields)
+}
+
+// Copy returns a deep copy of the CdxPropertyEntry that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CdxPropertyEntry) Copy() *CdxPropertyEntry {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CdxPropertyEntry as a *CdxPropertyEntry.
+func (o *CdxPropertyEntry) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CdxPropertyEntry) copyFields(c *CdxPropertyEntry) {
+}
+
+// Copy returns a deep copy of the Extension that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Extension) Copy() *Extension {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Extension as a *Extension.
+func (o *Extension) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Extension) copyFields(c *Extension) {
+}
+
+// Copy returns a deep copy of the CvssV2VulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CvssV2VulnAssessmentRelationship) Copy() *CvssV2VulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CvssV2VulnAssessmentRelationship as a *CvssV2VulnAssessmentRelationship.
+func (o *CvssV2VulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CvssV2VulnAssessmentRelationship) copyFields(c *CvssV2VulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the CvssV3VulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CvssV3VulnAssessmentRelationship) Copy() *CvssV3VulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CvssV3VulnAssessmentRelationship as a *CvssV3VulnAssessmentRelationship.
+func (o *CvssV3VulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CvssV3VulnAssessmentRelationship) copyFields(c *CvssV3VulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the CvssV4VulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *CvssV4VulnAssessmentRelationship) Copy() *CvssV4VulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the CvssV4VulnAssessmentRelationship as a *CvssV4VulnAssessmentRelationship.
+func (o *CvssV4VulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *CvssV4VulnAssessmentRelationship) copyFields(c *CvssV4VulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the EpssVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *EpssVulnAssessmentRelationship) Copy() *EpssVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the EpssVulnAssessmentRelationship as a *EpssVulnAssessmentRelationship.
+func (o *EpssVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *EpssVulnAssessmentRelationship) copyFields(c *EpssVulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the ExploitCatalogVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ExploitCatalogVulnAssessmentRelationship) Copy() *ExploitCatalogVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ExploitCatalogVulnAssessmentRelationship as a *ExploitCatalogVulnAssessmentRelationship.
+func (o *ExploitCatalogVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ExploitCatalogVulnAssessmentRelationship) copyFields(c *ExploitCatalogVulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the SsvcVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *SsvcVulnAssessmentRelationship) Copy() *SsvcVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the SsvcVulnAssessmentRelationship as a *SsvcVulnAssessmentRelationship.
+func (o *SsvcVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *SsvcVulnAssessmentRelationship) copyFields(c *SsvcVulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the VexAffectedVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *VexAffectedVulnAssessmentRelationship) Copy() *VexAffectedVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the VexAffectedVulnAssessmentRelationship as a *VexAffectedVulnAssessmentRelationship.
+func (o *VexAffectedVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *VexAffectedVulnAssessmentRelationship) copyFields(c *VexAffectedVulnAssessmentRelationship) {
+	o.VexVulnAssessmentRelationship.copyFields(&c.VexVulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the VexFixedVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *VexFixedVulnAssessmentRelationship) Copy() *VexFixedVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the VexFixedVulnAssessmentRelationship as a *VexFixedVulnAssessmentRelationship.
+func (o *VexFixedVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *VexFixedVulnAssessmentRelationship) copyFields(c *VexFixedVulnAssessmentRelationship) {
+	o.VexVulnAssessmentRelationship.copyFields(&c.VexVulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the VexNotAffectedVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *VexNotAffectedVulnAssessmentRelationship) Copy() *VexNotAffectedVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the VexNotAffectedVulnAssessmentRelationship as a *VexNotAffectedVulnAssessmentRelationship.
+func (o *VexNotAffectedVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *VexNotAffectedVulnAssessmentRelationship) copyFields(c *VexNotAffectedVulnAssessmentRelationship) {
+	o.VexVulnAssessmentRelationship.copyFields(&c.VexVulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the VexUnderInvestigationVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *VexUnderInvestigationVulnAssessmentRelationship) Copy() *VexUnderInvestigationVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the VexUnderInvestigationVulnAssessmentRelationship as a *VexUnderInvestigationVulnAssessmentRelationship.
+func (o *VexUnderInvestigationVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *VexUnderInvestigationVulnAssessmentRelationship) copyFields(c *VexUnderInvestigationVulnAssessmentRelationship) {
+	o.VexVulnAssessmentRelationship.copyFields(&c.VexVulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the VexVulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *VexVulnAssessmentRelationship) Copy() *VexVulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the VexVulnAssessmentRelationship as a *VexVulnAssessmentRelationship.
+func (o *VexVulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *VexVulnAssessmentRelationship) copyFields(c *VexVulnAssessmentRelationship) {
+	o.VulnAssessmentRelationship.copyFields(&c.VulnAssessmentRelationship)
+}
+
+// Copy returns a deep copy of the VulnAssessmentRelationship that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *VulnAssessmentRelationship) Copy() *VulnAssessmentRelationship {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the VulnAssessmentRelationship as a *VulnAssessmentRelationship.
+func (o *VulnAssessmentRelationship) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *VulnAssessmentRelationship) copyFields(c *VulnAssessmentRelationship) {
+	o.Relationship.copyFields(&c.Relationship)
+	c.AssessedElement = o.AssessedElement.Copy()
+	c.SuppliedBy = o.SuppliedBy.Copy()
+}
+
+// Copy returns a deep copy of the Vulnerability that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Vulnerability) Copy() *Vulnerability {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Vulnerability as a *Vulnerability.
+func (o *Vulnerability) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Vulnerability) copyFields(c *Vulnerability) {
+	o.Artifact.copyFields(&c.Artifact)
+}
+
+// Copy returns a deep copy of the AnyLicenseInfo that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *AnyLicenseInfo) Copy() *AnyLicenseInfo {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the AnyLicenseInfo as a *AnyLicenseInfo.
+func (o *AnyLicenseInfo) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *AnyLicenseInfo) copyFields(c *AnyLicenseInfo) {
+	o.Element.copyFields(&c.Element)
+}
+
+// Copy returns a deep copy of the LicenseExpression that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *LicenseExpression) Copy() *LicenseExpression {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the LicenseExpression as a *LicenseExpression.
+func (o *LicenseExpression) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *LicenseExpression) copyFields(c *LicenseExpression) {
+	o.AnyLicenseInfo.copyFields(&c.AnyLicenseInfo)
+	c.CustomIdToUri = copyObjects(o.CustomIdToUri, (*DictionaryEntry).copyFields)
+}
+
+// Copy returns a deep copy of the SimpleLicensingText that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *SimpleLicensingText) Copy() *SimpleLicensingText {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the SimpleLicensingText as a *SimpleLicensingText.
+func (o *SimpleLicensingText) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *SimpleLicensingText) copyFields(c *SimpleLicensingText) {
+	o.Element.copyFields(&c.Element)
+}
+
+// Copy returns a deep copy of the ContentIdentifier that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *ContentIdentifier) Copy() *ContentIdentifier {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the ContentIdentifier as a *ContentIdentifier.
+func (o *ContentIdentifier) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *ContentIdentifier) copyFields(c *ContentIdentifier) {
+	o.IntegrityMethod.copyFields(&c.IntegrityMethod)
+}
+
+// Copy returns a deep copy of the File that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *File) Copy() *File {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the File as a *File.
+func (o *File) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *File) copyFields(c *File) {
+	o.SoftwareArtifact.copyFields(&c.SoftwareArtifact)
+}
+
+// Copy returns a deep copy of the Package that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Package) Copy() *Package {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Package as a *Package.
+func (o *Package) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Package) copyFields(c *Package) {
+	o.SoftwareArtifact.copyFields(&c.SoftwareArtifact)
+}
+
+// Copy returns a deep copy of the Sbom that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Sbom) Copy() *Sbom {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Sbom as a *Sbom.
+func (o *Sbom) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Sbom) copyFields(c *Sbom) {
+	o.Bom.copyFields(&c.Bom)
+	c.SbomType = copySlice(o.SbomType)
+}
+
+// Copy returns a deep copy of the Snippet that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *Snippet) Copy() *Snippet {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the Snippet as a *Snippet.
+func (o *Snippet) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *Snippet) copyFields(c *Snippet) {
+	o.SoftwareArtifact.copyFields(&c.SoftwareArtifact)
+	c.ByteRange = o.ByteRange.Copy()
+	c.LineRange = o.LineRange.Copy()
+	o.SnippetFromFile.copyFields(&c.SnippetFromFile)
+}
+
+// Copy returns a deep copy of the SoftwareArtifact that shares no slices or nested
+// objects with it, or nil if o is nil.
+func (o *SoftwareArtifact) Copy() *SoftwareArtifact {
+	if o == nil {
+		return nil
+	}
+	c := *o
+	o.copyFields(&c)
+	return &c
+}
+
+// Clone returns a deep copy of the SoftwareArtifact as a *SoftwareArtifact.
+func (o *SoftwareArtifact) Clone() interface{} {
+	return o.Copy()
+}
+
+func (o *SoftwareArtifact) copyFields(c *SoftwareArtifact) {
+	o.Artifact.copyFields(&c.Artifact)
+	c.AdditionalPurpose = copySlice(o.AdditionalPurpose)
+	c.AttributionText = copySlice(o.AttributionText)
+	c.ContentIdentifier = copyObjects(o.ContentIdentifier, (*ContentIdentifier).copyFields)
+}
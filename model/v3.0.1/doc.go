@@ -99,10 +99,24 @@ Creating a simple SPDX document:
 
 # Interfaces
 
-The package provides interfaces for version-agnostic code:
+The package provides interfaces for version-agnostic code. Every class has a
+generated getter interface named after it that embeds the interface of its
+parent, for example:
 
   - ElementInterface: Common interface for all SPDX elements
   - ArtifactInterface: Interface for artifact elements
+  - PackageInterface: Implemented by Package, AIPackage and DatasetPackage
+
+AnyElement is a sealed interface for heterogeneous element collections.
+
+Concrete types are recovered from an AnyElement with the As* helpers, which
+follow the class hierarchy:
+
+	for _, e := range results {
+		if pkg, ok := spdx.AsPackage(e); ok {
+			fmt.Println(pkg.Name, pkg.PackageVersion)
+		}
+	}
 
 # Validation
 
@@ -113,11 +127,44 @@ Enum types provide IsValid() methods for validation:
 		// Handle invalid relationship type
 	}
 
+Every type also has a generated Validate method that enforces the SHACL
+constraints of the specification: required properties, cardinality, enum
+membership and IRI-only references. Violations are returned as
+*ValidationError values joined with errors.Join:
+
+	if err := rel.Validate(); err != nil {
+		var ve *spdx.ValidationError
+		if errors.As(err, &ve) {
+			fmt.Println(ve.Type, ve.Property, ve.Message)
+		}
+	}
+
+# Individual Values
+
+The specification defines NoAssertionElement, NoneElement, NoAssertionLicense
+and NoneLicense individuals. Their IRIs are available as constants, and
+IsNoAssertion/IsNone recognize every common spelling, including the SPDX 2
+literals NOASSERTION and NONE:
+
+	if spdx.IsNoAssertion(rel.To[0].SpdxID) {
+		// The producer made no assertion about the target
+	}
+
+# JSON Serialization
+
+All types implement json.Marshaler and json.Unmarshaler using the compact
+JSON-LD names of the SPDX context, such as "software_packageVersion" and
+"software_Package". Element references are written as IRIs, and a bare
+string is decoded as a reference:
+
+	data, err := json.Marshal(pkg)
+	// {"type":"software_Package","spdxId":"urn:spdx:pkg-1",...}
+
 # Generated Code
 
-The types_gen.go and enums_gen.go files are generated from the SPDX model
-specification. Do not edit these files directly. Use the spdx-gen tool to
-regenerate them:
+The *_gen.go files (types, enums, interfaces, validators, JSON
+serialization and the support code they share) are generated from the SPDX
+model specification. Do not edit these files directly. Use the spdx-gen tool to regenerate them:
 
 	go generate ./...
 */
@@ -2,6 +2,10 @@
 
 package spdx
 
+import (
+	"strings"
+)
+
 // EnergyUnitType Specifies the unit of energy consumption.
 type EnergyUnitType string
 
@@ -29,6 +33,38 @@ func (v EnergyUnitType) IsValid() bool {
 	}
 }
 
+// energyUnitTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// EnergyUnitType values to the values.
+var energyUnitTypeSpellings = map[string]EnergyUnitType{
+	"https://spdx.org/rdf/3.0.1/terms/AI/EnergyUnitType/kilowattHour": EnergyUnitTypeKilowattHour,
+	"KILOWATT_HOUR": EnergyUnitTypeKilowattHour,
+	"https://spdx.org/rdf/3.0.1/terms/AI/EnergyUnitType/megajoule": EnergyUnitTypeMegajoule,
+	"MEGAJOULE": EnergyUnitTypeMegajoule,
+	"https://spdx.org/rdf/3.0.1/terms/AI/EnergyUnitType/other": EnergyUnitTypeOther,
+	"OTHER": EnergyUnitTypeOther,
+}
+
+// NormalizeEnergyUnitType returns the canonical EnergyUnitType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeEnergyUnitType(s string) EnergyUnitType {
+	if v := EnergyUnitType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := energyUnitTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := energyUnitTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return EnergyUnitType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeEnergyUnitType.
+func (v *EnergyUnitType) UnmarshalText(text []byte) error {
+	*v = NormalizeEnergyUnitType(string(text))
+	return nil
+}
+
 // SafetyRiskAssessmentType Specifies the safety risk level.
 type SafetyRiskAssessmentType string
 
@@ -58,6 +94,40 @@ func (v SafetyRiskAssessmentType) IsValid() bool {
 	}
 }
 
+// safetyRiskAssessmentTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// SafetyRiskAssessmentType values to the values.
+var safetyRiskAssessmentTypeSpellings = map[string]SafetyRiskAssessmentType{
+	"https://spdx.org/rdf/3.0.1/terms/AI/SafetyRiskAssessmentType/high": SafetyRiskAssessmentTypeHigh,
+	"HIGH": SafetyRiskAssessmentTypeHigh,
+	"https://spdx.org/rdf/3.0.1/terms/AI/SafetyRiskAssessmentType/low": SafetyRiskAssessmentTypeLow,
+	"LOW": SafetyRiskAssessmentTypeLow,
+	"https://spdx.org/rdf/3.0.1/terms/AI/SafetyRiskAssessmentType/medium": SafetyRiskAssessmentTypeMedium,
+	"MEDIUM": SafetyRiskAssessmentTypeMedium,
+	"https://spdx.org/rdf/3.0.1/terms/AI/SafetyRiskAssessmentType/serious": SafetyRiskAssessmentTypeSerious,
+	"SERIOUS": SafetyRiskAssessmentTypeSerious,
+}
+
+// NormalizeSafetyRiskAssessmentType returns the canonical SafetyRiskAssessmentType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeSafetyRiskAssessmentType(s string) SafetyRiskAssessmentType {
+	if v := SafetyRiskAssessmentType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := safetyRiskAssessmentTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := safetyRiskAssessmentTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return SafetyRiskAssessmentType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeSafetyRiskAssessmentType.
+func (v *SafetyRiskAssessmentType) UnmarshalText(text []byte) error {
+	*v = NormalizeSafetyRiskAssessmentType(string(text))
+	return nil
+}
+
 // AnnotationType Specifies the type of an annotation.
 type AnnotationType string
 
@@ -83,6 +153,36 @@ func (v AnnotationType) IsValid() bool {
 	}
 }
 
+// annotationTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// AnnotationType values to the values.
+var annotationTypeSpellings = map[string]AnnotationType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/AnnotationType/other": AnnotationTypeOther,
+	"OTHER": AnnotationTypeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/AnnotationType/review": AnnotationTypeReview,
+	"REVIEW": AnnotationTypeReview,
+}
+
+// NormalizeAnnotationType returns the canonical AnnotationType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeAnnotationType(s string) AnnotationType {
+	if v := AnnotationType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := annotationTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := annotationTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return AnnotationType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeAnnotationType.
+func (v *AnnotationType) UnmarshalText(text []byte) error {
+	*v = NormalizeAnnotationType(string(text))
+	return nil
+}
+
 // ExternalIdentifierType Specifies the type of an external identifier.
 type ExternalIdentifierType string
 
@@ -126,6 +226,54 @@ func (v ExternalIdentifierType) IsValid() bool {
 	}
 }
 
+// externalIdentifierTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// ExternalIdentifierType values to the values.
+var externalIdentifierTypeSpellings = map[string]ExternalIdentifierType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/cpe22": ExternalIdentifierTypeCpe22,
+	"CPE22": ExternalIdentifierTypeCpe22,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/cpe23": ExternalIdentifierTypeCpe23,
+	"CPE23": ExternalIdentifierTypeCpe23,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/cve": ExternalIdentifierTypeCve,
+	"CVE": ExternalIdentifierTypeCve,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/email": ExternalIdentifierTypeEmail,
+	"EMAIL": ExternalIdentifierTypeEmail,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/gitoid": ExternalIdentifierTypeGitoid,
+	"GITOID": ExternalIdentifierTypeGitoid,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/other": ExternalIdentifierTypeOther,
+	"OTHER": ExternalIdentifierTypeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/packageUrl": ExternalIdentifierTypePackageUrl,
+	"PACKAGE_URL": ExternalIdentifierTypePackageUrl,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/securityOther": ExternalIdentifierTypeSecurityOther,
+	"SECURITY_OTHER": ExternalIdentifierTypeSecurityOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/swhid": ExternalIdentifierTypeSwhid,
+	"SWHID": ExternalIdentifierTypeSwhid,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/swid": ExternalIdentifierTypeSwid,
+	"SWID": ExternalIdentifierTypeSwid,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType/urlScheme": ExternalIdentifierTypeUrlScheme,
+	"URL_SCHEME": ExternalIdentifierTypeUrlScheme,
+}
+
+// NormalizeExternalIdentifierType returns the canonical ExternalIdentifierType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeExternalIdentifierType(s string) ExternalIdentifierType {
+	if v := ExternalIdentifierType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := externalIdentifierTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := externalIdentifierTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return ExternalIdentifierType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeExternalIdentifierType.
+func (v *ExternalIdentifierType) UnmarshalText(text []byte) error {
+	*v = NormalizeExternalIdentifierType(string(text))
+	return nil
+}
+
 // ExternalRefType Specifies the type of an external reference.
 type ExternalRefType string
 
@@ -239,6 +387,124 @@ func (v ExternalRefType) IsValid() bool {
 	}
 }
 
+// externalRefTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// ExternalRefType values to the values.
+var externalRefTypeSpellings = map[string]ExternalRefType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/altDownloadLocation": ExternalRefTypeAltDownloadLocation,
+	"ALT_DOWNLOAD_LOCATION": ExternalRefTypeAltDownloadLocation,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/altWebPage": ExternalRefTypeAltWebPage,
+	"ALT_WEB_PAGE": ExternalRefTypeAltWebPage,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/binaryArtifact": ExternalRefTypeBinaryArtifact,
+	"BINARY_ARTIFACT": ExternalRefTypeBinaryArtifact,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/bower": ExternalRefTypeBower,
+	"BOWER": ExternalRefTypeBower,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/buildMeta": ExternalRefTypeBuildMeta,
+	"BUILD_META": ExternalRefTypeBuildMeta,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/buildSystem": ExternalRefTypeBuildSystem,
+	"BUILD_SYSTEM": ExternalRefTypeBuildSystem,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/certificationReport": ExternalRefTypeCertificationReport,
+	"CERTIFICATION_REPORT": ExternalRefTypeCertificationReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/chat": ExternalRefTypeChat,
+	"CHAT": ExternalRefTypeChat,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/componentAnalysisReport": ExternalRefTypeComponentAnalysisReport,
+	"COMPONENT_ANALYSIS_REPORT":                                 ExternalRefTypeComponentAnalysisReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/cwe": ExternalRefTypeCwe,
+	"CWE": ExternalRefTypeCwe,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/documentation": ExternalRefTypeDocumentation,
+	"DOCUMENTATION": ExternalRefTypeDocumentation,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/dynamicAnalysisReport": ExternalRefTypeDynamicAnalysisReport,
+	"DYNAMIC_ANALYSIS_REPORT": ExternalRefTypeDynamicAnalysisReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/eolNotice": ExternalRefTypeEolNotice,
+	"EOL_NOTICE": ExternalRefTypeEolNotice,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/exportControlAssessment": ExternalRefTypeExportControlAssessment,
+	"EXPORT_CONTROL_ASSESSMENT":                                     ExternalRefTypeExportControlAssessment,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/funding": ExternalRefTypeFunding,
+	"FUNDING": ExternalRefTypeFunding,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/issueTracker": ExternalRefTypeIssueTracker,
+	"ISSUE_TRACKER": ExternalRefTypeIssueTracker,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/license": ExternalRefTypeLicense,
+	"LICENSE": ExternalRefTypeLicense,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/mailingList": ExternalRefTypeMailingList,
+	"MAILING_LIST": ExternalRefTypeMailingList,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/mavenCentral": ExternalRefTypeMavenCentral,
+	"MAVEN_CENTRAL": ExternalRefTypeMavenCentral,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/metrics": ExternalRefTypeMetrics,
+	"METRICS": ExternalRefTypeMetrics,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/npm": ExternalRefTypeNpm,
+	"NPM": ExternalRefTypeNpm,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/nuget": ExternalRefTypeNuget,
+	"NUGET": ExternalRefTypeNuget,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/other": ExternalRefTypeOther,
+	"OTHER": ExternalRefTypeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/privacyAssessment": ExternalRefTypePrivacyAssessment,
+	"PRIVACY_ASSESSMENT": ExternalRefTypePrivacyAssessment,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/productMetadata": ExternalRefTypeProductMetadata,
+	"PRODUCT_METADATA": ExternalRefTypeProductMetadata,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/purchaseOrder": ExternalRefTypePurchaseOrder,
+	"PURCHASE_ORDER": ExternalRefTypePurchaseOrder,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/qualityAssessmentReport": ExternalRefTypeQualityAssessmentReport,
+	"QUALITY_ASSESSMENT_REPORT": ExternalRefTypeQualityAssessmentReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/releaseHistory": ExternalRefTypeReleaseHistory,
+	"RELEASE_HISTORY": ExternalRefTypeReleaseHistory,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/releaseNotes": ExternalRefTypeReleaseNotes,
+	"RELEASE_NOTES": ExternalRefTypeReleaseNotes,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/riskAssessment": ExternalRefTypeRiskAssessment,
+	"RISK_ASSESSMENT": ExternalRefTypeRiskAssessment,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/runtimeAnalysisReport": ExternalRefTypeRuntimeAnalysisReport,
+	"RUNTIME_ANALYSIS_REPORT": ExternalRefTypeRuntimeAnalysisReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/secureSoftwareAttestation": ExternalRefTypeSecureSoftwareAttestation,
+	"SECURE_SOFTWARE_ATTESTATION": ExternalRefTypeSecureSoftwareAttestation,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityAdversaryModel": ExternalRefTypeSecurityAdversaryModel,
+	"SECURITY_ADVERSARY_MODEL": ExternalRefTypeSecurityAdversaryModel,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityAdvisory": ExternalRefTypeSecurityAdvisory,
+	"SECURITY_ADVISORY": ExternalRefTypeSecurityAdvisory,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityFix": ExternalRefTypeSecurityFix,
+	"SECURITY_FIX": ExternalRefTypeSecurityFix,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityOther": ExternalRefTypeSecurityOther,
+	"SECURITY_OTHER": ExternalRefTypeSecurityOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityPenTestReport": ExternalRefTypeSecurityPenTestReport,
+	"SECURITY_PEN_TEST_REPORT": ExternalRefTypeSecurityPenTestReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityPolicy": ExternalRefTypeSecurityPolicy,
+	"SECURITY_POLICY": ExternalRefTypeSecurityPolicy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/securityThreatModel": ExternalRefTypeSecurityThreatModel,
+	"SECURITY_THREAT_MODEL": ExternalRefTypeSecurityThreatModel,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/socialMedia": ExternalRefTypeSocialMedia,
+	"SOCIAL_MEDIA": ExternalRefTypeSocialMedia,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/sourceArtifact": ExternalRefTypeSourceArtifact,
+	"SOURCE_ARTIFACT": ExternalRefTypeSourceArtifact,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/staticAnalysisReport": ExternalRefTypeStaticAnalysisReport,
+	"STATIC_ANALYSIS_REPORT": ExternalRefTypeStaticAnalysisReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/support": ExternalRefTypeSupport,
+	"SUPPORT": ExternalRefTypeSupport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/vcs": ExternalRefTypeVcs,
+	"VCS": ExternalRefTypeVcs,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/vulnerabilityDisclosureReport": ExternalRefTypeVulnerabilityDisclosureReport,
+	"VULNERABILITY_DISCLOSURE_REPORT": ExternalRefTypeVulnerabilityDisclosureReport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType/vulnerabilityExploitabilityAssessment": ExternalRefTypeVulnerabilityExploitabilityAssessment,
+	"VULNERABILITY_EXPLOITABILITY_ASSESSMENT":                                                     ExternalRefTypeVulnerabilityExploitabilityAssessment,
+}
+
+// NormalizeExternalRefType returns the canonical ExternalRefType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeExternalRefType(s string) ExternalRefType {
+	if v := ExternalRefType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := externalRefTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := externalRefTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return ExternalRefType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeExternalRefType.
+func (v *ExternalRefType) UnmarshalText(text []byte) error {
+	*v = NormalizeExternalRefType(string(text))
+	return nil
+}
+
 // HashAlgorithm A mathematical algorithm that maps data of arbitrary size to a bit string.
 type HashAlgorithm string
 
@@ -304,6 +570,76 @@ func (v HashAlgorithm) IsValid() bool {
 	}
 }
 
+// hashAlgorithmSpellings maps the IRI and UPPER_SNAKE spellings of
+// HashAlgorithm values to the values.
+var hashAlgorithmSpellings = map[string]HashAlgorithm{
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/adler32": HashAlgorithmAdler32,
+	"ADLER32": HashAlgorithmAdler32,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/blake2b256": HashAlgorithmBlake2b256,
+	"BLAKE2B256": HashAlgorithmBlake2b256,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/blake2b384": HashAlgorithmBlake2b384,
+	"BLAKE2B384": HashAlgorithmBlake2b384,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/blake2b512": HashAlgorithmBlake2b512,
+	"BLAKE2B512": HashAlgorithmBlake2b512,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/blake3": HashAlgorithmBlake3,
+	"BLAKE3": HashAlgorithmBlake3,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/crystalsDilithium": HashAlgorithmCrystalsDilithium,
+	"CRYSTALS_DILITHIUM": HashAlgorithmCrystalsDilithium,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/crystalsKyber": HashAlgorithmCrystalsKyber,
+	"CRYSTALS_KYBER": HashAlgorithmCrystalsKyber,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/falcon": HashAlgorithmFalcon,
+	"FALCON": HashAlgorithmFalcon,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/md2": HashAlgorithmMd2,
+	"MD2": HashAlgorithmMd2,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/md4": HashAlgorithmMd4,
+	"MD4": HashAlgorithmMd4,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/md5": HashAlgorithmMd5,
+	"MD5": HashAlgorithmMd5,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/md6": HashAlgorithmMd6,
+	"MD6": HashAlgorithmMd6,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/other": HashAlgorithmOther,
+	"OTHER": HashAlgorithmOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha1": HashAlgorithmSha1,
+	"SHA1": HashAlgorithmSha1,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha224": HashAlgorithmSha224,
+	"SHA224": HashAlgorithmSha224,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha256": HashAlgorithmSha256,
+	"SHA256": HashAlgorithmSha256,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha384": HashAlgorithmSha384,
+	"SHA384": HashAlgorithmSha384,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha3_224": HashAlgorithmSha3224,
+	"SHA3_224": HashAlgorithmSha3224,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha3_256": HashAlgorithmSha3256,
+	"SHA3_256": HashAlgorithmSha3256,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha3_384": HashAlgorithmSha3384,
+	"SHA3_384": HashAlgorithmSha3384,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha3_512": HashAlgorithmSha3512,
+	"SHA3_512": HashAlgorithmSha3512,
+	"https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm/sha512": HashAlgorithmSha512,
+	"SHA512": HashAlgorithmSha512,
+}
+
+// NormalizeHashAlgorithm returns the canonical HashAlgorithm for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeHashAlgorithm(s string) HashAlgorithm {
+	if v := HashAlgorithm(s); v.IsValid() {
+		return v
+	}
+	if v, ok := hashAlgorithmSpellings[s]; ok {
+		return v
+	}
+	if v, ok := hashAlgorithmSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return HashAlgorithm(s)
+}
+
+// UnmarshalText decodes the value with NormalizeHashAlgorithm.
+func (v *HashAlgorithm) UnmarshalText(text []byte) error {
+	*v = NormalizeHashAlgorithm(string(text))
+	return nil
+}
+
 // LifecycleScopeType Provide an enumerated set of lifecycle phases that can provide context to relationships.
 type LifecycleScopeType string
 
@@ -337,6 +673,44 @@ func (v LifecycleScopeType) IsValid() bool {
 	}
 }
 
+// lifecycleScopeTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// LifecycleScopeType values to the values.
+var lifecycleScopeTypeSpellings = map[string]LifecycleScopeType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType/build": LifecycleScopeTypeBuild,
+	"BUILD": LifecycleScopeTypeBuild,
+	"https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType/design": LifecycleScopeTypeDesign,
+	"DESIGN": LifecycleScopeTypeDesign,
+	"https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType/development": LifecycleScopeTypeDevelopment,
+	"DEVELOPMENT": LifecycleScopeTypeDevelopment,
+	"https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType/other": LifecycleScopeTypeOther,
+	"OTHER": LifecycleScopeTypeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType/runtime": LifecycleScopeTypeRuntime,
+	"RUNTIME": LifecycleScopeTypeRuntime,
+	"https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType/test": LifecycleScopeTypeTest,
+	"TEST": LifecycleScopeTypeTest,
+}
+
+// NormalizeLifecycleScopeType returns the canonical LifecycleScopeType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeLifecycleScopeType(s string) LifecycleScopeType {
+	if v := LifecycleScopeType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := lifecycleScopeTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := lifecycleScopeTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return LifecycleScopeType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeLifecycleScopeType.
+func (v *LifecycleScopeType) UnmarshalText(text []byte) error {
+	*v = NormalizeLifecycleScopeType(string(text))
+	return nil
+}
+
 // PresenceType Categories of presence or absence.
 type PresenceType string
 
@@ -364,6 +738,38 @@ func (v PresenceType) IsValid() bool {
 	}
 }
 
+// presenceTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// PresenceType values to the values.
+var presenceTypeSpellings = map[string]PresenceType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/PresenceType/no": PresenceTypeNo,
+	"NO": PresenceTypeNo,
+	"https://spdx.org/rdf/3.0.1/terms/Core/PresenceType/noAssertion": PresenceTypeNoAssertion,
+	"NO_ASSERTION": PresenceTypeNoAssertion,
+	"https://spdx.org/rdf/3.0.1/terms/Core/PresenceType/yes": PresenceTypeYes,
+	"YES": PresenceTypeYes,
+}
+
+// NormalizePresenceType returns the canonical PresenceType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizePresenceType(s string) PresenceType {
+	if v := PresenceType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := presenceTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := presenceTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return PresenceType(s)
+}
+
+// UnmarshalText decodes the value with NormalizePresenceType.
+func (v *PresenceType) UnmarshalText(text []byte) error {
+	*v = NormalizePresenceType(string(text))
+	return nil
+}
+
 // ProfileIdentifierType Enumeration of the valid profiles.
 type ProfileIdentifierType string
 
@@ -405,6 +811,52 @@ func (v ProfileIdentifierType) IsValid() bool {
 	}
 }
 
+// profileIdentifierTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// ProfileIdentifierType values to the values.
+var profileIdentifierTypeSpellings = map[string]ProfileIdentifierType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/ai": ProfileIdentifierTypeAi,
+	"AI": ProfileIdentifierTypeAi,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/build": ProfileIdentifierTypeBuild,
+	"BUILD": ProfileIdentifierTypeBuild,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/core": ProfileIdentifierTypeCore,
+	"CORE": ProfileIdentifierTypeCore,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/dataset": ProfileIdentifierTypeDataset,
+	"DATASET": ProfileIdentifierTypeDataset,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/expandedLicensing": ProfileIdentifierTypeExpandedLicensing,
+	"EXPANDED_LICENSING": ProfileIdentifierTypeExpandedLicensing,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/extension": ProfileIdentifierTypeExtension,
+	"EXTENSION": ProfileIdentifierTypeExtension,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/lite": ProfileIdentifierTypeLite,
+	"LITE": ProfileIdentifierTypeLite,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/security": ProfileIdentifierTypeSecurity,
+	"SECURITY": ProfileIdentifierTypeSecurity,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/simpleLicensing": ProfileIdentifierTypeSimpleLicensing,
+	"SIMPLE_LICENSING": ProfileIdentifierTypeSimpleLicensing,
+	"https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType/software": ProfileIdentifierTypeSoftware,
+	"SOFTWARE": ProfileIdentifierTypeSoftware,
+}
+
+// NormalizeProfileIdentifierType returns the canonical ProfileIdentifierType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeProfileIdentifierType(s string) ProfileIdentifierType {
+	if v := ProfileIdentifierType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := profileIdentifierTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := profileIdentifierTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return ProfileIdentifierType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeProfileIdentifierType.
+func (v *ProfileIdentifierType) UnmarshalText(text []byte) error {
+	*v = NormalizeProfileIdentifierType(string(text))
+	return nil
+}
+
 // RelationshipCompleteness Indicates whether a relationship is known to be complete, incomplete, or if no assertion is made with respect to relationship completeness.
 type RelationshipCompleteness string
 
@@ -432,6 +884,38 @@ func (v RelationshipCompleteness) IsValid() bool {
 	}
 }
 
+// relationshipCompletenessSpellings maps the IRI and UPPER_SNAKE spellings of
+// RelationshipCompleteness values to the values.
+var relationshipCompletenessSpellings = map[string]RelationshipCompleteness{
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipCompleteness/complete": RelationshipCompletenessComplete,
+	"COMPLETE": RelationshipCompletenessComplete,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipCompleteness/incomplete": RelationshipCompletenessIncomplete,
+	"INCOMPLETE": RelationshipCompletenessIncomplete,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipCompleteness/noAssertion": RelationshipCompletenessNoAssertion,
+	"NO_ASSERTION": RelationshipCompletenessNoAssertion,
+}
+
+// NormalizeRelationshipCompleteness returns the canonical RelationshipCompleteness for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeRelationshipCompleteness(s string) RelationshipCompleteness {
+	if v := RelationshipCompleteness(s); v.IsValid() {
+		return v
+	}
+	if v, ok := relationshipCompletenessSpellings[s]; ok {
+		return v
+	}
+	if v, ok := relationshipCompletenessSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return RelationshipCompleteness(s)
+}
+
+// UnmarshalText decodes the value with NormalizeRelationshipCompleteness.
+func (v *RelationshipCompleteness) UnmarshalText(text []byte) error {
+	*v = NormalizeRelationshipCompleteness(string(text))
+	return nil
+}
+
 // RelationshipType Information about the relationship between two Elements.
 type RelationshipType string
 
@@ -571,6 +1055,150 @@ func (v RelationshipType) IsValid() bool {
 	}
 }
 
+// relationshipTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// RelationshipType values to the values.
+var relationshipTypeSpellings = map[string]RelationshipType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/affects": RelationshipTypeAffects,
+	"AFFECTS": RelationshipTypeAffects,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/amendedBy": RelationshipTypeAmendedBy,
+	"AMENDED_BY": RelationshipTypeAmendedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/ancestorOf": RelationshipTypeAncestorOf,
+	"ANCESTOR_OF": RelationshipTypeAncestorOf,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/availableFrom": RelationshipTypeAvailableFrom,
+	"AVAILABLE_FROM": RelationshipTypeAvailableFrom,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/configures": RelationshipTypeConfigures,
+	"CONFIGURES": RelationshipTypeConfigures,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/contains": RelationshipTypeContains,
+	"CONTAINS": RelationshipTypeContains,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/coordinatedBy": RelationshipTypeCoordinatedBy,
+	"COORDINATED_BY": RelationshipTypeCoordinatedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/copiedTo": RelationshipTypeCopiedTo,
+	"COPIED_TO": RelationshipTypeCopiedTo,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/delegatedTo": RelationshipTypeDelegatedTo,
+	"DELEGATED_TO": RelationshipTypeDelegatedTo,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/dependsOn": RelationshipTypeDependsOn,
+	"DEPENDS_ON": RelationshipTypeDependsOn,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/descendantOf": RelationshipTypeDescendantOf,
+	"DESCENDANT_OF": RelationshipTypeDescendantOf,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/describes": RelationshipTypeDescribes,
+	"DESCRIBES": RelationshipTypeDescribes,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/doesNotAffect": RelationshipTypeDoesNotAffect,
+	"DOES_NOT_AFFECT": RelationshipTypeDoesNotAffect,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/expandsTo": RelationshipTypeExpandsTo,
+	"EXPANDS_TO": RelationshipTypeExpandsTo,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/exploitCreatedBy": RelationshipTypeExploitCreatedBy,
+	"EXPLOIT_CREATED_BY": RelationshipTypeExploitCreatedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/fixedBy": RelationshipTypeFixedBy,
+	"FIXED_BY": RelationshipTypeFixedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/fixedIn": RelationshipTypeFixedIn,
+	"FIXED_IN": RelationshipTypeFixedIn,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/foundBy": RelationshipTypeFoundBy,
+	"FOUND_BY": RelationshipTypeFoundBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/generates": RelationshipTypeGenerates,
+	"GENERATES": RelationshipTypeGenerates,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasAddedFile": RelationshipTypeHasAddedFile,
+	"HAS_ADDED_FILE": RelationshipTypeHasAddedFile,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasAssessmentFor": RelationshipTypeHasAssessmentFor,
+	"HAS_ASSESSMENT_FOR": RelationshipTypeHasAssessmentFor,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasAssociatedVulnerability": RelationshipTypeHasAssociatedVulnerability,
+	"HAS_ASSOCIATED_VULNERABILITY": RelationshipTypeHasAssociatedVulnerability,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasConcludedLicense": RelationshipTypeHasConcludedLicense,
+	"HAS_CONCLUDED_LICENSE": RelationshipTypeHasConcludedLicense,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDataFile": RelationshipTypeHasDataFile,
+	"HAS_DATA_FILE": RelationshipTypeHasDataFile,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDeclaredLicense": RelationshipTypeHasDeclaredLicense,
+	"HAS_DECLARED_LICENSE": RelationshipTypeHasDeclaredLicense,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDeletedFile": RelationshipTypeHasDeletedFile,
+	"HAS_DELETED_FILE": RelationshipTypeHasDeletedFile,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDependencyManifest": RelationshipTypeHasDependencyManifest,
+	"HAS_DEPENDENCY_MANIFEST": RelationshipTypeHasDependencyManifest,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDistributionArtifact": RelationshipTypeHasDistributionArtifact,
+	"HAS_DISTRIBUTION_ARTIFACT": RelationshipTypeHasDistributionArtifact,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDocumentation": RelationshipTypeHasDocumentation,
+	"HAS_DOCUMENTATION": RelationshipTypeHasDocumentation,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasDynamicLink": RelationshipTypeHasDynamicLink,
+	"HAS_DYNAMIC_LINK": RelationshipTypeHasDynamicLink,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasEvidence": RelationshipTypeHasEvidence,
+	"HAS_EVIDENCE": RelationshipTypeHasEvidence,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasExample": RelationshipTypeHasExample,
+	"HAS_EXAMPLE": RelationshipTypeHasExample,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasHost": RelationshipTypeHasHost,
+	"HAS_HOST": RelationshipTypeHasHost,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasInput": RelationshipTypeHasInput,
+	"HAS_INPUT": RelationshipTypeHasInput,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasMetadata": RelationshipTypeHasMetadata,
+	"HAS_METADATA": RelationshipTypeHasMetadata,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasOptionalComponent": RelationshipTypeHasOptionalComponent,
+	"HAS_OPTIONAL_COMPONENT": RelationshipTypeHasOptionalComponent,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasOptionalDependency": RelationshipTypeHasOptionalDependency,
+	"HAS_OPTIONAL_DEPENDENCY": RelationshipTypeHasOptionalDependency,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasOutput": RelationshipTypeHasOutput,
+	"HAS_OUTPUT": RelationshipTypeHasOutput,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasPrerequisite": RelationshipTypeHasPrerequisite,
+	"HAS_PREREQUISITE": RelationshipTypeHasPrerequisite,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasProvidedDependency": RelationshipTypeHasProvidedDependency,
+	"HAS_PROVIDED_DEPENDENCY": RelationshipTypeHasProvidedDependency,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasRequirement": RelationshipTypeHasRequirement,
+	"HAS_REQUIREMENT": RelationshipTypeHasRequirement,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasSpecification": RelationshipTypeHasSpecification,
+	"HAS_SPECIFICATION": RelationshipTypeHasSpecification,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasStaticLink": RelationshipTypeHasStaticLink,
+	"HAS_STATIC_LINK": RelationshipTypeHasStaticLink,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasTest": RelationshipTypeHasTest,
+	"HAS_TEST": RelationshipTypeHasTest,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasTestCase": RelationshipTypeHasTestCase,
+	"HAS_TEST_CASE": RelationshipTypeHasTestCase,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/hasVariant": RelationshipTypeHasVariant,
+	"HAS_VARIANT": RelationshipTypeHasVariant,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/invokedBy": RelationshipTypeInvokedBy,
+	"INVOKED_BY": RelationshipTypeInvokedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/modifiedBy": RelationshipTypeModifiedBy,
+	"MODIFIED_BY": RelationshipTypeModifiedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/other": RelationshipTypeOther,
+	"OTHER": RelationshipTypeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/packagedBy": RelationshipTypePackagedBy,
+	"PACKAGED_BY": RelationshipTypePackagedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/patchedBy": RelationshipTypePatchedBy,
+	"PATCHED_BY": RelationshipTypePatchedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/publishedBy": RelationshipTypePublishedBy,
+	"PUBLISHED_BY": RelationshipTypePublishedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/reportedBy": RelationshipTypeReportedBy,
+	"REPORTED_BY": RelationshipTypeReportedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/republishedBy": RelationshipTypeRepublishedBy,
+	"REPUBLISHED_BY": RelationshipTypeRepublishedBy,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/serializedInArtifact": RelationshipTypeSerializedInArtifact,
+	"SERIALIZED_IN_ARTIFACT": RelationshipTypeSerializedInArtifact,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/testedOn": RelationshipTypeTestedOn,
+	"TESTED_ON": RelationshipTypeTestedOn,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/trainedOn": RelationshipTypeTrainedOn,
+	"TRAINED_ON": RelationshipTypeTrainedOn,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/underInvestigationFor": RelationshipTypeUnderInvestigationFor,
+	"UNDER_INVESTIGATION_FOR": RelationshipTypeUnderInvestigationFor,
+	"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/usesTool": RelationshipTypeUsesTool,
+	"USES_TOOL": RelationshipTypeUsesTool,
+}
+
+// NormalizeRelationshipType returns the canonical RelationshipType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeRelationshipType(s string) RelationshipType {
+	if v := RelationshipType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := relationshipTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := relationshipTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return RelationshipType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeRelationshipType.
+func (v *RelationshipType) UnmarshalText(text []byte) error {
+	*v = NormalizeRelationshipType(string(text))
+	return nil
+}
+
 // SupportType Indicates the type of support that is associated with an artifact.
 type SupportType string
 
@@ -606,6 +1234,46 @@ func (v SupportType) IsValid() bool {
 	}
 }
 
+// supportTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// SupportType values to the values.
+var supportTypeSpellings = map[string]SupportType{
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/deployed": SupportTypeDeployed,
+	"DEPLOYED": SupportTypeDeployed,
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/development": SupportTypeDevelopment,
+	"DEVELOPMENT": SupportTypeDevelopment,
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/endOfSupport": SupportTypeEndOfSupport,
+	"END_OF_SUPPORT": SupportTypeEndOfSupport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/limitedSupport": SupportTypeLimitedSupport,
+	"LIMITED_SUPPORT": SupportTypeLimitedSupport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/noAssertion": SupportTypeNoAssertion,
+	"NO_ASSERTION": SupportTypeNoAssertion,
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/noSupport": SupportTypeNoSupport,
+	"NO_SUPPORT": SupportTypeNoSupport,
+	"https://spdx.org/rdf/3.0.1/terms/Core/SupportType/support": SupportTypeSupport,
+	"SUPPORT": SupportTypeSupport,
+}
+
+// NormalizeSupportType returns the canonical SupportType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeSupportType(s string) SupportType {
+	if v := SupportType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := supportTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := supportTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return SupportType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeSupportType.
+func (v *SupportType) UnmarshalText(text []byte) error {
+	*v = NormalizeSupportType(string(text))
+	return nil
+}
+
 // ConfidentialityLevelType Categories of confidentiality level.
 type ConfidentialityLevelType string
 
@@ -635,6 +1303,40 @@ func (v ConfidentialityLevelType) IsValid() bool {
 	}
 }
 
+// confidentialityLevelTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// ConfidentialityLevelType values to the values.
+var confidentialityLevelTypeSpellings = map[string]ConfidentialityLevelType{
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/ConfidentialityLevelType/amber": ConfidentialityLevelTypeAmber,
+	"AMBER": ConfidentialityLevelTypeAmber,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/ConfidentialityLevelType/clear": ConfidentialityLevelTypeClear,
+	"CLEAR": ConfidentialityLevelTypeClear,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/ConfidentialityLevelType/green": ConfidentialityLevelTypeGreen,
+	"GREEN": ConfidentialityLevelTypeGreen,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/ConfidentialityLevelType/red": ConfidentialityLevelTypeRed,
+	"RED": ConfidentialityLevelTypeRed,
+}
+
+// NormalizeConfidentialityLevelType returns the canonical ConfidentialityLevelType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeConfidentialityLevelType(s string) ConfidentialityLevelType {
+	if v := ConfidentialityLevelType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := confidentialityLevelTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := confidentialityLevelTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return ConfidentialityLevelType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeConfidentialityLevelType.
+func (v *ConfidentialityLevelType) UnmarshalText(text []byte) error {
+	*v = NormalizeConfidentialityLevelType(string(text))
+	return nil
+}
+
 // DatasetAvailabilityType Availability of dataset.
 type DatasetAvailabilityType string
 
@@ -666,6 +1368,42 @@ func (v DatasetAvailabilityType) IsValid() bool {
 	}
 }
 
+// datasetAvailabilityTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// DatasetAvailabilityType values to the values.
+var datasetAvailabilityTypeSpellings = map[string]DatasetAvailabilityType{
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetAvailabilityType/clickthrough": DatasetAvailabilityTypeClickthrough,
+	"CLICKTHROUGH": DatasetAvailabilityTypeClickthrough,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetAvailabilityType/directDownload": DatasetAvailabilityTypeDirectDownload,
+	"DIRECT_DOWNLOAD": DatasetAvailabilityTypeDirectDownload,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetAvailabilityType/query": DatasetAvailabilityTypeQuery,
+	"QUERY": DatasetAvailabilityTypeQuery,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetAvailabilityType/registration": DatasetAvailabilityTypeRegistration,
+	"REGISTRATION": DatasetAvailabilityTypeRegistration,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetAvailabilityType/scrapingScript": DatasetAvailabilityTypeScrapingScript,
+	"SCRAPING_SCRIPT": DatasetAvailabilityTypeScrapingScript,
+}
+
+// NormalizeDatasetAvailabilityType returns the canonical DatasetAvailabilityType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeDatasetAvailabilityType(s string) DatasetAvailabilityType {
+	if v := DatasetAvailabilityType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := datasetAvailabilityTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := datasetAvailabilityTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return DatasetAvailabilityType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeDatasetAvailabilityType.
+func (v *DatasetAvailabilityType) UnmarshalText(text []byte) error {
+	*v = NormalizeDatasetAvailabilityType(string(text))
+	return nil
+}
+
 // DatasetType Enumeration of dataset types.
 type DatasetType string
 
@@ -715,6 +1453,60 @@ func (v DatasetType) IsValid() bool {
 	}
 }
 
+// datasetTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// DatasetType values to the values.
+var datasetTypeSpellings = map[string]DatasetType{
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/audio": DatasetTypeAudio,
+	"AUDIO": DatasetTypeAudio,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/categorical": DatasetTypeCategorical,
+	"CATEGORICAL": DatasetTypeCategorical,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/graph": DatasetTypeGraph,
+	"GRAPH": DatasetTypeGraph,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/image": DatasetTypeImage,
+	"IMAGE": DatasetTypeImage,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/noAssertion": DatasetTypeNoAssertion,
+	"NO_ASSERTION": DatasetTypeNoAssertion,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/numeric": DatasetTypeNumeric,
+	"NUMERIC": DatasetTypeNumeric,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/other": DatasetTypeOther,
+	"OTHER": DatasetTypeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/sensor": DatasetTypeSensor,
+	"SENSOR": DatasetTypeSensor,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/structured": DatasetTypeStructured,
+	"STRUCTURED": DatasetTypeStructured,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/syntactic": DatasetTypeSyntactic,
+	"SYNTACTIC": DatasetTypeSyntactic,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/text": DatasetTypeText,
+	"TEXT": DatasetTypeText,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/timeseries": DatasetTypeTimeseries,
+	"TIMESERIES": DatasetTypeTimeseries,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/timestamp": DatasetTypeTimestamp,
+	"TIMESTAMP": DatasetTypeTimestamp,
+	"https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType/video": DatasetTypeVideo,
+	"VIDEO": DatasetTypeVideo,
+}
+
+// NormalizeDatasetType returns the canonical DatasetType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeDatasetType(s string) DatasetType {
+	if v := DatasetType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := datasetTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := datasetTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return DatasetType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeDatasetType.
+func (v *DatasetType) UnmarshalText(text []byte) error {
+	*v = NormalizeDatasetType(string(text))
+	return nil
+}
+
 // CvssSeverityType Specifies the CVSS base, temporal, threat, or environmental severity type.
 type CvssSeverityType string
 
@@ -746,6 +1538,42 @@ func (v CvssSeverityType) IsValid() bool {
 	}
 }
 
+// cvssSeverityTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// CvssSeverityType values to the values.
+var cvssSeverityTypeSpellings = map[string]CvssSeverityType{
+	"https://spdx.org/rdf/3.0.1/terms/Security/CvssSeverityType/critical": CvssSeverityTypeCritical,
+	"CRITICAL": CvssSeverityTypeCritical,
+	"https://spdx.org/rdf/3.0.1/terms/Security/CvssSeverityType/high": CvssSeverityTypeHigh,
+	"HIGH": CvssSeverityTypeHigh,
+	"https://spdx.org/rdf/3.0.1/terms/Security/CvssSeverityType/low": CvssSeverityTypeLow,
+	"LOW": CvssSeverityTypeLow,
+	"https://spdx.org/rdf/3.0.1/terms/Security/CvssSeverityType/medium": CvssSeverityTypeMedium,
+	"MEDIUM": CvssSeverityTypeMedium,
+	"https://spdx.org/rdf/3.0.1/terms/Security/CvssSeverityType/none": CvssSeverityTypeNone,
+	"NONE": CvssSeverityTypeNone,
+}
+
+// NormalizeCvssSeverityType returns the canonical CvssSeverityType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeCvssSeverityType(s string) CvssSeverityType {
+	if v := CvssSeverityType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := cvssSeverityTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := cvssSeverityTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return CvssSeverityType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeCvssSeverityType.
+func (v *CvssSeverityType) UnmarshalText(text []byte) error {
+	*v = NormalizeCvssSeverityType(string(text))
+	return nil
+}
+
 // ExploitCatalogType Specifies the exploit catalog type.
 type ExploitCatalogType string
 
@@ -771,6 +1599,36 @@ func (v ExploitCatalogType) IsValid() bool {
 	}
 }
 
+// exploitCatalogTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// ExploitCatalogType values to the values.
+var exploitCatalogTypeSpellings = map[string]ExploitCatalogType{
+	"https://spdx.org/rdf/3.0.1/terms/Security/ExploitCatalogType/kev": ExploitCatalogTypeKev,
+	"KEV": ExploitCatalogTypeKev,
+	"https://spdx.org/rdf/3.0.1/terms/Security/ExploitCatalogType/other": ExploitCatalogTypeOther,
+	"OTHER": ExploitCatalogTypeOther,
+}
+
+// NormalizeExploitCatalogType returns the canonical ExploitCatalogType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeExploitCatalogType(s string) ExploitCatalogType {
+	if v := ExploitCatalogType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := exploitCatalogTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := exploitCatalogTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return ExploitCatalogType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeExploitCatalogType.
+func (v *ExploitCatalogType) UnmarshalText(text []byte) error {
+	*v = NormalizeExploitCatalogType(string(text))
+	return nil
+}
+
 // SsvcDecisionType Specifies the SSVC decision type.
 type SsvcDecisionType string
 
@@ -800,6 +1658,40 @@ func (v SsvcDecisionType) IsValid() bool {
 	}
 }
 
+// ssvcDecisionTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// SsvcDecisionType values to the values.
+var ssvcDecisionTypeSpellings = map[string]SsvcDecisionType{
+	"https://spdx.org/rdf/3.0.1/terms/Security/SsvcDecisionType/act": SsvcDecisionTypeAct,
+	"ACT": SsvcDecisionTypeAct,
+	"https://spdx.org/rdf/3.0.1/terms/Security/SsvcDecisionType/attend": SsvcDecisionTypeAttend,
+	"ATTEND": SsvcDecisionTypeAttend,
+	"https://spdx.org/rdf/3.0.1/terms/Security/SsvcDecisionType/track": SsvcDecisionTypeTrack,
+	"TRACK": SsvcDecisionTypeTrack,
+	"https://spdx.org/rdf/3.0.1/terms/Security/SsvcDecisionType/trackStar": SsvcDecisionTypeTrackStar,
+	"TRACK_STAR": SsvcDecisionTypeTrackStar,
+}
+
+// NormalizeSsvcDecisionType returns the canonical SsvcDecisionType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeSsvcDecisionType(s string) SsvcDecisionType {
+	if v := SsvcDecisionType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := ssvcDecisionTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := ssvcDecisionTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return SsvcDecisionType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeSsvcDecisionType.
+func (v *SsvcDecisionType) UnmarshalText(text []byte) error {
+	*v = NormalizeSsvcDecisionType(string(text))
+	return nil
+}
+
 // VexJustificationType Specifies the VEX justification type.
 type VexJustificationType string
 
@@ -831,6 +1723,42 @@ func (v VexJustificationType) IsValid() bool {
 	}
 }
 
+// vexJustificationTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// VexJustificationType values to the values.
+var vexJustificationTypeSpellings = map[string]VexJustificationType{
+	"https://spdx.org/rdf/3.0.1/terms/Security/VexJustificationType/componentNotPresent": VexJustificationTypeComponentNotPresent,
+	"COMPONENT_NOT_PRESENT": VexJustificationTypeComponentNotPresent,
+	"https://spdx.org/rdf/3.0.1/terms/Security/VexJustificationType/inlineMitigationsAlreadyExist": VexJustificationTypeInlineMitigationsAlreadyExist,
+	"INLINE_MITIGATIONS_ALREADY_EXIST": VexJustificationTypeInlineMitigationsAlreadyExist,
+	"https://spdx.org/rdf/3.0.1/terms/Security/VexJustificationType/vulnerableCodeCannotBeControlledByAdversary": VexJustificationTypeVulnerableCodeCannotBeControlledByAdversary,
+	"VULNERABLE_CODE_CANNOT_BE_CONTROLLED_BY_ADVERSARY":                                                          VexJustificationTypeVulnerableCodeCannotBeControlledByAdversary,
+	"https://spdx.org/rdf/3.0.1/terms/Security/VexJustificationType/vulnerableCodeNotInExecutePath":              VexJustificationTypeVulnerableCodeNotInExecutePath,
+	"VULNERABLE_CODE_NOT_IN_EXECUTE_PATH":                                                                        VexJustificationTypeVulnerableCodeNotInExecutePath,
+	"https://spdx.org/rdf/3.0.1/terms/Security/VexJustificationType/vulnerableCodeNotPresent":                    VexJustificationTypeVulnerableCodeNotPresent,
+	"VULNERABLE_CODE_NOT_PRESENT":                                                                                VexJustificationTypeVulnerableCodeNotPresent,
+}
+
+// NormalizeVexJustificationType returns the canonical VexJustificationType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeVexJustificationType(s string) VexJustificationType {
+	if v := VexJustificationType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := vexJustificationTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := vexJustificationTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return VexJustificationType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeVexJustificationType.
+func (v *VexJustificationType) UnmarshalText(text []byte) error {
+	*v = NormalizeVexJustificationType(string(text))
+	return nil
+}
+
 // ContentIdentifierType Specifies the type of a content identifier.
 type ContentIdentifierType string
 
@@ -856,6 +1784,36 @@ func (v ContentIdentifierType) IsValid() bool {
 	}
 }
 
+// contentIdentifierTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// ContentIdentifierType values to the values.
+var contentIdentifierTypeSpellings = map[string]ContentIdentifierType{
+	"https://spdx.org/rdf/3.0.1/terms/Software/ContentIdentifierType/gitoid": ContentIdentifierTypeGitoid,
+	"GITOID": ContentIdentifierTypeGitoid,
+	"https://spdx.org/rdf/3.0.1/terms/Software/ContentIdentifierType/swhid": ContentIdentifierTypeSwhid,
+	"SWHID": ContentIdentifierTypeSwhid,
+}
+
+// NormalizeContentIdentifierType returns the canonical ContentIdentifierType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeContentIdentifierType(s string) ContentIdentifierType {
+	if v := ContentIdentifierType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := contentIdentifierTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := contentIdentifierTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return ContentIdentifierType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeContentIdentifierType.
+func (v *ContentIdentifierType) UnmarshalText(text []byte) error {
+	*v = NormalizeContentIdentifierType(string(text))
+	return nil
+}
+
 // FileKindType Enumeration of the different kinds of SPDX file.
 type FileKindType string
 
@@ -881,6 +1839,36 @@ func (v FileKindType) IsValid() bool {
 	}
 }
 
+// fileKindTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// FileKindType values to the values.
+var fileKindTypeSpellings = map[string]FileKindType{
+	"https://spdx.org/rdf/3.0.1/terms/Software/FileKindType/directory": FileKindTypeDirectory,
+	"DIRECTORY": FileKindTypeDirectory,
+	"https://spdx.org/rdf/3.0.1/terms/Software/FileKindType/file": FileKindTypeFile,
+	"FILE": FileKindTypeFile,
+}
+
+// NormalizeFileKindType returns the canonical FileKindType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeFileKindType(s string) FileKindType {
+	if v := FileKindType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := fileKindTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := fileKindTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return FileKindType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeFileKindType.
+func (v *FileKindType) UnmarshalText(text []byte) error {
+	*v = NormalizeFileKindType(string(text))
+	return nil
+}
+
 // SbomType Provides a set of values to be used to describe the common types of SBOMs that tools may create.
 type SbomType string
 
@@ -914,6 +1902,44 @@ func (v SbomType) IsValid() bool {
 	}
 }
 
+// sbomTypeSpellings maps the IRI and UPPER_SNAKE spellings of
+// SbomType values to the values.
+var sbomTypeSpellings = map[string]SbomType{
+	"https://spdx.org/rdf/3.0.1/terms/Software/SbomType/analyzed": SbomTypeAnalyzed,
+	"ANALYZED": SbomTypeAnalyzed,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SbomType/build": SbomTypeBuild,
+	"BUILD": SbomTypeBuild,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SbomType/deployed": SbomTypeDeployed,
+	"DEPLOYED": SbomTypeDeployed,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SbomType/design": SbomTypeDesign,
+	"DESIGN": SbomTypeDesign,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SbomType/runtime": SbomTypeRuntime,
+	"RUNTIME": SbomTypeRuntime,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SbomType/source": SbomTypeSource,
+	"SOURCE": SbomTypeSource,
+}
+
+// NormalizeSbomType returns the canonical SbomType for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeSbomType(s string) SbomType {
+	if v := SbomType(s); v.IsValid() {
+		return v
+	}
+	if v, ok := sbomTypeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := sbomTypeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return SbomType(s)
+}
+
+// UnmarshalText decodes the value with NormalizeSbomType.
+func (v *SbomType) UnmarshalText(text []byte) error {
+	*v = NormalizeSbomType(string(text))
+	return nil
+}
+
 // SoftwarePurpose Provides information about the primary purpose of an Element.
 type SoftwarePurpose string
 
@@ -992,3 +2018,87 @@ func (v SoftwarePurpose) IsValid() bool {
 		return false
 	}
 }
+
+// softwarePurposeSpellings maps the IRI and UPPER_SNAKE spellings of
+// SoftwarePurpose values to the values.
+var softwarePurposeSpellings = map[string]SoftwarePurpose{
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/application": SoftwarePurposeApplication,
+	"APPLICATION": SoftwarePurposeApplication,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/archive": SoftwarePurposeArchive,
+	"ARCHIVE": SoftwarePurposeArchive,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/bom": SoftwarePurposeBom,
+	"BOM": SoftwarePurposeBom,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/configuration": SoftwarePurposeConfiguration,
+	"CONFIGURATION": SoftwarePurposeConfiguration,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/container": SoftwarePurposeContainer,
+	"CONTAINER": SoftwarePurposeContainer,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/data": SoftwarePurposeData,
+	"DATA": SoftwarePurposeData,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/device": SoftwarePurposeDevice,
+	"DEVICE": SoftwarePurposeDevice,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/deviceDriver": SoftwarePurposeDeviceDriver,
+	"DEVICE_DRIVER": SoftwarePurposeDeviceDriver,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/diskImage": SoftwarePurposeDiskImage,
+	"DISK_IMAGE": SoftwarePurposeDiskImage,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/documentation": SoftwarePurposeDocumentation,
+	"DOCUMENTATION": SoftwarePurposeDocumentation,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/evidence": SoftwarePurposeEvidence,
+	"EVIDENCE": SoftwarePurposeEvidence,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/executable": SoftwarePurposeExecutable,
+	"EXECUTABLE": SoftwarePurposeExecutable,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/file": SoftwarePurposeFile,
+	"FILE": SoftwarePurposeFile,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/filesystemImage": SoftwarePurposeFilesystemImage,
+	"FILESYSTEM_IMAGE": SoftwarePurposeFilesystemImage,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/firmware": SoftwarePurposeFirmware,
+	"FIRMWARE": SoftwarePurposeFirmware,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/framework": SoftwarePurposeFramework,
+	"FRAMEWORK": SoftwarePurposeFramework,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/install": SoftwarePurposeInstall,
+	"INSTALL": SoftwarePurposeInstall,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/library": SoftwarePurposeLibrary,
+	"LIBRARY": SoftwarePurposeLibrary,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/manifest": SoftwarePurposeManifest,
+	"MANIFEST": SoftwarePurposeManifest,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/model": SoftwarePurposeModel,
+	"MODEL": SoftwarePurposeModel,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/module": SoftwarePurposeModule,
+	"MODULE": SoftwarePurposeModule,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/operatingSystem": SoftwarePurposeOperatingSystem,
+	"OPERATING_SYSTEM": SoftwarePurposeOperatingSystem,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/other": SoftwarePurposeOther,
+	"OTHER": SoftwarePurposeOther,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/patch": SoftwarePurposePatch,
+	"PATCH": SoftwarePurposePatch,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/platform": SoftwarePurposePlatform,
+	"PLATFORM": SoftwarePurposePlatform,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/requirement": SoftwarePurposeRequirement,
+	"REQUIREMENT": SoftwarePurposeRequirement,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/source": SoftwarePurposeSource,
+	"SOURCE": SoftwarePurposeSource,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/specification": SoftwarePurposeSpecification,
+	"SPECIFICATION": SoftwarePurposeSpecification,
+	"https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose/test": SoftwarePurposeTest,
+	"TEST": SoftwarePurposeTest,
+}
+
+// NormalizeSoftwarePurpose returns the canonical SoftwarePurpose for its camelCase,
+// UPPER_SNAKE or IRI spelling. Unknown values are returned unchanged.
+func NormalizeSoftwarePurpose(s string) SoftwarePurpose {
+	if v := SoftwarePurpose(s); v.IsValid() {
+		return v
+	}
+	if v, ok := softwarePurposeSpellings[s]; ok {
+		return v
+	}
+	if v, ok := softwarePurposeSpellings[strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]; ok {
+		return v
+	}
+	return SoftwarePurpose(s)
+}
+
+// UnmarshalText decodes the value with NormalizeSoftwarePurpose.
+func (v *SoftwarePurpose) UnmarshalText(text []byte) error {
+	*v = NormalizeSoftwarePurpose(string(text))
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+// Individual values defined by the SPDX 3.0.1 specification.
+// These are the full IRIs used as element references in JSON-LD documents.
+const (
+	// NoAssertionElementIRI represents a set of Elements of unknown identity or cardinality.
+	NoAssertionElementIRI = "https://spdx.org/rdf/3.0.1/terms/Core/NoAssertionElement"
+
+	// NoneElementIRI represents a set of Elements with cardinality of zero.
+	NoneElementIRI = "https://spdx.org/rdf/3.0.1/terms/Core/NoneElement"
+
+	// NoAssertionLicenseIRI is used when no assertion can be made about a license.
+	NoAssertionLicenseIRI = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/NoAssertionLicense"
+
+	// NoneLicenseIRI is used when there is known to be no license.
+	NoneLicenseIRI = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/NoneLicense"
+)
+
+// Compact forms of the individual values as they appear when the SPDX
+// JSON-LD context is applied.
+const (
+	NoAssertionElementTerm = "NoAssertionElement"
+	NoneElementTerm        = "NoneElement"
+	NoAssertionLicenseTerm = "expandedlicensing_NoAssertionLicense"
+	NoneLicenseTerm        = "expandedlicensing_NoneLicense"
+)
+
+// SPDX 2 style literals that are still common in converted documents.
+const (
+	NoAssertionLiteral = "NOASSERTION"
+	NoneLiteral        = "NONE"
+)
+
+// Licensing profile aliases declared as owl:sameAs the ExpandedLicensing individuals.
+const (
+	licensingNoAssertionIRI = "https://spdx.org/rdf/3.0.1/terms/Licensing/NoAssertion"
+	licensingNoneIRI        = "https://spdx.org/rdf/3.0.1/terms/Licensing/None"
+)
+
+// IsNoAssertion returns true if s denotes a "no assertion" value in any of its
+// spellings: the Element or License individual (full IRI or compact term) or
+// the SPDX 2 literal NOASSERTION.
+func IsNoAssertion(s string) bool {
+	switch s {
+	case NoAssertionElementIRI, NoAssertionElementTerm,
+		NoAssertionLicenseIRI, NoAssertionLicenseTerm, licensingNoAssertionIRI,
+		NoAssertionLiteral:
+		return true
+	}
+	return false
+}
+
+// IsNone returns true if s denotes a "none" value in any of its spellings:
+// the Element or License individual (full IRI or compact term) or the
+// SPDX 2 literal NONE.
+func IsNone(s string) bool {
+	switch s {
+	case NoneElementIRI, NoneElementTerm,
+		NoneLicenseIRI, NoneLicenseTerm, licensingNoneIRI,
+		NoneLiteral:
+		return true
+	}
+	return false
+}
+
+// IsIndividualIRI returns true if iri is one of the individual values
+// defined by the specification.
+func IsIndividualIRI(iri string) bool {
+	switch iri {
+	case NoAssertionElementIRI, NoneElementIRI, NoAssertionLicenseIRI, NoneLicenseIRI:
+		return true
+	}
+	return false
+}
+
+// NormalizeElementRef converts a compact individual term (e.g., "NoneElement")
+// or a Licensing profile alias into the canonical full IRI. Any other value,
+// including the SPDX 2 literals, is returned unchanged because their meaning
+// depends on the property they appear in.
+func NormalizeElementRef(ref string) string {
+	switch ref {
+	case NoAssertionElementTerm:
+		return NoAssertionElementIRI
+	case NoneElementTerm:
+		return NoneElementIRI
+	case NoAssertionLicenseTerm, licensingNoAssertionIRI:
+		return NoAssertionLicenseIRI
+	case NoneLicenseTerm, licensingNoneIRI:
+		return NoneLicenseIRI
+	}
+	return ref
+}
+
+// NormalizeLicenseRef behaves like NormalizeElementRef but also maps the
+// SPDX 2 literals NOASSERTION and NONE to the corresponding license
+// individuals. Use it for references that are known to point at licenses.
+func NormalizeLicenseRef(ref string) string {
+	switch ref {
+	case NoAssertionLiteral:
+		return NoAssertionLicenseIRI
+	case NoneLiteral:
+		return NoneLicenseIRI
+	}
+	return NormalizeElementRef(ref)
+}
+
+// CompactIndividual returns the compact JSON-LD term for an individual IRI,
+// or the input unchanged if it is not an individual. Serializers use it to
+// emit references the way the SPDX context expects them.
+func CompactIndividual(ref string) string {
+	switch ref {
+	case NoAssertionElementIRI:
+		return NoAssertionElementTerm
+	case NoneElementIRI:
+		return NoneElementTerm
+	case NoAssertionLicenseIRI:
+		return NoAssertionLicenseTerm
+	case NoneLicenseIRI:
+		return NoneLicenseTerm
+	}
+	return ref
+}
+
+// IsNoAssertion returns true if the element is, or stands in for, a
+// "no assertion" value. Elements converted from SPDX 2 often carry the
+// NOASSERTION literal as their name instead of referencing the individual.
+func (e *Element) IsNoAssertion() bool {
+	return IsNoAssertion(e.SpdxID) || e.Name == NoAssertionLiteral
+}
+
+// IsNone returns true if the element is, or stands in for, a "none" value.
+func (e *Element) IsNone() bool {
+	return IsNone(e.SpdxID) || e.Name == NoneLiteral
+}
+
+// NewNoAssertionLicense returns an AnyLicenseInfo referencing the
+// NoAssertionLicense individual.
+func NewNoAssertionLicense() *AnyLicenseInfo {
+	return &AnyLicenseInfo{Element: Element{SpdxID: NoAssertionLicenseIRI, Name: NoAssertionLiteral}}
+}
+
+// NewNoneLicense returns an AnyLicenseInfo referencing the NoneLicense individual.
+func NewNoneLicense() *AnyLicenseInfo {
+	return &AnyLicenseInfo{Element: Element{SpdxID: NoneLicenseIRI, Name: NoneLiteral}}
+}
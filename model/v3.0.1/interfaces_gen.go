@@ -0,0 +1,1439 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+import (
+	"time"
+)
+
+// AIPackageInterface is implemented by AIPackage and the classes derived from it.
+type AIPackageInterface interface {
+	PackageInterface
+	GetAutonomyType() PresenceType
+	GetDomain() []string
+	GetEnergyConsumption() *EnergyConsumption
+	GetHyperparameter() []DictionaryEntry
+	GetInformationAboutApplication() string
+	GetInformationAboutTraining() string
+	GetLimitation() string
+	GetMetric() []DictionaryEntry
+	GetMetricDecisionThreshold() []DictionaryEntry
+	GetModelDataPreprocessing() []string
+	GetModelExplainability() []string
+	GetSafetyRiskAssessment() SafetyRiskAssessmentType
+	GetStandardCompliance() []string
+	GetTypeOfModel() []string
+	GetUseSensitivePersonalInformation() PresenceType
+}
+
+// GetAutonomyType returns the AutonomyType property of the AIPackage.
+func (o *AIPackage) GetAutonomyType() PresenceType {
+	return o.AutonomyType
+}
+
+// GetDomain returns the Domain property of the AIPackage.
+func (o *AIPackage) GetDomain() []string {
+	return o.Domain
+}
+
+// GetEnergyConsumption returns the EnergyConsumption property of the AIPackage.
+func (o *AIPackage) GetEnergyConsumption() *EnergyConsumption {
+	return o.EnergyConsumption
+}
+
+// GetHyperparameter returns the Hyperparameter property of the AIPackage.
+func (o *AIPackage) GetHyperparameter() []DictionaryEntry {
+	return o.Hyperparameter
+}
+
+// GetInformationAboutApplication returns the InformationAboutApplication property of the AIPackage.
+func (o *AIPackage) GetInformationAboutApplication() string {
+	return o.InformationAboutApplication
+}
+
+// GetInformationAboutTraining returns the InformationAboutTraining property of the AIPackage.
+func (o *AIPackage) GetInformationAboutTraining() string {
+	return o.InformationAboutTraining
+}
+
+// GetLimitation returns the Limitation property of the AIPackage.
+func (o *AIPackage) GetLimitation() string {
+	return o.Limitation
+}
+
+// GetMetric returns the Metric property of the AIPackage.
+func (o *AIPackage) GetMetric() []DictionaryEntry {
+	return o.Metric
+}
+
+// GetMetricDecisionThreshold returns the MetricDecisionThreshold property of the AIPackage.
+func (o *AIPackage) GetMetricDecisionThreshold() []DictionaryEntry {
+	return o.MetricDecisionThreshold
+}
+
+// GetModelDataPreprocessing returns the ModelDataPreprocessing property of the AIPackage.
+func (o *AIPackage) GetModelDataPreprocessing() []string {
+	return o.ModelDataPreprocessing
+}
+
+// GetModelExplainability returns the ModelExplainability property of the AIPackage.
+func (o *AIPackage) GetModelExplainability() []string {
+	return o.ModelExplainability
+}
+
+// GetSafetyRiskAssessment returns the SafetyRiskAssessment property of the AIPackage.
+func (o *AIPackage) GetSafetyRiskAssessment() SafetyRiskAssessmentType {
+	return o.SafetyRiskAssessment
+}
+
+// GetStandardCompliance returns the StandardCompliance property of the AIPackage.
+func (o *AIPackage) GetStandardCompliance() []string {
+	return o.StandardCompliance
+}
+
+// GetTypeOfModel returns the TypeOfModel property of the AIPackage.
+func (o *AIPackage) GetTypeOfModel() []string {
+	return o.TypeOfModel
+}
+
+// GetUseSensitivePersonalInformation returns the UseSensitivePersonalInformation property of the AIPackage.
+func (o *AIPackage) GetUseSensitivePersonalInformation() PresenceType {
+	return o.UseSensitivePersonalInformation
+}
+
+// EnergyConsumptionInterface is implemented by EnergyConsumption and the classes derived from it.
+type EnergyConsumptionInterface interface {
+	GetFinetuningEnergyConsumption() []EnergyConsumptionDescription
+	GetInferenceEnergyConsumption() []EnergyConsumptionDescription
+	GetTrainingEnergyConsumption() []EnergyConsumptionDescription
+}
+
+// GetFinetuningEnergyConsumption returns the FinetuningEnergyConsumption property of the EnergyConsumption.
+func (o *EnergyConsumption) GetFinetuningEnergyConsumption() []EnergyConsumptionDescription {
+	return o.FinetuningEnergyConsumption
+}
+
+// GetInferenceEnergyConsumption returns the InferenceEnergyConsumption property of the EnergyConsumption.
+func (o *EnergyConsumption) GetInferenceEnergyConsumption() []EnergyConsumptionDescription {
+	return o.InferenceEnergyConsumption
+}
+
+// GetTrainingEnergyConsumption returns the TrainingEnergyConsumption property of the EnergyConsumption.
+func (o *EnergyConsumption) GetTrainingEnergyConsumption() []EnergyConsumptionDescription {
+	return o.TrainingEnergyConsumption
+}
+
+// EnergyConsumptionDescriptionInterface is implemented by EnergyConsumptionDescription and the classes derived from it.
+type EnergyConsumptionDescriptionInterface interface {
+	GetEnergyQuantity() float64
+	GetEnergyUnit() EnergyUnitType
+}
+
+// GetEnergyQuantity returns the EnergyQuantity property of the EnergyConsumptionDescription.
+func (o *EnergyConsumptionDescription) GetEnergyQuantity() float64 {
+	return o.EnergyQuantity
+}
+
+// GetEnergyUnit returns the EnergyUnit property of the EnergyConsumptionDescription.
+func (o *EnergyConsumptionDescription) GetEnergyUnit() EnergyUnitType {
+	return o.EnergyUnit
+}
+
+// BuildInterface is implemented by Build and the classes derived from it.
+type BuildInterface interface {
+	ElementInterface
+	GetBuildType() string
+	GetBuildID() string
+	GetConfigSourceEntrypoint() []string
+	GetConfigSourceUri() []string
+	GetConfigSourceDigest() []Hash
+	GetParameter() []DictionaryEntry
+	GetBuildStartTime() time.Time
+	GetBuildEndTime() time.Time
+	GetEnvironment() []DictionaryEntry
+}
+
+// GetBuildType returns the BuildType property of the Build.
+func (o *Build) GetBuildType() string {
+	return o.BuildType
+}
+
+// GetBuildID returns the BuildID property of the Build.
+func (o *Build) GetBuildID() string {
+	return o.BuildId
+}
+
+// GetConfigSourceEntrypoint returns the ConfigSourceEntrypoint property of the Build.
+func (o *Build) GetConfigSourceEntrypoint() []string {
+	return o.ConfigSourceEntrypoint
+}
+
+// GetConfigSourceUri returns the ConfigSourceUri property of the Build.
+func (o *Build) GetConfigSourceUri() []string {
+	return o.ConfigSourceUri
+}
+
+// GetConfigSourceDigest returns the ConfigSourceDigest property of the Build.
+func (o *Build) GetConfigSourceDigest() []Hash {
+	return o.ConfigSourceDigest
+}
+
+// GetParameter returns the Parameter property of the Build.
+func (o *Build) GetParameter() []DictionaryEntry {
+	return o.Parameter
+}
+
+// GetBuildStartTime returns the BuildStartTime property of the Build.
+func (o *Build) GetBuildStartTime() time.Time {
+	return o.BuildStartTime
+}
+
+// GetBuildEndTime returns the BuildEndTime property of the Build.
+func (o *Build) GetBuildEndTime() time.Time {
+	return o.BuildEndTime
+}
+
+// GetEnvironment returns the Environment property of the Build.
+func (o *Build) GetEnvironment() []DictionaryEntry {
+	return o.Environment
+}
+
+// AgentInterface is implemented by Agent and the classes derived from it.
+type AgentInterface interface {
+	ElementInterface
+}
+
+// AnnotationInterface is implemented by Annotation and the classes derived from it.
+type AnnotationInterface interface {
+	ElementInterface
+	GetAnnotationType() AnnotationType
+	GetContentType() string
+	GetStatement() string
+	GetSubject() Element
+}
+
+// GetAnnotationType returns the AnnotationType property of the Annotation.
+func (o *Annotation) GetAnnotationType() AnnotationType {
+	return o.AnnotationType
+}
+
+// GetContentType returns the ContentType property of the Annotation.
+func (o *Annotation) GetContentType() string {
+	return o.ContentType
+}
+
+// GetStatement returns the Statement property of the Annotation.
+func (o *Annotation) GetStatement() string {
+	return o.Statement
+}
+
+// GetSubject returns the Subject property of the Annotation.
+func (o *Annotation) GetSubject() Element {
+	return o.Subject
+}
+
+// ArtifactInterface is implemented by Artifact and the classes derived from it.
+type ArtifactInterface interface {
+	ElementInterface
+	GetOriginatedBy() []Agent
+	GetSuppliedBy() *Agent
+	GetBuiltTime() time.Time
+	GetReleaseTime() time.Time
+	GetValidUntilTime() time.Time
+	GetStandardName() []string
+	GetSupportLevel() []SupportType
+}
+
+// GetOriginatedBy returns the OriginatedBy property of the Artifact.
+func (o *Artifact) GetOriginatedBy() []Agent {
+	return o.OriginatedBy
+}
+
+// GetSuppliedBy returns the SuppliedBy property of the Artifact.
+func (o *Artifact) GetSuppliedBy() *Agent {
+	return o.SuppliedBy
+}
+
+// GetBuiltTime returns the BuiltTime property of the Artifact.
+func (o *Artifact) GetBuiltTime() time.Time {
+	return o.BuiltTime
+}
+
+// GetReleaseTime returns the ReleaseTime property of the Artifact.
+func (o *Artifact) GetReleaseTime() time.Time {
+	return o.ReleaseTime
+}
+
+// GetValidUntilTime returns the ValidUntilTime property of the Artifact.
+func (o *Artifact) GetValidUntilTime() time.Time {
+	return o.ValidUntilTime
+}
+
+// GetStandardName returns the StandardName property of the Artifact.
+func (o *Artifact) GetStandardName() []string {
+	return o.StandardName
+}
+
+// GetSupportLevel returns the SupportLevel property of the Artifact.
+func (o *Artifact) GetSupportLevel() []SupportType {
+	return o.SupportLevel
+}
+
+// BomInterface is implemented by Bom and the classes derived from it.
+type BomInterface interface {
+	BundleInterface
+}
+
+// BundleInterface is implemented by Bundle and the classes derived from it.
+type BundleInterface interface {
+	ElementCollectionInterface
+	GetContext() string
+}
+
+// GetContext returns the Context property of the Bundle.
+func (o *Bundle) GetContext() string {
+	return o.Context
+}
+
+// CreationInfoInterface is implemented by CreationInfo and the classes derived from it.
+type CreationInfoInterface interface {
+	GetSpecVersion() string
+	GetComment() string
+	GetCreated() time.Time
+	GetCreatedBy() []Agent
+	GetCreatedUsing() []Tool
+}
+
+// GetSpecVersion returns the SpecVersion property of the CreationInfo.
+func (o *CreationInfo) GetSpecVersion() string {
+	return o.SpecVersion
+}
+
+// GetComment returns the Comment property of the CreationInfo.
+func (o *CreationInfo) GetComment() string {
+	return o.Comment
+}
+
+// GetCreated returns the Created property of the CreationInfo.
+func (o *CreationInfo) GetCreated() time.Time {
+	return o.Created
+}
+
+// GetCreatedBy returns the CreatedBy property of the CreationInfo.
+func (o *CreationInfo) GetCreatedBy() []Agent {
+	return o.CreatedBy
+}
+
+// GetCreatedUsing returns the CreatedUsing property of the CreationInfo.
+func (o *CreationInfo) GetCreatedUsing() []Tool {
+	return o.CreatedUsing
+}
+
+// DictionaryEntryInterface is implemented by DictionaryEntry and the classes derived from it.
+type DictionaryEntryInterface interface {
+	GetKey() string
+	GetValue() string
+}
+
+// GetKey returns the Key property of the DictionaryEntry.
+func (o *DictionaryEntry) GetKey() string {
+	return o.Key
+}
+
+// GetValue returns the Value property of the DictionaryEntry.
+func (o *DictionaryEntry) GetValue() string {
+	return o.Value
+}
+
+// ElementInterface is implemented by Element and the classes derived from it.
+type ElementInterface interface {
+	GetSpdxID() string
+	GetName() string
+	GetSummary() string
+	GetDescription() string
+	GetComment() string
+	GetCreationInfo() *CreationInfo
+	GetVerifiedUsing() []IntegrityMethodInterface
+	GetExternalRef() []ExternalRef
+	GetExternalIdentifier() []ExternalIdentifier
+	GetExtension() []ExtensionInterface
+}
+
+// GetSpdxID returns the SpdxID property of the Element.
+func (o *Element) GetSpdxID() string {
+	return o.SpdxID
+}
+
+// GetName returns the Name property of the Element.
+func (o *Element) GetName() string {
+	return o.Name
+}
+
+// GetSummary returns the Summary property of the Element.
+func (o *Element) GetSummary() string {
+	return o.Summary
+}
+
+// GetDescription returns the Description property of the Element.
+func (o *Element) GetDescription() string {
+	return o.Description
+}
+
+// GetComment returns the Comment property of the Element.
+func (o *Element) GetComment() string {
+	return o.Comment
+}
+
+// GetCreationInfo returns the CreationInfo property of the Element.
+func (o *Element) GetCreationInfo() *CreationInfo {
+	return &o.CreationInfo
+}
+
+// GetVerifiedUsing returns the VerifiedUsing property of the Element.
+func (o *Element) GetVerifiedUsing() []IntegrityMethodInterface {
+	return o.VerifiedUsing
+}
+
+// GetExternalRef returns the ExternalRef property of the Element.
+func (o *Element) GetExternalRef() []ExternalRef {
+	return o.ExternalRef
+}
+
+// GetExternalIdentifier returns the ExternalIdentifier property of the Element.
+func (o *Element) GetExternalIdentifier() []ExternalIdentifier {
+	return o.ExternalIdentifier
+}
+
+// GetExtension returns the Extension property of the Element.
+func (o *Element) GetExtension() []ExtensionInterface {
+	return o.Extension
+}
+
+// ElementCollectionInterface is implemented by ElementCollection and the classes derived from it.
+type ElementCollectionInterface interface {
+	ElementInterface
+	GetElements() []Element
+	GetRootElement() []Element
+	GetProfileConformance() []ProfileIdentifierType
+}
+
+// GetElements returns the Elements property of the ElementCollection.
+func (o *ElementCollection) GetElements() []Element {
+	return o.Elements
+}
+
+// GetRootElement returns the RootElement property of the ElementCollection.
+func (o *ElementCollection) GetRootElement() []Element {
+	return o.RootElement
+}
+
+// GetProfileConformance returns the ProfileConformance property of the ElementCollection.
+func (o *ElementCollection) GetProfileConformance() []ProfileIdentifierType {
+	return o.ProfileConformance
+}
+
+// ExternalIdentifierInterface is implemented by ExternalIdentifier and the classes derived from it.
+type ExternalIdentifierInterface interface {
+	GetExternalIdentifierType() ExternalIdentifierType
+	GetIdentifier() string
+	GetComment() string
+	GetIdentifierLocator() []string
+	GetIssuingAuthority() string
+}
+
+// GetExternalIdentifierType returns the ExternalIdentifierType property of the ExternalIdentifier.
+func (o *ExternalIdentifier) GetExternalIdentifierType() ExternalIdentifierType {
+	return o.ExternalIdentifierType
+}
+
+// GetIdentifier returns the Identifier property of the ExternalIdentifier.
+func (o *ExternalIdentifier) GetIdentifier() string {
+	return o.Identifier
+}
+
+// GetComment returns the Comment property of the ExternalIdentifier.
+func (o *ExternalIdentifier) GetComment() string {
+	return o.Comment
+}
+
+// GetIdentifierLocator returns the IdentifierLocator property of the ExternalIdentifier.
+func (o *ExternalIdentifier) GetIdentifierLocator() []string {
+	return o.IdentifierLocator
+}
+
+// GetIssuingAuthority returns the IssuingAuthority property of the ExternalIdentifier.
+func (o *ExternalIdentifier) GetIssuingAuthority() string {
+	return o.IssuingAuthority
+}
+
+// ExternalMapInterface is implemented by ExternalMap and the classes derived from it.
+type ExternalMapInterface interface {
+	GetExternalSpdxID() string
+	GetVerifiedUsing() []IntegrityMethodInterface
+	GetLocationHint() string
+	GetDefiningArtifact() *Artifact
+}
+
+// GetExternalSpdxID returns the ExternalSpdxID property of the ExternalMap.
+func (o *ExternalMap) GetExternalSpdxID() string {
+	return o.ExternalSpdxId
+}
+
+// GetVerifiedUsing returns the VerifiedUsing property of the ExternalMap.
+func (o *ExternalMap) GetVerifiedUsing() []IntegrityMethodInterface {
+	return o.VerifiedUsing
+}
+
+// GetLocationHint returns the LocationHint property of the ExternalMap.
+func (o *ExternalMap) GetLocationHint() string {
+	return o.LocationHint
+}
+
+// GetDefiningArtifact returns the DefiningArtifact property of the ExternalMap.
+func (o *ExternalMap) GetDefiningArtifact() *Artifact {
+	return o.DefiningArtifact
+}
+
+// ExternalRefInterface is implemented by ExternalRef and the classes derived from it.
+type ExternalRefInterface interface {
+	GetExternalRefType() ExternalRefType
+	GetLocator() []string
+	GetContentType() string
+	GetComment() string
+}
+
+// GetExternalRefType returns the ExternalRefType property of the ExternalRef.
+func (o *ExternalRef) GetExternalRefType() ExternalRefType {
+	return o.ExternalRefType
+}
+
+// GetLocator returns the Locator property of the ExternalRef.
+func (o *ExternalRef) GetLocator() []string {
+	return o.Locator
+}
+
+// GetContentType returns the ContentType property of the ExternalRef.
+func (o *ExternalRef) GetContentType() string {
+	return o.ContentType
+}
+
+// GetComment returns the Comment property of the ExternalRef.
+func (o *ExternalRef) GetComment() string {
+	return o.Comment
+}
+
+// HashInterface is implemented by Hash and the classes derived from it.
+type HashInterface interface {
+	IntegrityMethodInterface
+	GetAlgorithm() HashAlgorithm
+	GetHashValue() string
+}
+
+// GetAlgorithm returns the Algorithm property of the Hash.
+func (o *Hash) GetAlgorithm() HashAlgorithm {
+	return o.Algorithm
+}
+
+// GetHashValue returns the HashValue property of the Hash.
+func (o *Hash) GetHashValue() string {
+	return o.HashValue
+}
+
+// IndividualElementInterface is implemented by IndividualElement and the classes derived from it.
+type IndividualElementInterface interface {
+	ElementInterface
+}
+
+// IntegrityMethodInterface is implemented by IntegrityMethod and the classes derived from it.
+type IntegrityMethodInterface interface {
+	GetComment() string
+}
+
+// GetComment returns the Comment property of the IntegrityMethod.
+func (o *IntegrityMethod) GetComment() string {
+	return o.Comment
+}
+
+// LifecycleScopedRelationshipInterface is implemented by LifecycleScopedRelationship and the classes derived from it.
+type LifecycleScopedRelationshipInterface interface {
+	RelationshipInterface
+	GetScope() LifecycleScopeType
+}
+
+// GetScope returns the Scope property of the LifecycleScopedRelationship.
+func (o *LifecycleScopedRelationship) GetScope() LifecycleScopeType {
+	return o.Scope
+}
+
+// NamespaceMapInterface is implemented by NamespaceMap and the classes derived from it.
+type NamespaceMapInterface interface {
+	GetPrefix() string
+	GetNamespace() string
+}
+
+// GetPrefix returns the Prefix property of the NamespaceMap.
+func (o *NamespaceMap) GetPrefix() string {
+	return o.Prefix
+}
+
+// GetNamespace returns the Namespace property of the NamespaceMap.
+func (o *NamespaceMap) GetNamespace() string {
+	return o.Namespace
+}
+
+// OrganizationInterface is implemented by Organization and the classes derived from it.
+type OrganizationInterface interface {
+	AgentInterface
+}
+
+// PackageVerificationCodeInterface is implemented by PackageVerificationCode and the classes derived from it.
+type PackageVerificationCodeInterface interface {
+	IntegrityMethodInterface
+	GetAlgorithm() HashAlgorithm
+	GetHashValue() string
+	GetPackageVerificationCodeExcludedFile() []string
+}
+
+// GetAlgorithm returns the Algorithm property of the PackageVerificationCode.
+func (o *PackageVerificationCode) GetAlgorithm() HashAlgorithm {
+	return o.Algorithm
+}
+
+// GetHashValue returns the HashValue property of the PackageVerificationCode.
+func (o *PackageVerificationCode) GetHashValue() string {
+	return o.HashValue
+}
+
+// GetPackageVerificationCodeExcludedFile returns the PackageVerificationCodeExcludedFile property of the PackageVerificationCode.
+func (o *PackageVerificationCode) GetPackageVerificationCodeExcludedFile() []string {
+	return o.PackageVerificationCodeExcludedFile
+}
+
+// PersonInterface is implemented by Person and the classes derived from it.
+type PersonInterface interface {
+	AgentInterface
+}
+
+// PositiveIntegerRangeInterface is implemented by PositiveIntegerRange and the classes derived from it.
+type PositiveIntegerRangeInterface interface {
+	GetBeginIntegerRange() int
+	GetEndIntegerRange() int
+}
+
+// GetBeginIntegerRange returns the BeginIntegerRange property of the PositiveIntegerRange.
+func (o *PositiveIntegerRange) GetBeginIntegerRange() int {
+	return o.BeginIntegerRange
+}
+
+// GetEndIntegerRange returns the EndIntegerRange property of the PositiveIntegerRange.
+func (o *PositiveIntegerRange) GetEndIntegerRange() int {
+	return o.EndIntegerRange
+}
+
+// RelationshipInterface is implemented by Relationship and the classes derived from it.
+type RelationshipInterface interface {
+	ElementInterface
+	GetFrom() Element
+	GetTo() []Element
+	GetRelationshipType() RelationshipType
+	GetCompleteness() RelationshipCompleteness
+	GetStartTime() time.Time
+	GetEndTime() time.Time
+}
+
+// GetFrom returns the From property of the Relationship.
+func (o *Relationship) GetFrom() Element {
+	return o.From
+}
+
+// GetTo returns the To property of the Relationship.
+func (o *Relationship) GetTo() []Element {
+	return o.To
+}
+
+// GetRelationshipType returns the RelationshipType property of the Relationship.
+func (o *Relationship) GetRelationshipType() RelationshipType {
+	return o.RelationshipType
+}
+
+// GetCompleteness returns the Completeness property of the Relationship.
+func (o *Relationship) GetCompleteness() RelationshipCompleteness {
+	return o.Completeness
+}
+
+// GetStartTime returns the StartTime property of the Relationship.
+func (o *Relationship) GetStartTime() time.Time {
+	return o.StartTime
+}
+
+// GetEndTime returns the EndTime property of the Relationship.
+func (o *Relationship) GetEndTime() time.Time {
+	return o.EndTime
+}
+
+// SoftwareAgentInterface is implemented by SoftwareAgent and the classes derived from it.
+type SoftwareAgentInterface interface {
+	AgentInterface
+}
+
+// SpdxDocumentInterface is implemented by SpdxDocument and the classes derived from it.
+type SpdxDocumentInterface interface {
+	ElementCollectionInterface
+	GetImport() []ExternalMap
+	GetNamespaceMap() []NamespaceMap
+	GetDataLicense() *AnyLicenseInfo
+}
+
+// GetImport returns the Import property of the SpdxDocument.
+func (o *SpdxDocument) GetImport() []ExternalMap {
+	return o.Import
+}
+
+// GetNamespaceMap returns the NamespaceMap property of the SpdxDocument.
+func (o *SpdxDocument) GetNamespaceMap() []NamespaceMap {
+	return o.NamespaceMap
+}
+
+// GetDataLicense returns the DataLicense property of the SpdxDocument.
+func (o *SpdxDocument) GetDataLicense() *AnyLicenseInfo {
+	return o.DataLicense
+}
+
+// ToolInterface is implemented by Tool and the classes derived from it.
+type ToolInterface interface {
+	ElementInterface
+}
+
+// DatasetPackageInterface is implemented by DatasetPackage and the classes derived from it.
+type DatasetPackageInterface interface {
+	PackageInterface
+	GetAnonymizationMethodUsed() []string
+	GetConfidentialityLevel() ConfidentialityLevelType
+	GetDataCollectionProcess() string
+	GetDataPreprocessing() []string
+	GetDatasetAvailability() DatasetAvailabilityType
+	GetDatasetNoise() string
+	GetDatasetSize() int
+	GetDatasetType() []DatasetType
+	GetDatasetUpdateMechanism() string
+	GetHasSensitivePersonalInformation() PresenceType
+	GetIntendedUse() string
+	GetKnownBias() []string
+	GetSensor() []DictionaryEntry
+}
+
+// GetAnonymizationMethodUsed returns the AnonymizationMethodUsed property of the DatasetPackage.
+func (o *DatasetPackage) GetAnonymizationMethodUsed() []string {
+	return o.AnonymizationMethodUsed
+}
+
+// GetConfidentialityLevel returns the ConfidentialityLevel property of the DatasetPackage.
+func (o *DatasetPackage) GetConfidentialityLevel() ConfidentialityLevelType {
+	return o.ConfidentialityLevel
+}
+
+// GetDataCollectionProcess returns the DataCollectionProcess property of the DatasetPackage.
+func (o *DatasetPackage) GetDataCollectionProcess() string {
+	return o.DataCollectionProcess
+}
+
+// GetDataPreprocessing returns the DataPreprocessing property of the DatasetPackage.
+func (o *DatasetPackage) GetDataPreprocessing() []string {
+	return o.DataPreprocessing
+}
+
+// GetDatasetAvailability returns the DatasetAvailability property of the DatasetPackage.
+func (o *DatasetPackage) GetDatasetAvailability() DatasetAvailabilityType {
+	return o.DatasetAvailability
+}
+
+// GetDatasetNoise returns the DatasetNoise property of the DatasetPackage.
+func (o *DatasetPackage) GetDatasetNoise() string {
+	return o.DatasetNoise
+}
+
+// GetDatasetSize returns the DatasetSize property of the DatasetPackage.
+func (o *DatasetPackage) GetDatasetSize() int {
+	return o.DatasetSize
+}
+
+// GetDatasetType returns the DatasetType property of the DatasetPackage.
+func (o *DatasetPackage) GetDatasetType() []DatasetType {
+	return o.DatasetType
+}
+
+// GetDatasetUpdateMechanism returns the DatasetUpdateMechanism property of the DatasetPackage.
+func (o *DatasetPackage) GetDatasetUpdateMechanism() string {
+	return o.DatasetUpdateMechanism
+}
+
+// GetHasSensitivePersonalInformation returns the HasSensitivePersonalInformation property of the DatasetPackage.
+func (o *DatasetPackage) GetHasSensitivePersonalInformation() PresenceType {
+	return o.HasSensitivePersonalInformation
+}
+
+// GetIntendedUse returns the IntendedUse property of the DatasetPackage.
+func (o *DatasetPackage) GetIntendedUse() string {
+	return o.IntendedUse
+}
+
+// GetKnownBias returns the KnownBias property of the DatasetPackage.
+func (o *DatasetPackage) GetKnownBias() []string {
+	return o.KnownBias
+}
+
+// GetSensor returns the Sensor property of the DatasetPackage.
+func (o *DatasetPackage) GetSensor() []DictionaryEntry {
+	return o.Sensor
+}
+
+// ConjunctiveLicenseSetInterface is implemented by ConjunctiveLicenseSet and the classes derived from it.
+type ConjunctiveLicenseSetInterface interface {
+	AnyLicenseInfoInterface
+	GetMember() []AnyLicenseInfo
+}
+
+// GetMember returns the Member property of the ConjunctiveLicenseSet.
+func (o *ConjunctiveLicenseSet) GetMember() []AnyLicenseInfo {
+	return o.Member
+}
+
+// CustomLicenseInterface is implemented by CustomLicense and the classes derived from it.
+type CustomLicenseInterface interface {
+	LicenseInterface
+}
+
+// CustomLicenseAdditionInterface is implemented by CustomLicenseAddition and the classes derived from it.
+type CustomLicenseAdditionInterface interface {
+	LicenseAdditionInterface
+}
+
+// DisjunctiveLicenseSetInterface is implemented by DisjunctiveLicenseSet and the classes derived from it.
+type DisjunctiveLicenseSetInterface interface {
+	AnyLicenseInfoInterface
+	GetMember() []AnyLicenseInfo
+}
+
+// GetMember returns the Member property of the DisjunctiveLicenseSet.
+func (o *DisjunctiveLicenseSet) GetMember() []AnyLicenseInfo {
+	return o.Member
+}
+
+// ExtendableLicenseInterface is implemented by ExtendableLicense and the classes derived from it.
+type ExtendableLicenseInterface interface {
+	AnyLicenseInfoInterface
+}
+
+// IndividualLicensingInfoInterface is implemented by IndividualLicensingInfo and the classes derived from it.
+type IndividualLicensingInfoInterface interface {
+	AnyLicenseInfoInterface
+}
+
+// LicenseInterface is implemented by License and the classes derived from it.
+type LicenseInterface interface {
+	ExtendableLicenseInterface
+	GetLicenseText() string
+	GetIsDeprecatedLicenseID() bool
+	GetIsFsfLibre() bool
+	GetIsOsiApproved() bool
+	GetLicenseXml() string
+	GetObsoletedBy() string
+	GetSeeAlso() []string
+	GetStandardLicenseHeader() string
+	GetStandardLicenseTemplate() string
+}
+
+// GetLicenseText returns the LicenseText property of the License.
+func (o *License) GetLicenseText() string {
+	return o.LicenseText
+}
+
+// GetIsDeprecatedLicenseID returns the IsDeprecatedLicenseID property of the License.
+func (o *License) GetIsDeprecatedLicenseID() bool {
+	return o.IsDeprecatedLicenseId
+}
+
+// GetIsFsfLibre returns the IsFsfLibre property of the License.
+func (o *License) GetIsFsfLibre() bool {
+	return o.IsFsfLibre
+}
+
+// GetIsOsiApproved returns the IsOsiApproved property of the License.
+func (o *License) GetIsOsiApproved() bool {
+	return o.IsOsiApproved
+}
+
+// GetLicenseXml returns the LicenseXml property of the License.
+func (o *License) GetLicenseXml() string {
+	return o.LicenseXml
+}
+
+// GetObsoletedBy returns the ObsoletedBy property of the License.
+func (o *License) GetObsoletedBy() string {
+	return o.ObsoletedBy
+}
+
+// GetSeeAlso returns the SeeAlso property of the License.
+func (o *License) GetSeeAlso() []string {
+	return o.SeeAlso
+}
+
+// GetStandardLicenseHeader returns the StandardLicenseHeader property of the License.
+func (o *License) GetStandardLicenseHeader() string {
+	return o.StandardLicenseHeader
+}
+
+// GetStandardLicenseTemplate returns the StandardLicenseTemplate property of the License.
+func (o *License) GetStandardLicenseTemplate() string {
+	return o.StandardLicenseTemplate
+}
+
+// LicenseAdditionInterface is implemented by LicenseAddition and the classes derived from it.
+type LicenseAdditionInterface interface {
+	ElementInterface
+	GetAdditionText() string
+	GetIsDeprecatedAdditionID() bool
+	GetLicenseXml() string
+	GetObsoletedBy() string
+	GetSeeAlso() []string
+	GetStandardAdditionTemplate() string
+}
+
+// GetAdditionText returns the AdditionText property of the LicenseAddition.
+func (o *LicenseAddition) GetAdditionText() string {
+	return o.AdditionText
+}
+
+// GetIsDeprecatedAdditionID returns the IsDeprecatedAdditionID property of the LicenseAddition.
+func (o *LicenseAddition) GetIsDeprecatedAdditionID() bool {
+	return o.IsDeprecatedAdditionId
+}
+
+// GetLicenseXml returns the LicenseXml property of the LicenseAddition.
+func (o *LicenseAddition) GetLicenseXml() string {
+	return o.LicenseXml
+}
+
+// GetObsoletedBy returns the ObsoletedBy property of the LicenseAddition.
+func (o *LicenseAddition) GetObsoletedBy() string {
+	return o.ObsoletedBy
+}
+
+// GetSeeAlso returns the SeeAlso property of the LicenseAddition.
+func (o *LicenseAddition) GetSeeAlso() []string {
+	return o.SeeAlso
+}
+
+// GetStandardAdditionTemplate returns the StandardAdditionTemplate property of the LicenseAddition.
+func (o *LicenseAddition) GetStandardAdditionTemplate() string {
+	return o.StandardAdditionTemplate
+}
+
+// ListedLicenseInterface is implemented by ListedLicense and the classes derived from it.
+type ListedLicenseInterface interface {
+	LicenseInterface
+	GetDeprecatedVersion() string
+	GetListVersionAdded() string
+}
+
+// GetDeprecatedVersion returns the DeprecatedVersion property of the ListedLicense.
+func (o *ListedLicense) GetDeprecatedVersion() string {
+	return o.DeprecatedVersion
+}
+
+// GetListVersionAdded returns the ListVersionAdded property of the ListedLicense.
+func (o *ListedLicense) GetListVersionAdded() string {
+	return o.ListVersionAdded
+}
+
+// ListedLicenseExceptionInterface is implemented by ListedLicenseException and the classes derived from it.
+type ListedLicenseExceptionInterface interface {
+	LicenseAdditionInterface
+	GetDeprecatedVersion() string
+	GetListVersionAdded() string
+}
+
+// GetDeprecatedVersion returns the DeprecatedVersion property of the ListedLicenseException.
+func (o *ListedLicenseException) GetDeprecatedVersion() string {
+	return o.DeprecatedVersion
+}
+
+// GetListVersionAdded returns the ListVersionAdded property of the ListedLicenseException.
+func (o *ListedLicenseException) GetListVersionAdded() string {
+	return o.ListVersionAdded
+}
+
+// OrLaterOperatorInterface is implemented by OrLaterOperator and the classes derived from it.
+type OrLaterOperatorInterface interface {
+	ExtendableLicenseInterface
+	GetSubjectLicense() License
+}
+
+// GetSubjectLicense returns the SubjectLicense property of the OrLaterOperator.
+func (o *OrLaterOperator) GetSubjectLicense() License {
+	return o.SubjectLicense
+}
+
+// WithAdditionOperatorInterface is implemented by WithAdditionOperator and the classes derived from it.
+type WithAdditionOperatorInterface interface {
+	AnyLicenseInfoInterface
+	GetSubjectAddition() LicenseAddition
+	GetSubjectExtendableLicense() ExtendableLicense
+}
+
+// GetSubjectAddition returns the SubjectAddition property of the WithAdditionOperator.
+func (o *WithAdditionOperator) GetSubjectAddition() LicenseAddition {
+	return o.SubjectAddition
+}
+
+// GetSubjectExtendableLicense returns the SubjectExtendableLicense property of the WithAdditionOperator.
+func (o *WithAdditionOperator) GetSubjectExtendableLicense() ExtendableLicense {
+	return o.SubjectExtendableLicense
+}
+
+// CdxPropertiesExtensionInterface is implemented by CdxPropertiesExtension and the classes derived from it.
+type CdxPropertiesExtensionInterface interface {
+	ExtensionInterface
+	GetCdxProperty() []CdxPropertyEntry
+}
+
+// GetCdxProperty returns the CdxProperty property of the CdxPropertiesExtension.
+func (o *CdxPropertiesExtension) GetCdxProperty() []CdxPropertyEntry {
+	return o.CdxProperty
+}
+
+// CdxPropertyEntryInterface is implemented by CdxPropertyEntry and the classes derived from it.
+type CdxPropertyEntryInterface interface {
+	GetCdxPropName() string
+	GetCdxPropValue() string
+}
+
+// GetCdxPropName returns the CdxPropName property of the CdxPropertyEntry.
+func (o *CdxPropertyEntry) GetCdxPropName() string {
+	return o.CdxPropName
+}
+
+// GetCdxPropValue returns the CdxPropValue property of the CdxPropertyEntry.
+func (o *CdxPropertyEntry) GetCdxPropValue() string {
+	return o.CdxPropValue
+}
+
+// ExtensionInterface is implemented by Extension and the classes derived from it.
+type ExtensionInterface interface {
+}
+
+// CvssV2VulnAssessmentRelationshipInterface is implemented by CvssV2VulnAssessmentRelationship and the classes derived from it.
+type CvssV2VulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetScore() float64
+	GetVectorString() string
+}
+
+// GetScore returns the Score property of the CvssV2VulnAssessmentRelationship.
+func (o *CvssV2VulnAssessmentRelationship) GetScore() float64 {
+	return o.Score
+}
+
+// GetVectorString returns the VectorString property of the CvssV2VulnAssessmentRelationship.
+func (o *CvssV2VulnAssessmentRelationship) GetVectorString() string {
+	return o.VectorString
+}
+
+// CvssV3VulnAssessmentRelationshipInterface is implemented by CvssV3VulnAssessmentRelationship and the classes derived from it.
+type CvssV3VulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetScore() float64
+	GetSeverity() CvssSeverityType
+	GetVectorString() string
+}
+
+// GetScore returns the Score property of the CvssV3VulnAssessmentRelationship.
+func (o *CvssV3VulnAssessmentRelationship) GetScore() float64 {
+	return o.Score
+}
+
+// GetSeverity returns the Severity property of the CvssV3VulnAssessmentRelationship.
+func (o *CvssV3VulnAssessmentRelationship) GetSeverity() CvssSeverityType {
+	return o.Severity
+}
+
+// GetVectorString returns the VectorString property of the CvssV3VulnAssessmentRelationship.
+func (o *CvssV3VulnAssessmentRelationship) GetVectorString() string {
+	return o.VectorString
+}
+
+// CvssV4VulnAssessmentRelationshipInterface is implemented by CvssV4VulnAssessmentRelationship and the classes derived from it.
+type CvssV4VulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetScore() float64
+	GetSeverity() CvssSeverityType
+	GetVectorString() string
+}
+
+// GetScore returns the Score property of the CvssV4VulnAssessmentRelationship.
+func (o *CvssV4VulnAssessmentRelationship) GetScore() float64 {
+	return o.Score
+}
+
+// GetSeverity returns the Severity property of the CvssV4VulnAssessmentRelationship.
+func (o *CvssV4VulnAssessmentRelationship) GetSeverity() CvssSeverityType {
+	return o.Severity
+}
+
+// GetVectorString returns the VectorString property of the CvssV4VulnAssessmentRelationship.
+func (o *CvssV4VulnAssessmentRelationship) GetVectorString() string {
+	return o.VectorString
+}
+
+// EpssVulnAssessmentRelationshipInterface is implemented by EpssVulnAssessmentRelationship and the classes derived from it.
+type EpssVulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetProbability() float64
+	GetPercentile() float64
+}
+
+// GetProbability returns the Probability property of the EpssVulnAssessmentRelationship.
+func (o *EpssVulnAssessmentRelationship) GetProbability() float64 {
+	return o.Probability
+}
+
+// GetPercentile returns the Percentile property of the EpssVulnAssessmentRelationship.
+func (o *EpssVulnAssessmentRelationship) GetPercentile() float64 {
+	return o.Percentile
+}
+
+// ExploitCatalogVulnAssessmentRelationshipInterface is implemented by ExploitCatalogVulnAssessmentRelationship and the classes derived from it.
+type ExploitCatalogVulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetCatalogType() ExploitCatalogType
+	GetExploited() bool
+	GetLocator() string
+}
+
+// GetCatalogType returns the CatalogType property of the ExploitCatalogVulnAssessmentRelationship.
+func (o *ExploitCatalogVulnAssessmentRelationship) GetCatalogType() ExploitCatalogType {
+	return o.CatalogType
+}
+
+// GetExploited returns the Exploited property of the ExploitCatalogVulnAssessmentRelationship.
+func (o *ExploitCatalogVulnAssessmentRelationship) GetExploited() bool {
+	return o.Exploited
+}
+
+// GetLocator returns the Locator property of the ExploitCatalogVulnAssessmentRelationship.
+func (o *ExploitCatalogVulnAssessmentRelationship) GetLocator() string {
+	return o.Locator
+}
+
+// SsvcVulnAssessmentRelationshipInterface is implemented by SsvcVulnAssessmentRelationship and the classes derived from it.
+type SsvcVulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetDecisionType() SsvcDecisionType
+}
+
+// GetDecisionType returns the DecisionType property of the SsvcVulnAssessmentRelationship.
+func (o *SsvcVulnAssessmentRelationship) GetDecisionType() SsvcDecisionType {
+	return o.DecisionType
+}
+
+// VexAffectedVulnAssessmentRelationshipInterface is implemented by VexAffectedVulnAssessmentRelationship and the classes derived from it.
+type VexAffectedVulnAssessmentRelationshipInterface interface {
+	VexVulnAssessmentRelationshipInterface
+	GetActionStatement() string
+	GetActionStatementTime() time.Time
+}
+
+// GetActionStatement returns the ActionStatement property of the VexAffectedVulnAssessmentRelationship.
+func (o *VexAffectedVulnAssessmentRelationship) GetActionStatement() string {
+	return o.ActionStatement
+}
+
+// GetActionStatementTime returns the ActionStatementTime property of the VexAffectedVulnAssessmentRelationship.
+func (o *VexAffectedVulnAssessmentRelationship) GetActionStatementTime() time.Time {
+	return o.ActionStatementTime
+}
+
+// VexFixedVulnAssessmentRelationshipInterface is implemented by VexFixedVulnAssessmentRelationship and the classes derived from it.
+type VexFixedVulnAssessmentRelationshipInterface interface {
+	VexVulnAssessmentRelationshipInterface
+}
+
+// VexNotAffectedVulnAssessmentRelationshipInterface is implemented by VexNotAffectedVulnAssessmentRelationship and the classes derived from it.
+type VexNotAffectedVulnAssessmentRelationshipInterface interface {
+	VexVulnAssessmentRelationshipInterface
+	GetJustificationType() VexJustificationType
+	GetImpactStatement() string
+	GetImpactStatementTime() time.Time
+}
+
+// GetJustificationType returns the JustificationType property of the VexNotAffectedVulnAssessmentRelationship.
+func (o *VexNotAffectedVulnAssessmentRelationship) GetJustificationType() VexJustificationType {
+	return o.JustificationType
+}
+
+// GetImpactStatement returns the ImpactStatement property of the VexNotAffectedVulnAssessmentRelationship.
+func (o *VexNotAffectedVulnAssessmentRelationship) GetImpactStatement() string {
+	return o.ImpactStatement
+}
+
+// GetImpactStatementTime returns the ImpactStatementTime property of the VexNotAffectedVulnAssessmentRelationship.
+func (o *VexNotAffectedVulnAssessmentRelationship) GetImpactStatementTime() time.Time {
+	return o.ImpactStatementTime
+}
+
+// VexUnderInvestigationVulnAssessmentRelationshipInterface is implemented by VexUnderInvestigationVulnAssessmentRelationship and the classes derived from it.
+type VexUnderInvestigationVulnAssessmentRelationshipInterface interface {
+	VexVulnAssessmentRelationshipInterface
+}
+
+// VexVulnAssessmentRelationshipInterface is implemented by VexVulnAssessmentRelationship and the classes derived from it.
+type VexVulnAssessmentRelationshipInterface interface {
+	VulnAssessmentRelationshipInterface
+	GetVexVersion() string
+	GetStatusNotes() string
+}
+
+// GetVexVersion returns the VexVersion property of the VexVulnAssessmentRelationship.
+func (o *VexVulnAssessmentRelationship) GetVexVersion() string {
+	return o.VexVersion
+}
+
+// GetStatusNotes returns the StatusNotes property of the VexVulnAssessmentRelationship.
+func (o *VexVulnAssessmentRelationship) GetStatusNotes() string {
+	return o.StatusNotes
+}
+
+// VulnAssessmentRelationshipInterface is implemented by VulnAssessmentRelationship and the classes derived from it.
+type VulnAssessmentRelationshipInterface interface {
+	RelationshipInterface
+	GetAssessedElement() *SoftwareArtifact
+	GetPublishedTime() time.Time
+	GetSuppliedBy() *Agent
+	GetModifiedTime() time.Time
+	GetWithdrawnTime() time.Time
+}
+
+// GetAssessedElement returns the AssessedElement property of the VulnAssessmentRelationship.
+func (o *VulnAssessmentRelationship) GetAssessedElement() *SoftwareArtifact {
+	return o.AssessedElement
+}
+
+// GetPublishedTime returns the PublishedTime property of the VulnAssessmentRelationship.
+func (o *VulnAssessmentRelationship) GetPublishedTime() time.Time {
+	return o.PublishedTime
+}
+
+// GetSuppliedBy returns the SuppliedBy property of the VulnAssessmentRelationship.
+func (o *VulnAssessmentRelationship) GetSuppliedBy() *Agent {
+	return o.SuppliedBy
+}
+
+// GetModifiedTime returns the ModifiedTime property of the VulnAssessmentRelationship.
+func (o *VulnAssessmentRelationship) GetModifiedTime() time.Time {
+	return o.ModifiedTime
+}
+
+// GetWithdrawnTime returns the WithdrawnTime property of the VulnAssessmentRelationship.
+func (o *VulnAssessmentRelationship) GetWithdrawnTime() time.Time {
+	return o.WithdrawnTime
+}
+
+// VulnerabilityInterface is implemented by Vulnerability and the classes derived from it.
+type VulnerabilityInterface interface {
+	ArtifactInterface
+	GetPublishedTime() time.Time
+	GetModifiedTime() time.Time
+	GetWithdrawnTime() time.Time
+}
+
+// GetPublishedTime returns the PublishedTime property of the Vulnerability.
+func (o *Vulnerability) GetPublishedTime() time.Time {
+	return o.PublishedTime
+}
+
+// GetModifiedTime returns the ModifiedTime property of the Vulnerability.
+func (o *Vulnerability) GetModifiedTime() time.Time {
+	return o.ModifiedTime
+}
+
+// GetWithdrawnTime returns the WithdrawnTime property of the Vulnerability.
+func (o *Vulnerability) GetWithdrawnTime() time.Time {
+	return o.WithdrawnTime
+}
+
+// AnyLicenseInfoInterface is implemented by AnyLicenseInfo and the classes derived from it.
+type AnyLicenseInfoInterface interface {
+	ElementInterface
+}
+
+// LicenseExpressionInterface is implemented by LicenseExpression and the classes derived from it.
+type LicenseExpressionInterface interface {
+	AnyLicenseInfoInterface
+	GetLicenseExpression() string
+	GetLicenseListVersion() string
+	GetCustomIDToUri() []DictionaryEntry
+}
+
+// GetLicenseExpression returns the LicenseExpression property of the LicenseExpression.
+func (o *LicenseExpression) GetLicenseExpression() string {
+	return o.LicenseExpression
+}
+
+// GetLicenseListVersion returns the LicenseListVersion property of the LicenseExpression.
+func (o *LicenseExpression) GetLicenseListVersion() string {
+	return o.LicenseListVersion
+}
+
+// GetCustomIDToUri returns the CustomIDToUri property of the LicenseExpression.
+func (o *LicenseExpression) GetCustomIDToUri() []DictionaryEntry {
+	return o.CustomIdToUri
+}
+
+// SimpleLicensingTextInterface is implemented by SimpleLicensingText and the classes derived from it.
+type SimpleLicensingTextInterface interface {
+	ElementInterface
+	GetLicenseText() string
+}
+
+// GetLicenseText returns the LicenseText property of the SimpleLicensingText.
+func (o *SimpleLicensingText) GetLicenseText() string {
+	return o.LicenseText
+}
+
+// ContentIdentifierInterface is implemented by ContentIdentifier and the classes derived from it.
+type ContentIdentifierInterface interface {
+	IntegrityMethodInterface
+	GetContentIdentifierType() ContentIdentifierType
+	GetContentIdentifierValue() string
+}
+
+// GetContentIdentifierType returns the ContentIdentifierType property of the ContentIdentifier.
+func (o *ContentIdentifier) GetContentIdentifierType() ContentIdentifierType {
+	return o.ContentIdentifierType
+}
+
+// GetContentIdentifierValue returns the ContentIdentifierValue property of the ContentIdentifier.
+func (o *ContentIdentifier) GetContentIdentifierValue() string {
+	return o.ContentIdentifierValue
+}
+
+// FileInterface is implemented by File and the classes derived from it.
+type FileInterface interface {
+	SoftwareArtifactInterface
+	GetContentType() string
+	GetFileKind() FileKindType
+}
+
+// GetContentType returns the ContentType property of the File.
+func (o *File) GetContentType() string {
+	return o.ContentType
+}
+
+// GetFileKind returns the FileKind property of the File.
+func (o *File) GetFileKind() FileKindType {
+	return o.FileKind
+}
+
+// PackageInterface is implemented by Package and the classes derived from it.
+type PackageInterface interface {
+	SoftwareArtifactInterface
+	GetDownloadLocation() string
+	GetHomePage() string
+	GetPackageVersion() string
+	GetPackageURL() string
+	GetSourceInfo() string
+}
+
+// GetDownloadLocation returns the DownloadLocation property of the Package.
+func (o *Package) GetDownloadLocation() string {
+	return o.DownloadLocation
+}
+
+// GetHomePage returns the HomePage property of the Package.
+func (o *Package) GetHomePage() string {
+	return o.HomePage
+}
+
+// GetPackageVersion returns the PackageVersion property of the Package.
+func (o *Package) GetPackageVersion() string {
+	return o.PackageVersion
+}
+
+// GetPackageURL returns the PackageURL property of the Package.
+func (o *Package) GetPackageURL() string {
+	return o.PackageUrl
+}
+
+// GetSourceInfo returns the SourceInfo property of the Package.
+func (o *Package) GetSourceInfo() string {
+	return o.SourceInfo
+}
+
+// SbomInterface is implemented by Sbom and the classes derived from it.
+type SbomInterface interface {
+	BomInterface
+	GetSbomType() []SbomType
+}
+
+// GetSbomType returns the SbomType property of the Sbom.
+func (o *Sbom) GetSbomType() []SbomType {
+	return o.SbomType
+}
+
+// SnippetInterface is implemented by Snippet and the classes derived from it.
+type SnippetInterface interface {
+	SoftwareArtifactInterface
+	GetByteRange() *PositiveIntegerRange
+	GetLineRange() *PositiveIntegerRange
+	GetSnippetFromFile() File
+}
+
+// GetByteRange returns the ByteRange property of the Snippet.
+func (o *Snippet) GetByteRange() *PositiveIntegerRange {
+	return o.ByteRange
+}
+
+// GetLineRange returns the LineRange property of the Snippet.
+func (o *Snippet) GetLineRange() *PositiveIntegerRange {
+	return o.LineRange
+}
+
+// GetSnippetFromFile returns the SnippetFromFile property of the Snippet.
+func (o *Snippet) GetSnippetFromFile() File {
+	return o.SnippetFromFile
+}
+
+// SoftwareArtifactInterface is implemented by SoftwareArtifact and the classes derived from it.
+type SoftwareArtifactInterface interface {
+	ArtifactInterface
+	GetPrimaryPurpose() SoftwarePurpose
+	GetAdditionalPurpose() []SoftwarePurpose
+	GetCopyrightText() string
+	GetAttributionText() []string
+	GetContentIdentifier() []ContentIdentifier
+}
+
+// GetPrimaryPurpose returns the PrimaryPurpose property of the SoftwareArtifact.
+func (o *SoftwareArtifact) GetPrimaryPurpose() SoftwarePurpose {
+	return o.PrimaryPurpose
+}
+
+// GetAdditionalPurpose returns the AdditionalPurpose property of the SoftwareArtifact.
+func (o *SoftwareArtifact) GetAdditionalPurpose() []SoftwarePurpose {
+	return o.AdditionalPurpose
+}
+
+// GetCopyrightText returns the CopyrightText property of the SoftwareArtifact.
+func (o *SoftwareArtifact) GetCopyrightText() string {
+	return o.CopyrightText
+}
+
+// GetAttributionText returns the AttributionText property of the SoftwareArtifact.
+func (o *SoftwareArtifact) GetAttributionText() []string {
+	return o.AttributionText
+}
+
+// GetContentIdentifier returns the ContentIdentifier property of the SoftwareArtifact.
+func (o *SoftwareArtifact) GetContentIdentifier() []ContentIdentifier {
+	return o.ContentIdentifier
+}
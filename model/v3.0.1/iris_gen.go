@@ -0,0 +1,509 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+// IRIs of the SPDX classes, enumeration types included.
+const (
+	// IRIAIPackage is the IRI of the AIPackage class.
+	IRIAIPackage = "https://spdx.org/rdf/3.0.1/terms/AI/AIPackage"
+	// IRIAgent is the IRI of the Agent class.
+	IRIAgent = "https://spdx.org/rdf/3.0.1/terms/Core/Agent"
+	// IRIAnnotation is the IRI of the Annotation class.
+	IRIAnnotation = "https://spdx.org/rdf/3.0.1/terms/Core/Annotation"
+	// IRIAnnotationType is the IRI of the AnnotationType class.
+	IRIAnnotationType = "https://spdx.org/rdf/3.0.1/terms/Core/AnnotationType"
+	// IRIAnyLicenseInfo is the IRI of the AnyLicenseInfo class.
+	IRIAnyLicenseInfo = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/AnyLicenseInfo"
+	// IRIArtifact is the IRI of the Artifact class.
+	IRIArtifact = "https://spdx.org/rdf/3.0.1/terms/Core/Artifact"
+	// IRIBom is the IRI of the Bom class.
+	IRIBom = "https://spdx.org/rdf/3.0.1/terms/Core/Bom"
+	// IRIBuild is the IRI of the Build class.
+	IRIBuild = "https://spdx.org/rdf/3.0.1/terms/Build/Build"
+	// IRIBundle is the IRI of the Bundle class.
+	IRIBundle = "https://spdx.org/rdf/3.0.1/terms/Core/Bundle"
+	// IRICdxPropertiesExtension is the IRI of the CdxPropertiesExtension class.
+	IRICdxPropertiesExtension = "https://spdx.org/rdf/3.0.1/terms/Extension/CdxPropertiesExtension"
+	// IRICdxPropertyEntry is the IRI of the CdxPropertyEntry class.
+	IRICdxPropertyEntry = "https://spdx.org/rdf/3.0.1/terms/Extension/CdxPropertyEntry"
+	// IRIConfidentialityLevelType is the IRI of the ConfidentialityLevelType class.
+	IRIConfidentialityLevelType = "https://spdx.org/rdf/3.0.1/terms/Dataset/ConfidentialityLevelType"
+	// IRIConjunctiveLicenseSet is the IRI of the ConjunctiveLicenseSet class.
+	IRIConjunctiveLicenseSet = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/ConjunctiveLicenseSet"
+	// IRIContentIdentifier is the IRI of the ContentIdentifier class.
+	IRIContentIdentifier = "https://spdx.org/rdf/3.0.1/terms/Software/ContentIdentifier"
+	// IRIContentIdentifierType is the IRI of the ContentIdentifierType class.
+	IRIContentIdentifierType = "https://spdx.org/rdf/3.0.1/terms/Software/ContentIdentifierType"
+	// IRICreationInfo is the IRI of the CreationInfo class.
+	IRICreationInfo = "https://spdx.org/rdf/3.0.1/terms/Core/CreationInfo"
+	// IRICustomLicense is the IRI of the CustomLicense class.
+	IRICustomLicense = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/CustomLicense"
+	// IRICustomLicenseAddition is the IRI of the CustomLicenseAddition class.
+	IRICustomLicenseAddition = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/CustomLicenseAddition"
+	// IRICvssSeverityType is the IRI of the CvssSeverityType class.
+	IRICvssSeverityType = "https://spdx.org/rdf/3.0.1/terms/Security/CvssSeverityType"
+	// IRICvssV2VulnAssessmentRelationship is the IRI of the CvssV2VulnAssessmentRelationship class.
+	IRICvssV2VulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/CvssV2VulnAssessmentRelationship"
+	// IRICvssV3VulnAssessmentRelationship is the IRI of the CvssV3VulnAssessmentRelationship class.
+	IRICvssV3VulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/CvssV3VulnAssessmentRelationship"
+	// IRICvssV4VulnAssessmentRelationship is the IRI of the CvssV4VulnAssessmentRelationship class.
+	IRICvssV4VulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/CvssV4VulnAssessmentRelationship"
+	// IRIDatasetAvailabilityType is the IRI of the DatasetAvailabilityType class.
+	IRIDatasetAvailabilityType = "https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetAvailabilityType"
+	// IRIDatasetPackage is the IRI of the DatasetPackage class.
+	IRIDatasetPackage = "https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetPackage"
+	// IRIDatasetType is the IRI of the DatasetType class.
+	IRIDatasetType = "https://spdx.org/rdf/3.0.1/terms/Dataset/DatasetType"
+	// IRIDictionaryEntry is the IRI of the DictionaryEntry class.
+	IRIDictionaryEntry = "https://spdx.org/rdf/3.0.1/terms/Core/DictionaryEntry"
+	// IRIDisjunctiveLicenseSet is the IRI of the DisjunctiveLicenseSet class.
+	IRIDisjunctiveLicenseSet = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/DisjunctiveLicenseSet"
+	// IRIElement is the IRI of the Element class.
+	IRIElement = "https://spdx.org/rdf/3.0.1/terms/Core/Element"
+	// IRIElementCollection is the IRI of the ElementCollection class.
+	IRIElementCollection = "https://spdx.org/rdf/3.0.1/terms/Core/ElementCollection"
+	// IRIEnergyConsumption is the IRI of the EnergyConsumption class.
+	IRIEnergyConsumption = "https://spdx.org/rdf/3.0.1/terms/AI/EnergyConsumption"
+	// IRIEnergyConsumptionDescription is the IRI of the EnergyConsumptionDescription class.
+	IRIEnergyConsumptionDescription = "https://spdx.org/rdf/3.0.1/terms/AI/EnergyConsumptionDescription"
+	// IRIEnergyUnitType is the IRI of the EnergyUnitType class.
+	IRIEnergyUnitType = "https://spdx.org/rdf/3.0.1/terms/AI/EnergyUnitType"
+	// IRIEpssVulnAssessmentRelationship is the IRI of the EpssVulnAssessmentRelationship class.
+	IRIEpssVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/EpssVulnAssessmentRelationship"
+	// IRIExploitCatalogType is the IRI of the ExploitCatalogType class.
+	IRIExploitCatalogType = "https://spdx.org/rdf/3.0.1/terms/Security/ExploitCatalogType"
+	// IRIExploitCatalogVulnAssessmentRelationship is the IRI of the ExploitCatalogVulnAssessmentRelationship class.
+	IRIExploitCatalogVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/ExploitCatalogVulnAssessmentRelationship"
+	// IRIExtendableLicense is the IRI of the ExtendableLicense class.
+	IRIExtendableLicense = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/ExtendableLicense"
+	// IRIExtension is the IRI of the Extension class.
+	IRIExtension = "https://spdx.org/rdf/3.0.1/terms/Extension/Extension"
+	// IRIExternalIdentifier is the IRI of the ExternalIdentifier class.
+	IRIExternalIdentifier = "https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifier"
+	// IRIExternalIdentifierType is the IRI of the ExternalIdentifierType class.
+	IRIExternalIdentifierType = "https://spdx.org/rdf/3.0.1/terms/Core/ExternalIdentifierType"
+	// IRIExternalMap is the IRI of the ExternalMap class.
+	IRIExternalMap = "https://spdx.org/rdf/3.0.1/terms/Core/ExternalMap"
+	// IRIExternalRef is the IRI of the ExternalRef class.
+	IRIExternalRef = "https://spdx.org/rdf/3.0.1/terms/Core/ExternalRef"
+	// IRIExternalRefType is the IRI of the ExternalRefType class.
+	IRIExternalRefType = "https://spdx.org/rdf/3.0.1/terms/Core/ExternalRefType"
+	// IRIFile is the IRI of the File class.
+	IRIFile = "https://spdx.org/rdf/3.0.1/terms/Software/File"
+	// IRIFileKindType is the IRI of the FileKindType class.
+	IRIFileKindType = "https://spdx.org/rdf/3.0.1/terms/Software/FileKindType"
+	// IRIHash is the IRI of the Hash class.
+	IRIHash = "https://spdx.org/rdf/3.0.1/terms/Core/Hash"
+	// IRIHashAlgorithm is the IRI of the HashAlgorithm class.
+	IRIHashAlgorithm = "https://spdx.org/rdf/3.0.1/terms/Core/HashAlgorithm"
+	// IRIIndividualElement is the IRI of the IndividualElement class.
+	IRIIndividualElement = "https://spdx.org/rdf/3.0.1/terms/Core/IndividualElement"
+	// IRIIndividualLicensingInfo is the IRI of the IndividualLicensingInfo class.
+	IRIIndividualLicensingInfo = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/IndividualLicensingInfo"
+	// IRIIntegrityMethod is the IRI of the IntegrityMethod class.
+	IRIIntegrityMethod = "https://spdx.org/rdf/3.0.1/terms/Core/IntegrityMethod"
+	// IRILicense is the IRI of the License class.
+	IRILicense = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/License"
+	// IRILicenseAddition is the IRI of the LicenseAddition class.
+	IRILicenseAddition = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/LicenseAddition"
+	// IRILicenseExpression is the IRI of the LicenseExpression class.
+	IRILicenseExpression = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/LicenseExpression"
+	// IRILifecycleScopeType is the IRI of the LifecycleScopeType class.
+	IRILifecycleScopeType = "https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopeType"
+	// IRILifecycleScopedRelationship is the IRI of the LifecycleScopedRelationship class.
+	IRILifecycleScopedRelationship = "https://spdx.org/rdf/3.0.1/terms/Core/LifecycleScopedRelationship"
+	// IRIListedLicense is the IRI of the ListedLicense class.
+	IRIListedLicense = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/ListedLicense"
+	// IRIListedLicenseException is the IRI of the ListedLicenseException class.
+	IRIListedLicenseException = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/ListedLicenseException"
+	// IRINamespaceMap is the IRI of the NamespaceMap class.
+	IRINamespaceMap = "https://spdx.org/rdf/3.0.1/terms/Core/NamespaceMap"
+	// IRIOrLaterOperator is the IRI of the OrLaterOperator class.
+	IRIOrLaterOperator = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/OrLaterOperator"
+	// IRIOrganization is the IRI of the Organization class.
+	IRIOrganization = "https://spdx.org/rdf/3.0.1/terms/Core/Organization"
+	// IRIPackage is the IRI of the Package class.
+	IRIPackage = "https://spdx.org/rdf/3.0.1/terms/Software/Package"
+	// IRIPackageVerificationCode is the IRI of the PackageVerificationCode class.
+	IRIPackageVerificationCode = "https://spdx.org/rdf/3.0.1/terms/Core/PackageVerificationCode"
+	// IRIPerson is the IRI of the Person class.
+	IRIPerson = "https://spdx.org/rdf/3.0.1/terms/Core/Person"
+	// IRIPositiveIntegerRange is the IRI of the PositiveIntegerRange class.
+	IRIPositiveIntegerRange = "https://spdx.org/rdf/3.0.1/terms/Core/PositiveIntegerRange"
+	// IRIPresenceType is the IRI of the PresenceType class.
+	IRIPresenceType = "https://spdx.org/rdf/3.0.1/terms/Core/PresenceType"
+	// IRIProfileIdentifierType is the IRI of the ProfileIdentifierType class.
+	IRIProfileIdentifierType = "https://spdx.org/rdf/3.0.1/terms/Core/ProfileIdentifierType"
+	// IRIRelationship is the IRI of the Relationship class.
+	IRIRelationship = "https://spdx.org/rdf/3.0.1/terms/Core/Relationship"
+	// IRIRelationshipCompleteness is the IRI of the RelationshipCompleteness class.
+	IRIRelationshipCompleteness = "https://spdx.org/rdf/3.0.1/terms/Core/RelationshipCompleteness"
+	// IRIRelationshipType is the IRI of the RelationshipType class.
+	IRIRelationshipType = "https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType"
+	// IRISafetyRiskAssessmentType is the IRI of the SafetyRiskAssessmentType class.
+	IRISafetyRiskAssessmentType = "https://spdx.org/rdf/3.0.1/terms/AI/SafetyRiskAssessmentType"
+	// IRISbom is the IRI of the Sbom class.
+	IRISbom = "https://spdx.org/rdf/3.0.1/terms/Software/Sbom"
+	// IRISbomType is the IRI of the SbomType class.
+	IRISbomType = "https://spdx.org/rdf/3.0.1/terms/Software/SbomType"
+	// IRISimpleLicensingText is the IRI of the SimpleLicensingText class.
+	IRISimpleLicensingText = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/SimpleLicensingText"
+	// IRISnippet is the IRI of the Snippet class.
+	IRISnippet = "https://spdx.org/rdf/3.0.1/terms/Software/Snippet"
+	// IRISoftwareAgent is the IRI of the SoftwareAgent class.
+	IRISoftwareAgent = "https://spdx.org/rdf/3.0.1/terms/Core/SoftwareAgent"
+	// IRISoftwareArtifact is the IRI of the SoftwareArtifact class.
+	IRISoftwareArtifact = "https://spdx.org/rdf/3.0.1/terms/Software/SoftwareArtifact"
+	// IRISoftwarePurpose is the IRI of the SoftwarePurpose class.
+	IRISoftwarePurpose = "https://spdx.org/rdf/3.0.1/terms/Software/SoftwarePurpose"
+	// IRISpdxDocument is the IRI of the SpdxDocument class.
+	IRISpdxDocument = "https://spdx.org/rdf/3.0.1/terms/Core/SpdxDocument"
+	// IRISsvcDecisionType is the IRI of the SsvcDecisionType class.
+	IRISsvcDecisionType = "https://spdx.org/rdf/3.0.1/terms/Security/SsvcDecisionType"
+	// IRISsvcVulnAssessmentRelationship is the IRI of the SsvcVulnAssessmentRelationship class.
+	IRISsvcVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/SsvcVulnAssessmentRelationship"
+	// IRISupportType is the IRI of the SupportType class.
+	IRISupportType = "https://spdx.org/rdf/3.0.1/terms/Core/SupportType"
+	// IRITool is the IRI of the Tool class.
+	IRITool = "https://spdx.org/rdf/3.0.1/terms/Core/Tool"
+	// IRIVexAffectedVulnAssessmentRelationship is the IRI of the VexAffectedVulnAssessmentRelationship class.
+	IRIVexAffectedVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/VexAffectedVulnAssessmentRelationship"
+	// IRIVexFixedVulnAssessmentRelationship is the IRI of the VexFixedVulnAssessmentRelationship class.
+	IRIVexFixedVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/VexFixedVulnAssessmentRelationship"
+	// IRIVexJustificationType is the IRI of the VexJustificationType class.
+	IRIVexJustificationType = "https://spdx.org/rdf/3.0.1/terms/Security/VexJustificationType"
+	// IRIVexNotAffectedVulnAssessmentRelationship is the IRI of the VexNotAffectedVulnAssessmentRelationship class.
+	IRIVexNotAffectedVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/VexNotAffectedVulnAssessmentRelationship"
+	// IRIVexUnderInvestigationVulnAssessmentRelationship is the IRI of the VexUnderInvestigationVulnAssessmentRelationship class.
+	IRIVexUnderInvestigationVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/VexUnderInvestigationVulnAssessmentRelationship"
+	// IRIVexVulnAssessmentRelationship is the IRI of the VexVulnAssessmentRelationship class.
+	IRIVexVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/VexVulnAssessmentRelationship"
+	// IRIVulnAssessmentRelationship is the IRI of the VulnAssessmentRelationship class.
+	IRIVulnAssessmentRelationship = "https://spdx.org/rdf/3.0.1/terms/Security/VulnAssessmentRelationship"
+	// IRIVulnerability is the IRI of the Vulnerability class.
+	IRIVulnerability = "https://spdx.org/rdf/3.0.1/terms/Security/Vulnerability"
+	// IRIWithAdditionOperator is the IRI of the WithAdditionOperator class.
+	IRIWithAdditionOperator = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/WithAdditionOperator"
+)
+
+// IRIs of the SPDX properties.
+const (
+	// IRIActionStatement is the IRI of the actionStatement property.
+	IRIActionStatement = "https://spdx.org/rdf/3.0.1/terms/Security/actionStatement"
+	// IRIActionStatementTime is the IRI of the actionStatementTime property.
+	IRIActionStatementTime = "https://spdx.org/rdf/3.0.1/terms/Security/actionStatementTime"
+	// IRIAdditionText is the IRI of the additionText property.
+	IRIAdditionText = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/additionText"
+	// IRIAdditionalPurpose is the IRI of the additionalPurpose property.
+	IRIAdditionalPurpose = "https://spdx.org/rdf/3.0.1/terms/Software/additionalPurpose"
+	// IRIAlgorithm is the IRI of the algorithm property.
+	IRIAlgorithm = "https://spdx.org/rdf/3.0.1/terms/Core/algorithm"
+	// IRIAnnotationTypeProperty is the IRI of the annotationType property.
+	IRIAnnotationTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Core/annotationType"
+	// IRIAnonymizationMethodUsed is the IRI of the anonymizationMethodUsed property.
+	IRIAnonymizationMethodUsed = "https://spdx.org/rdf/3.0.1/terms/Dataset/anonymizationMethodUsed"
+	// IRIAssessedElement is the IRI of the assessedElement property.
+	IRIAssessedElement = "https://spdx.org/rdf/3.0.1/terms/Security/assessedElement"
+	// IRIAttributionText is the IRI of the attributionText property.
+	IRIAttributionText = "https://spdx.org/rdf/3.0.1/terms/Software/attributionText"
+	// IRIAutonomyType is the IRI of the autonomyType property.
+	IRIAutonomyType = "https://spdx.org/rdf/3.0.1/terms/AI/autonomyType"
+	// IRIBeginIntegerRange is the IRI of the beginIntegerRange property.
+	IRIBeginIntegerRange = "https://spdx.org/rdf/3.0.1/terms/Core/beginIntegerRange"
+	// IRIBuildEndTime is the IRI of the buildEndTime property.
+	IRIBuildEndTime = "https://spdx.org/rdf/3.0.1/terms/Build/buildEndTime"
+	// IRIBuildId is the IRI of the buildId property.
+	IRIBuildId = "https://spdx.org/rdf/3.0.1/terms/Build/buildId"
+	// IRIBuildStartTime is the IRI of the buildStartTime property.
+	IRIBuildStartTime = "https://spdx.org/rdf/3.0.1/terms/Build/buildStartTime"
+	// IRIBuildType is the IRI of the buildType property.
+	IRIBuildType = "https://spdx.org/rdf/3.0.1/terms/Build/buildType"
+	// IRIBuiltTime is the IRI of the builtTime property.
+	IRIBuiltTime = "https://spdx.org/rdf/3.0.1/terms/Core/builtTime"
+	// IRIByteRange is the IRI of the byteRange property.
+	IRIByteRange = "https://spdx.org/rdf/3.0.1/terms/Software/byteRange"
+	// IRICatalogType is the IRI of the catalogType property.
+	IRICatalogType = "https://spdx.org/rdf/3.0.1/terms/Security/catalogType"
+	// IRICdxPropName is the IRI of the cdxPropName property.
+	IRICdxPropName = "https://spdx.org/rdf/3.0.1/terms/Extension/cdxPropName"
+	// IRICdxPropValue is the IRI of the cdxPropValue property.
+	IRICdxPropValue = "https://spdx.org/rdf/3.0.1/terms/Extension/cdxPropValue"
+	// IRICdxProperty is the IRI of the cdxProperty property.
+	IRICdxProperty = "https://spdx.org/rdf/3.0.1/terms/Extension/cdxProperty"
+	// IRIComment is the IRI of the comment property.
+	IRIComment = "https://spdx.org/rdf/3.0.1/terms/Core/comment"
+	// IRICompleteness is the IRI of the completeness property.
+	IRICompleteness = "https://spdx.org/rdf/3.0.1/terms/Core/completeness"
+	// IRIConfidentialityLevel is the IRI of the confidentialityLevel property.
+	IRIConfidentialityLevel = "https://spdx.org/rdf/3.0.1/terms/Dataset/confidentialityLevel"
+	// IRIConfigSourceDigest is the IRI of the configSourceDigest property.
+	IRIConfigSourceDigest = "https://spdx.org/rdf/3.0.1/terms/Build/configSourceDigest"
+	// IRIConfigSourceEntrypoint is the IRI of the configSourceEntrypoint property.
+	IRIConfigSourceEntrypoint = "https://spdx.org/rdf/3.0.1/terms/Build/configSourceEntrypoint"
+	// IRIConfigSourceUri is the IRI of the configSourceUri property.
+	IRIConfigSourceUri = "https://spdx.org/rdf/3.0.1/terms/Build/configSourceUri"
+	// IRIContentIdentifierProperty is the IRI of the contentIdentifier property.
+	IRIContentIdentifierProperty = "https://spdx.org/rdf/3.0.1/terms/Software/contentIdentifier"
+	// IRIContentIdentifierTypeProperty is the IRI of the contentIdentifierType property.
+	IRIContentIdentifierTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Software/contentIdentifierType"
+	// IRIContentIdentifierValue is the IRI of the contentIdentifierValue property.
+	IRIContentIdentifierValue = "https://spdx.org/rdf/3.0.1/terms/Software/contentIdentifierValue"
+	// IRIContentType is the IRI of the contentType property.
+	IRIContentType = "https://spdx.org/rdf/3.0.1/terms/Core/contentType"
+	// IRIContext is the IRI of the context property.
+	IRIContext = "https://spdx.org/rdf/3.0.1/terms/Core/context"
+	// IRICopyrightText is the IRI of the copyrightText property.
+	IRICopyrightText = "https://spdx.org/rdf/3.0.1/terms/Software/copyrightText"
+	// IRICreated is the IRI of the created property.
+	IRICreated = "https://spdx.org/rdf/3.0.1/terms/Core/created"
+	// IRICreatedBy is the IRI of the createdBy property.
+	IRICreatedBy = "https://spdx.org/rdf/3.0.1/terms/Core/createdBy"
+	// IRICreatedUsing is the IRI of the createdUsing property.
+	IRICreatedUsing = "https://spdx.org/rdf/3.0.1/terms/Core/createdUsing"
+	// IRICreationInfoProperty is the IRI of the creationInfo property.
+	IRICreationInfoProperty = "https://spdx.org/rdf/3.0.1/terms/Core/creationInfo"
+	// IRICustomIdToUri is the IRI of the customIdToUri property.
+	IRICustomIdToUri = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/customIdToUri"
+	// IRIDataCollectionProcess is the IRI of the dataCollectionProcess property.
+	IRIDataCollectionProcess = "https://spdx.org/rdf/3.0.1/terms/Dataset/dataCollectionProcess"
+	// IRIDataLicense is the IRI of the dataLicense property.
+	IRIDataLicense = "https://spdx.org/rdf/3.0.1/terms/Core/dataLicense"
+	// IRIDataPreprocessing is the IRI of the dataPreprocessing property.
+	IRIDataPreprocessing = "https://spdx.org/rdf/3.0.1/terms/Dataset/dataPreprocessing"
+	// IRIDatasetAvailability is the IRI of the datasetAvailability property.
+	IRIDatasetAvailability = "https://spdx.org/rdf/3.0.1/terms/Dataset/datasetAvailability"
+	// IRIDatasetNoise is the IRI of the datasetNoise property.
+	IRIDatasetNoise = "https://spdx.org/rdf/3.0.1/terms/Dataset/datasetNoise"
+	// IRIDatasetSize is the IRI of the datasetSize property.
+	IRIDatasetSize = "https://spdx.org/rdf/3.0.1/terms/Dataset/datasetSize"
+	// IRIDatasetTypeProperty is the IRI of the datasetType property.
+	IRIDatasetTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Dataset/datasetType"
+	// IRIDatasetUpdateMechanism is the IRI of the datasetUpdateMechanism property.
+	IRIDatasetUpdateMechanism = "https://spdx.org/rdf/3.0.1/terms/Dataset/datasetUpdateMechanism"
+	// IRIDecisionType is the IRI of the decisionType property.
+	IRIDecisionType = "https://spdx.org/rdf/3.0.1/terms/Security/decisionType"
+	// IRIDefiningArtifact is the IRI of the definingArtifact property.
+	IRIDefiningArtifact = "https://spdx.org/rdf/3.0.1/terms/Core/definingArtifact"
+	// IRIDeprecatedVersion is the IRI of the deprecatedVersion property.
+	IRIDeprecatedVersion = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/deprecatedVersion"
+	// IRIDescription is the IRI of the description property.
+	IRIDescription = "https://spdx.org/rdf/3.0.1/terms/Core/description"
+	// IRIDomain is the IRI of the domain property.
+	IRIDomain = "https://spdx.org/rdf/3.0.1/terms/AI/domain"
+	// IRIDownloadLocation is the IRI of the downloadLocation property.
+	IRIDownloadLocation = "https://spdx.org/rdf/3.0.1/terms/Software/downloadLocation"
+	// IRIElementProperty is the IRI of the element property.
+	IRIElementProperty = "https://spdx.org/rdf/3.0.1/terms/Core/element"
+	// IRIEndIntegerRange is the IRI of the endIntegerRange property.
+	IRIEndIntegerRange = "https://spdx.org/rdf/3.0.1/terms/Core/endIntegerRange"
+	// IRIEndTime is the IRI of the endTime property.
+	IRIEndTime = "https://spdx.org/rdf/3.0.1/terms/Core/endTime"
+	// IRIEnergyConsumptionProperty is the IRI of the energyConsumption property.
+	IRIEnergyConsumptionProperty = "https://spdx.org/rdf/3.0.1/terms/AI/energyConsumption"
+	// IRIEnergyQuantity is the IRI of the energyQuantity property.
+	IRIEnergyQuantity = "https://spdx.org/rdf/3.0.1/terms/AI/energyQuantity"
+	// IRIEnergyUnit is the IRI of the energyUnit property.
+	IRIEnergyUnit = "https://spdx.org/rdf/3.0.1/terms/AI/energyUnit"
+	// IRIEnvironment is the IRI of the environment property.
+	IRIEnvironment = "https://spdx.org/rdf/3.0.1/terms/Build/environment"
+	// IRIExploited is the IRI of the exploited property.
+	IRIExploited = "https://spdx.org/rdf/3.0.1/terms/Security/exploited"
+	// IRIExtensionProperty is the IRI of the extension property.
+	IRIExtensionProperty = "https://spdx.org/rdf/3.0.1/terms/Core/extension"
+	// IRIExternalIdentifierProperty is the IRI of the externalIdentifier property.
+	IRIExternalIdentifierProperty = "https://spdx.org/rdf/3.0.1/terms/Core/externalIdentifier"
+	// IRIExternalIdentifierTypeProperty is the IRI of the externalIdentifierType property.
+	IRIExternalIdentifierTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Core/externalIdentifierType"
+	// IRIExternalRefProperty is the IRI of the externalRef property.
+	IRIExternalRefProperty = "https://spdx.org/rdf/3.0.1/terms/Core/externalRef"
+	// IRIExternalRefTypeProperty is the IRI of the externalRefType property.
+	IRIExternalRefTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Core/externalRefType"
+	// IRIExternalSpdxId is the IRI of the externalSpdxId property.
+	IRIExternalSpdxId = "https://spdx.org/rdf/3.0.1/terms/Core/externalSpdxId"
+	// IRIFileKind is the IRI of the fileKind property.
+	IRIFileKind = "https://spdx.org/rdf/3.0.1/terms/Software/fileKind"
+	// IRIFinetuningEnergyConsumption is the IRI of the finetuningEnergyConsumption property.
+	IRIFinetuningEnergyConsumption = "https://spdx.org/rdf/3.0.1/terms/AI/finetuningEnergyConsumption"
+	// IRIFrom is the IRI of the from property.
+	IRIFrom = "https://spdx.org/rdf/3.0.1/terms/Core/from"
+	// IRIHasSensitivePersonalInformation is the IRI of the hasSensitivePersonalInformation property.
+	IRIHasSensitivePersonalInformation = "https://spdx.org/rdf/3.0.1/terms/Dataset/hasSensitivePersonalInformation"
+	// IRIHashValue is the IRI of the hashValue property.
+	IRIHashValue = "https://spdx.org/rdf/3.0.1/terms/Core/hashValue"
+	// IRIHomePage is the IRI of the homePage property.
+	IRIHomePage = "https://spdx.org/rdf/3.0.1/terms/Software/homePage"
+	// IRIHyperparameter is the IRI of the hyperparameter property.
+	IRIHyperparameter = "https://spdx.org/rdf/3.0.1/terms/AI/hyperparameter"
+	// IRIIdentifier is the IRI of the identifier property.
+	IRIIdentifier = "https://spdx.org/rdf/3.0.1/terms/Core/identifier"
+	// IRIIdentifierLocator is the IRI of the identifierLocator property.
+	IRIIdentifierLocator = "https://spdx.org/rdf/3.0.1/terms/Core/identifierLocator"
+	// IRIImpactStatement is the IRI of the impactStatement property.
+	IRIImpactStatement = "https://spdx.org/rdf/3.0.1/terms/Security/impactStatement"
+	// IRIImpactStatementTime is the IRI of the impactStatementTime property.
+	IRIImpactStatementTime = "https://spdx.org/rdf/3.0.1/terms/Security/impactStatementTime"
+	// IRIImport is the IRI of the import property.
+	IRIImport = "https://spdx.org/rdf/3.0.1/terms/Core/import"
+	// IRIInferenceEnergyConsumption is the IRI of the inferenceEnergyConsumption property.
+	IRIInferenceEnergyConsumption = "https://spdx.org/rdf/3.0.1/terms/AI/inferenceEnergyConsumption"
+	// IRIInformationAboutApplication is the IRI of the informationAboutApplication property.
+	IRIInformationAboutApplication = "https://spdx.org/rdf/3.0.1/terms/AI/informationAboutApplication"
+	// IRIInformationAboutTraining is the IRI of the informationAboutTraining property.
+	IRIInformationAboutTraining = "https://spdx.org/rdf/3.0.1/terms/AI/informationAboutTraining"
+	// IRIIntendedUse is the IRI of the intendedUse property.
+	IRIIntendedUse = "https://spdx.org/rdf/3.0.1/terms/Dataset/intendedUse"
+	// IRIIsDeprecatedAdditionId is the IRI of the isDeprecatedAdditionId property.
+	IRIIsDeprecatedAdditionId = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/isDeprecatedAdditionId"
+	// IRIIsDeprecatedLicenseId is the IRI of the isDeprecatedLicenseId property.
+	IRIIsDeprecatedLicenseId = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/isDeprecatedLicenseId"
+	// IRIIsFsfLibre is the IRI of the isFsfLibre property.
+	IRIIsFsfLibre = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/isFsfLibre"
+	// IRIIsOsiApproved is the IRI of the isOsiApproved property.
+	IRIIsOsiApproved = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/isOsiApproved"
+	// IRIIssuingAuthority is the IRI of the issuingAuthority property.
+	IRIIssuingAuthority = "https://spdx.org/rdf/3.0.1/terms/Core/issuingAuthority"
+	// IRIJustificationType is the IRI of the justificationType property.
+	IRIJustificationType = "https://spdx.org/rdf/3.0.1/terms/Security/justificationType"
+	// IRIKey is the IRI of the key property.
+	IRIKey = "https://spdx.org/rdf/3.0.1/terms/Core/key"
+	// IRIKnownBias is the IRI of the knownBias property.
+	IRIKnownBias = "https://spdx.org/rdf/3.0.1/terms/Dataset/knownBias"
+	// IRILicenseExpressionProperty is the IRI of the licenseExpression property.
+	IRILicenseExpressionProperty = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/licenseExpression"
+	// IRILicenseListVersion is the IRI of the licenseListVersion property.
+	IRILicenseListVersion = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/licenseListVersion"
+	// IRILicenseText is the IRI of the licenseText property.
+	IRILicenseText = "https://spdx.org/rdf/3.0.1/terms/SimpleLicensing/licenseText"
+	// IRILicenseXml is the IRI of the licenseXml property.
+	IRILicenseXml = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/licenseXml"
+	// IRILimitation is the IRI of the limitation property.
+	IRILimitation = "https://spdx.org/rdf/3.0.1/terms/AI/limitation"
+	// IRILineRange is the IRI of the lineRange property.
+	IRILineRange = "https://spdx.org/rdf/3.0.1/terms/Software/lineRange"
+	// IRIListVersionAdded is the IRI of the listVersionAdded property.
+	IRIListVersionAdded = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/listVersionAdded"
+	// IRILocationHint is the IRI of the locationHint property.
+	IRILocationHint = "https://spdx.org/rdf/3.0.1/terms/Core/locationHint"
+	// IRILocator is the IRI of the locator property.
+	IRILocator = "https://spdx.org/rdf/3.0.1/terms/Core/locator"
+	// IRIMember is the IRI of the member property.
+	IRIMember = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/member"
+	// IRIMetric is the IRI of the metric property.
+	IRIMetric = "https://spdx.org/rdf/3.0.1/terms/AI/metric"
+	// IRIMetricDecisionThreshold is the IRI of the metricDecisionThreshold property.
+	IRIMetricDecisionThreshold = "https://spdx.org/rdf/3.0.1/terms/AI/metricDecisionThreshold"
+	// IRIModelDataPreprocessing is the IRI of the modelDataPreprocessing property.
+	IRIModelDataPreprocessing = "https://spdx.org/rdf/3.0.1/terms/AI/modelDataPreprocessing"
+	// IRIModelExplainability is the IRI of the modelExplainability property.
+	IRIModelExplainability = "https://spdx.org/rdf/3.0.1/terms/AI/modelExplainability"
+	// IRIModifiedTime is the IRI of the modifiedTime property.
+	IRIModifiedTime = "https://spdx.org/rdf/3.0.1/terms/Security/modifiedTime"
+	// IRIName is the IRI of the name property.
+	IRIName = "https://spdx.org/rdf/3.0.1/terms/Core/name"
+	// IRINamespace is the IRI of the namespace property.
+	IRINamespace = "https://spdx.org/rdf/3.0.1/terms/Core/namespace"
+	// IRINamespaceMapProperty is the IRI of the namespaceMap property.
+	IRINamespaceMapProperty = "https://spdx.org/rdf/3.0.1/terms/Core/namespaceMap"
+	// IRIObsoletedBy is the IRI of the obsoletedBy property.
+	IRIObsoletedBy = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/obsoletedBy"
+	// IRIOriginatedBy is the IRI of the originatedBy property.
+	IRIOriginatedBy = "https://spdx.org/rdf/3.0.1/terms/Core/originatedBy"
+	// IRIPackageUrl is the IRI of the packageUrl property.
+	IRIPackageUrl = "https://spdx.org/rdf/3.0.1/terms/Software/packageUrl"
+	// IRIPackageVerificationCodeExcludedFile is the IRI of the packageVerificationCodeExcludedFile property.
+	IRIPackageVerificationCodeExcludedFile = "https://spdx.org/rdf/3.0.1/terms/Core/packageVerificationCodeExcludedFile"
+	// IRIPackageVersion is the IRI of the packageVersion property.
+	IRIPackageVersion = "https://spdx.org/rdf/3.0.1/terms/Software/packageVersion"
+	// IRIParameter is the IRI of the parameter property.
+	IRIParameter = "https://spdx.org/rdf/3.0.1/terms/Build/parameter"
+	// IRIPercentile is the IRI of the percentile property.
+	IRIPercentile = "https://spdx.org/rdf/3.0.1/terms/Security/percentile"
+	// IRIPrefix is the IRI of the prefix property.
+	IRIPrefix = "https://spdx.org/rdf/3.0.1/terms/Core/prefix"
+	// IRIPrimaryPurpose is the IRI of the primaryPurpose property.
+	IRIPrimaryPurpose = "https://spdx.org/rdf/3.0.1/terms/Software/primaryPurpose"
+	// IRIProbability is the IRI of the probability property.
+	IRIProbability = "https://spdx.org/rdf/3.0.1/terms/Security/probability"
+	// IRIProfileConformance is the IRI of the profileConformance property.
+	IRIProfileConformance = "https://spdx.org/rdf/3.0.1/terms/Core/profileConformance"
+	// IRIPublishedTime is the IRI of the publishedTime property.
+	IRIPublishedTime = "https://spdx.org/rdf/3.0.1/terms/Security/publishedTime"
+	// IRIRelationshipTypeProperty is the IRI of the relationshipType property.
+	IRIRelationshipTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Core/relationshipType"
+	// IRIReleaseTime is the IRI of the releaseTime property.
+	IRIReleaseTime = "https://spdx.org/rdf/3.0.1/terms/Core/releaseTime"
+	// IRIRootElement is the IRI of the rootElement property.
+	IRIRootElement = "https://spdx.org/rdf/3.0.1/terms/Core/rootElement"
+	// IRISafetyRiskAssessment is the IRI of the safetyRiskAssessment property.
+	IRISafetyRiskAssessment = "https://spdx.org/rdf/3.0.1/terms/AI/safetyRiskAssessment"
+	// IRISbomTypeProperty is the IRI of the sbomType property.
+	IRISbomTypeProperty = "https://spdx.org/rdf/3.0.1/terms/Software/sbomType"
+	// IRIScope is the IRI of the scope property.
+	IRIScope = "https://spdx.org/rdf/3.0.1/terms/Core/scope"
+	// IRIScore is the IRI of the score property.
+	IRIScore = "https://spdx.org/rdf/3.0.1/terms/Security/score"
+	// IRISecurityLocator is the IRI of the locator property.
+	IRISecurityLocator = "https://spdx.org/rdf/3.0.1/terms/Security/locator"
+	// IRISeeAlso is the IRI of the seeAlso property.
+	IRISeeAlso = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/seeAlso"
+	// IRISensor is the IRI of the sensor property.
+	IRISensor = "https://spdx.org/rdf/3.0.1/terms/Dataset/sensor"
+	// IRISeverity is the IRI of the severity property.
+	IRISeverity = "https://spdx.org/rdf/3.0.1/terms/Security/severity"
+	// IRISnippetFromFile is the IRI of the snippetFromFile property.
+	IRISnippetFromFile = "https://spdx.org/rdf/3.0.1/terms/Software/snippetFromFile"
+	// IRISourceInfo is the IRI of the sourceInfo property.
+	IRISourceInfo = "https://spdx.org/rdf/3.0.1/terms/Software/sourceInfo"
+	// IRISpecVersion is the IRI of the specVersion property.
+	IRISpecVersion = "https://spdx.org/rdf/3.0.1/terms/Core/specVersion"
+	// IRIStandardAdditionTemplate is the IRI of the standardAdditionTemplate property.
+	IRIStandardAdditionTemplate = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/standardAdditionTemplate"
+	// IRIStandardCompliance is the IRI of the standardCompliance property.
+	IRIStandardCompliance = "https://spdx.org/rdf/3.0.1/terms/AI/standardCompliance"
+	// IRIStandardLicenseHeader is the IRI of the standardLicenseHeader property.
+	IRIStandardLicenseHeader = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/standardLicenseHeader"
+	// IRIStandardLicenseTemplate is the IRI of the standardLicenseTemplate property.
+	IRIStandardLicenseTemplate = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/standardLicenseTemplate"
+	// IRIStandardName is the IRI of the standardName property.
+	IRIStandardName = "https://spdx.org/rdf/3.0.1/terms/Core/standardName"
+	// IRIStartTime is the IRI of the startTime property.
+	IRIStartTime = "https://spdx.org/rdf/3.0.1/terms/Core/startTime"
+	// IRIStatement is the IRI of the statement property.
+	IRIStatement = "https://spdx.org/rdf/3.0.1/terms/Core/statement"
+	// IRIStatusNotes is the IRI of the statusNotes property.
+	IRIStatusNotes = "https://spdx.org/rdf/3.0.1/terms/Security/statusNotes"
+	// IRISubject is the IRI of the subject property.
+	IRISubject = "https://spdx.org/rdf/3.0.1/terms/Core/subject"
+	// IRISubjectAddition is the IRI of the subjectAddition property.
+	IRISubjectAddition = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/subjectAddition"
+	// IRISubjectExtendableLicense is the IRI of the subjectExtendableLicense property.
+	IRISubjectExtendableLicense = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/subjectExtendableLicense"
+	// IRISubjectLicense is the IRI of the subjectLicense property.
+	IRISubjectLicense = "https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/subjectLicense"
+	// IRISummary is the IRI of the summary property.
+	IRISummary = "https://spdx.org/rdf/3.0.1/terms/Core/summary"
+	// IRISuppliedBy is the IRI of the suppliedBy property.
+	IRISuppliedBy = "https://spdx.org/rdf/3.0.1/terms/Core/suppliedBy"
+	// IRISupportLevel is the IRI of the supportLevel property.
+	IRISupportLevel = "https://spdx.org/rdf/3.0.1/terms/Core/supportLevel"
+	// IRITo is the IRI of the to property.
+	IRITo = "https://spdx.org/rdf/3.0.1/terms/Core/to"
+	// IRITrainingEnergyConsumption is the IRI of the trainingEnergyConsumption property.
+	IRITrainingEnergyConsumption = "https://spdx.org/rdf/3.0.1/terms/AI/trainingEnergyConsumption"
+	// IRITypeOfModel is the IRI of the typeOfModel property.
+	IRITypeOfModel = "https://spdx.org/rdf/3.0.1/terms/AI/typeOfModel"
+	// IRIUseSensitivePersonalInformation is the IRI of the useSensitivePersonalInformation property.
+	IRIUseSensitivePersonalInformation = "https://spdx.org/rdf/3.0.1/terms/AI/useSensitivePersonalInformation"
+	// IRIValidUntilTime is the IRI of the validUntilTime property.
+	IRIValidUntilTime = "https://spdx.org/rdf/3.0.1/terms/Core/validUntilTime"
+	// IRIValue is the IRI of the value property.
+	IRIValue = "https://spdx.org/rdf/3.0.1/terms/Core/value"
+	// IRIVectorString is the IRI of the vectorString property.
+	IRIVectorString = "https://spdx.org/rdf/3.0.1/terms/Security/vectorString"
+	// IRIVerifiedUsing is the IRI of the verifiedUsing property.
+	IRIVerifiedUsing = "https://spdx.org/rdf/3.0.1/terms/Core/verifiedUsing"
+	// IRIVexVersion is the IRI of the vexVersion property.
+	IRIVexVersion = "https://spdx.org/rdf/3.0.1/terms/Security/vexVersion"
+	// IRIWithdrawnTime is the IRI of the withdrawnTime property.
+	IRIWithdrawnTime = "https://spdx.org/rdf/3.0.1/terms/Security/withdrawnTime"
+)
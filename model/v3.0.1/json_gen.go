@@ -0,0 +1,2452 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+// MarshalJSON encodes the AIPackage as a compact SPDX JSON-LD object.
+func (o AIPackage) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ai_AIPackage")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *AIPackage) marshalFields(w *jsonObject) {
+	o.Package.marshalFields(w)
+	w.put("ai_autonomyType", o.AutonomyType)
+	w.put("ai_domain", o.Domain)
+	w.put("ai_energyConsumption", o.EnergyConsumption)
+	w.put("ai_hyperparameter", o.Hyperparameter)
+	w.put("ai_informationAboutApplication", o.InformationAboutApplication)
+	w.put("ai_informationAboutTraining", o.InformationAboutTraining)
+	w.put("ai_limitation", o.Limitation)
+	w.put("ai_metric", o.Metric)
+	w.put("ai_metricDecisionThreshold", o.MetricDecisionThreshold)
+	w.put("ai_modelDataPreprocessing", o.ModelDataPreprocessing)
+	w.put("ai_modelExplainability", o.ModelExplainability)
+	w.put("ai_safetyRiskAssessment", o.SafetyRiskAssessment)
+	w.put("ai_standardCompliance", o.StandardCompliance)
+	w.put("ai_typeOfModel", o.TypeOfModel)
+	w.put("ai_useSensitivePersonalInformation", o.UseSensitivePersonalInformation)
+}
+
+// UnmarshalJSON decodes the AIPackage from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *AIPackage) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = AIPackage{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *AIPackage) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Package.unmarshalFields(n),
+		n.get("ai_autonomyType", &o.AutonomyType),
+		n.get("ai_domain", &o.Domain),
+		n.get("ai_energyConsumption", &o.EnergyConsumption),
+		n.get("ai_hyperparameter", &o.Hyperparameter),
+		n.get("ai_informationAboutApplication", &o.InformationAboutApplication),
+		n.get("ai_informationAboutTraining", &o.InformationAboutTraining),
+		n.get("ai_limitation", &o.Limitation),
+		n.get("ai_metric", &o.Metric),
+		n.get("ai_metricDecisionThreshold", &o.MetricDecisionThreshold),
+		n.get("ai_modelDataPreprocessing", &o.ModelDataPreprocessing),
+		n.get("ai_modelExplainability", &o.ModelExplainability),
+		n.get("ai_safetyRiskAssessment", &o.SafetyRiskAssessment),
+		n.get("ai_standardCompliance", &o.StandardCompliance),
+		n.get("ai_typeOfModel", &o.TypeOfModel),
+		n.get("ai_useSensitivePersonalInformation", &o.UseSensitivePersonalInformation),
+	)
+}
+
+// MarshalJSON encodes the EnergyConsumption as a compact SPDX JSON-LD object.
+func (o EnergyConsumption) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ai_EnergyConsumption")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *EnergyConsumption) marshalFields(w *jsonObject) {
+	w.put("ai_finetuningEnergyConsumption", o.FinetuningEnergyConsumption)
+	w.put("ai_inferenceEnergyConsumption", o.InferenceEnergyConsumption)
+	w.put("ai_trainingEnergyConsumption", o.TrainingEnergyConsumption)
+}
+
+// UnmarshalJSON decodes the EnergyConsumption from a compact SPDX JSON-LD object.
+func (o *EnergyConsumption) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *EnergyConsumption) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("ai_finetuningEnergyConsumption", &o.FinetuningEnergyConsumption),
+		n.get("ai_inferenceEnergyConsumption", &o.InferenceEnergyConsumption),
+		n.get("ai_trainingEnergyConsumption", &o.TrainingEnergyConsumption),
+	)
+}
+
+// MarshalJSON encodes the EnergyConsumptionDescription as a compact SPDX JSON-LD object.
+func (o EnergyConsumptionDescription) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ai_EnergyConsumptionDescription")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *EnergyConsumptionDescription) marshalFields(w *jsonObject) {
+	w.put("ai_energyQuantity", o.EnergyQuantity)
+	w.put("ai_energyUnit", o.EnergyUnit)
+}
+
+// UnmarshalJSON decodes the EnergyConsumptionDescription from a compact SPDX JSON-LD object.
+func (o *EnergyConsumptionDescription) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *EnergyConsumptionDescription) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("ai_energyQuantity", &o.EnergyQuantity),
+		n.get("ai_energyUnit", &o.EnergyUnit),
+	)
+}
+
+// MarshalJSON encodes the Build as a compact SPDX JSON-LD object.
+func (o Build) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("build_Build")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Build) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.put("build_buildType", o.BuildType)
+	w.put("build_buildId", o.BuildId)
+	w.put("build_configSourceEntrypoint", o.ConfigSourceEntrypoint)
+	w.put("build_configSourceUri", o.ConfigSourceUri)
+	w.put("build_configSourceDigest", o.ConfigSourceDigest)
+	w.put("build_parameter", o.Parameter)
+	w.put("build_buildStartTime", o.BuildStartTime)
+	w.put("build_buildEndTime", o.BuildEndTime)
+	w.put("build_environment", o.Environment)
+}
+
+// UnmarshalJSON decodes the Build from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Build) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Build{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Build) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("build_buildType", &o.BuildType),
+		n.get("build_buildId", &o.BuildId),
+		n.get("build_configSourceEntrypoint", &o.ConfigSourceEntrypoint),
+		n.get("build_configSourceUri", &o.ConfigSourceUri),
+		n.get("build_configSourceDigest", &o.ConfigSourceDigest),
+		n.get("build_parameter", &o.Parameter),
+		n.get("build_buildStartTime", &o.BuildStartTime),
+		n.get("build_buildEndTime", &o.BuildEndTime),
+		n.get("build_environment", &o.Environment),
+	)
+}
+
+// MarshalJSON encodes the Agent as a compact SPDX JSON-LD object.
+func (o Agent) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Agent")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Agent) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the Agent from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Agent) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Agent{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Agent) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the Annotation as a compact SPDX JSON-LD object.
+func (o Annotation) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Annotation")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Annotation) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.put("annotationType", o.AnnotationType)
+	w.put("contentType", o.ContentType)
+	w.put("statement", o.Statement)
+	w.ref("subject", o.Subject.SpdxID)
+}
+
+// UnmarshalJSON decodes the Annotation from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Annotation) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Annotation{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Annotation) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("annotationType", &o.AnnotationType),
+		n.get("contentType", &o.ContentType),
+		n.get("statement", &o.Statement),
+		n.get("subject", &o.Subject),
+	)
+}
+
+// MarshalJSON encodes the Artifact as a compact SPDX JSON-LD object.
+func (o Artifact) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Artifact")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Artifact) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.refs("originatedBy", refIDs(o.OriginatedBy))
+	if o.SuppliedBy != nil {
+		w.ref("suppliedBy", o.SuppliedBy.SpdxID)
+	}
+	w.put("builtTime", o.BuiltTime)
+	w.put("releaseTime", o.ReleaseTime)
+	w.put("validUntilTime", o.ValidUntilTime)
+	w.put("standardName", o.StandardName)
+	w.put("supportLevel", o.SupportLevel)
+}
+
+// UnmarshalJSON decodes the Artifact from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Artifact) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Artifact{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Artifact) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("originatedBy", &o.OriginatedBy),
+		n.get("suppliedBy", &o.SuppliedBy),
+		n.get("builtTime", &o.BuiltTime),
+		n.get("releaseTime", &o.ReleaseTime),
+		n.get("validUntilTime", &o.ValidUntilTime),
+		n.get("standardName", &o.StandardName),
+		n.get("supportLevel", &o.SupportLevel),
+	)
+}
+
+// MarshalJSON encodes the Bom as a compact SPDX JSON-LD object.
+func (o Bom) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Bom")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Bom) marshalFields(w *jsonObject) {
+	o.Bundle.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the Bom from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Bom) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Bom{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Bom) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Bundle.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the Bundle as a compact SPDX JSON-LD object.
+func (o Bundle) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Bundle")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Bundle) marshalFields(w *jsonObject) {
+	o.ElementCollection.marshalFields(w)
+	w.put("context", o.Context)
+}
+
+// UnmarshalJSON decodes the Bundle from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Bundle) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Bundle{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Bundle) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.ElementCollection.unmarshalFields(n),
+		n.get("context", &o.Context),
+	)
+}
+
+// MarshalJSON encodes the CreationInfo as a compact SPDX JSON-LD object.
+func (o CreationInfo) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("CreationInfo")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CreationInfo) marshalFields(w *jsonObject) {
+	w.put("specVersion", o.SpecVersion)
+	w.put("comment", o.Comment)
+	w.put("created", o.Created)
+	w.refs("createdBy", refIDs(o.CreatedBy))
+	w.refs("createdUsing", refIDs(o.CreatedUsing))
+}
+
+// UnmarshalJSON decodes the CreationInfo from a compact SPDX JSON-LD object.
+func (o *CreationInfo) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CreationInfo) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("specVersion", &o.SpecVersion),
+		n.get("comment", &o.Comment),
+		n.get("created", &o.Created),
+		n.get("createdBy", &o.CreatedBy),
+		n.get("createdUsing", &o.CreatedUsing),
+	)
+}
+
+// MarshalJSON encodes the DictionaryEntry as a compact SPDX JSON-LD object.
+func (o DictionaryEntry) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("DictionaryEntry")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *DictionaryEntry) marshalFields(w *jsonObject) {
+	w.put("key", o.Key)
+	w.put("value", o.Value)
+}
+
+// UnmarshalJSON decodes the DictionaryEntry from a compact SPDX JSON-LD object.
+func (o *DictionaryEntry) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *DictionaryEntry) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("key", &o.Key),
+		n.get("value", &o.Value),
+	)
+}
+
+// MarshalJSON encodes the Element as a compact SPDX JSON-LD object.
+func (o Element) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Element")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Element) marshalFields(w *jsonObject) {
+	w.put("spdxId", o.SpdxID)
+	w.put("name", o.Name)
+	w.put("summary", o.Summary)
+	w.put("description", o.Description)
+	w.put("comment", o.Comment)
+	w.put("creationInfo", o.CreationInfo)
+	w.put("verifiedUsing", o.VerifiedUsing)
+	w.put("externalRef", o.ExternalRef)
+	w.put("externalIdentifier", o.ExternalIdentifier)
+	w.put("extension", o.Extension)
+}
+
+// UnmarshalJSON decodes the Element from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Element) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Element{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Element) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.id(&o.SpdxID),
+		n.get("name", &o.Name),
+		n.get("summary", &o.Summary),
+		n.get("description", &o.Description),
+		n.get("comment", &o.Comment),
+		n.get("creationInfo", &o.CreationInfo),
+		getTyped(n, "verifiedUsing", "IntegrityMethod", &o.VerifiedUsing),
+		n.get("externalRef", &o.ExternalRef),
+		n.get("externalIdentifier", &o.ExternalIdentifier),
+		getTyped(n, "extension", "extension_Extension", &o.Extension),
+	)
+}
+
+// MarshalJSON encodes the ElementCollection as a compact SPDX JSON-LD object.
+func (o ElementCollection) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ElementCollection")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ElementCollection) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.refs("element", refIDs(o.Elements))
+	w.refs("rootElement", refIDs(o.RootElement))
+	w.put("profileConformance", o.ProfileConformance)
+}
+
+// UnmarshalJSON decodes the ElementCollection from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *ElementCollection) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = ElementCollection{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ElementCollection) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("element", &o.Elements),
+		n.get("rootElement", &o.RootElement),
+		n.get("profileConformance", &o.ProfileConformance),
+	)
+}
+
+// MarshalJSON encodes the ExternalIdentifier as a compact SPDX JSON-LD object.
+func (o ExternalIdentifier) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ExternalIdentifier")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ExternalIdentifier) marshalFields(w *jsonObject) {
+	w.put("externalIdentifierType", o.ExternalIdentifierType)
+	w.put("identifier", o.Identifier)
+	w.put("comment", o.Comment)
+	w.put("identifierLocator", o.IdentifierLocator)
+	w.put("issuingAuthority", o.IssuingAuthority)
+}
+
+// UnmarshalJSON decodes the ExternalIdentifier from a compact SPDX JSON-LD object.
+func (o *ExternalIdentifier) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ExternalIdentifier) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("externalIdentifierType", &o.ExternalIdentifierType),
+		n.get("identifier", &o.Identifier),
+		n.get("comment", &o.Comment),
+		n.get("identifierLocator", &o.IdentifierLocator),
+		n.get("issuingAuthority", &o.IssuingAuthority),
+	)
+}
+
+// MarshalJSON encodes the ExternalMap as a compact SPDX JSON-LD object.
+func (o ExternalMap) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ExternalMap")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ExternalMap) marshalFields(w *jsonObject) {
+	w.put("externalSpdxId", o.ExternalSpdxId)
+	w.put("verifiedUsing", o.VerifiedUsing)
+	w.put("locationHint", o.LocationHint)
+	if o.DefiningArtifact != nil {
+		w.ref("definingArtifact", o.DefiningArtifact.SpdxID)
+	}
+}
+
+// UnmarshalJSON decodes the ExternalMap from a compact SPDX JSON-LD object.
+func (o *ExternalMap) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ExternalMap) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("externalSpdxId", &o.ExternalSpdxId),
+		getTyped(n, "verifiedUsing", "IntegrityMethod", &o.VerifiedUsing),
+		n.get("locationHint", &o.LocationHint),
+		n.get("definingArtifact", &o.DefiningArtifact),
+	)
+}
+
+// MarshalJSON encodes the ExternalRef as a compact SPDX JSON-LD object.
+func (o ExternalRef) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("ExternalRef")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ExternalRef) marshalFields(w *jsonObject) {
+	w.put("externalRefType", o.ExternalRefType)
+	w.put("locator", o.Locator)
+	w.put("contentType", o.ContentType)
+	w.put("comment", o.Comment)
+}
+
+// UnmarshalJSON decodes the ExternalRef from a compact SPDX JSON-LD object.
+func (o *ExternalRef) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ExternalRef) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("externalRefType", &o.ExternalRefType),
+		n.get("locator", &o.Locator),
+		n.get("contentType", &o.ContentType),
+		n.get("comment", &o.Comment),
+	)
+}
+
+// MarshalJSON encodes the Hash as a compact SPDX JSON-LD object.
+func (o Hash) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Hash")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Hash) marshalFields(w *jsonObject) {
+	o.IntegrityMethod.marshalFields(w)
+	w.put("algorithm", o.Algorithm)
+	w.put("hashValue", o.HashValue)
+}
+
+// UnmarshalJSON decodes the Hash from a compact SPDX JSON-LD object.
+func (o *Hash) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Hash) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.IntegrityMethod.unmarshalFields(n),
+		n.get("algorithm", &o.Algorithm),
+		n.get("hashValue", &o.HashValue),
+	)
+}
+
+// MarshalJSON encodes the IndividualElement as a compact SPDX JSON-LD object.
+func (o IndividualElement) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("IndividualElement")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *IndividualElement) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the IndividualElement from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *IndividualElement) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = IndividualElement{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *IndividualElement) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the IntegrityMethod as a compact SPDX JSON-LD object.
+func (o IntegrityMethod) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("IntegrityMethod")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *IntegrityMethod) marshalFields(w *jsonObject) {
+	w.put("comment", o.Comment)
+}
+
+// UnmarshalJSON decodes the IntegrityMethod from a compact SPDX JSON-LD object.
+func (o *IntegrityMethod) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *IntegrityMethod) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("comment", &o.Comment),
+	)
+}
+
+// MarshalJSON encodes the LifecycleScopedRelationship as a compact SPDX JSON-LD object.
+func (o LifecycleScopedRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("LifecycleScopedRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *LifecycleScopedRelationship) marshalFields(w *jsonObject) {
+	o.Relationship.marshalFields(w)
+	w.put("scope", o.Scope)
+}
+
+// UnmarshalJSON decodes the LifecycleScopedRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *LifecycleScopedRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = LifecycleScopedRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *LifecycleScopedRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Relationship.unmarshalFields(n),
+		n.get("scope", &o.Scope),
+	)
+}
+
+// MarshalJSON encodes the NamespaceMap as a compact SPDX JSON-LD object.
+func (o NamespaceMap) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("NamespaceMap")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *NamespaceMap) marshalFields(w *jsonObject) {
+	w.put("prefix", o.Prefix)
+	w.put("namespace", o.Namespace)
+}
+
+// UnmarshalJSON decodes the NamespaceMap from a compact SPDX JSON-LD object.
+func (o *NamespaceMap) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *NamespaceMap) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("prefix", &o.Prefix),
+		n.get("namespace", &o.Namespace),
+	)
+}
+
+// MarshalJSON encodes the Organization as a compact SPDX JSON-LD object.
+func (o Organization) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Organization")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Organization) marshalFields(w *jsonObject) {
+	o.Agent.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the Organization from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Organization{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Organization) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Agent.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the PackageVerificationCode as a compact SPDX JSON-LD object.
+func (o PackageVerificationCode) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("PackageVerificationCode")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *PackageVerificationCode) marshalFields(w *jsonObject) {
+	o.IntegrityMethod.marshalFields(w)
+	w.put("algorithm", o.Algorithm)
+	w.put("hashValue", o.HashValue)
+	w.put("packageVerificationCodeExcludedFile", o.PackageVerificationCodeExcludedFile)
+}
+
+// UnmarshalJSON decodes the PackageVerificationCode from a compact SPDX JSON-LD object.
+func (o *PackageVerificationCode) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *PackageVerificationCode) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.IntegrityMethod.unmarshalFields(n),
+		n.get("algorithm", &o.Algorithm),
+		n.get("hashValue", &o.HashValue),
+		n.get("packageVerificationCodeExcludedFile", &o.PackageVerificationCodeExcludedFile),
+	)
+}
+
+// MarshalJSON encodes the Person as a compact SPDX JSON-LD object.
+func (o Person) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Person")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Person) marshalFields(w *jsonObject) {
+	o.Agent.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the Person from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Person) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Person{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Person) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Agent.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the PositiveIntegerRange as a compact SPDX JSON-LD object.
+func (o PositiveIntegerRange) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("PositiveIntegerRange")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *PositiveIntegerRange) marshalFields(w *jsonObject) {
+	w.put("beginIntegerRange", o.BeginIntegerRange)
+	w.put("endIntegerRange", o.EndIntegerRange)
+}
+
+// UnmarshalJSON decodes the PositiveIntegerRange from a compact SPDX JSON-LD object.
+func (o *PositiveIntegerRange) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *PositiveIntegerRange) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("beginIntegerRange", &o.BeginIntegerRange),
+		n.get("endIntegerRange", &o.EndIntegerRange),
+	)
+}
+
+// MarshalJSON encodes the Relationship as a compact SPDX JSON-LD object.
+func (o Relationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Relationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Relationship) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.ref("from", o.From.SpdxID)
+	w.refs("to", refIDs(o.To))
+	w.put("relationshipType", o.RelationshipType)
+	w.put("completeness", o.Completeness)
+	w.put("startTime", o.StartTime)
+	w.put("endTime", o.EndTime)
+}
+
+// UnmarshalJSON decodes the Relationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Relationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Relationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Relationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("from", &o.From),
+		n.get("to", &o.To),
+		n.get("relationshipType", &o.RelationshipType),
+		n.get("completeness", &o.Completeness),
+		n.get("startTime", &o.StartTime),
+		n.get("endTime", &o.EndTime),
+	)
+}
+
+// MarshalJSON encodes the SoftwareAgent as a compact SPDX JSON-LD object.
+func (o SoftwareAgent) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("SoftwareAgent")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *SoftwareAgent) marshalFields(w *jsonObject) {
+	o.Agent.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the SoftwareAgent from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *SoftwareAgent) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = SoftwareAgent{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *SoftwareAgent) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Agent.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the SpdxDocument as a compact SPDX JSON-LD object.
+func (o SpdxDocument) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("SpdxDocument")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *SpdxDocument) marshalFields(w *jsonObject) {
+	o.ElementCollection.marshalFields(w)
+	w.put("import", o.Import)
+	w.put("namespaceMap", o.NamespaceMap)
+	if o.DataLicense != nil {
+		w.ref("dataLicense", o.DataLicense.SpdxID)
+	}
+}
+
+// UnmarshalJSON decodes the SpdxDocument from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *SpdxDocument) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = SpdxDocument{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *SpdxDocument) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.ElementCollection.unmarshalFields(n),
+		n.get("import", &o.Import),
+		n.get("namespaceMap", &o.NamespaceMap),
+		n.get("dataLicense", &o.DataLicense),
+	)
+}
+
+// MarshalJSON encodes the Tool as a compact SPDX JSON-LD object.
+func (o Tool) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("Tool")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Tool) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the Tool from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Tool) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Tool{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Tool) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the DatasetPackage as a compact SPDX JSON-LD object.
+func (o DatasetPackage) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("dataset_DatasetPackage")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *DatasetPackage) marshalFields(w *jsonObject) {
+	o.Package.marshalFields(w)
+	w.put("dataset_anonymizationMethodUsed", o.AnonymizationMethodUsed)
+	w.put("dataset_confidentialityLevel", o.ConfidentialityLevel)
+	w.put("dataset_dataCollectionProcess", o.DataCollectionProcess)
+	w.put("dataset_dataPreprocessing", o.DataPreprocessing)
+	w.put("dataset_datasetAvailability", o.DatasetAvailability)
+	w.put("dataset_datasetNoise", o.DatasetNoise)
+	w.put("dataset_datasetSize", o.DatasetSize)
+	w.put("dataset_datasetType", o.DatasetType)
+	w.put("dataset_datasetUpdateMechanism", o.DatasetUpdateMechanism)
+	w.put("dataset_hasSensitivePersonalInformation", o.HasSensitivePersonalInformation)
+	w.put("dataset_intendedUse", o.IntendedUse)
+	w.put("dataset_knownBias", o.KnownBias)
+	w.put("dataset_sensor", o.Sensor)
+}
+
+// UnmarshalJSON decodes the DatasetPackage from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *DatasetPackage) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = DatasetPackage{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *DatasetPackage) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Package.unmarshalFields(n),
+		n.get("dataset_anonymizationMethodUsed", &o.AnonymizationMethodUsed),
+		n.get("dataset_confidentialityLevel", &o.ConfidentialityLevel),
+		n.get("dataset_dataCollectionProcess", &o.DataCollectionProcess),
+		n.get("dataset_dataPreprocessing", &o.DataPreprocessing),
+		n.get("dataset_datasetAvailability", &o.DatasetAvailability),
+		n.get("dataset_datasetNoise", &o.DatasetNoise),
+		n.get("dataset_datasetSize", &o.DatasetSize),
+		n.get("dataset_datasetType", &o.DatasetType),
+		n.get("dataset_datasetUpdateMechanism", &o.DatasetUpdateMechanism),
+		n.get("dataset_hasSensitivePersonalInformation", &o.HasSensitivePersonalInformation),
+		n.get("dataset_intendedUse", &o.IntendedUse),
+		n.get("dataset_knownBias", &o.KnownBias),
+		n.get("dataset_sensor", &o.Sensor),
+	)
+}
+
+// MarshalJSON encodes the ConjunctiveLicenseSet as a compact SPDX JSON-LD object.
+func (o ConjunctiveLicenseSet) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_ConjunctiveLicenseSet")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ConjunctiveLicenseSet) marshalFields(w *jsonObject) {
+	o.AnyLicenseInfo.marshalFields(w)
+	w.refs("expandedlicensing_member", refIDs(o.Member))
+}
+
+// UnmarshalJSON decodes the ConjunctiveLicenseSet from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *ConjunctiveLicenseSet) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = ConjunctiveLicenseSet{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ConjunctiveLicenseSet) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.AnyLicenseInfo.unmarshalFields(n),
+		n.get("expandedlicensing_member", &o.Member),
+	)
+}
+
+// MarshalJSON encodes the CustomLicense as a compact SPDX JSON-LD object.
+func (o CustomLicense) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_CustomLicense")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CustomLicense) marshalFields(w *jsonObject) {
+	o.License.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the CustomLicense from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *CustomLicense) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = CustomLicense{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CustomLicense) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.License.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the CustomLicenseAddition as a compact SPDX JSON-LD object.
+func (o CustomLicenseAddition) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_CustomLicenseAddition")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CustomLicenseAddition) marshalFields(w *jsonObject) {
+	o.LicenseAddition.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the CustomLicenseAddition from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *CustomLicenseAddition) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = CustomLicenseAddition{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CustomLicenseAddition) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.LicenseAddition.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the DisjunctiveLicenseSet as a compact SPDX JSON-LD object.
+func (o DisjunctiveLicenseSet) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_DisjunctiveLicenseSet")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *DisjunctiveLicenseSet) marshalFields(w *jsonObject) {
+	o.AnyLicenseInfo.marshalFields(w)
+	w.refs("expandedlicensing_member", refIDs(o.Member))
+}
+
+// UnmarshalJSON decodes the DisjunctiveLicenseSet from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *DisjunctiveLicenseSet) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = DisjunctiveLicenseSet{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *DisjunctiveLicenseSet) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.AnyLicenseInfo.unmarshalFields(n),
+		n.get("expandedlicensing_member", &o.Member),
+	)
+}
+
+// MarshalJSON encodes the ExtendableLicense as a compact SPDX JSON-LD object.
+func (o ExtendableLicense) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_ExtendableLicense")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ExtendableLicense) marshalFields(w *jsonObject) {
+	o.AnyLicenseInfo.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the ExtendableLicense from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *ExtendableLicense) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = ExtendableLicense{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ExtendableLicense) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.AnyLicenseInfo.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the IndividualLicensingInfo as a compact SPDX JSON-LD object.
+func (o IndividualLicensingInfo) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_IndividualLicensingInfo")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *IndividualLicensingInfo) marshalFields(w *jsonObject) {
+	o.AnyLicenseInfo.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the IndividualLicensingInfo from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *IndividualLicensingInfo) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = IndividualLicensingInfo{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *IndividualLicensingInfo) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.AnyLicenseInfo.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the License as a compact SPDX JSON-LD object.
+func (o License) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_License")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *License) marshalFields(w *jsonObject) {
+	o.ExtendableLicense.marshalFields(w)
+	w.put("simplelicensing_licenseText", o.LicenseText)
+	w.put("expandedlicensing_isDeprecatedLicenseId", o.IsDeprecatedLicenseId)
+	w.put("expandedlicensing_isFsfLibre", o.IsFsfLibre)
+	w.put("expandedlicensing_isOsiApproved", o.IsOsiApproved)
+	w.put("expandedlicensing_licenseXml", o.LicenseXml)
+	w.put("expandedlicensing_obsoletedBy", o.ObsoletedBy)
+	w.put("expandedlicensing_seeAlso", o.SeeAlso)
+	w.put("expandedlicensing_standardLicenseHeader", o.StandardLicenseHeader)
+	w.put("expandedlicensing_standardLicenseTemplate", o.StandardLicenseTemplate)
+}
+
+// UnmarshalJSON decodes the License from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *License) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = License{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *License) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.ExtendableLicense.unmarshalFields(n),
+		n.get("simplelicensing_licenseText", &o.LicenseText),
+		n.get("expandedlicensing_isDeprecatedLicenseId", &o.IsDeprecatedLicenseId),
+		n.get("expandedlicensing_isFsfLibre", &o.IsFsfLibre),
+		n.get("expandedlicensing_isOsiApproved", &o.IsOsiApproved),
+		n.get("expandedlicensing_licenseXml", &o.LicenseXml),
+		n.get("expandedlicensing_obsoletedBy", &o.ObsoletedBy),
+		n.get("expandedlicensing_seeAlso", &o.SeeAlso),
+		n.get("expandedlicensing_standardLicenseHeader", &o.StandardLicenseHeader),
+		n.get("expandedlicensing_standardLicenseTemplate", &o.StandardLicenseTemplate),
+	)
+}
+
+// MarshalJSON encodes the LicenseAddition as a compact SPDX JSON-LD object.
+func (o LicenseAddition) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_LicenseAddition")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *LicenseAddition) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.put("expandedlicensing_additionText", o.AdditionText)
+	w.put("expandedlicensing_isDeprecatedAdditionId", o.IsDeprecatedAdditionId)
+	w.put("expandedlicensing_licenseXml", o.LicenseXml)
+	w.put("expandedlicensing_obsoletedBy", o.ObsoletedBy)
+	w.put("expandedlicensing_seeAlso", o.SeeAlso)
+	w.put("expandedlicensing_standardAdditionTemplate", o.StandardAdditionTemplate)
+}
+
+// UnmarshalJSON decodes the LicenseAddition from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *LicenseAddition) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = LicenseAddition{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *LicenseAddition) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("expandedlicensing_additionText", &o.AdditionText),
+		n.get("expandedlicensing_isDeprecatedAdditionId", &o.IsDeprecatedAdditionId),
+		n.get("expandedlicensing_licenseXml", &o.LicenseXml),
+		n.get("expandedlicensing_obsoletedBy", &o.ObsoletedBy),
+		n.get("expandedlicensing_seeAlso", &o.SeeAlso),
+		n.get("expandedlicensing_standardAdditionTemplate", &o.StandardAdditionTemplate),
+	)
+}
+
+// MarshalJSON encodes the ListedLicense as a compact SPDX JSON-LD object.
+func (o ListedLicense) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_ListedLicense")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ListedLicense) marshalFields(w *jsonObject) {
+	o.License.marshalFields(w)
+	w.put("expandedlicensing_deprecatedVersion", o.DeprecatedVersion)
+	w.put("expandedlicensing_listVersionAdded", o.ListVersionAdded)
+}
+
+// UnmarshalJSON decodes the ListedLicense from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *ListedLicense) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = ListedLicense{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ListedLicense) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.License.unmarshalFields(n),
+		n.get("expandedlicensing_deprecatedVersion", &o.DeprecatedVersion),
+		n.get("expandedlicensing_listVersionAdded", &o.ListVersionAdded),
+	)
+}
+
+// MarshalJSON encodes the ListedLicenseException as a compact SPDX JSON-LD object.
+func (o ListedLicenseException) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_ListedLicenseException")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ListedLicenseException) marshalFields(w *jsonObject) {
+	o.LicenseAddition.marshalFields(w)
+	w.put("expandedlicensing_deprecatedVersion", o.DeprecatedVersion)
+	w.put("expandedlicensing_listVersionAdded", o.ListVersionAdded)
+}
+
+// UnmarshalJSON decodes the ListedLicenseException from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *ListedLicenseException) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = ListedLicenseException{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ListedLicenseException) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.LicenseAddition.unmarshalFields(n),
+		n.get("expandedlicensing_deprecatedVersion", &o.DeprecatedVersion),
+		n.get("expandedlicensing_listVersionAdded", &o.ListVersionAdded),
+	)
+}
+
+// MarshalJSON encodes the OrLaterOperator as a compact SPDX JSON-LD object.
+func (o OrLaterOperator) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_OrLaterOperator")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *OrLaterOperator) marshalFields(w *jsonObject) {
+	o.ExtendableLicense.marshalFields(w)
+	w.ref("expandedlicensing_subjectLicense", o.SubjectLicense.SpdxID)
+}
+
+// UnmarshalJSON decodes the OrLaterOperator from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *OrLaterOperator) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = OrLaterOperator{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *OrLaterOperator) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.ExtendableLicense.unmarshalFields(n),
+		n.get("expandedlicensing_subjectLicense", &o.SubjectLicense),
+	)
+}
+
+// MarshalJSON encodes the WithAdditionOperator as a compact SPDX JSON-LD object.
+func (o WithAdditionOperator) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("expandedlicensing_WithAdditionOperator")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *WithAdditionOperator) marshalFields(w *jsonObject) {
+	o.AnyLicenseInfo.marshalFields(w)
+	w.ref("expandedlicensing_subjectAddition", o.SubjectAddition.SpdxID)
+	w.ref("expandedlicensing_subjectExtendableLicense", o.SubjectExtendableLicense.SpdxID)
+}
+
+// UnmarshalJSON decodes the WithAdditionOperator from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *WithAdditionOperator) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = WithAdditionOperator{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *WithAdditionOperator) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.AnyLicenseInfo.unmarshalFields(n),
+		n.get("expandedlicensing_subjectAddition", &o.SubjectAddition),
+		n.get("expandedlicensing_subjectExtendableLicense", &o.SubjectExtendableLicense),
+	)
+}
+
+// MarshalJSON encodes the CdxPropertiesExtension as a compact SPDX JSON-LD object.
+func (o CdxPropertiesExtension) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("extension_CdxPropertiesExtension")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CdxPropertiesExtension) marshalFields(w *jsonObject) {
+	o.Extension.marshalFields(w)
+	w.put("extension_cdxProperty", o.CdxProperty)
+}
+
+// UnmarshalJSON decodes the CdxPropertiesExtension from a compact SPDX JSON-LD object.
+func (o *CdxPropertiesExtension) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CdxPropertiesExtension) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Extension.unmarshalFields(n),
+		n.get("extension_cdxProperty", &o.CdxProperty),
+	)
+}
+
+// MarshalJSON encodes the CdxPropertyEntry as a compact SPDX JSON-LD object.
+func (o CdxPropertyEntry) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("extension_CdxPropertyEntry")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CdxPropertyEntry) marshalFields(w *jsonObject) {
+	w.put("extension_cdxPropName", o.CdxPropName)
+	w.put("extension_cdxPropValue", o.CdxPropValue)
+}
+
+// UnmarshalJSON decodes the CdxPropertyEntry from a compact SPDX JSON-LD object.
+func (o *CdxPropertyEntry) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CdxPropertyEntry) unmarshalFields(n jsonNode) error {
+	return firstError(
+		n.get("extension_cdxPropName", &o.CdxPropName),
+		n.get("extension_cdxPropValue", &o.CdxPropValue),
+	)
+}
+
+// MarshalJSON encodes the Extension as a compact SPDX JSON-LD object.
+func (o Extension) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("extension_Extension")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Extension) marshalFields(w *jsonObject) {
+}
+
+// UnmarshalJSON decodes the Extension from a compact SPDX JSON-LD object.
+func (o *Extension) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Extension) unmarshalFields(n jsonNode) error {
+	return firstError()
+}
+
+// MarshalJSON encodes the CvssV2VulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o CvssV2VulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_CvssV2VulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CvssV2VulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_score", o.Score)
+	w.put("security_vectorString", o.VectorString)
+}
+
+// UnmarshalJSON decodes the CvssV2VulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *CvssV2VulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = CvssV2VulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CvssV2VulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_score", &o.Score),
+		n.get("security_vectorString", &o.VectorString),
+	)
+}
+
+// MarshalJSON encodes the CvssV3VulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o CvssV3VulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_CvssV3VulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CvssV3VulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_score", o.Score)
+	w.put("security_severity", o.Severity)
+	w.put("security_vectorString", o.VectorString)
+}
+
+// UnmarshalJSON decodes the CvssV3VulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *CvssV3VulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = CvssV3VulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CvssV3VulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_score", &o.Score),
+		n.get("security_severity", &o.Severity),
+		n.get("security_vectorString", &o.VectorString),
+	)
+}
+
+// MarshalJSON encodes the CvssV4VulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o CvssV4VulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_CvssV4VulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *CvssV4VulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_score", o.Score)
+	w.put("security_severity", o.Severity)
+	w.put("security_vectorString", o.VectorString)
+}
+
+// UnmarshalJSON decodes the CvssV4VulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *CvssV4VulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = CvssV4VulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *CvssV4VulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_score", &o.Score),
+		n.get("security_severity", &o.Severity),
+		n.get("security_vectorString", &o.VectorString),
+	)
+}
+
+// MarshalJSON encodes the EpssVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o EpssVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_EpssVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *EpssVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_probability", o.Probability)
+	w.put("security_percentile", o.Percentile)
+}
+
+// UnmarshalJSON decodes the EpssVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *EpssVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = EpssVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *EpssVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_probability", &o.Probability),
+		n.get("security_percentile", &o.Percentile),
+	)
+}
+
+// MarshalJSON encodes the ExploitCatalogVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o ExploitCatalogVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_ExploitCatalogVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ExploitCatalogVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_catalogType", o.CatalogType)
+	w.put("security_exploited", o.Exploited)
+	w.put("security_locator", o.Locator)
+}
+
+// UnmarshalJSON decodes the ExploitCatalogVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *ExploitCatalogVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = ExploitCatalogVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ExploitCatalogVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_catalogType", &o.CatalogType),
+		n.get("security_exploited", &o.Exploited),
+		n.get("security_locator", &o.Locator),
+	)
+}
+
+// MarshalJSON encodes the SsvcVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o SsvcVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_SsvcVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *SsvcVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_decisionType", o.DecisionType)
+}
+
+// UnmarshalJSON decodes the SsvcVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *SsvcVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = SsvcVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *SsvcVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_decisionType", &o.DecisionType),
+	)
+}
+
+// MarshalJSON encodes the VexAffectedVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o VexAffectedVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_VexAffectedVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *VexAffectedVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VexVulnAssessmentRelationship.marshalFields(w)
+	w.put("security_actionStatement", o.ActionStatement)
+	w.put("security_actionStatementTime", o.ActionStatementTime)
+}
+
+// UnmarshalJSON decodes the VexAffectedVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *VexAffectedVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = VexAffectedVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *VexAffectedVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VexVulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_actionStatement", &o.ActionStatement),
+		n.get("security_actionStatementTime", &o.ActionStatementTime),
+	)
+}
+
+// MarshalJSON encodes the VexFixedVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o VexFixedVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_VexFixedVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *VexFixedVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VexVulnAssessmentRelationship.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the VexFixedVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *VexFixedVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = VexFixedVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *VexFixedVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VexVulnAssessmentRelationship.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the VexNotAffectedVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o VexNotAffectedVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_VexNotAffectedVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *VexNotAffectedVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VexVulnAssessmentRelationship.marshalFields(w)
+	w.put("security_justificationType", o.JustificationType)
+	w.put("security_impactStatement", o.ImpactStatement)
+	w.put("security_impactStatementTime", o.ImpactStatementTime)
+}
+
+// UnmarshalJSON decodes the VexNotAffectedVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *VexNotAffectedVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = VexNotAffectedVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *VexNotAffectedVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VexVulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_justificationType", &o.JustificationType),
+		n.get("security_impactStatement", &o.ImpactStatement),
+		n.get("security_impactStatementTime", &o.ImpactStatementTime),
+	)
+}
+
+// MarshalJSON encodes the VexUnderInvestigationVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o VexUnderInvestigationVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_VexUnderInvestigationVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *VexUnderInvestigationVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VexVulnAssessmentRelationship.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the VexUnderInvestigationVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *VexUnderInvestigationVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = VexUnderInvestigationVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *VexUnderInvestigationVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VexVulnAssessmentRelationship.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the VexVulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o VexVulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_VexVulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *VexVulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.VulnAssessmentRelationship.marshalFields(w)
+	w.put("security_vexVersion", o.VexVersion)
+	w.put("security_statusNotes", o.StatusNotes)
+}
+
+// UnmarshalJSON decodes the VexVulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *VexVulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = VexVulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *VexVulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.VulnAssessmentRelationship.unmarshalFields(n),
+		n.get("security_vexVersion", &o.VexVersion),
+		n.get("security_statusNotes", &o.StatusNotes),
+	)
+}
+
+// MarshalJSON encodes the VulnAssessmentRelationship as a compact SPDX JSON-LD object.
+func (o VulnAssessmentRelationship) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_VulnAssessmentRelationship")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *VulnAssessmentRelationship) marshalFields(w *jsonObject) {
+	o.Relationship.marshalFields(w)
+	if o.AssessedElement != nil {
+		w.ref("security_assessedElement", o.AssessedElement.SpdxID)
+	}
+	w.put("security_publishedTime", o.PublishedTime)
+	if o.SuppliedBy != nil {
+		w.ref("suppliedBy", o.SuppliedBy.SpdxID)
+	}
+	w.put("security_modifiedTime", o.ModifiedTime)
+	w.put("security_withdrawnTime", o.WithdrawnTime)
+}
+
+// UnmarshalJSON decodes the VulnAssessmentRelationship from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *VulnAssessmentRelationship) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = VulnAssessmentRelationship{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *VulnAssessmentRelationship) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Relationship.unmarshalFields(n),
+		n.get("security_assessedElement", &o.AssessedElement),
+		n.get("security_publishedTime", &o.PublishedTime),
+		n.get("suppliedBy", &o.SuppliedBy),
+		n.get("security_modifiedTime", &o.ModifiedTime),
+		n.get("security_withdrawnTime", &o.WithdrawnTime),
+	)
+}
+
+// MarshalJSON encodes the Vulnerability as a compact SPDX JSON-LD object.
+func (o Vulnerability) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("security_Vulnerability")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Vulnerability) marshalFields(w *jsonObject) {
+	o.Artifact.marshalFields(w)
+	w.put("security_publishedTime", o.PublishedTime)
+	w.put("security_modifiedTime", o.ModifiedTime)
+	w.put("security_withdrawnTime", o.WithdrawnTime)
+}
+
+// UnmarshalJSON decodes the Vulnerability from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Vulnerability) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Vulnerability{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Vulnerability) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Artifact.unmarshalFields(n),
+		n.get("security_publishedTime", &o.PublishedTime),
+		n.get("security_modifiedTime", &o.ModifiedTime),
+		n.get("security_withdrawnTime", &o.WithdrawnTime),
+	)
+}
+
+// MarshalJSON encodes the AnyLicenseInfo as a compact SPDX JSON-LD object.
+func (o AnyLicenseInfo) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("simplelicensing_AnyLicenseInfo")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *AnyLicenseInfo) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+}
+
+// UnmarshalJSON decodes the AnyLicenseInfo from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *AnyLicenseInfo) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = AnyLicenseInfo{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *AnyLicenseInfo) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+	)
+}
+
+// MarshalJSON encodes the LicenseExpression as a compact SPDX JSON-LD object.
+func (o LicenseExpression) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("simplelicensing_LicenseExpression")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *LicenseExpression) marshalFields(w *jsonObject) {
+	o.AnyLicenseInfo.marshalFields(w)
+	w.put("simplelicensing_licenseExpression", o.LicenseExpression)
+	w.put("simplelicensing_licenseListVersion", o.LicenseListVersion)
+	w.put("simplelicensing_customIdToUri", o.CustomIdToUri)
+}
+
+// UnmarshalJSON decodes the LicenseExpression from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *LicenseExpression) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = LicenseExpression{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *LicenseExpression) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.AnyLicenseInfo.unmarshalFields(n),
+		n.get("simplelicensing_licenseExpression", &o.LicenseExpression),
+		n.get("simplelicensing_licenseListVersion", &o.LicenseListVersion),
+		n.get("simplelicensing_customIdToUri", &o.CustomIdToUri),
+	)
+}
+
+// MarshalJSON encodes the SimpleLicensingText as a compact SPDX JSON-LD object.
+func (o SimpleLicensingText) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("simplelicensing_SimpleLicensingText")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *SimpleLicensingText) marshalFields(w *jsonObject) {
+	o.Element.marshalFields(w)
+	w.put("simplelicensing_licenseText", o.LicenseText)
+}
+
+// UnmarshalJSON decodes the SimpleLicensingText from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *SimpleLicensingText) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = SimpleLicensingText{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *SimpleLicensingText) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Element.unmarshalFields(n),
+		n.get("simplelicensing_licenseText", &o.LicenseText),
+	)
+}
+
+// MarshalJSON encodes the ContentIdentifier as a compact SPDX JSON-LD object.
+func (o ContentIdentifier) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("software_ContentIdentifier")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *ContentIdentifier) marshalFields(w *jsonObject) {
+	o.IntegrityMethod.marshalFields(w)
+	w.put("software_contentIdentifierType", o.ContentIdentifierType)
+	w.put("software_contentIdentifierValue", o.ContentIdentifierValue)
+}
+
+// UnmarshalJSON decodes the ContentIdentifier from a compact SPDX JSON-LD object.
+func (o *ContentIdentifier) UnmarshalJSON(data []byte) error {
+	if _, ok := jsonRef(data); ok {
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *ContentIdentifier) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.IntegrityMethod.unmarshalFields(n),
+		n.get("software_contentIdentifierType", &o.ContentIdentifierType),
+		n.get("software_contentIdentifierValue", &o.ContentIdentifierValue),
+	)
+}
+
+// MarshalJSON encodes the File as a compact SPDX JSON-LD object.
+func (o File) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("software_File")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *File) marshalFields(w *jsonObject) {
+	o.SoftwareArtifact.marshalFields(w)
+	w.put("contentType", o.ContentType)
+	w.put("software_fileKind", o.FileKind)
+}
+
+// UnmarshalJSON decodes the File from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *File) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = File{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *File) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.SoftwareArtifact.unmarshalFields(n),
+		n.get("contentType", &o.ContentType),
+		n.get("software_fileKind", &o.FileKind),
+	)
+}
+
+// MarshalJSON encodes the Package as a compact SPDX JSON-LD object.
+func (o Package) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("software_Package")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Package) marshalFields(w *jsonObject) {
+	o.SoftwareArtifact.marshalFields(w)
+	w.put("software_downloadLocation", o.DownloadLocation)
+	w.put("software_homePage", o.HomePage)
+	w.put("software_packageVersion", o.PackageVersion)
+	w.put("software_packageUrl", o.PackageUrl)
+	w.put("software_sourceInfo", o.SourceInfo)
+}
+
+// UnmarshalJSON decodes the Package from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Package) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Package{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Package) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.SoftwareArtifact.unmarshalFields(n),
+		n.get("software_downloadLocation", &o.DownloadLocation),
+		n.get("software_homePage", &o.HomePage),
+		n.get("software_packageVersion", &o.PackageVersion),
+		n.get("software_packageUrl", &o.PackageUrl),
+		n.get("software_sourceInfo", &o.SourceInfo),
+	)
+}
+
+// MarshalJSON encodes the Sbom as a compact SPDX JSON-LD object.
+func (o Sbom) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("software_Sbom")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Sbom) marshalFields(w *jsonObject) {
+	o.Bom.marshalFields(w)
+	w.put("software_sbomType", o.SbomType)
+}
+
+// UnmarshalJSON decodes the Sbom from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Sbom) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Sbom{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Sbom) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Bom.unmarshalFields(n),
+		n.get("software_sbomType", &o.SbomType),
+	)
+}
+
+// MarshalJSON encodes the Snippet as a compact SPDX JSON-LD object.
+func (o Snippet) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("software_Snippet")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *Snippet) marshalFields(w *jsonObject) {
+	o.SoftwareArtifact.marshalFields(w)
+	w.put("software_byteRange", o.ByteRange)
+	w.put("software_lineRange", o.LineRange)
+	w.ref("software_snippetFromFile", o.SnippetFromFile.SpdxID)
+}
+
+// UnmarshalJSON decodes the Snippet from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *Snippet) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = Snippet{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *Snippet) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.SoftwareArtifact.unmarshalFields(n),
+		n.get("software_byteRange", &o.ByteRange),
+		n.get("software_lineRange", &o.LineRange),
+		n.get("software_snippetFromFile", &o.SnippetFromFile),
+	)
+}
+
+// MarshalJSON encodes the SoftwareArtifact as a compact SPDX JSON-LD object.
+func (o SoftwareArtifact) MarshalJSON() ([]byte, error) {
+	w := newJSONObject("software_SoftwareArtifact")
+	o.marshalFields(w)
+	return w.bytes()
+}
+
+func (o *SoftwareArtifact) marshalFields(w *jsonObject) {
+	o.Artifact.marshalFields(w)
+	w.put("software_primaryPurpose", o.PrimaryPurpose)
+	w.put("software_additionalPurpose", o.AdditionalPurpose)
+	w.put("software_copyrightText", o.CopyrightText)
+	w.put("software_attributionText", o.AttributionText)
+	w.put("software_contentIdentifier", o.ContentIdentifier)
+}
+
+// UnmarshalJSON decodes the SoftwareArtifact from a compact SPDX JSON-LD object.
+// A bare string is decoded as a reference to the element with that ID.
+func (o *SoftwareArtifact) UnmarshalJSON(data []byte) error {
+	if id, ok := jsonRef(data); ok {
+		*o = SoftwareArtifact{}
+		o.SpdxID = id
+		return nil
+	}
+	n, err := decodeJSONNode(data)
+	if err != nil {
+		return err
+	}
+	return o.unmarshalFields(n)
+}
+
+func (o *SoftwareArtifact) unmarshalFields(n jsonNode) error {
+	return firstError(
+		o.Artifact.unmarshalFields(n),
+		n.get("software_primaryPurpose", &o.PrimaryPurpose),
+		n.get("software_additionalPurpose", &o.AdditionalPurpose),
+		n.get("software_copyrightText", &o.CopyrightText),
+		n.get("software_attributionText", &o.AttributionText),
+		n.get("software_contentIdentifier", &o.ContentIdentifier),
+	)
+}
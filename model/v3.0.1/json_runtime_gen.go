@@ -0,0 +1,189 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// jsonObject builds a JSON-LD node for the generated MarshalJSON methods,
+// keeping properties in the order they were written.
+type jsonObject struct {
+	keys []string
+	vals []json.RawMessage
+	err  error
+}
+
+func newJSONObject(typ string) *jsonObject {
+	w := &jsonObject{}
+	w.put("type", typ)
+	return w
+}
+
+// put writes a property. Empty values are omitted; missing required
+// properties are reported by Validate rather than written as zero values.
+func (w *jsonObject) put(name string, v interface{}) {
+	if w.err != nil || isEmpty(v) {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		w.err = fmt.Errorf("%s: %w", name, err)
+		return
+	}
+	w.keys = append(w.keys, name)
+	w.vals = append(w.vals, b)
+}
+
+// ref writes a reference to a single element by its ID. References to the
+// individuals of the specification are written as their compact terms.
+func (w *jsonObject) ref(name, id string) {
+	w.put(name, CompactIndividual(id))
+}
+
+// refs writes references to several elements by their IDs, as ref does.
+func (w *jsonObject) refs(name string, ids []string) {
+	for i, id := range ids {
+		ids[i] = CompactIndividual(id)
+	}
+	w.put(name, ids)
+}
+
+func (w *jsonObject) bytes() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range w.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(w.vals[i])
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// isEmpty reports whether a property value should be omitted.
+func isEmpty(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
+
+// refIDs returns the IDs of referenced elements.
+func refIDs[T any, P interface {
+	*T
+	GetSpdxID() string
+}](items []T) []string {
+	if len(items) == 0 {
+		return nil
+	}
+	ids := make([]string, len(items))
+	for i := range items {
+		ids[i] = P(&items[i]).GetSpdxID()
+	}
+	return ids
+}
+
+// jsonNode is a decoded JSON-LD object for the generated UnmarshalJSON methods.
+type jsonNode map[string]json.RawMessage
+
+func decodeJSONNode(data []byte) (jsonNode, error) {
+	var n jsonNode
+	if err := json.Unmarshal(data, &n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// get decodes a property into v if it is present and not null.
+func (n jsonNode) get(name string, v interface{}) error {
+	raw, ok := n[name]
+	if !ok || string(raw) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// id decodes the element identifier, which the SPDX context aliases to @id.
+func (n jsonNode) id(v *string) error {
+	if _, ok := n["spdxId"]; ok {
+		return n.get("spdxId", v)
+	}
+	return n.get("@id", v)
+}
+
+// jsonRef returns the ID if data is a bare string reference to a node.
+func jsonRef(data []byte) (string, bool) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '"' {
+		return "", false
+	}
+	var id string
+	if err := json.Unmarshal(data, &id); err != nil {
+		return "", false
+	}
+	return id, true
+}
+
+func firstError(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTyped decodes a property holding objects of the abstract class with
+// the given compact type name as the classes named by their type
+// properties. Objects of unknown types are decoded as the abstract class
+// itself, and references to blank nodes are skipped.
+func getTyped[T any](n jsonNode, name, class string, v *[]T) error {
+	var raws []json.RawMessage
+	if err := n.get(name, &raws); err != nil {
+		return err
+	}
+	for _, raw := range raws {
+		if _, ok := jsonRef(raw); ok {
+			continue
+		}
+		var head struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(raw, &head); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		info, ok := LookupType(head.Type)
+		if !ok {
+			info, _ = LookupType(class)
+		}
+		x, ok := info.New().(T)
+		if !ok {
+			return fmt.Errorf("%s: %s is not a %s", name, head.Type, class)
+		}
+		if err := json.Unmarshal(raw, x); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		*v = append(*v, x)
+	}
+	return nil
+}
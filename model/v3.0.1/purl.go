@@ -0,0 +1,260 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PURL is a package URL split into its components, as in
+// "pkg:maven/org.apache.commons/commons-lang3@3.12.0?type=jar". The
+// components are held decoded.
+type PURL struct {
+	// Type is the package type, such as "npm" or "maven", in lower case.
+	Type string
+	// Namespace is the slash-separated namespace, such as the group of a
+	// Maven package or the scope of an npm package, or empty.
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers map[string]string
+	// Subpath is the slash-separated path within the package, or empty.
+	Subpath string
+}
+
+// ParsePURL parses a package URL and normalizes it as the purl
+// specification defines: the type and qualifier keys are lowercased,
+// qualifiers without a value are dropped, empty and "." or ".." segments
+// are dropped from the namespace and subpath, and the namespace and name
+// are lowercased for the types whose names are not case sensitive, such
+// as github and pypi. An unencoded "@" before the last "/" is taken as
+// part of the namespace, as in npm scopes.
+func ParsePURL(s string) (PURL, error) {
+	var p PURL
+	rest, ok := strings.CutPrefix(s, "pkg:")
+	if !ok {
+		if len(s) < 4 || !strings.EqualFold(s[:4], "pkg:") {
+			return PURL{}, fmt.Errorf("package URL %q does not start with pkg:", s)
+		}
+		rest = s[4:]
+	}
+
+	var err error
+	if i := strings.LastIndex(rest, "#"); i >= 0 {
+		segments, err := splitSegments(rest[i+1:])
+		if err != nil {
+			return PURL{}, fmt.Errorf("package URL %q has an invalid subpath: %w", s, err)
+		}
+		segments = slices.DeleteFunc(segments, func(seg string) bool { return seg == "." || seg == ".." })
+		p.Subpath = strings.Join(segments, "/")
+		rest = rest[:i]
+	}
+	if i := strings.LastIndex(rest, "?"); i >= 0 {
+		for _, pair := range strings.Split(rest[i+1:], "&") {
+			key, value, _ := strings.Cut(pair, "=")
+			if value, err = url.PathUnescape(value); err != nil {
+				return PURL{}, fmt.Errorf("package URL %q has an invalid qualifier: %w", s, err)
+			}
+			if key == "" || value == "" {
+				continue
+			}
+			if p.Qualifiers == nil {
+				p.Qualifiers = make(map[string]string)
+			}
+			p.Qualifiers[strings.ToLower(key)] = value
+		}
+		rest = rest[:i]
+	}
+
+	rest = strings.Trim(rest, "/")
+	typ, rest, ok := strings.Cut(rest, "/")
+	if !ok || typ == "" {
+		return PURL{}, fmt.Errorf("package URL %q has no type and name", s)
+	}
+	p.Type = strings.ToLower(typ)
+	if i := strings.LastIndex(rest, "@"); i > strings.LastIndex(rest, "/") {
+		if p.Version, err = url.PathUnescape(rest[i+1:]); err != nil {
+			return PURL{}, fmt.Errorf("package URL %q has an invalid version: %w", s, err)
+		}
+		rest = rest[:i]
+	}
+	segments, err := splitSegments(rest)
+	if err != nil {
+		return PURL{}, fmt.Errorf("package URL %q has an invalid name: %w", s, err)
+	}
+	if len(segments) == 0 {
+		return PURL{}, fmt.Errorf("package URL %q has no name", s)
+	}
+	p.Name = segments[len(segments)-1]
+	p.Namespace = strings.Join(segments[:len(segments)-1], "/")
+
+	switch p.Type {
+	case "bitbucket", "composer", "github", "hex":
+		p.Namespace = strings.ToLower(p.Namespace)
+		p.Name = strings.ToLower(p.Name)
+	case "pypi":
+		p.Name = strings.ReplaceAll(strings.ToLower(p.Name), "_", "-")
+	}
+	return p, nil
+}
+
+// splitSegments splits a slash-separated path into its decoded, non-empty
+// segments.
+func splitSegments(s string) ([]string, error) {
+	var segments []string
+	for _, seg := range strings.Split(s, "/") {
+		if seg == "" {
+			continue
+		}
+		seg, err := url.PathUnescape(seg)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, seg)
+	}
+	return segments, nil
+}
+
+// String returns the canonical form of the package URL, with its
+// qualifiers sorted by key.
+func (p PURL) String() string {
+	var b strings.Builder
+	b.WriteString("pkg:")
+	b.WriteString(p.Type)
+	b.WriteByte('/')
+	if p.Namespace != "" {
+		b.WriteString(escapeSegments(p.Namespace))
+		b.WriteByte('/')
+	}
+	b.WriteString(escapePURL(p.Name, ""))
+	if p.Version != "" {
+		b.WriteByte('@')
+		b.WriteString(escapePURL(p.Version, ""))
+	}
+	keys := make([]string, 0, len(p.Qualifiers))
+	for k, v := range p.Qualifiers {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for i, k := range keys {
+		if i == 0 {
+			b.WriteByte('?')
+		} else {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(escapePURL(p.Qualifiers[k], "/"))
+	}
+	if p.Subpath != "" {
+		b.WriteByte('#')
+		b.WriteString(escapeSegments(p.Subpath))
+	}
+	return b.String()
+}
+
+// escapeSegments percent-encodes the segments of a slash-separated path.
+func escapeSegments(s string) string {
+	segments := strings.Split(s, "/")
+	for i, seg := range segments {
+		segments[i] = escapePURL(seg, "")
+	}
+	return strings.Join(segments, "/")
+}
+
+// escapePURL percent-encodes the characters of s other than letters,
+// digits, ".-_~:" and those in keep.
+func escapePURL(s, keep string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.IndexByte(".-_~:"+keep, c) >= 0 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// NormalizePURL returns the canonical form of a package URL, as ParsePURL
+// normalizes it, so that package URLs written differently by different
+// tools compare equal.
+//
+//	spdx.NormalizePURL("pkg:PyPI/Django_Rest@3.0?b=2&a=1") // "pkg:pypi/django-rest@3.0?a=1&b=2"
+func NormalizePURL(s string) (string, error) {
+	p, err := ParsePURL(s)
+	if err != nil {
+		return "", err
+	}
+	return p.String(), nil
+}
+
+// PURL returns the package URL of the package: its packageUrl or, if it
+// has none, its first package URL external identifier, which GetPURL
+// returns. It returns "" if the package has neither.
+func (o *Package) PURL() string {
+	if o.PackageUrl != "" {
+		return o.PackageUrl
+	}
+	return o.GetPURL()
+}
+
+// PURLComponents returns the components of the package URL of the
+// package, as PURL returns it. It returns false if the package has no
+// package URL or it cannot be parsed.
+func (o *Package) PURLComponents() (PURL, bool) {
+	s := o.PURL()
+	if s == "" {
+		return PURL{}, false
+	}
+	p, err := ParsePURL(s)
+	if err != nil {
+		return PURL{}, false
+	}
+	return p, true
+}
+
+// NormalizePURLs replaces the packageUrl and package URL external
+// identifiers of the package by their canonical forms, as NormalizePURL
+// returns them. Package URLs that cannot be parsed are left as they are
+// and reported in the returned error.
+func (o *Package) NormalizePURLs() error {
+	var errs []error
+	normalize := func(s *string) {
+		if *s == "" {
+			return
+		}
+		n, err := NormalizePURL(*s)
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		*s = n
+	}
+	normalize(&o.PackageUrl)
+	for i := range o.ExternalIdentifier {
+		if o.ExternalIdentifier[i].ExternalIdentifierType == ExternalIdentifierTypePackageUrl {
+			normalize(&o.ExternalIdentifier[i].Identifier)
+		}
+	}
+	return errors.Join(errs...)
+}
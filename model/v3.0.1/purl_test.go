@@ -0,0 +1,88 @@
+package spdx_test
+
+import (
+	"reflect"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+func TestParsePURL(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    spdx.PURL
+		wantErr bool
+	}{
+		{
+			in:   "pkg:maven/org.apache.commons/commons-lang3@3.12.0?type=jar&classifier=sources",
+			want: spdx.PURL{Type: "maven", Namespace: "org.apache.commons", Name: "commons-lang3", Version: "3.12.0", Qualifiers: map[string]string{"type": "jar", "classifier": "sources"}},
+		},
+		{in: "pkg:npm/%40babel/core@7.24.0", want: spdx.PURL{Type: "npm", Namespace: "@babel", Name: "core", Version: "7.24.0"}},
+		{in: "pkg:npm/@babel/core", want: spdx.PURL{Type: "npm", Namespace: "@babel", Name: "core"}},
+		{in: "PKG:GitHub/Package-URL/Purl-Spec@244fd47#src//./main", want: spdx.PURL{Type: "github", Namespace: "package-url", Name: "purl-spec", Version: "244fd47", Subpath: "src/main"}},
+		{in: "pkg:pypi/Django_Rest@3.0?Arch=&OS=linux", want: spdx.PURL{Type: "pypi", Name: "django-rest", Version: "3.0", Qualifiers: map[string]string{"os": "linux"}}},
+		{in: "pkg:golang/github.com/Azure/go-autorest@v14.2.0%2Bincompatible", want: spdx.PURL{Type: "golang", Namespace: "github.com/Azure", Name: "go-autorest", Version: "v14.2.0+incompatible"}},
+		{in: "npm/core", wantErr: true},
+		{in: "pkg:npm", wantErr: true},
+		{in: "pkg:npm/%zz", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := spdx.ParsePURL(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParsePURL(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParsePURL(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizePURL(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"pkg:maven/org.apache.commons/commons-lang3@3.12.0?type=jar&classifier=sources", "pkg:maven/org.apache.commons/commons-lang3@3.12.0?classifier=sources&type=jar"},
+		{"pkg:npm/@babel/core@7.24.0", "pkg:npm/%40babel/core@7.24.0"},
+		{"pkg:PyPI/Django_Rest@3.0?b=2&a=1", "pkg:pypi/django-rest@3.0?a=1&b=2"},
+		{"pkg:golang/github.com/Azure/go-autorest@v14.2.0+incompatible", "pkg:golang/github.com/Azure/go-autorest@v14.2.0%2Bincompatible"},
+		{"pkg:docker/library/nginx?repository_url=registry.example/mirror", "pkg:docker/library/nginx?repository_url=registry.example/mirror"},
+	}
+	for _, tt := range tests {
+		got, err := spdx.NormalizePURL(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("NormalizePURL(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+		if again, _ := spdx.NormalizePURL(got); again != got {
+			t.Errorf("NormalizePURL(%q) = %q, not stable", got, again)
+		}
+	}
+}
+
+func TestPackage_PURLs(t *testing.T) {
+	pkg := spdx.NewPackage("urn:spdx:django", "django", "3.0", spdx.NewCreationInfo(nil))
+	if _, ok := pkg.PURLComponents(); ok || pkg.PURL() != "" {
+		t.Errorf("package without a package URL: PURL = %q, PURLComponents = %v", pkg.PURL(), ok)
+	}
+	pkg.WithPURL("pkg:PyPI/Django@3.0")
+	pkg.ExternalIdentifier = append(pkg.ExternalIdentifier, spdx.NewExternalIdentifier(spdx.ExternalIdentifierTypeCpe23, "cpe:2.3:a:Django"))
+	if p, ok := pkg.PURLComponents(); !ok || p.Type != "pypi" || p.Name != "django" || pkg.PURL() != "pkg:PyPI/Django@3.0" {
+		t.Errorf("PURLComponents from the external identifier = %+v, %v", p, ok)
+	}
+	pkg.PackageUrl = "pkg:pypi/Django_Rest?b=2&a=1"
+	if p, ok := pkg.PURLComponents(); !ok || p.Name != "django-rest" || pkg.PURL() != pkg.PackageUrl {
+		t.Errorf("PURLComponents from packageUrl = %+v, %v", p, ok)
+	}
+
+	if err := pkg.NormalizePURLs(); err != nil {
+		t.Fatalf("NormalizePURLs: %v", err)
+	}
+	if pkg.PackageUrl != "pkg:pypi/django-rest?a=1&b=2" || pkg.GetPURL() != "pkg:pypi/django@3.0" || pkg.GetCPE() != "cpe:2.3:a:Django" {
+		t.Errorf("normalized package URLs = %q, %q, cpe %q", pkg.PackageUrl, pkg.GetPURL(), pkg.GetCPE())
+	}
+
+	pkg.PackageUrl = "not a purl"
+	if err := pkg.NormalizePURLs(); err == nil || pkg.PackageUrl != "not a purl" {
+		t.Errorf("NormalizePURLs of an invalid package URL = %v, %q", err, pkg.PackageUrl)
+	}
+}
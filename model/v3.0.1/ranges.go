@@ -0,0 +1,182 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// NewPositiveIntegerRange creates a PositiveIntegerRange from begin to end,
+// both included and counted from 1, as the byte and line ranges of
+// snippets are.
+func NewPositiveIntegerRange(begin, end int) *PositiveIntegerRange {
+	return &PositiveIntegerRange{BeginIntegerRange: begin, EndIntegerRange: end}
+}
+
+// ValidateOrdered checks the range as Validate does, and that it does not
+// end before it begins.
+func (o *PositiveIntegerRange) ValidateOrdered() error {
+	v := &validator{}
+	o.validate(v)
+	if o.EndIntegerRange < o.BeginIntegerRange {
+		v.add("PositiveIntegerRange", "endIntegerRange", "must not be less than beginIntegerRange %d, is %d", o.BeginIntegerRange, o.EndIntegerRange)
+	}
+	return v.err()
+}
+
+// Len returns the number of integers in the range.
+func (o *PositiveIntegerRange) Len() int {
+	return max(o.EndIntegerRange-o.BeginIntegerRange+1, 0)
+}
+
+// Contains reports whether n is in the range.
+func (o *PositiveIntegerRange) Contains(n int) bool {
+	return o.BeginIntegerRange <= n && n <= o.EndIntegerRange
+}
+
+// Overlaps reports whether the range and other have an integer in common.
+func (o *PositiveIntegerRange) Overlaps(other *PositiveIntegerRange) bool {
+	return o.BeginIntegerRange <= other.EndIntegerRange && other.BeginIntegerRange <= o.EndIntegerRange
+}
+
+// ValidateRanges checks that the byte and line ranges of the snippet, if
+// set, are positive and ordered, as ValidateOrdered does.
+func (o *Snippet) ValidateRanges() error {
+	var errs []error
+	if o.ByteRange != nil {
+		errs = append(errs, o.ByteRange.ValidateOrdered())
+	}
+	if o.LineRange != nil {
+		errs = append(errs, o.LineRange.ValidateOrdered())
+	}
+	return errors.Join(errs...)
+}
+
+// Overlaps reports whether the snippet and other are of the same file and
+// cover part of the same content: their byte ranges overlap or, if either
+// has no byte range, their line ranges do. Snippets without comparable
+// ranges do not overlap.
+func (o *Snippet) Overlaps(other *Snippet) bool {
+	if o.SnippetFromFile.SpdxID != other.SnippetFromFile.SpdxID {
+		return false
+	}
+	if o.ByteRange != nil && other.ByteRange != nil {
+		return o.ByteRange.Overlaps(other.ByteRange)
+	}
+	if o.LineRange != nil && other.LineRange != nil {
+		return o.LineRange.Overlaps(other.LineRange)
+	}
+	return false
+}
+
+// ByteRangeOfLines returns the byte range of content that the lines of a
+// line range span, the line terminator of the last line included.
+func ByteRangeOfLines(content []byte, lines *PositiveIntegerRange) (*PositiveIntegerRange, error) {
+	if err := lines.ValidateOrdered(); err != nil {
+		return nil, err
+	}
+	starts := lineStarts(content)
+	if lines.EndIntegerRange > len(starts) {
+		return nil, fmt.Errorf("line range %d-%d exceeds the %d lines of the content", lines.BeginIntegerRange, lines.EndIntegerRange, len(starts))
+	}
+	end := len(content)
+	if lines.EndIntegerRange < len(starts) {
+		end = starts[lines.EndIntegerRange]
+	}
+	return NewPositiveIntegerRange(starts[lines.BeginIntegerRange-1]+1, end), nil
+}
+
+// LineRangeOfBytes returns the range of the lines of content that a byte
+// range spans, in part or in full.
+func LineRangeOfBytes(content []byte, byteRange *PositiveIntegerRange) (*PositiveIntegerRange, error) {
+	if err := byteRange.ValidateOrdered(); err != nil {
+		return nil, err
+	}
+	if byteRange.EndIntegerRange > len(content) {
+		return nil, fmt.Errorf("byte range %d-%d exceeds the %d bytes of the content", byteRange.BeginIntegerRange, byteRange.EndIntegerRange, len(content))
+	}
+	line := func(offset int) int {
+		return bytes.Count(content[:offset], []byte{'\n'}) + 1
+	}
+	return NewPositiveIntegerRange(line(byteRange.BeginIntegerRange-1), line(byteRange.EndIntegerRange-1)), nil
+}
+
+// lineStarts returns the zero-based offsets at which the lines of content
+// start. A final line terminator does not start another line.
+func lineStarts(content []byte) []int {
+	var starts []int
+	for i := 0; i < len(content); {
+		starts = append(starts, i)
+		next := bytes.IndexByte(content[i:], '\n')
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+	return starts
+}
+
+// Extract returns the content of the snippet from the content of its file:
+// the bytes of its byte range or, if it has none, the lines of its line
+// range, line terminators included.
+//
+//	f, err := os.Open("src/main.go")
+//	...
+//	code, err := snippet.Extract(f)
+func (o *Snippet) Extract(r io.ReaderAt) ([]byte, error) {
+	switch {
+	case o.ByteRange != nil:
+		if err := o.ByteRange.ValidateOrdered(); err != nil {
+			return nil, err
+		}
+		// The range is read as far as the content goes rather than
+		// allocated up front, since it comes from the document and may
+		// be far larger than the content.
+		n := int64(o.ByteRange.Len())
+		buf, err := io.ReadAll(io.NewSectionReader(r, int64(o.ByteRange.BeginIntegerRange-1), n))
+		if err != nil {
+			return nil, fmt.Errorf("reading snippet: %w", err)
+		}
+		if int64(len(buf)) < n {
+			return nil, fmt.Errorf("reading snippet: byte range %d-%d exceeds the content", o.ByteRange.BeginIntegerRange, o.ByteRange.EndIntegerRange)
+		}
+		return buf, nil
+	case o.LineRange != nil:
+		if err := o.LineRange.ValidateOrdered(); err != nil {
+			return nil, err
+		}
+		var out []byte
+		br := bufio.NewReader(io.NewSectionReader(r, 0, math.MaxInt64))
+		for line := 1; line <= o.LineRange.EndIntegerRange; line++ {
+			b, err := br.ReadBytes('\n')
+			if len(b) == 0 && errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("line range %d-%d exceeds the %d lines of the content", o.LineRange.BeginIntegerRange, o.LineRange.EndIntegerRange, line-1)
+			}
+			if err != nil && !errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("reading snippet: %w", err)
+			}
+			if line >= o.LineRange.BeginIntegerRange {
+				out = append(out, b...)
+			}
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("snippet %q has no byte or line range", o.SpdxID)
+}
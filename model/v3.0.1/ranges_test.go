@@ -0,0 +1,132 @@
+package spdx_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+func TestPositiveIntegerRange_ValidateOrdered(t *testing.T) {
+	tests := []struct {
+		begin, end int
+		wantProps  []string
+	}{
+		{1, 1, nil},
+		{3, 10, nil},
+		{0, 5, []string{"beginIntegerRange"}},
+		{5, 4, []string{"endIntegerRange"}},
+		{-2, -1, []string{"beginIntegerRange", "endIntegerRange"}},
+	}
+	for _, tt := range tests {
+		err := spdx.NewPositiveIntegerRange(tt.begin, tt.end).ValidateOrdered()
+		var got []string
+		if err != nil {
+			for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+				var ve *spdx.ValidationError
+				if !errors.As(e, &ve) {
+					t.Fatalf("unexpected error type %T", e)
+				}
+				got = append(got, ve.Property)
+			}
+		}
+		if strings.Join(got, ",") != strings.Join(tt.wantProps, ",") {
+			t.Errorf("ValidateOrdered(%d-%d) violations = %v, want %v", tt.begin, tt.end, got, tt.wantProps)
+		}
+	}
+}
+
+func TestSnippet_Overlaps(t *testing.T) {
+	snippet := func(file string, byteRange, lineRange *spdx.PositiveIntegerRange) *spdx.Snippet {
+		return &spdx.Snippet{SnippetFromFile: spdx.File{SoftwareArtifact: spdx.SoftwareArtifact{Artifact: spdx.Artifact{Element: spdx.Element{SpdxID: file}}}},
+			ByteRange: byteRange, LineRange: lineRange}
+	}
+	tests := []struct {
+		name string
+		a, b *spdx.Snippet
+		want bool
+	}{
+		{"overlapping bytes", snippet("f", spdx.NewPositiveIntegerRange(1, 100), nil), snippet("f", spdx.NewPositiveIntegerRange(100, 200), nil), true},
+		{"adjacent bytes", snippet("f", spdx.NewPositiveIntegerRange(1, 99), nil), snippet("f", spdx.NewPositiveIntegerRange(100, 200), nil), false},
+		{"other file", snippet("f", spdx.NewPositiveIntegerRange(1, 100), nil), snippet("g", spdx.NewPositiveIntegerRange(1, 100), nil), false},
+		{"bytes before lines", snippet("f", spdx.NewPositiveIntegerRange(1, 10), spdx.NewPositiveIntegerRange(1, 5)),
+			snippet("f", spdx.NewPositiveIntegerRange(20, 30), spdx.NewPositiveIntegerRange(5, 8)), false},
+		{"lines", snippet("f", nil, spdx.NewPositiveIntegerRange(1, 5)), snippet("f", spdx.NewPositiveIntegerRange(20, 30), spdx.NewPositiveIntegerRange(5, 8)), true},
+		{"no ranges", snippet("f", nil, nil), snippet("f", nil, nil), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Overlaps(tt.b); got != tt.want {
+			t.Errorf("%s: Overlaps() = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := tt.b.Overlaps(tt.a); got != tt.want {
+			t.Errorf("%s: reversed Overlaps() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRangeConversions(t *testing.T) {
+	content := []byte("package main\n\nfunc main() {\n}\n")
+	tests := []struct {
+		lines, bytes spdx.PositiveIntegerRange
+	}{
+		{spdx.PositiveIntegerRange{BeginIntegerRange: 1, EndIntegerRange: 1}, spdx.PositiveIntegerRange{BeginIntegerRange: 1, EndIntegerRange: 13}},
+		{spdx.PositiveIntegerRange{BeginIntegerRange: 2, EndIntegerRange: 2}, spdx.PositiveIntegerRange{BeginIntegerRange: 14, EndIntegerRange: 14}},
+		{spdx.PositiveIntegerRange{BeginIntegerRange: 3, EndIntegerRange: 4}, spdx.PositiveIntegerRange{BeginIntegerRange: 15, EndIntegerRange: 30}},
+	}
+	for _, tt := range tests {
+		got, err := spdx.ByteRangeOfLines(content, &tt.lines)
+		if err != nil || *got != tt.bytes {
+			t.Errorf("ByteRangeOfLines(%v) = %v, %v; want %v", tt.lines, got, err, tt.bytes)
+		}
+		got, err = spdx.LineRangeOfBytes(content, &tt.bytes)
+		if err != nil || *got != tt.lines {
+			t.Errorf("LineRangeOfBytes(%v) = %v, %v; want %v", tt.bytes, got, err, tt.lines)
+		}
+	}
+
+	if got, err := spdx.LineRangeOfBytes(content, spdx.NewPositiveIntegerRange(20, 22)); err != nil || *got != *spdx.NewPositiveIntegerRange(3, 3) {
+		t.Errorf("LineRangeOfBytes(20-22) = %v, %v; want 3-3", got, err)
+	}
+	if _, err := spdx.ByteRangeOfLines(content, spdx.NewPositiveIntegerRange(4, 5)); err == nil {
+		t.Error("ByteRangeOfLines(4-5) error = nil, want an error")
+	}
+	if _, err := spdx.LineRangeOfBytes(content, spdx.NewPositiveIntegerRange(1, 31)); err == nil {
+		t.Error("LineRangeOfBytes(1-31) error = nil, want an error")
+	}
+	if _, err := spdx.ByteRangeOfLines(content, spdx.NewPositiveIntegerRange(3, 2)); err == nil {
+		t.Error("ByteRangeOfLines(3-2) error = nil, want an error")
+	}
+}
+
+func TestSnippet_Extract(t *testing.T) {
+	content := "package main\n\nfunc main() {\n}"
+	tests := []struct {
+		name                 string
+		byteRange, lineRange *spdx.PositiveIntegerRange
+		want                 string
+		wantErr              bool
+	}{
+		{name: "bytes", byteRange: spdx.NewPositiveIntegerRange(15, 26), want: "func main() "},
+		{name: "bytes before lines", byteRange: spdx.NewPositiveIntegerRange(1, 7), lineRange: spdx.NewPositiveIntegerRange(3, 4), want: "package"},
+		{name: "lines", lineRange: spdx.NewPositiveIntegerRange(3, 4), want: "func main() {\n}"},
+		{name: "first line", lineRange: spdx.NewPositiveIntegerRange(1, 1), want: "package main\n"},
+		{name: "bytes past the end", byteRange: spdx.NewPositiveIntegerRange(20, 40), wantErr: true},
+		{name: "huge byte range", byteRange: spdx.NewPositiveIntegerRange(1, 1<<62), wantErr: true},
+		{name: "bytes far past the end", byteRange: spdx.NewPositiveIntegerRange(1<<62, 1<<62+10), wantErr: true},
+		{name: "lines past the end", lineRange: spdx.NewPositiveIntegerRange(4, 5), wantErr: true},
+		{name: "unordered", byteRange: spdx.NewPositiveIntegerRange(5, 1), wantErr: true},
+		{name: "no range", wantErr: true},
+	}
+	for _, tt := range tests {
+		s := &spdx.Snippet{ByteRange: tt.byteRange, LineRange: tt.lineRange}
+		got, err := s.Extract(strings.NewReader(content))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Extract() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: Extract() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,414 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+import (
+	"reflect"
+)
+
+// typeRegistry holds the classes of the model by compact JSON-LD type
+// name.
+var typeRegistry = map[string]TypeInfo{
+	"ai_AIPackage": {
+		Name: "ai_AIPackage", IRI: IRIAIPackage, Profile: "AI",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(AIPackage{}),
+		New:  func() interface{} { return &AIPackage{} },
+	},
+	"ai_EnergyConsumption": {
+		Name: "ai_EnergyConsumption", IRI: IRIEnergyConsumption, Profile: "AI",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(EnergyConsumption{}),
+		New:  func() interface{} { return &EnergyConsumption{} },
+	},
+	"ai_EnergyConsumptionDescription": {
+		Name: "ai_EnergyConsumptionDescription", IRI: IRIEnergyConsumptionDescription, Profile: "AI",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(EnergyConsumptionDescription{}),
+		New:  func() interface{} { return &EnergyConsumptionDescription{} },
+	},
+	"build_Build": {
+		Name: "build_Build", IRI: IRIBuild, Profile: "Build",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Build{}),
+		New:  func() interface{} { return &Build{} },
+	},
+	"Agent": {
+		Name: "Agent", IRI: IRIAgent, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Agent{}),
+		New:  func() interface{} { return &Agent{} },
+	},
+	"Annotation": {
+		Name: "Annotation", IRI: IRIAnnotation, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Annotation{}),
+		New:  func() interface{} { return &Annotation{} },
+	},
+	"Artifact": {
+		Name: "Artifact", IRI: IRIArtifact, Profile: "Core",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(Artifact{}),
+		New:  func() interface{} { return &Artifact{} },
+	},
+	"Bom": {
+		Name: "Bom", IRI: IRIBom, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Bom{}),
+		New:  func() interface{} { return &Bom{} },
+	},
+	"Bundle": {
+		Name: "Bundle", IRI: IRIBundle, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Bundle{}),
+		New:  func() interface{} { return &Bundle{} },
+	},
+	"CreationInfo": {
+		Name: "CreationInfo", IRI: IRICreationInfo, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(CreationInfo{}),
+		New:  func() interface{} { return &CreationInfo{} },
+	},
+	"DictionaryEntry": {
+		Name: "DictionaryEntry", IRI: IRIDictionaryEntry, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(DictionaryEntry{}),
+		New:  func() interface{} { return &DictionaryEntry{} },
+	},
+	"Element": {
+		Name: "Element", IRI: IRIElement, Profile: "Core",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(Element{}),
+		New:  func() interface{} { return &Element{} },
+	},
+	"ElementCollection": {
+		Name: "ElementCollection", IRI: IRIElementCollection, Profile: "Core",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(ElementCollection{}),
+		New:  func() interface{} { return &ElementCollection{} },
+	},
+	"ExternalIdentifier": {
+		Name: "ExternalIdentifier", IRI: IRIExternalIdentifier, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(ExternalIdentifier{}),
+		New:  func() interface{} { return &ExternalIdentifier{} },
+	},
+	"ExternalMap": {
+		Name: "ExternalMap", IRI: IRIExternalMap, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(ExternalMap{}),
+		New:  func() interface{} { return &ExternalMap{} },
+	},
+	"ExternalRef": {
+		Name: "ExternalRef", IRI: IRIExternalRef, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(ExternalRef{}),
+		New:  func() interface{} { return &ExternalRef{} },
+	},
+	"Hash": {
+		Name: "Hash", IRI: IRIHash, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(Hash{}),
+		New:  func() interface{} { return &Hash{} },
+	},
+	"IndividualElement": {
+		Name: "IndividualElement", IRI: IRIIndividualElement, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(IndividualElement{}),
+		New:  func() interface{} { return &IndividualElement{} },
+	},
+	"IntegrityMethod": {
+		Name: "IntegrityMethod", IRI: IRIIntegrityMethod, Profile: "Core",
+		Abstract: true, Element: false,
+		Type: reflect.TypeOf(IntegrityMethod{}),
+		New:  func() interface{} { return &IntegrityMethod{} },
+	},
+	"LifecycleScopedRelationship": {
+		Name: "LifecycleScopedRelationship", IRI: IRILifecycleScopedRelationship, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(LifecycleScopedRelationship{}),
+		New:  func() interface{} { return &LifecycleScopedRelationship{} },
+	},
+	"NamespaceMap": {
+		Name: "NamespaceMap", IRI: IRINamespaceMap, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(NamespaceMap{}),
+		New:  func() interface{} { return &NamespaceMap{} },
+	},
+	"Organization": {
+		Name: "Organization", IRI: IRIOrganization, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Organization{}),
+		New:  func() interface{} { return &Organization{} },
+	},
+	"PackageVerificationCode": {
+		Name: "PackageVerificationCode", IRI: IRIPackageVerificationCode, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(PackageVerificationCode{}),
+		New:  func() interface{} { return &PackageVerificationCode{} },
+	},
+	"Person": {
+		Name: "Person", IRI: IRIPerson, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Person{}),
+		New:  func() interface{} { return &Person{} },
+	},
+	"PositiveIntegerRange": {
+		Name: "PositiveIntegerRange", IRI: IRIPositiveIntegerRange, Profile: "Core",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(PositiveIntegerRange{}),
+		New:  func() interface{} { return &PositiveIntegerRange{} },
+	},
+	"Relationship": {
+		Name: "Relationship", IRI: IRIRelationship, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Relationship{}),
+		New:  func() interface{} { return &Relationship{} },
+	},
+	"SoftwareAgent": {
+		Name: "SoftwareAgent", IRI: IRISoftwareAgent, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(SoftwareAgent{}),
+		New:  func() interface{} { return &SoftwareAgent{} },
+	},
+	"SpdxDocument": {
+		Name: "SpdxDocument", IRI: IRISpdxDocument, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(SpdxDocument{}),
+		New:  func() interface{} { return &SpdxDocument{} },
+	},
+	"Tool": {
+		Name: "Tool", IRI: IRITool, Profile: "Core",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Tool{}),
+		New:  func() interface{} { return &Tool{} },
+	},
+	"dataset_DatasetPackage": {
+		Name: "dataset_DatasetPackage", IRI: IRIDatasetPackage, Profile: "Dataset",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(DatasetPackage{}),
+		New:  func() interface{} { return &DatasetPackage{} },
+	},
+	"expandedlicensing_ConjunctiveLicenseSet": {
+		Name: "expandedlicensing_ConjunctiveLicenseSet", IRI: IRIConjunctiveLicenseSet, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(ConjunctiveLicenseSet{}),
+		New:  func() interface{} { return &ConjunctiveLicenseSet{} },
+	},
+	"expandedlicensing_CustomLicense": {
+		Name: "expandedlicensing_CustomLicense", IRI: IRICustomLicense, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(CustomLicense{}),
+		New:  func() interface{} { return &CustomLicense{} },
+	},
+	"expandedlicensing_CustomLicenseAddition": {
+		Name: "expandedlicensing_CustomLicenseAddition", IRI: IRICustomLicenseAddition, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(CustomLicenseAddition{}),
+		New:  func() interface{} { return &CustomLicenseAddition{} },
+	},
+	"expandedlicensing_DisjunctiveLicenseSet": {
+		Name: "expandedlicensing_DisjunctiveLicenseSet", IRI: IRIDisjunctiveLicenseSet, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(DisjunctiveLicenseSet{}),
+		New:  func() interface{} { return &DisjunctiveLicenseSet{} },
+	},
+	"expandedlicensing_ExtendableLicense": {
+		Name: "expandedlicensing_ExtendableLicense", IRI: IRIExtendableLicense, Profile: "ExpandedLicensing",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(ExtendableLicense{}),
+		New:  func() interface{} { return &ExtendableLicense{} },
+	},
+	"expandedlicensing_IndividualLicensingInfo": {
+		Name: "expandedlicensing_IndividualLicensingInfo", IRI: IRIIndividualLicensingInfo, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(IndividualLicensingInfo{}),
+		New:  func() interface{} { return &IndividualLicensingInfo{} },
+	},
+	"expandedlicensing_License": {
+		Name: "expandedlicensing_License", IRI: IRILicense, Profile: "ExpandedLicensing",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(License{}),
+		New:  func() interface{} { return &License{} },
+	},
+	"expandedlicensing_LicenseAddition": {
+		Name: "expandedlicensing_LicenseAddition", IRI: IRILicenseAddition, Profile: "ExpandedLicensing",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(LicenseAddition{}),
+		New:  func() interface{} { return &LicenseAddition{} },
+	},
+	"expandedlicensing_ListedLicense": {
+		Name: "expandedlicensing_ListedLicense", IRI: IRIListedLicense, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(ListedLicense{}),
+		New:  func() interface{} { return &ListedLicense{} },
+	},
+	"expandedlicensing_ListedLicenseException": {
+		Name: "expandedlicensing_ListedLicenseException", IRI: IRIListedLicenseException, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(ListedLicenseException{}),
+		New:  func() interface{} { return &ListedLicenseException{} },
+	},
+	"expandedlicensing_OrLaterOperator": {
+		Name: "expandedlicensing_OrLaterOperator", IRI: IRIOrLaterOperator, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(OrLaterOperator{}),
+		New:  func() interface{} { return &OrLaterOperator{} },
+	},
+	"expandedlicensing_WithAdditionOperator": {
+		Name: "expandedlicensing_WithAdditionOperator", IRI: IRIWithAdditionOperator, Profile: "ExpandedLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(WithAdditionOperator{}),
+		New:  func() interface{} { return &WithAdditionOperator{} },
+	},
+	"extension_CdxPropertiesExtension": {
+		Name: "extension_CdxPropertiesExtension", IRI: IRICdxPropertiesExtension, Profile: "Extension",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(CdxPropertiesExtension{}),
+		New:  func() interface{} { return &CdxPropertiesExtension{} },
+	},
+	"extension_CdxPropertyEntry": {
+		Name: "extension_CdxPropertyEntry", IRI: IRICdxPropertyEntry, Profile: "Extension",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(CdxPropertyEntry{}),
+		New:  func() interface{} { return &CdxPropertyEntry{} },
+	},
+	"extension_Extension": {
+		Name: "extension_Extension", IRI: IRIExtension, Profile: "Extension",
+		Abstract: true, Element: false,
+		Type: reflect.TypeOf(Extension{}),
+		New:  func() interface{} { return &Extension{} },
+	},
+	"security_CvssV2VulnAssessmentRelationship": {
+		Name: "security_CvssV2VulnAssessmentRelationship", IRI: IRICvssV2VulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(CvssV2VulnAssessmentRelationship{}),
+		New:  func() interface{} { return &CvssV2VulnAssessmentRelationship{} },
+	},
+	"security_CvssV3VulnAssessmentRelationship": {
+		Name: "security_CvssV3VulnAssessmentRelationship", IRI: IRICvssV3VulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(CvssV3VulnAssessmentRelationship{}),
+		New:  func() interface{} { return &CvssV3VulnAssessmentRelationship{} },
+	},
+	"security_CvssV4VulnAssessmentRelationship": {
+		Name: "security_CvssV4VulnAssessmentRelationship", IRI: IRICvssV4VulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(CvssV4VulnAssessmentRelationship{}),
+		New:  func() interface{} { return &CvssV4VulnAssessmentRelationship{} },
+	},
+	"security_EpssVulnAssessmentRelationship": {
+		Name: "security_EpssVulnAssessmentRelationship", IRI: IRIEpssVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(EpssVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &EpssVulnAssessmentRelationship{} },
+	},
+	"security_ExploitCatalogVulnAssessmentRelationship": {
+		Name: "security_ExploitCatalogVulnAssessmentRelationship", IRI: IRIExploitCatalogVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(ExploitCatalogVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &ExploitCatalogVulnAssessmentRelationship{} },
+	},
+	"security_SsvcVulnAssessmentRelationship": {
+		Name: "security_SsvcVulnAssessmentRelationship", IRI: IRISsvcVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(SsvcVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &SsvcVulnAssessmentRelationship{} },
+	},
+	"security_VexAffectedVulnAssessmentRelationship": {
+		Name: "security_VexAffectedVulnAssessmentRelationship", IRI: IRIVexAffectedVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(VexAffectedVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &VexAffectedVulnAssessmentRelationship{} },
+	},
+	"security_VexFixedVulnAssessmentRelationship": {
+		Name: "security_VexFixedVulnAssessmentRelationship", IRI: IRIVexFixedVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(VexFixedVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &VexFixedVulnAssessmentRelationship{} },
+	},
+	"security_VexNotAffectedVulnAssessmentRelationship": {
+		Name: "security_VexNotAffectedVulnAssessmentRelationship", IRI: IRIVexNotAffectedVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(VexNotAffectedVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &VexNotAffectedVulnAssessmentRelationship{} },
+	},
+	"security_VexUnderInvestigationVulnAssessmentRelationship": {
+		Name: "security_VexUnderInvestigationVulnAssessmentRelationship", IRI: IRIVexUnderInvestigationVulnAssessmentRelationship, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(VexUnderInvestigationVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &VexUnderInvestigationVulnAssessmentRelationship{} },
+	},
+	"security_VexVulnAssessmentRelationship": {
+		Name: "security_VexVulnAssessmentRelationship", IRI: IRIVexVulnAssessmentRelationship, Profile: "Security",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(VexVulnAssessmentRelationship{}),
+		New:  func() interface{} { return &VexVulnAssessmentRelationship{} },
+	},
+	"security_VulnAssessmentRelationship": {
+		Name: "security_VulnAssessmentRelationship", IRI: IRIVulnAssessmentRelationship, Profile: "Security",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(VulnAssessmentRelationship{}),
+		New:  func() interface{} { return &VulnAssessmentRelationship{} },
+	},
+	"security_Vulnerability": {
+		Name: "security_Vulnerability", IRI: IRIVulnerability, Profile: "Security",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Vulnerability{}),
+		New:  func() interface{} { return &Vulnerability{} },
+	},
+	"simplelicensing_AnyLicenseInfo": {
+		Name: "simplelicensing_AnyLicenseInfo", IRI: IRIAnyLicenseInfo, Profile: "SimpleLicensing",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(AnyLicenseInfo{}),
+		New:  func() interface{} { return &AnyLicenseInfo{} },
+	},
+	"simplelicensing_LicenseExpression": {
+		Name: "simplelicensing_LicenseExpression", IRI: IRILicenseExpression, Profile: "SimpleLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(LicenseExpression{}),
+		New:  func() interface{} { return &LicenseExpression{} },
+	},
+	"simplelicensing_SimpleLicensingText": {
+		Name: "simplelicensing_SimpleLicensingText", IRI: IRISimpleLicensingText, Profile: "SimpleLicensing",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(SimpleLicensingText{}),
+		New:  func() interface{} { return &SimpleLicensingText{} },
+	},
+	"software_ContentIdentifier": {
+		Name: "software_ContentIdentifier", IRI: IRIContentIdentifier, Profile: "Software",
+		Abstract: false, Element: false,
+		Type: reflect.TypeOf(ContentIdentifier{}),
+		New:  func() interface{} { return &ContentIdentifier{} },
+	},
+	"software_File": {
+		Name: "software_File", IRI: IRIFile, Profile: "Software",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(File{}),
+		New:  func() interface{} { return &File{} },
+	},
+	"software_Package": {
+		Name: "software_Package", IRI: IRIPackage, Profile: "Software",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Package{}),
+		New:  func() interface{} { return &Package{} },
+	},
+	"software_Sbom": {
+		Name: "software_Sbom", IRI: IRISbom, Profile: "Software",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Sbom{}),
+		New:  func() interface{} { return &Sbom{} },
+	},
+	"software_Snippet": {
+		Name: "software_Snippet", IRI: IRISnippet, Profile: "Software",
+		Abstract: false, Element: true,
+		Type: reflect.TypeOf(Snippet{}),
+		New:  func() interface{} { return &Snippet{} },
+	},
+	"software_SoftwareArtifact": {
+		Name: "software_SoftwareArtifact", IRI: IRISoftwareArtifact, Profile: "Software",
+		Abstract: true, Element: true,
+		Type: reflect.TypeOf(SoftwareArtifact{}),
+		New:  func() interface{} { return &SoftwareArtifact{} },
+	},
+}
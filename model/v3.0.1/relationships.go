@@ -0,0 +1,90 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+// inverseNames are the names of the inverses of the relationship types.
+// SPDX 3 defines each relationship in one direction only; the names, in
+// the style of SPDX 2 and of the types themselves, say how the To
+// elements relate back to the From element.
+var inverseNames = map[RelationshipType]string{
+	RelationshipTypeAffects:                    "affectedBy",
+	RelationshipTypeAmendedBy:                  "amends",
+	RelationshipTypeAncestorOf:                 "descendantOf",
+	RelationshipTypeAvailableFrom:              "providesAvailabilityOf",
+	RelationshipTypeConfigures:                 "configuredBy",
+	RelationshipTypeContains:                   "containedBy",
+	RelationshipTypeCoordinatedBy:              "coordinates",
+	RelationshipTypeCopiedTo:                   "copiedFrom",
+	RelationshipTypeDelegatedTo:                "delegatedFrom",
+	RelationshipTypeDependsOn:                  "dependencyOf",
+	RelationshipTypeDescendantOf:               "ancestorOf",
+	RelationshipTypeDescribes:                  "describedBy",
+	RelationshipTypeDoesNotAffect:              "notAffectedBy",
+	RelationshipTypeExpandsTo:                  "expandedFrom",
+	RelationshipTypeExploitCreatedBy:           "createdExploitFor",
+	RelationshipTypeFixedBy:                    "fixes",
+	RelationshipTypeFixedIn:                    "fixOf",
+	RelationshipTypeFoundBy:                    "found",
+	RelationshipTypeGenerates:                  "generatedFrom",
+	RelationshipTypeHasAddedFile:               "addedFileOf",
+	RelationshipTypeHasAssessmentFor:           "assessedBy",
+	RelationshipTypeHasAssociatedVulnerability: "associatedVulnerabilityOf",
+	RelationshipTypeHasConcludedLicense:        "concludedLicenseOf",
+	RelationshipTypeHasDataFile:                "dataFileOf",
+	RelationshipTypeHasDeclaredLicense:         "declaredLicenseOf",
+	RelationshipTypeHasDeletedFile:             "deletedFileOf",
+	RelationshipTypeHasDependencyManifest:      "dependencyManifestOf",
+	RelationshipTypeHasDistributionArtifact:    "distributionArtifactOf",
+	RelationshipTypeHasDocumentation:           "documentationOf",
+	RelationshipTypeHasDynamicLink:             "dynamicLinkOf",
+	RelationshipTypeHasEvidence:                "evidenceFor",
+	RelationshipTypeHasExample:                 "exampleOf",
+	RelationshipTypeHasHost:                    "hostOf",
+	RelationshipTypeHasInput:                   "inputOf",
+	RelationshipTypeHasMetadata:                "metadataOf",
+	RelationshipTypeHasOptionalComponent:       "optionalComponentOf",
+	RelationshipTypeHasOptionalDependency:      "optionalDependencyOf",
+	RelationshipTypeHasOutput:                  "outputOf",
+	RelationshipTypeHasPrerequisite:            "prerequisiteFor",
+	RelationshipTypeHasProvidedDependency:      "providedDependencyOf",
+	RelationshipTypeHasRequirement:             "requirementFor",
+	RelationshipTypeHasSpecification:           "specificationFor",
+	RelationshipTypeHasStaticLink:              "staticLinkOf",
+	RelationshipTypeHasTest:                    "testOf",
+	RelationshipTypeHasTestCase:                "testCaseOf",
+	RelationshipTypeHasVariant:                 "variantOf",
+	RelationshipTypeInvokedBy:                  "invokes",
+	RelationshipTypeModifiedBy:                 "modifies",
+	RelationshipTypeOther:                      "other",
+	RelationshipTypePackagedBy:                 "packages",
+	RelationshipTypePatchedBy:                  "patches",
+	RelationshipTypePublishedBy:                "publishes",
+	RelationshipTypeReportedBy:                 "reported",
+	RelationshipTypeRepublishedBy:              "republishes",
+	RelationshipTypeSerializedInArtifact:       "serializationOf",
+	RelationshipTypeTestedOn:                   "testPlatformOf",
+	RelationshipTypeTrainedOn:                  "trainingDataOf",
+	RelationshipTypeUnderInvestigationFor:      "investigatedFor",
+	RelationshipTypeUsesTool:                   "toolOf",
+}
+
+// InverseName returns the name of the inverse of the relationship type,
+// such as "containedBy" for contains and "dependencyOf" for dependsOn, or
+// an empty string for values that are not relationship types. Only
+// ancestorOf and descendantOf are each other's inverse in SPDX 3; the
+// other names are not relationship types.
+func (v RelationshipType) InverseName() string {
+	return inverseNames[v]
+}
@@ -16,7 +16,7 @@ package spdx
 
 import "time"
 
-//go:generate go run ../../cmd/spdx-gen -spec ../../docs/spdx-model.json-ld -out . -pkg spdx
+//go:generate go run ../../cmd/spdx-gen -spec ../../docs/spdx-model.json-ld -out . -pkg spdx -parser-out ../../parse/internal/parser -model-import github.com/interlynk-io/spdx-zen/model/v3.0.1 -fixtures-out ../../parse/testdata/golden -schema-out ../../docs/spdx-schema.json -proto-out ../../docs/spdx.proto
 
 const (
 	// SpecVersion is the SPDX specification version this package implements.
@@ -26,116 +26,6 @@ const (
 	ContextURL = "https://spdx.org/rdf/3.0.1/spdx-context.jsonld"
 )
 
-// ElementInterface defines the common interface for all SPDX elements.
-// This interface is implemented by all Element types and can be used
-// for version-agnostic code.
-type ElementInterface interface {
-	GetSpdxID() string
-	GetName() string
-	GetCreationInfo() *CreationInfo
-}
-
-// GetSpdxID returns the SPDX identifier of the element.
-func (e *Element) GetSpdxID() string {
-	return e.SpdxID
-}
-
-// GetName returns the name of the element.
-func (e *Element) GetName() string {
-	return e.Name
-}
-
-// GetCreationInfo returns the creation information of the element.
-func (e *Element) GetCreationInfo() *CreationInfo {
-	return &e.CreationInfo
-}
-
-// ArtifactInterface defines the interface for artifact elements.
-type ArtifactInterface interface {
-	ElementInterface
-	GetOriginatedBy() []Agent
-	GetSuppliedBy() *Agent
-}
-
-// GetOriginatedBy returns the agents that originated this artifact.
-func (a *Artifact) GetOriginatedBy() []Agent {
-	return a.OriginatedBy
-}
-
-// GetSuppliedBy returns the agent that supplied this artifact.
-func (a *Artifact) GetSuppliedBy() *Agent {
-	return a.SuppliedBy
-}
-
-// SoftwareArtifactInterface defines the interface for software artifact elements.
-type SoftwareArtifactInterface interface {
-	ArtifactInterface
-	GetPrimaryPurpose() SoftwarePurpose
-	GetAdditionalPurpose() []SoftwarePurpose
-	GetCopyrightText() string
-}
-
-// GetPrimaryPurpose returns the primary purpose of the software artifact.
-func (sa *SoftwareArtifact) GetPrimaryPurpose() SoftwarePurpose {
-	return sa.PrimaryPurpose
-}
-
-// GetAdditionalPurpose returns additional purposes of the software artifact.
-func (sa *SoftwareArtifact) GetAdditionalPurpose() []SoftwarePurpose {
-	return sa.AdditionalPurpose
-}
-
-// GetCopyrightText returns the copyright text of the software artifact.
-func (sa *SoftwareArtifact) GetCopyrightText() string {
-	return sa.CopyrightText
-}
-
-// PackageInterface defines the interface for package elements.
-type PackageInterface interface {
-	SoftwareArtifactInterface
-	GetPackageVersion() string
-	GetPackageURL() string
-	GetDownloadLocation() string
-}
-
-// GetPackageVersion returns the version of the package.
-func (p *Package) GetPackageVersion() string {
-	return p.PackageVersion
-}
-
-// GetPackageURL returns the package URL (PURL) of the package.
-func (p *Package) GetPackageURL() string {
-	return p.PackageUrl
-}
-
-// GetDownloadLocation returns the download location of the package.
-func (p *Package) GetDownloadLocation() string {
-	return p.DownloadLocation
-}
-
-// RelationshipInterface defines the interface for relationship elements.
-type RelationshipInterface interface {
-	ElementInterface
-	GetFrom() Element
-	GetTo() []Element
-	GetRelationshipType() RelationshipType
-}
-
-// GetFrom returns the source element of the relationship.
-func (r *Relationship) GetFrom() Element {
-	return r.From
-}
-
-// GetTo returns the target elements of the relationship.
-func (r *Relationship) GetTo() []Element {
-	return r.To
-}
-
-// GetRelationshipType returns the type of the relationship.
-func (r *Relationship) GetRelationshipType() RelationshipType {
-	return r.RelationshipType
-}
-
 // NewCreationInfo creates a new CreationInfo with required fields.
 func NewCreationInfo(createdBy []Agent) CreationInfo {
 	return CreationInfo{
@@ -249,12 +139,23 @@ func (e *Element) WithCPE(cpe string) *Element {
 }
 
 // AddHash adds a hash to the element's verification methods.
-// TODO: Implement properly when VerifiedUsing supports Hash type.
-func (e *Element) AddHash(_ HashAlgorithm, _ string) *Element {
-	e.VerifiedUsing = append(e.VerifiedUsing, IntegrityMethod{})
+func (e *Element) AddHash(algorithm HashAlgorithm, hashValue string) *Element {
+	h := NewHash(algorithm, hashValue)
+	e.VerifiedUsing = append(e.VerifiedUsing, &h)
 	return e
 }
 
+// Hashes returns the hashes among the element's verification methods.
+func (e *Element) Hashes() []*Hash {
+	var hashes []*Hash
+	for _, m := range e.VerifiedUsing {
+		if h, ok := m.(*Hash); ok {
+			hashes = append(hashes, h)
+		}
+	}
+	return hashes
+}
+
 // HasPURL returns true if the element has a PURL external identifier.
 func (e *Element) HasPURL() bool {
 	for _, ei := range e.ExternalIdentifier {
@@ -361,85 +262,3 @@ func (r *Relationship) IsBuildRelationship() bool {
 	}
 	return false
 }
-
-// relationshipTypeMap maps uppercase underscore format to camelCase format.
-var relationshipTypeMap = map[string]RelationshipType{
-	"AFFECTS":                      RelationshipTypeAffects,
-	"AMENDED_BY":                   RelationshipTypeAmendedBy,
-	"ANCESTOR_OF":                  RelationshipTypeAncestorOf,
-	"AVAILABLE_FROM":               RelationshipTypeAvailableFrom,
-	"CONFIGURES":                   RelationshipTypeConfigures,
-	"CONTAINS":                     RelationshipTypeContains,
-	"COORDINATED_BY":               RelationshipTypeCoordinatedBy,
-	"COPIED_TO":                    RelationshipTypeCopiedTo,
-	"DELEGATED_TO":                 RelationshipTypeDelegatedTo,
-	"DEPENDS_ON":                   RelationshipTypeDependsOn,
-	"DESCENDANT_OF":                RelationshipTypeDescendantOf,
-	"DESCRIBES":                    RelationshipTypeDescribes,
-	"DOES_NOT_AFFECT":              RelationshipTypeDoesNotAffect,
-	"EXPANDS_TO":                   RelationshipTypeExpandsTo,
-	"EXPLOIT_CREATED_BY":           RelationshipTypeExploitCreatedBy,
-	"FIXED_BY":                     RelationshipTypeFixedBy,
-	"FIXED_IN":                     RelationshipTypeFixedIn,
-	"FOUND_BY":                     RelationshipTypeFoundBy,
-	"GENERATES":                    RelationshipTypeGenerates,
-	"HAS_ADDED_FILE":               RelationshipTypeHasAddedFile,
-	"HAS_ASSESSMENT_FOR":           RelationshipTypeHasAssessmentFor,
-	"HAS_ASSOCIATED_VULNERABILITY": RelationshipTypeHasAssociatedVulnerability,
-	"HAS_CONCLUDED_LICENSE":        RelationshipTypeHasConcludedLicense,
-	"HAS_DATA_FILE":                RelationshipTypeHasDataFile,
-	"HAS_DECLARED_LICENSE":         RelationshipTypeHasDeclaredLicense,
-	"HAS_DELETED_FILE":             RelationshipTypeHasDeletedFile,
-	"HAS_DEPENDENCY_MANIFEST":      RelationshipTypeHasDependencyManifest,
-	"HAS_DISTRIBUTION_ARTIFACT":    RelationshipTypeHasDistributionArtifact,
-	"HAS_DOCUMENTATION":            RelationshipTypeHasDocumentation,
-	"HAS_DYNAMIC_LINK":             RelationshipTypeHasDynamicLink,
-	"HAS_EVIDENCE":                 RelationshipTypeHasEvidence,
-	"HAS_EXAMPLE":                  RelationshipTypeHasExample,
-	"HAS_HOST":                     RelationshipTypeHasHost,
-	"HAS_INPUT":                    RelationshipTypeHasInput,
-	"HAS_METADATA":                 RelationshipTypeHasMetadata,
-	"HAS_OPTIONAL_COMPONENT":       RelationshipTypeHasOptionalComponent,
-	"HAS_OPTIONAL_DEPENDENCY":      RelationshipTypeHasOptionalDependency,
-	"HAS_OUTPUT":                   RelationshipTypeHasOutput,
-	"HAS_PREREQUISITE":             RelationshipTypeHasPrerequisite,
-	"HAS_PROVIDED_DEPENDENCY":      RelationshipTypeHasProvidedDependency,
-	"HAS_REQUIREMENT":              RelationshipTypeHasRequirement,
-	"HAS_SPECIFICATION":            RelationshipTypeHasSpecification,
-	"HAS_STATIC_LINK":              RelationshipTypeHasStaticLink,
-	"HAS_TEST":                     RelationshipTypeHasTest,
-	"HAS_TEST_CASE":                RelationshipTypeHasTestCase,
-	"HAS_VARIANT":                  RelationshipTypeHasVariant,
-	"INVOKED_BY":                   RelationshipTypeInvokedBy,
-	"MODIFIED_BY":                  RelationshipTypeModifiedBy,
-	"OTHER":                        RelationshipTypeOther,
-	"PACKAGED_BY":                  RelationshipTypePackagedBy,
-	"PATCHED_BY":                   RelationshipTypePatchedBy,
-	"PUBLISHED_BY":                 RelationshipTypePublishedBy,
-	"REPORTED_BY":                  RelationshipTypeReportedBy,
-	"REPUBLISHED_BY":               RelationshipTypeRepublishedBy,
-	"SERIALIZED_IN_ARTIFACT":       RelationshipTypeSerializedInArtifact,
-	"TESTED_ON":                    RelationshipTypeTestedOn,
-	"TRAINED_ON":                   RelationshipTypeTrainedOn,
-	"UNDER_INVESTIGATION_FOR":      RelationshipTypeUnderInvestigationFor,
-	"USES_TOOL":                    RelationshipTypeUsesTool,
-}
-
-// NormalizeRelationshipType converts a relationship type string to the canonical
-// camelCase format used in the SPDX 3.0.1 model. It accepts both the camelCase
-// format (e.g., "dependsOn") and the uppercase underscore format (e.g., "DEPENDS_ON").
-func NormalizeRelationshipType(s string) RelationshipType {
-	// First check if it's already a valid camelCase type
-	rt := RelationshipType(s)
-	if rt.IsValid() {
-		return rt
-	}
-
-	// Try to map from uppercase underscore format
-	if normalized, ok := relationshipTypeMap[s]; ok {
-		return normalized
-	}
-
-	// Return as-is (will be invalid but preserves the original value)
-	return rt
-}
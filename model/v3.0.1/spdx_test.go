@@ -1,6 +1,13 @@
 package spdx_test
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -189,6 +196,28 @@ func TestRelationshipType_IsValid(t *testing.T) {
 	}
 }
 
+func TestRelationshipType_InverseName(t *testing.T) {
+	tests := []struct {
+		relType spdx.RelationshipType
+		want    string
+	}{
+		{spdx.RelationshipTypeContains, "containedBy"},
+		{spdx.RelationshipTypeDependsOn, "dependencyOf"},
+		{spdx.RelationshipTypeAncestorOf, "descendantOf"},
+		{spdx.RelationshipTypeDescendantOf, "ancestorOf"},
+		{spdx.RelationshipTypeHasStaticLink, "staticLinkOf"},
+		{spdx.RelationshipType("invalid"), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.relType), func(t *testing.T) {
+			if got := tt.relType.InverseName(); got != tt.want {
+				t.Errorf("InverseName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestHashAlgorithm_IsValid(t *testing.T) {
 	tests := []struct {
 		algo  spdx.HashAlgorithm
@@ -253,6 +282,34 @@ func TestNewHash(t *testing.T) {
 	}
 }
 
+func TestElement_AddHash(t *testing.T) {
+	pkg := spdx.NewPackage("urn:spdx:pkg-1", "pkg", "1.0.0", spdx.CreationInfo{})
+	pkg.AddHash(spdx.HashAlgorithmSha256, "abc123")
+
+	out, err := json.Marshal(pkg)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(out), `"verifiedUsing":[{"type":"Hash","algorithm":"sha256","hashValue":"abc123"}]`) {
+		t.Errorf("verifiedUsing not encoded as a Hash: %s", out)
+	}
+
+	var decoded spdx.Package
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	hashes := decoded.Hashes()
+	if len(hashes) != 1 || hashes[0].Algorithm != spdx.HashAlgorithmSha256 || hashes[0].HashValue != "abc123" {
+		t.Errorf("Hashes() = %+v, want the sha256 hash", hashes)
+	}
+
+	c := decoded.Copy()
+	c.Hashes()[0].HashValue = "changed"
+	if decoded.Hashes()[0].HashValue != "abc123" {
+		t.Error("modifying the hash of the copy changed the original")
+	}
+}
+
 // Test interface implementations
 func TestElementInterface(_ *testing.T) {
 	var _ spdx.ElementInterface = &spdx.Element{}
@@ -277,6 +334,31 @@ func TestRelationshipInterface(_ *testing.T) {
 	var _ spdx.RelationshipInterface = &spdx.Relationship{}
 }
 
+func TestGeneratedInterfaces(t *testing.T) {
+	var _ spdx.PackageInterface = &spdx.AIPackage{}
+	var _ spdx.PackageInterface = &spdx.DatasetPackage{}
+	var _ spdx.VulnAssessmentRelationshipInterface = &spdx.VexAffectedVulnAssessmentRelationship{}
+
+	ai := &spdx.AIPackage{}
+	ai.PackageVersion = "1.0"
+	ai.Domain = []string{"vision"}
+	ai.EnergyConsumption = &spdx.EnergyConsumption{}
+
+	var iface spdx.AIPackageInterface = ai
+	if iface.GetPackageVersion() != "1.0" {
+		t.Errorf("GetPackageVersion() = %q, want %q", iface.GetPackageVersion(), "1.0")
+	}
+	if len(iface.GetDomain()) != 1 || iface.GetEnergyConsumption() == nil {
+		t.Error("expected AI package properties through the interface")
+	}
+
+	// Nested objects are returned by pointer and can be modified in place.
+	iface.GetCreationInfo().SpecVersion = spdx.SpecVersion
+	if ai.CreationInfo.SpecVersion != spdx.SpecVersion {
+		t.Error("expected GetCreationInfo to return a pointer into the element")
+	}
+}
+
 func TestRelationship_IsLicenseRelationship(t *testing.T) {
 	tests := []struct {
 		relType spdx.RelationshipType
@@ -366,6 +448,13 @@ func TestNormalizeRelationshipType(t *testing.T) {
 		{"HAS_PREREQUISITE", spdx.RelationshipTypeHasPrerequisite},
 		{"HAS_PROVIDED_DEPENDENCY", spdx.RelationshipTypeHasProvidedDependency},
 
+		// Other spellings of the uppercase underscore format
+		{"depends_on", spdx.RelationshipTypeDependsOn},
+		{"HAS-DECLARED-LICENSE", spdx.RelationshipTypeHasDeclaredLicense},
+
+		// Full IRI
+		{"https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType/dependsOn", spdx.RelationshipTypeDependsOn},
+
 		// Invalid - should return as-is
 		{"invalid", spdx.RelationshipType("invalid")},
 		{"", spdx.RelationshipType("")},
@@ -380,3 +469,744 @@ func TestNormalizeRelationshipType(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeEnums(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"hash algorithm", string(spdx.NormalizeHashAlgorithm("SHA256")), string(spdx.HashAlgorithmSha256)},
+		{"hash algorithm with underscore", string(spdx.NormalizeHashAlgorithm("SHA3_256")), string(spdx.HashAlgorithmSha3256)},
+		{"purpose", string(spdx.NormalizeSoftwarePurpose("OPERATING_SYSTEM")), string(spdx.SoftwarePurposeOperatingSystem)},
+		{"external ref type", string(spdx.NormalizeExternalRefType("SECURITY_ADVISORY")), string(spdx.ExternalRefTypeSecurityAdvisory)},
+		{"IRI", string(spdx.NormalizePresenceType("https://spdx.org/rdf/3.0.1/terms/Core/PresenceType/yes")), string(spdx.PresenceTypeYes)},
+		{"unknown", string(spdx.NormalizeHashAlgorithm("crc32")), "crc32"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoAssertion(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{spdx.NoAssertionElementIRI, true},
+		{spdx.NoAssertionElementTerm, true},
+		{spdx.NoAssertionLicenseIRI, true},
+		{spdx.NoAssertionLicenseTerm, true},
+		{"https://spdx.org/rdf/3.0.1/terms/Licensing/NoAssertion", true},
+		{"NOASSERTION", true},
+		{spdx.NoneElementIRI, false},
+		{"noassertion", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := spdx.IsNoAssertion(tt.input); got != tt.want {
+				t.Errorf("IsNoAssertion(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNone(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{spdx.NoneElementIRI, true},
+		{spdx.NoneElementTerm, true},
+		{spdx.NoneLicenseIRI, true},
+		{spdx.NoneLicenseTerm, true},
+		{"NONE", true},
+		{spdx.NoAssertionLicenseIRI, false},
+		{"none", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := spdx.IsNone(tt.input); got != tt.want {
+				t.Errorf("IsNone(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRefs(t *testing.T) {
+	tests := []struct {
+		input       string
+		wantElement string
+		wantLicense string
+	}{
+		{spdx.NoAssertionElementTerm, spdx.NoAssertionElementIRI, spdx.NoAssertionElementIRI},
+		{spdx.NoneLicenseTerm, spdx.NoneLicenseIRI, spdx.NoneLicenseIRI},
+		{"NOASSERTION", "NOASSERTION", spdx.NoAssertionLicenseIRI},
+		{"NONE", "NONE", spdx.NoneLicenseIRI},
+		{"urn:spdx:pkg-1", "urn:spdx:pkg-1", "urn:spdx:pkg-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := spdx.NormalizeElementRef(tt.input); got != tt.wantElement {
+				t.Errorf("NormalizeElementRef(%q) = %q, want %q", tt.input, got, tt.wantElement)
+			}
+			if got := spdx.NormalizeLicenseRef(tt.input); got != tt.wantLicense {
+				t.Errorf("NormalizeLicenseRef(%q) = %q, want %q", tt.input, got, tt.wantLicense)
+			}
+		})
+	}
+
+	if got := spdx.CompactIndividual(spdx.NoneElementIRI); got != spdx.NoneElementTerm {
+		t.Errorf("CompactIndividual() = %q, want %q", got, spdx.NoneElementTerm)
+	}
+}
+
+func TestElement_IsNoAssertion(t *testing.T) {
+	if !(&spdx.Element{SpdxID: spdx.NoAssertionElementIRI}).IsNoAssertion() {
+		t.Error("IsNoAssertion() should return true for NoAssertionElement")
+	}
+	if !(&spdx.Element{SpdxID: "SPDXRef-Organization-1", Name: "NOASSERTION"}).IsNoAssertion() {
+		t.Error("IsNoAssertion() should return true for elements named NOASSERTION")
+	}
+	if (&spdx.Element{SpdxID: "SPDXRef-Organization-1", Name: "ACME"}).IsNoAssertion() {
+		t.Error("IsNoAssertion() should return false for regular elements")
+	}
+	if !(&spdx.Element{SpdxID: spdx.NoneElementIRI}).IsNone() {
+		t.Error("IsNone() should return true for NoneElement")
+	}
+}
+
+func TestAnyElement_AsHelpers(t *testing.T) {
+	ci := spdx.CreationInfo{SpecVersion: spdx.SpecVersion}
+	pkg := spdx.NewPackage("urn:spdx:pkg-1", "pkg", "1.0.0", ci)
+	aiPkg := &spdx.AIPackage{Package: *spdx.NewPackage("urn:spdx:ai-1", "model", "2.0", ci)}
+	file := spdx.NewFile("urn:spdx:file-1", "main.go", ci)
+	vex := &spdx.VexAffectedVulnAssessmentRelationship{}
+	vex.SpdxID = "urn:spdx:vex-1"
+
+	elems := []spdx.AnyElement{pkg, aiPkg, file, vex}
+
+	if got, ok := spdx.AsPackage(pkg); !ok || got != pkg {
+		t.Error("AsPackage() should return the package itself")
+	}
+	if got, ok := spdx.AsPackage(aiPkg); !ok || got != &aiPkg.Package {
+		t.Error("AsPackage() should return the embedded Package of an AIPackage")
+	}
+	if _, ok := spdx.AsPackage(file); ok {
+		t.Error("AsPackage() should fail for a File")
+	}
+	if _, ok := spdx.AsAIPackage(pkg); ok {
+		t.Error("AsAIPackage() should fail for a plain Package")
+	}
+	if got, ok := spdx.AsRelationship(vex); !ok || got.SpdxID != "urn:spdx:vex-1" {
+		t.Error("AsRelationship() should succeed for VEX assessments")
+	}
+	if _, ok := spdx.AsVulnAssessment(vex); !ok {
+		t.Error("AsVulnAssessment() should succeed for VEX assessments")
+	}
+
+	artifacts := 0
+	for _, e := range elems {
+		if _, ok := spdx.AsSoftwareArtifact(e); ok {
+			artifacts++
+		}
+		if spdx.AsElement(e).SpdxID != e.GetSpdxID() {
+			t.Errorf("AsElement() SpdxID mismatch for %s", e.GetSpdxID())
+		}
+	}
+	if artifacts != 3 {
+		t.Errorf("expected 3 software artifacts, got %d", artifacts)
+	}
+}
+
+func TestRelationship_Validate(t *testing.T) {
+	ci := spdx.NewCreationInfo([]spdx.Agent{
+		{Element: spdx.Element{SpdxID: "urn:spdx:agent-1", Name: "Test Agent"}},
+	})
+
+	tests := []struct {
+		name      string
+		rel       *spdx.Relationship
+		wantProps []string
+	}{
+		{
+			name: "valid",
+			rel: &spdx.Relationship{
+				Element:          spdx.Element{SpdxID: "urn:spdx:rel-1", CreationInfo: ci},
+				From:             spdx.Element{SpdxID: "urn:spdx:pkg-1"},
+				To:               []spdx.Element{{SpdxID: "urn:spdx:pkg-2"}},
+				RelationshipType: spdx.RelationshipTypeDependsOn,
+			},
+		},
+		{
+			name:      "missing required properties",
+			rel:       &spdx.Relationship{Element: spdx.Element{SpdxID: "urn:spdx:rel-1"}},
+			wantProps: []string{"creationInfo", "from", "to", "relationshipType"},
+		},
+		{
+			name: "invalid enum and blank node reference",
+			rel: &spdx.Relationship{
+				Element:          spdx.Element{SpdxID: "urn:spdx:rel-1", CreationInfo: ci},
+				From:             spdx.Element{SpdxID: "_:pkg"},
+				To:               []spdx.Element{{SpdxID: "urn:spdx:pkg-2"}},
+				RelationshipType: "uses",
+			},
+			wantProps: []string{"from", "relationshipType"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rel.Validate()
+			if len(tt.wantProps) == 0 {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Validate() error = nil, want violations")
+			}
+
+			var got []string
+			for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+				var ve *spdx.ValidationError
+				if !errors.As(e, &ve) {
+					t.Fatalf("unexpected error type %T", e)
+				}
+				got = append(got, ve.Property)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.wantProps, ",") {
+				t.Errorf("violations = %v, want %v", got, tt.wantProps)
+			}
+		})
+	}
+}
+
+func TestCreationInfo_Validate(t *testing.T) {
+	ci := &spdx.CreationInfo{SpecVersion: spdx.SpecVersion, Created: time.Now()}
+	if err := ci.Validate(); err == nil {
+		t.Error("expected error for missing createdBy")
+	}
+
+	ci.CreatedBy = []spdx.Agent{{Element: spdx.Element{SpdxID: "urn:spdx:agent-1"}}}
+	if err := ci.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestPackage_JSON(t *testing.T) {
+	input := `{
+		"type": "software_Package",
+		"spdxId": "urn:spdx:pkg-1",
+		"name": "my-package",
+		"creationInfo": "_:creationinfo",
+		"suppliedBy": "urn:spdx:org-1",
+		"software_packageVersion": "1.2.3",
+		"software_primaryPurpose": "library",
+		"externalIdentifier": [
+			{"type": "ExternalIdentifier", "externalIdentifierType": "packageUrl", "identifier": "pkg:golang/example.com/my-package@1.2.3"}
+		]
+	}`
+
+	var pkg spdx.Package
+	if err := json.Unmarshal([]byte(input), &pkg); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if pkg.SpdxID != "urn:spdx:pkg-1" || pkg.Name != "my-package" {
+		t.Errorf("element fields = %q, %q", pkg.SpdxID, pkg.Name)
+	}
+	if pkg.PackageVersion != "1.2.3" {
+		t.Errorf("PackageVersion = %q, want %q", pkg.PackageVersion, "1.2.3")
+	}
+	if pkg.PrimaryPurpose != spdx.SoftwarePurposeLibrary {
+		t.Errorf("PrimaryPurpose = %q, want %q", pkg.PrimaryPurpose, spdx.SoftwarePurposeLibrary)
+	}
+	if pkg.SuppliedBy == nil || pkg.SuppliedBy.SpdxID != "urn:spdx:org-1" {
+		t.Errorf("SuppliedBy = %+v, want reference to urn:spdx:org-1", pkg.SuppliedBy)
+	}
+	if pkg.GetPURL() != "pkg:golang/example.com/my-package@1.2.3" {
+		t.Errorf("GetPURL() = %q", pkg.GetPURL())
+	}
+
+	out, err := json.Marshal(pkg)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	want := map[string]interface{}{
+		"type":                    "software_Package",
+		"spdxId":                  "urn:spdx:pkg-1",
+		"suppliedBy":              "urn:spdx:org-1",
+		"software_packageVersion": "1.2.3",
+		"software_primaryPurpose": "library",
+	}
+	for key, val := range want {
+		if got[key] != val {
+			t.Errorf("output[%q] = %v, want %v", key, got[key], val)
+		}
+	}
+	if _, ok := got["packageVersion"]; ok {
+		t.Error("output uses unprefixed property name packageVersion")
+	}
+	if !strings.HasPrefix(string(out), `{"type":"software_Package","spdxId":"urn:spdx:pkg-1"`) {
+		t.Errorf("unexpected property order: %s", out)
+	}
+}
+
+func TestRelationship_JSON(t *testing.T) {
+	rel := spdx.Relationship{
+		Element:          spdx.Element{SpdxID: "urn:spdx:rel-1"},
+		From:             spdx.Element{SpdxID: "urn:spdx:pkg-1"},
+		To:               []spdx.Element{{SpdxID: "urn:spdx:pkg-2"}, {SpdxID: "urn:spdx:pkg-3"}},
+		RelationshipType: spdx.RelationshipTypeDependsOn,
+	}
+
+	out, err := json.Marshal(rel)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var back spdx.Relationship
+	if err := json.Unmarshal(out, &back); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if back.From.SpdxID != "urn:spdx:pkg-1" || len(back.To) != 2 || back.To[1].SpdxID != "urn:spdx:pkg-3" {
+		t.Errorf("round trip = %+v", back)
+	}
+	if back.RelationshipType != spdx.RelationshipTypeDependsOn {
+		t.Errorf("RelationshipType = %q", back.RelationshipType)
+	}
+}
+
+func TestRelationship_UnmarshalJSON_NormalizesEnums(t *testing.T) {
+	data := `{"type": "Relationship", "spdxId": "urn:spdx:rel-1", "relationshipType": "DEPENDS_ON", "completeness": "NO_ASSERTION"}`
+
+	var rel spdx.Relationship
+	if err := json.Unmarshal([]byte(data), &rel); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if rel.RelationshipType != spdx.RelationshipTypeDependsOn {
+		t.Errorf("RelationshipType = %q, want %q", rel.RelationshipType, spdx.RelationshipTypeDependsOn)
+	}
+	if rel.Completeness != spdx.RelationshipCompletenessNoAssertion {
+		t.Errorf("Completeness = %q, want %q", rel.Completeness, spdx.RelationshipCompletenessNoAssertion)
+	}
+}
+
+func TestIRIConstants(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"class", spdx.IRIPackage, "https://spdx.org/rdf/3.0.1/terms/Software/Package"},
+		{"property", spdx.IRIPackageVersion, "https://spdx.org/rdf/3.0.1/terms/Software/packageVersion"},
+		{"property named like a class", spdx.IRICreationInfoProperty, "https://spdx.org/rdf/3.0.1/terms/Core/creationInfo"},
+		{"property of a profile", spdx.IRISecurityLocator, "https://spdx.org/rdf/3.0.1/terms/Security/locator"},
+		{"enum type", spdx.IRIRelationshipType, "https://spdx.org/rdf/3.0.1/terms/Core/RelationshipType"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %q, want %q", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupType(t *testing.T) {
+	tests := []struct {
+		name     string
+		wantOK   bool
+		wantIRI  string
+		profile  string
+		abstract bool
+		element  bool
+	}{
+		{"software_Package", true, spdx.IRIPackage, "Software", false, true},
+		{"Element", true, spdx.IRIElement, "Core", true, true},
+		{"Hash", true, spdx.IRIHash, "Core", false, false},
+		{"expandedlicensing_ListedLicense", true, spdx.IRIListedLicense, "ExpandedLicensing", false, true},
+		{"Package", false, "", "", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, ok := spdx.LookupType(tt.name)
+			if ok != tt.wantOK {
+				t.Fatalf("LookupType(%q) ok = %v, want %v", tt.name, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if info.Name != tt.name || info.IRI != tt.wantIRI || info.Profile != tt.profile ||
+				info.Abstract != tt.abstract || info.Element != tt.element {
+				t.Errorf("LookupType(%q) = %+v", tt.name, info)
+			}
+			if got, ok := spdx.TypeOf(info.New()); !ok || got.Name != tt.name {
+				t.Errorf("TypeOf(New()) = %q, %v", got.Name, ok)
+			}
+		})
+	}
+}
+
+func TestTypes(t *testing.T) {
+	types := spdx.Types()
+	if len(types) == 0 {
+		t.Fatal("Types() is empty")
+	}
+	for i := 1; i < len(types); i++ {
+		if types[i-1].Name >= types[i].Name {
+			t.Fatalf("Types() not sorted: %q before %q", types[i-1].Name, types[i].Name)
+		}
+	}
+	if _, ok := spdx.TypeOf("not a model type"); ok {
+		t.Error("TypeOf(string) ok = true, want false")
+	}
+}
+
+func TestUnmarshalTyped(t *testing.T) {
+	v, err := spdx.UnmarshalTyped([]byte(`{"type": "software_Package", "spdxId": "urn:spdx:pkg-1", "software_packageVersion": "1.2.3"}`))
+	if err != nil {
+		t.Fatalf("UnmarshalTyped() error = %v", err)
+	}
+	pkg, ok := v.(*spdx.Package)
+	if !ok {
+		t.Fatalf("UnmarshalTyped() = %T, want *spdx.Package", v)
+	}
+	if pkg.SpdxID != "urn:spdx:pkg-1" || pkg.PackageVersion != "1.2.3" {
+		t.Errorf("package = %+v", pkg)
+	}
+
+	if _, err := spdx.UnmarshalTyped([]byte(`{"type": "acme_Firmware"}`)); err == nil {
+		t.Error("UnmarshalTyped() with unknown type: expected error")
+	}
+}
+
+func TestPackage_Copy(t *testing.T) {
+	orig := &spdx.Package{PackageVersion: "1.0.0"}
+	orig.SpdxID = "urn:spdx:pkg-1"
+	orig.CreationInfo.CreatedBy = []spdx.Agent{{Element: spdx.Element{SpdxID: "urn:spdx:agent-1"}}}
+	orig.ExternalIdentifier = []spdx.ExternalIdentifier{{Identifier: "pkg:golang/example.com/a@1.0.0"}}
+	orig.AttributionText = []string{"Copyright Example"}
+	orig.SuppliedBy = &spdx.Agent{Element: spdx.Element{SpdxID: "urn:spdx:supplier"}}
+
+	c := orig.Copy()
+	c.PackageVersion = "2.0.0"
+	c.CreationInfo.CreatedBy[0].SpdxID = "urn:spdx:agent-2"
+	c.ExternalIdentifier[0].Identifier = "pkg:golang/example.com/b@2.0.0"
+	c.AttributionText[0] = "Copyright Other"
+	c.SuppliedBy.SpdxID = "urn:spdx:other"
+
+	if orig.PackageVersion != "1.0.0" ||
+		orig.CreationInfo.CreatedBy[0].SpdxID != "urn:spdx:agent-1" ||
+		orig.ExternalIdentifier[0].Identifier != "pkg:golang/example.com/a@1.0.0" ||
+		orig.AttributionText[0] != "Copyright Example" ||
+		orig.SuppliedBy.SpdxID != "urn:spdx:supplier" {
+		t.Errorf("modifying the copy changed the original: %+v", orig)
+	}
+	if c.SpdxID != orig.SpdxID {
+		t.Errorf("copy SpdxID = %q, want %q", c.SpdxID, orig.SpdxID)
+	}
+	if c.VerifiedUsing != nil {
+		t.Errorf("copy VerifiedUsing = %v, want nil", c.VerifiedUsing)
+	}
+
+	var nilPkg *spdx.Package
+	if nilPkg.Copy() != nil {
+		t.Error("Copy() of nil package should be nil")
+	}
+}
+
+func TestCloner(t *testing.T) {
+	var v spdx.Cloner = &spdx.Relationship{RelationshipType: spdx.RelationshipTypeDependsOn}
+	clone, ok := v.Clone().(*spdx.Relationship)
+	if !ok {
+		t.Fatalf("Clone() = %T, want *spdx.Relationship", v.Clone())
+	}
+	if clone.RelationshipType != spdx.RelationshipTypeDependsOn {
+		t.Errorf("clone RelationshipType = %q", clone.RelationshipType)
+	}
+}
+
+// countingVisitor counts the packages and relationships it visits.
+type countingVisitor struct {
+	spdx.BaseVisitor
+	packages, relationships int
+}
+
+func (v *countingVisitor) VisitPackage(*spdx.Package) error {
+	v.packages++
+	return nil
+}
+
+func (v *countingVisitor) VisitRelationship(*spdx.Relationship) error {
+	v.relationships++
+	return nil
+}
+
+func TestVisitor(t *testing.T) {
+	values := []spdx.Visitable{
+		&spdx.Package{},
+		&spdx.Relationship{},
+		&spdx.File{},
+		&spdx.Package{},
+	}
+
+	v := &countingVisitor{}
+	for _, val := range values {
+		if err := val.Accept(v); err != nil {
+			t.Fatalf("Accept() error = %v", err)
+		}
+	}
+	if v.packages != 2 || v.relationships != 1 {
+		t.Errorf("visited %d packages and %d relationships, want 2 and 1", v.packages, v.relationships)
+	}
+
+	var elem spdx.ElementInterface = &spdx.Sbom{}
+	if _, ok := elem.(spdx.Visitable); !ok {
+		t.Error("*Sbom should implement Visitable")
+	}
+}
+
+// jsonSchema is a decoded JSON Schema.
+type jsonSchema = map[string]interface{}
+
+func loadSchema(t *testing.T) jsonSchema {
+	t.Helper()
+	data, err := os.ReadFile("../../docs/spdx-schema.json")
+	if err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	var schema jsonSchema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("decode schema: %v", err)
+	}
+	return schema
+}
+
+// errSchemaMismatch marks values of the wrong JSON type or constant, so that
+// anyOf reports the error of the alternative that was meant.
+var errSchemaMismatch = errors.New("mismatch")
+
+// checkSchema reports the first violation of a JSON Schema by a decoded
+// JSON value. It supports the keywords used by the generated schema.
+func checkSchema(root, s jsonSchema, v interface{}, path string) error {
+	if ref, ok := s["$ref"].(string); ok {
+		def, ok := root["$defs"].(jsonSchema)[strings.TrimPrefix(ref, "#/$defs/")].(jsonSchema)
+		if !ok {
+			return fmt.Errorf("%s: unresolved $ref %s", path, ref)
+		}
+		return checkSchema(root, def, v, path)
+	}
+	if c, ok := s["const"]; ok && c != v {
+		return fmt.Errorf("%s: %v is not %v: %w", path, v, c, errSchemaMismatch)
+	}
+	if enum, ok := s["enum"].([]interface{}); ok {
+		found := false
+		for _, e := range enum {
+			found = found || e == v
+		}
+		if !found {
+			return fmt.Errorf("%s: %v is not one of %v", path, v, enum)
+		}
+	}
+	if alts, ok := s["anyOf"].([]interface{}); ok {
+		var meant error
+		for _, alt := range alts {
+			err := checkSchema(root, alt.(jsonSchema), v, path)
+			if err == nil {
+				return nil
+			}
+			if !errors.Is(err, errSchemaMismatch) {
+				meant = err
+			}
+		}
+		if meant != nil {
+			return meant
+		}
+		return fmt.Errorf("%s: matches none of %d alternatives: %w", path, len(alts), errSchemaMismatch)
+	}
+
+	switch s["type"] {
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %v is not an object: %w", path, v, errSchemaMismatch)
+		}
+		// Check the constant type first, so that objects of other classes
+		// are told apart from invalid ones.
+		props, _ := s["properties"].(jsonSchema)
+		if typ, ok := props["type"].(jsonSchema); ok {
+			if err := checkSchema(root, typ, obj["type"], path+".type"); err != nil {
+				return err
+			}
+		}
+		for _, name := range s["required"].([]interface{}) {
+			if _, ok := obj[name.(string)]; !ok {
+				return fmt.Errorf("%s: missing required property %s", path, name)
+			}
+		}
+		for name, prop := range props {
+			if val, ok := obj[name]; ok {
+				if err := checkSchema(root, prop.(jsonSchema), val, path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		arr, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %v is not an array: %w", path, v, errSchemaMismatch)
+		}
+		if n, ok := s["minItems"].(float64); ok && float64(len(arr)) < n {
+			return fmt.Errorf("%s: %d items, want at least %v", path, len(arr), n)
+		}
+		if n, ok := s["maxItems"].(float64); ok && float64(len(arr)) > n {
+			return fmt.Errorf("%s: %d items, want at most %v", path, len(arr), n)
+		}
+		for i, item := range arr {
+			if err := checkSchema(root, s["items"].(jsonSchema), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("%s: %v is not a string: %w", path, v, errSchemaMismatch)
+		}
+		if pattern, ok := s["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(str) {
+			return fmt.Errorf("%s: %q does not match %s", path, str, pattern)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return fmt.Errorf("%s: %v is not a boolean: %w", path, v, errSchemaMismatch)
+		}
+	case "integer", "number":
+		n, ok := v.(float64)
+		if !ok || (s["type"] == "integer" && n != float64(int64(n))) {
+			return fmt.Errorf("%s: %v is not an %s: %w", path, v, s["type"], errSchemaMismatch)
+		}
+		if minimum, ok := s["minimum"].(float64); ok && n < minimum {
+			return fmt.Errorf("%s: %v is less than %v", path, n, minimum)
+		}
+	}
+	return nil
+}
+
+func TestJSONSchema_GoldenFixtures(t *testing.T) {
+	schema := loadSchema(t)
+	files, err := filepath.Glob("../../parse/testdata/golden/*.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no golden fixtures found: %v", err)
+	}
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var doc interface{}
+			if err := json.Unmarshal(data, &doc); err != nil {
+				t.Fatal(err)
+			}
+			if err := checkSchema(schema, schema, doc, "$"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestJSONSchema_MarshalJSON(t *testing.T) {
+	schema := loadSchema(t)
+
+	ci := spdx.CreationInfo{
+		SpecVersion: spdx.SpecVersion,
+		Created:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatedBy:   []spdx.Agent{{Element: spdx.Element{SpdxID: "urn:spdx:agent-1"}}},
+	}
+	pkg := spdx.Package{PackageVersion: "1.0.0"}
+	pkg.SpdxID = "urn:spdx:pkg-1"
+	pkg.CreationInfo = ci
+	pkg.PrimaryPurpose = spdx.SoftwarePurposeLibrary
+	rel := spdx.Relationship{
+		Element:          spdx.Element{SpdxID: "urn:spdx:rel-1", CreationInfo: ci},
+		From:             pkg.Element,
+		To:               []spdx.Element{{SpdxID: "urn:spdx:pkg-2"}},
+		RelationshipType: spdx.RelationshipTypeDependsOn,
+	}
+
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr string
+	}{
+		{"package", pkg, ""},
+		{"relationship", rel, ""},
+		{"relationship without to", spdx.Relationship{Element: rel.Element, From: rel.From, RelationshipType: rel.RelationshipType}, "missing required property to"},
+		{"package without creation info", spdx.Package{SoftwareArtifact: spdx.SoftwareArtifact{Artifact: spdx.Artifact{Element: spdx.Element{SpdxID: "urn:spdx:pkg-3"}}}}, "missing required property"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var item interface{}
+			if err := json.Unmarshal(data, &item); err != nil {
+				t.Fatal(err)
+			}
+			doc := map[string]interface{}{
+				"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+				"@graph":   []interface{}{item},
+			}
+			err = checkSchema(schema, schema, doc, "$")
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("schema check failed: %v\n%s", err, data)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("schema check error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProto_ConcreteClasses(t *testing.T) {
+	data, err := os.ReadFile("../../docs/spdx.proto")
+	if err != nil {
+		t.Fatalf("read proto: %v", err)
+	}
+	proto := string(data)
+	node := regexp.MustCompile(`(?s)\nmessage Node \{\n.*?\n\}\n`).FindString(proto)
+	if node == "" {
+		t.Fatal("no message Node")
+	}
+	for _, info := range spdx.Types() {
+		if info.Abstract {
+			continue
+		}
+		name := info.Type.Name()
+		if !strings.Contains(proto, "\nmessage "+name+" {\n") {
+			t.Errorf("no message %s for %s", name, info.Name)
+		}
+		if !strings.Contains(node, " "+name+" ") {
+			t.Errorf("message Node has no field of type %s", name)
+		}
+	}
+}
@@ -109,16 +109,16 @@ type DictionaryEntry struct {
 // Element Base domain class from which all other SPDX-3.0 domain classes derive.
 // Element is an abstract type and should not be instantiated directly.
 type Element struct {
-	SpdxID             string               `json:"spdxId"`
-	Name               string               `json:"name,omitempty"`
-	Summary            string               `json:"summary,omitempty"`
-	Description        string               `json:"description,omitempty"`
-	Comment            string               `json:"comment,omitempty"`
-	CreationInfo       CreationInfo         `json:"creationInfo" validate:"required"`
-	VerifiedUsing      []IntegrityMethod    `json:"verifiedUsing,omitempty"`
-	ExternalRef        []ExternalRef        `json:"externalRef,omitempty"`
-	ExternalIdentifier []ExternalIdentifier `json:"externalIdentifier,omitempty"`
-	Extension          []Extension          `json:"extension,omitempty"`
+	SpdxID             string                     `json:"spdxId"`
+	Name               string                     `json:"name,omitempty"`
+	Summary            string                     `json:"summary,omitempty"`
+	Description        string                     `json:"description,omitempty"`
+	Comment            string                     `json:"comment,omitempty"`
+	CreationInfo       CreationInfo               `json:"creationInfo" validate:"required"`
+	VerifiedUsing      []IntegrityMethodInterface `json:"verifiedUsing,omitempty"`
+	ExternalRef        []ExternalRef              `json:"externalRef,omitempty"`
+	ExternalIdentifier []ExternalIdentifier       `json:"externalIdentifier,omitempty"`
+	Extension          []ExtensionInterface       `json:"extension,omitempty"`
 }
 
 // ElementCollection A collection of Elements, not necessarily with unifying context.
@@ -141,10 +141,10 @@ type ExternalIdentifier struct {
 
 // ExternalMap A map of Element identifiers that are used within an SpdxDocument but defined external to that SpdxDocument.
 type ExternalMap struct {
-	ExternalSpdxId   string            `json:"externalSpdxId" validate:"required,omitempty,url"`
-	VerifiedUsing    []IntegrityMethod `json:"verifiedUsing,omitempty"`
-	LocationHint     string            `json:"locationHint,omitempty" validate:"omitempty,url"`
-	DefiningArtifact *Artifact         `json:"definingArtifact,omitempty"`
+	ExternalSpdxId   string                     `json:"externalSpdxId" validate:"required,omitempty,url"`
+	VerifiedUsing    []IntegrityMethodInterface `json:"verifiedUsing,omitempty"`
+	LocationHint     string                     `json:"locationHint,omitempty" validate:"omitempty,url"`
+	DefiningArtifact *Artifact                  `json:"definingArtifact,omitempty"`
 }
 
 // ExternalRef A reference to a resource outside the scope of SPDX-3.0 content related to an Element.
@@ -279,6 +279,7 @@ type DisjunctiveLicenseSet struct {
 }
 
 // ExtendableLicense Abstract class representing a License or an OrLaterOperator.
+// ExtendableLicense is an abstract type and should not be instantiated directly.
 type ExtendableLicense struct {
 	AnyLicenseInfo
 }
@@ -355,6 +356,7 @@ type CdxPropertyEntry struct {
 }
 
 // Extension A characterization of some aspect of an Element that is associated with the Element in a generalized fashion.
+// Extension is an abstract type and should not be instantiated directly.
 type Extension struct {
 }
 
@@ -455,6 +457,7 @@ type Vulnerability struct {
 }
 
 // AnyLicenseInfo Abstract class representing a license combination consisting of one or more licenses.
+// AnyLicenseInfo is an abstract type and should not be instantiated directly.
 type AnyLicenseInfo struct {
 	Element
 }
@@ -0,0 +1,858 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+// Validate checks the AIPackage against the constraints of the SPDX model.
+func (o *AIPackage) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *AIPackage) validate(v *validator) {
+	o.Package.validate(v)
+	v.enum("AIPackage", "autonomyType", string(o.AutonomyType), o.AutonomyType.IsValid())
+	v.enum("AIPackage", "safetyRiskAssessment", string(o.SafetyRiskAssessment), o.SafetyRiskAssessment.IsValid())
+	v.enum("AIPackage", "useSensitivePersonalInformation", string(o.UseSensitivePersonalInformation), o.UseSensitivePersonalInformation.IsValid())
+}
+
+// Validate checks the EnergyConsumption against the constraints of the SPDX model.
+func (o *EnergyConsumption) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *EnergyConsumption) validate(v *validator) {
+}
+
+// Validate checks the EnergyConsumptionDescription against the constraints of the SPDX model.
+func (o *EnergyConsumptionDescription) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *EnergyConsumptionDescription) validate(v *validator) {
+	v.required("EnergyConsumptionDescription", "energyUnit", o.EnergyUnit != "")
+	v.enum("EnergyConsumptionDescription", "energyUnit", string(o.EnergyUnit), o.EnergyUnit.IsValid())
+}
+
+// Validate checks the Build against the constraints of the SPDX model.
+func (o *Build) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Build) validate(v *validator) {
+	o.Element.validate(v)
+	v.required("Build", "buildType", o.BuildType != "")
+}
+
+// Validate checks the Agent against the constraints of the SPDX model.
+func (o *Agent) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Agent) validate(v *validator) {
+	o.Element.validate(v)
+}
+
+// Validate checks the Annotation against the constraints of the SPDX model.
+func (o *Annotation) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Annotation) validate(v *validator) {
+	o.Element.validate(v)
+	v.required("Annotation", "annotationType", o.AnnotationType != "")
+	v.enum("Annotation", "annotationType", string(o.AnnotationType), o.AnnotationType.IsValid())
+	v.required("Annotation", "subject", !isZero(o.Subject))
+	v.iri("Annotation", "subject", o.Subject.SpdxID)
+}
+
+// Validate checks the Artifact against the constraints of the SPDX model.
+func (o *Artifact) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Artifact) validate(v *validator) {
+	o.Element.validate(v)
+	for _, x := range o.OriginatedBy {
+		v.iri("Artifact", "originatedBy", x.SpdxID)
+	}
+	if o.SuppliedBy != nil {
+		v.iri("Artifact", "suppliedBy", o.SuppliedBy.SpdxID)
+	}
+	for _, x := range o.SupportLevel {
+		v.enum("Artifact", "supportLevel", string(x), x.IsValid())
+	}
+}
+
+// Validate checks the Bom against the constraints of the SPDX model.
+func (o *Bom) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Bom) validate(v *validator) {
+	o.Bundle.validate(v)
+}
+
+// Validate checks the Bundle against the constraints of the SPDX model.
+func (o *Bundle) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Bundle) validate(v *validator) {
+	o.ElementCollection.validate(v)
+}
+
+// Validate checks the CreationInfo against the constraints of the SPDX model.
+func (o *CreationInfo) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CreationInfo) validate(v *validator) {
+	v.required("CreationInfo", "specVersion", o.SpecVersion != "")
+	v.required("CreationInfo", "created", !o.Created.IsZero())
+	v.minCount("CreationInfo", "createdBy", len(o.CreatedBy), 1)
+	for _, x := range o.CreatedBy {
+		v.iri("CreationInfo", "createdBy", x.SpdxID)
+	}
+	for _, x := range o.CreatedUsing {
+		v.iri("CreationInfo", "createdUsing", x.SpdxID)
+	}
+}
+
+// Validate checks the DictionaryEntry against the constraints of the SPDX model.
+func (o *DictionaryEntry) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *DictionaryEntry) validate(v *validator) {
+	v.required("DictionaryEntry", "key", o.Key != "")
+}
+
+// Validate checks the Element against the constraints of the SPDX model.
+func (o *Element) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Element) validate(v *validator) {
+	v.required("Element", "creationInfo", !isZero(o.CreationInfo))
+}
+
+// Validate checks the ElementCollection against the constraints of the SPDX model.
+func (o *ElementCollection) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ElementCollection) validate(v *validator) {
+	o.Element.validate(v)
+	for _, x := range o.Elements {
+		v.iri("ElementCollection", "element", x.SpdxID)
+	}
+	for _, x := range o.RootElement {
+		v.iri("ElementCollection", "rootElement", x.SpdxID)
+	}
+	for _, x := range o.ProfileConformance {
+		v.enum("ElementCollection", "profileConformance", string(x), x.IsValid())
+	}
+}
+
+// Validate checks the ExternalIdentifier against the constraints of the SPDX model.
+func (o *ExternalIdentifier) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ExternalIdentifier) validate(v *validator) {
+	v.required("ExternalIdentifier", "externalIdentifierType", o.ExternalIdentifierType != "")
+	v.enum("ExternalIdentifier", "externalIdentifierType", string(o.ExternalIdentifierType), o.ExternalIdentifierType.IsValid())
+	v.required("ExternalIdentifier", "identifier", o.Identifier != "")
+}
+
+// Validate checks the ExternalMap against the constraints of the SPDX model.
+func (o *ExternalMap) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ExternalMap) validate(v *validator) {
+	v.required("ExternalMap", "externalSpdxId", o.ExternalSpdxId != "")
+	if o.DefiningArtifact != nil {
+		v.iri("ExternalMap", "definingArtifact", o.DefiningArtifact.SpdxID)
+	}
+}
+
+// Validate checks the ExternalRef against the constraints of the SPDX model.
+func (o *ExternalRef) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ExternalRef) validate(v *validator) {
+	v.enum("ExternalRef", "externalRefType", string(o.ExternalRefType), o.ExternalRefType.IsValid())
+}
+
+// Validate checks the Hash against the constraints of the SPDX model.
+func (o *Hash) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Hash) validate(v *validator) {
+	o.IntegrityMethod.validate(v)
+	v.required("Hash", "algorithm", o.Algorithm != "")
+	v.enum("Hash", "algorithm", string(o.Algorithm), o.Algorithm.IsValid())
+	v.required("Hash", "hashValue", o.HashValue != "")
+}
+
+// Validate checks the IndividualElement against the constraints of the SPDX model.
+func (o *IndividualElement) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *IndividualElement) validate(v *validator) {
+	o.Element.validate(v)
+}
+
+// Validate checks the IntegrityMethod against the constraints of the SPDX model.
+func (o *IntegrityMethod) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *IntegrityMethod) validate(v *validator) {
+}
+
+// Validate checks the LifecycleScopedRelationship against the constraints of the SPDX model.
+func (o *LifecycleScopedRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *LifecycleScopedRelationship) validate(v *validator) {
+	o.Relationship.validate(v)
+	v.enum("LifecycleScopedRelationship", "scope", string(o.Scope), o.Scope.IsValid())
+}
+
+// Validate checks the NamespaceMap against the constraints of the SPDX model.
+func (o *NamespaceMap) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *NamespaceMap) validate(v *validator) {
+	v.required("NamespaceMap", "prefix", o.Prefix != "")
+	v.required("NamespaceMap", "namespace", o.Namespace != "")
+}
+
+// Validate checks the Organization against the constraints of the SPDX model.
+func (o *Organization) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Organization) validate(v *validator) {
+	o.Agent.validate(v)
+}
+
+// Validate checks the PackageVerificationCode against the constraints of the SPDX model.
+func (o *PackageVerificationCode) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *PackageVerificationCode) validate(v *validator) {
+	o.IntegrityMethod.validate(v)
+	v.required("PackageVerificationCode", "algorithm", o.Algorithm != "")
+	v.enum("PackageVerificationCode", "algorithm", string(o.Algorithm), o.Algorithm.IsValid())
+	v.required("PackageVerificationCode", "hashValue", o.HashValue != "")
+}
+
+// Validate checks the Person against the constraints of the SPDX model.
+func (o *Person) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Person) validate(v *validator) {
+	o.Agent.validate(v)
+}
+
+// Validate checks the PositiveIntegerRange against the constraints of the SPDX model.
+func (o *PositiveIntegerRange) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *PositiveIntegerRange) validate(v *validator) {
+	v.minInclusive("PositiveIntegerRange", "beginIntegerRange", int(o.BeginIntegerRange), 1)
+	v.minInclusive("PositiveIntegerRange", "endIntegerRange", int(o.EndIntegerRange), 1)
+}
+
+// Validate checks the Relationship against the constraints of the SPDX model.
+func (o *Relationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Relationship) validate(v *validator) {
+	o.Element.validate(v)
+	v.required("Relationship", "from", !isZero(o.From))
+	v.iri("Relationship", "from", o.From.SpdxID)
+	v.minCount("Relationship", "to", len(o.To), 1)
+	for _, x := range o.To {
+		v.iri("Relationship", "to", x.SpdxID)
+	}
+	v.required("Relationship", "relationshipType", o.RelationshipType != "")
+	v.enum("Relationship", "relationshipType", string(o.RelationshipType), o.RelationshipType.IsValid())
+	v.enum("Relationship", "completeness", string(o.Completeness), o.Completeness.IsValid())
+}
+
+// Validate checks the SoftwareAgent against the constraints of the SPDX model.
+func (o *SoftwareAgent) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *SoftwareAgent) validate(v *validator) {
+	o.Agent.validate(v)
+}
+
+// Validate checks the SpdxDocument against the constraints of the SPDX model.
+func (o *SpdxDocument) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *SpdxDocument) validate(v *validator) {
+	o.ElementCollection.validate(v)
+	if o.DataLicense != nil {
+		v.iri("SpdxDocument", "dataLicense", o.DataLicense.SpdxID)
+	}
+}
+
+// Validate checks the Tool against the constraints of the SPDX model.
+func (o *Tool) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Tool) validate(v *validator) {
+	o.Element.validate(v)
+}
+
+// Validate checks the DatasetPackage against the constraints of the SPDX model.
+func (o *DatasetPackage) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *DatasetPackage) validate(v *validator) {
+	o.Package.validate(v)
+	v.enum("DatasetPackage", "confidentialityLevel", string(o.ConfidentialityLevel), o.ConfidentialityLevel.IsValid())
+	v.enum("DatasetPackage", "datasetAvailability", string(o.DatasetAvailability), o.DatasetAvailability.IsValid())
+	v.minInclusive("DatasetPackage", "datasetSize", int(o.DatasetSize), 0)
+	v.minCount("DatasetPackage", "datasetType", len(o.DatasetType), 1)
+	for _, x := range o.DatasetType {
+		v.enum("DatasetPackage", "datasetType", string(x), x.IsValid())
+	}
+	v.enum("DatasetPackage", "hasSensitivePersonalInformation", string(o.HasSensitivePersonalInformation), o.HasSensitivePersonalInformation.IsValid())
+}
+
+// Validate checks the ConjunctiveLicenseSet against the constraints of the SPDX model.
+func (o *ConjunctiveLicenseSet) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ConjunctiveLicenseSet) validate(v *validator) {
+	o.AnyLicenseInfo.validate(v)
+	v.minCount("ConjunctiveLicenseSet", "member", len(o.Member), 2)
+	for _, x := range o.Member {
+		v.iri("ConjunctiveLicenseSet", "member", x.SpdxID)
+	}
+}
+
+// Validate checks the CustomLicense against the constraints of the SPDX model.
+func (o *CustomLicense) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CustomLicense) validate(v *validator) {
+	o.License.validate(v)
+}
+
+// Validate checks the CustomLicenseAddition against the constraints of the SPDX model.
+func (o *CustomLicenseAddition) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CustomLicenseAddition) validate(v *validator) {
+	o.LicenseAddition.validate(v)
+}
+
+// Validate checks the DisjunctiveLicenseSet against the constraints of the SPDX model.
+func (o *DisjunctiveLicenseSet) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *DisjunctiveLicenseSet) validate(v *validator) {
+	o.AnyLicenseInfo.validate(v)
+	v.minCount("DisjunctiveLicenseSet", "member", len(o.Member), 2)
+	for _, x := range o.Member {
+		v.iri("DisjunctiveLicenseSet", "member", x.SpdxID)
+	}
+}
+
+// Validate checks the ExtendableLicense against the constraints of the SPDX model.
+func (o *ExtendableLicense) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ExtendableLicense) validate(v *validator) {
+	o.AnyLicenseInfo.validate(v)
+}
+
+// Validate checks the IndividualLicensingInfo against the constraints of the SPDX model.
+func (o *IndividualLicensingInfo) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *IndividualLicensingInfo) validate(v *validator) {
+	o.AnyLicenseInfo.validate(v)
+}
+
+// Validate checks the License against the constraints of the SPDX model.
+func (o *License) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *License) validate(v *validator) {
+	o.ExtendableLicense.validate(v)
+	v.required("License", "licenseText", o.LicenseText != "")
+}
+
+// Validate checks the LicenseAddition against the constraints of the SPDX model.
+func (o *LicenseAddition) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *LicenseAddition) validate(v *validator) {
+	o.Element.validate(v)
+	v.required("LicenseAddition", "additionText", o.AdditionText != "")
+}
+
+// Validate checks the ListedLicense against the constraints of the SPDX model.
+func (o *ListedLicense) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ListedLicense) validate(v *validator) {
+	o.License.validate(v)
+}
+
+// Validate checks the ListedLicenseException against the constraints of the SPDX model.
+func (o *ListedLicenseException) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ListedLicenseException) validate(v *validator) {
+	o.LicenseAddition.validate(v)
+}
+
+// Validate checks the OrLaterOperator against the constraints of the SPDX model.
+func (o *OrLaterOperator) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *OrLaterOperator) validate(v *validator) {
+	o.ExtendableLicense.validate(v)
+	v.required("OrLaterOperator", "subjectLicense", !isZero(o.SubjectLicense))
+	v.iri("OrLaterOperator", "subjectLicense", o.SubjectLicense.SpdxID)
+}
+
+// Validate checks the WithAdditionOperator against the constraints of the SPDX model.
+func (o *WithAdditionOperator) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *WithAdditionOperator) validate(v *validator) {
+	o.AnyLicenseInfo.validate(v)
+	v.required("WithAdditionOperator", "subjectAddition", !isZero(o.SubjectAddition))
+	v.iri("WithAdditionOperator", "subjectAddition", o.SubjectAddition.SpdxID)
+	v.required("WithAdditionOperator", "subjectExtendableLicense", !isZero(o.SubjectExtendableLicense))
+	v.iri("WithAdditionOperator", "subjectExtendableLicense", o.SubjectExtendableLicense.SpdxID)
+}
+
+// Validate checks the CdxPropertiesExtension against the constraints of the SPDX model.
+func (o *CdxPropertiesExtension) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CdxPropertiesExtension) validate(v *validator) {
+	o.Extension.validate(v)
+	v.minCount("CdxPropertiesExtension", "cdxProperty", len(o.CdxProperty), 1)
+}
+
+// Validate checks the CdxPropertyEntry against the constraints of the SPDX model.
+func (o *CdxPropertyEntry) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CdxPropertyEntry) validate(v *validator) {
+	v.required("CdxPropertyEntry", "cdxPropName", o.CdxPropName != "")
+}
+
+// Validate checks the Extension against the constraints of the SPDX model.
+func (o *Extension) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Extension) validate(v *validator) {
+}
+
+// Validate checks the CvssV2VulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *CvssV2VulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CvssV2VulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+	v.required("CvssV2VulnAssessmentRelationship", "vectorString", o.VectorString != "")
+}
+
+// Validate checks the CvssV3VulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *CvssV3VulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CvssV3VulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+	v.required("CvssV3VulnAssessmentRelationship", "severity", o.Severity != "")
+	v.enum("CvssV3VulnAssessmentRelationship", "severity", string(o.Severity), o.Severity.IsValid())
+	v.required("CvssV3VulnAssessmentRelationship", "vectorString", o.VectorString != "")
+}
+
+// Validate checks the CvssV4VulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *CvssV4VulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *CvssV4VulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+	v.required("CvssV4VulnAssessmentRelationship", "severity", o.Severity != "")
+	v.enum("CvssV4VulnAssessmentRelationship", "severity", string(o.Severity), o.Severity.IsValid())
+	v.required("CvssV4VulnAssessmentRelationship", "vectorString", o.VectorString != "")
+}
+
+// Validate checks the EpssVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *EpssVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *EpssVulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+}
+
+// Validate checks the ExploitCatalogVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *ExploitCatalogVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ExploitCatalogVulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+	v.required("ExploitCatalogVulnAssessmentRelationship", "catalogType", o.CatalogType != "")
+	v.enum("ExploitCatalogVulnAssessmentRelationship", "catalogType", string(o.CatalogType), o.CatalogType.IsValid())
+	v.required("ExploitCatalogVulnAssessmentRelationship", "locator", o.Locator != "")
+}
+
+// Validate checks the SsvcVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *SsvcVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *SsvcVulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+	v.required("SsvcVulnAssessmentRelationship", "decisionType", o.DecisionType != "")
+	v.enum("SsvcVulnAssessmentRelationship", "decisionType", string(o.DecisionType), o.DecisionType.IsValid())
+}
+
+// Validate checks the VexAffectedVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *VexAffectedVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *VexAffectedVulnAssessmentRelationship) validate(v *validator) {
+	o.VexVulnAssessmentRelationship.validate(v)
+	v.required("VexAffectedVulnAssessmentRelationship", "actionStatement", o.ActionStatement != "")
+}
+
+// Validate checks the VexFixedVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *VexFixedVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *VexFixedVulnAssessmentRelationship) validate(v *validator) {
+	o.VexVulnAssessmentRelationship.validate(v)
+}
+
+// Validate checks the VexNotAffectedVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *VexNotAffectedVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *VexNotAffectedVulnAssessmentRelationship) validate(v *validator) {
+	o.VexVulnAssessmentRelationship.validate(v)
+	v.enum("VexNotAffectedVulnAssessmentRelationship", "justificationType", string(o.JustificationType), o.JustificationType.IsValid())
+}
+
+// Validate checks the VexUnderInvestigationVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *VexUnderInvestigationVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *VexUnderInvestigationVulnAssessmentRelationship) validate(v *validator) {
+	o.VexVulnAssessmentRelationship.validate(v)
+}
+
+// Validate checks the VexVulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *VexVulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *VexVulnAssessmentRelationship) validate(v *validator) {
+	o.VulnAssessmentRelationship.validate(v)
+}
+
+// Validate checks the VulnAssessmentRelationship against the constraints of the SPDX model.
+func (o *VulnAssessmentRelationship) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *VulnAssessmentRelationship) validate(v *validator) {
+	o.Relationship.validate(v)
+	if o.AssessedElement != nil {
+		v.iri("VulnAssessmentRelationship", "assessedElement", o.AssessedElement.SpdxID)
+	}
+	if o.SuppliedBy != nil {
+		v.iri("VulnAssessmentRelationship", "suppliedBy", o.SuppliedBy.SpdxID)
+	}
+}
+
+// Validate checks the Vulnerability against the constraints of the SPDX model.
+func (o *Vulnerability) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Vulnerability) validate(v *validator) {
+	o.Artifact.validate(v)
+}
+
+// Validate checks the AnyLicenseInfo against the constraints of the SPDX model.
+func (o *AnyLicenseInfo) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *AnyLicenseInfo) validate(v *validator) {
+	o.Element.validate(v)
+}
+
+// Validate checks the LicenseExpression against the constraints of the SPDX model.
+func (o *LicenseExpression) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *LicenseExpression) validate(v *validator) {
+	o.AnyLicenseInfo.validate(v)
+	v.required("LicenseExpression", "licenseExpression", o.LicenseExpression != "")
+}
+
+// Validate checks the SimpleLicensingText against the constraints of the SPDX model.
+func (o *SimpleLicensingText) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *SimpleLicensingText) validate(v *validator) {
+	o.Element.validate(v)
+	v.required("SimpleLicensingText", "licenseText", o.LicenseText != "")
+}
+
+// Validate checks the ContentIdentifier against the constraints of the SPDX model.
+func (o *ContentIdentifier) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *ContentIdentifier) validate(v *validator) {
+	o.IntegrityMethod.validate(v)
+	v.required("ContentIdentifier", "contentIdentifierType", o.ContentIdentifierType != "")
+	v.enum("ContentIdentifier", "contentIdentifierType", string(o.ContentIdentifierType), o.ContentIdentifierType.IsValid())
+	v.required("ContentIdentifier", "contentIdentifierValue", o.ContentIdentifierValue != "")
+}
+
+// Validate checks the File against the constraints of the SPDX model.
+func (o *File) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *File) validate(v *validator) {
+	o.SoftwareArtifact.validate(v)
+	v.enum("File", "fileKind", string(o.FileKind), o.FileKind.IsValid())
+}
+
+// Validate checks the Package against the constraints of the SPDX model.
+func (o *Package) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Package) validate(v *validator) {
+	o.SoftwareArtifact.validate(v)
+}
+
+// Validate checks the Sbom against the constraints of the SPDX model.
+func (o *Sbom) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Sbom) validate(v *validator) {
+	o.Bom.validate(v)
+	for _, x := range o.SbomType {
+		v.enum("Sbom", "sbomType", string(x), x.IsValid())
+	}
+}
+
+// Validate checks the Snippet against the constraints of the SPDX model.
+func (o *Snippet) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *Snippet) validate(v *validator) {
+	o.SoftwareArtifact.validate(v)
+	v.required("Snippet", "snippetFromFile", !isZero(o.SnippetFromFile))
+	v.iri("Snippet", "snippetFromFile", o.SnippetFromFile.SpdxID)
+}
+
+// Validate checks the SoftwareArtifact against the constraints of the SPDX model.
+func (o *SoftwareArtifact) Validate() error {
+	v := &validator{}
+	o.validate(v)
+	return v.err()
+}
+
+func (o *SoftwareArtifact) validate(v *validator) {
+	o.Artifact.validate(v)
+	v.enum("SoftwareArtifact", "primaryPurpose", string(o.PrimaryPurpose), o.PrimaryPurpose.IsValid())
+	for _, x := range o.AdditionalPurpose {
+		v.enum("SoftwareArtifact", "additionalPurpose", string(x), x.IsValid())
+	}
+}
@@ -0,0 +1,443 @@
+// Copyright 2025 Interlynk Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a semantic version, as in "1.4.2-rc.1+build.7".
+type Semver struct {
+	Major, Minor, Patch uint64
+	// Prerelease are the dot-separated identifiers after "-", if any.
+	Prerelease []string
+	// Build is the build metadata after "+", which does not take part in
+	// comparisons.
+	Build string
+}
+
+// ParseSemver parses a semantic version. A leading "v", as in Go module
+// versions, is allowed, and so are missing minor and patch versions, which
+// are taken as 0.
+func ParseSemver(s string) (Semver, error) {
+	var v Semver
+	rest := strings.TrimPrefix(s, "v")
+	rest, v.Build, _ = strings.Cut(rest, "+")
+	rest, pre, hasPre := strings.Cut(rest, "-")
+	if hasPre {
+		if pre == "" {
+			return Semver{}, fmt.Errorf("version %q has an empty prerelease", s)
+		}
+		v.Prerelease = strings.Split(pre, ".")
+		for _, id := range v.Prerelease {
+			if id == "" {
+				return Semver{}, fmt.Errorf("version %q has an empty prerelease identifier", s)
+			}
+		}
+	}
+	parts := strings.Split(rest, ".")
+	if len(parts) > 3 {
+		return Semver{}, fmt.Errorf("version %q has more than three numbers", s)
+	}
+	nums := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Semver{}, fmt.Errorf("version %q is not a semantic version", s)
+		}
+		*nums[i] = n
+	}
+	return v, nil
+}
+
+// String returns the version as "major.minor.patch", with its prerelease
+// and build metadata if any.
+func (v Semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0 or +1 as v precedes, equals or follows w, per the
+// precedence rules of semantic versioning: a prerelease precedes its
+// release, and build metadata is ignored.
+func (v Semver) Compare(w Semver) int {
+	if c := cmp.Compare(v.Major, w.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Minor, w.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Patch, w.Patch); c != 0 {
+		return c
+	}
+	switch {
+	case len(v.Prerelease) == 0 && len(w.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(w.Prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Prerelease) && i < len(w.Prerelease); i++ {
+		a, b := v.Prerelease[i], w.Prerelease[i]
+		an, aErr := strconv.ParseUint(a, 10, 64)
+		bn, bErr := strconv.ParseUint(b, 10, 64)
+		var c int
+		switch {
+		case aErr == nil && bErr == nil:
+			c = cmp.Compare(an, bn)
+		case aErr == nil:
+			c = -1
+		case bErr == nil:
+			c = 1
+		default:
+			c = strings.Compare(a, b)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(v.Prerelease), len(w.Prerelease))
+}
+
+// semverEcosystems are the package URL types whose versions are semantic
+// versions.
+var semverEcosystems = map[string]bool{
+	"cargo": true, "composer": true, "conan": true, "golang": true, "hex": true,
+	"npm": true, "nuget": true, "pub": true, "swift": true,
+}
+
+// CompareVersions returns -1, 0 or +1 as version a of a package of the
+// given ecosystem, a package URL type such as "npm" or "maven", precedes,
+// equals or follows version b.
+//
+// Versions of ecosystems that use semantic versioning, and of an empty
+// ecosystem, are compared as semantic versions if both parse as such. Deb
+// and RPM versions are compared as dpkg and rpm compare them, epochs and
+// revisions included. Other versions, such as those of Maven and PyPI, are
+// compared by their numeric and alphabetic parts: numbers numerically,
+// trailing zeros ignored, and the usual prerelease qualifiers, "dev",
+// "alpha", "beta", "milestone", "rc" and "snapshot", before the release
+// and "post" and "sp" after it.
+//
+//	spdx.CompareVersions("npm", "1.10.0", "1.9.3")        // 1
+//	spdx.CompareVersions("maven", "2.0-rc1", "2.0")       // -1
+//	spdx.CompareVersions("deb", "1:1.0-1", "2.0-1")       // 1
+func CompareVersions(ecosystem, a, b string) int {
+	ecosystem = strings.ToLower(ecosystem)
+	switch {
+	case ecosystem == "deb":
+		return compareDeb(a, b)
+	case ecosystem == "rpm":
+		return compareRPM(a, b)
+	case ecosystem == "" || semverEcosystems[ecosystem]:
+		va, errA := ParseSemver(a)
+		vb, errB := ParseSemver(b)
+		if errA == nil && errB == nil {
+			return va.Compare(vb)
+		}
+	}
+	unknown := -5
+	if ecosystem == "maven" {
+		// Maven orders unknown qualifiers after all known ones.
+		unknown = 20
+	}
+	return compareGeneric(a, b, unknown)
+}
+
+// CompareVersion returns -1, 0 or +1 as the version of the package
+// precedes, equals or follows version, compared as CompareVersions does in
+// the ecosystem of the type of its package URL.
+func (o *Package) CompareVersion(version string) int {
+	ecosystem := ""
+	if p, ok := o.PURLComponents(); ok {
+		ecosystem = p.Type
+	}
+	return CompareVersions(ecosystem, o.PackageVersion, version)
+}
+
+// qualifierRanks order the alphabetic parts of versions compared by
+// compareGeneric relative to a release, which ranks 0.
+var qualifierRanks = map[string]int{
+	"dev":   -60,
+	"alpha": -50, "a": -50,
+	"beta": -40, "b": -40,
+	"milestone": -30, "m": -30,
+	"rc": -20, "cr": -20, "c": -20, "pre": -20, "preview": -20,
+	"snapshot": -10,
+	"final":    0, "ga": 0, "release": 0,
+	"post": 10, "sp": 10,
+}
+
+// versionToken is a run of digits or letters of a version.
+type versionToken struct {
+	s       string
+	numeric bool
+}
+
+// versionTokens splits a version into runs of digits and of letters, in
+// lower case, dropping a leading "v" and the separators between them.
+func versionTokens(s string) []versionToken {
+	s = strings.ToLower(s)
+	if len(s) > 1 && s[0] == 'v' && '0' <= s[1] && s[1] <= '9' {
+		s = s[1:]
+	}
+	var tokens []versionToken
+	for i := 0; i < len(s); {
+		isDigit := '0' <= s[i] && s[i] <= '9'
+		isLetter := 'a' <= s[i] && s[i] <= 'z'
+		if !isDigit && !isLetter {
+			i++
+			continue
+		}
+		j := i
+		for j < len(s) && (isDigit && '0' <= s[j] && s[j] <= '9' || isLetter && 'a' <= s[j] && s[j] <= 'z') {
+			j++
+		}
+		tokens = append(tokens, versionToken{s: s[i:j], numeric: isDigit})
+		i = j
+	}
+	return tokens
+}
+
+// rank returns the rank of a token relative to a release, for a token that
+// another version does not have: 0 for a zero or a release qualifier.
+func (t versionToken) rank(unknown int) int {
+	if t.numeric {
+		if strings.Trim(t.s, "0") == "" {
+			return 0
+		}
+		return 1
+	}
+	if r, ok := qualifierRanks[t.s]; ok {
+		return r
+	}
+	return unknown
+}
+
+// compareGeneric compares versions by their tokens, ranking unknown
+// qualifiers as unknown.
+func compareGeneric(a, b string, unknown int) int {
+	ta, tb := versionTokens(a), versionTokens(b)
+	for i := 0; i < len(ta) || i < len(tb); i++ {
+		var c int
+		switch {
+		case i >= len(ta):
+			c = -cmp.Compare(tb[i].rank(unknown), 0)
+		case i >= len(tb):
+			c = cmp.Compare(ta[i].rank(unknown), 0)
+		case ta[i].numeric && tb[i].numeric:
+			c = compareDigits(ta[i].s, tb[i].s)
+		case ta[i].numeric:
+			c = 1
+		case tb[i].numeric:
+			c = -1
+		default:
+			ra, rb := ta[i].rank(unknown), tb[i].rank(unknown)
+			c = cmp.Compare(ra, rb)
+			if c == 0 {
+				c = strings.Compare(ta[i].s, tb[i].s)
+				if _, ok := qualifierRanks[ta[i].s]; ok {
+					// Synonyms, as "a" and "alpha", are equal.
+					c = 0
+				}
+			}
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareDigits compares two runs of digits numerically, however long.
+func compareDigits(a, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if c := cmp.Compare(len(a), len(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// splitEVR splits a deb or RPM version into its epoch, version and
+// revision or release.
+func splitEVR(s string) (epoch, version, revision string) {
+	version = s
+	if e, v, ok := strings.Cut(version, ":"); ok {
+		epoch, version = e, v
+	}
+	if i := strings.LastIndex(version, "-"); i >= 0 {
+		version, revision = version[:i], version[i+1:]
+	}
+	return epoch, version, revision
+}
+
+// compareDeb compares Debian package versions as dpkg does.
+func compareDeb(a, b string) int {
+	ea, va, ra := splitEVR(a)
+	eb, vb, rb := splitEVR(b)
+	if c := compareDigits(ea, eb); c != 0 {
+		return c
+	}
+	if c := debVerRevCmp(va, vb); c != 0 {
+		return c
+	}
+	return debVerRevCmp(ra, rb)
+}
+
+// debOrder is the order of a character in the non-digit parts of Debian
+// versions: "~" before the end of the part, the end before letters and
+// letters before other characters.
+func debOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	switch c := s[i]; {
+	case '0' <= c && c <= '9':
+		return 0
+	case 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z':
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+// debVerRevCmp compares the upstream versions or revisions of Debian
+// versions, alternating between non-digit and digit parts.
+func debVerRevCmp(a, b string) int {
+	isDigit := func(s string, i int) bool { return i < len(s) && '0' <= s[i] && s[i] <= '9' }
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isDigit(a, i) || j < len(b) && !isDigit(b, j) {
+			if c := cmp.Compare(debOrder(a, i), debOrder(b, j)); c != 0 {
+				return c
+			}
+			i++
+			j++
+		}
+		si, sj := i, j
+		for isDigit(a, i) {
+			i++
+		}
+		for isDigit(b, j) {
+			j++
+		}
+		if c := compareDigits(a[si:i], b[sj:j]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareRPM compares RPM package versions as rpm does.
+func compareRPM(a, b string) int {
+	ea, va, ra := splitEVR(a)
+	eb, vb, rb := splitEVR(b)
+	if c := compareDigits(ea, eb); c != 0 {
+		return c
+	}
+	if c := rpmVerCmp(va, vb); c != 0 {
+		return c
+	}
+	return rpmVerCmp(ra, rb)
+}
+
+// rpmVerCmp compares RPM versions or releases as rpmvercmp does: by their
+// runs of digits and of letters, with "~" sorting before and "^" after
+// the end of a version.
+func rpmVerCmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	isAlnum := func(c byte) bool {
+		return '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+	}
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	for {
+		separator := func(r rune) bool { return !(r < 128 && isAlnum(byte(r))) && r != '~' && r != '^' }
+		a, b = strings.TrimLeftFunc(a, separator), strings.TrimLeftFunc(b, separator)
+		if strings.HasPrefix(a, "~") || strings.HasPrefix(b, "~") {
+			if !strings.HasPrefix(a, "~") {
+				return 1
+			}
+			if !strings.HasPrefix(b, "~") {
+				return -1
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		if strings.HasPrefix(a, "^") || strings.HasPrefix(b, "^") {
+			switch {
+			case a == "":
+				return -1
+			case b == "":
+				return 1
+			case !strings.HasPrefix(a, "^"):
+				return 1
+			case !strings.HasPrefix(b, "^"):
+				return -1
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		if a == "" || b == "" {
+			break
+		}
+		numeric := isDigit(a[0])
+		run := func(s string) (string, string) {
+			i := 0
+			for i < len(s) && isAlnum(s[i]) && isDigit(s[i]) == numeric {
+				i++
+			}
+			return s[:i], s[i:]
+		}
+		var sa, sb string
+		sa, a = run(a)
+		sb, b = run(b)
+		if sb == "" {
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+		var c int
+		if numeric {
+			c = compareDigits(sa, sb)
+		} else {
+			c = strings.Compare(sa, sb)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	}
+	return 1
+}
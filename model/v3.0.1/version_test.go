@@ -0,0 +1,92 @@
+package spdx_test
+
+import (
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+func TestParseSemver(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "1.4.2-rc.1+build.7", want: "1.4.2-rc.1+build.7"},
+		{in: "v0.0.0-20240101120000-abcdef123456", want: "0.0.0-20240101120000-abcdef123456"},
+		{in: "2", want: "2.0.0"},
+		{in: "1.2.3.4", wantErr: true},
+		{in: "1.x", wantErr: true},
+		{in: "1.2.3-", wantErr: true},
+		{in: "1.2.3-rc..1", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := spdx.ParseSemver(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSemver(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("ParseSemver(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		ecosystem, a, b string
+		want            int
+	}{
+		{"npm", "1.10.0", "1.9.3", 1},
+		{"npm", "1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"npm", "1.0.0-alpha.beta", "1.0.0-beta", -1},
+		{"npm", "1.0.0-beta.11", "1.0.0-beta.2", 1},
+		{"npm", "1.0.0-rc.1", "1.0.0", -1},
+		{"npm", "1.0.0+build.1", "1.0.0+build.2", 0},
+		{"golang", "v1.2.0", "v1.2.0+incompatible", 0},
+		{"golang", "v0.0.0-20240101120000-abcdef123456", "v0.1.0", -1},
+		{"", "2.0.0", "10.0.0", -1},
+		{"npm", "1.2.3.4", "1.2.3", 1},
+		{"maven", "2.0-rc1", "2.0", -1},
+		{"maven", "2.0-SNAPSHOT", "2.0-rc1", 1},
+		{"maven", "2.0", "2.0.0.Final", 0},
+		{"maven", "2.0-sp1", "2.0", 1},
+		{"maven", "2.0-foo", "2.0-sp1", 1},
+		{"maven", "1.0-alpha1", "1.0-a1", 0},
+		{"pypi", "1.0.dev1", "1.0a1", -1},
+		{"pypi", "1.0rc1", "1.0", -1},
+		{"pypi", "1.0.post1", "1.0", 1},
+		{"pypi", "1.0.post1", "1.0.1", -1},
+		{"pypi", "1.0", "1.0.0", 0},
+		{"deb", "1:1.0-1", "2.0-1", 1},
+		{"deb", "1.0~rc1-1", "1.0-1", -1},
+		{"deb", "1.0-1", "1.0-1ubuntu1", -1},
+		{"deb", "1.0+dfsg-1", "1.0-1", 1},
+		{"rpm", "1.0~rc1", "1.0", -1},
+		{"rpm", "1.0^git1", "1.0", 1},
+		{"rpm", "1.0a", "1.0.1", -1},
+		{"rpm", "2:1.0-1.el9", "1:2.0-1.el9", 1},
+		{"rpm", "1.0-2.el9", "1.0-10.el9", -1},
+	}
+	for _, tt := range tests {
+		if got := spdx.CompareVersions(tt.ecosystem, tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareVersions(%q, %q, %q) = %d, want %d", tt.ecosystem, tt.a, tt.b, got, tt.want)
+		}
+		if got := spdx.CompareVersions(tt.ecosystem, tt.b, tt.a); got != -tt.want {
+			t.Errorf("CompareVersions(%q, %q, %q) = %d, want %d", tt.ecosystem, tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+func TestPackage_CompareVersion(t *testing.T) {
+	pkg := spdx.NewPackage("urn:spdx:lib", "lib", "1.0-1", spdx.NewCreationInfo(nil))
+	pkg.PackageUrl = "pkg:deb/debian/lib@1.0-1"
+	if got := pkg.CompareVersion("1.0~rc1-1"); got != 1 {
+		t.Errorf("CompareVersion in the deb ecosystem = %d, want 1", got)
+	}
+	pkg.PackageUrl = ""
+	pkg.PackageVersion = "1.10.0"
+	if got := pkg.CompareVersion("1.9.0"); got != 1 {
+		t.Errorf("CompareVersion without a package URL = %d, want 1", got)
+	}
+}
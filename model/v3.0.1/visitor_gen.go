@@ -0,0 +1,624 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package spdx
+
+// Visitor has a method per concrete class of the model. Accept calls the
+// method for the type of the value it is called on, so a Visitor handles a
+// value by its concrete class without a type switch. Embed BaseVisitor to
+// implement only the methods of the classes of interest.
+type Visitor interface {
+	VisitAIPackage(*AIPackage) error
+	VisitEnergyConsumption(*EnergyConsumption) error
+	VisitEnergyConsumptionDescription(*EnergyConsumptionDescription) error
+	VisitBuild(*Build) error
+	VisitAgent(*Agent) error
+	VisitAnnotation(*Annotation) error
+	VisitBom(*Bom) error
+	VisitBundle(*Bundle) error
+	VisitCreationInfo(*CreationInfo) error
+	VisitDictionaryEntry(*DictionaryEntry) error
+	VisitExternalIdentifier(*ExternalIdentifier) error
+	VisitExternalMap(*ExternalMap) error
+	VisitExternalRef(*ExternalRef) error
+	VisitHash(*Hash) error
+	VisitIndividualElement(*IndividualElement) error
+	VisitLifecycleScopedRelationship(*LifecycleScopedRelationship) error
+	VisitNamespaceMap(*NamespaceMap) error
+	VisitOrganization(*Organization) error
+	VisitPackageVerificationCode(*PackageVerificationCode) error
+	VisitPerson(*Person) error
+	VisitPositiveIntegerRange(*PositiveIntegerRange) error
+	VisitRelationship(*Relationship) error
+	VisitSoftwareAgent(*SoftwareAgent) error
+	VisitSpdxDocument(*SpdxDocument) error
+	VisitTool(*Tool) error
+	VisitDatasetPackage(*DatasetPackage) error
+	VisitConjunctiveLicenseSet(*ConjunctiveLicenseSet) error
+	VisitCustomLicense(*CustomLicense) error
+	VisitCustomLicenseAddition(*CustomLicenseAddition) error
+	VisitDisjunctiveLicenseSet(*DisjunctiveLicenseSet) error
+	VisitIndividualLicensingInfo(*IndividualLicensingInfo) error
+	VisitListedLicense(*ListedLicense) error
+	VisitListedLicenseException(*ListedLicenseException) error
+	VisitOrLaterOperator(*OrLaterOperator) error
+	VisitWithAdditionOperator(*WithAdditionOperator) error
+	VisitCdxPropertiesExtension(*CdxPropertiesExtension) error
+	VisitCdxPropertyEntry(*CdxPropertyEntry) error
+	VisitCvssV2VulnAssessmentRelationship(*CvssV2VulnAssessmentRelationship) error
+	VisitCvssV3VulnAssessmentRelationship(*CvssV3VulnAssessmentRelationship) error
+	VisitCvssV4VulnAssessmentRelationship(*CvssV4VulnAssessmentRelationship) error
+	VisitEpssVulnAssessmentRelationship(*EpssVulnAssessmentRelationship) error
+	VisitExploitCatalogVulnAssessmentRelationship(*ExploitCatalogVulnAssessmentRelationship) error
+	VisitSsvcVulnAssessmentRelationship(*SsvcVulnAssessmentRelationship) error
+	VisitVexAffectedVulnAssessmentRelationship(*VexAffectedVulnAssessmentRelationship) error
+	VisitVexFixedVulnAssessmentRelationship(*VexFixedVulnAssessmentRelationship) error
+	VisitVexNotAffectedVulnAssessmentRelationship(*VexNotAffectedVulnAssessmentRelationship) error
+	VisitVexUnderInvestigationVulnAssessmentRelationship(*VexUnderInvestigationVulnAssessmentRelationship) error
+	VisitVulnerability(*Vulnerability) error
+	VisitLicenseExpression(*LicenseExpression) error
+	VisitSimpleLicensingText(*SimpleLicensingText) error
+	VisitContentIdentifier(*ContentIdentifier) error
+	VisitFile(*File) error
+	VisitPackage(*Package) error
+	VisitSbom(*Sbom) error
+	VisitSnippet(*Snippet) error
+}
+
+// Visitable is implemented by every concrete class of the model.
+type Visitable interface {
+	Accept(Visitor) error
+}
+
+// BaseVisitor implements Visitor with methods that do nothing and return
+// nil.
+type BaseVisitor struct{}
+
+// VisitAIPackage does nothing.
+func (BaseVisitor) VisitAIPackage(*AIPackage) error {
+	return nil
+}
+
+// VisitEnergyConsumption does nothing.
+func (BaseVisitor) VisitEnergyConsumption(*EnergyConsumption) error {
+	return nil
+}
+
+// VisitEnergyConsumptionDescription does nothing.
+func (BaseVisitor) VisitEnergyConsumptionDescription(*EnergyConsumptionDescription) error {
+	return nil
+}
+
+// VisitBuild does nothing.
+func (BaseVisitor) VisitBuild(*Build) error {
+	return nil
+}
+
+// VisitAgent does nothing.
+func (BaseVisitor) VisitAgent(*Agent) error {
+	return nil
+}
+
+// VisitAnnotation does nothing.
+func (BaseVisitor) VisitAnnotation(*Annotation) error {
+	return nil
+}
+
+// VisitBom does nothing.
+func (BaseVisitor) VisitBom(*Bom) error {
+	return nil
+}
+
+// VisitBundle does nothing.
+func (BaseVisitor) VisitBundle(*Bundle) error {
+	return nil
+}
+
+// VisitCreationInfo does nothing.
+func (BaseVisitor) VisitCreationInfo(*CreationInfo) error {
+	return nil
+}
+
+// VisitDictionaryEntry does nothing.
+func (BaseVisitor) VisitDictionaryEntry(*DictionaryEntry) error {
+	return nil
+}
+
+// VisitExternalIdentifier does nothing.
+func (BaseVisitor) VisitExternalIdentifier(*ExternalIdentifier) error {
+	return nil
+}
+
+// VisitExternalMap does nothing.
+func (BaseVisitor) VisitExternalMap(*ExternalMap) error {
+	return nil
+}
+
+// VisitExternalRef does nothing.
+func (BaseVisitor) VisitExternalRef(*ExternalRef) error {
+	return nil
+}
+
+// VisitHash does nothing.
+func (BaseVisitor) VisitHash(*Hash) error {
+	return nil
+}
+
+// VisitIndividualElement does nothing.
+func (BaseVisitor) VisitIndividualElement(*IndividualElement) error {
+	return nil
+}
+
+// VisitLifecycleScopedRelationship does nothing.
+func (BaseVisitor) VisitLifecycleScopedRelationship(*LifecycleScopedRelationship) error {
+	return nil
+}
+
+// VisitNamespaceMap does nothing.
+func (BaseVisitor) VisitNamespaceMap(*NamespaceMap) error {
+	return nil
+}
+
+// VisitOrganization does nothing.
+func (BaseVisitor) VisitOrganization(*Organization) error {
+	return nil
+}
+
+// VisitPackageVerificationCode does nothing.
+func (BaseVisitor) VisitPackageVerificationCode(*PackageVerificationCode) error {
+	return nil
+}
+
+// VisitPerson does nothing.
+func (BaseVisitor) VisitPerson(*Person) error {
+	return nil
+}
+
+// VisitPositiveIntegerRange does nothing.
+func (BaseVisitor) VisitPositiveIntegerRange(*PositiveIntegerRange) error {
+	return nil
+}
+
+// VisitRelationship does nothing.
+func (BaseVisitor) VisitRelationship(*Relationship) error {
+	return nil
+}
+
+// VisitSoftwareAgent does nothing.
+func (BaseVisitor) VisitSoftwareAgent(*SoftwareAgent) error {
+	return nil
+}
+
+// VisitSpdxDocument does nothing.
+func (BaseVisitor) VisitSpdxDocument(*SpdxDocument) error {
+	return nil
+}
+
+// VisitTool does nothing.
+func (BaseVisitor) VisitTool(*Tool) error {
+	return nil
+}
+
+// VisitDatasetPackage does nothing.
+func (BaseVisitor) VisitDatasetPackage(*DatasetPackage) error {
+	return nil
+}
+
+// VisitConjunctiveLicenseSet does nothing.
+func (BaseVisitor) VisitConjunctiveLicenseSet(*ConjunctiveLicenseSet) error {
+	return nil
+}
+
+// VisitCustomLicense does nothing.
+func (BaseVisitor) VisitCustomLicense(*CustomLicense) error {
+	return nil
+}
+
+// VisitCustomLicenseAddition does nothing.
+func (BaseVisitor) VisitCustomLicenseAddition(*CustomLicenseAddition) error {
+	return nil
+}
+
+// VisitDisjunctiveLicenseSet does nothing.
+func (BaseVisitor) VisitDisjunctiveLicenseSet(*DisjunctiveLicenseSet) error {
+	return nil
+}
+
+// VisitIndividualLicensingInfo does nothing.
+func (BaseVisitor) VisitIndividualLicensingInfo(*IndividualLicensingInfo) error {
+	return nil
+}
+
+// VisitListedLicense does nothing.
+func (BaseVisitor) VisitListedLicense(*ListedLicense) error {
+	return nil
+}
+
+// VisitListedLicenseException does nothing.
+func (BaseVisitor) VisitListedLicenseException(*ListedLicenseException) error {
+	return nil
+}
+
+// VisitOrLaterOperator does nothing.
+func (BaseVisitor) VisitOrLaterOperator(*OrLaterOperator) error {
+	return nil
+}
+
+// VisitWithAdditionOperator does nothing.
+func (BaseVisitor) VisitWithAdditionOperator(*WithAdditionOperator) error {
+	return nil
+}
+
+// VisitCdxPropertiesExtension does nothing.
+func (BaseVisitor) VisitCdxPropertiesExtension(*CdxPropertiesExtension) error {
+	return nil
+}
+
+// VisitCdxPropertyEntry does nothing.
+func (BaseVisitor) VisitCdxPropertyEntry(*CdxPropertyEntry) error {
+	return nil
+}
+
+// VisitCvssV2VulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitCvssV2VulnAssessmentRelationship(*CvssV2VulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitCvssV3VulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitCvssV3VulnAssessmentRelationship(*CvssV3VulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitCvssV4VulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitCvssV4VulnAssessmentRelationship(*CvssV4VulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitEpssVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitEpssVulnAssessmentRelationship(*EpssVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitExploitCatalogVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitExploitCatalogVulnAssessmentRelationship(*ExploitCatalogVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitSsvcVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitSsvcVulnAssessmentRelationship(*SsvcVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitVexAffectedVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitVexAffectedVulnAssessmentRelationship(*VexAffectedVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitVexFixedVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitVexFixedVulnAssessmentRelationship(*VexFixedVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitVexNotAffectedVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitVexNotAffectedVulnAssessmentRelationship(*VexNotAffectedVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitVexUnderInvestigationVulnAssessmentRelationship does nothing.
+func (BaseVisitor) VisitVexUnderInvestigationVulnAssessmentRelationship(*VexUnderInvestigationVulnAssessmentRelationship) error {
+	return nil
+}
+
+// VisitVulnerability does nothing.
+func (BaseVisitor) VisitVulnerability(*Vulnerability) error {
+	return nil
+}
+
+// VisitLicenseExpression does nothing.
+func (BaseVisitor) VisitLicenseExpression(*LicenseExpression) error {
+	return nil
+}
+
+// VisitSimpleLicensingText does nothing.
+func (BaseVisitor) VisitSimpleLicensingText(*SimpleLicensingText) error {
+	return nil
+}
+
+// VisitContentIdentifier does nothing.
+func (BaseVisitor) VisitContentIdentifier(*ContentIdentifier) error {
+	return nil
+}
+
+// VisitFile does nothing.
+func (BaseVisitor) VisitFile(*File) error {
+	return nil
+}
+
+// VisitPackage does nothing.
+func (BaseVisitor) VisitPackage(*Package) error {
+	return nil
+}
+
+// VisitSbom does nothing.
+func (BaseVisitor) VisitSbom(*Sbom) error {
+	return nil
+}
+
+// VisitSnippet does nothing.
+func (BaseVisitor) VisitSnippet(*Snippet) error {
+	return nil
+}
+
+// Accept calls the VisitAIPackage method of v.
+func (o *AIPackage) Accept(v Visitor) error {
+	return v.VisitAIPackage(o)
+}
+
+// Accept calls the VisitEnergyConsumption method of v.
+func (o *EnergyConsumption) Accept(v Visitor) error {
+	return v.VisitEnergyConsumption(o)
+}
+
+// Accept calls the VisitEnergyConsumptionDescription method of v.
+func (o *EnergyConsumptionDescription) Accept(v Visitor) error {
+	return v.VisitEnergyConsumptionDescription(o)
+}
+
+// Accept calls the VisitBuild method of v.
+func (o *Build) Accept(v Visitor) error {
+	return v.VisitBuild(o)
+}
+
+// Accept calls the VisitAgent method of v.
+func (o *Agent) Accept(v Visitor) error {
+	return v.VisitAgent(o)
+}
+
+// Accept calls the VisitAnnotation method of v.
+func (o *Annotation) Accept(v Visitor) error {
+	return v.VisitAnnotation(o)
+}
+
+// Accept calls the VisitBom method of v.
+func (o *Bom) Accept(v Visitor) error {
+	return v.VisitBom(o)
+}
+
+// Accept calls the VisitBundle method of v.
+func (o *Bundle) Accept(v Visitor) error {
+	return v.VisitBundle(o)
+}
+
+// Accept calls the VisitCreationInfo method of v.
+func (o *CreationInfo) Accept(v Visitor) error {
+	return v.VisitCreationInfo(o)
+}
+
+// Accept calls the VisitDictionaryEntry method of v.
+func (o *DictionaryEntry) Accept(v Visitor) error {
+	return v.VisitDictionaryEntry(o)
+}
+
+// Accept calls the VisitExternalIdentifier method of v.
+func (o *ExternalIdentifier) Accept(v Visitor) error {
+	return v.VisitExternalIdentifier(o)
+}
+
+// Accept calls the VisitExternalMap method of v.
+func (o *ExternalMap) Accept(v Visitor) error {
+	return v.VisitExternalMap(o)
+}
+
+// Accept calls the VisitExternalRef method of v.
+func (o *ExternalRef) Accept(v Visitor) error {
+	return v.VisitExternalRef(o)
+}
+
+// Accept calls the VisitHash method of v.
+func (o *Hash) Accept(v Visitor) error {
+	return v.VisitHash(o)
+}
+
+// Accept calls the VisitIndividualElement method of v.
+func (o *IndividualElement) Accept(v Visitor) error {
+	return v.VisitIndividualElement(o)
+}
+
+// Accept calls the VisitLifecycleScopedRelationship method of v.
+func (o *LifecycleScopedRelationship) Accept(v Visitor) error {
+	return v.VisitLifecycleScopedRelationship(o)
+}
+
+// Accept calls the VisitNamespaceMap method of v.
+func (o *NamespaceMap) Accept(v Visitor) error {
+	return v.VisitNamespaceMap(o)
+}
+
+// Accept calls the VisitOrganization method of v.
+func (o *Organization) Accept(v Visitor) error {
+	return v.VisitOrganization(o)
+}
+
+// Accept calls the VisitPackageVerificationCode method of v.
+func (o *PackageVerificationCode) Accept(v Visitor) error {
+	return v.VisitPackageVerificationCode(o)
+}
+
+// Accept calls the VisitPerson method of v.
+func (o *Person) Accept(v Visitor) error {
+	return v.VisitPerson(o)
+}
+
+// Accept calls the VisitPositiveIntegerRange method of v.
+func (o *PositiveIntegerRange) Accept(v Visitor) error {
+	return v.VisitPositiveIntegerRange(o)
+}
+
+// Accept calls the VisitRelationship method of v.
+func (o *Relationship) Accept(v Visitor) error {
+	return v.VisitRelationship(o)
+}
+
+// Accept calls the VisitSoftwareAgent method of v.
+func (o *SoftwareAgent) Accept(v Visitor) error {
+	return v.VisitSoftwareAgent(o)
+}
+
+// Accept calls the VisitSpdxDocument method of v.
+func (o *SpdxDocument) Accept(v Visitor) error {
+	return v.VisitSpdxDocument(o)
+}
+
+// Accept calls the VisitTool method of v.
+func (o *Tool) Accept(v Visitor) error {
+	return v.VisitTool(o)
+}
+
+// Accept calls the VisitDatasetPackage method of v.
+func (o *DatasetPackage) Accept(v Visitor) error {
+	return v.VisitDatasetPackage(o)
+}
+
+// Accept calls the VisitConjunctiveLicenseSet method of v.
+func (o *ConjunctiveLicenseSet) Accept(v Visitor) error {
+	return v.VisitConjunctiveLicenseSet(o)
+}
+
+// Accept calls the VisitCustomLicense method of v.
+func (o *CustomLicense) Accept(v Visitor) error {
+	return v.VisitCustomLicense(o)
+}
+
+// Accept calls the VisitCustomLicenseAddition method of v.
+func (o *CustomLicenseAddition) Accept(v Visitor) error {
+	return v.VisitCustomLicenseAddition(o)
+}
+
+// Accept calls the VisitDisjunctiveLicenseSet method of v.
+func (o *DisjunctiveLicenseSet) Accept(v Visitor) error {
+	return v.VisitDisjunctiveLicenseSet(o)
+}
+
+// Accept calls the VisitIndividualLicensingInfo method of v.
+func (o *IndividualLicensingInfo) Accept(v Visitor) error {
+	return v.VisitIndividualLicensingInfo(o)
+}
+
+// Accept calls the VisitListedLicense method of v.
+func (o *ListedLicense) Accept(v Visitor) error {
+	return v.VisitListedLicense(o)
+}
+
+// Accept calls the VisitListedLicenseException method of v.
+func (o *ListedLicenseException) Accept(v Visitor) error {
+	return v.VisitListedLicenseException(o)
+}
+
+// Accept calls the VisitOrLaterOperator method of v.
+func (o *OrLaterOperator) Accept(v Visitor) error {
+	return v.VisitOrLaterOperator(o)
+}
+
+// Accept calls the VisitWithAdditionOperator method of v.
+func (o *WithAdditionOperator) Accept(v Visitor) error {
+	return v.VisitWithAdditionOperator(o)
+}
+
+// Accept calls the VisitCdxPropertiesExtension method of v.
+func (o *CdxPropertiesExtension) Accept(v Visitor) error {
+	return v.VisitCdxPropertiesExtension(o)
+}
+
+// Accept calls the VisitCdxPropertyEntry method of v.
+func (o *CdxPropertyEntry) Accept(v Visitor) error {
+	return v.VisitCdxPropertyEntry(o)
+}
+
+// Accept calls the VisitCvssV2VulnAssessmentRelationship method of v.
+func (o *CvssV2VulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitCvssV2VulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitCvssV3VulnAssessmentRelationship method of v.
+func (o *CvssV3VulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitCvssV3VulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitCvssV4VulnAssessmentRelationship method of v.
+func (o *CvssV4VulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitCvssV4VulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitEpssVulnAssessmentRelationship method of v.
+func (o *EpssVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitEpssVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitExploitCatalogVulnAssessmentRelationship method of v.
+func (o *ExploitCatalogVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitExploitCatalogVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitSsvcVulnAssessmentRelationship method of v.
+func (o *SsvcVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitSsvcVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitVexAffectedVulnAssessmentRelationship method of v.
+func (o *VexAffectedVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitVexAffectedVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitVexFixedVulnAssessmentRelationship method of v.
+func (o *VexFixedVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitVexFixedVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitVexNotAffectedVulnAssessmentRelationship method of v.
+func (o *VexNotAffectedVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitVexNotAffectedVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitVexUnderInvestigationVulnAssessmentRelationship method of v.
+func (o *VexUnderInvestigationVulnAssessmentRelationship) Accept(v Visitor) error {
+	return v.VisitVexUnderInvestigationVulnAssessmentRelationship(o)
+}
+
+// Accept calls the VisitVulnerability method of v.
+func (o *Vulnerability) Accept(v Visitor) error {
+	return v.VisitVulnerability(o)
+}
+
+// Accept calls the VisitLicenseExpression method of v.
+func (o *LicenseExpression) Accept(v Visitor) error {
+	return v.VisitLicenseExpression(o)
+}
+
+// Accept calls the VisitSimpleLicensingText method of v.
+func (o *SimpleLicensingText) Accept(v Visitor) error {
+	return v.VisitSimpleLicensingText(o)
+}
+
+// Accept calls the VisitContentIdentifier method of v.
+func (o *ContentIdentifier) Accept(v Visitor) error {
+	return v.VisitContentIdentifier(o)
+}
+
+// Accept calls the VisitFile method of v.
+func (o *File) Accept(v Visitor) error {
+	return v.VisitFile(o)
+}
+
+// Accept calls the VisitPackage method of v.
+func (o *Package) Accept(v Visitor) error {
+	return v.VisitPackage(o)
+}
+
+// Accept calls the VisitSbom method of v.
+func (o *Sbom) Accept(v Visitor) error {
+	return v.VisitSbom(o)
+}
+
+// Accept calls the VisitSnippet method of v.
+func (o *Snippet) Accept(v Visitor) error {
+	return v.VisitSnippet(o)
+}
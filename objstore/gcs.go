@@ -0,0 +1,146 @@
+package objstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// gcsChunk is the unit of the chunks of resumable uploads.
+const gcsChunk = 256 << 10
+
+// GCSStore is a bucket of Google Cloud Storage, accessed with its JSON API.
+type GCSStore struct {
+	config
+	bucket string
+}
+
+// NewGCSStore creates a store of the Cloud Storage bucket with the given
+// options.
+func NewGCSStore(bucket string, opts ...Option) *GCSStore {
+	s := &GCSStore{config: newConfig(opts), bucket: bucket}
+	if s.endpoint == "" {
+		s.endpoint = "https://storage.googleapis.com"
+	}
+	s.partSize = (s.partSize + gcsChunk - 1) / gcsChunk * gcsChunk
+	return s
+}
+
+// Open opens the object at key for reading.
+func (s *GCSStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	u := s.endpoint + "/storage/v1/b/" + url.PathEscape(s.bucket) + "/o/" + url.PathEscape(key) + "?alt=media"
+	resp, err := s.do(ctx, s.request(http.MethodGet, u, nil, nil), http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("opening gs://%s/%s: %w", s.bucket, key, err)
+	}
+	return resp.Body, nil
+}
+
+// Create opens the object at key for writing. Objects larger than the
+// part size are written with a resumable upload, in chunks of the part
+// size, which is canceled if the upload fails.
+func (s *GCSStore) Create(ctx context.Context, key string) (io.WriteCloser, error) {
+	return newPartWriter(ctx, &gcsUpload{s: s, key: key}, s.partSize), nil
+}
+
+// request returns a function building an authorized request.
+func (s *GCSStore) request(method, u string, body []byte, header http.Header) func(context.Context) (*http.Request, error) {
+	build := bodyRequest(method, u, body, header)
+	return func(ctx context.Context) (*http.Request, error) {
+		req, err := build(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if s.token != nil {
+			token, err := s.token(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("getting access token: %w", err)
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		return req, nil
+	}
+}
+
+// gcsUpload uploads an object in one request, or with a resumable upload.
+type gcsUpload struct {
+	s       *GCSStore
+	key     string
+	session string
+	last    []byte
+	offset  int64
+}
+
+func (u *gcsUpload) uploadURL(uploadType string) string {
+	return u.s.endpoint + "/upload/storage/v1/b/" + url.PathEscape(u.s.bucket) + "/o?" +
+		url.Values{"uploadType": {uploadType}, "name": {u.key}}.Encode()
+}
+
+func (u *gcsUpload) put(ctx context.Context, data []byte) error {
+	header := http.Header{"Content-Type": {ContentType}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPost, u.uploadURL("media"), data, header), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (u *gcsUpload) start(ctx context.Context) error {
+	header := http.Header{"X-Upload-Content-Type": {ContentType}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPost, u.uploadURL("resumable"), nil, header), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	u.session = resp.Header.Get("Location")
+	if u.session == "" {
+		return errors.New("no upload session in response")
+	}
+	return nil
+}
+
+// part uploads a chunk of the object, which the service acknowledges with
+// a 308 status until the upload is complete. Only the last chunk may be
+// shorter than the chunk unit, and is sent with the size of the object.
+func (u *gcsUpload) part(ctx context.Context, _ int, offset int64, data []byte) error {
+	if len(data)%gcsChunk != 0 {
+		u.last, u.offset = data, offset
+		return nil
+	}
+	end := offset + int64(len(data)) - 1
+	header := http.Header{"Content-Range": {"bytes " + strconv.FormatInt(offset, 10) + "-" + strconv.FormatInt(end, 10) + "/*"}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPut, u.session, data, header), http.StatusPermanentRedirect)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// complete uploads the last chunk with the size of the object, or only
+// declares the size once all its chunks are uploaded.
+func (u *gcsUpload) complete(ctx context.Context, size int64) error {
+	contentRange := "bytes */" + strconv.FormatInt(size, 10)
+	if len(u.last) > 0 {
+		contentRange = "bytes " + strconv.FormatInt(u.offset, 10) + "-" + strconv.FormatInt(size-1, 10) + "/" + strconv.FormatInt(size, 10)
+	}
+	header := http.Header{"Content-Range": {contentRange}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPut, u.session, u.last, header), http.StatusOK, http.StatusCreated)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// abort cancels the upload session, to which the service responds with a
+// 499 status.
+func (u *gcsUpload) abort(ctx context.Context) error {
+	resp, err := u.s.do(ctx, u.s.request(http.MethodDelete, u.session, nil, nil), 499, http.StatusNoContent)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
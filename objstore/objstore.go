@@ -0,0 +1,420 @@
+// Package objstore reads and writes SPDX documents in cloud object stores:
+// Amazon S3, Google Cloud Storage and Azure Blob Storage, or services
+// compatible with their APIs such as MinIO.
+//
+//	store := objstore.NewS3Store("acme-sboms", objstore.WithRegion("eu-west-1"))
+//	doc, err := objstore.ReadDocument(ctx, store, "app/1.0.0.spdx.json", parse.NewReader())
+//	err = objstore.Upload(ctx, store, "app/1.0.0.spdx.json", bytes.NewReader(data))
+//
+// Objects are streamed: reads do not load the object before parsing
+// starts, and writes are uploaded in parts, with multipart uploads,
+// resumable uploads or block lists, once they exceed the part size.
+// Requests failing with a network error, a 429 or a 5xx status are retried
+// with exponential backoff.
+package objstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// ContentType is the content type of the objects written.
+const ContentType = "application/spdx+json"
+
+// DefaultPartSize is the size of the parts of uploads, unless WithPartSize
+// sets another.
+const DefaultPartSize = 8 << 20
+
+// Store is an object store.
+type Store interface {
+	// Open opens the object at key for reading. It returns an error
+	// wrapping fs.ErrNotExist if there is no such object.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Create opens the object at key for writing, replacing it once the
+	// writer is closed, and not before. If ctx is canceled before then, the
+	// upload is abandoned and Close fails.
+	Create(ctx context.Context, key string) (io.WriteCloser, error)
+}
+
+// ReadDocument reads the document at key in s with r, as it is
+// downloaded.
+func ReadDocument(ctx context.Context, s Store, key string, r *parse.Reader) (*parse.Document, error) {
+	body, err := s.Open(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	doc, err := r.FromReader(body)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", key, err)
+	}
+	return doc, nil
+}
+
+// FileReader returns a function reading the objects of s, by key, for
+// parse.WithFileReader, so that Reader.ReadFile reads from the store.
+func FileReader(ctx context.Context, s Store) func(string) ([]byte, error) {
+	return func(key string) ([]byte, error) {
+		body, err := s.Open(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+}
+
+// Upload writes the content of src to the object at key in s.
+// If reading src fails, the upload is abandoned.
+func Upload(ctx context.Context, s Store, key string, src io.Reader) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w, err := s.Create(ctx, key)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, src); err != nil {
+		cancel()
+		_ = w.Close()
+		return fmt.Errorf("uploading %s: %w", key, err)
+	}
+	return w.Close()
+}
+
+// Option configures a store.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	httpClient *http.Client
+	endpoint   string
+	attempts   int
+	backoff    time.Duration
+	partSize   int
+	now        func() time.Time
+
+	// S3
+	region       string
+	accessKeyID  string
+	secretKey    string
+	sessionToken string
+
+	// Google Cloud Storage
+	token TokenSource
+
+	// Azure Blob Storage
+	sharedKey string
+	sas       string
+}
+
+// TokenSource returns an OAuth 2.0 access token for requests.
+type TokenSource func(ctx context.Context) (string, error)
+
+func newConfig(opts []Option) config {
+	c := config{
+		httpClient: http.DefaultClient,
+		attempts:   4,
+		backoff:    250 * time.Millisecond,
+		partSize:   DefaultPartSize,
+		now:        time.Now,
+
+		region:       os.Getenv("AWS_REGION"),
+		accessKeyID:  os.Getenv("AWS_ACCESS_KEY_ID"),
+		secretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		sessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+		sharedKey:    os.Getenv("AZURE_STORAGE_KEY"),
+		sas:          strings.TrimPrefix(os.Getenv("AZURE_STORAGE_SAS_TOKEN"), "?"),
+	}
+	if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		c.token = func(context.Context) (string, error) { return token, nil }
+	}
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+	return c
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(c *config) {
+		c.httpClient = client
+	})
+}
+
+// WithEndpoint sets the base URL of the service, e.g. for MinIO, a storage
+// emulator or a test server. Buckets and containers are then addressed in
+// the path of the URL rather than its host.
+func WithEndpoint(endpoint string) Option {
+	return optionFunc(func(c *config) {
+		c.endpoint = strings.TrimSuffix(endpoint, "/")
+	})
+}
+
+// WithRetry sets the number of attempts of each request, at least one, and
+// the delay before the first retry, which doubles with every retry.
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return optionFunc(func(c *config) {
+		c.attempts = max(attempts, 1)
+		c.backoff = backoff
+	})
+}
+
+// WithPartSize sets the size of the parts of uploads: objects up to that
+// size are written in one request, and larger ones in parts of that size.
+// S3 requires parts of at least 5 MiB; Google Cloud Storage, multiples of
+// 256 KiB, to which the size is rounded up.
+func WithPartSize(size int) Option {
+	return optionFunc(func(c *config) {
+		if size > 0 {
+			c.partSize = size
+		}
+	})
+}
+
+// WithRegion sets the AWS region of an S3 bucket, by default that of the
+// AWS_REGION environment variable, or else us-east-1.
+func WithRegion(region string) Option {
+	return optionFunc(func(c *config) {
+		c.region = region
+	})
+}
+
+// WithAWSCredentials sets the credentials S3 requests are signed with, by
+// default those of the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables. The session token is only set
+// for temporary credentials. Requests are unsigned without credentials.
+func WithAWSCredentials(accessKeyID, secretKey, sessionToken string) Option {
+	return optionFunc(func(c *config) {
+		c.accessKeyID, c.secretKey, c.sessionToken = accessKeyID, secretKey, sessionToken
+	})
+}
+
+// WithTokenSource sets the source of the access tokens of Google Cloud
+// Storage requests, by default the GOOGLE_OAUTH_ACCESS_TOKEN environment
+// variable. Requests are anonymous without a token.
+func WithTokenSource(source TokenSource) Option {
+	return optionFunc(func(c *config) {
+		c.token = source
+	})
+}
+
+// WithAzureSharedKey sets the base64 account key Azure requests are signed
+// with, by default that of the AZURE_STORAGE_KEY environment variable.
+func WithAzureSharedKey(accountKey string) Option {
+	return optionFunc(func(c *config) {
+		c.sharedKey = accountKey
+	})
+}
+
+// WithAzureSAS sets a shared access signature, the query string granting
+// access to the container, used instead of a shared key; by default that
+// of the AZURE_STORAGE_SAS_TOKEN environment variable.
+func WithAzureSAS(token string) Option {
+	return optionFunc(func(c *config) {
+		c.sas = strings.TrimPrefix(token, "?")
+	})
+}
+
+// StatusError is returned, wrapped, when a service responds with an
+// unexpected status.
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Message)
+}
+
+// Is makes a 404 status match fs.ErrNotExist.
+func (e *StatusError) Is(target error) bool {
+	return target == fs.ErrNotExist && e.StatusCode == http.StatusNotFound
+}
+
+// do sends the request built by newRequest, built anew for every attempt,
+// until the response has one of the statuses in want, or the attempts are
+// exhausted. Network errors, 429 and 5xx statuses are retried.
+func (c *config) do(ctx context.Context, newRequest func(ctx context.Context) (*http.Request, error), want ...int) (*http.Response, error) {
+	var err error
+	for attempt := range c.attempts {
+		if attempt > 0 {
+			delay := c.backoff << (attempt - 1)
+			delay += rand.N(delay/2 + 1)
+			if ra, ok := err.(*retryAfter); ok && ra.delay > delay {
+				delay = ra.delay
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+		req, rerr := newRequest(ctx)
+		if rerr != nil {
+			return nil, rerr
+		}
+		resp, derr := c.httpClient.Do(req)
+		if derr != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			err = derr
+			continue
+		}
+		if slices.Contains(want, resp.StatusCode) {
+			return resp, nil
+		}
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		resp.Body.Close()
+		serr := &StatusError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(msg))}
+		if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+			return nil, serr
+		}
+		err = &retryAfter{StatusError: serr}
+		if s, perr := strconv.Atoi(resp.Header.Get("Retry-After")); perr == nil {
+			err.(*retryAfter).delay = time.Duration(s) * time.Second
+		}
+	}
+	if ra, ok := err.(*retryAfter); ok {
+		return nil, ra.StatusError
+	}
+	return nil, err
+}
+
+// retryAfter is a retryable status, with the delay the service asks for.
+type retryAfter struct {
+	*StatusError
+	delay time.Duration
+}
+
+// uploader uploads an object, in one request or in parts.
+type uploader interface {
+	put(ctx context.Context, data []byte) error
+	start(ctx context.Context) error
+	part(ctx context.Context, n int, offset int64, data []byte) error
+	complete(ctx context.Context, size int64) error
+	abort(ctx context.Context) error
+}
+
+// partWriter buffers writes into parts of an uploader.
+type partWriter struct {
+	ctx      context.Context
+	up       uploader
+	size     int
+	buf      []byte
+	parts    int
+	written  int64
+	started  bool
+	err      error
+	finished bool
+}
+
+func newPartWriter(ctx context.Context, up uploader, size int) *partWriter {
+	return &partWriter{ctx: ctx, up: up, size: size}
+}
+
+func (w *partWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	if w.finished {
+		return 0, errors.New("write to closed object")
+	}
+	w.buf = append(w.buf, p...)
+	for len(w.buf) > w.size {
+		if err := w.flush(w.buf[:w.size]); err != nil {
+			return 0, err
+		}
+		w.buf = slices.Delete(w.buf, 0, w.size)
+	}
+	return len(p), nil
+}
+
+// flush uploads a part, starting the upload first if it is the first one.
+func (w *partWriter) flush(data []byte) error {
+	if !w.started {
+		if err := w.up.start(w.ctx); err != nil {
+			w.err = fmt.Errorf("starting upload: %w", err)
+			return w.err
+		}
+		w.started = true
+	}
+	w.parts++
+	if err := w.up.part(w.ctx, w.parts, w.written, data); err != nil {
+		return w.abortWith(w.ctx, fmt.Errorf("uploading part %d: %w", w.parts, err))
+	}
+	w.written += int64(len(data))
+	return nil
+}
+
+// abortWith abandons the upload and fails the writer with err.
+func (w *partWriter) abortWith(ctx context.Context, err error) error {
+	if w.started && !w.finished {
+		// Abort even once ctx is canceled, as that is the usual cause.
+		_ = w.up.abort(context.WithoutCancel(ctx))
+	}
+	w.finished = true
+	if w.err == nil {
+		w.err = err
+	}
+	return w.err
+}
+
+func (w *partWriter) Close() error {
+	if w.err != nil || w.finished {
+		return w.err
+	}
+	if err := w.ctx.Err(); err != nil {
+		return w.abortWith(w.ctx, err)
+	}
+	if !w.started {
+		w.finished = true
+		if err := w.up.put(w.ctx, w.buf); err != nil {
+			w.err = fmt.Errorf("uploading: %w", err)
+		}
+		return w.err
+	}
+	if len(w.buf) > 0 {
+		if err := w.flush(w.buf); err != nil {
+			return err
+		}
+	}
+	if err := w.up.complete(w.ctx, w.written); err != nil {
+		return w.abortWith(w.ctx, fmt.Errorf("completing upload: %w", err))
+	}
+	w.finished = true
+	return nil
+}
+
+// bodyRequest returns a function building a request with a copy of body.
+func bodyRequest(method, url string, body []byte, header http.Header) func(context.Context) (*http.Request, error) {
+	return func(ctx context.Context) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
+		for k, v := range header {
+			req.Header[k] = slices.Clone(v)
+		}
+		return req, nil
+	}
+}
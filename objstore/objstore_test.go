@@ -0,0 +1,347 @@
+package objstore_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/objstore"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// fakeService keeps the objects of an object store in memory, failing the
+// first request of every upload or download with a 503 status.
+type fakeService struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	parts   map[string][]byte
+	pending []byte
+	auth    []string
+	aborted bool
+	failed  map[string]bool
+}
+
+func newFakeService() *fakeService {
+	return &fakeService{objects: map[string][]byte{}, parts: map[string][]byte{}, failed: map[string]bool{}}
+}
+
+// failOnce reports whether the request is the first of its kind, which
+// the caller fails to exercise retries.
+func (f *fakeService) failOnce(w http.ResponseWriter, r *http.Request) bool {
+	kind := r.Method + " " + r.URL.Query().Get("comp") + r.URL.Query().Get("uploadType")
+	if f.failed[kind] {
+		return false
+	}
+	f.failed[kind] = true
+	w.Header().Set("Retry-After", "0")
+	http.Error(w, "slow down", http.StatusServiceUnavailable)
+	return true
+}
+
+func (f *fakeService) get(w http.ResponseWriter, key string) {
+	data, ok := f.objects[key]
+	if !ok {
+		http.Error(w, "no such key", http.StatusNotFound)
+		return
+	}
+	w.Write(data)
+}
+
+// s3 serves the S3 API with path-style addressing.
+func (f *fakeService) s3(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.auth = append(f.auth, r.Header.Get("Authorization"))
+	if f.failOnce(w, r) {
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/sboms/")
+	body, _ := io.ReadAll(r.Body)
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet:
+		f.get(w, key)
+	case r.Method == http.MethodPut && q.Has("partNumber"):
+		f.parts[q.Get("partNumber")] = body
+		w.Header().Set("ETag", `"etag-`+q.Get("partNumber")+`"`)
+	case r.Method == http.MethodPut:
+		f.objects[key] = body
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		fmt.Fprint(w, `<InitiateMultipartUploadResult><UploadId>upload-1</UploadId></InitiateMultipartUploadResult>`)
+	case r.Method == http.MethodPost:
+		var complete struct {
+			Parts []struct {
+				PartNumber string
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, p := range complete.Parts {
+			if p.ETag != `"etag-`+p.PartNumber+`"` {
+				http.Error(w, "invalid part", http.StatusBadRequest)
+				return
+			}
+			data = append(data, f.parts[p.PartNumber]...)
+		}
+		f.objects[key] = data
+		fmt.Fprint(w, `<CompleteMultipartUploadResult/>`)
+	case r.Method == http.MethodDelete:
+		f.aborted = true
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// gcs serves the JSON API of Cloud Storage, with resumable uploads.
+func (f *fakeService) gcs(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.auth = append(f.auth, r.Header.Get("Authorization"))
+	if f.failOnce(w, r) {
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet && q.Get("alt") == "media":
+		f.get(w, strings.TrimPrefix(r.URL.Path, "/storage/v1/b/sboms/o/"))
+	case q.Get("uploadType") == "media":
+		f.objects[q.Get("name")] = body
+	case q.Get("uploadType") == "resumable":
+		f.pending = nil
+		w.Header().Set("Location", "http://"+r.Host+"/session/"+q.Get("name"))
+	case r.Method == http.MethodPut:
+		var start, end, size int
+		if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &size); err == nil && start == len(f.pending) {
+			f.pending = append(f.pending, body...)
+		} else {
+			fmt.Sscanf(r.Header.Get("Content-Range"), "bytes */%d", &size)
+		}
+		if size > 0 {
+			if size != len(f.pending) {
+				http.Error(w, "size mismatch", http.StatusBadRequest)
+				return
+			}
+			f.objects[strings.TrimPrefix(r.URL.Path, "/session/")] = f.pending
+			return
+		}
+		fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/*", &start, &end)
+		if start != len(f.pending) || end != start+len(body)-1 || len(body)%(256<<10) != 0 {
+			http.Error(w, "invalid range", http.StatusBadRequest)
+			return
+		}
+		f.pending = append(f.pending, body...)
+		w.WriteHeader(http.StatusPermanentRedirect)
+	case r.Method == http.MethodDelete:
+		f.aborted = true
+		w.WriteHeader(499)
+	}
+}
+
+// azure serves the Blob Storage API, with block blobs.
+func (f *fakeService) azure(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.auth = append(f.auth, r.Header.Get("Authorization")+r.URL.Query().Get("sig"))
+	if f.failOnce(w, r) {
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/devstoreaccount1/sboms/")
+	body, _ := io.ReadAll(r.Body)
+	q := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet:
+		f.get(w, key)
+	case q.Get("comp") == "block":
+		f.parts[q.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case q.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string
+		}
+		if err := xml.Unmarshal(body, &list); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, id := range list.Latest {
+			data = append(data, f.parts[id]...)
+		}
+		f.objects[key] = data
+		w.WriteHeader(http.StatusCreated)
+	case r.Header.Get("X-Ms-Blob-Type") == "BlockBlob":
+		f.objects[key] = body
+		w.WriteHeader(http.StatusCreated)
+	default:
+		http.Error(w, "invalid request", http.StatusBadRequest)
+	}
+}
+
+type storeTest struct {
+	name     string
+	handler  func(*fakeService) http.HandlerFunc
+	newStore func(opts ...objstore.Option) objstore.Store
+	auth     string
+	partSize int
+}
+
+var storeTests = []storeTest{
+	{
+		name:    "s3",
+		handler: func(f *fakeService) http.HandlerFunc { return f.s3 },
+		newStore: func(opts ...objstore.Option) objstore.Store {
+			return objstore.NewS3Store("sboms", append(opts, objstore.WithRegion("eu-west-1"),
+				objstore.WithAWSCredentials("AKIDEXAMPLE", "secret", ""))...)
+		},
+		auth:     "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/",
+		partSize: 5 << 20,
+	},
+	{
+		name:    "gcs",
+		handler: func(f *fakeService) http.HandlerFunc { return f.gcs },
+		newStore: func(opts ...objstore.Option) objstore.Store {
+			return objstore.NewGCSStore("sboms", append(opts, objstore.WithTokenSource(func(context.Context) (string, error) {
+				return "ya29.token", nil
+			}))...)
+		},
+		auth:     "Bearer ya29.token",
+		partSize: 256 << 10,
+	},
+	{
+		name:    "azure shared key",
+		handler: func(f *fakeService) http.HandlerFunc { return f.azure },
+		newStore: func(opts ...objstore.Option) objstore.Store {
+			return objstore.NewAzureStore("devstoreaccount1", "sboms", append(opts, objstore.WithAzureSAS(""),
+				objstore.WithAzureSharedKey("a2V5"))...)
+		},
+		auth:     "SharedKey devstoreaccount1:",
+		partSize: 1 << 20,
+	},
+	{
+		name:    "azure sas",
+		handler: func(f *fakeService) http.HandlerFunc { return f.azure },
+		newStore: func(opts ...objstore.Option) objstore.Store {
+			return objstore.NewAzureStore("devstoreaccount1", "sboms", append(opts, objstore.WithAzureSAS("?sv=2021-08-06&sig=c2ln"))...)
+		},
+		auth:     "c2ln",
+		partSize: 1 << 20,
+	},
+}
+
+func (tt storeTest) start(t *testing.T, opts ...objstore.Option) (*fakeService, objstore.Store) {
+	t.Helper()
+	f := newFakeService()
+	srv := httptest.NewServer(tt.handler(f))
+	t.Cleanup(srv.Close)
+	endpoint := srv.URL
+	if strings.HasPrefix(tt.name, "azure") {
+		endpoint += "/devstoreaccount1"
+	}
+	opts = append([]objstore.Option{objstore.WithEndpoint(endpoint), objstore.WithRetry(3, time.Millisecond)}, opts...)
+	return f, tt.newStore(opts...)
+}
+
+func TestStores(t *testing.T) {
+	ctx := context.Background()
+	doc := testsbom.JSON(t, "1.0.0")
+	for _, tt := range storeTests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, store := tt.start(t, objstore.WithPartSize(tt.partSize))
+
+			large := bytes.Repeat([]byte("0123456789abcdef"), tt.partSize/16*2+1000)
+			for key, data := range map[string][]byte{"app/1.0.0.spdx.json": doc, "large.bin": large} {
+				if err := objstore.Upload(ctx, store, key, bytes.NewReader(data)); err != nil {
+					t.Fatalf("Upload %s: %v", key, err)
+				}
+				if !bytes.Equal(f.objects[key], data) {
+					t.Errorf("object %s has %d bytes, want %d", key, len(f.objects[key]), len(data))
+				}
+			}
+
+			got, err := objstore.ReadDocument(ctx, store, "app/1.0.0.spdx.json", parse.NewReader())
+			if err != nil {
+				t.Fatalf("ReadDocument: %v", err)
+			}
+			if len(got.Packages) != 4 || got.Packages[0].Name != "app" {
+				t.Errorf("ReadDocument packages = %v", got.Packages)
+			}
+			r := parse.NewReader(parse.WithFileReader(objstore.FileReader(ctx, store)))
+			if _, err := r.ReadFile("app/1.0.0.spdx.json"); err != nil {
+				t.Errorf("ReadFile: %v", err)
+			}
+
+			if _, err := store.Open(ctx, "missing.spdx.json"); !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("Open missing = %v, want fs.ErrNotExist", err)
+			}
+			for _, auth := range f.auth {
+				if !strings.Contains(auth, tt.auth) {
+					t.Errorf("authorization = %q, want %q", auth, tt.auth)
+					break
+				}
+			}
+		})
+	}
+}
+
+// failingReader fails once n bytes are read.
+type failingReader struct{ n int }
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.n <= 0 {
+		return 0, errors.New("disk failure")
+	}
+	n := min(len(p), r.n)
+	r.n -= n
+	return n, nil
+}
+
+func TestUpload_Abort(t *testing.T) {
+	for _, tt := range storeTests[:2] {
+		t.Run(tt.name, func(t *testing.T) {
+			f, store := tt.start(t, objstore.WithPartSize(tt.partSize))
+			err := objstore.Upload(context.Background(), store, "large.bin", &failingReader{n: 3 * tt.partSize})
+			if err == nil || !strings.Contains(err.Error(), "disk failure") {
+				t.Fatalf("Upload = %v, want read error", err)
+			}
+			if !f.aborted {
+				t.Error("upload not aborted")
+			}
+			if _, ok := f.objects["large.bin"]; ok {
+				t.Error("object written")
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	store := objstore.NewS3Store("sboms", objstore.WithEndpoint(srv.URL), objstore.WithRetry(3, time.Millisecond))
+	_, err := store.Open(context.Background(), "app.spdx.json")
+	var serr *objstore.StatusError
+	if !errors.As(err, &serr) || serr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Open = %v, want status error", err)
+	}
+	if calls != 3 {
+		t.Errorf("%d attempts, want 3", calls)
+	}
+}
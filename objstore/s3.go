@@ -0,0 +1,280 @@
+package objstore
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// S3Store is a bucket of Amazon S3, or of a service compatible with its
+// API, whose requests are signed with AWS Signature Version 4.
+type S3Store struct {
+	config
+	bucket string
+}
+
+// NewS3Store creates a store of the S3 bucket with the given options.
+func NewS3Store(bucket string, opts ...Option) *S3Store {
+	s := &S3Store{config: newConfig(opts), bucket: bucket}
+	if s.region == "" {
+		s.region = "us-east-1"
+	}
+	return s
+}
+
+// Open opens the object at key for reading.
+func (s *S3Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, s.request(http.MethodGet, key, nil, nil, nil), http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("opening s3://%s/%s: %w", s.bucket, key, err)
+	}
+	return resp.Body, nil
+}
+
+// Create opens the object at key for writing. Objects larger than the
+// part size are written with a multipart upload, which is aborted if the
+// upload fails.
+func (s *S3Store) Create(ctx context.Context, key string) (io.WriteCloser, error) {
+	return newPartWriter(ctx, &s3Upload{s: s, key: key}, s.partSize), nil
+}
+
+// url returns the URL of the object at key: virtual-hosted on AWS, in the
+// path of the endpoint otherwise. The path and query are escaped as
+// Signature Version 4 requires.
+func (s *S3Store) url(key string, query url.Values) (*url.URL, error) {
+	path := "/" + awsEscape(key, true)
+	var u *url.URL
+	if s.endpoint == "" {
+		host := "s3." + s.region + ".amazonaws.com"
+		if s.region == "us-east-1" {
+			host = "s3.amazonaws.com"
+		}
+		u = &url.URL{Scheme: "https", Host: s.bucket + "." + host}
+	} else {
+		var err error
+		if u, err = url.Parse(s.endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint: %w", err)
+		}
+		path = u.EscapedPath() + "/" + awsEscape(s.bucket, false) + path
+	}
+	var err error
+	if u.Path, err = url.PathUnescape(path); err != nil {
+		return nil, fmt.Errorf("invalid endpoint: %w", err)
+	}
+	u.RawPath = path
+	u.RawQuery = canonicalQuery(query)
+	return u, nil
+}
+
+// request returns a function building a signed request for the object at
+// key.
+func (s *S3Store) request(method, key string, query url.Values, body []byte, header http.Header) func(context.Context) (*http.Request, error) {
+	return func(ctx context.Context) (*http.Request, error) {
+		u, err := s.url(key, query)
+		if err != nil {
+			return nil, err
+		}
+		req, err := bodyRequest(method, u.String(), body, header)(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.URL = u
+		s.sign(req, body)
+		return req, nil
+	}
+}
+
+// sign signs req, whose body is payload, with AWS Signature Version 4.
+// Requests are not signed without credentials.
+func (s *S3Store) sign(req *http.Request, payload []byte) {
+	if s.accessKeyID == "" {
+		return
+	}
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256.Sum256(payload)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if strings.HasPrefix(name, "x-amz-") || name == "content-type" || name == "content-md5" || name == "range" {
+			headers[name] = strings.Join(values, ",")
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.Join(strings.Fields(headers[name]), " ") + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// awsEscape escapes s as Signature Version 4 requires: every byte but the
+// unreserved characters of RFC 3986, and "/" if keepSlash.
+func awsEscape(s string, keepSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && keepSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// canonicalQuery encodes query sorted by name, as Signature Version 4
+// requires.
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var parts []string
+	for _, name := range names {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, awsEscape(name, false)+"="+awsEscape(v, false))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// s3Upload uploads an object with a PUT, or a multipart upload.
+type s3Upload struct {
+	s        *S3Store
+	key      string
+	uploadID string
+	parts    []s3Part
+}
+
+type s3Part struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+func (u *s3Upload) put(ctx context.Context, data []byte) error {
+	header := http.Header{"Content-Type": {ContentType}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPut, u.key, nil, data, header), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (u *s3Upload) start(ctx context.Context) error {
+	header := http.Header{"Content-Type": {ContentType}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPost, u.key, url.Values{"uploads": {""}}, nil, header), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decoding upload: %w", err)
+	}
+	if result.UploadID == "" {
+		return errors.New("no upload ID in response")
+	}
+	u.uploadID = result.UploadID
+	return nil
+}
+
+func (u *s3Upload) part(ctx context.Context, n int, _ int64, data []byte) error {
+	query := url.Values{"partNumber": {strconv.Itoa(n)}, "uploadId": {u.uploadID}}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPut, u.key, query, data, nil), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	u.parts = append(u.parts, s3Part{PartNumber: n, ETag: resp.Header.Get("ETag")})
+	return nil
+}
+
+func (u *s3Upload) complete(ctx context.Context, _ int64) error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		Parts   []s3Part `xml:"Part"`
+	}{Parts: u.parts})
+	if err != nil {
+		return fmt.Errorf("encoding parts: %w", err)
+	}
+	resp, err := u.s.do(ctx, u.s.request(http.MethodPost, u.key, url.Values{"uploadId": {u.uploadID}}, body, nil), http.StatusOK)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// S3 may report a failure with a 200 status once it has started
+	// responding.
+	var result struct {
+		XMLName xml.Name
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err == nil && result.XMLName.Local == "Error" {
+		return fmt.Errorf("%s: %s", result.Code, result.Message)
+	}
+	return nil
+}
+
+func (u *s3Upload) abort(ctx context.Context) error {
+	resp, err := u.s.do(ctx, u.s.request(http.MethodDelete, u.key, url.Values{"uploadId": {u.uploadID}}, nil, nil), http.StatusNoContent, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,116 @@
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// authenticator authorizes requests as registries ask to, with basic
+// authentication or bearer tokens from their token service, kept per
+// repository.
+type authenticator struct {
+	*config
+	mu     sync.Mutex
+	basic  map[string]bool
+	tokens map[string]string
+}
+
+func (a *authenticator) key(ref Reference) string {
+	return ref.Registry + "/" + ref.Repository
+}
+
+// authorize adds the credentials obtained for the repository of ref to
+// req.
+func (a *authenticator) authorize(req *http.Request, ref Reference) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if token, ok := a.tokens[a.key(ref)]; ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	} else if a.basic[ref.Registry] {
+		req.SetBasicAuth(a.username, a.password)
+	}
+}
+
+var challengeParam = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// challenge obtains credentials for the repository of ref as the
+// WWW-Authenticate header of a response asks.
+func (a *authenticator) challenge(ctx context.Context, ref Reference, header string) error {
+	scheme, params, _ := strings.Cut(header, " ")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if a.username == "" {
+			return errors.New("registry requires credentials")
+		}
+		a.mu.Lock()
+		if a.basic == nil {
+			a.basic = map[string]bool{}
+		}
+		a.basic[ref.Registry] = true
+		a.mu.Unlock()
+		return nil
+	case "bearer":
+	default:
+		return fmt.Errorf("unsupported authentication challenge %q", header)
+	}
+
+	challenge := map[string]string{}
+	for _, m := range challengeParam.FindAllStringSubmatch(params, -1) {
+		challenge[m[1]] = m[2]
+	}
+	realm, err := url.Parse(challenge["realm"])
+	if err != nil || challenge["realm"] == "" {
+		return fmt.Errorf("invalid authentication realm %q", challenge["realm"])
+	}
+	q := realm.Query()
+	if service := challenge["service"]; service != "" {
+		q.Set("service", service)
+	}
+	scope := challenge["scope"]
+	if scope == "" {
+		scope = "repository:" + ref.Repository + ":pull,push"
+	}
+	q.Set("scope", scope)
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return fmt.Errorf("creating token request: %w", err)
+	}
+	if a.username != "" {
+		req.SetBasicAuth(a.username, a.password)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("requesting token: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("requesting token: %w", newStatusError(resp))
+	}
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("decoding token: %w", err)
+	}
+	token := body.Token
+	if token == "" {
+		token = body.AccessToken
+	}
+	if token == "" {
+		return errors.New("no token in response")
+	}
+	a.mu.Lock()
+	a.tokens[a.key(ref)] = token
+	a.mu.Unlock()
+	return nil
+}
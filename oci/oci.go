@@ -0,0 +1,590 @@
+// Package oci attaches SPDX documents to container images in OCI
+// registries, as artifacts referring to the image through the referrers
+// API of the OCI Distribution Specification 1.1.
+//
+//	client := oci.NewClient(oci.WithBasicAuth(user, password))
+//	desc, err := client.Push(ctx, "registry.acme.example/app:1.0.0", data)
+//	referrers, err := client.Referrers(ctx, "registry.acme.example/app@sha256:…")
+//	data, err = client.Pull(ctx, "registry.acme.example/app@sha256:…", referrers[0])
+//
+// Registries without the referrers API are supported with the referrers
+// tag schema: an index of the referrers tagged after the image digest.
+package oci
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Media types of the artifacts pushed and of the manifests.
+const (
+	// ArtifactType is the artifact type, and the media type of the layer,
+	// of SPDX documents.
+	ArtifactType = "application/spdx+json"
+
+	ManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	IndexMediaType    = "application/vnd.oci.image.index.v1+json"
+	EmptyMediaType    = "application/vnd.oci.empty.v1+json"
+)
+
+// Annotations of the artifacts pushed.
+const (
+	AnnotationCreated = "org.opencontainers.image.created"
+	AnnotationTitle   = "org.opencontainers.image.title"
+)
+
+// manifestMediaTypes are the media types of the manifests an image
+// reference may resolve to.
+var manifestMediaTypes = []string{
+	ManifestMediaType,
+	IndexMediaType,
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+}
+
+// emptyConfig is the empty JSON object used as the config of artifacts.
+var emptyConfig = []byte("{}")
+
+// Descriptor describes content in a registry.
+type Descriptor struct {
+	MediaType    string            `json:"mediaType"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Digest       string            `json:"digest"`
+	Size         int64             `json:"size"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+// Manifest is an OCI image manifest.
+type Manifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        Descriptor        `json:"config"`
+	Layers        []Descriptor      `json:"layers"`
+	Subject       *Descriptor       `json:"subject,omitempty"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// Index is an OCI image index, the form of the list of referrers.
+type Index struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType"`
+	Manifests     []Descriptor `json:"manifests"`
+}
+
+// Reference is a reference to an image, by tag or digest.
+type Reference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+// ParseReference parses an image reference such as
+// "registry.acme.example/app:1.0.0" or "app@sha256:…". References without
+// a registry are of Docker Hub, and those without a tag or digest are of
+// the "latest" tag.
+func ParseReference(s string) (Reference, error) {
+	var ref Reference
+	name := s
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Digest = name[:i], name[i+1:]
+		if _, _, err := splitDigest(ref.Digest); err != nil {
+			return Reference{}, fmt.Errorf("invalid reference %q: %w", s, err)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+	}
+	if registry, repository, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(registry, ".:") || registry == "localhost") {
+		ref.Registry, ref.Repository = registry, repository
+	} else {
+		ref.Registry, ref.Repository = "docker.io", name
+		if !strings.Contains(name, "/") {
+			ref.Repository = "library/" + name
+		}
+	}
+	if ref.Repository == "" || ref.Repository != strings.ToLower(ref.Repository) {
+		return Reference{}, fmt.Errorf("invalid reference %q: invalid repository", s)
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = "latest"
+	}
+	return ref, nil
+}
+
+// String returns the reference in its canonical form.
+func (r Reference) String() string {
+	s := r.Registry + "/" + r.Repository
+	if r.Tag != "" {
+		s += ":" + r.Tag
+	}
+	if r.Digest != "" {
+		s += "@" + r.Digest
+	}
+	return s
+}
+
+func (r Reference) reference() string {
+	if r.Digest != "" {
+		return r.Digest
+	}
+	return r.Tag
+}
+
+// splitDigest splits a digest into its algorithm and encoded hash.
+func splitDigest(digest string) (algorithm, encoded string, err error) {
+	algorithm, encoded, ok := strings.Cut(digest, ":")
+	if !ok || algorithm != "sha256" || len(encoded) != 64 {
+		return "", "", fmt.Errorf("unsupported digest %q", digest)
+	}
+	if _, err := hex.DecodeString(encoded); err != nil {
+		return "", "", fmt.Errorf("invalid digest %q", digest)
+	}
+	return algorithm, encoded, nil
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Option configures a Client.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	httpClient *http.Client
+	username   string
+	password   string
+	plainHTTP  bool
+	now        func() time.Time
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(c *config) {
+		c.httpClient = client
+	})
+}
+
+// WithBasicAuth sets the credentials used with registries requiring basic
+// authentication, or to obtain bearer tokens from their token service.
+func WithBasicAuth(username, password string) Option {
+	return optionFunc(func(c *config) {
+		c.username, c.password = username, password
+	})
+}
+
+// WithPlainHTTP makes requests to registries over HTTP rather than HTTPS,
+// e.g. for a local registry.
+func WithPlainHTTP() Option {
+	return optionFunc(func(c *config) {
+		c.plainHTTP = true
+	})
+}
+
+// Client pushes and pulls SPDX documents attached to images.
+type Client struct {
+	config
+	auth *authenticator
+}
+
+// NewClient creates a client with the given options.
+func NewClient(opts ...Option) *Client {
+	c := config{httpClient: http.DefaultClient, now: time.Now}
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+	client := &Client{config: c}
+	client.auth = &authenticator{config: &client.config, tokens: map[string]string{}}
+	return client
+}
+
+// Push pushes data, an SPDX document, as an artifact referring to the
+// image, and returns the descriptor of the artifact manifest.
+func (c *Client) Push(ctx context.Context, image string, data []byte) (*Descriptor, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	subject, _, err := c.resolve(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", ref, err)
+	}
+
+	empty := Descriptor{MediaType: EmptyMediaType, Digest: digestOf(emptyConfig), Size: int64(len(emptyConfig))}
+	layer := Descriptor{
+		MediaType:   ArtifactType,
+		Digest:      digestOf(data),
+		Size:        int64(len(data)),
+		Annotations: map[string]string{AnnotationTitle: "sbom.spdx.json"},
+	}
+	for _, blob := range []struct {
+		desc Descriptor
+		data []byte
+	}{{empty, emptyConfig}, {layer, data}} {
+		if err := c.pushBlob(ctx, ref, blob.desc, blob.data); err != nil {
+			return nil, fmt.Errorf("pushing blob %s: %w", blob.desc.Digest, err)
+		}
+	}
+
+	created := c.now().UTC().Format(time.RFC3339)
+	manifest, err := json.Marshal(Manifest{
+		SchemaVersion: 2,
+		MediaType:     ManifestMediaType,
+		ArtifactType:  ArtifactType,
+		Config:        empty,
+		Layers:        []Descriptor{layer},
+		Subject:       subject,
+		Annotations:   map[string]string{AnnotationCreated: created},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding manifest: %w", err)
+	}
+	desc := &Descriptor{
+		MediaType:    ManifestMediaType,
+		ArtifactType: ArtifactType,
+		Digest:       digestOf(manifest),
+		Size:         int64(len(manifest)),
+		Annotations:  map[string]string{AnnotationCreated: created},
+	}
+	resp, err := c.pushManifest(ctx, ref, desc.Digest, ManifestMediaType, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("pushing manifest: %w", err)
+	}
+	// Registries supporting the referrers API acknowledge the subject.
+	if resp.Header.Get("OCI-Subject") == "" {
+		if err := c.tagReferrer(ctx, ref, subject.Digest, *desc); err != nil {
+			return nil, fmt.Errorf("tagging referrer: %w", err)
+		}
+	}
+	return desc, nil
+}
+
+// Referrers returns the descriptors of the SPDX documents referring to
+// the image.
+func (c *Client) Referrers(ctx context.Context, image string) ([]Descriptor, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	digest := ref.Digest
+	if digest == "" {
+		subject, _, err := c.resolve(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", ref, err)
+		}
+		digest = subject.Digest
+	}
+	index, err := c.referrers(ctx, ref, digest)
+	if err != nil {
+		return nil, fmt.Errorf("listing referrers of %s: %w", digest, err)
+	}
+	var descs []Descriptor
+	for _, desc := range index.Manifests {
+		// Registries may ignore the artifact type filter.
+		if desc.ArtifactType == ArtifactType {
+			descs = append(descs, desc)
+		}
+	}
+	return descs, nil
+}
+
+// Pull returns the SPDX document of the artifact, one of the referrers of
+// the image.
+func (c *Client) Pull(ctx context.Context, image string, artifact Descriptor) ([]byte, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	ref.Tag, ref.Digest = "", artifact.Digest
+	_, data, err := c.resolve(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("fetching manifest %s: %w", artifact.Digest, err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("decoding manifest %s: %w", artifact.Digest, err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == ArtifactType {
+			return c.pullBlob(ctx, ref, layer)
+		}
+	}
+	return nil, fmt.Errorf("manifest %s has no %s layer", artifact.Digest, ArtifactType)
+}
+
+// ReadDocuments reads, with r, the SPDX documents referring to the image.
+func (c *Client) ReadDocuments(ctx context.Context, image string, r *parse.Reader) ([]*parse.Document, error) {
+	descs, err := c.Referrers(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]*parse.Document, 0, len(descs))
+	for _, desc := range descs {
+		data, err := c.Pull(ctx, image, desc)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := r.Read(data)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", desc.Digest, err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// resolve fetches the manifest of ref, and returns its descriptor.
+func (c *Client) resolve(ctx context.Context, ref Reference) (*Descriptor, []byte, error) {
+	resp, err := c.do(ctx, ref, http.MethodGet, "/manifests/"+ref.reference(), nil,
+		http.Header{"Accept": {strings.Join(manifestMediaTypes, ", ")}}, http.StatusOK)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading manifest: %w", err)
+	}
+	desc := &Descriptor{Digest: digestOf(data), Size: int64(len(data))}
+	if ref.Digest != "" && desc.Digest != ref.Digest {
+		return nil, nil, fmt.Errorf("manifest digest %s does not match %s", desc.Digest, ref.Digest)
+	}
+	desc.MediaType, _, _ = mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if desc.MediaType == "" {
+		var m struct {
+			MediaType string `json:"mediaType"`
+		}
+		_ = json.Unmarshal(data, &m)
+		desc.MediaType = m.MediaType
+	}
+	return desc, data, nil
+}
+
+// pushBlob uploads a blob in a single request, unless the repository has
+// it already.
+func (c *Client) pushBlob(ctx context.Context, ref Reference, desc Descriptor, data []byte) error {
+	if resp, err := c.do(ctx, ref, http.MethodHead, "/blobs/"+desc.Digest, nil, nil, http.StatusOK); err == nil {
+		resp.Body.Close()
+		return nil
+	}
+	resp, err := c.do(ctx, ref, http.MethodPost, "/blobs/uploads/", nil, nil, http.StatusAccepted)
+	if err != nil {
+		return fmt.Errorf("starting upload: %w", err)
+	}
+	resp.Body.Close()
+	location, err := resp.Request.URL.Parse(resp.Header.Get("Location"))
+	if err != nil || resp.Header.Get("Location") == "" {
+		return errors.New("no upload location in response")
+	}
+	q := location.Query()
+	q.Set("digest", desc.Digest)
+	location.RawQuery = q.Encode()
+	resp, err = c.send(ctx, ref, http.MethodPut, location.String(), data,
+		http.Header{"Content-Type": {"application/octet-stream"}}, http.StatusCreated)
+	if err != nil {
+		return fmt.Errorf("uploading: %w", err)
+	}
+	return resp.Body.Close()
+}
+
+// pullBlob downloads a blob, checking its digest.
+func (c *Client) pullBlob(ctx context.Context, ref Reference, desc Descriptor) ([]byte, error) {
+	resp, err := c.do(ctx, ref, http.MethodGet, "/blobs/"+desc.Digest, nil, nil, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("fetching blob %s: %w", desc.Digest, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading blob %s: %w", desc.Digest, err)
+	}
+	if digestOf(data) != desc.Digest {
+		return nil, fmt.Errorf("blob digest does not match %s", desc.Digest)
+	}
+	return data, nil
+}
+
+func (c *Client) pushManifest(ctx context.Context, ref Reference, reference, mediaType string, data []byte) (*http.Response, error) {
+	resp, err := c.do(ctx, ref, http.MethodPut, "/manifests/"+reference, data,
+		http.Header{"Content-Type": {mediaType}}, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// referrers returns the referrers of the digest, with the referrers API
+// or else the referrers tag schema.
+func (c *Client) referrers(ctx context.Context, ref Reference, digest string) (*Index, error) {
+	path := "/referrers/" + digest + "?" + url.Values{"artifactType": {ArtifactType}}.Encode()
+	resp, err := c.do(ctx, ref, http.MethodGet, path, nil, http.Header{"Accept": {IndexMediaType}}, http.StatusOK)
+	var serr *statusError
+	if errors.As(err, &serr) && serr.StatusCode == http.StatusNotFound {
+		return c.taggedReferrers(ctx, ref, digest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var index Index
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, fmt.Errorf("decoding referrers: %w", err)
+	}
+	return &index, nil
+}
+
+// referrersTag returns the tag of the referrers of the digest in the
+// referrers tag schema.
+func referrersTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1)
+}
+
+// taggedReferrers returns the index of the referrers tag of the digest,
+// empty if the tag does not exist.
+func (c *Client) taggedReferrers(ctx context.Context, ref Reference, digest string) (*Index, error) {
+	resp, err := c.do(ctx, ref, http.MethodGet, "/manifests/"+referrersTag(digest), nil,
+		http.Header{"Accept": {IndexMediaType}}, http.StatusOK)
+	var serr *statusError
+	if errors.As(err, &serr) && serr.StatusCode == http.StatusNotFound {
+		return &Index{SchemaVersion: 2, MediaType: IndexMediaType, Manifests: []Descriptor{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var index Index
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, fmt.Errorf("decoding referrers tag: %w", err)
+	}
+	return &index, nil
+}
+
+// tagReferrer adds desc to the referrers tag of the digest.
+func (c *Client) tagReferrer(ctx context.Context, ref Reference, digest string, desc Descriptor) error {
+	index, err := c.taggedReferrers(ctx, ref, digest)
+	if err != nil {
+		return err
+	}
+	for _, m := range index.Manifests {
+		if m.Digest == desc.Digest {
+			return nil
+		}
+	}
+	index.Manifests = append(index.Manifests, desc)
+	data, err := json.Marshal(index)
+	if err != nil {
+		return fmt.Errorf("encoding referrers tag: %w", err)
+	}
+	_, err = c.pushManifest(ctx, ref, referrersTag(digest), IndexMediaType, data)
+	return err
+}
+
+// do sends a request for path in the repository of ref.
+func (c *Client) do(ctx context.Context, ref Reference, method, path string, body []byte, header http.Header, want ...int) (*http.Response, error) {
+	return c.send(ctx, ref, method, c.baseURL(ref)+path, body, header, want...)
+}
+
+func (c *Client) baseURL(ref Reference) string {
+	scheme, host := "https", ref.Registry
+	if c.plainHTTP {
+		scheme = "http"
+	}
+	if host == "docker.io" {
+		host = "registry-1.docker.io"
+	}
+	return scheme + "://" + host + "/v2/" + ref.Repository
+}
+
+// send sends a request, authenticating as the registry asks to.
+func (c *Client) send(ctx context.Context, ref Reference, method, u string, body []byte, header http.Header, want ...int) (*http.Response, error) {
+	newRequest := func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("creating request: %w", err)
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		c.auth.authorize(req, ref)
+		return req, nil
+	}
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := c.auth.challenge(ctx, ref, challenge); err != nil {
+			return nil, fmt.Errorf("authenticating: %w", err)
+		}
+		if req, err = newRequest(); err != nil {
+			return nil, err
+		}
+		if resp, err = c.httpClient.Do(req); err != nil {
+			return nil, err
+		}
+	}
+	for _, status := range want {
+		if resp.StatusCode == status {
+			return resp, nil
+		}
+	}
+	defer resp.Body.Close()
+	return nil, newStatusError(resp)
+}
+
+// statusError is an unexpected status, with the errors of the response.
+type statusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.Message)
+}
+
+func newStatusError(resp *http.Response) *statusError {
+	var body struct {
+		Errors []struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	msg := strings.TrimSpace(string(data))
+	if json.Unmarshal(data, &body) == nil && len(body.Errors) > 0 {
+		msgs := make([]string, len(body.Errors))
+		for i, e := range body.Errors {
+			msgs[i] = e.Code + ": " + e.Message
+		}
+		msg = strings.Join(msgs, "; ")
+	}
+	return &statusError{StatusCode: resp.StatusCode, Message: msg}
+}
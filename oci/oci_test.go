@@ -0,0 +1,280 @@
+package oci_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/oci"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+type manifest struct {
+	mediaType string
+	data      []byte
+}
+
+// fakeRegistry is a registry of a single repository, requiring bearer
+// tokens issued for the credentials "ci:secret".
+type fakeRegistry struct {
+	srv          *httptest.Server
+	referrersAPI bool
+	mu           sync.Mutex
+	manifests    map[string]manifest
+	tags         map[string]string
+	blobs        map[string][]byte
+	uploads      int
+}
+
+func newFakeRegistry(t *testing.T, referrersAPI bool) *fakeRegistry {
+	t.Helper()
+	r := &fakeRegistry{
+		referrersAPI: referrersAPI,
+		manifests:    map[string]manifest{},
+		tags:         map[string]string{},
+		blobs:        map[string][]byte{},
+	}
+	r.srv = httptest.NewServer(r)
+	t.Cleanup(r.srv.Close)
+	image := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","config":{"mediaType":"application/vnd.oci.image.config.v1+json","digest":"sha256:44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a","size":2},"layers":[]}`)
+	r.manifests[digestOf(image)] = manifest{oci.ManifestMediaType, image}
+	r.tags["1.0.0"] = digestOf(image)
+	return r
+}
+
+func (r *fakeRegistry) host() string {
+	return strings.TrimPrefix(r.srv.URL, "http://")
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.URL.Path == "/token" {
+		if user, pass, _ := req.BasicAuth(); user != "ci" || pass != "secret" {
+			http.Error(w, `{"errors":[{"code":"UNAUTHORIZED","message":"invalid credentials"}]}`, http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"token":"t0k"}`)
+		return
+	}
+	if req.Header.Get("Authorization") != "Bearer t0k" {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="`+r.srv.URL+`/token",service="fake",scope="repository:acme/app:pull"`)
+		http.Error(w, `{"errors":[{"code":"UNAUTHORIZED","message":"authentication required"}]}`, http.StatusUnauthorized)
+		return
+	}
+	body, _ := io.ReadAll(req.Body)
+
+	if strings.HasPrefix(req.URL.Path, "/upload/") {
+		digest := req.URL.Query().Get("digest")
+		if req.Method != http.MethodPut || digestOf(body) != digest {
+			http.Error(w, "invalid upload", http.StatusBadRequest)
+			return
+		}
+		r.blobs[digest] = body
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	rest, ok := strings.CutPrefix(req.URL.Path, "/v2/acme/app/")
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	kind, ref, _ := strings.Cut(rest, "/")
+	switch {
+	case kind == "manifests" && req.Method == http.MethodGet:
+		digest := ref
+		if d, ok := r.tags[ref]; ok {
+			digest = d
+		}
+		m, ok := r.manifests[digest]
+		if !ok {
+			http.Error(w, `{"errors":[{"code":"MANIFEST_UNKNOWN","message":"manifest unknown"}]}`, http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", m.mediaType)
+		w.Write(m.data)
+	case kind == "manifests" && req.Method == http.MethodPut:
+		var m oci.Manifest
+		if err := json.Unmarshal(body, &m); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, d := range m.Layers {
+			if _, ok := r.blobs[d.Digest]; !ok {
+				http.Error(w, "missing layer", http.StatusBadRequest)
+				return
+			}
+		}
+		digest := digestOf(body)
+		r.manifests[digest] = manifest{req.Header.Get("Content-Type"), body}
+		if ref != digest {
+			r.tags[ref] = digest
+		}
+		if m.Subject != nil && r.referrersAPI {
+			w.Header().Set("OCI-Subject", m.Subject.Digest)
+		}
+		w.WriteHeader(http.StatusCreated)
+	case kind == "blobs" && ref == "uploads/" && req.Method == http.MethodPost:
+		r.uploads++
+		w.Header().Set("Location", fmt.Sprintf("/upload/%d?state=x", r.uploads))
+		w.WriteHeader(http.StatusAccepted)
+	case kind == "blobs":
+		data, ok := r.blobs[ref]
+		if !ok {
+			http.Error(w, `{"errors":[{"code":"BLOB_UNKNOWN","message":"blob unknown"}]}`, http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case kind == "referrers" && r.referrersAPI:
+		index := oci.Index{SchemaVersion: 2, MediaType: oci.IndexMediaType, Manifests: []oci.Descriptor{}}
+		for digest, m := range r.manifests {
+			var artifact oci.Manifest
+			if json.Unmarshal(m.data, &artifact) == nil && artifact.Subject != nil && artifact.Subject.Digest == ref {
+				index.Manifests = append(index.Manifests, oci.Descriptor{
+					MediaType:    m.mediaType,
+					ArtifactType: artifact.ArtifactType,
+					Digest:       digest,
+					Size:         int64(len(m.data)),
+					Annotations:  artifact.Annotations,
+				})
+			}
+		}
+		// An unrelated referrer the artifact type filter is not applied to.
+		index.Manifests = append(index.Manifests, oci.Descriptor{MediaType: oci.ManifestMediaType, ArtifactType: "application/vnd.dev.sigstore.bundle.v0.3+json", Digest: digestOf(nil)})
+		w.Header().Set("Content-Type", oci.IndexMediaType)
+		json.NewEncoder(w).Encode(index)
+	default:
+		http.Error(w, `{"errors":[{"code":"UNSUPPORTED","message":"unsupported"}]}`, http.StatusNotFound)
+	}
+}
+
+func TestClient(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		referrersAPI bool
+	}{
+		{"referrers API", true},
+		{"referrers tag schema", false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			reg := newFakeRegistry(t, tt.referrersAPI)
+			client := oci.NewClient(oci.WithPlainHTTP(), oci.WithBasicAuth("ci", "secret"))
+			image := reg.host() + "/acme/app:1.0.0"
+			imageDigest := reg.tags["1.0.0"]
+
+			docs := [][]byte{testsbom.JSON(t, "1.0.0"), testsbom.JSON(t, "1.0.1")}
+			pushed := map[string][]byte{}
+			var first string
+			for _, data := range docs {
+				desc, err := client.Push(ctx, image, data)
+				if err != nil {
+					t.Fatalf("Push: %v", err)
+				}
+				if desc.ArtifactType != oci.ArtifactType || desc.MediaType != oci.ManifestMediaType {
+					t.Errorf("Push descriptor = %+v", desc)
+				}
+				pushed[desc.Digest] = data
+				if first == "" {
+					first = desc.Digest
+				}
+			}
+			var artifact oci.Manifest
+			if err := json.Unmarshal(reg.manifests[first].data, &artifact); err != nil {
+				t.Fatal(err)
+			}
+			if artifact.Subject == nil || artifact.Subject.Digest != imageDigest || artifact.Subject.MediaType != oci.ManifestMediaType {
+				t.Errorf("artifact subject = %+v, want %s", artifact.Subject, imageDigest)
+			}
+			if _, tagged := reg.tags[strings.Replace(imageDigest, ":", "-", 1)]; tagged == tt.referrersAPI {
+				t.Errorf("referrers tag written = %v, want %v", tagged, !tt.referrersAPI)
+			}
+
+			for _, ref := range []string{image, reg.host() + "/acme/app@" + imageDigest} {
+				referrers, err := client.Referrers(ctx, ref)
+				if err != nil {
+					t.Fatalf("Referrers(%s): %v", ref, err)
+				}
+				if len(referrers) != len(docs) {
+					t.Fatalf("Referrers(%s) = %d descriptors, want %d", ref, len(referrers), len(docs))
+				}
+				for _, desc := range referrers {
+					data, err := client.Pull(ctx, ref, desc)
+					if err != nil {
+						t.Fatalf("Pull: %v", err)
+					}
+					if !bytes.Equal(data, pushed[desc.Digest]) {
+						t.Errorf("Pull(%s) returned another document", desc.Digest)
+					}
+				}
+			}
+
+			read, err := client.ReadDocuments(ctx, image, parse.NewReader())
+			if err != nil {
+				t.Fatalf("ReadDocuments: %v", err)
+			}
+			if len(read) != len(docs) {
+				t.Errorf("ReadDocuments = %d documents, want %d", len(read), len(docs))
+			}
+		})
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	ctx := t.Context()
+	reg := newFakeRegistry(t, true)
+
+	_, err := oci.NewClient(oci.WithPlainHTTP(), oci.WithBasicAuth("ci", "wrong")).Push(ctx, reg.host()+"/acme/app:1.0.0", testsbom.JSON(t, "1.0.0"))
+	if err == nil || !strings.Contains(err.Error(), "invalid credentials") {
+		t.Errorf("Push with invalid credentials = %v", err)
+	}
+	client := oci.NewClient(oci.WithPlainHTTP(), oci.WithBasicAuth("ci", "secret"))
+	if _, err := client.Push(ctx, reg.host()+"/acme/app:2.0.0", testsbom.JSON(t, "2.0.0")); err == nil || !strings.Contains(err.Error(), "manifest unknown") {
+		t.Errorf("Push to unknown tag = %v", err)
+	}
+	if _, err := client.Pull(ctx, reg.host()+"/acme/app:1.0.0", oci.Descriptor{Digest: reg.tags["1.0.0"]}); err == nil {
+		t.Error("Pull of an image without SPDX layer succeeded")
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	tests := []struct {
+		in   string
+		want oci.Reference
+		err  bool
+	}{
+		{"alpine", oci.Reference{Registry: "docker.io", Repository: "library/alpine", Tag: "latest"}, false},
+		{"acme/app:1.0", oci.Reference{Registry: "docker.io", Repository: "acme/app", Tag: "1.0"}, false},
+		{"ghcr.io/acme/app@" + digest, oci.Reference{Registry: "ghcr.io", Repository: "acme/app", Digest: digest}, false},
+		{"localhost:5000/app:1.0@" + digest, oci.Reference{Registry: "localhost:5000", Repository: "app", Tag: "1.0", Digest: digest}, false},
+		{"localhost/app", oci.Reference{Registry: "localhost", Repository: "app", Tag: "latest"}, false},
+		{"ghcr.io/Acme/app", oci.Reference{}, true},
+		{"ghcr.io/acme/app@sha256:abc", oci.Reference{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := oci.ParseReference(tt.in)
+			if (err != nil) != tt.err {
+				t.Fatalf("ParseReference error = %v, want error %v", err, tt.err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseReference = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// AddElements adds elements to a document read by a Reader, filing them as
+// the reader files the elements of the graph: into the typed slices, the ID
+// indexes, ElementsByID, Graph and, for relationships, the relationship
+// indexes.
+// ElementsByID receives their raw JSON maps, or the elements themselves if
+// the document was read WithStreaming.
+// Elements of types the document does not keep are added to Extensions.
+// The elements are also listed as members of the SpdxDocument, if any.
+func (d *Document) AddElements(elems ...spdx.AnyElement) error {
+	var r Reader
+	for _, elem := range elems {
+		id := elem.GetSpdxID()
+		raw, err := d.rawElement(elem)
+		if err != nil {
+			return err
+		}
+
+		if !r.fileElement(d, elem, id) {
+			d.Extensions = append(d.Extensions, elem)
+			if id != "" {
+				d.ExtensionsByID[id] = elem
+			}
+		}
+		d.Graph = append(d.Graph, elem)
+		if id == "" {
+			continue
+		}
+		d.ElementsByID[id] = raw
+		if rel, ok := elem.(*spdx.Relationship); ok {
+			d.indexRelationship(rel)
+		}
+		if d.SpdxDocument != nil {
+			d.SpdxDocument.Elements = append(d.SpdxDocument.Elements, spdx.Element{SpdxID: id})
+		}
+	}
+	return nil
+}
+
+// indexRelationship adds a relationship to the relationship indexes, if
+// they have been built.
+func (d *Document) indexRelationship(rel *spdx.Relationship) {
+	if d.RelationshipsFromIndex == nil {
+		return
+	}
+	fromID := rel.From.GetSpdxID()
+	d.RelationshipsFromIndex[fromID] = append(d.RelationshipsFromIndex[fromID], rel)
+	for _, to := range rel.To {
+		toID := to.GetSpdxID()
+		d.RelationshipsToIndex[toID] = append(d.RelationshipsToIndex[toID], rel)
+	}
+}
+
+// unindexRelationship removes a relationship from the relationship indexes.
+func (d *Document) unindexRelationship(rel *spdx.Relationship) {
+	unindex := func(index map[string][]*spdx.Relationship, id string) {
+		if rels := slices.DeleteFunc(index[id], func(r *spdx.Relationship) bool { return r == rel }); len(rels) > 0 {
+			index[id] = rels
+		} else {
+			delete(index, id)
+		}
+	}
+	if d.RelationshipsFromIndex == nil {
+		return
+	}
+	unindex(d.RelationshipsFromIndex, rel.From.GetSpdxID())
+	for _, to := range rel.To {
+		unindex(d.RelationshipsToIndex, to.GetSpdxID())
+	}
+}
+
+// UpdateElements refreshes the raw elements in ElementsByID after typed
+// elements of the document were modified in place, so that GetElementByID
+// returns their current values. The elements must not have changed their
+// SPDX ID; use RewriteIDs for that. Documents read WithStreaming hold the
+// typed elements, which are current already.
+func (d *Document) UpdateElements(elems ...spdx.AnyElement) error {
+	for _, elem := range elems {
+		id := elem.GetSpdxID()
+		if _, ok := d.ElementsByID[id]; !ok {
+			return fmt.Errorf("element %q is not in the document", id)
+		}
+		raw, err := d.rawElement(elem)
+		if err != nil {
+			return err
+		}
+		d.ElementsByID[id] = raw
+	}
+	return nil
+}
+
+// rawElement returns the JSON-LD map of a typed element, as the reader
+// keeps in ElementsByID, or the element itself for typed documents.
+func (d *Document) rawElement(elem spdx.AnyElement) (interface{}, error) {
+	if d.typed {
+		return elem, nil
+	}
+	data, err := json.Marshal(elem)
+	if err != nil {
+		return nil, fmt.Errorf("encoding element %q: %w", elem.GetSpdxID(), err)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("decoding element %q: %w", elem.GetSpdxID(), err)
+	}
+	return raw, nil
+}
@@ -0,0 +1,190 @@
+package parse_test
+
+import (
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_AddElements(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{
+				"type": "SpdxDocument",
+				"spdxId": "https://acme.example/sbom/DOCUMENT",
+				"element": ["https://acme.example/sbom/Package-1"]
+			},
+			{"type": "software_Package", "spdxId": "https://acme.example/sbom/Package-1", "name": "app"}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	const (
+		pkgID  = "https://acme.example/sbom/Package-1"
+		vulnID = "https://acme.example/sbom/Vuln-1"
+		relID  = "https://acme.example/sbom/Rel-1"
+	)
+	vuln := &spdx.Vulnerability{}
+	vuln.SpdxID = vulnID
+	vuln.Name = "CVE-2024-0001"
+	rel := &spdx.Relationship{
+		From:             spdx.Element{SpdxID: pkgID},
+		To:               []spdx.Element{{SpdxID: vulnID}},
+		RelationshipType: spdx.RelationshipTypeHasAssociatedVulnerability,
+	}
+	rel.SpdxID = relID
+
+	if err := doc.AddElements(vuln, rel); err != nil {
+		t.Fatalf("AddElements: %v", err)
+	}
+
+	if doc.VulnerabilitiesByID[vulnID] != vuln || len(doc.Vulnerabilities) != 1 {
+		t.Error("vulnerability not filed")
+	}
+	if len(doc.Relationships) != 1 {
+		t.Errorf("relationships = %d, want 1", len(doc.Relationships))
+	}
+	if got := doc.GetSecurityInfoFor(pkgID).Relationships; len(got) != 1 || got[0] != rel {
+		t.Errorf("security info = %v, want the added relationship", got)
+	}
+	if got := doc.GetRelationshipsTo(vulnID); len(got) != 1 {
+		t.Errorf("relationships to vulnerability = %d, want 1", len(got))
+	}
+	raw, ok := doc.GetElementByID(vulnID).(map[string]interface{})
+	if !ok || raw["name"] != "CVE-2024-0001" {
+		t.Errorf("raw element = %v", doc.GetElementByID(vulnID))
+	}
+	if n := len(doc.SpdxDocument.Elements); n != 3 {
+		t.Errorf("document lists %d elements, want 3", n)
+	}
+}
+
+func TestDocument_UpdateElements(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{"type": "software_Package", "spdxId": "https://acme.example/sbom/Package-1", "name": "app"}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	pkg := doc.GetPackageByID("https://acme.example/sbom/Package-1")
+	pkg.Name = "renamed"
+	if err := doc.UpdateElements(pkg); err != nil {
+		t.Fatalf("UpdateElements: %v", err)
+	}
+	raw, _ := doc.GetElementByID(pkg.SpdxID).(map[string]interface{})
+	if raw["name"] != "renamed" {
+		t.Errorf("raw name = %v, want %q", raw["name"], "renamed")
+	}
+
+	other := &spdx.Package{}
+	other.SpdxID = "https://acme.example/sbom/Package-2"
+	if err := doc.UpdateElements(other); err == nil {
+		t.Error("UpdateElements succeeded for an element not in the document")
+	}
+}
+
+func TestDocument_Annotate(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]},
+			{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "element": ["urn:spdx:pkg"]},
+			{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme"},
+			{"type": "software_Package", "spdxId": "urn:spdx:pkg", "name": "app"}
+		]
+	}`
+	for _, opts := range [][]parse.Option{nil, {parse.WithStreaming()}} {
+		doc, err := parse.NewReader(opts...).Read([]byte(docJSON))
+		if err != nil {
+			t.Fatalf("failed to parse document: %v", err)
+		}
+		reviewer := &spdx.Person{Agent: spdx.Agent{Element: spdx.Element{SpdxID: "urn:spdx:jane", Name: "Jane Doe"}}}
+
+		ann, err := doc.Annotate("urn:spdx:pkg", spdx.AnnotationTypeReview, "License verified.", reviewer)
+		if err != nil {
+			t.Fatalf("Annotate() error = %v", err)
+		}
+		if ann.SpdxID != "urn:spdx:doc#annotation-1" {
+			t.Errorf("SpdxID = %q, want urn:spdx:doc#annotation-1", ann.SpdxID)
+		}
+		if ci := ann.CreationInfo; ci.Created.IsZero() || len(ci.CreatedBy) != 1 || ci.CreatedBy[0].SpdxID != "urn:spdx:jane" {
+			t.Errorf("CreationInfo = %+v, want created now by urn:spdx:jane", ci)
+		}
+		if doc.GetPersonByID("urn:spdx:jane") != reviewer {
+			t.Error("reviewer was not added to the document")
+		}
+		if got := doc.GetAnnotationsFor("urn:spdx:pkg"); len(got) != 1 || got[0] != ann {
+			t.Errorf("GetAnnotationsFor() = %v, want the annotation", got)
+		}
+		if doc.GetElementByID(ann.SpdxID) == nil {
+			t.Error("GetElementByID() = nil, want the annotation")
+		}
+
+		second, err := doc.Annotate("urn:spdx:pkg", spdx.AnnotationTypeOther, "Imported from the vendor.", reviewer)
+		if err != nil {
+			t.Fatalf("Annotate() error = %v", err)
+		}
+		if second.SpdxID != "urn:spdx:doc#annotation-2" {
+			t.Errorf("SpdxID = %q, want urn:spdx:doc#annotation-2", second.SpdxID)
+		}
+		if got := len(doc.Persons); got != 1 {
+			t.Errorf("len(Persons) = %d, want 1", got)
+		}
+
+		if err := doc.UpdateAnnotation(ann.SpdxID, spdx.AnnotationTypeReview, "License and copyright verified."); err != nil {
+			t.Fatalf("UpdateAnnotation() error = %v", err)
+		}
+		if raw, ok := doc.GetElementByID(ann.SpdxID).(map[string]interface{}); ok && raw["statement"] != "License and copyright verified." {
+			t.Errorf("raw statement = %v, want the updated statement", raw["statement"])
+		}
+
+		if err := doc.RemoveAnnotation(ann.SpdxID); err != nil {
+			t.Fatalf("RemoveAnnotation() error = %v", err)
+		}
+		if got := doc.GetAnnotationsFor("urn:spdx:pkg"); len(got) != 1 || got[0] != second {
+			t.Errorf("GetAnnotationsFor() after removal = %v, want the second annotation", got)
+		}
+		if doc.GetElementByID(ann.SpdxID) != nil {
+			t.Error("GetElementByID() of a removed annotation is not nil")
+		}
+		if got := doc.GetCollectionsContaining(ann.SpdxID); len(got) != 0 {
+			t.Errorf("GetCollectionsContaining() of a removed annotation = %v, want none", got)
+		}
+
+		for _, tt := range []struct {
+			name string
+			err  error
+		}{
+			{"missing subject", func() error {
+				_, err := doc.Annotate("urn:spdx:missing", spdx.AnnotationTypeReview, "", reviewer)
+				return err
+			}()},
+			{"invalid type", func() error {
+				_, err := doc.Annotate("urn:spdx:pkg", "comment", "", reviewer)
+				return err
+			}()},
+			{"no agent", func() error {
+				_, err := doc.Annotate("urn:spdx:pkg", spdx.AnnotationTypeReview, "", nil)
+				return err
+			}()},
+			{"update removed", doc.UpdateAnnotation(ann.SpdxID, spdx.AnnotationTypeReview, "")},
+			{"remove removed", doc.RemoveAnnotation(ann.SpdxID)},
+		} {
+			if tt.err == nil {
+				t.Errorf("%s: error = nil, want an error", tt.name)
+			}
+		}
+	}
+}
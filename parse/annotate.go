@@ -0,0 +1,128 @@
+package parse
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// Annotate adds an Annotation of an element of the document, such as a
+// review comment on a package of a third-party SBOM, and returns it. The
+// annotation is created now by the given agent and otherwise has the
+// creation info of the document; the agent is added to the document, as
+// AddElements does, if the document does not hold it yet. Its SPDX ID is
+// "<document ID>#annotation-<n>", with the lowest n not in use.
+//
+//	reviewer := &spdx.Person{Agent: spdx.Agent{Element: spdx.Element{SpdxID: "urn:spdx:jane", Name: "Jane Doe"}}}
+//	ann, err := doc.Annotate(pkg.SpdxID, spdx.AnnotationTypeReview, "License verified against upstream.", reviewer)
+func (d *Document) Annotate(subjectID string, annotationType spdx.AnnotationType, statement string, agent spdx.AgentInterface) (*spdx.Annotation, error) {
+	if !annotationType.IsValid() {
+		return nil, fmt.Errorf("invalid annotation type %q", annotationType)
+	}
+	if _, ok := d.ElementsByID[subjectID]; !ok {
+		return nil, fmt.Errorf("element %q is not in the document", subjectID)
+	}
+	if agent == nil || agent.GetSpdxID() == "" {
+		return nil, fmt.Errorf("annotation has no agent")
+	}
+
+	var added []spdx.AnyElement
+	if _, ok := d.ElementsByID[agent.GetSpdxID()]; !ok {
+		elem, ok := agent.(spdx.AnyElement)
+		if !ok {
+			return nil, fmt.Errorf("agent %q is not an element", agent.GetSpdxID())
+		}
+		added = append(added, elem)
+	}
+
+	ci := spdx.NewCreationInfo(nil)
+	if d.CreationInfo != nil {
+		ci = *d.CreationInfo.Copy()
+	}
+	ci.Created = time.Now().UTC().Truncate(time.Second)
+	ci.CreatedBy = []spdx.Agent{{Element: spdx.Element{SpdxID: agent.GetSpdxID()}}}
+	ci.CreatedUsing = nil
+
+	ann := &spdx.Annotation{
+		Element:        spdx.NewElement(d.newElementID("annotation"), "", ci),
+		AnnotationType: annotationType,
+		Statement:      statement,
+		Subject:        spdx.Element{SpdxID: subjectID},
+	}
+	if err := d.AddElements(append(added, ann)...); err != nil {
+		return nil, fmt.Errorf("adding annotation: %w", err)
+	}
+	return ann, nil
+}
+
+// newElementID returns an unused SPDX ID "<document ID>#<kind>-<n>" for an
+// element the document adds itself.
+func (d *Document) newElementID(kind string) string {
+	prefix := d.GetSpdxID() + "#"
+	if prefix == "#" {
+		prefix = "urn:spdx-zen:" + kind + ":"
+	}
+	for n := 1; ; n++ {
+		id := prefix + kind + "-" + strconv.Itoa(n)
+		if _, ok := d.ElementsByID[id]; !ok {
+			return id
+		}
+	}
+}
+
+// getAnnotationByID returns the annotation with the given SPDX ID, or nil.
+func (d *Document) getAnnotationByID(spdxID string) *spdx.Annotation {
+	for _, ann := range d.Annotations {
+		if ann.SpdxID == spdxID {
+			return ann
+		}
+	}
+	return nil
+}
+
+// UpdateAnnotation sets the type and statement of an annotation of the
+// document and refreshes its raw element in ElementsByID.
+func (d *Document) UpdateAnnotation(spdxID string, annotationType spdx.AnnotationType, statement string) error {
+	if !annotationType.IsValid() {
+		return fmt.Errorf("invalid annotation type %q", annotationType)
+	}
+	ann := d.getAnnotationByID(spdxID)
+	if ann == nil {
+		return fmt.Errorf("annotation %q is not in the document", spdxID)
+	}
+	ann.AnnotationType = annotationType
+	ann.Statement = statement
+	return d.UpdateElements(ann)
+}
+
+// RemoveAnnotation removes an annotation from the document: from
+// Annotations, Graph, ElementsByID and the element lists of its
+// collections. The
+// agent that created it is kept.
+func (d *Document) RemoveAnnotation(spdxID string) error {
+	ann := d.getAnnotationByID(spdxID)
+	if ann == nil {
+		return fmt.Errorf("annotation %q is not in the document", spdxID)
+	}
+	isAnnotation := func(e spdx.Element) bool { return e.SpdxID == spdxID }
+	for _, c := range d.GetCollectionsContaining(spdxID) {
+		switch c := c.(type) {
+		case *spdx.SpdxDocument:
+			c.Elements = slices.DeleteFunc(c.Elements, isAnnotation)
+		case *spdx.Bundle:
+			c.Elements = slices.DeleteFunc(c.Elements, isAnnotation)
+		case *spdx.Bom:
+			c.Elements = slices.DeleteFunc(c.Elements, isAnnotation)
+		}
+		if err := d.UpdateElements(c.(spdx.AnyElement)); err != nil {
+			return err
+		}
+	}
+	d.Annotations = slices.DeleteFunc(d.Annotations, func(a *spdx.Annotation) bool { return a == ann })
+	d.Graph = slices.DeleteFunc(d.Graph, func(e spdx.ElementInterface) bool { return e == spdx.ElementInterface(ann) })
+	delete(d.ElementsByID, spdxID)
+	return nil
+}
@@ -1,11 +1,24 @@
 package parse
 
-import spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
 
 // Document represents an SPDX 3.0 JSON-LD document
 type Document struct {
-	Context []string       `json:"@context,omitempty"`
-	Graph   []spdx.Element `json:"-"` // Parsed elements from @graph
+	Context []string `json:"@context,omitempty"`
+
+	// Graph holds the elements of the document, as the typed slices hold
+	// them, in the order of the @graph array they were read from; Bytes
+	// and WriteFile write them in this order. Elements added with
+	// AddElements are appended.
+	Graph []spdx.ElementInterface `json:"-"`
 
 	// Parsed and categorized elements
 	SpdxDocument                 *spdx.SpdxDocument
@@ -17,6 +30,7 @@ type Document struct {
 	Annotations                  []*spdx.Annotation
 	ExternalMaps                 []*spdx.ExternalMap
 	CreationInfo                 *spdx.CreationInfo
+	Agents                       []*spdx.Agent
 	Organizations                []*spdx.Organization
 	Persons                      []*spdx.Person
 	SoftwareAgents               []*spdx.SoftwareAgent
@@ -64,7 +78,11 @@ type Document struct {
 	// Build-related elements
 	Builds []*spdx.Build
 
-	// All elements indexed by SPDX ID
+	// Elements of types added through a Registry
+	Extensions []spdx.AnyElement
+
+	// All elements indexed by SPDX ID: their raw JSON maps or, for
+	// documents read WithStreaming, the typed elements
 	ElementsByID map[string]interface{}
 
 	// Relationship indexes for O(1) lookups
@@ -74,6 +92,7 @@ type Document struct {
 	// Element type indexes for O(1) lookups
 	PackagesByID                             map[string]*spdx.Package
 	FilesByID                                map[string]*spdx.File
+	AgentsByID                               map[string]*spdx.Agent
 	OrganizationsByID                        map[string]*spdx.Organization
 	PersonsByID                              map[string]*spdx.Person
 	SoftwareAgentsByID                       map[string]*spdx.SoftwareAgent
@@ -111,6 +130,20 @@ type Document struct {
 
 	// Build-related maps
 	BuildsByID map[string]*spdx.Build
+
+	// Registered extension maps
+	ExtensionsByID map[string]spdx.AnyElement
+
+	// typed reports whether ElementsByID holds typed elements.
+	typed bool
+
+	// provenance is where the elements were read from, for documents
+	// read WithProvenance.
+	provenance *provenance
+
+	// raw is the JSON of the elements as read, for documents read
+	// WithRawElements.
+	raw map[string]json.RawMessage
 }
 
 // GetName returns the document name
@@ -193,6 +226,16 @@ func (d *Document) GetFileByName(name string) []*spdx.File {
 	return result
 }
 
+// GetSnippetByID returns the snippet with the given SPDX ID, or nil.
+func (d *Document) GetSnippetByID(spdxID string) *spdx.Snippet {
+	for _, snippet := range d.Snippets {
+		if snippet.SpdxID == spdxID {
+			return snippet
+		}
+	}
+	return nil
+}
+
 // GetRelationshipsByType returns relationships of a specific type
 func (d *Document) GetRelationshipsByType(relType spdx.RelationshipType) []*spdx.Relationship {
 	var result []*spdx.Relationship
@@ -273,6 +316,75 @@ func (d *Document) GetPackagesWithPURL() []*spdx.Package {
 	return result
 }
 
+// NormalizePURLs replaces the package URLs of the packages of the document
+// by their canonical forms, as spdx.Package.NormalizePURLs does, and
+// refreshes the raw elements of the packages it changes. Package URLs that
+// cannot be parsed are left as they are and reported in the returned error.
+func (d *Document) NormalizePURLs() error {
+	var errs []error
+	var changed []spdx.AnyElement
+	for _, pkg := range d.Packages {
+		before := pkgPURLs(pkg)
+		if err := pkg.NormalizePURLs(); err != nil {
+			errs = append(errs, fmt.Errorf("package %q: %w", pkg.SpdxID, err))
+		}
+		if !slices.Equal(before, pkgPURLs(pkg)) {
+			changed = append(changed, pkg)
+		}
+	}
+	if err := d.UpdateElements(changed...); err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// pkgPURLs returns the packageUrl and package URL external identifiers of
+// a package.
+func pkgPURLs(pkg *spdx.Package) []string {
+	purls := []string{pkg.PackageUrl}
+	for _, ei := range pkg.ExternalIdentifier {
+		if ei.ExternalIdentifierType == spdx.ExternalIdentifierTypePackageUrl {
+			purls = append(purls, ei.Identifier)
+		}
+	}
+	return purls
+}
+
+// GetPackagesOlderThan returns the packages named name whose version
+// precedes version, compared in the ecosystem of their package URLs as
+// spdx.Package.CompareVersion does. A name starting with "pkg:" is a
+// package URL without a version, matching packages with the same type,
+// namespace and name. Packages without a version are left out.
+//
+//	outdated := doc.GetPackagesOlderThan("pkg:npm/lodash", "4.17.21")
+func (d *Document) GetPackagesOlderThan(name, version string) []*spdx.Package {
+	query, isPURL := spdx.PURL{}, strings.HasPrefix(name, "pkg:")
+	if isPURL {
+		var err error
+		if query, err = spdx.ParsePURL(name); err != nil {
+			return nil
+		}
+	}
+	var result []*spdx.Package
+	for _, pkg := range d.Packages {
+		if pkg.PackageVersion == "" {
+			continue
+		}
+		if isPURL {
+			p, ok := pkg.PURLComponents()
+			if !ok || p.Type != query.Type || p.Namespace != query.Namespace || p.Name != query.Name {
+				continue
+			}
+		} else if pkg.Name != name {
+			continue
+		}
+		if pkg.CompareVersion(version) < 0 {
+			result = append(result, pkg)
+		}
+	}
+	return result
+}
+
 // GetDependenciesFor returns the packages that the given element depends on.
 // It uses the model's IsDependency() method to identify dependency relationships
 // (DEPENDS_ON, HAS_OPTIONAL_DEPENDENCY, HAS_PROVIDED_DEPENDENCY, HAS_PREREQUISITE).
@@ -290,6 +402,154 @@ func (d *Document) GetDependenciesFor(spdxID string) []*spdx.Package {
 	return result
 }
 
+// GetRootElements returns the elements that the root elements of a
+// collection, such as a Bom or the SpdxDocument, refer to. References to
+// elements the document does not hold are skipped.
+//
+//	for _, bom := range doc.Boms {
+//	    roots := doc.GetRootElements(&bom.ElementCollection)
+//	}
+func (d *Document) GetRootElements(collection *spdx.ElementCollection) []spdx.ElementInterface {
+	if collection == nil {
+		return nil
+	}
+	return d.resolveElements(collection.RootElement)
+}
+
+// GetCollectionElements returns the elements that the element list of a
+// collection, such as a Bom, a Bundle or the SpdxDocument, refers to, as
+// GetRootElements does for its root elements.
+//
+//	for _, bom := range doc.Boms {
+//	    for _, elem := range doc.GetCollectionElements(&bom.ElementCollection) {
+//	        fmt.Println(elem.GetSpdxID(), elem.GetName())
+//	    }
+//	}
+func (d *Document) GetCollectionElements(collection *spdx.ElementCollection) []spdx.ElementInterface {
+	if collection == nil {
+		return nil
+	}
+	return d.resolveElements(collection.Elements)
+}
+
+// resolveElements returns the elements of the document that refs refer
+// to, skipping those it does not hold.
+func (d *Document) resolveElements(refs []spdx.Element) []spdx.ElementInterface {
+	if len(refs) == 0 {
+		return nil
+	}
+	byID := make(map[string]spdx.ElementInterface)
+	for elem := range d.AllElements() {
+		byID[elem.GetSpdxID()] = elem
+	}
+	var result []spdx.ElementInterface
+	for _, ref := range refs {
+		if elem, ok := byID[ref.GetSpdxID()]; ok {
+			result = append(result, elem)
+		}
+	}
+	return result
+}
+
+// GetCollectionsContaining returns the collections of the document whose
+// element or root element lists hold the given element: the SpdxDocument,
+// then its Bundles and Boms, Sboms included.
+func (d *Document) GetCollectionsContaining(spdxID string) []spdx.ElementCollectionInterface {
+	holds := func(c *spdx.ElementCollection) bool {
+		has := func(e spdx.Element) bool { return e.SpdxID == spdxID }
+		return slices.ContainsFunc(c.Elements, has) || slices.ContainsFunc(c.RootElement, has)
+	}
+	var result []spdx.ElementCollectionInterface
+	if d.SpdxDocument != nil && holds(&d.SpdxDocument.ElementCollection) {
+		result = append(result, d.SpdxDocument)
+	}
+	for _, b := range d.Bundles {
+		if holds(&b.ElementCollection) {
+			result = append(result, b)
+		}
+	}
+	for _, b := range d.Boms {
+		if holds(&b.ElementCollection) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// GetRootPackages returns the packages among the root elements of a
+// collection, including AI and dataset packages.
+func (d *Document) GetRootPackages(collection *spdx.ElementCollection) []*spdx.Package {
+	if collection == nil {
+		return nil
+	}
+	var result []*spdx.Package
+	for _, root := range collection.RootElement {
+		if pkg := d.getAnyPackageByID(root.GetSpdxID()); pkg != nil {
+			result = append(result, pkg)
+		}
+	}
+	return result
+}
+
+// GetPrimaryComponent returns the package the document is an SBOM of: the
+// first root package of its Boms, else of the SpdxDocument, else the first
+// package the SpdxDocument DESCRIBES. It returns nil if the document names
+// no package as its subject.
+func (d *Document) GetPrimaryComponent() *spdx.Package {
+	for _, bom := range d.Boms {
+		if roots := d.GetRootPackages(&bom.ElementCollection); len(roots) > 0 {
+			return roots[0]
+		}
+	}
+	if d.SpdxDocument == nil {
+		return nil
+	}
+	if roots := d.GetRootPackages(&d.SpdxDocument.ElementCollection); len(roots) > 0 {
+		return roots[0]
+	}
+	for _, rel := range d.GetRelationshipsFrom(d.SpdxDocument.SpdxID) {
+		if rel.RelationshipType != spdx.RelationshipTypeDescribes {
+			continue
+		}
+		for _, to := range rel.To {
+			if pkg := d.getAnyPackageByID(to.GetSpdxID()); pkg != nil {
+				return pkg
+			}
+		}
+	}
+	return nil
+}
+
+// getAnyPackageByID returns a package, AI package or dataset package by
+// its SPDX ID.
+func (d *Document) getAnyPackageByID(spdxID string) *spdx.Package {
+	if pkg := d.GetPackageByID(spdxID); pkg != nil {
+		return pkg
+	}
+	if ai := findByID(d.AiPackagesByID, d.AiPackages, spdxID); ai != nil {
+		return &ai.Package
+	}
+	if ds := findByID(d.DatasetPackagesByID, d.DatasetPackages, spdxID); ds != nil {
+		return &ds.Package
+	}
+	return nil
+}
+
+// findByID returns an element by its SPDX ID from an index or, if the
+// index was not built, from the slice it indexes.
+func findByID[E interface{ GetSpdxID() string }](index map[string]E, elems []E, spdxID string) E {
+	if index != nil {
+		return index[spdxID]
+	}
+	for _, e := range elems {
+		if e.GetSpdxID() == spdxID {
+			return e
+		}
+	}
+	var zero E
+	return zero
+}
+
 // LicenseInfo holds license information for an element.
 type LicenseInfo struct {
 	ConcludedLicenses []*spdx.AnyLicenseInfo
@@ -483,6 +743,22 @@ func (d *Document) GetAnyLicenseInfoByID(spdxID string) *spdx.AnyLicenseInfo {
 				}
 			}
 		}
+		if e, ok := elem.(spdx.ElementInterface); ok && e.GetName() != "" {
+			return &spdx.AnyLicenseInfo{
+				Element: spdx.Element{
+					SpdxID: spdxID,
+					Name:   e.GetName(),
+				},
+			}
+		}
+	}
+
+	// Fall back to the license individuals defined by the specification
+	switch spdxID {
+	case spdx.NoAssertionLicenseIRI:
+		return spdx.NewNoAssertionLicense()
+	case spdx.NoneLicenseIRI:
+		return spdx.NewNoneLicense()
 	}
 	return nil
 }
@@ -511,6 +787,190 @@ func (d *Document) GetSecurityInfoFor(spdxID string) *SecurityInfo {
 	return info
 }
 
+// AffectedPackage is a package that a vulnerability affects, does not
+// affect or is fixed in, with the relationship that says so.
+type AffectedPackage struct {
+	Package       *spdx.Package
+	Vulnerability *spdx.Vulnerability
+
+	// RelationshipType is affects, doesNotAffect or fixedIn.
+	RelationshipType spdx.RelationshipType
+
+	// Relationship is the relationship or VEX assessment linking the
+	// vulnerability to the package. For VEX assessments it is the embedded
+	// Relationship of the assessment.
+	Relationship *spdx.Relationship
+}
+
+// GetVulnerabilitiesByIdentifier returns the vulnerabilities known by the
+// given ID, e.g. "CVE-2024-1234": those with an external identifier, SPDX
+// ID or name equal to it. Identifiers and names are compared
+// case-insensitively.
+func (d *Document) GetVulnerabilitiesByIdentifier(id string) []*spdx.Vulnerability {
+	var result []*spdx.Vulnerability
+	for _, v := range d.Vulnerabilities {
+		if v.SpdxID == id || strings.EqualFold(v.Name, id) || slices.ContainsFunc(v.ExternalIdentifier, func(ei spdx.ExternalIdentifier) bool {
+			return strings.EqualFold(ei.Identifier, id)
+		}) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// GetAffectedPackagesByVulnID returns the packages that the vulnerabilities
+// known by the given ID affect, do not affect or are fixed in, following
+// both plain affects, doesNotAffect and fixedIn relationships and the VEX
+// affected, not affected and fixed assessments of the vulnerabilities.
+// A package appears once per relationship, so it may be listed both as
+// affected and as fixed. Endpoints that are not packages are skipped.
+//
+//	for _, ap := range doc.GetAffectedPackagesByVulnID("CVE-2024-1234") {
+//	    fmt.Println(ap.Package.Name, ap.RelationshipType)
+//	}
+func (d *Document) GetAffectedPackagesByVulnID(id string) []*AffectedPackage {
+	var result []*AffectedPackage
+	for _, v := range d.GetVulnerabilitiesByIdentifier(id) {
+		add := func(rel *spdx.Relationship) {
+			if rel.From.GetSpdxID() != v.SpdxID {
+				return
+			}
+			for _, to := range rel.To {
+				if pkg := d.GetPackageByID(to.GetSpdxID()); pkg != nil {
+					result = append(result, &AffectedPackage{
+						Package:          pkg,
+						Vulnerability:    v,
+						RelationshipType: rel.RelationshipType,
+						Relationship:     rel,
+					})
+				}
+			}
+		}
+		for _, rel := range d.GetRelationshipsFrom(v.SpdxID) {
+			switch rel.RelationshipType {
+			case spdx.RelationshipTypeAffects, spdx.RelationshipTypeDoesNotAffect, spdx.RelationshipTypeFixedIn:
+				add(rel)
+			}
+		}
+		for _, a := range d.VexAffectedVulnAssessments {
+			add(&a.Relationship)
+		}
+		for _, a := range d.VexNotAffectedVulnAssessments {
+			add(&a.Relationship)
+		}
+		for _, a := range d.VexFixedVulnAssessments {
+			add(&a.Relationship)
+		}
+	}
+	return result
+}
+
+// GetVulnerabilitySeverity returns the highest CVSS severity given to a
+// vulnerability by its CVSS v2, v3 and v4 assessments, or "" if it has
+// none. CVSS v2 scores, which carry no severity, are rated with the NVD
+// ranges: high from 7.0 and medium from 4.0.
+func (d *Document) GetVulnerabilitySeverity(vulnID string) spdx.CvssSeverityType {
+	var highest spdx.CvssSeverityType
+	d.eachCvssScore(vulnID, func(_ float64, severity spdx.CvssSeverityType) {
+		if severityRank(severity) > severityRank(highest) {
+			highest = severity
+		}
+	})
+	return highest
+}
+
+// GetVulnerabilityScore returns the highest CVSS score given to a
+// vulnerability by its CVSS v2, v3 and v4 assessments, and whether it has
+// any.
+func (d *Document) GetVulnerabilityScore(vulnID string) (float64, bool) {
+	var highest float64
+	var found bool
+	d.eachCvssScore(vulnID, func(score float64, _ spdx.CvssSeverityType) {
+		if !found || score > highest {
+			highest = score
+		}
+		found = true
+	})
+	return highest, found
+}
+
+// GetVulnerabilitiesBySeverity returns the vulnerabilities whose highest
+// CVSS severity, as returned by GetVulnerabilitySeverity, is severity.
+//
+//	critical := doc.GetVulnerabilitiesBySeverity(spdx.CvssSeverityTypeCritical)
+func (d *Document) GetVulnerabilitiesBySeverity(severity spdx.CvssSeverityType) []*spdx.Vulnerability {
+	var result []*spdx.Vulnerability
+	for _, v := range d.Vulnerabilities {
+		if d.GetVulnerabilitySeverity(v.SpdxID) == severity {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// GetVulnerabilitiesAboveScore returns the vulnerabilities with a CVSS v2,
+// v3 or v4 score of at least minScore. Vulnerabilities without a CVSS
+// assessment are not returned.
+//
+//	gate := doc.GetVulnerabilitiesAboveScore(7.0)
+func (d *Document) GetVulnerabilitiesAboveScore(minScore float64) []*spdx.Vulnerability {
+	var result []*spdx.Vulnerability
+	for _, v := range d.Vulnerabilities {
+		if score, ok := d.GetVulnerabilityScore(v.SpdxID); ok && score >= minScore {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// eachCvssScore calls fn with the score and severity of each CVSS
+// assessment of a vulnerability.
+func (d *Document) eachCvssScore(vulnID string, fn func(score float64, severity spdx.CvssSeverityType)) {
+	for _, a := range d.CvssV2VulnAssessments {
+		if a.From.SpdxID == vulnID {
+			fn(a.Score, cvssV2Severity(a.Score))
+		}
+	}
+	for _, a := range d.CvssV3VulnAssessments {
+		if a.From.SpdxID == vulnID {
+			fn(a.Score, a.Severity)
+		}
+	}
+	for _, a := range d.CvssV4VulnAssessments {
+		if a.From.SpdxID == vulnID {
+			fn(a.Score, a.Severity)
+		}
+	}
+}
+
+// cvssV2Severity rates a CVSS v2 score with the NVD ranges.
+func cvssV2Severity(score float64) spdx.CvssSeverityType {
+	switch {
+	case score >= 7:
+		return spdx.CvssSeverityTypeHigh
+	case score >= 4:
+		return spdx.CvssSeverityTypeMedium
+	}
+	return spdx.CvssSeverityTypeLow
+}
+
+// severityRank orders CVSS severities, unknown ones lowest.
+func severityRank(s spdx.CvssSeverityType) int {
+	switch s {
+	case spdx.CvssSeverityTypeCritical:
+		return 5
+	case spdx.CvssSeverityTypeHigh:
+		return 4
+	case spdx.CvssSeverityTypeMedium:
+		return 3
+	case spdx.CvssSeverityTypeLow:
+		return 2
+	case spdx.CvssSeverityTypeNone:
+		return 1
+	}
+	return 0
+}
+
 // BuildInfo holds build information for an element.
 type BuildInfo struct {
 	Relationships []*spdx.Relationship
@@ -551,6 +1011,9 @@ func (d *Document) GetAnnotationsFor(spdxID string) []*spdx.Annotation {
 // To determine the specific agent type, use GetAgentTypeByID or the type-specific
 // methods: GetOrganizationByID, GetPersonByID, GetSoftwareAgentByID.
 func (d *Document) GetAgentByID(spdxID string) *spdx.Agent {
+	if agent, ok := d.AgentsByID[spdxID]; ok {
+		return agent
+	}
 	if org, ok := d.OrganizationsByID[spdxID]; ok {
 		return &org.Agent
 	}
@@ -618,6 +1081,56 @@ func (d *Document) GetToolByID(spdxID string) *spdx.Tool {
 	return nil
 }
 
+// GetCreators returns the agents of a CreationInfo, such as the document's,
+// resolved to the Person, Organization, SoftwareAgent and Agent elements of
+// the document: a CreationInfo only holds references to them. A reference
+// to an agent the document does not hold is returned as the reference
+// itself.
+//
+//	for _, agent := range doc.GetCreators(doc.CreationInfo) {
+//	    if person, ok := agent.(*spdx.Person); ok {
+//	        fmt.Println(person.Name)
+//	    }
+//	}
+func (d *Document) GetCreators(ci *spdx.CreationInfo) []spdx.AgentInterface {
+	if ci == nil {
+		return nil
+	}
+	result := make([]spdx.AgentInterface, 0, len(ci.CreatedBy))
+	for i := range ci.CreatedBy {
+		id := ci.CreatedBy[i].SpdxID
+		if p := findByID(d.PersonsByID, d.Persons, id); p != nil {
+			result = append(result, p)
+		} else if o := findByID(d.OrganizationsByID, d.Organizations, id); o != nil {
+			result = append(result, o)
+		} else if sa := findByID(d.SoftwareAgentsByID, d.SoftwareAgents, id); sa != nil {
+			result = append(result, sa)
+		} else if a := findByID(d.AgentsByID, d.Agents, id); a != nil {
+			result = append(result, a)
+		} else {
+			result = append(result, &ci.CreatedBy[i])
+		}
+	}
+	return result
+}
+
+// GetCreationTools returns the tools of a CreationInfo resolved to the
+// Tool elements of the document, as GetCreators resolves its agents.
+func (d *Document) GetCreationTools(ci *spdx.CreationInfo) []*spdx.Tool {
+	if ci == nil {
+		return nil
+	}
+	result := make([]*spdx.Tool, 0, len(ci.CreatedUsing))
+	for i := range ci.CreatedUsing {
+		if tool := d.GetToolByID(ci.CreatedUsing[i].SpdxID); tool != nil {
+			result = append(result, tool)
+		} else {
+			result = append(result, &ci.CreatedUsing[i])
+		}
+	}
+	return result
+}
+
 // GetContainedFilesFor returns the files contained by the given element.
 // It looks up CONTAINS relationships where the element is the 'from' side.
 func (d *Document) GetContainedFilesFor(spdxID string) []*spdx.File {
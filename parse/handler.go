@@ -0,0 +1,61 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
+)
+
+// ElementHandler is called with an element of a type handled through
+// WithElementHandler. obj is the element parsed into its model struct, as
+// the document holds it, or nil for types the model does not define;
+// elemMap is the JSON of the element, which the handler must not modify.
+// Returning an error stops the reading of the document.
+type ElementHandler func(obj interface{}, elemMap map[string]interface{}) error
+
+// WithElementHandler calls fn with every element of type t in the
+// documents the reader reads, in graph order, once the reader has filed it
+// into the document as it otherwise would. It lets applications capture
+// elements of custom types, or collect those of the built-in ones, into
+// their own structures without registering a type:
+//
+//	var firmware []map[string]interface{}
+//	reader := parse.NewReader(parse.WithElementHandler("acme_Firmware",
+//	    func(_ interface{}, m map[string]interface{}) error {
+//	        firmware = append(firmware, m)
+//	        return nil
+//	    }))
+//
+// Types are matched by their compact names, so that handlers also see
+// elements written with the legacy names of their types. Handlers of the
+// same type are called in the order they were given. Watchers do not call
+// them.
+func WithElementHandler(t ElementType, fn ElementHandler) Option {
+	return optionFunc(func(r *Reader) {
+		if r.handlers == nil {
+			r.handlers = make(map[ElementType][]ElementHandler)
+		}
+		t = compactType(t)
+		r.handlers[t] = append(r.handlers[t], fn)
+	})
+}
+
+// handles reports whether the reader has handlers of a type.
+func (r *Reader) handles(t ElementType) bool {
+	return len(r.handlers[compactType(t)]) > 0
+}
+
+// callHandlers calls the handlers of the type of an element.
+func (r *Reader) callHandlers(t ElementType, spdxID string, obj interface{}, elemMap map[string]interface{}) error {
+	for _, fn := range r.handlers[compactType(t)] {
+		if err := fn(obj, elemMap); err != nil {
+			return fmt.Errorf("handling %s element %q: %w", t, spdxID, err)
+		}
+	}
+	return nil
+}
+
+// compactType returns the compact name of an element type.
+func compactType(t ElementType) ElementType {
+	return ElementType(parser.CompactType(string(t)))
+}
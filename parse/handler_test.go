@@ -0,0 +1,73 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestWithElementHandler(t *testing.T) {
+	data := watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`,
+		`{"type": "acme_Widget", "spdxId": "urn:spdx:widget", "acme_size": 3}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`,
+		`{"type": "acme_Widget", "spdxId": "urn:spdx:gadget", "acme_size": 5}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			var widgets []float64
+			var packages []*spdx.Package
+			reader := parse.NewReader(append(opts,
+				parse.WithElementHandler("acme_Widget", func(obj interface{}, m map[string]interface{}) error {
+					if obj != nil {
+						t.Errorf("handler obj = %T, want nil for a custom type", obj)
+					}
+					size, _ := m["acme_size"].(float64)
+					widgets = append(widgets, size)
+					return nil
+				}),
+				parse.WithElementHandler(parse.TypeSoftwarePackage, func(obj interface{}, m map[string]interface{}) error {
+					pkg, ok := obj.(*spdx.Package)
+					if !ok || m["name"] != pkg.Name {
+						t.Errorf("handler obj = %T, map %v; want the package and its JSON", obj, m)
+					}
+					packages = append(packages, pkg)
+					return nil
+				}),
+			)...)
+			doc, err := reader.Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(widgets) != 2 || widgets[0] != 3 || widgets[1] != 5 {
+				t.Errorf("widgets = %v, want [3 5]", widgets)
+			}
+			if len(packages) != 2 || len(doc.Packages) != 2 {
+				t.Fatalf("handled %d packages, document has %d; want 2 of each", len(packages), len(doc.Packages))
+			}
+			if packages[0] != doc.Packages[0] || packages[1].Name != "lib" {
+				t.Errorf("handled packages are not the ones the document holds, in graph order")
+			}
+		})
+	}
+}
+
+func TestWithElementHandler_Error(t *testing.T) {
+	errStop := errors.New("stop")
+	data := watchDoc(`{"type": "acme_Widget", "spdxId": "urn:spdx:widget"}`)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			reader := parse.NewReader(append(opts, parse.WithElementHandler("acme_Widget", func(interface{}, map[string]interface{}) error {
+				return errStop
+			}))...)
+			if _, err := reader.Read(data); !errors.Is(err, errStop) {
+				t.Errorf("Read() error = %v, want %v", err, errStop)
+			}
+		})
+	}
+}
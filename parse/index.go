@@ -0,0 +1,197 @@
+package parse
+
+import (
+	"runtime"
+	"sync"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// Index names a group of the ID and relationship indexes of a Document.
+// Indexes are combined with |.
+type Index uint
+
+const (
+	// IndexCore is AgentsByID, OrganizationsByID, PersonsByID,
+	// SoftwareAgentsByID and ToolsByID.
+	IndexCore Index = 1 << iota
+	// IndexSoftware is PackagesByID and FilesByID.
+	IndexSoftware
+	// IndexLicensing is the ByID maps of the licensing elements.
+	IndexLicensing
+	// IndexSecurity is VulnerabilitiesByID and the ByID maps of the
+	// vulnerability assessments.
+	IndexSecurity
+	// IndexAI is AiPackagesByID.
+	IndexAI
+	// IndexDataset is DatasetPackagesByID.
+	IndexDataset
+	// IndexBuild is BuildsByID.
+	IndexBuild
+	// IndexRelationships is RelationshipsFromIndex and RelationshipsToIndex.
+	IndexRelationships
+
+	// AllIndexes is every index, which the reader builds by default.
+	AllIndexes = IndexCore | IndexSoftware | IndexLicensing | IndexSecurity |
+		IndexAI | IndexDataset | IndexBuild | IndexRelationships
+)
+
+// indexTasks holds the task building each index. Tasks write disjoint
+// fields of the document and may run concurrently.
+var indexTasks = []struct {
+	index Index
+	build func(*Document)
+}{
+	{IndexCore, indexCoreElements},
+	{IndexSoftware, indexSoftwareElements},
+	{IndexLicensing, indexLicensingElements},
+	{IndexSecurity, indexSecurityElements},
+	{IndexAI, func(doc *Document) { doc.AiPackagesByID = indexByID(doc.AiPackages) }},
+	{IndexDataset, func(doc *Document) { doc.DatasetPackagesByID = indexByID(doc.DatasetPackages) }},
+	{IndexBuild, func(doc *Document) { doc.BuildsByID = indexByID(doc.Builds) }},
+	{IndexRelationships, func(doc *Document) { doc.RelationshipsFromIndex = indexRelationshipsFrom(doc.Relationships) }},
+	{IndexRelationships, func(doc *Document) { doc.RelationshipsToIndex = indexRelationshipsTo(doc.Relationships) }},
+}
+
+// BuildIndexes builds the given indexes of the document from its typed
+// slices, or all of them if none is given, replacing those built already.
+// It is meant for documents read WithIndexes that turn out to need more.
+func (d *Document) BuildIndexes(indexes ...Index) {
+	set := AllIndexes
+	if len(indexes) > 0 {
+		set = 0
+		for _, index := range indexes {
+			set |= index
+		}
+	}
+	buildIndexes(d, set)
+}
+
+// indexDocument builds the indexes of a document the reader read,
+// reporting the progress to the ProgressFunc of the reader.
+func (r *Reader) indexDocument(doc *Document) {
+	if r.progress == nil {
+		buildIndexes(doc, r.indexes)
+		return
+	}
+	total := 0
+	for _, t := range indexTasks {
+		if r.indexes&t.index != 0 {
+			total++
+		}
+	}
+	p := r.startProgress(PhaseIndexes, total)
+	buildIndexes(doc, r.indexes)
+	p.finish(total)
+}
+
+// parallelIndexThreshold is the number of elements from which buildIndexes
+// builds the indexes of the element categories concurrently. Below it,
+// starting the goroutines costs more than it saves; see
+// BenchmarkReader_BuildIndexes.
+const parallelIndexThreshold = 2048
+
+// buildIndexes builds the given indexes of a document from its typed
+// slices. Each category of elements is indexed by its own task, and large
+// documents run the tasks concurrently.
+func buildIndexes(doc *Document, indexes Index) {
+	var tasks []func(*Document)
+	for _, t := range indexTasks {
+		if indexes&t.index != 0 {
+			tasks = append(tasks, t.build)
+		}
+	}
+
+	if len(doc.ElementsByID) < parallelIndexThreshold || runtime.GOMAXPROCS(0) == 1 {
+		for _, build := range tasks {
+			build(doc)
+		}
+		return
+	}
+	var wg sync.WaitGroup
+	for _, build := range tasks {
+		wg.Go(func() { build(doc) })
+	}
+	wg.Wait()
+}
+
+func indexCoreElements(doc *Document) {
+	doc.AgentsByID = indexByID(doc.Agents)
+	doc.OrganizationsByID = indexByID(doc.Organizations)
+	doc.PersonsByID = indexByID(doc.Persons)
+	doc.SoftwareAgentsByID = indexByID(doc.SoftwareAgents)
+	doc.ToolsByID = indexByID(doc.Tools)
+}
+
+func indexSoftwareElements(doc *Document) {
+	doc.PackagesByID = indexByID(doc.Packages)
+	doc.FilesByID = indexByID(doc.Files)
+}
+
+func indexLicensingElements(doc *Document) {
+	doc.AnyLicenseInfosByID = indexByID(doc.AnyLicenseInfos)
+	doc.ConjunctiveLicenseSetsByID = indexByID(doc.ConjunctiveLicenseSets)
+	doc.CustomLicensesByID = indexByID(doc.CustomLicenses)
+	doc.CustomLicenseAdditionsByID = indexByID(doc.CustomLicenseAdditions)
+	doc.DisjunctiveLicenseSetsByID = indexByID(doc.DisjunctiveLicenseSets)
+	doc.IndividualLicensingInfosByID = indexByID(doc.IndividualLicensingInfos)
+	doc.ListedLicensesByID = indexByID(doc.ListedLicenses)
+	doc.ListedLicenseExceptionsByID = indexByID(doc.ListedLicenseExceptions)
+	doc.LicenseExpressionsByID = indexByID(doc.LicenseExpressions)
+	doc.OrLaterOperatorsByID = indexByID(doc.OrLaterOperators)
+	doc.SimpleLicensingTextsByID = indexByID(doc.SimpleLicensingTexts)
+	doc.WithAdditionOperatorsByID = indexByID(doc.WithAdditionOperators)
+}
+
+func indexSecurityElements(doc *Document) {
+	doc.VulnerabilitiesByID = indexByID(doc.Vulnerabilities)
+	doc.CvssV2VulnAssessmentsByID = indexByID(doc.CvssV2VulnAssessments)
+	doc.CvssV3VulnAssessmentsByID = indexByID(doc.CvssV3VulnAssessments)
+	doc.CvssV4VulnAssessmentsByID = indexByID(doc.CvssV4VulnAssessments)
+	doc.EpssVulnAssessmentsByID = indexByID(doc.EpssVulnAssessments)
+	doc.SsvcVulnAssessmentsByID = indexByID(doc.SsvcVulnAssessments)
+	doc.ExploitCatalogVulnAssessmentsByID = indexByID(doc.ExploitCatalogVulnAssessments)
+	doc.VexAffectedVulnAssessmentsByID = indexByID(doc.VexAffectedVulnAssessments)
+	doc.VexFixedVulnAssessmentsByID = indexByID(doc.VexFixedVulnAssessments)
+	doc.VexNotAffectedVulnAssessmentsByID = indexByID(doc.VexNotAffectedVulnAssessments)
+	doc.VexUnderInvestigationVulnAssessmentsByID = indexByID(doc.VexUnderInvestigationVulnAssessments)
+}
+
+// indexByID returns the elements with an SPDX ID keyed by it. Later
+// elements replace earlier ones with the same ID.
+func indexByID[E interface{ GetSpdxID() string }](elems []E) map[string]E {
+	index := make(map[string]E, len(elems))
+	for _, e := range elems {
+		if id := e.GetSpdxID(); id != "" {
+			index[id] = e
+		}
+	}
+	return index
+}
+
+func indexRelationshipsFrom(rels []*spdx.Relationship) map[string][]*spdx.Relationship {
+	index := make(map[string][]*spdx.Relationship)
+	for _, rel := range rels {
+		fromID := rel.From.GetSpdxID()
+		index[fromID] = append(index[fromID], rel)
+	}
+	return index
+}
+
+func indexRelationshipsTo(rels []*spdx.Relationship) map[string][]*spdx.Relationship {
+	index := make(map[string][]*spdx.Relationship)
+	for _, rel := range rels {
+		for _, to := range rel.To {
+			toID := to.GetSpdxID()
+			index[toID] = append(index[toID], rel)
+		}
+	}
+	return index
+}
+
+// addToIndex adds an element to an ID index that has been built already.
+func addToIndex[E any](index map[string]E, id string, e E) {
+	if index != nil && id != "" {
+		index[id] = e
+	}
+}
@@ -0,0 +1,86 @@
+package parse_test
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_BuildIndexes(t *testing.T) {
+	// Large documents have their indexes built concurrently.
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	data := benchmarkDocument(t, 3000)
+
+	for _, opts := range [][]parse.Option{nil, {parse.WithStreaming()}} {
+		doc, err := parse.NewReader(opts...).Read(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(doc.PackagesByID) != 3000 || doc.GetPackageByID("urn:spdx:pkg-2999") != doc.Packages[2999] {
+			t.Errorf("PackagesByID has %d packages, want 3000", len(doc.PackagesByID))
+		}
+		if got := len(doc.GetRelationshipsFrom("urn:spdx:app")); got != 3000 {
+			t.Errorf("relationships from app = %d, want 3000", got)
+		}
+		if got := doc.GetRelationshipsTo("urn:spdx:pkg-42"); len(got) != 1 || got[0].SpdxID != "urn:spdx:pkg-42-rel" {
+			t.Errorf("relationships to pkg-42 = %v", got)
+		}
+		if doc.AgentsByID == nil || doc.VulnerabilitiesByID == nil || doc.BuildsByID == nil {
+			t.Error("indexes of absent categories are nil")
+		}
+	}
+}
+
+// BenchmarkReader_BuildIndexes reads documents around the size from which
+// the indexes are built concurrently, to keep the threshold where the
+// concurrent build starts to pay off.
+func BenchmarkReader_BuildIndexes(b *testing.B) {
+	for _, n := range []int{256, 1024, 4096, 16384} {
+		data := benchmarkDocument(b, n/2)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			reader := parse.NewReader(parse.WithStreaming())
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := reader.Read(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestReader_WithIndexes(t *testing.T) {
+	for _, opts := range [][]parse.Option{{parse.WithIndexes(parse.IndexSoftware)}, {parse.WithIndexes(parse.IndexSoftware), parse.WithStreaming()}} {
+		doc, err := parse.NewReader(opts...).Read([]byte(lazyDocJSON))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(doc.PackagesByID) != 2 {
+			t.Errorf("PackagesByID = %v, want 2 packages", doc.PackagesByID)
+		}
+		if doc.AgentsByID != nil || doc.ListedLicensesByID != nil || doc.RelationshipsFromIndex != nil {
+			t.Error("indexes not asked for were built")
+		}
+		// Lookups scan the typed slices instead.
+		if got := doc.GetRelationshipsFrom("SPDXRef-App"); len(got) != 1 {
+			t.Errorf("GetRelationshipsFrom() = %v, want 1 relationship", got)
+		}
+
+		rel := &spdx.Relationship{From: spdx.Element{SpdxID: "SPDXRef-Lib"}, RelationshipType: spdx.RelationshipTypeDependsOn}
+		rel.SpdxID = "SPDXRef-Rel-3"
+		rel.To = []spdx.Element{{SpdxID: "SPDXRef-File"}}
+		if err := doc.AddElements(rel); err != nil {
+			t.Fatalf("AddElements() error = %v", err)
+		}
+		doc.BuildIndexes(parse.IndexRelationships)
+		if got := doc.RelationshipsToIndex["SPDXRef-File"]; len(got) != 2 {
+			t.Errorf("RelationshipsToIndex after BuildIndexes = %v, want 2 relationships", got)
+		}
+		if doc.AgentsByID != nil {
+			t.Error("BuildIndexes built indexes not asked for")
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package parse
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// InferenceRule is a rule by which InferRelationships adds relationships
+// that a document implies but does not state.
+type InferenceRule string
+
+// Rules of InferRelationships.
+const (
+	// InferDescribes adds a describes relationship from a Bom, Sboms
+	// included, to those of its root elements it does not describe.
+	InferDescribes InferenceRule = "describes"
+	// InferFileDependencies adds a dependsOn relationship from a package to
+	// the packages that the files it contains depend on, when it has no
+	// dependency relationship to them.
+	InferFileDependencies InferenceRule = "fileDependencies"
+)
+
+// inferenceRules are the rules InferRelationships applies by default, in
+// the order it applies them.
+var inferenceRules = []InferenceRule{InferDescribes, InferFileDependencies}
+
+// inferredPrefix starts the comments of inferred relationships.
+const inferredPrefix = "Inferred by spdx-zen: "
+
+// InferRelationships adds the relationships that the document implies by
+// the given rules, or by all of them if none is given, and returns them.
+// Each has an SPDX ID "<document ID>#inferred-<n>", the creation info of
+// the document, created now, and a comment starting "Inferred by spdx-zen:"
+// that says why it was inferred, as IsInferred reports. Relationships
+// inferred by one rule are not used by the next.
+//
+//	inferred, err := doc.InferRelationships(parse.InferDescribes)
+//	...
+//	for _, rel := range inferred {
+//	    log.Printf("%s %s %v: %s", rel.From.SpdxID, rel.RelationshipType, rel.To, rel.Comment)
+//	}
+func (d *Document) InferRelationships(rules ...InferenceRule) ([]*spdx.Relationship, error) {
+	if len(rules) == 0 {
+		rules = inferenceRules
+	}
+	for _, rule := range rules {
+		if !slices.Contains(inferenceRules, rule) {
+			return nil, fmt.Errorf("unknown inference rule %q", rule)
+		}
+	}
+
+	type edge struct {
+		from    string
+		relType spdx.RelationshipType
+		to      []string
+		reason  string
+	}
+	var edges []edge
+	for _, rule := range rules {
+		switch rule {
+		case InferDescribes:
+			for _, bom := range d.Boms {
+				var missing []string
+				for _, root := range bom.RootElement {
+					if !d.hasRelationship(bom.SpdxID, root.SpdxID, (*spdx.Relationship).IsDescription) {
+						missing = append(missing, root.SpdxID)
+					}
+				}
+				if len(missing) > 0 {
+					edges = append(edges, edge{bom.SpdxID, spdx.RelationshipTypeDescribes, missing, "the Bom lists the elements as its root elements"})
+				}
+			}
+		case InferFileDependencies:
+			for _, pkg := range d.Packages {
+				var missing []string
+				for _, file := range d.containedFiles(pkg.SpdxID) {
+					for _, rel := range d.GetRelationshipsFrom(file) {
+						if !rel.IsDependency() {
+							continue
+						}
+						for _, to := range rel.To {
+							id := to.GetSpdxID()
+							if id == pkg.SpdxID || d.GetPackageByID(id) == nil || slices.Contains(missing, id) ||
+								d.hasRelationship(pkg.SpdxID, id, (*spdx.Relationship).IsDependency) {
+								continue
+							}
+							missing = append(missing, id)
+						}
+					}
+				}
+				if len(missing) > 0 {
+					edges = append(edges, edge{pkg.SpdxID, spdx.RelationshipTypeDependsOn, missing, "files contained in the package depend on the packages"})
+				}
+			}
+		}
+	}
+
+	ci := spdx.NewCreationInfo(nil)
+	if d.CreationInfo != nil {
+		ci = *d.CreationInfo.Copy()
+	}
+	ci.Created = time.Now().UTC().Truncate(time.Second)
+
+	var inferred []*spdx.Relationship
+	for _, e := range edges {
+		to := make([]spdx.Element, len(e.to))
+		for i, id := range e.to {
+			to[i] = spdx.Element{SpdxID: id}
+		}
+		rel := spdx.NewRelationship(d.newElementID("inferred"), spdx.Element{SpdxID: e.from}, to, e.relType, ci)
+		rel.Comment = inferredPrefix + e.reason
+		if err := d.AddElements(rel); err != nil {
+			return inferred, fmt.Errorf("adding inferred relationship: %w", err)
+		}
+		inferred = append(inferred, rel)
+	}
+	return inferred, nil
+}
+
+// IsInferred reports whether a relationship was added by
+// InferRelationships, including in a document written and read again.
+func IsInferred(rel *spdx.Relationship) bool {
+	return strings.HasPrefix(rel.Comment, inferredPrefix)
+}
+
+// hasRelationship reports whether the document has a relationship from one
+// element to another that satisfies is.
+func (d *Document) hasRelationship(from, to string, is func(*spdx.Relationship) bool) bool {
+	for _, rel := range d.GetRelationshipsFrom(from) {
+		if is(rel) && slices.ContainsFunc(rel.To, func(e spdx.Element) bool { return e.GetSpdxID() == to }) {
+			return true
+		}
+	}
+	return false
+}
+
+// containedFiles returns the SPDX IDs of the files that an element
+// contains.
+func (d *Document) containedFiles(spdxID string) []string {
+	var files []string
+	for _, rel := range d.GetRelationshipsFrom(spdxID) {
+		if !rel.IsContainment() {
+			continue
+		}
+		for _, to := range rel.To {
+			if d.GetFileByID(to.GetSpdxID()) != nil {
+				files = append(files, to.GetSpdxID())
+			}
+		}
+	}
+	return files
+}
@@ -0,0 +1,71 @@
+package parse_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_InferRelationships(t *testing.T) {
+	data := watchDoc(
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "creationInfo": "_:ci", "element": ["urn:spdx:app"]}`,
+		`{"type": "software_Sbom", "spdxId": "urn:spdx:sbom", "creationInfo": "_:ci", "rootElement": ["urn:spdx:app", "urn:spdx:cli"]}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "creationInfo": "_:ci", "name": "app"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:cli", "creationInfo": "_:ci", "name": "cli"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "creationInfo": "_:ci", "name": "lib"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:log", "creationInfo": "_:ci", "name": "log"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "creationInfo": "_:ci", "name": "main.go"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:util", "creationInfo": "_:ci", "name": "util.go"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-1", "creationInfo": "_:ci", "from": "urn:spdx:sbom", "to": ["urn:spdx:cli"], "relationshipType": "describes"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-2", "creationInfo": "_:ci", "from": "urn:spdx:app", "to": ["urn:spdx:main", "urn:spdx:util"], "relationshipType": "contains"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-3", "creationInfo": "_:ci", "from": "urn:spdx:main", "to": ["urn:spdx:lib", "urn:spdx:app"], "relationshipType": "dependsOn"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-4", "creationInfo": "_:ci", "from": "urn:spdx:util", "to": ["urn:spdx:lib", "urn:spdx:log"], "relationshipType": "dependsOn"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-5", "creationInfo": "_:ci", "from": "urn:spdx:app", "to": ["urn:spdx:log"], "relationshipType": "hasOptionalDependency"}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(opts...).Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			inferred, err := doc.InferRelationships()
+			if err != nil {
+				t.Fatalf("InferRelationships() error = %v", err)
+			}
+
+			var got []string
+			for _, rel := range inferred {
+				got = append(got, rel.SpdxID+" "+rel.From.SpdxID+" "+string(rel.RelationshipType))
+				for _, to := range rel.To {
+					got[len(got)-1] += " " + to.SpdxID
+				}
+				if !parse.IsInferred(rel) {
+					t.Errorf("IsInferred(%s) = false, comment %q", rel.SpdxID, rel.Comment)
+				}
+			}
+			want := []string{
+				"urn:spdx:doc#inferred-1 urn:spdx:sbom describes urn:spdx:app",
+				"urn:spdx:doc#inferred-2 urn:spdx:app dependsOn urn:spdx:lib",
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("InferRelationships() = %q, want %q", got, want)
+			}
+			if deps := doc.GetDependenciesFor("urn:spdx:app"); len(deps) != 2 {
+				t.Errorf("GetDependenciesFor() = %v, want 2 packages", deps)
+			}
+			if _, ok := doc.ElementsByID["urn:spdx:doc#inferred-2"]; !ok {
+				t.Error("inferred relationship is not in ElementsByID")
+			}
+
+			again, err := doc.InferRelationships()
+			if err != nil || len(again) != 0 {
+				t.Errorf("second InferRelationships() = %v, %v, want nothing", again, err)
+			}
+			if _, err := doc.InferRelationships("transitive"); err == nil {
+				t.Error("InferRelationships() of an unknown rule succeeded")
+			}
+		})
+	}
+}
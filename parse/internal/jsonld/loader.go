@@ -2,7 +2,14 @@
 package jsonld
 
 import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+
 	"github.com/piprate/json-gold/ld"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
 )
 
 // DocumentLoader defines the interface for loading JSON-LD documents.
@@ -11,34 +18,57 @@ type DocumentLoader interface {
 	LoadDocument(url string) (*ld.RemoteDocument, error)
 }
 
-// FallbackLoader provides a document loader that falls back to empty contexts
-// when remote URLs are unavailable.
+// FallbackLoader provides a document loader that serves the SPDX context from
+// the model and falls back to empty contexts when remote URLs are unavailable.
 type FallbackLoader struct {
 	defaultLoader ld.DocumentLoader
+	logger        *slog.Logger
 }
 
-// NewFallbackLoader creates a new FallbackLoader with the default document loader.
-func NewFallbackLoader() *FallbackLoader {
+// NewFallbackLoader creates a new FallbackLoader with the default document
+// loader, which logs remote context fetches to logger.
+func NewFallbackLoader(logger *slog.Logger) *FallbackLoader {
 	return &FallbackLoader{
 		defaultLoader: ld.NewDefaultDocumentLoader(nil),
+		logger:        logger,
 	}
 }
 
 // LoadDocument loads a JSON-LD document from the given URL.
-// If the default loader fails, it returns an empty context document.
+// The SPDX context is served without network access; for other URLs, if
+// the default loader fails, it returns an empty context document.
 func (l *FallbackLoader) LoadDocument(url string) (*ld.RemoteDocument, error) {
+	if url == spdx.ContextURL {
+		context, err := spdxContext()
+		if err != nil {
+			return nil, err
+		}
+		return &ld.RemoteDocument{DocumentURL: url, Document: context}, nil
+	}
+
+	l.logger.Debug("fetching JSON-LD context", "url", url)
 	doc, err := l.defaultLoader.LoadDocument(url)
 	if err == nil {
 		return doc, nil
 	}
 
 	// Return empty context for unavailable URLs
+	l.logger.Warn("JSON-LD context unavailable, using an empty context", "url", url, "error", err)
 	return &ld.RemoteDocument{
 		DocumentURL: url,
 		Document:    map[string]interface{}{},
 	}, nil
 }
 
+// spdxContext decodes the SPDX context once.
+var spdxContext = sync.OnceValues(func() (interface{}, error) {
+	var context interface{}
+	if err := json.Unmarshal([]byte(spdx.Context), &context); err != nil {
+		return nil, fmt.Errorf("decoding SPDX context: %w", err)
+	}
+	return context, nil
+})
+
 // Processor wraps the JSON-LD processor with configurable options.
 type Processor struct {
 	proc    *ld.JsonLdProcessor
@@ -71,3 +101,71 @@ func (p *Processor) Flatten(doc interface{}) (interface{}, error) {
 func (p *Processor) Compact(doc interface{}, context interface{}) (interface{}, error) {
 	return p.proc.Compact(doc, context, p.options)
 }
+
+// Canonicalize converts the document to RDF and returns the dataset in
+// canonical N-Quads form, as the RDFC-1.0 (URDNA2015) algorithm labels
+// blank nodes and orders statements. Statements about the subjects for
+// which exclude reports true, statements referring to them and statements
+// about blank nodes only they refer to are left out first.
+//
+// Terms the context does not define make canonicalization fail rather than
+// being dropped from the dataset, since the canonical form would not cover
+// them.
+func (p *Processor) Canonicalize(doc interface{}, exclude func(iri string) bool) (string, error) {
+	opts := p.options.Copy()
+	opts.Format = ""
+	opts.SafeMode = true
+	rdf, err := p.proc.ToRDF(doc, opts)
+	if err != nil {
+		return "", err
+	}
+	dataset := rdf.(*ld.RDFDataset)
+	if exclude != nil {
+		for name, quads := range dataset.Graphs {
+			dataset.Graphs[name] = excludeQuads(quads, exclude)
+		}
+	}
+
+	opts.Algorithm = ld.AlgorithmURDNA2015
+	opts.Format = "application/n-quads"
+	canonical, err := ld.NewJsonLdApi().Normalize(dataset, opts)
+	if err != nil {
+		return "", err
+	}
+	return canonical.(string), nil
+}
+
+// excludeQuads returns quads without those about or referring to excluded
+// subjects, and without those about the blank nodes that only removed quads
+// referred to.
+func excludeQuads(quads []*ld.Quad, exclude func(iri string) bool) []*ld.Quad {
+	removed := make(map[string]bool)
+	for _, q := range quads {
+		for _, n := range []ld.Node{q.Subject, q.Object} {
+			if ld.IsIRI(n) && exclude(n.GetValue()) {
+				removed[n.GetValue()] = true
+			}
+		}
+	}
+	for len(removed) > 0 {
+		var kept []*ld.Quad
+		orphans := make(map[string]bool)
+		for _, q := range quads {
+			if removed[q.Subject.GetValue()] || removed[q.Object.GetValue()] && !ld.IsLiteral(q.Object) {
+				if ld.IsBlankNode(q.Object) {
+					orphans[q.Object.GetValue()] = true
+				}
+				continue
+			}
+			kept = append(kept, q)
+		}
+		quads = kept
+		for _, q := range quads {
+			if ld.IsBlankNode(q.Object) {
+				delete(orphans, q.Object.GetValue())
+			}
+		}
+		removed = orphans
+	}
+	return quads
+}
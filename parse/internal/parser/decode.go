@@ -0,0 +1,407 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// Decoder decodes the graph elements of one document. It keeps the
+// buffers shared by the elements it decodes and interns their strings, so
+// that IDs, type names and other values repeated across the document are
+// only allocated once. A Decoder is not safe for concurrent use.
+type Decoder struct {
+	p       *ElementParser
+	members []member
+	strings map[string]string
+}
+
+// NewDecoder returns a Decoder for the elements of a document.
+func (p *ElementParser) NewDecoder() *Decoder {
+	return &Decoder{p: p, strings: make(map[string]string)}
+}
+
+// Limits on the buffers a Decoder keeps across Reset, so that one very
+// large document does not pin its buffers for all the documents after it.
+const (
+	maxRetainedMembers = 1 << 14
+	maxRetainedStrings = 1 << 16
+)
+
+// Reset prepares the decoder for the elements of another document, keeping
+// its buffers for reuse. Strings returned for earlier documents stay valid.
+func (d *Decoder) Reset() {
+	d.members = d.members[:0]
+	if cap(d.members) > maxRetainedMembers {
+		d.members = nil
+	}
+	if len(d.strings) > maxRetainedStrings {
+		d.strings = make(map[string]string)
+	} else {
+		clear(d.strings)
+	}
+}
+
+// Head holds the properties of a decoded element that the reader files it
+// by.
+type Head struct {
+	Type   string
+	SpdxID string
+}
+
+// Decode decodes the JSON object of a graph element according to its
+// "type" property, like Parse does for a JSON map. The data must be valid
+// JSON, as returned by json.Decoder for a json.RawMessage, and may be
+// reused once Decode returns. It returns false for types that are not part
+// of the model, which are then only described by the returned head.
+func (d *Decoder) Decode(data []byte) (interface{}, Head, bool, error) {
+	// The members of the previous element are no longer referenced.
+	d.members = d.members[:0]
+	o := object{d: d}
+	if err := o.split(data); err != nil {
+		return nil, Head{}, false, err
+	}
+	head := Head{Type: o.getString("type"), SpdxID: o.getString("spdxId")}
+	obj, ok := d.p.decodeType(CompactType(head.Type), &o)
+	return obj, head, ok, nil
+}
+
+// maxIntern is the length of the longest strings a Decoder interns. Longer
+// strings, such as license texts and descriptions, are rarely repeated.
+const maxIntern = 256
+
+// intern returns the string of b, allocating it only once per decoder.
+func (d *Decoder) intern(b []byte) string {
+	if len(b) > maxIntern {
+		return string(b)
+	}
+	if s, ok := d.strings[string(b)]; ok {
+		return s
+	}
+	s := string(b)
+	d.strings[s] = s
+	return s
+}
+
+// object is a JSON object split into its members, whose values are kept as
+// raw JSON and only converted when a property is read. It is the
+// counterpart of the JSON maps the Parse methods read: the decode methods
+// generated into decode_gen.go read objects the same way, with the same
+// leniency, but without building an interface{} tree first.
+//
+// An object may also stand for an element reference, in which case it has
+// no members and ref holds the referenced ID.
+type object struct {
+	d       *Decoder
+	members []member
+	ref     string
+}
+
+type member struct {
+	key   []byte
+	value []byte
+}
+
+// split reads the members of the JSON object in data. They are appended to
+// the buffer of the decoder, which objects split earlier keep referring to
+// even if it grows.
+func (o *object) split(data []byte) error {
+	start := len(o.d.members)
+	err := o.d.split(data)
+	o.members = o.d.members[start:len(o.d.members):len(o.d.members)]
+	return err
+}
+
+func (d *Decoder) split(data []byte) error {
+	i := skipSpace(data, 0)
+	if i >= len(data) || data[i] != '{' {
+		return fmt.Errorf("element is not a JSON object")
+	}
+	i = skipSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return nil
+	}
+	for i < len(data) {
+		end := skipValue(data, i)
+		if end < 0 || data[i] != '"' {
+			return fmt.Errorf("invalid object key at offset %d", i)
+		}
+		key := data[i+1 : end-1]
+		i = skipSpace(data, end)
+		if i >= len(data) || data[i] != ':' {
+			return fmt.Errorf("missing ':' at offset %d", i)
+		}
+		i = skipSpace(data, i+1)
+		end = skipValue(data, i)
+		if end < 0 {
+			return fmt.Errorf("invalid value at offset %d", i)
+		}
+		d.members = append(d.members, member{key: key, value: data[i:end]})
+		i = skipSpace(data, end)
+		if i < len(data) && data[i] == ',' {
+			i = skipSpace(data, i+1)
+			continue
+		}
+		if i < len(data) && data[i] == '}' {
+			return nil
+		}
+		break
+	}
+	return fmt.Errorf("unterminated object")
+}
+
+// lookup returns the raw value of the member with the given key, or nil.
+// Escaped keys are compared unescaped. As for JSON maps, the last of
+// duplicate keys wins.
+func (o *object) lookup(key string) []byte {
+	for i := len(o.members) - 1; i >= 0; i-- {
+		if m := o.members[i]; string(m.key) == key {
+			return m.value
+		}
+	}
+	for i := len(o.members) - 1; i >= 0; i-- {
+		if m := o.members[i]; bytes.IndexByte(m.key, '\\') >= 0 && unescape(append(append([]byte{'"'}, m.key...), '"')) == key {
+			return m.value
+		}
+	}
+	return nil
+}
+
+// get returns the raw value of a property like Helpers.Get, falling back
+// to the unprefixed name of properties outside the Core profile.
+func (o *object) get(key string) []byte {
+	if v := o.lookup(key); v != nil {
+		return v
+	}
+	if i := strings.IndexByte(key, '_'); i > 0 {
+		return o.lookup(key[i+1:])
+	}
+	return nil
+}
+
+func (o *object) getString(key string) string {
+	return o.d.stringValue(o.get(key))
+}
+
+func (o *object) getStringSlice(key string) []string {
+	v := o.get(key)
+	if len(v) == 0 {
+		return nil
+	}
+	if v[0] == '"' {
+		return []string{o.d.unquote(v)}
+	}
+	var result []string
+	for item := range items(v) {
+		if len(item) > 0 && item[0] == '"' {
+			result = append(result, o.d.unquote(item))
+		}
+	}
+	return result
+}
+
+func (o *object) getInt(key string) int {
+	return int(o.getFloat(key))
+}
+
+func (o *object) getFloat(key string) float64 {
+	v := o.get(key)
+	if len(v) == 0 || (v[0] != '-' && (v[0] < '0' || v[0] > '9')) {
+		return 0
+	}
+	f, err := strconv.ParseFloat(string(v), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+func (o *object) getBool(key string) bool {
+	return string(o.get(key)) == "true"
+}
+
+func (o *object) getTime(key string) time.Time {
+	v := o.get(key)
+	if len(v) == 0 || v[0] != '"' {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, unescape(v))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// getMap returns the nested object of a property, or false if the
+// property is not an object.
+func (o *object) getMap(key string) (object, bool) {
+	return o.object(o.get(key))
+}
+
+// getSlice returns an iterator over the items of an array property.
+func (o *object) getSlice(key string) iter.Seq[[]byte] {
+	return items(o.get(key))
+}
+
+// object splits a raw value into an object, or returns false if it is not
+// an object.
+func (o *object) object(v []byte) (object, bool) {
+	if len(v) == 0 || v[0] != '{' {
+		return object{}, false
+	}
+	n := object{d: o.d}
+	if err := n.split(v); err != nil {
+		return object{}, false
+	}
+	return n, true
+}
+
+// decodeID returns the element identifier, like id does for JSON maps.
+func (p *ElementParser) decodeID(o *object) string {
+	if o.ref != "" {
+		return spdx.NormalizeElementRef(o.ref)
+	}
+	id := o.getString("spdxId")
+	if id == "" {
+		id = o.getString("@id")
+	}
+	return spdx.NormalizeElementRef(id)
+}
+
+// decodeNode returns the object of an element referenced from o, like node
+// does for JSON maps.
+func (p *ElementParser) decodeNode(o *object, v []byte, normalizeRef func(string) string) (object, bool) {
+	if len(v) == 0 {
+		return object{}, false
+	}
+	switch v[0] {
+	case '"':
+		return object{d: o.d, ref: normalizeRef(o.d.unquote(v))}, true
+	case '{':
+		return o.object(v)
+	}
+	return object{}, false
+}
+
+// stringValue returns the string a raw value holds, or "" if it is not a
+// string.
+func (d *Decoder) stringValue(v []byte) string {
+	if len(v) == 0 || v[0] != '"' {
+		return ""
+	}
+	return d.unquote(v)
+}
+
+// unquote returns the string of a quoted JSON string, interned. Strings
+// without escapes, the common case, need no further decoding.
+func (d *Decoder) unquote(v []byte) string {
+	inner := v[1 : len(v)-1]
+	if bytes.IndexByte(inner, '\\') >= 0 {
+		return unescape(v)
+	}
+	return d.intern(inner)
+}
+
+// unescape decodes a quoted JSON string with escapes.
+func unescape(v []byte) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		return ""
+	}
+	return s
+}
+
+// items returns an iterator over the raw items of a JSON array, yielding
+// nothing if v is not an array.
+func items(v []byte) iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		if len(v) == 0 || v[0] != '[' {
+			return
+		}
+		i := skipSpace(v, 1)
+		for i < len(v) && v[i] != ']' {
+			end := skipValue(v, i)
+			if end < 0 || !yield(v[i:end]) {
+				return
+			}
+			i = skipSpace(v, end)
+			if i < len(v) && v[i] == ',' {
+				i = skipSpace(v, i+1)
+			}
+		}
+	}
+}
+
+func skipSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// skipValue returns the offset just past the JSON value starting at offset
+// i, or -1 if there is no complete value there.
+func skipValue(data []byte, i int) int {
+	if i >= len(data) {
+		return -1
+	}
+	switch data[i] {
+	case '"':
+		for j := i + 1; j < len(data); j++ {
+			switch data[j] {
+			case '\\':
+				j++
+			case '"':
+				return j + 1
+			}
+		}
+		return -1
+	case '{', '[':
+		depth := 0
+		for j := i; j < len(data); j++ {
+			switch data[j] {
+			case '"':
+				end := skipValue(data, j)
+				if end < 0 {
+					return -1
+				}
+				j = end - 1
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+				if depth == 0 {
+					return j + 1
+				}
+			}
+		}
+		return -1
+	default:
+		j := i
+		for j < len(data) {
+			switch data[j] {
+			case ',', '}', ']', ' ', '\t', '\n', '\r':
+				if j == i {
+					return -1
+				}
+				return j
+			}
+			j++
+		}
+		if j == i {
+			return -1
+		}
+		return j
+	}
+}
@@ -0,0 +1,1270 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package parser
+
+import (
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// decodeType decodes obj as the class with the given compact JSON-LD
+// type name, like parseType does for JSON maps.
+func (p *ElementParser) decodeType(typ string, obj *object) (interface{}, bool) {
+	switch typ {
+	case "ai_AIPackage":
+		return p.decodeAIPackage(obj), true
+	case "ai_EnergyConsumption":
+		return p.decodeEnergyConsumption(obj), true
+	case "ai_EnergyConsumptionDescription":
+		return p.decodeEnergyConsumptionDescription(obj), true
+	case "build_Build":
+		return p.decodeBuild(obj), true
+	case "Agent":
+		return p.decodeAgent(obj), true
+	case "Annotation":
+		return p.decodeAnnotation(obj), true
+	case "Artifact":
+		return p.decodeArtifact(obj), true
+	case "Bom":
+		return p.decodeBom(obj), true
+	case "Bundle":
+		return p.decodeBundle(obj), true
+	case "CreationInfo":
+		return p.decodeCreationInfo(obj), true
+	case "DictionaryEntry":
+		return p.decodeDictionaryEntry(obj), true
+	case "Element":
+		return p.decodeElement(obj), true
+	case "ElementCollection":
+		return p.decodeElementCollection(obj), true
+	case "ExternalIdentifier":
+		return p.decodeExternalIdentifier(obj), true
+	case "ExternalMap":
+		return p.decodeExternalMap(obj), true
+	case "ExternalRef":
+		return p.decodeExternalRef(obj), true
+	case "Hash":
+		return p.decodeHash(obj), true
+	case "IndividualElement":
+		return p.decodeIndividualElement(obj), true
+	case "IntegrityMethod":
+		return p.decodeIntegrityMethod(obj), true
+	case "LifecycleScopedRelationship":
+		return p.decodeLifecycleScopedRelationship(obj), true
+	case "NamespaceMap":
+		return p.decodeNamespaceMap(obj), true
+	case "Organization":
+		return p.decodeOrganization(obj), true
+	case "PackageVerificationCode":
+		return p.decodePackageVerificationCode(obj), true
+	case "Person":
+		return p.decodePerson(obj), true
+	case "PositiveIntegerRange":
+		return p.decodePositiveIntegerRange(obj), true
+	case "Relationship":
+		return p.decodeRelationship(obj), true
+	case "SoftwareAgent":
+		return p.decodeSoftwareAgent(obj), true
+	case "SpdxDocument":
+		return p.decodeSpdxDocument(obj), true
+	case "Tool":
+		return p.decodeTool(obj), true
+	case "dataset_DatasetPackage":
+		return p.decodeDatasetPackage(obj), true
+	case "expandedlicensing_ConjunctiveLicenseSet":
+		return p.decodeConjunctiveLicenseSet(obj), true
+	case "expandedlicensing_CustomLicense":
+		return p.decodeCustomLicense(obj), true
+	case "expandedlicensing_CustomLicenseAddition":
+		return p.decodeCustomLicenseAddition(obj), true
+	case "expandedlicensing_DisjunctiveLicenseSet":
+		return p.decodeDisjunctiveLicenseSet(obj), true
+	case "expandedlicensing_ExtendableLicense":
+		return p.decodeExtendableLicense(obj), true
+	case "expandedlicensing_IndividualLicensingInfo":
+		return p.decodeIndividualLicensingInfo(obj), true
+	case "expandedlicensing_License":
+		return p.decodeLicense(obj), true
+	case "expandedlicensing_LicenseAddition":
+		return p.decodeLicenseAddition(obj), true
+	case "expandedlicensing_ListedLicense":
+		return p.decodeListedLicense(obj), true
+	case "expandedlicensing_ListedLicenseException":
+		return p.decodeListedLicenseException(obj), true
+	case "expandedlicensing_OrLaterOperator":
+		return p.decodeOrLaterOperator(obj), true
+	case "expandedlicensing_WithAdditionOperator":
+		return p.decodeWithAdditionOperator(obj), true
+	case "extension_CdxPropertiesExtension":
+		return p.decodeCdxPropertiesExtension(obj), true
+	case "extension_CdxPropertyEntry":
+		return p.decodeCdxPropertyEntry(obj), true
+	case "extension_Extension":
+		return p.decodeExtension(obj), true
+	case "security_CvssV2VulnAssessmentRelationship":
+		return p.decodeCvssV2VulnAssessmentRelationship(obj), true
+	case "security_CvssV3VulnAssessmentRelationship":
+		return p.decodeCvssV3VulnAssessmentRelationship(obj), true
+	case "security_CvssV4VulnAssessmentRelationship":
+		return p.decodeCvssV4VulnAssessmentRelationship(obj), true
+	case "security_EpssVulnAssessmentRelationship":
+		return p.decodeEpssVulnAssessmentRelationship(obj), true
+	case "security_ExploitCatalogVulnAssessmentRelationship":
+		return p.decodeExploitCatalogVulnAssessmentRelationship(obj), true
+	case "security_SsvcVulnAssessmentRelationship":
+		return p.decodeSsvcVulnAssessmentRelationship(obj), true
+	case "security_VexAffectedVulnAssessmentRelationship":
+		return p.decodeVexAffectedVulnAssessmentRelationship(obj), true
+	case "security_VexFixedVulnAssessmentRelationship":
+		return p.decodeVexFixedVulnAssessmentRelationship(obj), true
+	case "security_VexNotAffectedVulnAssessmentRelationship":
+		return p.decodeVexNotAffectedVulnAssessmentRelationship(obj), true
+	case "security_VexUnderInvestigationVulnAssessmentRelationship":
+		return p.decodeVexUnderInvestigationVulnAssessmentRelationship(obj), true
+	case "security_VexVulnAssessmentRelationship":
+		return p.decodeVexVulnAssessmentRelationship(obj), true
+	case "security_VulnAssessmentRelationship":
+		return p.decodeVulnAssessmentRelationship(obj), true
+	case "security_Vulnerability":
+		return p.decodeVulnerability(obj), true
+	case "simplelicensing_AnyLicenseInfo":
+		return p.decodeAnyLicenseInfo(obj), true
+	case "simplelicensing_LicenseExpression":
+		return p.decodeLicenseExpression(obj), true
+	case "simplelicensing_SimpleLicensingText":
+		return p.decodeSimpleLicensingText(obj), true
+	case "software_ContentIdentifier":
+		return p.decodeContentIdentifier(obj), true
+	case "software_File":
+		return p.decodeFile(obj), true
+	case "software_Package":
+		return p.decodePackage(obj), true
+	case "software_Sbom":
+		return p.decodeSbom(obj), true
+	case "software_Snippet":
+		return p.decodeSnippet(obj), true
+	case "software_SoftwareArtifact":
+		return p.decodeSoftwareArtifact(obj), true
+	}
+	return nil, false
+}
+
+func (p *ElementParser) decodeAIPackage(obj *object) *spdx.AIPackage {
+	o := &spdx.AIPackage{}
+	p.fillAIPackageFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAIPackageFrom(obj *object, o *spdx.AIPackage) {
+	p.fillPackageFrom(obj, &o.Package)
+	o.AutonomyType = spdx.NormalizePresenceType(obj.getString("ai_autonomyType"))
+	o.Domain = obj.getStringSlice("ai_domain")
+	if n, ok := obj.getMap("ai_energyConsumption"); ok {
+		o.EnergyConsumption = &spdx.EnergyConsumption{}
+		p.fillEnergyConsumptionFrom(&n, o.EnergyConsumption)
+	}
+	for v := range obj.getSlice("ai_hyperparameter") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.Hyperparameter = append(o.Hyperparameter, x)
+		}
+	}
+	o.InformationAboutApplication = obj.getString("ai_informationAboutApplication")
+	o.InformationAboutTraining = obj.getString("ai_informationAboutTraining")
+	o.Limitation = obj.getString("ai_limitation")
+	for v := range obj.getSlice("ai_metric") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.Metric = append(o.Metric, x)
+		}
+	}
+	for v := range obj.getSlice("ai_metricDecisionThreshold") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.MetricDecisionThreshold = append(o.MetricDecisionThreshold, x)
+		}
+	}
+	o.ModelDataPreprocessing = obj.getStringSlice("ai_modelDataPreprocessing")
+	o.ModelExplainability = obj.getStringSlice("ai_modelExplainability")
+	o.SafetyRiskAssessment = spdx.NormalizeSafetyRiskAssessmentType(obj.getString("ai_safetyRiskAssessment"))
+	o.StandardCompliance = obj.getStringSlice("ai_standardCompliance")
+	o.TypeOfModel = obj.getStringSlice("ai_typeOfModel")
+	o.UseSensitivePersonalInformation = spdx.NormalizePresenceType(obj.getString("ai_useSensitivePersonalInformation"))
+}
+
+func (p *ElementParser) decodeEnergyConsumption(obj *object) *spdx.EnergyConsumption {
+	o := &spdx.EnergyConsumption{}
+	p.fillEnergyConsumptionFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillEnergyConsumptionFrom(obj *object, o *spdx.EnergyConsumption) {
+	for v := range obj.getSlice("ai_finetuningEnergyConsumption") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.EnergyConsumptionDescription
+			p.fillEnergyConsumptionDescriptionFrom(&n, &x)
+			o.FinetuningEnergyConsumption = append(o.FinetuningEnergyConsumption, x)
+		}
+	}
+	for v := range obj.getSlice("ai_inferenceEnergyConsumption") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.EnergyConsumptionDescription
+			p.fillEnergyConsumptionDescriptionFrom(&n, &x)
+			o.InferenceEnergyConsumption = append(o.InferenceEnergyConsumption, x)
+		}
+	}
+	for v := range obj.getSlice("ai_trainingEnergyConsumption") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.EnergyConsumptionDescription
+			p.fillEnergyConsumptionDescriptionFrom(&n, &x)
+			o.TrainingEnergyConsumption = append(o.TrainingEnergyConsumption, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeEnergyConsumptionDescription(obj *object) *spdx.EnergyConsumptionDescription {
+	o := &spdx.EnergyConsumptionDescription{}
+	p.fillEnergyConsumptionDescriptionFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillEnergyConsumptionDescriptionFrom(obj *object, o *spdx.EnergyConsumptionDescription) {
+	o.EnergyQuantity = obj.getFloat("ai_energyQuantity")
+	o.EnergyUnit = spdx.NormalizeEnergyUnitType(obj.getString("ai_energyUnit"))
+}
+
+func (p *ElementParser) decodeBuild(obj *object) *spdx.Build {
+	o := &spdx.Build{}
+	p.fillBuildFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillBuildFrom(obj *object, o *spdx.Build) {
+	p.fillElementFrom(obj, &o.Element)
+	o.BuildType = obj.getString("build_buildType")
+	o.BuildId = obj.getString("build_buildId")
+	o.ConfigSourceEntrypoint = obj.getStringSlice("build_configSourceEntrypoint")
+	o.ConfigSourceUri = obj.getStringSlice("build_configSourceUri")
+	for v := range obj.getSlice("build_configSourceDigest") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.Hash
+			p.fillHashFrom(&n, &x)
+			o.ConfigSourceDigest = append(o.ConfigSourceDigest, x)
+		}
+	}
+	for v := range obj.getSlice("build_parameter") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.Parameter = append(o.Parameter, x)
+		}
+	}
+	o.BuildStartTime = obj.getTime("build_buildStartTime")
+	o.BuildEndTime = obj.getTime("build_buildEndTime")
+	for v := range obj.getSlice("build_environment") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.Environment = append(o.Environment, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeAgent(obj *object) *spdx.Agent {
+	o := &spdx.Agent{}
+	p.fillAgentFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAgentFrom(obj *object, o *spdx.Agent) {
+	p.fillElementFrom(obj, &o.Element)
+}
+
+func (p *ElementParser) decodeAnnotation(obj *object) *spdx.Annotation {
+	o := &spdx.Annotation{}
+	p.fillAnnotationFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAnnotationFrom(obj *object, o *spdx.Annotation) {
+	p.fillElementFrom(obj, &o.Element)
+	o.AnnotationType = spdx.NormalizeAnnotationType(obj.getString("annotationType"))
+	o.ContentType = obj.getString("contentType")
+	o.Statement = obj.getString("statement")
+	if n, ok := p.decodeNode(obj, obj.get("subject"), spdx.NormalizeElementRef); ok {
+		p.fillElementFrom(&n, &o.Subject)
+	}
+}
+
+func (p *ElementParser) decodeArtifact(obj *object) *spdx.Artifact {
+	o := &spdx.Artifact{}
+	p.fillArtifactFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillArtifactFrom(obj *object, o *spdx.Artifact) {
+	p.fillElementFrom(obj, &o.Element)
+	for v := range obj.getSlice("originatedBy") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeElementRef); ok {
+			var x spdx.Agent
+			p.fillAgentFrom(&n, &x)
+			o.OriginatedBy = append(o.OriginatedBy, x)
+		}
+	}
+	if n, ok := p.decodeNode(obj, obj.get("suppliedBy"), spdx.NormalizeElementRef); ok {
+		o.SuppliedBy = &spdx.Agent{}
+		p.fillAgentFrom(&n, o.SuppliedBy)
+	}
+	o.BuiltTime = obj.getTime("builtTime")
+	o.ReleaseTime = obj.getTime("releaseTime")
+	o.ValidUntilTime = obj.getTime("validUntilTime")
+	o.StandardName = obj.getStringSlice("standardName")
+	for _, s := range obj.getStringSlice("supportLevel") {
+		o.SupportLevel = append(o.SupportLevel, spdx.NormalizeSupportType(s))
+	}
+}
+
+func (p *ElementParser) decodeBom(obj *object) *spdx.Bom {
+	o := &spdx.Bom{}
+	p.fillBomFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillBomFrom(obj *object, o *spdx.Bom) {
+	p.fillBundleFrom(obj, &o.Bundle)
+}
+
+func (p *ElementParser) decodeBundle(obj *object) *spdx.Bundle {
+	o := &spdx.Bundle{}
+	p.fillBundleFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillBundleFrom(obj *object, o *spdx.Bundle) {
+	p.fillElementCollectionFrom(obj, &o.ElementCollection)
+	o.Context = obj.getString("context")
+}
+
+func (p *ElementParser) decodeCreationInfo(obj *object) *spdx.CreationInfo {
+	o := &spdx.CreationInfo{}
+	p.fillCreationInfoFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillCreationInfoFrom(obj *object, o *spdx.CreationInfo) {
+	o.SpecVersion = obj.getString("specVersion")
+	o.Comment = obj.getString("comment")
+	o.Created = obj.getTime("created")
+	for v := range obj.getSlice("createdBy") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeElementRef); ok {
+			var x spdx.Agent
+			p.fillAgentFrom(&n, &x)
+			o.CreatedBy = append(o.CreatedBy, x)
+		}
+	}
+	for v := range obj.getSlice("createdUsing") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeElementRef); ok {
+			var x spdx.Tool
+			p.fillToolFrom(&n, &x)
+			o.CreatedUsing = append(o.CreatedUsing, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeDictionaryEntry(obj *object) *spdx.DictionaryEntry {
+	o := &spdx.DictionaryEntry{}
+	p.fillDictionaryEntryFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillDictionaryEntryFrom(obj *object, o *spdx.DictionaryEntry) {
+	o.Key = obj.getString("key")
+	o.Value = obj.getString("value")
+}
+
+func (p *ElementParser) decodeElement(obj *object) *spdx.Element {
+	o := &spdx.Element{}
+	p.fillElementFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillElementFrom(obj *object, o *spdx.Element) {
+	o.SpdxID = p.decodeID(obj)
+	o.Name = obj.getString("name")
+	o.Summary = obj.getString("summary")
+	o.Description = obj.getString("description")
+	o.Comment = obj.getString("comment")
+	if n, ok := obj.getMap("creationInfo"); ok {
+		p.fillCreationInfoFrom(&n, &o.CreationInfo)
+	}
+	for v := range obj.getSlice("verifiedUsing") {
+		if n, ok := obj.object(v); ok {
+			o.VerifiedUsing = append(o.VerifiedUsing, p.decodeIntegrityMethodInterface(&n))
+		}
+	}
+	for v := range obj.getSlice("externalRef") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.ExternalRef
+			p.fillExternalRefFrom(&n, &x)
+			o.ExternalRef = append(o.ExternalRef, x)
+		}
+	}
+	for v := range obj.getSlice("externalIdentifier") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.ExternalIdentifier
+			p.fillExternalIdentifierFrom(&n, &x)
+			o.ExternalIdentifier = append(o.ExternalIdentifier, x)
+		}
+	}
+	for v := range obj.getSlice("extension") {
+		if n, ok := obj.object(v); ok {
+			o.Extension = append(o.Extension, p.decodeExtensionInterface(&n))
+		}
+	}
+}
+
+func (p *ElementParser) decodeElementCollection(obj *object) *spdx.ElementCollection {
+	o := &spdx.ElementCollection{}
+	p.fillElementCollectionFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillElementCollectionFrom(obj *object, o *spdx.ElementCollection) {
+	p.fillElementFrom(obj, &o.Element)
+	for v := range obj.getSlice("element") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeElementRef); ok {
+			var x spdx.Element
+			p.fillElementFrom(&n, &x)
+			o.Elements = append(o.Elements, x)
+		}
+	}
+	for v := range obj.getSlice("rootElement") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeElementRef); ok {
+			var x spdx.Element
+			p.fillElementFrom(&n, &x)
+			o.RootElement = append(o.RootElement, x)
+		}
+	}
+	for _, s := range obj.getStringSlice("profileConformance") {
+		o.ProfileConformance = append(o.ProfileConformance, spdx.NormalizeProfileIdentifierType(s))
+	}
+}
+
+func (p *ElementParser) decodeExternalIdentifier(obj *object) *spdx.ExternalIdentifier {
+	o := &spdx.ExternalIdentifier{}
+	p.fillExternalIdentifierFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillExternalIdentifierFrom(obj *object, o *spdx.ExternalIdentifier) {
+	o.ExternalIdentifierType = spdx.NormalizeExternalIdentifierType(obj.getString("externalIdentifierType"))
+	o.Identifier = obj.getString("identifier")
+	o.Comment = obj.getString("comment")
+	o.IdentifierLocator = obj.getStringSlice("identifierLocator")
+	o.IssuingAuthority = obj.getString("issuingAuthority")
+}
+
+func (p *ElementParser) decodeExternalMap(obj *object) *spdx.ExternalMap {
+	o := &spdx.ExternalMap{}
+	p.fillExternalMapFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillExternalMapFrom(obj *object, o *spdx.ExternalMap) {
+	o.ExternalSpdxId = obj.getString("externalSpdxId")
+	for v := range obj.getSlice("verifiedUsing") {
+		if n, ok := obj.object(v); ok {
+			o.VerifiedUsing = append(o.VerifiedUsing, p.decodeIntegrityMethodInterface(&n))
+		}
+	}
+	o.LocationHint = obj.getString("locationHint")
+	if n, ok := p.decodeNode(obj, obj.get("definingArtifact"), spdx.NormalizeElementRef); ok {
+		o.DefiningArtifact = &spdx.Artifact{}
+		p.fillArtifactFrom(&n, o.DefiningArtifact)
+	}
+}
+
+func (p *ElementParser) decodeExternalRef(obj *object) *spdx.ExternalRef {
+	o := &spdx.ExternalRef{}
+	p.fillExternalRefFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillExternalRefFrom(obj *object, o *spdx.ExternalRef) {
+	o.ExternalRefType = spdx.NormalizeExternalRefType(obj.getString("externalRefType"))
+	o.Locator = obj.getStringSlice("locator")
+	o.ContentType = obj.getString("contentType")
+	o.Comment = obj.getString("comment")
+}
+
+func (p *ElementParser) decodeHash(obj *object) *spdx.Hash {
+	o := &spdx.Hash{}
+	p.fillHashFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillHashFrom(obj *object, o *spdx.Hash) {
+	p.fillIntegrityMethodFrom(obj, &o.IntegrityMethod)
+	o.Algorithm = spdx.NormalizeHashAlgorithm(obj.getString("algorithm"))
+	o.HashValue = obj.getString("hashValue")
+}
+
+func (p *ElementParser) decodeIndividualElement(obj *object) *spdx.IndividualElement {
+	o := &spdx.IndividualElement{}
+	p.fillIndividualElementFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillIndividualElementFrom(obj *object, o *spdx.IndividualElement) {
+	p.fillElementFrom(obj, &o.Element)
+}
+
+func (p *ElementParser) decodeIntegrityMethod(obj *object) *spdx.IntegrityMethod {
+	o := &spdx.IntegrityMethod{}
+	p.fillIntegrityMethodFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillIntegrityMethodFrom(obj *object, o *spdx.IntegrityMethod) {
+	o.Comment = obj.getString("comment")
+}
+
+func (p *ElementParser) decodeLifecycleScopedRelationship(obj *object) *spdx.LifecycleScopedRelationship {
+	o := &spdx.LifecycleScopedRelationship{}
+	p.fillLifecycleScopedRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLifecycleScopedRelationshipFrom(obj *object, o *spdx.LifecycleScopedRelationship) {
+	p.fillRelationshipFrom(obj, &o.Relationship)
+	o.Scope = spdx.NormalizeLifecycleScopeType(obj.getString("scope"))
+}
+
+func (p *ElementParser) decodeNamespaceMap(obj *object) *spdx.NamespaceMap {
+	o := &spdx.NamespaceMap{}
+	p.fillNamespaceMapFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillNamespaceMapFrom(obj *object, o *spdx.NamespaceMap) {
+	o.Prefix = obj.getString("prefix")
+	o.Namespace = obj.getString("namespace")
+}
+
+func (p *ElementParser) decodeOrganization(obj *object) *spdx.Organization {
+	o := &spdx.Organization{}
+	p.fillOrganizationFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillOrganizationFrom(obj *object, o *spdx.Organization) {
+	p.fillAgentFrom(obj, &o.Agent)
+}
+
+func (p *ElementParser) decodePackageVerificationCode(obj *object) *spdx.PackageVerificationCode {
+	o := &spdx.PackageVerificationCode{}
+	p.fillPackageVerificationCodeFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillPackageVerificationCodeFrom(obj *object, o *spdx.PackageVerificationCode) {
+	p.fillIntegrityMethodFrom(obj, &o.IntegrityMethod)
+	o.Algorithm = spdx.NormalizeHashAlgorithm(obj.getString("algorithm"))
+	o.HashValue = obj.getString("hashValue")
+	o.PackageVerificationCodeExcludedFile = obj.getStringSlice("packageVerificationCodeExcludedFile")
+}
+
+func (p *ElementParser) decodePerson(obj *object) *spdx.Person {
+	o := &spdx.Person{}
+	p.fillPersonFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillPersonFrom(obj *object, o *spdx.Person) {
+	p.fillAgentFrom(obj, &o.Agent)
+}
+
+func (p *ElementParser) decodePositiveIntegerRange(obj *object) *spdx.PositiveIntegerRange {
+	o := &spdx.PositiveIntegerRange{}
+	p.fillPositiveIntegerRangeFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillPositiveIntegerRangeFrom(obj *object, o *spdx.PositiveIntegerRange) {
+	o.BeginIntegerRange = obj.getInt("beginIntegerRange")
+	o.EndIntegerRange = obj.getInt("endIntegerRange")
+}
+
+func (p *ElementParser) decodeRelationship(obj *object) *spdx.Relationship {
+	o := &spdx.Relationship{}
+	p.fillRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillRelationshipFrom(obj *object, o *spdx.Relationship) {
+	p.fillElementFrom(obj, &o.Element)
+	if n, ok := p.decodeNode(obj, obj.get("from"), spdx.NormalizeElementRef); ok {
+		p.fillElementFrom(&n, &o.From)
+	}
+	for v := range obj.getSlice("to") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeElementRef); ok {
+			var x spdx.Element
+			p.fillElementFrom(&n, &x)
+			o.To = append(o.To, x)
+		}
+	}
+	o.RelationshipType = spdx.NormalizeRelationshipType(obj.getString("relationshipType"))
+	o.Completeness = spdx.NormalizeRelationshipCompleteness(obj.getString("completeness"))
+	o.StartTime = obj.getTime("startTime")
+	o.EndTime = obj.getTime("endTime")
+}
+
+func (p *ElementParser) decodeSoftwareAgent(obj *object) *spdx.SoftwareAgent {
+	o := &spdx.SoftwareAgent{}
+	p.fillSoftwareAgentFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSoftwareAgentFrom(obj *object, o *spdx.SoftwareAgent) {
+	p.fillAgentFrom(obj, &o.Agent)
+}
+
+func (p *ElementParser) decodeSpdxDocument(obj *object) *spdx.SpdxDocument {
+	o := &spdx.SpdxDocument{}
+	p.fillSpdxDocumentFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSpdxDocumentFrom(obj *object, o *spdx.SpdxDocument) {
+	p.fillElementCollectionFrom(obj, &o.ElementCollection)
+	for v := range obj.getSlice("import") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.ExternalMap
+			p.fillExternalMapFrom(&n, &x)
+			o.Import = append(o.Import, x)
+		}
+	}
+	for v := range obj.getSlice("namespaceMap") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.NamespaceMap
+			p.fillNamespaceMapFrom(&n, &x)
+			o.NamespaceMap = append(o.NamespaceMap, x)
+		}
+	}
+	if n, ok := p.decodeNode(obj, obj.get("dataLicense"), spdx.NormalizeLicenseRef); ok {
+		o.DataLicense = &spdx.AnyLicenseInfo{}
+		p.fillAnyLicenseInfoFrom(&n, o.DataLicense)
+	}
+}
+
+func (p *ElementParser) decodeTool(obj *object) *spdx.Tool {
+	o := &spdx.Tool{}
+	p.fillToolFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillToolFrom(obj *object, o *spdx.Tool) {
+	p.fillElementFrom(obj, &o.Element)
+}
+
+func (p *ElementParser) decodeDatasetPackage(obj *object) *spdx.DatasetPackage {
+	o := &spdx.DatasetPackage{}
+	p.fillDatasetPackageFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillDatasetPackageFrom(obj *object, o *spdx.DatasetPackage) {
+	p.fillPackageFrom(obj, &o.Package)
+	o.AnonymizationMethodUsed = obj.getStringSlice("dataset_anonymizationMethodUsed")
+	o.ConfidentialityLevel = spdx.NormalizeConfidentialityLevelType(obj.getString("dataset_confidentialityLevel"))
+	o.DataCollectionProcess = obj.getString("dataset_dataCollectionProcess")
+	o.DataPreprocessing = obj.getStringSlice("dataset_dataPreprocessing")
+	o.DatasetAvailability = spdx.NormalizeDatasetAvailabilityType(obj.getString("dataset_datasetAvailability"))
+	o.DatasetNoise = obj.getString("dataset_datasetNoise")
+	o.DatasetSize = obj.getInt("dataset_datasetSize")
+	for _, s := range obj.getStringSlice("dataset_datasetType") {
+		o.DatasetType = append(o.DatasetType, spdx.NormalizeDatasetType(s))
+	}
+	o.DatasetUpdateMechanism = obj.getString("dataset_datasetUpdateMechanism")
+	o.HasSensitivePersonalInformation = spdx.NormalizePresenceType(obj.getString("dataset_hasSensitivePersonalInformation"))
+	o.IntendedUse = obj.getString("dataset_intendedUse")
+	o.KnownBias = obj.getStringSlice("dataset_knownBias")
+	for v := range obj.getSlice("dataset_sensor") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.Sensor = append(o.Sensor, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeConjunctiveLicenseSet(obj *object) *spdx.ConjunctiveLicenseSet {
+	o := &spdx.ConjunctiveLicenseSet{}
+	p.fillConjunctiveLicenseSetFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillConjunctiveLicenseSetFrom(obj *object, o *spdx.ConjunctiveLicenseSet) {
+	p.fillAnyLicenseInfoFrom(obj, &o.AnyLicenseInfo)
+	for v := range obj.getSlice("expandedlicensing_member") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeLicenseRef); ok {
+			var x spdx.AnyLicenseInfo
+			p.fillAnyLicenseInfoFrom(&n, &x)
+			o.Member = append(o.Member, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeCustomLicense(obj *object) *spdx.CustomLicense {
+	o := &spdx.CustomLicense{}
+	p.fillCustomLicenseFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCustomLicenseFrom(obj *object, o *spdx.CustomLicense) {
+	p.fillLicenseFrom(obj, &o.License)
+}
+
+func (p *ElementParser) decodeCustomLicenseAddition(obj *object) *spdx.CustomLicenseAddition {
+	o := &spdx.CustomLicenseAddition{}
+	p.fillCustomLicenseAdditionFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCustomLicenseAdditionFrom(obj *object, o *spdx.CustomLicenseAddition) {
+	p.fillLicenseAdditionFrom(obj, &o.LicenseAddition)
+}
+
+func (p *ElementParser) decodeDisjunctiveLicenseSet(obj *object) *spdx.DisjunctiveLicenseSet {
+	o := &spdx.DisjunctiveLicenseSet{}
+	p.fillDisjunctiveLicenseSetFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillDisjunctiveLicenseSetFrom(obj *object, o *spdx.DisjunctiveLicenseSet) {
+	p.fillAnyLicenseInfoFrom(obj, &o.AnyLicenseInfo)
+	for v := range obj.getSlice("expandedlicensing_member") {
+		if n, ok := p.decodeNode(obj, v, spdx.NormalizeLicenseRef); ok {
+			var x spdx.AnyLicenseInfo
+			p.fillAnyLicenseInfoFrom(&n, &x)
+			o.Member = append(o.Member, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeExtendableLicense(obj *object) *spdx.ExtendableLicense {
+	o := &spdx.ExtendableLicense{}
+	p.fillExtendableLicenseFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillExtendableLicenseFrom(obj *object, o *spdx.ExtendableLicense) {
+	p.fillAnyLicenseInfoFrom(obj, &o.AnyLicenseInfo)
+}
+
+func (p *ElementParser) decodeIndividualLicensingInfo(obj *object) *spdx.IndividualLicensingInfo {
+	o := &spdx.IndividualLicensingInfo{}
+	p.fillIndividualLicensingInfoFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillIndividualLicensingInfoFrom(obj *object, o *spdx.IndividualLicensingInfo) {
+	p.fillAnyLicenseInfoFrom(obj, &o.AnyLicenseInfo)
+}
+
+func (p *ElementParser) decodeLicense(obj *object) *spdx.License {
+	o := &spdx.License{}
+	p.fillLicenseFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLicenseFrom(obj *object, o *spdx.License) {
+	p.fillExtendableLicenseFrom(obj, &o.ExtendableLicense)
+	o.LicenseText = obj.getString("simplelicensing_licenseText")
+	o.IsDeprecatedLicenseId = obj.getBool("expandedlicensing_isDeprecatedLicenseId")
+	o.IsFsfLibre = obj.getBool("expandedlicensing_isFsfLibre")
+	o.IsOsiApproved = obj.getBool("expandedlicensing_isOsiApproved")
+	o.LicenseXml = obj.getString("expandedlicensing_licenseXml")
+	o.ObsoletedBy = obj.getString("expandedlicensing_obsoletedBy")
+	o.SeeAlso = obj.getStringSlice("expandedlicensing_seeAlso")
+	o.StandardLicenseHeader = obj.getString("expandedlicensing_standardLicenseHeader")
+	o.StandardLicenseTemplate = obj.getString("expandedlicensing_standardLicenseTemplate")
+}
+
+func (p *ElementParser) decodeLicenseAddition(obj *object) *spdx.LicenseAddition {
+	o := &spdx.LicenseAddition{}
+	p.fillLicenseAdditionFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLicenseAdditionFrom(obj *object, o *spdx.LicenseAddition) {
+	p.fillElementFrom(obj, &o.Element)
+	o.AdditionText = obj.getString("expandedlicensing_additionText")
+	o.IsDeprecatedAdditionId = obj.getBool("expandedlicensing_isDeprecatedAdditionId")
+	o.LicenseXml = obj.getString("expandedlicensing_licenseXml")
+	o.ObsoletedBy = obj.getString("expandedlicensing_obsoletedBy")
+	o.SeeAlso = obj.getStringSlice("expandedlicensing_seeAlso")
+	o.StandardAdditionTemplate = obj.getString("expandedlicensing_standardAdditionTemplate")
+}
+
+func (p *ElementParser) decodeListedLicense(obj *object) *spdx.ListedLicense {
+	o := &spdx.ListedLicense{}
+	p.fillListedLicenseFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillListedLicenseFrom(obj *object, o *spdx.ListedLicense) {
+	p.fillLicenseFrom(obj, &o.License)
+	o.DeprecatedVersion = obj.getString("expandedlicensing_deprecatedVersion")
+	o.ListVersionAdded = obj.getString("expandedlicensing_listVersionAdded")
+}
+
+func (p *ElementParser) decodeListedLicenseException(obj *object) *spdx.ListedLicenseException {
+	o := &spdx.ListedLicenseException{}
+	p.fillListedLicenseExceptionFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillListedLicenseExceptionFrom(obj *object, o *spdx.ListedLicenseException) {
+	p.fillLicenseAdditionFrom(obj, &o.LicenseAddition)
+	o.DeprecatedVersion = obj.getString("expandedlicensing_deprecatedVersion")
+	o.ListVersionAdded = obj.getString("expandedlicensing_listVersionAdded")
+}
+
+func (p *ElementParser) decodeOrLaterOperator(obj *object) *spdx.OrLaterOperator {
+	o := &spdx.OrLaterOperator{}
+	p.fillOrLaterOperatorFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillOrLaterOperatorFrom(obj *object, o *spdx.OrLaterOperator) {
+	p.fillExtendableLicenseFrom(obj, &o.ExtendableLicense)
+	if n, ok := p.decodeNode(obj, obj.get("expandedlicensing_subjectLicense"), spdx.NormalizeLicenseRef); ok {
+		p.fillLicenseFrom(&n, &o.SubjectLicense)
+	}
+}
+
+func (p *ElementParser) decodeWithAdditionOperator(obj *object) *spdx.WithAdditionOperator {
+	o := &spdx.WithAdditionOperator{}
+	p.fillWithAdditionOperatorFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillWithAdditionOperatorFrom(obj *object, o *spdx.WithAdditionOperator) {
+	p.fillAnyLicenseInfoFrom(obj, &o.AnyLicenseInfo)
+	if n, ok := p.decodeNode(obj, obj.get("expandedlicensing_subjectAddition"), spdx.NormalizeElementRef); ok {
+		p.fillLicenseAdditionFrom(&n, &o.SubjectAddition)
+	}
+	if n, ok := p.decodeNode(obj, obj.get("expandedlicensing_subjectExtendableLicense"), spdx.NormalizeLicenseRef); ok {
+		p.fillExtendableLicenseFrom(&n, &o.SubjectExtendableLicense)
+	}
+}
+
+func (p *ElementParser) decodeCdxPropertiesExtension(obj *object) *spdx.CdxPropertiesExtension {
+	o := &spdx.CdxPropertiesExtension{}
+	p.fillCdxPropertiesExtensionFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillCdxPropertiesExtensionFrom(obj *object, o *spdx.CdxPropertiesExtension) {
+	p.fillExtensionFrom(obj, &o.Extension)
+	for v := range obj.getSlice("extension_cdxProperty") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.CdxPropertyEntry
+			p.fillCdxPropertyEntryFrom(&n, &x)
+			o.CdxProperty = append(o.CdxProperty, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeCdxPropertyEntry(obj *object) *spdx.CdxPropertyEntry {
+	o := &spdx.CdxPropertyEntry{}
+	p.fillCdxPropertyEntryFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillCdxPropertyEntryFrom(obj *object, o *spdx.CdxPropertyEntry) {
+	o.CdxPropName = obj.getString("extension_cdxPropName")
+	o.CdxPropValue = obj.getString("extension_cdxPropValue")
+}
+
+func (p *ElementParser) decodeExtension(obj *object) *spdx.Extension {
+	o := &spdx.Extension{}
+	p.fillExtensionFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillExtensionFrom(obj *object, o *spdx.Extension) {
+}
+
+func (p *ElementParser) decodeCvssV2VulnAssessmentRelationship(obj *object) *spdx.CvssV2VulnAssessmentRelationship {
+	o := &spdx.CvssV2VulnAssessmentRelationship{}
+	p.fillCvssV2VulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCvssV2VulnAssessmentRelationshipFrom(obj *object, o *spdx.CvssV2VulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.Score = obj.getFloat("security_score")
+	o.VectorString = obj.getString("security_vectorString")
+}
+
+func (p *ElementParser) decodeCvssV3VulnAssessmentRelationship(obj *object) *spdx.CvssV3VulnAssessmentRelationship {
+	o := &spdx.CvssV3VulnAssessmentRelationship{}
+	p.fillCvssV3VulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCvssV3VulnAssessmentRelationshipFrom(obj *object, o *spdx.CvssV3VulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.Score = obj.getFloat("security_score")
+	o.Severity = spdx.NormalizeCvssSeverityType(obj.getString("security_severity"))
+	o.VectorString = obj.getString("security_vectorString")
+}
+
+func (p *ElementParser) decodeCvssV4VulnAssessmentRelationship(obj *object) *spdx.CvssV4VulnAssessmentRelationship {
+	o := &spdx.CvssV4VulnAssessmentRelationship{}
+	p.fillCvssV4VulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCvssV4VulnAssessmentRelationshipFrom(obj *object, o *spdx.CvssV4VulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.Score = obj.getFloat("security_score")
+	o.Severity = spdx.NormalizeCvssSeverityType(obj.getString("security_severity"))
+	o.VectorString = obj.getString("security_vectorString")
+}
+
+func (p *ElementParser) decodeEpssVulnAssessmentRelationship(obj *object) *spdx.EpssVulnAssessmentRelationship {
+	o := &spdx.EpssVulnAssessmentRelationship{}
+	p.fillEpssVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillEpssVulnAssessmentRelationshipFrom(obj *object, o *spdx.EpssVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.Probability = obj.getFloat("security_probability")
+	o.Percentile = obj.getFloat("security_percentile")
+}
+
+func (p *ElementParser) decodeExploitCatalogVulnAssessmentRelationship(obj *object) *spdx.ExploitCatalogVulnAssessmentRelationship {
+	o := &spdx.ExploitCatalogVulnAssessmentRelationship{}
+	p.fillExploitCatalogVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillExploitCatalogVulnAssessmentRelationshipFrom(obj *object, o *spdx.ExploitCatalogVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.CatalogType = spdx.NormalizeExploitCatalogType(obj.getString("security_catalogType"))
+	o.Exploited = obj.getBool("security_exploited")
+	o.Locator = obj.getString("security_locator")
+}
+
+func (p *ElementParser) decodeSsvcVulnAssessmentRelationship(obj *object) *spdx.SsvcVulnAssessmentRelationship {
+	o := &spdx.SsvcVulnAssessmentRelationship{}
+	p.fillSsvcVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSsvcVulnAssessmentRelationshipFrom(obj *object, o *spdx.SsvcVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.DecisionType = spdx.NormalizeSsvcDecisionType(obj.getString("security_decisionType"))
+}
+
+func (p *ElementParser) decodeVexAffectedVulnAssessmentRelationship(obj *object) *spdx.VexAffectedVulnAssessmentRelationship {
+	o := &spdx.VexAffectedVulnAssessmentRelationship{}
+	p.fillVexAffectedVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexAffectedVulnAssessmentRelationshipFrom(obj *object, o *spdx.VexAffectedVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationshipFrom(obj, &o.VexVulnAssessmentRelationship)
+	o.ActionStatement = obj.getString("security_actionStatement")
+	o.ActionStatementTime = obj.getTime("security_actionStatementTime")
+}
+
+func (p *ElementParser) decodeVexFixedVulnAssessmentRelationship(obj *object) *spdx.VexFixedVulnAssessmentRelationship {
+	o := &spdx.VexFixedVulnAssessmentRelationship{}
+	p.fillVexFixedVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexFixedVulnAssessmentRelationshipFrom(obj *object, o *spdx.VexFixedVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationshipFrom(obj, &o.VexVulnAssessmentRelationship)
+}
+
+func (p *ElementParser) decodeVexNotAffectedVulnAssessmentRelationship(obj *object) *spdx.VexNotAffectedVulnAssessmentRelationship {
+	o := &spdx.VexNotAffectedVulnAssessmentRelationship{}
+	p.fillVexNotAffectedVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexNotAffectedVulnAssessmentRelationshipFrom(obj *object, o *spdx.VexNotAffectedVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationshipFrom(obj, &o.VexVulnAssessmentRelationship)
+	o.JustificationType = spdx.NormalizeVexJustificationType(obj.getString("security_justificationType"))
+	o.ImpactStatement = obj.getString("security_impactStatement")
+	o.ImpactStatementTime = obj.getTime("security_impactStatementTime")
+}
+
+func (p *ElementParser) decodeVexUnderInvestigationVulnAssessmentRelationship(obj *object) *spdx.VexUnderInvestigationVulnAssessmentRelationship {
+	o := &spdx.VexUnderInvestigationVulnAssessmentRelationship{}
+	p.fillVexUnderInvestigationVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexUnderInvestigationVulnAssessmentRelationshipFrom(obj *object, o *spdx.VexUnderInvestigationVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationshipFrom(obj, &o.VexVulnAssessmentRelationship)
+}
+
+func (p *ElementParser) decodeVexVulnAssessmentRelationship(obj *object) *spdx.VexVulnAssessmentRelationship {
+	o := &spdx.VexVulnAssessmentRelationship{}
+	p.fillVexVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexVulnAssessmentRelationshipFrom(obj *object, o *spdx.VexVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationshipFrom(obj, &o.VulnAssessmentRelationship)
+	o.VexVersion = obj.getString("security_vexVersion")
+	o.StatusNotes = obj.getString("security_statusNotes")
+}
+
+func (p *ElementParser) decodeVulnAssessmentRelationship(obj *object) *spdx.VulnAssessmentRelationship {
+	o := &spdx.VulnAssessmentRelationship{}
+	p.fillVulnAssessmentRelationshipFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVulnAssessmentRelationshipFrom(obj *object, o *spdx.VulnAssessmentRelationship) {
+	p.fillRelationshipFrom(obj, &o.Relationship)
+	if n, ok := p.decodeNode(obj, obj.get("security_assessedElement"), spdx.NormalizeElementRef); ok {
+		o.AssessedElement = &spdx.SoftwareArtifact{}
+		p.fillSoftwareArtifactFrom(&n, o.AssessedElement)
+	}
+	o.PublishedTime = obj.getTime("security_publishedTime")
+	if n, ok := p.decodeNode(obj, obj.get("suppliedBy"), spdx.NormalizeElementRef); ok {
+		o.SuppliedBy = &spdx.Agent{}
+		p.fillAgentFrom(&n, o.SuppliedBy)
+	}
+	o.ModifiedTime = obj.getTime("security_modifiedTime")
+	o.WithdrawnTime = obj.getTime("security_withdrawnTime")
+}
+
+func (p *ElementParser) decodeVulnerability(obj *object) *spdx.Vulnerability {
+	o := &spdx.Vulnerability{}
+	p.fillVulnerabilityFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVulnerabilityFrom(obj *object, o *spdx.Vulnerability) {
+	p.fillArtifactFrom(obj, &o.Artifact)
+	o.PublishedTime = obj.getTime("security_publishedTime")
+	o.ModifiedTime = obj.getTime("security_modifiedTime")
+	o.WithdrawnTime = obj.getTime("security_withdrawnTime")
+}
+
+func (p *ElementParser) decodeAnyLicenseInfo(obj *object) *spdx.AnyLicenseInfo {
+	o := &spdx.AnyLicenseInfo{}
+	p.fillAnyLicenseInfoFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAnyLicenseInfoFrom(obj *object, o *spdx.AnyLicenseInfo) {
+	p.fillElementFrom(obj, &o.Element)
+}
+
+func (p *ElementParser) decodeLicenseExpression(obj *object) *spdx.LicenseExpression {
+	o := &spdx.LicenseExpression{}
+	p.fillLicenseExpressionFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLicenseExpressionFrom(obj *object, o *spdx.LicenseExpression) {
+	p.fillAnyLicenseInfoFrom(obj, &o.AnyLicenseInfo)
+	o.LicenseExpression = obj.getString("simplelicensing_licenseExpression")
+	o.LicenseListVersion = obj.getString("simplelicensing_licenseListVersion")
+	for v := range obj.getSlice("simplelicensing_customIdToUri") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntryFrom(&n, &x)
+			o.CustomIdToUri = append(o.CustomIdToUri, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeSimpleLicensingText(obj *object) *spdx.SimpleLicensingText {
+	o := &spdx.SimpleLicensingText{}
+	p.fillSimpleLicensingTextFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSimpleLicensingTextFrom(obj *object, o *spdx.SimpleLicensingText) {
+	p.fillElementFrom(obj, &o.Element)
+	o.LicenseText = obj.getString("simplelicensing_licenseText")
+}
+
+func (p *ElementParser) decodeContentIdentifier(obj *object) *spdx.ContentIdentifier {
+	o := &spdx.ContentIdentifier{}
+	p.fillContentIdentifierFrom(obj, o)
+	return o
+}
+
+func (p *ElementParser) fillContentIdentifierFrom(obj *object, o *spdx.ContentIdentifier) {
+	p.fillIntegrityMethodFrom(obj, &o.IntegrityMethod)
+	o.ContentIdentifierType = spdx.NormalizeContentIdentifierType(obj.getString("software_contentIdentifierType"))
+	o.ContentIdentifierValue = obj.getString("software_contentIdentifierValue")
+}
+
+func (p *ElementParser) decodeFile(obj *object) *spdx.File {
+	o := &spdx.File{}
+	p.fillFileFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillFileFrom(obj *object, o *spdx.File) {
+	p.fillSoftwareArtifactFrom(obj, &o.SoftwareArtifact)
+	o.ContentType = obj.getString("contentType")
+	o.FileKind = spdx.NormalizeFileKindType(obj.getString("software_fileKind"))
+}
+
+func (p *ElementParser) decodePackage(obj *object) *spdx.Package {
+	o := &spdx.Package{}
+	p.fillPackageFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillPackageFrom(obj *object, o *spdx.Package) {
+	p.fillSoftwareArtifactFrom(obj, &o.SoftwareArtifact)
+	o.DownloadLocation = obj.getString("software_downloadLocation")
+	o.HomePage = obj.getString("software_homePage")
+	o.PackageVersion = obj.getString("software_packageVersion")
+	o.PackageUrl = obj.getString("software_packageUrl")
+	o.SourceInfo = obj.getString("software_sourceInfo")
+}
+
+func (p *ElementParser) decodeSbom(obj *object) *spdx.Sbom {
+	o := &spdx.Sbom{}
+	p.fillSbomFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSbomFrom(obj *object, o *spdx.Sbom) {
+	p.fillBomFrom(obj, &o.Bom)
+	for _, s := range obj.getStringSlice("software_sbomType") {
+		o.SbomType = append(o.SbomType, spdx.NormalizeSbomType(s))
+	}
+}
+
+func (p *ElementParser) decodeSnippet(obj *object) *spdx.Snippet {
+	o := &spdx.Snippet{}
+	p.fillSnippetFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSnippetFrom(obj *object, o *spdx.Snippet) {
+	p.fillSoftwareArtifactFrom(obj, &o.SoftwareArtifact)
+	if n, ok := obj.getMap("software_byteRange"); ok {
+		o.ByteRange = &spdx.PositiveIntegerRange{}
+		p.fillPositiveIntegerRangeFrom(&n, o.ByteRange)
+	}
+	if n, ok := obj.getMap("software_lineRange"); ok {
+		o.LineRange = &spdx.PositiveIntegerRange{}
+		p.fillPositiveIntegerRangeFrom(&n, o.LineRange)
+	}
+	if n, ok := p.decodeNode(obj, obj.get("software_snippetFromFile"), spdx.NormalizeElementRef); ok {
+		p.fillFileFrom(&n, &o.SnippetFromFile)
+	}
+}
+
+func (p *ElementParser) decodeSoftwareArtifact(obj *object) *spdx.SoftwareArtifact {
+	o := &spdx.SoftwareArtifact{}
+	p.fillSoftwareArtifactFrom(obj, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSoftwareArtifactFrom(obj *object, o *spdx.SoftwareArtifact) {
+	p.fillArtifactFrom(obj, &o.Artifact)
+	o.PrimaryPurpose = spdx.NormalizeSoftwarePurpose(obj.getString("software_primaryPurpose"))
+	for _, s := range obj.getStringSlice("software_additionalPurpose") {
+		o.AdditionalPurpose = append(o.AdditionalPurpose, spdx.NormalizeSoftwarePurpose(s))
+	}
+	o.CopyrightText = obj.getString("software_copyrightText")
+	o.AttributionText = obj.getStringSlice("software_attributionText")
+	for v := range obj.getSlice("software_contentIdentifier") {
+		if n, ok := obj.object(v); ok {
+			var x spdx.ContentIdentifier
+			p.fillContentIdentifierFrom(&n, &x)
+			o.ContentIdentifier = append(o.ContentIdentifier, x)
+		}
+	}
+}
+
+func (p *ElementParser) decodeIntegrityMethodInterface(obj *object) spdx.IntegrityMethodInterface {
+	if v, ok := p.decodeType(obj.getString("type"), obj); ok {
+		if x, ok := v.(spdx.IntegrityMethodInterface); ok {
+			return x
+		}
+	}
+	return p.decodeIntegrityMethod(obj)
+}
+
+func (p *ElementParser) decodeExtensionInterface(obj *object) spdx.ExtensionInterface {
+	if v, ok := p.decodeType(obj.getString("type"), obj); ok {
+		if x, ok := v.(spdx.ExtensionInterface); ok {
+			return x
+		}
+	}
+	return p.decodeExtension(obj)
+}
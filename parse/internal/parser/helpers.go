@@ -1,7 +1,10 @@
 // Package parser provides element parsing utilities for SPDX documents.
 package parser
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Helpers provides utility methods for extracting values from JSON maps.
 type Helpers struct{}
@@ -11,10 +14,26 @@ func NewHelpers() *Helpers {
 	return &Helpers{}
 }
 
+// Get returns the raw value of a property, or nil if it is not present.
+//
+// Properties outside the Core profile are keyed by their compact,
+// profile-prefixed name (e.g. "software_packageUrl"). Some producers emit
+// them unprefixed, so when the prefixed key is missing the unprefixed name
+// is tried as well. All getters share this lookup.
+func (h *Helpers) Get(m map[string]interface{}, key string) interface{} {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	if i := strings.IndexByte(key, '_'); i > 0 {
+		return m[key[i+1:]]
+	}
+	return nil
+}
+
 // GetString extracts a string value from a map by key.
 // Returns empty string if key doesn't exist or value is not a string.
 func (h *Helpers) GetString(m map[string]interface{}, key string) string {
-	if v, ok := m[key].(string); ok {
+	if v, ok := h.Get(m, key).(string); ok {
 		return v
 	}
 	return ""
@@ -25,7 +44,7 @@ func (h *Helpers) GetString(m map[string]interface{}, key string) string {
 func (h *Helpers) GetStringSlice(m map[string]interface{}, key string) []string {
 	var result []string
 
-	switch v := m[key].(type) {
+	switch v := h.Get(m, key).(type) {
 	case []interface{}:
 		for _, item := range v {
 			if s, ok := item.(string); ok {
@@ -42,7 +61,7 @@ func (h *Helpers) GetStringSlice(m map[string]interface{}, key string) []string
 // GetInt extracts an integer value from a map by key.
 // JSON numbers are float64, so this handles the conversion.
 func (h *Helpers) GetInt(m map[string]interface{}, key string) int {
-	if v, ok := m[key].(float64); ok {
+	if v, ok := h.Get(m, key).(float64); ok {
 		return int(v)
 	}
 	return 0
@@ -50,7 +69,7 @@ func (h *Helpers) GetInt(m map[string]interface{}, key string) int {
 
 // GetFloat extracts a float64 value from a map by key.
 func (h *Helpers) GetFloat(m map[string]interface{}, key string) float64 {
-	if v, ok := m[key].(float64); ok {
+	if v, ok := h.Get(m, key).(float64); ok {
 		return v
 	}
 	return 0
@@ -58,7 +77,7 @@ func (h *Helpers) GetFloat(m map[string]interface{}, key string) float64 {
 
 // GetBool extracts a boolean value from a map by key.
 func (h *Helpers) GetBool(m map[string]interface{}, key string) bool {
-	if v, ok := m[key].(bool); ok {
+	if v, ok := h.Get(m, key).(bool); ok {
 		return v
 	}
 	return false
@@ -67,7 +86,7 @@ func (h *Helpers) GetBool(m map[string]interface{}, key string) bool {
 // GetTime parses a time string from a map by key using RFC3339 format.
 // Returns zero time if parsing fails or key doesn't exist.
 func (h *Helpers) GetTime(m map[string]interface{}, key string) time.Time {
-	if v, ok := m[key].(string); ok {
+	if v, ok := h.Get(m, key).(string); ok {
 		if t, err := time.Parse(time.RFC3339, v); err == nil {
 			return t
 		}
@@ -78,7 +97,7 @@ func (h *Helpers) GetTime(m map[string]interface{}, key string) time.Time {
 // GetMap extracts a nested map from a map by key.
 // Returns nil if key doesn't exist or value is not a map.
 func (h *Helpers) GetMap(m map[string]interface{}, key string) map[string]interface{} {
-	if v, ok := m[key].(map[string]interface{}); ok {
+	if v, ok := h.Get(m, key).(map[string]interface{}); ok {
 		return v
 	}
 	return nil
@@ -87,7 +106,7 @@ func (h *Helpers) GetMap(m map[string]interface{}, key string) map[string]interf
 // GetSlice extracts a slice of interfaces from a map by key.
 // Returns nil if key doesn't exist or value is not a slice.
 func (h *Helpers) GetSlice(m map[string]interface{}, key string) []interface{} {
-	if v, ok := m[key].([]interface{}); ok {
+	if v, ok := h.Get(m, key).([]interface{}); ok {
 		return v
 	}
 	return nil
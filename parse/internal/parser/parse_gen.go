@@ -0,0 +1,1543 @@
+// Code generated by spdx-gen. DO NOT EDIT.
+
+package parser
+
+import (
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// parseType parses elemMap as the class with the given compact JSON-LD
+// type name. Abstract classes are included so that documents using them
+// still parse into the closest available type.
+func (p *ElementParser) parseType(typ string, elemMap map[string]interface{}) (interface{}, bool) {
+	switch typ {
+	case "ai_AIPackage":
+		return p.ParseAIPackage(elemMap), true
+	case "ai_EnergyConsumption":
+		return p.ParseEnergyConsumption(elemMap), true
+	case "ai_EnergyConsumptionDescription":
+		return p.ParseEnergyConsumptionDescription(elemMap), true
+	case "build_Build":
+		return p.ParseBuild(elemMap), true
+	case "Agent":
+		return p.ParseAgent(elemMap), true
+	case "Annotation":
+		return p.ParseAnnotation(elemMap), true
+	case "Artifact":
+		return p.ParseArtifact(elemMap), true
+	case "Bom":
+		return p.ParseBom(elemMap), true
+	case "Bundle":
+		return p.ParseBundle(elemMap), true
+	case "CreationInfo":
+		return p.ParseCreationInfo(elemMap), true
+	case "DictionaryEntry":
+		return p.ParseDictionaryEntry(elemMap), true
+	case "Element":
+		return p.ParseElement(elemMap), true
+	case "ElementCollection":
+		return p.ParseElementCollection(elemMap), true
+	case "ExternalIdentifier":
+		return p.ParseExternalIdentifier(elemMap), true
+	case "ExternalMap":
+		return p.ParseExternalMap(elemMap), true
+	case "ExternalRef":
+		return p.ParseExternalRef(elemMap), true
+	case "Hash":
+		return p.ParseHash(elemMap), true
+	case "IndividualElement":
+		return p.ParseIndividualElement(elemMap), true
+	case "IntegrityMethod":
+		return p.ParseIntegrityMethod(elemMap), true
+	case "LifecycleScopedRelationship":
+		return p.ParseLifecycleScopedRelationship(elemMap), true
+	case "NamespaceMap":
+		return p.ParseNamespaceMap(elemMap), true
+	case "Organization":
+		return p.ParseOrganization(elemMap), true
+	case "PackageVerificationCode":
+		return p.ParsePackageVerificationCode(elemMap), true
+	case "Person":
+		return p.ParsePerson(elemMap), true
+	case "PositiveIntegerRange":
+		return p.ParsePositiveIntegerRange(elemMap), true
+	case "Relationship":
+		return p.ParseRelationship(elemMap), true
+	case "SoftwareAgent":
+		return p.ParseSoftwareAgent(elemMap), true
+	case "SpdxDocument":
+		return p.ParseSpdxDocument(elemMap), true
+	case "Tool":
+		return p.ParseTool(elemMap), true
+	case "dataset_DatasetPackage":
+		return p.ParseDatasetPackage(elemMap), true
+	case "expandedlicensing_ConjunctiveLicenseSet":
+		return p.ParseConjunctiveLicenseSet(elemMap), true
+	case "expandedlicensing_CustomLicense":
+		return p.ParseCustomLicense(elemMap), true
+	case "expandedlicensing_CustomLicenseAddition":
+		return p.ParseCustomLicenseAddition(elemMap), true
+	case "expandedlicensing_DisjunctiveLicenseSet":
+		return p.ParseDisjunctiveLicenseSet(elemMap), true
+	case "expandedlicensing_ExtendableLicense":
+		return p.ParseExtendableLicense(elemMap), true
+	case "expandedlicensing_IndividualLicensingInfo":
+		return p.ParseIndividualLicensingInfo(elemMap), true
+	case "expandedlicensing_License":
+		return p.ParseLicense(elemMap), true
+	case "expandedlicensing_LicenseAddition":
+		return p.ParseLicenseAddition(elemMap), true
+	case "expandedlicensing_ListedLicense":
+		return p.ParseListedLicense(elemMap), true
+	case "expandedlicensing_ListedLicenseException":
+		return p.ParseListedLicenseException(elemMap), true
+	case "expandedlicensing_OrLaterOperator":
+		return p.ParseOrLaterOperator(elemMap), true
+	case "expandedlicensing_WithAdditionOperator":
+		return p.ParseWithAdditionOperator(elemMap), true
+	case "extension_CdxPropertiesExtension":
+		return p.ParseCdxPropertiesExtension(elemMap), true
+	case "extension_CdxPropertyEntry":
+		return p.ParseCdxPropertyEntry(elemMap), true
+	case "extension_Extension":
+		return p.ParseExtension(elemMap), true
+	case "security_CvssV2VulnAssessmentRelationship":
+		return p.ParseCvssV2VulnAssessmentRelationship(elemMap), true
+	case "security_CvssV3VulnAssessmentRelationship":
+		return p.ParseCvssV3VulnAssessmentRelationship(elemMap), true
+	case "security_CvssV4VulnAssessmentRelationship":
+		return p.ParseCvssV4VulnAssessmentRelationship(elemMap), true
+	case "security_EpssVulnAssessmentRelationship":
+		return p.ParseEpssVulnAssessmentRelationship(elemMap), true
+	case "security_ExploitCatalogVulnAssessmentRelationship":
+		return p.ParseExploitCatalogVulnAssessmentRelationship(elemMap), true
+	case "security_SsvcVulnAssessmentRelationship":
+		return p.ParseSsvcVulnAssessmentRelationship(elemMap), true
+	case "security_VexAffectedVulnAssessmentRelationship":
+		return p.ParseVexAffectedVulnAssessmentRelationship(elemMap), true
+	case "security_VexFixedVulnAssessmentRelationship":
+		return p.ParseVexFixedVulnAssessmentRelationship(elemMap), true
+	case "security_VexNotAffectedVulnAssessmentRelationship":
+		return p.ParseVexNotAffectedVulnAssessmentRelationship(elemMap), true
+	case "security_VexUnderInvestigationVulnAssessmentRelationship":
+		return p.ParseVexUnderInvestigationVulnAssessmentRelationship(elemMap), true
+	case "security_VexVulnAssessmentRelationship":
+		return p.ParseVexVulnAssessmentRelationship(elemMap), true
+	case "security_VulnAssessmentRelationship":
+		return p.ParseVulnAssessmentRelationship(elemMap), true
+	case "security_Vulnerability":
+		return p.ParseVulnerability(elemMap), true
+	case "simplelicensing_AnyLicenseInfo":
+		return p.ParseAnyLicenseInfo(elemMap), true
+	case "simplelicensing_LicenseExpression":
+		return p.ParseLicenseExpression(elemMap), true
+	case "simplelicensing_SimpleLicensingText":
+		return p.ParseSimpleLicensingText(elemMap), true
+	case "software_ContentIdentifier":
+		return p.ParseContentIdentifier(elemMap), true
+	case "software_File":
+		return p.ParseFile(elemMap), true
+	case "software_Package":
+		return p.ParsePackage(elemMap), true
+	case "software_Sbom":
+		return p.ParseSbom(elemMap), true
+	case "software_Snippet":
+		return p.ParseSnippet(elemMap), true
+	case "software_SoftwareArtifact":
+		return p.ParseSoftwareArtifact(elemMap), true
+	}
+	return nil, false
+}
+
+// ParseAIPackage parses a AIPackage from a JSON map.
+func (p *ElementParser) ParseAIPackage(elemMap map[string]interface{}) *spdx.AIPackage {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.AIPackage{}
+	p.fillAIPackage(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAIPackage(elemMap map[string]interface{}, o *spdx.AIPackage) {
+	p.fillPackage(elemMap, &o.Package)
+	o.AutonomyType = spdx.NormalizePresenceType(p.H.GetString(elemMap, "ai_autonomyType"))
+	o.Domain = p.H.GetStringSlice(elemMap, "ai_domain")
+	if n := p.H.GetMap(elemMap, "ai_energyConsumption"); n != nil {
+		o.EnergyConsumption = &spdx.EnergyConsumption{}
+		p.fillEnergyConsumption(n, o.EnergyConsumption)
+	}
+	for _, v := range p.H.GetSlice(elemMap, "ai_hyperparameter") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.Hyperparameter = append(o.Hyperparameter, x)
+		}
+	}
+	o.InformationAboutApplication = p.H.GetString(elemMap, "ai_informationAboutApplication")
+	o.InformationAboutTraining = p.H.GetString(elemMap, "ai_informationAboutTraining")
+	o.Limitation = p.H.GetString(elemMap, "ai_limitation")
+	for _, v := range p.H.GetSlice(elemMap, "ai_metric") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.Metric = append(o.Metric, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "ai_metricDecisionThreshold") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.MetricDecisionThreshold = append(o.MetricDecisionThreshold, x)
+		}
+	}
+	o.ModelDataPreprocessing = p.H.GetStringSlice(elemMap, "ai_modelDataPreprocessing")
+	o.ModelExplainability = p.H.GetStringSlice(elemMap, "ai_modelExplainability")
+	o.SafetyRiskAssessment = spdx.NormalizeSafetyRiskAssessmentType(p.H.GetString(elemMap, "ai_safetyRiskAssessment"))
+	o.StandardCompliance = p.H.GetStringSlice(elemMap, "ai_standardCompliance")
+	o.TypeOfModel = p.H.GetStringSlice(elemMap, "ai_typeOfModel")
+	o.UseSensitivePersonalInformation = spdx.NormalizePresenceType(p.H.GetString(elemMap, "ai_useSensitivePersonalInformation"))
+}
+
+// ParseEnergyConsumption parses a EnergyConsumption from a JSON map.
+func (p *ElementParser) ParseEnergyConsumption(elemMap map[string]interface{}) *spdx.EnergyConsumption {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.EnergyConsumption{}
+	p.fillEnergyConsumption(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillEnergyConsumption(elemMap map[string]interface{}, o *spdx.EnergyConsumption) {
+	for _, v := range p.H.GetSlice(elemMap, "ai_finetuningEnergyConsumption") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.EnergyConsumptionDescription
+			p.fillEnergyConsumptionDescription(n, &x)
+			o.FinetuningEnergyConsumption = append(o.FinetuningEnergyConsumption, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "ai_inferenceEnergyConsumption") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.EnergyConsumptionDescription
+			p.fillEnergyConsumptionDescription(n, &x)
+			o.InferenceEnergyConsumption = append(o.InferenceEnergyConsumption, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "ai_trainingEnergyConsumption") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.EnergyConsumptionDescription
+			p.fillEnergyConsumptionDescription(n, &x)
+			o.TrainingEnergyConsumption = append(o.TrainingEnergyConsumption, x)
+		}
+	}
+}
+
+// ParseEnergyConsumptionDescription parses a EnergyConsumptionDescription from a JSON map.
+func (p *ElementParser) ParseEnergyConsumptionDescription(elemMap map[string]interface{}) *spdx.EnergyConsumptionDescription {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.EnergyConsumptionDescription{}
+	p.fillEnergyConsumptionDescription(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillEnergyConsumptionDescription(elemMap map[string]interface{}, o *spdx.EnergyConsumptionDescription) {
+	o.EnergyQuantity = p.H.GetFloat(elemMap, "ai_energyQuantity")
+	o.EnergyUnit = spdx.NormalizeEnergyUnitType(p.H.GetString(elemMap, "ai_energyUnit"))
+}
+
+// ParseBuild parses a Build from a JSON map.
+func (p *ElementParser) ParseBuild(elemMap map[string]interface{}) *spdx.Build {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Build{}
+	p.fillBuild(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillBuild(elemMap map[string]interface{}, o *spdx.Build) {
+	p.fillElement(elemMap, &o.Element)
+	o.BuildType = p.H.GetString(elemMap, "build_buildType")
+	o.BuildId = p.H.GetString(elemMap, "build_buildId")
+	o.ConfigSourceEntrypoint = p.H.GetStringSlice(elemMap, "build_configSourceEntrypoint")
+	o.ConfigSourceUri = p.H.GetStringSlice(elemMap, "build_configSourceUri")
+	for _, v := range p.H.GetSlice(elemMap, "build_configSourceDigest") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.Hash
+			p.fillHash(n, &x)
+			o.ConfigSourceDigest = append(o.ConfigSourceDigest, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "build_parameter") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.Parameter = append(o.Parameter, x)
+		}
+	}
+	o.BuildStartTime = p.H.GetTime(elemMap, "build_buildStartTime")
+	o.BuildEndTime = p.H.GetTime(elemMap, "build_buildEndTime")
+	for _, v := range p.H.GetSlice(elemMap, "build_environment") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.Environment = append(o.Environment, x)
+		}
+	}
+}
+
+// ParseAgent parses a Agent from a JSON map.
+func (p *ElementParser) ParseAgent(elemMap map[string]interface{}) *spdx.Agent {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Agent{}
+	p.fillAgent(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAgent(elemMap map[string]interface{}, o *spdx.Agent) {
+	p.fillElement(elemMap, &o.Element)
+}
+
+// ParseAnnotation parses a Annotation from a JSON map.
+func (p *ElementParser) ParseAnnotation(elemMap map[string]interface{}) *spdx.Annotation {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Annotation{}
+	p.fillAnnotation(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAnnotation(elemMap map[string]interface{}, o *spdx.Annotation) {
+	p.fillElement(elemMap, &o.Element)
+	o.AnnotationType = spdx.NormalizeAnnotationType(p.H.GetString(elemMap, "annotationType"))
+	o.ContentType = p.H.GetString(elemMap, "contentType")
+	o.Statement = p.H.GetString(elemMap, "statement")
+	if n := p.node(p.H.Get(elemMap, "subject"), spdx.NormalizeElementRef); n != nil {
+		p.fillElement(n, &o.Subject)
+	}
+}
+
+// ParseArtifact parses a Artifact from a JSON map.
+func (p *ElementParser) ParseArtifact(elemMap map[string]interface{}) *spdx.Artifact {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Artifact{}
+	p.fillArtifact(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillArtifact(elemMap map[string]interface{}, o *spdx.Artifact) {
+	p.fillElement(elemMap, &o.Element)
+	for _, v := range p.H.GetSlice(elemMap, "originatedBy") {
+		if n := p.node(v, spdx.NormalizeElementRef); n != nil {
+			var x spdx.Agent
+			p.fillAgent(n, &x)
+			o.OriginatedBy = append(o.OriginatedBy, x)
+		}
+	}
+	if n := p.node(p.H.Get(elemMap, "suppliedBy"), spdx.NormalizeElementRef); n != nil {
+		o.SuppliedBy = &spdx.Agent{}
+		p.fillAgent(n, o.SuppliedBy)
+	}
+	o.BuiltTime = p.H.GetTime(elemMap, "builtTime")
+	o.ReleaseTime = p.H.GetTime(elemMap, "releaseTime")
+	o.ValidUntilTime = p.H.GetTime(elemMap, "validUntilTime")
+	o.StandardName = p.H.GetStringSlice(elemMap, "standardName")
+	for _, s := range p.H.GetStringSlice(elemMap, "supportLevel") {
+		o.SupportLevel = append(o.SupportLevel, spdx.NormalizeSupportType(s))
+	}
+}
+
+// ParseBom parses a Bom from a JSON map.
+func (p *ElementParser) ParseBom(elemMap map[string]interface{}) *spdx.Bom {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Bom{}
+	p.fillBom(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillBom(elemMap map[string]interface{}, o *spdx.Bom) {
+	p.fillBundle(elemMap, &o.Bundle)
+}
+
+// ParseBundle parses a Bundle from a JSON map.
+func (p *ElementParser) ParseBundle(elemMap map[string]interface{}) *spdx.Bundle {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Bundle{}
+	p.fillBundle(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillBundle(elemMap map[string]interface{}, o *spdx.Bundle) {
+	p.fillElementCollection(elemMap, &o.ElementCollection)
+	o.Context = p.H.GetString(elemMap, "context")
+}
+
+// ParseCreationInfo parses a CreationInfo from a JSON map.
+func (p *ElementParser) ParseCreationInfo(elemMap map[string]interface{}) *spdx.CreationInfo {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CreationInfo{}
+	p.fillCreationInfo(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillCreationInfo(elemMap map[string]interface{}, o *spdx.CreationInfo) {
+	o.SpecVersion = p.H.GetString(elemMap, "specVersion")
+	o.Comment = p.H.GetString(elemMap, "comment")
+	o.Created = p.H.GetTime(elemMap, "created")
+	for _, v := range p.H.GetSlice(elemMap, "createdBy") {
+		if n := p.node(v, spdx.NormalizeElementRef); n != nil {
+			var x spdx.Agent
+			p.fillAgent(n, &x)
+			o.CreatedBy = append(o.CreatedBy, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "createdUsing") {
+		if n := p.node(v, spdx.NormalizeElementRef); n != nil {
+			var x spdx.Tool
+			p.fillTool(n, &x)
+			o.CreatedUsing = append(o.CreatedUsing, x)
+		}
+	}
+}
+
+// ParseDictionaryEntry parses a DictionaryEntry from a JSON map.
+func (p *ElementParser) ParseDictionaryEntry(elemMap map[string]interface{}) *spdx.DictionaryEntry {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.DictionaryEntry{}
+	p.fillDictionaryEntry(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillDictionaryEntry(elemMap map[string]interface{}, o *spdx.DictionaryEntry) {
+	o.Key = p.H.GetString(elemMap, "key")
+	o.Value = p.H.GetString(elemMap, "value")
+}
+
+// ParseElement parses a Element from a JSON map.
+func (p *ElementParser) ParseElement(elemMap map[string]interface{}) *spdx.Element {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Element{}
+	p.fillElement(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillElement(elemMap map[string]interface{}, o *spdx.Element) {
+	o.SpdxID = p.id(elemMap)
+	o.Name = p.H.GetString(elemMap, "name")
+	o.Summary = p.H.GetString(elemMap, "summary")
+	o.Description = p.H.GetString(elemMap, "description")
+	o.Comment = p.H.GetString(elemMap, "comment")
+	if n := p.H.GetMap(elemMap, "creationInfo"); n != nil {
+		p.fillCreationInfo(n, &o.CreationInfo)
+	}
+	for _, v := range p.H.GetSlice(elemMap, "verifiedUsing") {
+		if n, ok := v.(map[string]interface{}); ok {
+			o.VerifiedUsing = append(o.VerifiedUsing, p.parseIntegrityMethodInterface(n))
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "externalRef") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.ExternalRef
+			p.fillExternalRef(n, &x)
+			o.ExternalRef = append(o.ExternalRef, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "externalIdentifier") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.ExternalIdentifier
+			p.fillExternalIdentifier(n, &x)
+			o.ExternalIdentifier = append(o.ExternalIdentifier, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "extension") {
+		if n, ok := v.(map[string]interface{}); ok {
+			o.Extension = append(o.Extension, p.parseExtensionInterface(n))
+		}
+	}
+}
+
+// ParseElementCollection parses a ElementCollection from a JSON map.
+func (p *ElementParser) ParseElementCollection(elemMap map[string]interface{}) *spdx.ElementCollection {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ElementCollection{}
+	p.fillElementCollection(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillElementCollection(elemMap map[string]interface{}, o *spdx.ElementCollection) {
+	p.fillElement(elemMap, &o.Element)
+	for _, v := range p.H.GetSlice(elemMap, "element") {
+		if n := p.node(v, spdx.NormalizeElementRef); n != nil {
+			var x spdx.Element
+			p.fillElement(n, &x)
+			o.Elements = append(o.Elements, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "rootElement") {
+		if n := p.node(v, spdx.NormalizeElementRef); n != nil {
+			var x spdx.Element
+			p.fillElement(n, &x)
+			o.RootElement = append(o.RootElement, x)
+		}
+	}
+	for _, s := range p.H.GetStringSlice(elemMap, "profileConformance") {
+		o.ProfileConformance = append(o.ProfileConformance, spdx.NormalizeProfileIdentifierType(s))
+	}
+}
+
+// ParseExternalIdentifier parses a ExternalIdentifier from a JSON map.
+func (p *ElementParser) ParseExternalIdentifier(elemMap map[string]interface{}) *spdx.ExternalIdentifier {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ExternalIdentifier{}
+	p.fillExternalIdentifier(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillExternalIdentifier(elemMap map[string]interface{}, o *spdx.ExternalIdentifier) {
+	o.ExternalIdentifierType = spdx.NormalizeExternalIdentifierType(p.H.GetString(elemMap, "externalIdentifierType"))
+	o.Identifier = p.H.GetString(elemMap, "identifier")
+	o.Comment = p.H.GetString(elemMap, "comment")
+	o.IdentifierLocator = p.H.GetStringSlice(elemMap, "identifierLocator")
+	o.IssuingAuthority = p.H.GetString(elemMap, "issuingAuthority")
+}
+
+// ParseExternalMap parses a ExternalMap from a JSON map.
+func (p *ElementParser) ParseExternalMap(elemMap map[string]interface{}) *spdx.ExternalMap {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ExternalMap{}
+	p.fillExternalMap(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillExternalMap(elemMap map[string]interface{}, o *spdx.ExternalMap) {
+	o.ExternalSpdxId = p.H.GetString(elemMap, "externalSpdxId")
+	for _, v := range p.H.GetSlice(elemMap, "verifiedUsing") {
+		if n, ok := v.(map[string]interface{}); ok {
+			o.VerifiedUsing = append(o.VerifiedUsing, p.parseIntegrityMethodInterface(n))
+		}
+	}
+	o.LocationHint = p.H.GetString(elemMap, "locationHint")
+	if n := p.node(p.H.Get(elemMap, "definingArtifact"), spdx.NormalizeElementRef); n != nil {
+		o.DefiningArtifact = &spdx.Artifact{}
+		p.fillArtifact(n, o.DefiningArtifact)
+	}
+}
+
+// ParseExternalRef parses a ExternalRef from a JSON map.
+func (p *ElementParser) ParseExternalRef(elemMap map[string]interface{}) *spdx.ExternalRef {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ExternalRef{}
+	p.fillExternalRef(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillExternalRef(elemMap map[string]interface{}, o *spdx.ExternalRef) {
+	o.ExternalRefType = spdx.NormalizeExternalRefType(p.H.GetString(elemMap, "externalRefType"))
+	o.Locator = p.H.GetStringSlice(elemMap, "locator")
+	o.ContentType = p.H.GetString(elemMap, "contentType")
+	o.Comment = p.H.GetString(elemMap, "comment")
+}
+
+// ParseHash parses a Hash from a JSON map.
+func (p *ElementParser) ParseHash(elemMap map[string]interface{}) *spdx.Hash {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Hash{}
+	p.fillHash(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillHash(elemMap map[string]interface{}, o *spdx.Hash) {
+	p.fillIntegrityMethod(elemMap, &o.IntegrityMethod)
+	o.Algorithm = spdx.NormalizeHashAlgorithm(p.H.GetString(elemMap, "algorithm"))
+	o.HashValue = p.H.GetString(elemMap, "hashValue")
+}
+
+// ParseIndividualElement parses a IndividualElement from a JSON map.
+func (p *ElementParser) ParseIndividualElement(elemMap map[string]interface{}) *spdx.IndividualElement {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.IndividualElement{}
+	p.fillIndividualElement(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillIndividualElement(elemMap map[string]interface{}, o *spdx.IndividualElement) {
+	p.fillElement(elemMap, &o.Element)
+}
+
+// ParseIntegrityMethod parses a IntegrityMethod from a JSON map.
+func (p *ElementParser) ParseIntegrityMethod(elemMap map[string]interface{}) *spdx.IntegrityMethod {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.IntegrityMethod{}
+	p.fillIntegrityMethod(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillIntegrityMethod(elemMap map[string]interface{}, o *spdx.IntegrityMethod) {
+	o.Comment = p.H.GetString(elemMap, "comment")
+}
+
+// ParseLifecycleScopedRelationship parses a LifecycleScopedRelationship from a JSON map.
+func (p *ElementParser) ParseLifecycleScopedRelationship(elemMap map[string]interface{}) *spdx.LifecycleScopedRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.LifecycleScopedRelationship{}
+	p.fillLifecycleScopedRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLifecycleScopedRelationship(elemMap map[string]interface{}, o *spdx.LifecycleScopedRelationship) {
+	p.fillRelationship(elemMap, &o.Relationship)
+	o.Scope = spdx.NormalizeLifecycleScopeType(p.H.GetString(elemMap, "scope"))
+}
+
+// ParseNamespaceMap parses a NamespaceMap from a JSON map.
+func (p *ElementParser) ParseNamespaceMap(elemMap map[string]interface{}) *spdx.NamespaceMap {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.NamespaceMap{}
+	p.fillNamespaceMap(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillNamespaceMap(elemMap map[string]interface{}, o *spdx.NamespaceMap) {
+	o.Prefix = p.H.GetString(elemMap, "prefix")
+	o.Namespace = p.H.GetString(elemMap, "namespace")
+}
+
+// ParseOrganization parses a Organization from a JSON map.
+func (p *ElementParser) ParseOrganization(elemMap map[string]interface{}) *spdx.Organization {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Organization{}
+	p.fillOrganization(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillOrganization(elemMap map[string]interface{}, o *spdx.Organization) {
+	p.fillAgent(elemMap, &o.Agent)
+}
+
+// ParsePackageVerificationCode parses a PackageVerificationCode from a JSON map.
+func (p *ElementParser) ParsePackageVerificationCode(elemMap map[string]interface{}) *spdx.PackageVerificationCode {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.PackageVerificationCode{}
+	p.fillPackageVerificationCode(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillPackageVerificationCode(elemMap map[string]interface{}, o *spdx.PackageVerificationCode) {
+	p.fillIntegrityMethod(elemMap, &o.IntegrityMethod)
+	o.Algorithm = spdx.NormalizeHashAlgorithm(p.H.GetString(elemMap, "algorithm"))
+	o.HashValue = p.H.GetString(elemMap, "hashValue")
+	o.PackageVerificationCodeExcludedFile = p.H.GetStringSlice(elemMap, "packageVerificationCodeExcludedFile")
+}
+
+// ParsePerson parses a Person from a JSON map.
+func (p *ElementParser) ParsePerson(elemMap map[string]interface{}) *spdx.Person {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Person{}
+	p.fillPerson(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillPerson(elemMap map[string]interface{}, o *spdx.Person) {
+	p.fillAgent(elemMap, &o.Agent)
+}
+
+// ParsePositiveIntegerRange parses a PositiveIntegerRange from a JSON map.
+func (p *ElementParser) ParsePositiveIntegerRange(elemMap map[string]interface{}) *spdx.PositiveIntegerRange {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.PositiveIntegerRange{}
+	p.fillPositiveIntegerRange(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillPositiveIntegerRange(elemMap map[string]interface{}, o *spdx.PositiveIntegerRange) {
+	o.BeginIntegerRange = p.H.GetInt(elemMap, "beginIntegerRange")
+	o.EndIntegerRange = p.H.GetInt(elemMap, "endIntegerRange")
+}
+
+// ParseRelationship parses a Relationship from a JSON map.
+func (p *ElementParser) ParseRelationship(elemMap map[string]interface{}) *spdx.Relationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Relationship{}
+	p.fillRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillRelationship(elemMap map[string]interface{}, o *spdx.Relationship) {
+	p.fillElement(elemMap, &o.Element)
+	if n := p.node(p.H.Get(elemMap, "from"), spdx.NormalizeElementRef); n != nil {
+		p.fillElement(n, &o.From)
+	}
+	for _, v := range p.H.GetSlice(elemMap, "to") {
+		if n := p.node(v, spdx.NormalizeElementRef); n != nil {
+			var x spdx.Element
+			p.fillElement(n, &x)
+			o.To = append(o.To, x)
+		}
+	}
+	o.RelationshipType = spdx.NormalizeRelationshipType(p.H.GetString(elemMap, "relationshipType"))
+	o.Completeness = spdx.NormalizeRelationshipCompleteness(p.H.GetString(elemMap, "completeness"))
+	o.StartTime = p.H.GetTime(elemMap, "startTime")
+	o.EndTime = p.H.GetTime(elemMap, "endTime")
+}
+
+// ParseSoftwareAgent parses a SoftwareAgent from a JSON map.
+func (p *ElementParser) ParseSoftwareAgent(elemMap map[string]interface{}) *spdx.SoftwareAgent {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.SoftwareAgent{}
+	p.fillSoftwareAgent(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSoftwareAgent(elemMap map[string]interface{}, o *spdx.SoftwareAgent) {
+	p.fillAgent(elemMap, &o.Agent)
+}
+
+// ParseSpdxDocument parses a SpdxDocument from a JSON map.
+func (p *ElementParser) ParseSpdxDocument(elemMap map[string]interface{}) *spdx.SpdxDocument {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.SpdxDocument{}
+	p.fillSpdxDocument(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSpdxDocument(elemMap map[string]interface{}, o *spdx.SpdxDocument) {
+	p.fillElementCollection(elemMap, &o.ElementCollection)
+	for _, v := range p.H.GetSlice(elemMap, "import") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.ExternalMap
+			p.fillExternalMap(n, &x)
+			o.Import = append(o.Import, x)
+		}
+	}
+	for _, v := range p.H.GetSlice(elemMap, "namespaceMap") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.NamespaceMap
+			p.fillNamespaceMap(n, &x)
+			o.NamespaceMap = append(o.NamespaceMap, x)
+		}
+	}
+	if n := p.node(p.H.Get(elemMap, "dataLicense"), spdx.NormalizeLicenseRef); n != nil {
+		o.DataLicense = &spdx.AnyLicenseInfo{}
+		p.fillAnyLicenseInfo(n, o.DataLicense)
+	}
+}
+
+// ParseTool parses a Tool from a JSON map.
+func (p *ElementParser) ParseTool(elemMap map[string]interface{}) *spdx.Tool {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Tool{}
+	p.fillTool(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillTool(elemMap map[string]interface{}, o *spdx.Tool) {
+	p.fillElement(elemMap, &o.Element)
+}
+
+// ParseDatasetPackage parses a DatasetPackage from a JSON map.
+func (p *ElementParser) ParseDatasetPackage(elemMap map[string]interface{}) *spdx.DatasetPackage {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.DatasetPackage{}
+	p.fillDatasetPackage(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillDatasetPackage(elemMap map[string]interface{}, o *spdx.DatasetPackage) {
+	p.fillPackage(elemMap, &o.Package)
+	o.AnonymizationMethodUsed = p.H.GetStringSlice(elemMap, "dataset_anonymizationMethodUsed")
+	o.ConfidentialityLevel = spdx.NormalizeConfidentialityLevelType(p.H.GetString(elemMap, "dataset_confidentialityLevel"))
+	o.DataCollectionProcess = p.H.GetString(elemMap, "dataset_dataCollectionProcess")
+	o.DataPreprocessing = p.H.GetStringSlice(elemMap, "dataset_dataPreprocessing")
+	o.DatasetAvailability = spdx.NormalizeDatasetAvailabilityType(p.H.GetString(elemMap, "dataset_datasetAvailability"))
+	o.DatasetNoise = p.H.GetString(elemMap, "dataset_datasetNoise")
+	o.DatasetSize = p.H.GetInt(elemMap, "dataset_datasetSize")
+	for _, s := range p.H.GetStringSlice(elemMap, "dataset_datasetType") {
+		o.DatasetType = append(o.DatasetType, spdx.NormalizeDatasetType(s))
+	}
+	o.DatasetUpdateMechanism = p.H.GetString(elemMap, "dataset_datasetUpdateMechanism")
+	o.HasSensitivePersonalInformation = spdx.NormalizePresenceType(p.H.GetString(elemMap, "dataset_hasSensitivePersonalInformation"))
+	o.IntendedUse = p.H.GetString(elemMap, "dataset_intendedUse")
+	o.KnownBias = p.H.GetStringSlice(elemMap, "dataset_knownBias")
+	for _, v := range p.H.GetSlice(elemMap, "dataset_sensor") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.Sensor = append(o.Sensor, x)
+		}
+	}
+}
+
+// ParseConjunctiveLicenseSet parses a ConjunctiveLicenseSet from a JSON map.
+func (p *ElementParser) ParseConjunctiveLicenseSet(elemMap map[string]interface{}) *spdx.ConjunctiveLicenseSet {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ConjunctiveLicenseSet{}
+	p.fillConjunctiveLicenseSet(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillConjunctiveLicenseSet(elemMap map[string]interface{}, o *spdx.ConjunctiveLicenseSet) {
+	p.fillAnyLicenseInfo(elemMap, &o.AnyLicenseInfo)
+	for _, v := range p.H.GetSlice(elemMap, "expandedlicensing_member") {
+		if n := p.node(v, spdx.NormalizeLicenseRef); n != nil {
+			var x spdx.AnyLicenseInfo
+			p.fillAnyLicenseInfo(n, &x)
+			o.Member = append(o.Member, x)
+		}
+	}
+}
+
+// ParseCustomLicense parses a CustomLicense from a JSON map.
+func (p *ElementParser) ParseCustomLicense(elemMap map[string]interface{}) *spdx.CustomLicense {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CustomLicense{}
+	p.fillCustomLicense(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCustomLicense(elemMap map[string]interface{}, o *spdx.CustomLicense) {
+	p.fillLicense(elemMap, &o.License)
+}
+
+// ParseCustomLicenseAddition parses a CustomLicenseAddition from a JSON map.
+func (p *ElementParser) ParseCustomLicenseAddition(elemMap map[string]interface{}) *spdx.CustomLicenseAddition {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CustomLicenseAddition{}
+	p.fillCustomLicenseAddition(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCustomLicenseAddition(elemMap map[string]interface{}, o *spdx.CustomLicenseAddition) {
+	p.fillLicenseAddition(elemMap, &o.LicenseAddition)
+}
+
+// ParseDisjunctiveLicenseSet parses a DisjunctiveLicenseSet from a JSON map.
+func (p *ElementParser) ParseDisjunctiveLicenseSet(elemMap map[string]interface{}) *spdx.DisjunctiveLicenseSet {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.DisjunctiveLicenseSet{}
+	p.fillDisjunctiveLicenseSet(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillDisjunctiveLicenseSet(elemMap map[string]interface{}, o *spdx.DisjunctiveLicenseSet) {
+	p.fillAnyLicenseInfo(elemMap, &o.AnyLicenseInfo)
+	for _, v := range p.H.GetSlice(elemMap, "expandedlicensing_member") {
+		if n := p.node(v, spdx.NormalizeLicenseRef); n != nil {
+			var x spdx.AnyLicenseInfo
+			p.fillAnyLicenseInfo(n, &x)
+			o.Member = append(o.Member, x)
+		}
+	}
+}
+
+// ParseExtendableLicense parses a ExtendableLicense from a JSON map.
+func (p *ElementParser) ParseExtendableLicense(elemMap map[string]interface{}) *spdx.ExtendableLicense {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ExtendableLicense{}
+	p.fillExtendableLicense(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillExtendableLicense(elemMap map[string]interface{}, o *spdx.ExtendableLicense) {
+	p.fillAnyLicenseInfo(elemMap, &o.AnyLicenseInfo)
+}
+
+// ParseIndividualLicensingInfo parses a IndividualLicensingInfo from a JSON map.
+func (p *ElementParser) ParseIndividualLicensingInfo(elemMap map[string]interface{}) *spdx.IndividualLicensingInfo {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.IndividualLicensingInfo{}
+	p.fillIndividualLicensingInfo(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillIndividualLicensingInfo(elemMap map[string]interface{}, o *spdx.IndividualLicensingInfo) {
+	p.fillAnyLicenseInfo(elemMap, &o.AnyLicenseInfo)
+}
+
+// ParseLicense parses a License from a JSON map.
+func (p *ElementParser) ParseLicense(elemMap map[string]interface{}) *spdx.License {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.License{}
+	p.fillLicense(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLicense(elemMap map[string]interface{}, o *spdx.License) {
+	p.fillExtendableLicense(elemMap, &o.ExtendableLicense)
+	o.LicenseText = p.H.GetString(elemMap, "simplelicensing_licenseText")
+	o.IsDeprecatedLicenseId = p.H.GetBool(elemMap, "expandedlicensing_isDeprecatedLicenseId")
+	o.IsFsfLibre = p.H.GetBool(elemMap, "expandedlicensing_isFsfLibre")
+	o.IsOsiApproved = p.H.GetBool(elemMap, "expandedlicensing_isOsiApproved")
+	o.LicenseXml = p.H.GetString(elemMap, "expandedlicensing_licenseXml")
+	o.ObsoletedBy = p.H.GetString(elemMap, "expandedlicensing_obsoletedBy")
+	o.SeeAlso = p.H.GetStringSlice(elemMap, "expandedlicensing_seeAlso")
+	o.StandardLicenseHeader = p.H.GetString(elemMap, "expandedlicensing_standardLicenseHeader")
+	o.StandardLicenseTemplate = p.H.GetString(elemMap, "expandedlicensing_standardLicenseTemplate")
+}
+
+// ParseLicenseAddition parses a LicenseAddition from a JSON map.
+func (p *ElementParser) ParseLicenseAddition(elemMap map[string]interface{}) *spdx.LicenseAddition {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.LicenseAddition{}
+	p.fillLicenseAddition(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLicenseAddition(elemMap map[string]interface{}, o *spdx.LicenseAddition) {
+	p.fillElement(elemMap, &o.Element)
+	o.AdditionText = p.H.GetString(elemMap, "expandedlicensing_additionText")
+	o.IsDeprecatedAdditionId = p.H.GetBool(elemMap, "expandedlicensing_isDeprecatedAdditionId")
+	o.LicenseXml = p.H.GetString(elemMap, "expandedlicensing_licenseXml")
+	o.ObsoletedBy = p.H.GetString(elemMap, "expandedlicensing_obsoletedBy")
+	o.SeeAlso = p.H.GetStringSlice(elemMap, "expandedlicensing_seeAlso")
+	o.StandardAdditionTemplate = p.H.GetString(elemMap, "expandedlicensing_standardAdditionTemplate")
+}
+
+// ParseListedLicense parses a ListedLicense from a JSON map.
+func (p *ElementParser) ParseListedLicense(elemMap map[string]interface{}) *spdx.ListedLicense {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ListedLicense{}
+	p.fillListedLicense(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillListedLicense(elemMap map[string]interface{}, o *spdx.ListedLicense) {
+	p.fillLicense(elemMap, &o.License)
+	o.DeprecatedVersion = p.H.GetString(elemMap, "expandedlicensing_deprecatedVersion")
+	o.ListVersionAdded = p.H.GetString(elemMap, "expandedlicensing_listVersionAdded")
+}
+
+// ParseListedLicenseException parses a ListedLicenseException from a JSON map.
+func (p *ElementParser) ParseListedLicenseException(elemMap map[string]interface{}) *spdx.ListedLicenseException {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ListedLicenseException{}
+	p.fillListedLicenseException(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillListedLicenseException(elemMap map[string]interface{}, o *spdx.ListedLicenseException) {
+	p.fillLicenseAddition(elemMap, &o.LicenseAddition)
+	o.DeprecatedVersion = p.H.GetString(elemMap, "expandedlicensing_deprecatedVersion")
+	o.ListVersionAdded = p.H.GetString(elemMap, "expandedlicensing_listVersionAdded")
+}
+
+// ParseOrLaterOperator parses a OrLaterOperator from a JSON map.
+func (p *ElementParser) ParseOrLaterOperator(elemMap map[string]interface{}) *spdx.OrLaterOperator {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.OrLaterOperator{}
+	p.fillOrLaterOperator(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillOrLaterOperator(elemMap map[string]interface{}, o *spdx.OrLaterOperator) {
+	p.fillExtendableLicense(elemMap, &o.ExtendableLicense)
+	if n := p.node(p.H.Get(elemMap, "expandedlicensing_subjectLicense"), spdx.NormalizeLicenseRef); n != nil {
+		p.fillLicense(n, &o.SubjectLicense)
+	}
+}
+
+// ParseWithAdditionOperator parses a WithAdditionOperator from a JSON map.
+func (p *ElementParser) ParseWithAdditionOperator(elemMap map[string]interface{}) *spdx.WithAdditionOperator {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.WithAdditionOperator{}
+	p.fillWithAdditionOperator(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillWithAdditionOperator(elemMap map[string]interface{}, o *spdx.WithAdditionOperator) {
+	p.fillAnyLicenseInfo(elemMap, &o.AnyLicenseInfo)
+	if n := p.node(p.H.Get(elemMap, "expandedlicensing_subjectAddition"), spdx.NormalizeElementRef); n != nil {
+		p.fillLicenseAddition(n, &o.SubjectAddition)
+	}
+	if n := p.node(p.H.Get(elemMap, "expandedlicensing_subjectExtendableLicense"), spdx.NormalizeLicenseRef); n != nil {
+		p.fillExtendableLicense(n, &o.SubjectExtendableLicense)
+	}
+}
+
+// ParseCdxPropertiesExtension parses a CdxPropertiesExtension from a JSON map.
+func (p *ElementParser) ParseCdxPropertiesExtension(elemMap map[string]interface{}) *spdx.CdxPropertiesExtension {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CdxPropertiesExtension{}
+	p.fillCdxPropertiesExtension(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillCdxPropertiesExtension(elemMap map[string]interface{}, o *spdx.CdxPropertiesExtension) {
+	p.fillExtension(elemMap, &o.Extension)
+	for _, v := range p.H.GetSlice(elemMap, "extension_cdxProperty") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.CdxPropertyEntry
+			p.fillCdxPropertyEntry(n, &x)
+			o.CdxProperty = append(o.CdxProperty, x)
+		}
+	}
+}
+
+// ParseCdxPropertyEntry parses a CdxPropertyEntry from a JSON map.
+func (p *ElementParser) ParseCdxPropertyEntry(elemMap map[string]interface{}) *spdx.CdxPropertyEntry {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CdxPropertyEntry{}
+	p.fillCdxPropertyEntry(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillCdxPropertyEntry(elemMap map[string]interface{}, o *spdx.CdxPropertyEntry) {
+	o.CdxPropName = p.H.GetString(elemMap, "extension_cdxPropName")
+	o.CdxPropValue = p.H.GetString(elemMap, "extension_cdxPropValue")
+}
+
+// ParseExtension parses a Extension from a JSON map.
+func (p *ElementParser) ParseExtension(elemMap map[string]interface{}) *spdx.Extension {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Extension{}
+	p.fillExtension(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillExtension(elemMap map[string]interface{}, o *spdx.Extension) {
+}
+
+// ParseCvssV2VulnAssessmentRelationship parses a CvssV2VulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseCvssV2VulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.CvssV2VulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CvssV2VulnAssessmentRelationship{}
+	p.fillCvssV2VulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCvssV2VulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.CvssV2VulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.Score = p.H.GetFloat(elemMap, "security_score")
+	o.VectorString = p.H.GetString(elemMap, "security_vectorString")
+}
+
+// ParseCvssV3VulnAssessmentRelationship parses a CvssV3VulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseCvssV3VulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.CvssV3VulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CvssV3VulnAssessmentRelationship{}
+	p.fillCvssV3VulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCvssV3VulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.CvssV3VulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.Score = p.H.GetFloat(elemMap, "security_score")
+	o.Severity = spdx.NormalizeCvssSeverityType(p.H.GetString(elemMap, "security_severity"))
+	o.VectorString = p.H.GetString(elemMap, "security_vectorString")
+}
+
+// ParseCvssV4VulnAssessmentRelationship parses a CvssV4VulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseCvssV4VulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.CvssV4VulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.CvssV4VulnAssessmentRelationship{}
+	p.fillCvssV4VulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillCvssV4VulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.CvssV4VulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.Score = p.H.GetFloat(elemMap, "security_score")
+	o.Severity = spdx.NormalizeCvssSeverityType(p.H.GetString(elemMap, "security_severity"))
+	o.VectorString = p.H.GetString(elemMap, "security_vectorString")
+}
+
+// ParseEpssVulnAssessmentRelationship parses a EpssVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseEpssVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.EpssVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.EpssVulnAssessmentRelationship{}
+	p.fillEpssVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillEpssVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.EpssVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.Probability = p.H.GetFloat(elemMap, "security_probability")
+	o.Percentile = p.H.GetFloat(elemMap, "security_percentile")
+}
+
+// ParseExploitCatalogVulnAssessmentRelationship parses a ExploitCatalogVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseExploitCatalogVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.ExploitCatalogVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ExploitCatalogVulnAssessmentRelationship{}
+	p.fillExploitCatalogVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillExploitCatalogVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.ExploitCatalogVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.CatalogType = spdx.NormalizeExploitCatalogType(p.H.GetString(elemMap, "security_catalogType"))
+	o.Exploited = p.H.GetBool(elemMap, "security_exploited")
+	o.Locator = p.H.GetString(elemMap, "security_locator")
+}
+
+// ParseSsvcVulnAssessmentRelationship parses a SsvcVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseSsvcVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.SsvcVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.SsvcVulnAssessmentRelationship{}
+	p.fillSsvcVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSsvcVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.SsvcVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.DecisionType = spdx.NormalizeSsvcDecisionType(p.H.GetString(elemMap, "security_decisionType"))
+}
+
+// ParseVexAffectedVulnAssessmentRelationship parses a VexAffectedVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseVexAffectedVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.VexAffectedVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.VexAffectedVulnAssessmentRelationship{}
+	p.fillVexAffectedVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexAffectedVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.VexAffectedVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationship(elemMap, &o.VexVulnAssessmentRelationship)
+	o.ActionStatement = p.H.GetString(elemMap, "security_actionStatement")
+	o.ActionStatementTime = p.H.GetTime(elemMap, "security_actionStatementTime")
+}
+
+// ParseVexFixedVulnAssessmentRelationship parses a VexFixedVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseVexFixedVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.VexFixedVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.VexFixedVulnAssessmentRelationship{}
+	p.fillVexFixedVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexFixedVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.VexFixedVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationship(elemMap, &o.VexVulnAssessmentRelationship)
+}
+
+// ParseVexNotAffectedVulnAssessmentRelationship parses a VexNotAffectedVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseVexNotAffectedVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.VexNotAffectedVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.VexNotAffectedVulnAssessmentRelationship{}
+	p.fillVexNotAffectedVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexNotAffectedVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.VexNotAffectedVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationship(elemMap, &o.VexVulnAssessmentRelationship)
+	o.JustificationType = spdx.NormalizeVexJustificationType(p.H.GetString(elemMap, "security_justificationType"))
+	o.ImpactStatement = p.H.GetString(elemMap, "security_impactStatement")
+	o.ImpactStatementTime = p.H.GetTime(elemMap, "security_impactStatementTime")
+}
+
+// ParseVexUnderInvestigationVulnAssessmentRelationship parses a VexUnderInvestigationVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseVexUnderInvestigationVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.VexUnderInvestigationVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.VexUnderInvestigationVulnAssessmentRelationship{}
+	p.fillVexUnderInvestigationVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexUnderInvestigationVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.VexUnderInvestigationVulnAssessmentRelationship) {
+	p.fillVexVulnAssessmentRelationship(elemMap, &o.VexVulnAssessmentRelationship)
+}
+
+// ParseVexVulnAssessmentRelationship parses a VexVulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseVexVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.VexVulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.VexVulnAssessmentRelationship{}
+	p.fillVexVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVexVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.VexVulnAssessmentRelationship) {
+	p.fillVulnAssessmentRelationship(elemMap, &o.VulnAssessmentRelationship)
+	o.VexVersion = p.H.GetString(elemMap, "security_vexVersion")
+	o.StatusNotes = p.H.GetString(elemMap, "security_statusNotes")
+}
+
+// ParseVulnAssessmentRelationship parses a VulnAssessmentRelationship from a JSON map.
+func (p *ElementParser) ParseVulnAssessmentRelationship(elemMap map[string]interface{}) *spdx.VulnAssessmentRelationship {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.VulnAssessmentRelationship{}
+	p.fillVulnAssessmentRelationship(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVulnAssessmentRelationship(elemMap map[string]interface{}, o *spdx.VulnAssessmentRelationship) {
+	p.fillRelationship(elemMap, &o.Relationship)
+	if n := p.node(p.H.Get(elemMap, "security_assessedElement"), spdx.NormalizeElementRef); n != nil {
+		o.AssessedElement = &spdx.SoftwareArtifact{}
+		p.fillSoftwareArtifact(n, o.AssessedElement)
+	}
+	o.PublishedTime = p.H.GetTime(elemMap, "security_publishedTime")
+	if n := p.node(p.H.Get(elemMap, "suppliedBy"), spdx.NormalizeElementRef); n != nil {
+		o.SuppliedBy = &spdx.Agent{}
+		p.fillAgent(n, o.SuppliedBy)
+	}
+	o.ModifiedTime = p.H.GetTime(elemMap, "security_modifiedTime")
+	o.WithdrawnTime = p.H.GetTime(elemMap, "security_withdrawnTime")
+}
+
+// ParseVulnerability parses a Vulnerability from a JSON map.
+func (p *ElementParser) ParseVulnerability(elemMap map[string]interface{}) *spdx.Vulnerability {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Vulnerability{}
+	p.fillVulnerability(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillVulnerability(elemMap map[string]interface{}, o *spdx.Vulnerability) {
+	p.fillArtifact(elemMap, &o.Artifact)
+	o.PublishedTime = p.H.GetTime(elemMap, "security_publishedTime")
+	o.ModifiedTime = p.H.GetTime(elemMap, "security_modifiedTime")
+	o.WithdrawnTime = p.H.GetTime(elemMap, "security_withdrawnTime")
+}
+
+// ParseAnyLicenseInfo parses a AnyLicenseInfo from a JSON map.
+func (p *ElementParser) ParseAnyLicenseInfo(elemMap map[string]interface{}) *spdx.AnyLicenseInfo {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.AnyLicenseInfo{}
+	p.fillAnyLicenseInfo(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillAnyLicenseInfo(elemMap map[string]interface{}, o *spdx.AnyLicenseInfo) {
+	p.fillElement(elemMap, &o.Element)
+}
+
+// ParseLicenseExpression parses a LicenseExpression from a JSON map.
+func (p *ElementParser) ParseLicenseExpression(elemMap map[string]interface{}) *spdx.LicenseExpression {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.LicenseExpression{}
+	p.fillLicenseExpression(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillLicenseExpression(elemMap map[string]interface{}, o *spdx.LicenseExpression) {
+	p.fillAnyLicenseInfo(elemMap, &o.AnyLicenseInfo)
+	o.LicenseExpression = p.H.GetString(elemMap, "simplelicensing_licenseExpression")
+	o.LicenseListVersion = p.H.GetString(elemMap, "simplelicensing_licenseListVersion")
+	for _, v := range p.H.GetSlice(elemMap, "simplelicensing_customIdToUri") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.DictionaryEntry
+			p.fillDictionaryEntry(n, &x)
+			o.CustomIdToUri = append(o.CustomIdToUri, x)
+		}
+	}
+}
+
+// ParseSimpleLicensingText parses a SimpleLicensingText from a JSON map.
+func (p *ElementParser) ParseSimpleLicensingText(elemMap map[string]interface{}) *spdx.SimpleLicensingText {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.SimpleLicensingText{}
+	p.fillSimpleLicensingText(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSimpleLicensingText(elemMap map[string]interface{}, o *spdx.SimpleLicensingText) {
+	p.fillElement(elemMap, &o.Element)
+	o.LicenseText = p.H.GetString(elemMap, "simplelicensing_licenseText")
+}
+
+// ParseContentIdentifier parses a ContentIdentifier from a JSON map.
+func (p *ElementParser) ParseContentIdentifier(elemMap map[string]interface{}) *spdx.ContentIdentifier {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.ContentIdentifier{}
+	p.fillContentIdentifier(elemMap, o)
+	return o
+}
+
+func (p *ElementParser) fillContentIdentifier(elemMap map[string]interface{}, o *spdx.ContentIdentifier) {
+	p.fillIntegrityMethod(elemMap, &o.IntegrityMethod)
+	o.ContentIdentifierType = spdx.NormalizeContentIdentifierType(p.H.GetString(elemMap, "software_contentIdentifierType"))
+	o.ContentIdentifierValue = p.H.GetString(elemMap, "software_contentIdentifierValue")
+}
+
+// ParseFile parses a File from a JSON map.
+func (p *ElementParser) ParseFile(elemMap map[string]interface{}) *spdx.File {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.File{}
+	p.fillFile(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillFile(elemMap map[string]interface{}, o *spdx.File) {
+	p.fillSoftwareArtifact(elemMap, &o.SoftwareArtifact)
+	o.ContentType = p.H.GetString(elemMap, "contentType")
+	o.FileKind = spdx.NormalizeFileKindType(p.H.GetString(elemMap, "software_fileKind"))
+}
+
+// ParsePackage parses a Package from a JSON map.
+func (p *ElementParser) ParsePackage(elemMap map[string]interface{}) *spdx.Package {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Package{}
+	p.fillPackage(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillPackage(elemMap map[string]interface{}, o *spdx.Package) {
+	p.fillSoftwareArtifact(elemMap, &o.SoftwareArtifact)
+	o.DownloadLocation = p.H.GetString(elemMap, "software_downloadLocation")
+	o.HomePage = p.H.GetString(elemMap, "software_homePage")
+	o.PackageVersion = p.H.GetString(elemMap, "software_packageVersion")
+	o.PackageUrl = p.H.GetString(elemMap, "software_packageUrl")
+	o.SourceInfo = p.H.GetString(elemMap, "software_sourceInfo")
+}
+
+// ParseSbom parses a Sbom from a JSON map.
+func (p *ElementParser) ParseSbom(elemMap map[string]interface{}) *spdx.Sbom {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Sbom{}
+	p.fillSbom(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSbom(elemMap map[string]interface{}, o *spdx.Sbom) {
+	p.fillBom(elemMap, &o.Bom)
+	for _, s := range p.H.GetStringSlice(elemMap, "software_sbomType") {
+		o.SbomType = append(o.SbomType, spdx.NormalizeSbomType(s))
+	}
+}
+
+// ParseSnippet parses a Snippet from a JSON map.
+func (p *ElementParser) ParseSnippet(elemMap map[string]interface{}) *spdx.Snippet {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.Snippet{}
+	p.fillSnippet(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSnippet(elemMap map[string]interface{}, o *spdx.Snippet) {
+	p.fillSoftwareArtifact(elemMap, &o.SoftwareArtifact)
+	if n := p.H.GetMap(elemMap, "software_byteRange"); n != nil {
+		o.ByteRange = &spdx.PositiveIntegerRange{}
+		p.fillPositiveIntegerRange(n, o.ByteRange)
+	}
+	if n := p.H.GetMap(elemMap, "software_lineRange"); n != nil {
+		o.LineRange = &spdx.PositiveIntegerRange{}
+		p.fillPositiveIntegerRange(n, o.LineRange)
+	}
+	if n := p.node(p.H.Get(elemMap, "software_snippetFromFile"), spdx.NormalizeElementRef); n != nil {
+		p.fillFile(n, &o.SnippetFromFile)
+	}
+}
+
+// ParseSoftwareArtifact parses a SoftwareArtifact from a JSON map.
+func (p *ElementParser) ParseSoftwareArtifact(elemMap map[string]interface{}) *spdx.SoftwareArtifact {
+	if elemMap == nil {
+		return nil
+	}
+	o := &spdx.SoftwareArtifact{}
+	p.fillSoftwareArtifact(elemMap, o)
+	p.normalize(o)
+	return o
+}
+
+func (p *ElementParser) fillSoftwareArtifact(elemMap map[string]interface{}, o *spdx.SoftwareArtifact) {
+	p.fillArtifact(elemMap, &o.Artifact)
+	o.PrimaryPurpose = spdx.NormalizeSoftwarePurpose(p.H.GetString(elemMap, "software_primaryPurpose"))
+	for _, s := range p.H.GetStringSlice(elemMap, "software_additionalPurpose") {
+		o.AdditionalPurpose = append(o.AdditionalPurpose, spdx.NormalizeSoftwarePurpose(s))
+	}
+	o.CopyrightText = p.H.GetString(elemMap, "software_copyrightText")
+	o.AttributionText = p.H.GetStringSlice(elemMap, "software_attributionText")
+	for _, v := range p.H.GetSlice(elemMap, "software_contentIdentifier") {
+		if n, ok := v.(map[string]interface{}); ok {
+			var x spdx.ContentIdentifier
+			p.fillContentIdentifier(n, &x)
+			o.ContentIdentifier = append(o.ContentIdentifier, x)
+		}
+	}
+}
+
+// parseIntegrityMethodInterface parses elemMap as the class derived from IntegrityMethod that
+// its type names, or as IntegrityMethod itself.
+func (p *ElementParser) parseIntegrityMethodInterface(elemMap map[string]interface{}) spdx.IntegrityMethodInterface {
+	if v, ok := p.parseType(p.H.GetString(elemMap, "type"), elemMap); ok {
+		if x, ok := v.(spdx.IntegrityMethodInterface); ok {
+			return x
+		}
+	}
+	return p.ParseIntegrityMethod(elemMap)
+}
+
+// parseExtensionInterface parses elemMap as the class derived from Extension that
+// its type names, or as Extension itself.
+func (p *ElementParser) parseExtensionInterface(elemMap map[string]interface{}) spdx.ExtensionInterface {
+	if v, ok := p.parseType(p.H.GetString(elemMap, "type"), elemMap); ok {
+		if x, ok := v.(spdx.ExtensionInterface); ok {
+			return x
+		}
+	}
+	return p.ParseExtension(elemMap)
+}
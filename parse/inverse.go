@@ -0,0 +1,66 @@
+package parse
+
+import (
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// InverseRelationship is a relationship seen from one of the elements it
+// relates to: the element relates back to the From element of the
+// relationship by the inverse of its type.
+type InverseRelationship struct {
+	// Type is the name of the inverse of the relationship type, such as
+	// "containedBy" for contains, as spdx.RelationshipType.InverseName
+	// returns it.
+	Type string
+
+	// To is the SPDX ID of the From element of the relationship.
+	To string
+
+	Relationship *spdx.Relationship
+}
+
+// GetInverseRelationships returns the relationships to an element as seen
+// from it, in the order of GetRelationshipsTo.
+//
+//	for _, inv := range doc.GetInverseRelationships(file.SpdxID) {
+//	    fmt.Println(file.Name, inv.Type, inv.To) // main.go containedBy urn:spdx:app
+//	}
+func (d *Document) GetInverseRelationships(spdxID string) []InverseRelationship {
+	var result []InverseRelationship
+	for _, rel := range d.GetRelationshipsTo(spdxID) {
+		result = append(result, InverseRelationship{
+			Type:         rel.RelationshipType.InverseName(),
+			To:           rel.From.GetSpdxID(),
+			Relationship: rel,
+		})
+	}
+	return result
+}
+
+// GetDependentsOf returns the packages that depend on the given element:
+// the From packages of its dependency relationships, as IsDependency
+// identifies them, which is the dependencyOf view of GetDependenciesFor.
+func (d *Document) GetDependentsOf(spdxID string) []*spdx.Package {
+	var result []*spdx.Package
+	for _, rel := range d.GetRelationshipsTo(spdxID) {
+		if rel.IsDependency() {
+			if pkg := d.GetPackageByID(rel.From.GetSpdxID()); pkg != nil {
+				result = append(result, pkg)
+			}
+		}
+	}
+	return result
+}
+
+// GetContainersOf returns the elements that contain the given element, such
+// as the package of a file: the From elements of the contains relationships
+// to it.
+func (d *Document) GetContainersOf(spdxID string) []spdx.ElementInterface {
+	var refs []spdx.Element
+	for _, rel := range d.GetRelationshipsTo(spdxID) {
+		if rel.IsContainment() {
+			refs = append(refs, rel.From)
+		}
+	}
+	return d.resolveElements(refs)
+}
@@ -0,0 +1,63 @@
+package parse_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_GetInverseRelationships(t *testing.T) {
+	data := watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:cli", "name": "cli"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "name": "main.go"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-1", "from": "urn:spdx:app", "to": ["urn:spdx:lib", "urn:spdx:main"], "relationshipType": "contains"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-2", "from": "urn:spdx:app", "to": ["urn:spdx:lib"], "relationshipType": "dependsOn"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel-3", "from": "urn:spdx:cli", "to": ["urn:spdx:lib"], "relationshipType": "hasOptionalDependency"}`,
+	)
+
+	// Without the relationship indexes the relationships are scanned.
+	for mode, opts := range map[string][]parse.Option{
+		"maps":      nil,
+		"streaming": {parse.WithStreaming()},
+		"unindexed": {parse.WithIndexes(parse.IndexSoftware)},
+	} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(opts...).Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, inv := range doc.GetInverseRelationships("urn:spdx:lib") {
+				got = append(got, inv.Type+" "+inv.To+" "+inv.Relationship.SpdxID)
+			}
+			want := []string{
+				"containedBy urn:spdx:app urn:spdx:rel-1",
+				"dependencyOf urn:spdx:app urn:spdx:rel-2",
+				"optionalDependencyOf urn:spdx:cli urn:spdx:rel-3",
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("GetInverseRelationships() = %q, want %q", got, want)
+			}
+
+			var dependents []string
+			for _, pkg := range doc.GetDependentsOf("urn:spdx:lib") {
+				dependents = append(dependents, pkg.SpdxID)
+			}
+			if !slices.Equal(dependents, []string{"urn:spdx:app", "urn:spdx:cli"}) {
+				t.Errorf("GetDependentsOf() = %v", dependents)
+			}
+
+			containers := doc.GetContainersOf("urn:spdx:main")
+			if len(containers) != 1 || containers[0].GetSpdxID() != "urn:spdx:app" {
+				t.Errorf("GetContainersOf() = %v, want urn:spdx:app", containers)
+			}
+			if got := doc.GetContainersOf("urn:spdx:app"); len(got) != 0 {
+				t.Errorf("GetContainersOf() of the root = %v", got)
+			}
+		})
+	}
+}
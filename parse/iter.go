@@ -0,0 +1,123 @@
+package parse
+
+import (
+	"iter"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// AllElements returns an iterator over every typed element in the document,
+// regardless of its category, including elements of registered extension
+// types. Non-element helper classes such as CreationInfo, Hash and
+// DictionaryEntry are not included.
+//
+//	for elem := range doc.AllElements() {
+//	    fmt.Println(elem.GetSpdxID(), elem.GetName())
+//	}
+func (d *Document) AllElements() iter.Seq[spdx.ElementInterface] {
+	return func(yield func(spdx.ElementInterface) bool) {
+		if d.SpdxDocument != nil && !yield(d.SpdxDocument) {
+			return
+		}
+
+		// Core
+		_ = eachElement(d.Relationships, yield) &&
+			eachElement(d.LifecycleScopedRelationships, yield) &&
+			eachElement(d.Annotations, yield) &&
+			eachElement(d.Agents, yield) &&
+			eachElement(d.Organizations, yield) &&
+			eachElement(d.Persons, yield) &&
+			eachElement(d.SoftwareAgents, yield) &&
+			eachElement(d.Tools, yield) &&
+			eachElement(d.Bundles, yield) &&
+			eachElement(d.Boms, yield) &&
+			// Software
+			eachElement(d.Packages, yield) &&
+			eachElement(d.Files, yield) &&
+			eachElement(d.Snippets, yield) &&
+			// Licensing
+			eachElement(d.AnyLicenseInfos, yield) &&
+			eachElement(d.ConjunctiveLicenseSets, yield) &&
+			eachElement(d.CustomLicenses, yield) &&
+			eachElement(d.CustomLicenseAdditions, yield) &&
+			eachElement(d.DisjunctiveLicenseSets, yield) &&
+			eachElement(d.IndividualLicensingInfos, yield) &&
+			eachElement(d.ListedLicenses, yield) &&
+			eachElement(d.ListedLicenseExceptions, yield) &&
+			eachElement(d.LicenseExpressions, yield) &&
+			eachElement(d.OrLaterOperators, yield) &&
+			eachElement(d.SimpleLicensingTexts, yield) &&
+			eachElement(d.WithAdditionOperators, yield) &&
+			// Security
+			eachElement(d.Vulnerabilities, yield) &&
+			eachElement(d.CvssV2VulnAssessments, yield) &&
+			eachElement(d.CvssV3VulnAssessments, yield) &&
+			eachElement(d.CvssV4VulnAssessments, yield) &&
+			eachElement(d.EpssVulnAssessments, yield) &&
+			eachElement(d.SsvcVulnAssessments, yield) &&
+			eachElement(d.ExploitCatalogVulnAssessments, yield) &&
+			eachElement(d.VexAffectedVulnAssessments, yield) &&
+			eachElement(d.VexFixedVulnAssessments, yield) &&
+			eachElement(d.VexNotAffectedVulnAssessments, yield) &&
+			eachElement(d.VexUnderInvestigationVulnAssessments, yield) &&
+			// AI, Dataset and Build
+			eachElement(d.AiPackages, yield) &&
+			eachElement(d.DatasetPackages, yield) &&
+			eachElement(d.Builds, yield) &&
+			// Registered extensions
+			eachElement(d.Extensions, yield)
+	}
+}
+
+// AllArtifacts returns an iterator over all artifact elements in the document:
+// packages, files, snippets, vulnerabilities, AI packages and datasets.
+func (d *Document) AllArtifacts() iter.Seq[spdx.ArtifactInterface] {
+	return func(yield func(spdx.ArtifactInterface) bool) {
+		_ = eachArtifact(d.Packages, yield) &&
+			eachArtifact(d.Files, yield) &&
+			eachArtifact(d.Snippets, yield) &&
+			eachArtifact(d.Vulnerabilities, yield) &&
+			eachArtifact(d.AiPackages, yield) &&
+			eachArtifact(d.DatasetPackages, yield)
+	}
+}
+
+// AllSoftwareArtifacts returns an iterator over all software artifacts in the
+// document: packages, files, snippets, AI packages and datasets.
+func (d *Document) AllSoftwareArtifacts() iter.Seq[spdx.SoftwareArtifactInterface] {
+	return func(yield func(spdx.SoftwareArtifactInterface) bool) {
+		_ = eachSoftwareArtifact(d.Packages, yield) &&
+			eachSoftwareArtifact(d.Files, yield) &&
+			eachSoftwareArtifact(d.Snippets, yield) &&
+			eachSoftwareArtifact(d.AiPackages, yield) &&
+			eachSoftwareArtifact(d.DatasetPackages, yield)
+	}
+}
+
+// eachElement yields every item and reports whether iteration should continue.
+func eachElement[T spdx.ElementInterface](items []T, yield func(spdx.ElementInterface) bool) bool {
+	for _, item := range items {
+		if !yield(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func eachArtifact[T spdx.ArtifactInterface](items []T, yield func(spdx.ArtifactInterface) bool) bool {
+	for _, item := range items {
+		if !yield(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func eachSoftwareArtifact[T spdx.SoftwareArtifactInterface](items []T, yield func(spdx.SoftwareArtifactInterface) bool) bool {
+	for _, item := range items {
+		if !yield(item) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,25 @@
+//go:build !nojsonld
+
+package parse
+
+import (
+	"log/slog"
+
+	"github.com/interlynk-io/spdx-zen/parse/internal/jsonld"
+)
+
+// WithDocumentLoader sets a custom JSON-LD document loader.
+// This is useful for testing or for providing custom context resolution.
+// It is not available when built with the nojsonld tag.
+func WithDocumentLoader(loader jsonld.DocumentLoader) Option {
+	return optionFunc(func(r *Reader) {
+		r.processor = jsonld.NewProcessor(loader)
+	})
+}
+
+// newProcessor returns the JSON-LD processor of a reader without a
+// document loader of its own: the SPDX context is served from the model
+// and other contexts are fetched.
+func newProcessor(logger *slog.Logger) processor {
+	return jsonld.NewProcessor(jsonld.NewFallbackLoader(logger))
+}
@@ -0,0 +1,31 @@
+//go:build nojsonld
+
+package parse
+
+import (
+	"log/slog"
+)
+
+// newProcessor returns a processor whose every operation fails with
+// ErrNoJSONLD, for builds with the nojsonld tag, which leave json-gold out.
+func newProcessor(*slog.Logger) processor {
+	return noProcessor{}
+}
+
+type noProcessor struct{}
+
+func (noProcessor) Expand(interface{}) ([]interface{}, error) {
+	return nil, ErrNoJSONLD
+}
+
+func (noProcessor) Flatten(interface{}) (interface{}, error) {
+	return nil, ErrNoJSONLD
+}
+
+func (noProcessor) Compact(interface{}, interface{}) (interface{}, error) {
+	return nil, ErrNoJSONLD
+}
+
+func (noProcessor) Canonicalize(interface{}, func(string) bool) (string, error) {
+	return "", ErrNoJSONLD
+}
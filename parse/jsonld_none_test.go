@@ -0,0 +1,36 @@
+//go:build nojsonld
+
+package parse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_NoJSONLD(t *testing.T) {
+	data := watchDoc(`{"type": "software_Package", "spdxId": "urn:spdx:pkg", "name": "pkg", "creationInfo": "_:ci"}`)
+	reader := parse.NewReader()
+
+	doc, err := reader.Read(data)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if pkg := doc.GetPackageByID("urn:spdx:pkg"); pkg == nil || pkg.Name != "pkg" {
+		t.Errorf("GetPackageByID() = %v, want pkg", pkg)
+	}
+
+	if _, err := reader.Expand(data); !errors.Is(err, parse.ErrNoJSONLD) {
+		t.Errorf("Expand() error = %v, want ErrNoJSONLD", err)
+	}
+	if _, err := reader.Flatten(data); !errors.Is(err, parse.ErrNoJSONLD) {
+		t.Errorf("Flatten() error = %v, want ErrNoJSONLD", err)
+	}
+	if _, err := reader.Compact(data, nil); !errors.Is(err, parse.ErrNoJSONLD) {
+		t.Errorf("Compact() error = %v, want ErrNoJSONLD", err)
+	}
+	if _, err := reader.Canonicalize(data); !errors.Is(err, parse.ErrNoJSONLD) {
+		t.Errorf("Canonicalize() error = %v, want ErrNoJSONLD", err)
+	}
+}
@@ -0,0 +1,84 @@
+//go:build !nojsonld
+
+package parse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_Canonicalize(t *testing.T) {
+	const base = `{
+  "@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+  "@graph": [
+    {"type": "CreationInfo", "@id": "_:creationinfo", "specVersion": "3.0.1", "created": "2024-01-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]},
+    {"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme", "creationInfo": "_:creationinfo"},
+    {"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0",
+     "verifiedUsing": [{"type": "Hash", "algorithm": "sha256", "hashValue": "ab12"}], "creationInfo": "_:creationinfo"}
+  ]
+}`
+	tests := []struct {
+		name    string
+		data    string
+		exclude []string
+		same    bool
+		wantErr bool
+	}{
+		{
+			name: "reordered and reformatted",
+			data: `{"@graph":[{"creationInfo":"_:b0","software_packageVersion":"1.0","name":"app","spdxId":"urn:spdx:app","type":"software_Package",` +
+				`"verifiedUsing":{"hashValue":"ab12","algorithm":"sha256","type":"Hash"}},` +
+				`{"name":"Acme","creationInfo":"_:b0","spdxId":"urn:spdx:acme","type":"Organization"},` +
+				`{"createdBy":"urn:spdx:acme","created":"2024-01-01T00:00:00Z","specVersion":"3.0.1","@id":"_:b0","type":"CreationInfo"}],` +
+				`"@context":"https://spdx.org/rdf/3.0.1/spdx-context.jsonld"}`,
+			same: true,
+		},
+		{
+			name: "annotation excluded",
+			data: strings.Replace(base, `"creationInfo": "_:creationinfo"}
+  ]`, `"creationInfo": "_:creationinfo"},
+    {"type": "Annotation", "spdxId": "urn:spdx:note", "annotationType": "other", "subject": "urn:spdx:app", "statement": "signed",
+     "creationInfo": {"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-02-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]}}
+  ]`, 1),
+			exclude: []string{"urn:spdx:note"},
+			same:    true,
+		},
+		{
+			name: "modified",
+			data: strings.Replace(base, `"1.0"`, `"1.1"`, 1),
+		},
+		{
+			name:    "undefined term",
+			data:    strings.Replace(base, `"name": "app"`, `"title": "app"`, 1),
+			wantErr: true,
+		},
+	}
+
+	reader := parse.NewReader()
+	want, err := reader.Canonicalize([]byte(base))
+	if err != nil {
+		t.Fatalf("Canonicalize() error = %v", err)
+	}
+	if !strings.Contains(string(want), "<urn:spdx:app> <https://spdx.org/rdf/3.0.1/terms/Software/packageVersion> \"1.0\"") {
+		t.Errorf("Canonicalize() = %s", want)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := reader.Canonicalize([]byte(tt.data), tt.exclude...)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Canonicalize() = %s, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Canonicalize() error = %v", err)
+			}
+			if same := string(got) == string(want); same != tt.same {
+				t.Errorf("Canonicalize() same = %v, want %v:\n%s", same, tt.same, got)
+			}
+		})
+	}
+}
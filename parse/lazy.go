@@ -0,0 +1,275 @@
+package parse
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"sync"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
+)
+
+// LazyDocument is an SPDX document whose graph entries are only indexed by
+// ID and type when it is read, and parsed into typed structs when first
+// accessed. It suits tools reading a few elements of a large document,
+// which then need neither the time nor the memory to parse the rest.
+//
+// A LazyDocument is safe for concurrent use.
+type LazyDocument struct {
+	// Context holds the JSON-LD context URLs.
+	Context []string
+
+	r       *Reader
+	context json.RawMessage
+	entries []*lazyEntry
+	byID    map[string]*lazyEntry
+	byType  map[ElementType][]*lazyEntry
+	from    map[string][]*lazyEntry
+	to      map[string][]*lazyEntry
+}
+
+// lazyEntry is a graph entry, parsed once on first access.
+type lazyEntry struct {
+	raw  json.RawMessage
+	typ  ElementType
+	once sync.Once
+	obj  interface{}
+	err  error
+}
+
+// lazyHead holds the properties of a graph entry it is indexed by.
+type lazyHead struct {
+	Type   string          `json:"type"`
+	SpdxID string          `json:"spdxId"`
+	From   string          `json:"from"`
+	To     json.RawMessage `json:"to"`
+}
+
+// ReadLazy indexes SPDX JSON-LD data for elements to be parsed on first
+// access. Unlike Read, it does not report elements that fail to parse
+// until they are accessed.
+func (r *Reader) ReadLazy(data []byte) (*LazyDocument, error) {
+	if err := r.limits.checkSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	if err := r.limits.checkJSON(data, 0); err != nil {
+		return nil, err
+	}
+	var raw struct {
+		Context json.RawMessage   `json:"@context"`
+		Graph   []json.RawMessage `json:"@graph"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	if raw.Graph == nil {
+		return nil, fmt.Errorf("document does not contain @graph array")
+	}
+	if err := r.limits.checkElements(len(raw.Graph)); err != nil {
+		return nil, err
+	}
+
+	doc := &LazyDocument{
+		r:       r,
+		context: raw.Context,
+		entries: make([]*lazyEntry, 0, len(raw.Graph)),
+		byID:    make(map[string]*lazyEntry),
+		byType:  make(map[ElementType][]*lazyEntry),
+		from:    make(map[string][]*lazyEntry),
+		to:      make(map[string][]*lazyEntry),
+	}
+	if raw.Context != nil {
+		var ctx interface{}
+		if err := json.Unmarshal(raw.Context, &ctx); err == nil {
+			doc.Context = r.parseContext(ctx)
+		}
+	}
+
+	p := r.startProgress(PhaseElements, len(raw.Graph))
+	for i, elem := range raw.Graph {
+		p.update(i)
+		var head lazyHead
+		// Entries that are not objects are skipped, as Read does.
+		if err := json.Unmarshal(elem, &head); err != nil {
+			r.logger.Debug("skipping graph entry that is not an object", "index", i)
+			continue
+		}
+		e := &lazyEntry{raw: elem, typ: ElementType(parser.CompactType(head.Type))}
+		doc.entries = append(doc.entries, e)
+		doc.byType[e.typ] = append(doc.byType[e.typ], e)
+		if head.SpdxID != "" {
+			doc.byID[head.SpdxID] = e
+		}
+		if e.typ == TypeRelationship {
+			doc.from[head.From] = append(doc.from[head.From], e)
+			var to []string
+			_ = json.Unmarshal(head.To, &to)
+			for _, id := range to {
+				doc.to[id] = append(doc.to[id], e)
+			}
+		}
+	}
+	p.finish(len(raw.Graph))
+	return doc, nil
+}
+
+// Len returns the number of entries in the graph of the document.
+func (d *LazyDocument) Len() int {
+	return len(d.entries)
+}
+
+// Types returns the number of graph entries of each type.
+func (d *LazyDocument) Types() map[ElementType]int {
+	counts := make(map[ElementType]int, len(d.byType))
+	for typ, entries := range d.byType {
+		counts[typ] = len(entries)
+	}
+	return counts
+}
+
+// IDs returns an iterator over the IDs of the elements of the document.
+func (d *LazyDocument) IDs() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for id := range d.byID {
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+// Raw returns the JSON of the element with the given ID, or nil if there
+// is none.
+func (d *LazyDocument) Raw(spdxID string) json.RawMessage {
+	if e, ok := d.byID[spdxID]; ok {
+		return e.raw
+	}
+	return nil
+}
+
+// Element returns the element with the given ID, parsed into its typed
+// struct, or nil if there is none. Elements of unknown types are parsed
+// with the registry of the reader, and are nil if it has no such type.
+func (d *LazyDocument) Element(spdxID string) (interface{}, error) {
+	e, ok := d.byID[spdxID]
+	if !ok {
+		return nil, nil
+	}
+	return d.materialize(e)
+}
+
+// ElementsOfType returns an iterator over the graph entries of a type,
+// parsed into their typed structs.
+//
+//	for obj, err := range doc.ElementsOfType(parse.TypeSoftwarePackage) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(obj.(*spdx.Package).Name)
+//	}
+func (d *LazyDocument) ElementsOfType(typ ElementType) iter.Seq2[interface{}, error] {
+	return func(yield func(interface{}, error) bool) {
+		for _, e := range d.byType[typ] {
+			obj, err := d.materialize(e)
+			if (obj != nil || err != nil) && !yield(obj, err) {
+				return
+			}
+		}
+	}
+}
+
+// SpdxDocument returns the SpdxDocument element of the document, or nil if
+// there is none.
+func (d *LazyDocument) SpdxDocument() (*spdx.SpdxDocument, error) {
+	for obj, err := range d.ElementsOfType(TypeSpdxDocument) {
+		if err != nil {
+			return nil, err
+		}
+		if sd, ok := obj.(*spdx.SpdxDocument); ok {
+			return sd, nil
+		}
+	}
+	return nil, nil
+}
+
+// RelationshipsFrom returns the relationships from the element with the
+// given ID, parsing only those.
+func (d *LazyDocument) RelationshipsFrom(spdxID string) ([]*spdx.Relationship, error) {
+	return d.relationships(d.from[spdxID])
+}
+
+// RelationshipsTo returns the relationships to the element with the given
+// ID, parsing only those.
+func (d *LazyDocument) RelationshipsTo(spdxID string) ([]*spdx.Relationship, error) {
+	return d.relationships(d.to[spdxID])
+}
+
+func (d *LazyDocument) relationships(entries []*lazyEntry) ([]*spdx.Relationship, error) {
+	rels := make([]*spdx.Relationship, 0, len(entries))
+	for _, e := range entries {
+		obj, err := d.materialize(e)
+		if err != nil {
+			return nil, err
+		}
+		if rel, ok := obj.(*spdx.Relationship); ok {
+			rels = append(rels, rel)
+		}
+	}
+	return rels, nil
+}
+
+// Materialize parses every entry of the document into a Document, as Read
+// does.
+func (d *LazyDocument) Materialize() (*Document, error) {
+	graph := make([]interface{}, 0, len(d.entries))
+	for _, e := range d.entries {
+		var elem interface{}
+		if err := json.Unmarshal(e.raw, &elem); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		graph = append(graph, elem)
+	}
+	rawDoc := map[string]interface{}{"@graph": graph}
+	if d.context != nil {
+		var ctx interface{}
+		if err := json.Unmarshal(d.context, &ctx); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		rawDoc["@context"] = ctx
+	}
+	return d.r.parse(rawDoc)
+}
+
+// materialize parses an entry, once.
+func (d *LazyDocument) materialize(e *lazyEntry) (interface{}, error) {
+	e.once.Do(func() {
+		obj, head, ok, err := d.r.parser.NewDecoder().Decode(e.raw)
+		if err != nil {
+			e.err = fmt.Errorf("parsing JSON: %w", err)
+			return
+		}
+		if ok {
+			e.obj = obj
+			return
+		}
+		info, ok := d.r.lookup(e.typ)
+		if !ok {
+			return
+		}
+		var elemMap map[string]interface{}
+		if err := json.Unmarshal(e.raw, &elemMap); err != nil {
+			e.err = fmt.Errorf("parsing JSON: %w", err)
+			return
+		}
+		elem, err := info.Parse(elemMap)
+		if err != nil {
+			e.err = fmt.Errorf("parsing %s element %q: %w", e.typ, head.SpdxID, err)
+			return
+		}
+		if elem != nil {
+			e.obj = elem
+		}
+	})
+	return e.obj, e.err
+}
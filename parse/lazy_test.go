@@ -0,0 +1,154 @@
+package parse_test
+
+import (
+	"sync"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+const lazyDocJSON = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+	"@graph": [
+		{"type": "CreationInfo", "@id": "_:creationinfo", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z"},
+		{"type": "SpdxDocument", "spdxId": "SPDXRef-DOCUMENT", "name": "Test SBOM"},
+		{"type": "software_Package", "spdxId": "SPDXRef-App", "name": "app", "software_packageVersion": "1.0.0"},
+		{"type": "software_Package", "spdxId": "SPDXRef-Lib", "name": "lib", "software_packageVersion": "2.1.0"},
+		{"type": "software_File", "spdxId": "SPDXRef-File", "name": "main.go"},
+		{"type": "Relationship", "spdxId": "SPDXRef-Rel-1", "from": "SPDXRef-DOCUMENT", "to": ["SPDXRef-App"], "relationshipType": "DESCRIBES"},
+		{"type": "Relationship", "spdxId": "SPDXRef-Rel-2", "from": "SPDXRef-App", "to": ["SPDXRef-Lib", "SPDXRef-File"], "relationshipType": "DEPENDS_ON"},
+		{"type": "acme_Firmware", "spdxId": "SPDXRef-Firmware-1", "name": "bios", "software_packageVersion": "2.1", "acme_bootLoader": "grub"},
+		{"type": "acme_Firmware", "spdxId": "SPDXRef-Firmware-2"}
+	]
+}`
+
+func TestReader_ReadLazy(t *testing.T) {
+	reg := parse.NewRegistry()
+	reg.MustRegister(parse.TypeInfo{Type: "acme_Firmware", Parse: parseFirmware})
+	reader := parse.NewReader(parse.WithRegistry(reg))
+	doc, err := reader.ReadLazy([]byte(lazyDocJSON))
+	if err != nil {
+		t.Fatalf("ReadLazy() error = %v", err)
+	}
+
+	if doc.Len() != 9 {
+		t.Errorf("Len() = %d, want 9", doc.Len())
+	}
+	if got := doc.Types()[parse.TypeSoftwarePackage]; got != 2 {
+		t.Errorf("Types()[software_Package] = %d, want 2", got)
+	}
+	if len(doc.Context) != 1 {
+		t.Errorf("Context = %v", doc.Context)
+	}
+	ids := 0
+	for range doc.IDs() {
+		ids++
+	}
+	if ids != 8 {
+		t.Errorf("IDs() yielded %d IDs, want 8", ids)
+	}
+	if raw := doc.Raw("SPDXRef-Lib"); !containsString(string(raw), `"lib"`) {
+		t.Errorf("Raw() = %s", raw)
+	}
+
+	t.Run("Element", func(t *testing.T) {
+		obj, err := doc.Element("SPDXRef-Lib")
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkg, ok := obj.(*spdx.Package)
+		if !ok || pkg.PackageVersion != "2.1.0" {
+			t.Fatalf("Element() = %#v, want package lib", obj)
+		}
+		if again, _ := doc.Element("SPDXRef-Lib"); again != obj {
+			t.Error("Element() parsed the element again")
+		}
+		if obj, err := doc.Element("SPDXRef-Unknown"); obj != nil || err != nil {
+			t.Errorf("Element() of unknown ID = %v, %v", obj, err)
+		}
+		if fw, err := doc.Element("SPDXRef-Firmware-1"); err != nil || fw.(*firmware).Name != "bios" {
+			t.Errorf("Element() of extension = %v, %v", fw, err)
+		}
+		if _, err := doc.Element("SPDXRef-Firmware-2"); err == nil || !containsString(err.Error(), "SPDXRef-Firmware-2") {
+			t.Errorf("Element() of invalid extension error = %v", err)
+		}
+	})
+
+	t.Run("ElementsOfType", func(t *testing.T) {
+		var names []string
+		for obj, err := range doc.ElementsOfType(parse.TypeSoftwarePackage) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			names = append(names, obj.(*spdx.Package).Name)
+		}
+		if len(names) != 2 || names[0] != "app" || names[1] != "lib" {
+			t.Errorf("ElementsOfType() = %v, want [app lib]", names)
+		}
+		sd, err := doc.SpdxDocument()
+		if err != nil || sd == nil || sd.Name != "Test SBOM" {
+			t.Errorf("SpdxDocument() = %v, %v", sd, err)
+		}
+	})
+
+	t.Run("Relationships", func(t *testing.T) {
+		from, err := doc.RelationshipsFrom("SPDXRef-App")
+		if err != nil || len(from) != 1 || from[0].RelationshipType != spdx.RelationshipTypeDependsOn {
+			t.Errorf("RelationshipsFrom() = %v, %v", from, err)
+		}
+		to, err := doc.RelationshipsTo("SPDXRef-File")
+		if err != nil || len(to) != 1 || to[0].GetSpdxID() != "SPDXRef-Rel-2" {
+			t.Errorf("RelationshipsTo() = %v, %v", to, err)
+		}
+	})
+
+	t.Run("concurrent access", func(t *testing.T) {
+		doc, err := reader.ReadLazy([]byte(lazyDocJSON))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var wg sync.WaitGroup
+		objs := make([]interface{}, 8)
+		for i := range objs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				objs[i], _ = doc.Element("SPDXRef-App")
+			}()
+		}
+		wg.Wait()
+		for _, obj := range objs {
+			if obj != objs[0] {
+				t.Fatal("concurrent Element() calls returned different values")
+			}
+		}
+	})
+
+	t.Run("Materialize", func(t *testing.T) {
+		good, err := parse.NewReader().ReadLazy([]byte(lazyDocJSON))
+		if err != nil {
+			t.Fatal(err)
+		}
+		full, err := good.Materialize()
+		if err != nil {
+			t.Fatalf("Materialize() error = %v", err)
+		}
+		want, err := parse.NewReader().Read([]byte(lazyDocJSON))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(full.Packages) != len(want.Packages) || len(full.Files) != len(want.Files) ||
+			len(full.GetRelationshipsFrom("SPDXRef-App")) != 1 || full.GetName() != want.GetName() {
+			t.Errorf("Materialize() = %+v, want %+v", full, want)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, data := range []string{`[]`, `{"@context": "x"}`, `{`} {
+			if _, err := reader.ReadLazy([]byte(data)); err == nil {
+				t.Errorf("ReadLazy(%s) succeeded", data)
+			}
+		}
+	})
+}
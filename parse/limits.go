@@ -0,0 +1,135 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrLimitExceeded is returned, wrapped with details, when a document
+// exceeds one of the Limits of the reader.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+// Limits bounds the resources a Reader spends on a document, so that
+// services reading untrusted documents are not exhausted by oversized or
+// maliciously nested ones. A zero field means no limit.
+type Limits struct {
+	// MaxDocumentSize is the largest document, in bytes, that is read.
+	// FromReader and ReadFS stop reading as soon as their input exceeds
+	// it, and ReadFile and watchers do not read files whose size exceeds
+	// it.
+	MaxDocumentSize int64
+	// MaxElements is the largest number of entries in the @graph array.
+	MaxElements int
+	// MaxDepth is the deepest nesting of JSON objects and arrays. The
+	// document object is at depth 1 and its graph elements at depth 3.
+	MaxDepth int
+	// MaxStringLength is the longest JSON string, in bytes as encoded,
+	// including object keys.
+	MaxStringLength int
+}
+
+// WithLimits sets the limits the reader enforces on the documents it
+// reads. Documents exceeding them fail with an error wrapping
+// ErrLimitExceeded. The nesting depth and string lengths are checked on
+// the JSON text before it is decoded, so that nothing is allocated for
+// the oversized values.
+//
+//	reader := parse.NewReader(parse.WithLimits(parse.Limits{
+//		MaxDocumentSize: 64 << 20,
+//		MaxElements:     500_000,
+//		MaxDepth:        32,
+//		MaxStringLength: 1 << 20,
+//	}))
+func WithLimits(limits Limits) Option {
+	return optionFunc(func(r *Reader) {
+		r.limits = limits
+	})
+}
+
+// checkSize checks the size of a document read whole, or of a file before
+// it is read.
+func (l Limits) checkSize(n int64) error {
+	if l.MaxDocumentSize > 0 && n > l.MaxDocumentSize {
+		return fmt.Errorf("%w: document larger than %d bytes", ErrLimitExceeded, l.MaxDocumentSize)
+	}
+	return nil
+}
+
+// checkElements checks the number of graph entries read so far.
+func (l Limits) checkElements(n int) error {
+	if l.MaxElements > 0 && n > l.MaxElements {
+		return fmt.Errorf("%w: graph has more than %d elements", ErrLimitExceeded, l.MaxElements)
+	}
+	return nil
+}
+
+// checkJSON checks the nesting depth and string lengths of the JSON text
+// in data, whose values are nested depth levels deep in the document. It
+// only scans for strings and brackets, leaving the validation of the text
+// to the JSON decoder.
+func (l Limits) checkJSON(data []byte, depth int) error {
+	if l.MaxDepth <= 0 && l.MaxStringLength <= 0 {
+		return nil
+	}
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '"':
+			start := i
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+			}
+			if n := i - start - 1; l.MaxStringLength > 0 && n > l.MaxStringLength {
+				return fmt.Errorf("%w: string of %d bytes at offset %d, longer than %d", ErrLimitExceeded, n, start, l.MaxStringLength)
+			}
+		case '{', '[':
+			depth++
+			if l.MaxDepth > 0 && depth > l.MaxDepth {
+				return fmt.Errorf("%w: nesting deeper than %d at offset %d", ErrLimitExceeded, l.MaxDepth, i)
+			}
+		case '}', ']':
+			depth--
+		}
+	}
+	return nil
+}
+
+// limitReader returns r, limited to the maximum document size.
+func (l Limits) limitReader(r io.Reader) io.Reader {
+	if l.MaxDocumentSize <= 0 {
+		return r
+	}
+	return &sizeLimitedReader{r: r, max: l.MaxDocumentSize}
+}
+
+// sizeLimitedReader fails with ErrLimitExceeded once more than max bytes
+// have been read, unlike io.LimitedReader, which reports a truncated
+// input as its end.
+type sizeLimitedReader struct {
+	r   io.Reader
+	n   int64
+	max int64
+}
+
+func (s *sizeLimitedReader) Read(p []byte) (int, error) {
+	if s.n > s.max {
+		return 0, s.err()
+	}
+	// Reading one byte past the limit tells a document of exactly max
+	// bytes from a larger one.
+	if rem := s.max + 1 - s.n; int64(len(p)) > rem {
+		p = p[:rem]
+	}
+	n, err := s.r.Read(p)
+	s.n += int64(n)
+	if s.n > s.max {
+		return n - 1, s.err()
+	}
+	return n, err
+}
+
+func (s *sizeLimitedReader) err() error {
+	return fmt.Errorf("%w: document larger than %d bytes", ErrLimitExceeded, s.max)
+}
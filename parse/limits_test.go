@@ -0,0 +1,133 @@
+package parse_test
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_WithLimits(t *testing.T) {
+	deep := `{"@graph": [{"type": "Person", "spdxId": "urn:spdx:p", "comment": ` +
+		strings.Repeat("[", 10) + strings.Repeat("]", 10) + `}]}`
+	long := `{"@graph": [{"type": "Person", "spdxId": "urn:spdx:p", "name": "` + strings.Repeat("a", 100) + `"}]}`
+	deepContext := `{"@context": [[[["x"]]]], "@graph": []}`
+
+	tests := []struct {
+		name    string
+		limits  parse.Limits
+		input   string
+		wantErr bool
+	}{
+		{"within all limits", parse.Limits{MaxDocumentSize: 1 << 20, MaxElements: 20, MaxDepth: 6, MaxStringLength: 100}, lenientDocJSON, false},
+		{"document size", parse.Limits{MaxDocumentSize: 100}, lenientDocJSON, true},
+		{"document size exactly", parse.Limits{MaxDocumentSize: int64(len(lazyDocJSON))}, lazyDocJSON, false},
+		{"elements", parse.Limits{MaxElements: 9}, lenientDocJSON, true},
+		{"elements exactly", parse.Limits{MaxElements: 10}, lenientDocJSON, false},
+		{"depth", parse.Limits{MaxDepth: 12}, deep, true},
+		{"depth exactly", parse.Limits{MaxDepth: 13}, deep, false},
+		{"depth of context", parse.Limits{MaxDepth: 4}, deepContext, true},
+		{"string length", parse.Limits{MaxStringLength: 99}, long, true},
+		{"string length exactly", parse.Limits{MaxStringLength: 100}, long, false},
+	}
+	modes := map[string][]parse.Option{
+		"maps":      nil,
+		"streaming": {parse.WithStreaming()},
+	}
+	for _, tt := range tests {
+		for mode, opts := range modes {
+			t.Run(tt.name+"/"+mode, func(t *testing.T) {
+				reader := parse.NewReader(append(opts, parse.WithLimits(tt.limits))...)
+				check := func(method string, err error) {
+					t.Helper()
+					if tt.wantErr != (err != nil) || (err != nil && !errors.Is(err, parse.ErrLimitExceeded)) {
+						t.Errorf("%s() error = %v, want limit exceeded %v", method, err, tt.wantErr)
+					}
+				}
+				_, err := reader.Read([]byte(tt.input))
+				check("Read", err)
+				_, err = reader.FromReader(strings.NewReader(tt.input))
+				check("FromReader", err)
+				_, err = reader.ReadLazy([]byte(tt.input))
+				check("ReadLazy", err)
+			})
+		}
+	}
+}
+
+// TestReader_WithLimits_StopsReading checks that FromReader does not read
+// much past the size limit of an endless input.
+func TestReader_WithLimits_StopsReading(t *testing.T) {
+	for _, opts := range [][]parse.Option{nil, {parse.WithStreaming()}} {
+		elems := &repeatReader{data: []byte(`{"type": "Person", "name": "p"},`)}
+		input := io.MultiReader(strings.NewReader(`{"@graph": [`), elems)
+		reader := parse.NewReader(append(opts, parse.WithLimits(parse.Limits{MaxDocumentSize: 1 << 16}))...)
+		if _, err := reader.FromReader(input); !errors.Is(err, parse.ErrLimitExceeded) {
+			t.Errorf("FromReader() error = %v, want limit exceeded", err)
+		}
+		if elems.n > 1<<17 {
+			t.Errorf("FromReader() read %d bytes", elems.n)
+		}
+	}
+}
+
+// TestReader_WithLimits_Files checks that files larger than the size
+// limit are not read whole.
+func TestReader_WithLimits_Files(t *testing.T) {
+	limits := parse.WithLimits(parse.Limits{MaxDocumentSize: int64(len(lazyDocJSON)) - 1})
+	fsys := fstest.MapFS{"sbom.spdx.json": {Data: []byte(lazyDocJSON)}}
+	reads := 0
+	reader := parse.NewReader(limits, parse.WithFS(fsys), parse.WithFileReader(func(name string) ([]byte, error) {
+		reads++
+		return fs.ReadFile(fsys, name)
+	}))
+	if _, err := reader.ReadFile("sbom.spdx.json"); !errors.Is(err, parse.ErrLimitExceeded) {
+		t.Errorf("ReadFile() error = %v, want limit exceeded", err)
+	}
+	if _, err := reader.WatchFile("sbom.spdx.json"); !errors.Is(err, parse.ErrLimitExceeded) {
+		t.Errorf("WatchFile() error = %v, want limit exceeded", err)
+	}
+	if reads != 0 {
+		t.Errorf("the file was read %d times", reads)
+	}
+
+	elems := &repeatReader{data: []byte(`{"type": "Person", "name": "p"},`)}
+	endless := endlessFS{io.MultiReader(strings.NewReader(`{"@graph": [`), elems)}
+	reader = parse.NewReader(parse.WithLimits(parse.Limits{MaxDocumentSize: 1 << 16}))
+	if _, err := reader.ReadFS(endless, "sbom.spdx.json"); !errors.Is(err, parse.ErrLimitExceeded) {
+		t.Errorf("ReadFS() error = %v, want limit exceeded", err)
+	}
+	if elems.n > 1<<17 {
+		t.Errorf("ReadFS() read %d bytes", elems.n)
+	}
+}
+
+// endlessFS holds a single file of unknown size, read from r.
+type endlessFS struct{ r io.Reader }
+
+func (e endlessFS) Open(string) (fs.File, error) { return endlessFile(e), nil }
+
+type endlessFile struct{ r io.Reader }
+
+func (f endlessFile) Read(p []byte) (int, error) { return f.r.Read(p) }
+func (endlessFile) Stat() (fs.FileInfo, error)   { return nil, errors.ErrUnsupported }
+func (endlessFile) Close() error                 { return nil }
+
+// repeatReader repeats data endlessly.
+type repeatReader struct {
+	data []byte
+	n    int
+}
+
+func (r *repeatReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		n += copy(p[n:], r.data[(r.n+n)%len(r.data):])
+	}
+	r.n += n
+	return n, nil
+}
@@ -0,0 +1,267 @@
+package parse
+
+import (
+	"reflect"
+	"slices"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// LiteReport lists what ReduceToLite removed from a document.
+type LiteReport struct {
+	// Removed lists the elements and other graph objects dropped: the
+	// elements in the order of AllElements, then the relationships, the
+	// non-element objects and the elements of types the document does not
+	// keep. Objects without an SPDX ID have an empty SpdxID.
+	Removed []RemovedObject
+
+	// Properties counts the properties cleared from the kept elements, by
+	// type and property name, such as "Package.sourceInfo".
+	Properties map[string]int
+}
+
+// RemovedObject is an object dropped from a document.
+type RemovedObject struct {
+	SpdxID string
+	Type   string
+}
+
+// liteRelationships are the relationship types kept by ReduceToLite,
+// besides the dependency relationships.
+var liteRelationships = []spdx.RelationshipType{
+	spdx.RelationshipTypeContains,
+	spdx.RelationshipTypeDescribes,
+	spdx.RelationshipTypeHasConcludedLicense,
+	spdx.RelationshipTypeHasDeclaredLicense,
+}
+
+// liteProfiles are the profiles a document reduced to SPDX Lite conforms
+// to.
+var liteProfiles = []spdx.ProfileIdentifierType{
+	spdx.ProfileIdentifierTypeCore,
+	spdx.ProfileIdentifierTypeSoftware,
+	spdx.ProfileIdentifierTypeSimpleLicensing,
+	spdx.ProfileIdentifierTypeLite,
+}
+
+// ReduceToLite reduces the document in place to the field set of the SPDX
+// Lite profile, as required by some supply chains, and reports what it
+// removed. Write the result with Bytes or WriteFile. The raw elements in
+// ElementsByID are updated to match.
+//
+// The document keeps its SpdxDocument, Boms, packages, agents, tools,
+// simple licensing elements, creation info and hashes, and the
+// relationships and lifecycle-scoped relationships between them that
+// describe, contain, depend on or license packages. Every other element is
+// dropped, along with relationships to dropped elements and collection
+// members that were dropped; references to elements the document does not
+// define, such as listed licenses and imported elements, are kept. The kept
+// elements lose their summary, description and extensions, packages
+// their source info, additional purposes, content identifiers, support
+// levels and standard names, relationships their start and end times and
+// the SpdxDocument its imports; the SpdxDocument then declares conformance
+// to the Core, Software, SimpleLicensing and Lite profiles.
+//
+//	report, err := doc.ReduceToLite()
+//	...
+//	for _, r := range report.Removed {
+//	    log.Printf("dropped %s %s", r.Type, r.SpdxID)
+//	}
+//	err = doc.WriteFile("sbom.lite.spdx.json")
+func (d *Document) ReduceToLite() (*LiteReport, error) {
+	report := &LiteReport{Properties: make(map[string]int)}
+	removed := make(map[interface{}]struct{})
+	kept := make(map[string]bool)
+	reported := make(map[string]bool)
+	changed := make(map[spdx.ElementInterface]bool)
+	drop := func(obj interface{}, spdxID string) {
+		addFiled(removed, reflect.ValueOf(obj))
+		report.Removed = append(report.Removed, RemovedObject{SpdxID: spdxID, Type: typeName(obj)})
+		reported[spdxID] = true
+	}
+
+	// References to elements the document does not define, such as
+	// listed licenses and imported elements, are kept; only those to
+	// dropped elements are removed.
+	defined := make(map[string]bool, len(d.ElementsByID))
+	for id := range d.ElementsByID {
+		defined[id] = true
+	}
+	for elem := range d.AllElements() {
+		defined[elem.GetSpdxID()] = true
+	}
+	dropped := func(id string) bool { return defined[id] && !kept[id] }
+
+	var rels []spdx.AnyElement
+	for elem := range d.AllElements() {
+		switch elem.(type) {
+		case *spdx.SpdxDocument, *spdx.Bom, *spdx.Package,
+			*spdx.Agent, *spdx.Organization, *spdx.Person, *spdx.SoftwareAgent, *spdx.Tool,
+			*spdx.AnyLicenseInfo, *spdx.LicenseExpression, *spdx.SimpleLicensingText:
+			kept[elem.GetSpdxID()] = true
+		case *spdx.Relationship, *spdx.LifecycleScopedRelationship:
+			rels = append(rels, elem.(spdx.AnyElement))
+		default:
+			drop(elem, elem.GetSpdxID())
+		}
+	}
+
+	for _, elem := range rels {
+		rel, _ := spdx.AsRelationship(elem)
+		if !rel.IsDependency() && !slices.Contains(liteRelationships, rel.RelationshipType) || dropped(rel.From.GetSpdxID()) {
+			drop(elem, rel.SpdxID)
+			continue
+		}
+		to := slices.DeleteFunc(slices.Clone(rel.To), func(e spdx.Element) bool {
+			return dropped(e.GetSpdxID())
+		})
+		if len(to) == 0 {
+			drop(elem, rel.SpdxID)
+			continue
+		}
+		if len(to) != len(rel.To) {
+			rel.To = to
+			changed[elem] = true
+		}
+		kept[rel.SpdxID] = true
+	}
+
+	for id, o := range d.EnergyConsumptionsByID {
+		drop(o, id)
+	}
+	for id, o := range d.EnergyConsumptionDescriptionsByID {
+		drop(o, id)
+	}
+	dropObjects(d.ExternalMaps, removed, drop)
+	dropObjects(d.DictionaryEntries, removed, drop)
+	dropObjects(d.PackageVerificationCodes, removed, drop)
+	dropObjects(d.EnergyConsumptions, removed, drop)
+	dropObjects(d.EnergyConsumptionDescriptions, removed, drop)
+	pruneDocument(d, removed)
+	if d.RelationshipsFromIndex != nil {
+		d.RelationshipsFromIndex = indexRelationshipsFrom(d.Relationships)
+		d.RelationshipsToIndex = indexRelationshipsTo(d.Relationships)
+	}
+
+	// The raw elements of the dropped objects and of unknown types go
+	// last, by SPDX ID.
+	var unknown []string
+	for id := range d.ElementsByID {
+		if !kept[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	slices.Sort(unknown)
+	for _, id := range unknown {
+		if !reported[id] {
+			t := typeName(d.ElementsByID[id])
+			if raw, ok := d.ElementsByID[id].(map[string]interface{}); ok {
+				t, _ = raw["type"].(string)
+			}
+			report.Removed = append(report.Removed, RemovedObject{SpdxID: id, Type: t})
+		}
+		delete(d.ElementsByID, id)
+	}
+
+	if d.SpdxDocument != nil {
+		pruneMembers(&d.SpdxDocument.ElementCollection, dropped)
+		changed[d.SpdxDocument] = true
+	}
+	for _, bom := range d.Boms {
+		pruneMembers(&bom.ElementCollection, dropped)
+		changed[bom] = true
+	}
+	for elem := range d.AllElements() {
+		if reduceElement(elem.(spdx.AnyElement), report) {
+			changed[elem] = true
+		}
+	}
+
+	// The raw elements of the changed elements are refreshed, as
+	// UpdateElements does. Typed documents hold the elements themselves.
+	for elem := range d.AllElements() {
+		if d.typed || !changed[elem] || elem.GetSpdxID() == "" {
+			continue
+		}
+		raw, err := d.rawElement(elem.(spdx.AnyElement))
+		if err != nil {
+			return nil, err
+		}
+		d.ElementsByID[elem.GetSpdxID()] = raw
+	}
+	return report, nil
+}
+
+// pruneMembers removes the elements and root elements of a collection
+// that were dropped.
+func pruneMembers(c *spdx.ElementCollection, dropped func(string) bool) {
+	isDropped := func(e spdx.Element) bool { return dropped(e.GetSpdxID()) }
+	c.Elements = slices.DeleteFunc(c.Elements, isDropped)
+	c.RootElement = slices.DeleteFunc(c.RootElement, isDropped)
+}
+
+// dropObjects drops the non-element objects of a slice of the document
+// that are not already dropped.
+func dropObjects[T any](objs []*T, removed map[interface{}]struct{}, drop func(interface{}, string)) {
+	for _, o := range objs {
+		if _, ok := removed[o]; !ok {
+			drop(o, "")
+		}
+	}
+}
+
+// reduceElement clears the properties of a kept element that are not part
+// of the Lite profile and reports whether it cleared any.
+func reduceElement(elem spdx.AnyElement, report *LiteReport) bool {
+	name := typeName(elem)
+	changed := false
+	cleared := func(property string, set bool) {
+		if set {
+			report.Properties[name+"."+property]++
+			changed = true
+		}
+	}
+
+	e := spdx.AsElement(elem)
+	cleared("summary", e.Summary != "")
+	cleared("description", e.Description != "")
+	cleared("extension", len(e.Extension) > 0)
+	e.Summary, e.Description, e.Extension = "", "", nil
+
+	switch o := elem.(type) {
+	case *spdx.SpdxDocument:
+		cleared("import", len(o.Import) > 0)
+		o.Import = nil
+		o.ProfileConformance = slices.Clone(liteProfiles)
+	case *spdx.Package:
+		cleared("sourceInfo", o.SourceInfo != "")
+		cleared("additionalPurpose", len(o.AdditionalPurpose) > 0)
+		cleared("contentIdentifier", len(o.ContentIdentifier) > 0)
+		cleared("supportLevel", len(o.SupportLevel) > 0)
+		cleared("standardName", len(o.StandardName) > 0)
+		o.SourceInfo, o.AdditionalPurpose, o.ContentIdentifier, o.SupportLevel, o.StandardName = "", nil, nil, nil, nil
+	case *spdx.Relationship:
+		clearTimes(o, cleared)
+	case *spdx.LifecycleScopedRelationship:
+		clearTimes(&o.Relationship, cleared)
+	}
+	return changed
+}
+
+// clearTimes clears the start and end times of a relationship, which are
+// not part of the Lite profile.
+func clearTimes(rel *spdx.Relationship, cleared func(string, bool)) {
+	cleared("startTime", !rel.StartTime.IsZero())
+	cleared("endTime", !rel.EndTime.IsZero())
+	rel.StartTime, rel.EndTime = time.Time{}, time.Time{}
+}
+
+// typeName returns the name of the model type of an object.
+func typeName(obj interface{}) string {
+	t := reflect.TypeOf(obj)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
@@ -0,0 +1,146 @@
+package parse_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_ReduceToLite(t *testing.T) {
+	input := watchDoc(
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "name": "full", "profileConformance": ["core", "software", "security"],
+		  "element": ["urn:spdx:app", "urn:spdx:main", "urn:spdx:vuln"], "rootElement": ["urn:spdx:app"],
+		  "import": [{"type": "ExternalMap", "externalSpdxId": "urn:other:x"}]}`,
+		`{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0",
+		  "summary": "the app", "software_sourceInfo": "built from git", "suppliedBy": "urn:spdx:acme"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib", "software_packageVersion": "2.0"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "name": "main.go"}`,
+		`{"type": "simplelicensing_LicenseExpression", "spdxId": "urn:spdx:mit", "simplelicensing_licenseExpression": "MIT"}`,
+		`{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln", "name": "CVE-2024-0001"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:dep", "from": "urn:spdx:app", "to": ["urn:spdx:lib", "urn:spdx:main"], "relationshipType": "dependsOn",
+		  "startTime": "2024-01-01T00:00:00Z"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:lic", "from": "urn:spdx:app", "to": ["urn:spdx:mit"], "relationshipType": "hasDeclaredLicense"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:contains", "from": "urn:spdx:app", "to": ["urn:spdx:main"], "relationshipType": "contains"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:vuln-rel", "from": "urn:spdx:app", "to": ["urn:spdx:vuln"], "relationshipType": "hasAssociatedVulnerability"}`,
+		`{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown", "name": "kept raw"}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			reader := parse.NewReader(opts...)
+			doc, err := reader.Read(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			report, err := doc.ReduceToLite()
+			if err != nil {
+				t.Fatalf("ReduceToLite() error = %v", err)
+			}
+
+			wantRemoved := []parse.RemovedObject{
+				{SpdxID: "urn:spdx:main", Type: "File"},
+				{SpdxID: "urn:spdx:vuln", Type: "Vulnerability"},
+				{SpdxID: "urn:spdx:contains", Type: "Relationship"},
+				{SpdxID: "urn:spdx:vuln-rel", Type: "Relationship"},
+				{SpdxID: "urn:spdx:unknown", Type: "acme_Unknown"},
+			}
+			if !reflect.DeepEqual(report.Removed, wantRemoved) {
+				t.Errorf("Removed = %+v, want %+v", report.Removed, wantRemoved)
+			}
+			wantProps := map[string]int{"Package.summary": 1, "Package.sourceInfo": 1, "Relationship.startTime": 1, "SpdxDocument.import": 1}
+			if !reflect.DeepEqual(report.Properties, wantProps) {
+				t.Errorf("Properties = %v, want %v", report.Properties, wantProps)
+			}
+
+			if len(doc.Files) != 0 || len(doc.Vulnerabilities) != 0 || doc.FilesByID["urn:spdx:main"] != nil || len(doc.Packages) != 2 {
+				t.Errorf("document keeps non-Lite elements: %d files, %d vulnerabilities, %d packages", len(doc.Files), len(doc.Vulnerabilities), len(doc.Packages))
+			}
+			if _, ok := doc.ElementsByID["urn:spdx:unknown"]; ok {
+				t.Errorf("ElementsByID keeps an unknown element")
+			}
+			if deps := doc.GetDependenciesFor("urn:spdx:app"); len(deps) != 1 || deps[0].SpdxID != "urn:spdx:lib" {
+				t.Errorf("GetDependenciesFor() = %v, want urn:spdx:lib", deps)
+			}
+			if rels := doc.GetRelationshipsTo("urn:spdx:main"); len(rels) != 0 {
+				t.Errorf("GetRelationshipsTo() of a dropped file = %v", rels)
+			}
+			sd := doc.SpdxDocument
+			if !reflect.DeepEqual(sd.Elements, []spdx.Element{{SpdxID: "urn:spdx:app"}}) || sd.Import != nil ||
+				!slices.Contains(sd.ProfileConformance, spdx.ProfileIdentifierTypeLite) {
+				t.Errorf("SpdxDocument = %+v", sd)
+			}
+
+			// The reduced document reads back the same.
+			data, err := doc.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			saved, err := reader.Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSameDocument(t, saved, doc)
+			if mode == "maps" {
+				raw := doc.ElementsByID["urn:spdx:app"].(map[string]interface{})
+				if _, ok := raw["summary"]; ok {
+					t.Errorf("raw element keeps a cleared property: %v", raw)
+				}
+			}
+		})
+	}
+}
+
+func TestDocument_ReduceToLite_ExternalReferences(t *testing.T) {
+	input := watchDoc(
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "element": ["urn:spdx:app", "urn:other:lib"],
+		  "import": [{"type": "ExternalMap", "externalSpdxId": "urn:other:lib"}]}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "name": "main.go"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:declared", "from": "urn:spdx:app", "to": ["https://spdx.org/licenses/MIT"], "relationshipType": "hasDeclaredLicense"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:concluded", "from": "urn:spdx:app", "to": ["https://spdx.org/licenses/Apache-2.0"], "relationshipType": "hasConcludedLicense"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:dep", "from": "urn:spdx:app", "to": ["urn:other:lib", "urn:spdx:main"], "relationshipType": "dependsOn"}`,
+		`{"type": "LifecycleScopedRelationship", "spdxId": "urn:spdx:build-dep", "from": "urn:spdx:app", "to": ["urn:other:lib"], "relationshipType": "dependsOn", "scope": "build",
+		  "startTime": "2024-01-01T00:00:00Z"}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(opts...).Read(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			report, err := doc.ReduceToLite()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantRemoved := []parse.RemovedObject{{SpdxID: "urn:spdx:main", Type: "File"}}
+			if !reflect.DeepEqual(report.Removed, wantRemoved) {
+				t.Errorf("Removed = %+v, want %+v", report.Removed, wantRemoved)
+			}
+			for _, typ := range []spdx.RelationshipType{spdx.RelationshipTypeHasDeclaredLicense, spdx.RelationshipTypeHasConcludedLicense} {
+				if rels := doc.GetRelationshipsByType(typ); len(rels) != 1 || len(rels[0].To) != 1 {
+					t.Errorf("%s relationships = %v, want the one to a listed license", typ, rels)
+				}
+			}
+			for _, rel := range doc.Relationships {
+				if rel.SpdxID == "urn:spdx:dep" && !reflect.DeepEqual(rel.To, []spdx.Element{{SpdxID: "urn:other:lib"}}) {
+					t.Errorf("dependsOn to = %v, want the imported lib only", rel.To)
+				}
+			}
+			if len(doc.LifecycleScopedRelationships) != 1 {
+				t.Fatalf("LifecycleScopedRelationships = %d, want 1", len(doc.LifecycleScopedRelationships))
+			}
+			if lsr := doc.LifecycleScopedRelationships[0]; !lsr.StartTime.IsZero() || lsr.Scope != spdx.LifecycleScopeTypeBuild {
+				t.Errorf("lifecycle-scoped relationship = %+v, want its scope without start time", lsr)
+			}
+			if got := doc.SpdxDocument.Elements; !reflect.DeepEqual(got, []spdx.Element{{SpdxID: "urn:spdx:app"}, {SpdxID: "urn:other:lib"}}) {
+				t.Errorf("SpdxDocument elements = %v, want the imported lib kept", got)
+			}
+		})
+	}
+}
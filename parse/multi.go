@@ -0,0 +1,71 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ReadAll reads and parses every SPDX JSON-LD document of an input holding
+// several, as some aggregators emit: a JSON array of documents, or
+// documents concatenated one after the other, optionally separated by
+// whitespace as in JSON Lines. An input holding a single document yields
+// one Document. Each document is read as Read reads it, limits included.
+//
+//	docs, err := reader.ReadAll(data)
+//	for _, doc := range docs {
+//	    fmt.Println(doc.GetName())
+//	}
+func (r *Reader) ReadAll(data []byte) ([]*Document, error) {
+	var parts []json.RawMessage
+	var err error
+	if trimmed := bytes.TrimLeft(data, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '[' {
+		parts, err = splitArray(trimmed)
+	} else {
+		parts, err = splitConcatenated(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("input contains no document")
+	}
+
+	docs := make([]*Document, 0, len(parts))
+	for i, part := range parts {
+		doc, err := r.Read(part)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// splitArray returns the elements of a JSON array of documents.
+func splitArray(data []byte) ([]json.RawMessage, error) {
+	var parts []json.RawMessage
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// splitConcatenated returns the JSON values of data, one after the other.
+func splitConcatenated(data []byte) ([]json.RawMessage, error) {
+	var parts []json.RawMessage
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var part json.RawMessage
+		err := dec.Decode(&part)
+		if errors.Is(err, io.EOF) {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+}
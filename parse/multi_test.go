@@ -0,0 +1,56 @@
+package parse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_ReadAll(t *testing.T) {
+	doc := func(name string) string {
+		return `{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [` +
+			`{"type": "SpdxDocument", "spdxId": "urn:spdx:` + name + `", "name": "` + name + `"}]}`
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    []string
+		wantErr string
+	}{
+		{"single document", doc("a"), []string{"a"}, ""},
+		{"array", "[" + doc("a") + ", " + doc("b") + "]", []string{"a", "b"}, ""},
+		{"array after whitespace", "\n  [" + doc("a") + "]", []string{"a"}, ""},
+		{"concatenated", doc("a") + doc("b") + doc("c"), []string{"a", "b", "c"}, ""},
+		{"JSON lines", doc("a") + "\n" + doc("b") + "\n", []string{"a", "b"}, ""},
+		{"empty array", "[]", nil, "no document"},
+		{"empty input", "  ", nil, "no document"},
+		{"invalid JSON", doc("a") + `{"@graph": [`, nil, "parsing JSON"},
+		{"invalid document", "[" + doc("a") + `, {"@context": "x"}]`, nil, "document 1: "},
+		{"not an object", `[` + doc("a") + `, 42]`, nil, "document 1: "},
+	}
+	for _, tt := range tests {
+		for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+			t.Run(tt.name+"/"+mode, func(t *testing.T) {
+				docs, err := parse.NewReader(opts...).ReadAll([]byte(tt.input))
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Errorf("ReadAll() error = %v, want %q", err, tt.wantErr)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("ReadAll() error = %v", err)
+				}
+				var names []string
+				for _, d := range docs {
+					names = append(names, d.GetName())
+				}
+				if strings.Join(names, ",") != strings.Join(tt.want, ",") {
+					t.Errorf("ReadAll() = %v, want %v", names, tt.want)
+				}
+			})
+		}
+	}
+}
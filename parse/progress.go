@@ -0,0 +1,83 @@
+package parse
+
+// Phase names a phase of a long operation on a document.
+type Phase string
+
+// Phases reported by the Reader and by the operations of other packages
+// taking a ProgressFunc.
+const (
+	// PhaseDecode is the decoding of the JSON text, counted in bytes.
+	PhaseDecode Phase = "decode"
+	// PhaseElements is the parsing of the graph entries.
+	PhaseElements Phase = "elements"
+	// PhaseIndexes is the building of the document indexes, counted in
+	// index tasks.
+	PhaseIndexes Phase = "indexes"
+	// PhaseMerge is the merging of elements.
+	PhaseMerge Phase = "merge"
+	// PhaseValidate is the validation of elements.
+	PhaseValidate Phase = "validate"
+)
+
+// Progress is the state of a phase of an operation. Total is 0 while it is
+// not known, as for the graph of a document read WithStreaming.
+type Progress struct {
+	Phase Phase
+	Done  int
+	Total int
+}
+
+// ProgressFunc receives the progress of an operation. It is called when a
+// phase starts and ends and every ProgressStep items in between, from the
+// goroutine running the operation.
+type ProgressFunc func(Progress)
+
+// ProgressStep is the number of items between reports of the progress of
+// a phase.
+const ProgressStep = 1024
+
+// WithProgress makes the reader report its progress through the phases of
+// reading a document to fn, so that tools can show the progress of
+// multi-minute reads of giant documents.
+func WithProgress(fn ProgressFunc) Option {
+	return optionFunc(func(r *Reader) {
+		r.progress = fn
+	})
+}
+
+// progress reports the progress of a phase, throttled to ProgressStep.
+// Its methods do nothing on a nil progress, which readers without a
+// ProgressFunc use.
+type progress struct {
+	fn    ProgressFunc
+	phase Phase
+	total int
+	next  int
+}
+
+// startProgress starts reporting a phase to the ProgressFunc of the
+// reader, if it has one.
+func (r *Reader) startProgress(phase Phase, total int) *progress {
+	if r.progress == nil {
+		return nil
+	}
+	r.progress(Progress{Phase: phase, Total: total})
+	return &progress{fn: r.progress, phase: phase, total: total, next: ProgressStep}
+}
+
+// update reports that done items of the phase are done.
+func (p *progress) update(done int) {
+	if p == nil || done < p.next {
+		return
+	}
+	p.fn(Progress{Phase: p.phase, Done: done, Total: p.total})
+	p.next = done + ProgressStep
+}
+
+// finish reports the end of the phase after done items.
+func (p *progress) finish(done int) {
+	if p == nil {
+		return
+	}
+	p.fn(Progress{Phase: p.phase, Done: done, Total: done})
+}
@@ -0,0 +1,54 @@
+package parse_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_WithProgress(t *testing.T) {
+	data := benchmarkDocument(t, 1000)
+	const entries = 2001
+	// The relationship indexes are built by two tasks.
+	indexes := []parse.Progress{{Phase: parse.PhaseIndexes, Total: 3}, {Phase: parse.PhaseIndexes, Done: 3, Total: 3}}
+	tests := []struct {
+		name string
+		opts []parse.Option
+		want []parse.Progress
+	}{
+		{
+			name: "maps",
+			want: append([]parse.Progress{
+				{Phase: parse.PhaseDecode, Total: len(data)},
+				{Phase: parse.PhaseDecode, Done: len(data), Total: len(data)},
+				{Phase: parse.PhaseElements, Total: entries},
+				{Phase: parse.PhaseElements, Done: 1024, Total: entries},
+				{Phase: parse.PhaseElements, Done: entries, Total: entries},
+			}, indexes...),
+		},
+		{
+			name: "streaming",
+			opts: []parse.Option{parse.WithStreaming()},
+			want: append([]parse.Progress{
+				{Phase: parse.PhaseElements},
+				{Phase: parse.PhaseElements, Done: 1024},
+				{Phase: parse.PhaseElements, Done: entries, Total: entries},
+			}, indexes...),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []parse.Progress
+			opts := append(tt.opts,
+				parse.WithIndexes(parse.IndexSoftware, parse.IndexRelationships),
+				parse.WithProgress(func(p parse.Progress) { got = append(got, p) }))
+			if _, err := parse.NewReader(opts...).Read(data); err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("progress = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
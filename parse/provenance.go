@@ -0,0 +1,161 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// Provenance is where an element of a document was read from.
+type Provenance struct {
+	// Source is the file path the element was read from with ReadFile or
+	// ReadFS, or the source given to ReadSource, such as a URL. It is
+	// empty for documents read with Read or FromReader.
+	Source string
+
+	// Offset is the byte offset of the JSON object of the element in the
+	// document, as read.
+	Offset int64
+
+	// Document is the SPDX ID of the SpdxDocument the element was read
+	// with, as read, or empty if there was none.
+	Document string
+
+	// Import is the ExternalMap of the SpdxDocument that declares the
+	// element as imported from an external document, if the document
+	// holds a copy of such an element.
+	Import *spdx.ExternalMap
+}
+
+// provenance is what a reader WithProvenance records of a document.
+type provenance struct {
+	source   string
+	document string
+	offsets  map[string]int64
+}
+
+// WithProvenance records where each element of a read document comes
+// from, for ProvenanceOf. Recording the offsets takes another pass over
+// the JSON of documents not read WithStreaming.
+func WithProvenance() Option {
+	return optionFunc(func(r *Reader) {
+		r.provenance = true
+	})
+}
+
+// ProvenanceOf returns where an element of the document was read from, or
+// false if the document was not read WithProvenance, does not hold the
+// element or had it added after reading.
+//
+//	reader := parse.NewReader(parse.WithProvenance())
+//	doc, err := reader.ReadFile("vendor/sbom.spdx.json")
+//	...
+//	if p, ok := doc.ProvenanceOf("urn:spdx:lib"); ok {
+//	    fmt.Printf("%s at byte %d\n", p.Source, p.Offset)
+//	}
+func (d *Document) ProvenanceOf(spdxID string) (Provenance, bool) {
+	if d.provenance == nil {
+		return Provenance{}, false
+	}
+	if _, ok := d.ElementsByID[spdxID]; !ok {
+		return Provenance{}, false
+	}
+	offset, ok := d.provenance.offsets[spdxID]
+	if !ok {
+		return Provenance{}, false
+	}
+	p := Provenance{Source: d.provenance.source, Offset: offset, Document: d.provenance.document}
+	if d.SpdxDocument != nil {
+		for i := range d.SpdxDocument.Import {
+			if d.SpdxDocument.Import[i].ExternalSpdxId == spdxID {
+				p.Import = &d.SpdxDocument.Import[i]
+			}
+		}
+	}
+	return p, true
+}
+
+// startProvenance sets up the recording of the provenance of a document
+// read from source, if the reader records it.
+func (r *Reader) startProvenance(doc *Document, source string) {
+	if r.provenance {
+		doc.provenance = &provenance{source: source, offsets: make(map[string]int64)}
+	}
+}
+
+// finishProvenance records the SpdxDocument of a read document.
+func (d *Document) finishProvenance() {
+	if d.provenance != nil {
+		d.provenance.document = d.GetSpdxID()
+	}
+}
+
+// recordGraph records the offsets, for readers WithProvenance, and the raw
+// JSON, for readers WithRawElements, of the graph elements of a document
+// read from data into graph.
+func (d *Document) recordGraph(data []byte, graph []interface{}) error {
+	entries, err := graphEntries(data)
+	if err != nil {
+		return fmt.Errorf("parsing JSON: %w", err)
+	}
+	for i, elem := range graph {
+		elemMap, ok := elem.(map[string]interface{})
+		if !ok || i >= len(entries) {
+			continue
+		}
+		spdxID, ok := elemMap["spdxId"].(string)
+		if !ok {
+			continue
+		}
+		if d.provenance != nil {
+			d.provenance.offsets[spdxID] = entries[i].offset
+		}
+		if d.raw != nil {
+			d.raw[spdxID] = entries[i].raw
+		}
+	}
+	return nil
+}
+
+// graphEntry is an entry of the @graph array of a JSON-LD document.
+type graphEntry struct {
+	offset int64
+	raw    json.RawMessage
+}
+
+// graphEntries returns the entries of the @graph array of a JSON-LD
+// document with their byte offsets.
+func graphEntries(data []byte) ([]graphEntry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if key != "@graph" {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		var entries []graphEntry
+		for dec.More() {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, err
+			}
+			entries = append(entries, graphEntry{offset: dec.InputOffset() - int64(len(raw)), raw: raw})
+		}
+		return entries, nil
+	}
+	return nil, nil
+}
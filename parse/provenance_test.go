@@ -0,0 +1,78 @@
+package parse_test
+
+import (
+	"bytes"
+	"testing"
+	"testing/fstest"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_ProvenanceOf(t *testing.T) {
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`
+	lib := `{"type": "software_Package", "spdxId": "urn:other:lib", "name": "lib"}`
+	data := watchDoc(
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc",
+		  "import": [{"type": "ExternalMap", "externalSpdxId": "urn:other:lib", "locationHint": "https://other.example/sbom.spdx.json"}]}`,
+		app, lib,
+	)
+	fsys := fstest.MapFS{"vendor/sbom.spdx.json": {Data: data}}
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(append(opts, parse.WithProvenance())...).ReadFS(fsys, "vendor/sbom.spdx.json")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p, ok := doc.ProvenanceOf("urn:spdx:app")
+			if !ok {
+				t.Fatal("ProvenanceOf(app) = false, want true")
+			}
+			want := parse.Provenance{Source: "vendor/sbom.spdx.json", Offset: int64(bytes.Index(data, []byte(app))), Document: "urn:spdx:doc"}
+			if p != want {
+				t.Errorf("ProvenanceOf(app) = %+v, want %+v", p, want)
+			}
+
+			p, ok = doc.ProvenanceOf("urn:other:lib")
+			if !ok || p.Offset != int64(bytes.Index(data, []byte(lib))) {
+				t.Errorf("ProvenanceOf(lib) = %+v, %v; want the offset of lib", p, ok)
+			}
+			if p.Import == nil || p.Import.LocationHint != "https://other.example/sbom.spdx.json" {
+				t.Errorf("ProvenanceOf(lib).Import = %+v, want the import of lib", p.Import)
+			}
+
+			if err := doc.AddElements(spdx.NewPackage("urn:spdx:added", "added", "1.0", spdx.CreationInfo{})); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := doc.ProvenanceOf("urn:spdx:added"); ok {
+				t.Error("ProvenanceOf(added) = true, want false")
+			}
+
+			doc.RenameNamespace("urn:spdx:", "urn:acme:")
+			if p, ok := doc.ProvenanceOf("urn:acme:app"); !ok || p.Document != "urn:spdx:doc" {
+				t.Errorf("ProvenanceOf(renamed app) = %+v, %v; want the provenance as read", p, ok)
+			}
+		})
+	}
+}
+
+func TestDocument_ProvenanceOf_NotRecorded(t *testing.T) {
+	data := watchDoc(`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`)
+	doc, err := parse.NewReader().Read(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc.ProvenanceOf("urn:spdx:app"); ok {
+		t.Error("ProvenanceOf() = true without WithProvenance, want false")
+	}
+
+	doc, err = parse.NewReader(parse.WithProvenance()).ReadSource("https://acme.example/sbom.spdx.json", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := doc.ProvenanceOf("urn:spdx:app"); !ok || p.Source != "https://acme.example/sbom.spdx.json" || p.Document != "" {
+		t.Errorf("ProvenanceOf() = %+v, %v; want the source URL and no document", p, ok)
+	}
+}
@@ -0,0 +1,45 @@
+package parse
+
+import "encoding/json"
+
+// WithRawElements keeps the JSON of each element of a read document as it
+// appears in the @graph array, for RawOf. Keeping it takes another pass over
+// the JSON of documents not read WithStreaming.
+func WithRawElements() Option {
+	return optionFunc(func(r *Reader) {
+		r.rawElements = true
+	})
+}
+
+// RawOf returns the JSON that an element of the document was read from, or
+// false if the document was not read WithRawElements, does not hold the
+// element or had it added after reading. It is meant for debugging a
+// document whose elements do not parse as expected; ElementsByID of
+// documents not read WithStreaming holds the decoded JSON as well.
+//
+//	reader := parse.NewReader(parse.WithRawElements())
+//	doc, err := reader.ReadFile("vendor/sbom.spdx.json")
+//	...
+//	if raw, ok := doc.RawOf("urn:spdx:lib"); ok {
+//	    fmt.Printf("%s\n", raw)
+//	}
+//
+// The returned JSON must not be modified.
+func (d *Document) RawOf(spdxID string) (json.RawMessage, bool) {
+	if d.raw == nil {
+		return nil, false
+	}
+	if _, ok := d.ElementsByID[spdxID]; !ok {
+		return nil, false
+	}
+	raw, ok := d.raw[spdxID]
+	return raw, ok
+}
+
+// startRaw sets up the keeping of the JSON of the elements of a document,
+// if the reader keeps it.
+func (r *Reader) startRaw(doc *Document) {
+	if r.rawElements {
+		doc.raw = make(map[string]json.RawMessage)
+	}
+}
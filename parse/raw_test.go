@@ -0,0 +1,80 @@
+package parse_test
+
+import (
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_RawOf(t *testing.T) {
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0"}`
+	custom := `{"type": "acme_Widget", "spdxId": "urn:spdx:widget", "acme_size": 3}`
+	data := watchDoc(`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc"}`, app, custom)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(append(opts, parse.WithRawElements())...).Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tests := []struct {
+				id   string
+				want string
+				ok   bool
+			}{
+				{id: "urn:spdx:app", want: app, ok: true},
+				{id: "urn:spdx:widget", want: custom, ok: true},
+				{id: "urn:spdx:missing"},
+			}
+			for _, tt := range tests {
+				raw, ok := doc.RawOf(tt.id)
+				if ok != tt.ok || string(raw) != tt.want {
+					t.Errorf("RawOf(%q) = %s, %v; want %s, %v", tt.id, raw, ok, tt.want, tt.ok)
+				}
+			}
+
+			if err := doc.AddElements(spdx.NewPackage("urn:spdx:added", "added", "1.0", spdx.CreationInfo{})); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := doc.RawOf("urn:spdx:added"); ok {
+				t.Error("RawOf(added) = true, want false")
+			}
+
+			doc.RenameNamespace("urn:spdx:", "urn:acme:")
+			if raw, ok := doc.RawOf("urn:acme:app"); !ok || string(raw) != app {
+				t.Errorf("RawOf(renamed app) = %s, %v; want the JSON as read", raw, ok)
+			}
+		})
+	}
+
+	doc, err := parse.NewReader().Read(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc.RawOf("urn:spdx:app"); ok {
+		t.Error("RawOf() without WithRawElements = true, want false")
+	}
+}
+
+func TestWatcher_RawOf(t *testing.T) {
+	v1 := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0"}`
+	v2 := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "2.0"}`
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`
+
+	w, err := parse.NewReader(parse.WithRawElements()).Watch(watchDoc(v1, lib))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Update(watchDoc(v2)); err != nil {
+		t.Fatal(err)
+	}
+	doc := w.Document()
+	if raw, ok := doc.RawOf("urn:spdx:app"); !ok || string(raw) != v2 {
+		t.Errorf("RawOf(app) = %s, %v; want the updated JSON", raw, ok)
+	}
+	if _, ok := doc.RawOf("urn:spdx:lib"); ok {
+		t.Error("RawOf(removed lib) = true, want false")
+	}
+}
@@ -14,21 +14,57 @@
 package parse
 
 import (
+	"bytes"
+	"cmp"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"log/slog"
 	"os"
+	"slices"
+	"sync"
 
 	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
-	"github.com/interlynk-io/spdx-zen/parse/internal/jsonld"
 	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
 )
 
 // Reader provides JSON-LD parsing capabilities for SPDX 3.0 documents.
 type Reader struct {
-	processor *jsonld.Processor
+	processor processor
 	parser    *parser.ElementParser
 	fileRead  func(string) ([]byte, error)
+	fileStat  func(string) (fs.FileInfo, error)
+	registry  *Registry
+	handlers  map[ElementType][]ElementHandler
+	store     *ElementStore
+	streaming bool
+	indexes   Index
+	pool      *sync.Pool
+	limits    Limits
+	logger    *slog.Logger
+	progress  ProgressFunc
+
+	provenance  bool
+	rawElements bool
+	timestamps  *TimestampFormat
+}
+
+// ErrNoJSONLD is returned, wrapped, by the JSON-LD operations of a Reader,
+// Expand, Flatten, Compact and Canonicalize, in builds with the nojsonld
+// tag. Such builds leave out the json-gold JSON-LD processor and its
+// network-capable document loader, so that the reader compiles to
+// WebAssembly and TinyGo and small binaries; reading documents does not
+// process them as JSON-LD and works the same.
+var ErrNoJSONLD = errors.New("JSON-LD processing not available in this build")
+
+// processor performs the JSON-LD operations of a Reader.
+type processor interface {
+	Expand(doc interface{}) ([]interface{}, error)
+	Flatten(doc interface{}) (interface{}, error)
+	Compact(doc interface{}, context interface{}) (interface{}, error)
+	Canonicalize(doc interface{}, exclude func(iri string) bool) (string, error)
 }
 
 // Option configures a Reader.
@@ -40,14 +76,6 @@ type optionFunc func(*Reader)
 
 func (f optionFunc) apply(r *Reader) { f(r) }
 
-// WithDocumentLoader sets a custom JSON-LD document loader.
-// This is useful for testing or for providing custom context resolution.
-func WithDocumentLoader(loader jsonld.DocumentLoader) Option {
-	return optionFunc(func(r *Reader) {
-		r.processor = jsonld.NewProcessor(loader)
-	})
-}
-
 // WithFileReader sets a custom file reader function.
 // This is useful for testing or for reading from custom sources.
 func WithFileReader(readFn func(string) ([]byte, error)) Option {
@@ -56,34 +84,149 @@ func WithFileReader(readFn func(string) ([]byte, error)) Option {
 	})
 }
 
+// WithFS roots all file access of the reader in fsys: ReadFile and
+// watched files read their paths, which must be valid fs.FS paths, from
+// it. This suits tests using fstest.MapFS and documents embedded in the
+// binary or read from archives.
+//
+//	//go:embed sboms
+//	var sboms embed.FS
+//
+//	reader := parse.NewReader(parse.WithFS(sboms))
+//	doc, err := reader.ReadFile("sboms/app.spdx.json")
+func WithFS(fsys fs.FS) Option {
+	return optionFunc(func(r *Reader) {
+		r.fileRead = func(name string) ([]byte, error) {
+			return fs.ReadFile(fsys, name)
+		}
+		r.fileStat = func(name string) (fs.FileInfo, error) {
+			return fs.Stat(fsys, name)
+		}
+	})
+}
+
+// WithRegistry sets the registry used to parse element types that the
+// reader does not handle itself. By default the reader uses DefaultRegistry.
+func WithRegistry(reg *Registry) Option {
+	return optionFunc(func(r *Reader) {
+		r.registry = reg
+	})
+}
+
+// WithStreaming makes the reader decode documents from the JSON token
+// stream straight into the typed model structs, instead of decoding them
+// into interface{} maps first and parsing those. This takes a fraction of
+// the allocations on large documents, and FromReader no longer holds the
+// whole input in memory.
+//
+// ElementsByID then holds the typed elements rather than their raw JSON
+// maps; only elements of types the reader does not know are kept as maps.
+func WithStreaming() Option {
+	return optionFunc(func(r *Reader) {
+		r.streaming = true
+	})
+}
+
+// WithPooling makes the reader decode documents as WithStreaming does,
+// reusing the buffers of one document for the next instead of allocating
+// them anew: the element buffer, the member buffer shared by the objects
+// of an element and the table its strings are interned in. It suits
+// services parsing many documents with one Reader; the buffers are shared
+// safely between concurrent reads.
+func WithPooling() Option {
+	return optionFunc(func(r *Reader) {
+		r.streaming = true
+		r.pool = &sync.Pool{}
+	})
+}
+
+// WithIndexes makes the reader build only the given indexes of the
+// documents it reads, saving the time and memory of the others. The ID
+// lookup methods of a Document scan its typed slices when an index was not
+// built, but code reading the maps directly sees them nil. Skipped indexes
+// can still be built on demand with Document.BuildIndexes.
+//
+//	reader := parse.NewReader(parse.WithIndexes(parse.IndexSoftware, parse.IndexRelationships))
+func WithIndexes(indexes ...Index) Option {
+	return optionFunc(func(r *Reader) {
+		r.indexes = 0
+		for _, index := range indexes {
+			r.indexes |= index
+		}
+	})
+}
+
+// WithLogger sets the logger the reader reports to: at debug level the
+// graph entries it skips or coerces and the JSON-LD contexts it fetches,
+// and as warnings the elements of unknown types it drops, duplicate SPDX
+// IDs and contexts that could not be fetched. By default nothing is
+// logged.
+func WithLogger(logger *slog.Logger) Option {
+	return optionFunc(func(r *Reader) {
+		r.logger = logger
+	})
+}
+
 // NewReader creates a new SPDX JSON-LD reader with the given options.
 func NewReader(opts ...Option) *Reader {
 	r := &Reader{
-		processor: jsonld.NewProcessor(jsonld.NewFallbackLoader()),
-		parser:    parser.NewElementParser(),
-		fileRead:  os.ReadFile,
+		parser:   parser.NewElementParser(),
+		fileRead: os.ReadFile,
+		fileStat: os.Stat,
+		registry: defaultRegistry,
+		indexes:  AllIndexes,
+		logger:   slog.New(slog.DiscardHandler),
 	}
 
 	for _, opt := range opts {
 		opt.apply(r)
 	}
+	if r.processor == nil {
+		r.processor = newProcessor(r.logger)
+	}
 
 	return r
 }
 
 // ReadFile reads and parses an SPDX JSON-LD file from the given path.
+// Files larger than the MaxDocumentSize of the reader, as their file
+// system reports their size, are not read.
 func (r *Reader) ReadFile(filePath string) (*Document, error) {
+	if info, err := r.fileStat(filePath); err == nil && info.Mode().IsRegular() {
+		if err := r.limits.checkSize(info.Size()); err != nil {
+			return nil, err
+		}
+	}
 	data, err := r.fileRead(filePath)
 	if err != nil {
 		return nil, fmt.Errorf("reading file: %w", err)
 	}
 
-	return r.Read(data)
+	return r.ReadSource(filePath, data)
+}
+
+// ReadFS reads and parses an SPDX JSON-LD file from fsys, whatever file
+// system the reader is rooted in.
+func (r *Reader) ReadFS(fsys fs.FS, filePath string) (*Document, error) {
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(r.limits.limitReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+
+	return r.ReadSource(filePath, data)
 }
 
 // FromReader reads and parses an SPDX JSON-LD document from an io.Reader.
 func (r *Reader) FromReader(reader io.Reader) (*Document, error) {
-	data, err := io.ReadAll(reader)
+	if r.streaming {
+		return r.decode(json.NewDecoder(r.limits.limitReader(reader)), "")
+	}
+	data, err := io.ReadAll(r.limits.limitReader(reader))
 	if err != nil {
 		return nil, fmt.Errorf("reading input: %w", err)
 	}
@@ -93,12 +236,43 @@ func (r *Reader) FromReader(reader io.Reader) (*Document, error) {
 
 // Read parses SPDX JSON-LD data from bytes.
 func (r *Reader) Read(data []byte) (*Document, error) {
+	return r.ReadSource("", data)
+}
+
+// ReadSource parses SPDX JSON-LD data from bytes read from source, such
+// as the URL the data was fetched from, which readers WithProvenance
+// record as the Source of its elements.
+func (r *Reader) ReadSource(source string, data []byte) (*Document, error) {
+	if err := r.limits.checkSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	if r.streaming {
+		return r.decode(json.NewDecoder(bytes.NewReader(data)), source)
+	}
+	if err := r.limits.checkJSON(data, 0); err != nil {
+		return nil, err
+	}
+	p := r.startProgress(PhaseDecode, len(data))
 	var rawDoc interface{}
 	if err := json.Unmarshal(data, &rawDoc); err != nil {
 		return nil, fmt.Errorf("parsing JSON: %w", err)
 	}
+	p.finish(len(data))
 
-	return r.parse(rawDoc)
+	doc, err := r.parse(rawDoc)
+	if err != nil {
+		return nil, err
+	}
+	r.startProvenance(doc, source)
+	r.startRaw(doc)
+	if doc.provenance != nil || doc.raw != nil {
+		graph, _ := rawDoc.(map[string]interface{})["@graph"].([]interface{})
+		if err := doc.recordGraph(data, graph); err != nil {
+			return nil, err
+		}
+		doc.finishProvenance()
+	}
+	return doc, nil
 }
 
 // parse processes the raw JSON-LD document.
@@ -108,45 +282,7 @@ func (r *Reader) parse(rawDoc interface{}) (*Document, error) {
 		return nil, fmt.Errorf("document is not a JSON object")
 	}
 
-	doc := &Document{
-		ElementsByID:                             make(map[string]interface{}),
-		RelationshipsFromIndex:                   make(map[string][]*spdx.Relationship),
-		RelationshipsToIndex:                     make(map[string][]*spdx.Relationship),
-		PackagesByID:                             make(map[string]*spdx.Package),
-		FilesByID:                                make(map[string]*spdx.File),
-		OrganizationsByID:                        make(map[string]*spdx.Organization),
-		PersonsByID:                              make(map[string]*spdx.Person),
-		SoftwareAgentsByID:                       make(map[string]*spdx.SoftwareAgent),
-		ToolsByID:                                make(map[string]*spdx.Tool),
-		AnyLicenseInfosByID:                      make(map[string]*spdx.AnyLicenseInfo),
-		ConjunctiveLicenseSetsByID:               make(map[string]*spdx.ConjunctiveLicenseSet),
-		CustomLicensesByID:                       make(map[string]*spdx.CustomLicense),
-		CustomLicenseAdditionsByID:               make(map[string]*spdx.CustomLicenseAddition),
-		DisjunctiveLicenseSetsByID:               make(map[string]*spdx.DisjunctiveLicenseSet),
-		IndividualLicensingInfosByID:             make(map[string]*spdx.IndividualLicensingInfo),
-		ListedLicensesByID:                       make(map[string]*spdx.ListedLicense),
-		ListedLicenseExceptionsByID:              make(map[string]*spdx.ListedLicenseException),
-		LicenseExpressionsByID:                   make(map[string]*spdx.LicenseExpression),
-		OrLaterOperatorsByID:                     make(map[string]*spdx.OrLaterOperator),
-		SimpleLicensingTextsByID:                 make(map[string]*spdx.SimpleLicensingText),
-		WithAdditionOperatorsByID:                make(map[string]*spdx.WithAdditionOperator),
-		VulnerabilitiesByID:                      make(map[string]*spdx.Vulnerability),
-		CvssV2VulnAssessmentsByID:                make(map[string]*spdx.CvssV2VulnAssessmentRelationship),
-		CvssV3VulnAssessmentsByID:                make(map[string]*spdx.CvssV3VulnAssessmentRelationship),
-		CvssV4VulnAssessmentsByID:                make(map[string]*spdx.CvssV4VulnAssessmentRelationship),
-		EpssVulnAssessmentsByID:                  make(map[string]*spdx.EpssVulnAssessmentRelationship),
-		SsvcVulnAssessmentsByID:                  make(map[string]*spdx.SsvcVulnAssessmentRelationship),
-		ExploitCatalogVulnAssessmentsByID:        make(map[string]*spdx.ExploitCatalogVulnAssessmentRelationship),
-		VexAffectedVulnAssessmentsByID:           make(map[string]*spdx.VexAffectedVulnAssessmentRelationship),
-		VexFixedVulnAssessmentsByID:              make(map[string]*spdx.VexFixedVulnAssessmentRelationship),
-		VexNotAffectedVulnAssessmentsByID:        make(map[string]*spdx.VexNotAffectedVulnAssessmentRelationship),
-		VexUnderInvestigationVulnAssessmentsByID: make(map[string]*spdx.VexUnderInvestigationVulnAssessmentRelationship),
-		AiPackagesByID:                           make(map[string]*spdx.AIPackage),
-		EnergyConsumptionsByID:                   make(map[string]*spdx.EnergyConsumption),
-		EnergyConsumptionDescriptionsByID:        make(map[string]*spdx.EnergyConsumptionDescription),
-		DatasetPackagesByID:                      make(map[string]*spdx.DatasetPackage),
-		BuildsByID:                               make(map[string]*spdx.Build),
-	}
+	doc := newDocument()
 
 	// Extract @context
 	if ctx, ok := docMap["@context"]; ok {
@@ -158,11 +294,18 @@ func (r *Reader) parse(rawDoc interface{}) (*Document, error) {
 	if !ok {
 		return nil, fmt.Errorf("document does not contain @graph array")
 	}
+	if err := r.limits.checkElements(len(graph)); err != nil {
+		return nil, err
+	}
 
 	// First pass: categorize and count elements
-	for _, elem := range graph {
+	positions := make(map[string]int64, len(graph))
+	p := r.startProgress(PhaseElements, len(graph))
+	for i, elem := range graph {
+		p.update(i)
 		elemMap, ok := elem.(map[string]interface{})
 		if !ok {
+			r.logger.Debug("skipping graph entry that is not an object", "index", i)
 			continue
 		}
 
@@ -170,26 +313,59 @@ func (r *Reader) parse(rawDoc interface{}) (*Document, error) {
 
 		// Get SPDX ID if available
 		if spdxID, ok := elemMap["spdxId"].(string); ok {
+			r.checkDuplicate(doc, spdxID)
 			doc.ElementsByID[spdxID] = elemMap
+			positions[spdxID] = int64(i)
 		}
 
 		// Parse and categorize by type
-		r.categorizeElement(doc, elemMap, elemType)
-	}
-
-	// Build relationship indexes for O(1) lookups
-	for _, rel := range doc.Relationships {
-		fromID := rel.From.GetSpdxID()
-		doc.RelationshipsFromIndex[fromID] = append(doc.RelationshipsFromIndex[fromID], rel)
-		for _, to := range rel.To {
-			toID := to.GetSpdxID()
-			doc.RelationshipsToIndex[toID] = append(doc.RelationshipsToIndex[toID], rel)
+		if err := r.categorizeElement(doc, elemMap, elemType); err != nil {
+			return nil, err
 		}
 	}
 
+	p.finish(len(graph))
+
+	doc.orderGraph(positions)
+	r.normalizeTimestamps(doc)
+	r.indexDocument(doc)
+	r.logger.Debug("read SPDX document", "elements", len(graph))
 	return doc, nil
 }
 
+// newDocument returns a document to read a graph into. The ID and
+// relationship indexes are left nil, to be built by buildIndexes once the
+// graph is read; only the indexes of objects that do not carry their ID,
+// and ElementsByID and ExtensionsByID, are filled while reading.
+func newDocument() *Document {
+	return &Document{
+		ElementsByID:                      make(map[string]interface{}),
+		EnergyConsumptionsByID:            make(map[string]*spdx.EnergyConsumption),
+		EnergyConsumptionDescriptionsByID: make(map[string]*spdx.EnergyConsumptionDescription),
+		ExtensionsByID:                    make(map[string]spdx.AnyElement),
+	}
+}
+
+// orderGraph fills the Graph of a document with its elements, ordered by
+// the positions of their IDs in the @graph array. Elements without a
+// position follow in the order of AllElements.
+func (d *Document) orderGraph(positions map[string]int64) {
+	d.Graph = slices.Collect(d.AllElements())
+	slices.SortStableFunc(d.Graph, func(a, b spdx.ElementInterface) int {
+		pa, okA := positions[a.GetSpdxID()]
+		pb, okB := positions[b.GetSpdxID()]
+		switch {
+		case okA && okB:
+			return cmp.Compare(pa, pb)
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+		return 0
+	})
+}
+
 // parseContext extracts context URLs from the @context field.
 func (r *Reader) parseContext(ctx interface{}) []string {
 	var contexts []string
@@ -216,293 +392,269 @@ func (r *Reader) getElementType(elemMap map[string]interface{}) ElementType {
 	return ""
 }
 
-// categorizeElement parses and categorizes an element based on its type.
-func (r *Reader) categorizeElement(doc *Document, elemMap map[string]interface{}, elemType ElementType) {
-	if r.handleCoreElements(doc, elemMap, elemType) {
-		return
+// categorizeElement parses an element, takes the copy of the store of the
+// reader if it has one, and files it into the document by its Go type,
+// then calls the handlers of its type. Types the model does not
+// define, or that the document does not keep, are looked up in the
+// registry.
+func (r *Reader) categorizeElement(doc *Document, elemMap map[string]interface{}, elemType ElementType) error {
+	spdxID := r.parser.H.GetString(elemMap, "spdxId")
+	r.logCoercedType(elemType, spdxID)
+	obj, ok := r.parser.Parse(elemMap)
+	if ok && r.store != nil {
+		obj, elemMap = r.internMap(obj, elemMap)
+		if spdxID != "" {
+			doc.ElementsByID[spdxID] = elemMap
+		}
 	}
-	if r.handleSoftwareElements(doc, elemMap, elemType) {
-		return
+	if !ok || !r.fileElement(doc, obj, spdxID) {
+		r.logUnfiled(elemType, spdxID, ok)
+		if err := r.handleRegisteredElements(doc, elemMap, elemType); err != nil {
+			return err
+		}
 	}
-	if r.handleLicensingElements(doc, elemMap, elemType) {
-		return
+	if !ok {
+		obj = nil
 	}
-	if r.handleSecurityElements(doc, elemMap, elemType) {
-		return
+	return r.callHandlers(elemType, spdxID, obj, elemMap)
+}
+
+// logCoercedType logs elements whose type is read under another name, such
+// as the legacy names of the software profile types.
+func (r *Reader) logCoercedType(elemType ElementType, spdxID string) {
+	if compact := parser.CompactType(string(elemType)); compact != string(elemType) {
+		r.logger.Debug("reading element type under its compact name", "type", elemType, "compact", compact, "spdxId", spdxID)
 	}
-	// Add new handlers here
-	if r.handleAiElements(doc, elemMap, elemType) {
+}
+
+// logUnfiled logs an element that the document does not keep in its typed
+// slices, unless a registered type or a handler takes it. known reports
+// whether the model defines its type.
+func (r *Reader) logUnfiled(elemType ElementType, spdxID string, known bool) {
+	if _, registered := r.lookup(elemType); registered || r.handles(elemType) {
 		return
 	}
-	if r.handleDatasetElements(doc, elemMap, elemType) {
-		return
+	if known {
+		r.logger.Debug("skipping element of a type the document does not keep", "type", elemType, "spdxId", spdxID)
+	} else {
+		r.logger.Warn("skipping element of unknown type", "type", elemType, "spdxId", spdxID)
 	}
-	if r.handleBuildElements(doc, elemMap, elemType) {
-		return
+}
+
+// checkDuplicate logs an SPDX ID that an earlier graph entry already had.
+func (r *Reader) checkDuplicate(doc *Document, spdxID string) {
+	if _, ok := doc.ElementsByID[spdxID]; ok {
+		r.logger.Warn("duplicate SPDX ID, the later element replaces the earlier one in ElementsByID", "spdxId", spdxID)
 	}
 }
 
-func (r *Reader) handleCoreElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	switch elemType {
-	case TypeSpdxDocument:
-		doc.SpdxDocument = r.parser.ParseSpdxDocument(elemMap)
-	case TypeRelationship:
-		doc.Relationships = append(doc.Relationships, r.parser.ParseRelationship(elemMap))
-	case TypeLifecycleScopedRelationship:
-		doc.LifecycleScopedRelationships = append(doc.LifecycleScopedRelationships, r.parser.ParseLifecycleScopedRelationship(elemMap))
-	case TypeAnnotation:
-		doc.Annotations = append(doc.Annotations, r.parser.ParseAnnotation(elemMap))
-	case TypeExternalMap:
-		doc.ExternalMaps = append(doc.ExternalMaps, r.parser.ParseExternalMap(elemMap))
-	case TypeCreationInfo:
-		doc.CreationInfo = r.parser.ParseCreationInfo(elemMap)
-	case TypeOrganization:
-		org := r.parser.ParseOrganization(elemMap)
-		doc.Organizations = append(doc.Organizations, org)
-		if org.SpdxID != "" {
-			doc.OrganizationsByID[org.SpdxID] = org
-		}
-	case TypePerson:
-		person := r.parser.ParsePerson(elemMap)
-		doc.Persons = append(doc.Persons, person)
-		if person.SpdxID != "" {
-			doc.PersonsByID[person.SpdxID] = person
-		}
-	case TypeSoftwareAgent:
-		sa := r.parser.ParseSoftwareAgent(elemMap)
-		doc.SoftwareAgents = append(doc.SoftwareAgents, sa)
-		if sa.SpdxID != "" {
-			doc.SoftwareAgentsByID[sa.SpdxID] = sa
-		}
-	case TypeTool:
-		tool := r.parser.ParseTool(elemMap)
-		doc.Tools = append(doc.Tools, tool)
-		if tool.SpdxID != "" {
-			doc.ToolsByID[tool.SpdxID] = tool
-		}
-	case TypeBom:
-		bom := r.parser.ParseBom(elemMap)
-		doc.Boms = append(doc.Boms, bom)
-	case TypeBundle:
-		bundle := r.parser.ParseBundle(elemMap)
-		doc.Bundles = append(doc.Bundles, bundle)
-	case TypeDictionaryEntry:
-		de := r.parser.ParseDictionaryEntry(elemMap)
-		doc.DictionaryEntries = append(doc.DictionaryEntries, de)
-	case TypeHash:
-		hash := r.parser.ParseHash(elemMap)
-		doc.Hashes = append(doc.Hashes, hash)
-	case TypePackageVerificationCode:
-		pvc := r.parser.ParsePackageVerificationCode(elemMap)
-		doc.PackageVerificationCodes = append(doc.PackageVerificationCodes, pvc)
+// fileElement adds a parsed object to the slices and ID indexes of the
+// document and reports whether the document keeps objects of its type.
+// spdxID is the ID of the object, which non-element objects may also carry.
+func (r *Reader) fileElement(doc *Document, obj interface{}, spdxID string) bool {
+	return r.handleCoreElements(doc, obj) ||
+		r.handleSoftwareElements(doc, obj) ||
+		r.handleLicensingElements(doc, obj) ||
+		r.handleSecurityElements(doc, obj) ||
+		// Add new handlers here
+		r.handleAiElements(doc, spdxID, obj) ||
+		r.handleDatasetElements(doc, obj) ||
+		r.handleBuildElements(doc, obj)
+}
+
+// handleRegisteredElements parses element types added through a Registry.
+// Unknown types that are not registered are ignored.
+func (r *Reader) handleRegisteredElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) error {
+	if r.registry == nil {
+		return nil
+	}
+	info, ok := r.registry.Lookup(elemType)
+	if !ok {
+		return nil
+	}
+
+	elem, err := info.Parse(elemMap)
+	if err != nil {
+		return fmt.Errorf("parsing %s element %q: %w", elemType, r.parser.H.GetString(elemMap, "spdxId"), err)
+	}
+	if elem == nil {
+		return nil
+	}
+
+	doc.Extensions = append(doc.Extensions, elem)
+	if id := elem.GetSpdxID(); id != "" {
+		doc.ExtensionsByID[id] = elem
+	}
+	return nil
+}
+
+func (r *Reader) handleCoreElements(doc *Document, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.SpdxDocument:
+		doc.SpdxDocument = o
+	case *spdx.Relationship:
+		doc.Relationships = append(doc.Relationships, o)
+	case *spdx.LifecycleScopedRelationship:
+		doc.LifecycleScopedRelationships = append(doc.LifecycleScopedRelationships, o)
+	case *spdx.Annotation:
+		doc.Annotations = append(doc.Annotations, o)
+	case *spdx.ExternalMap:
+		doc.ExternalMaps = append(doc.ExternalMaps, o)
+	case *spdx.CreationInfo:
+		doc.CreationInfo = o
+	case *spdx.Agent:
+		doc.Agents = append(doc.Agents, o)
+		addToIndex(doc.AgentsByID, o.SpdxID, o)
+	case *spdx.Organization:
+		doc.Organizations = append(doc.Organizations, o)
+		addToIndex(doc.OrganizationsByID, o.SpdxID, o)
+	case *spdx.Person:
+		doc.Persons = append(doc.Persons, o)
+		addToIndex(doc.PersonsByID, o.SpdxID, o)
+	case *spdx.SoftwareAgent:
+		doc.SoftwareAgents = append(doc.SoftwareAgents, o)
+		addToIndex(doc.SoftwareAgentsByID, o.SpdxID, o)
+	case *spdx.Tool:
+		doc.Tools = append(doc.Tools, o)
+		addToIndex(doc.ToolsByID, o.SpdxID, o)
+	case *spdx.Bom:
+		doc.Boms = append(doc.Boms, o)
+	case *spdx.Bundle:
+		doc.Bundles = append(doc.Bundles, o)
+	case *spdx.DictionaryEntry:
+		doc.DictionaryEntries = append(doc.DictionaryEntries, o)
+	case *spdx.Hash:
+		doc.Hashes = append(doc.Hashes, o)
+	case *spdx.PackageVerificationCode:
+		doc.PackageVerificationCodes = append(doc.PackageVerificationCodes, o)
 	default:
 		return false
 	}
 	return true
 }
 
-func (r *Reader) handleSoftwareElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	switch elemType {
-	case TypeSoftwarePackage:
-		pkg := r.parser.ParsePackage(elemMap)
-		doc.Packages = append(doc.Packages, pkg)
-		if pkg.SpdxID != "" {
-			doc.PackagesByID[pkg.SpdxID] = pkg
-		}
-	case TypeSoftwareFile:
-		file := r.parser.ParseFile(elemMap)
-		doc.Files = append(doc.Files, file)
-		if file.SpdxID != "" {
-			doc.FilesByID[file.SpdxID] = file
-		}
-	case TypeSoftwareSnippet:
-		doc.Snippets = append(doc.Snippets, r.parser.ParseSnippet(elemMap))
-	case TypeSoftwareSbom:
-		sbom := r.parser.ParseSbom(elemMap)
-		doc.Boms = append(doc.Boms, &sbom.Bom)
+func (r *Reader) handleSoftwareElements(doc *Document, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.Package:
+		doc.Packages = append(doc.Packages, o)
+		addToIndex(doc.PackagesByID, o.SpdxID, o)
+	case *spdx.File:
+		doc.Files = append(doc.Files, o)
+		addToIndex(doc.FilesByID, o.SpdxID, o)
+	case *spdx.Snippet:
+		doc.Snippets = append(doc.Snippets, o)
+	case *spdx.Sbom:
+		doc.Boms = append(doc.Boms, &o.Bom)
 	default:
 		return false
 	}
 	return true
 }
 
-func (r *Reader) handleLicensingElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	spdxID := r.parser.H.GetString(elemMap, "spdxId")
-
-	switch elemType {
-	case TypeAnyLicenseInfo:
-		lic := r.parser.ParseAnyLicenseInfo(elemMap)
-		doc.AnyLicenseInfos = append(doc.AnyLicenseInfos, lic)
-		if spdxID != "" {
-			doc.AnyLicenseInfosByID[spdxID] = lic
-		}
-	case TypeConjunctiveLicenseSet:
-		cls := r.parser.ParseConjunctiveLicenseSet(elemMap)
-		doc.ConjunctiveLicenseSets = append(doc.ConjunctiveLicenseSets, cls)
-		if spdxID != "" {
-			doc.ConjunctiveLicenseSetsByID[spdxID] = cls
-		}
-	case TypeCustomLicense:
-		cl := r.parser.ParseCustomLicense(elemMap)
-		doc.CustomLicenses = append(doc.CustomLicenses, cl)
-		if spdxID != "" {
-			doc.CustomLicensesByID[spdxID] = cl
-		}
-	case TypeLicenseAddition:
-		cla := r.parser.ParseCustomLicenseAddition(elemMap)
-		doc.CustomLicenseAdditions = append(doc.CustomLicenseAdditions, cla)
-		if spdxID != "" {
-			doc.CustomLicenseAdditionsByID[spdxID] = cla
-		}
-	case TypeDisjunctiveLicenseSet:
-		dls := r.parser.ParseDisjunctiveLicenseSet(elemMap)
-		doc.DisjunctiveLicenseSets = append(doc.DisjunctiveLicenseSets, dls)
-		if spdxID != "" {
-			doc.DisjunctiveLicenseSetsByID[spdxID] = dls
-		}
-	case TypeIndividualLicensingInfo: // Moved from handleCoreElements
-		ili := r.parser.ParseIndividualLicensingInfo(elemMap)
-		doc.IndividualLicensingInfos = append(doc.IndividualLicensingInfos, ili)
-		if spdxID != "" {
-			doc.IndividualLicensingInfosByID[spdxID] = ili
-		}
-	case TypeListedLicense:
-		ll := r.parser.ParseListedLicense(elemMap)
-		doc.ListedLicenses = append(doc.ListedLicenses, ll)
-		if spdxID != "" {
-			doc.ListedLicensesByID[spdxID] = ll
-		}
-	case TypeListedLicenseException:
-		lle := r.parser.ParseListedLicenseException(elemMap)
-		doc.ListedLicenseExceptions = append(doc.ListedLicenseExceptions, lle)
-		if spdxID != "" {
-			doc.ListedLicenseExceptionsByID[spdxID] = lle
-		}
-	case TypeLicenseExpression, TypeSimpleLicensingExpression:
-		le := r.parser.ParseLicenseExpression(elemMap)
-		doc.LicenseExpressions = append(doc.LicenseExpressions, le)
-		if spdxID != "" {
-			doc.LicenseExpressionsByID[spdxID] = le
-		}
-	case TypeOrLaterOperator:
-		olo := r.parser.ParseOrLaterOperator(elemMap)
-		doc.OrLaterOperators = append(doc.OrLaterOperators, olo)
-		if spdxID != "" {
-			doc.OrLaterOperatorsByID[spdxID] = olo
-		}
-	case TypeSimpleLicensingText:
-		slt := r.parser.ParseSimpleLicensingText(elemMap)
-		doc.SimpleLicensingTexts = append(doc.SimpleLicensingTexts, slt)
-		if spdxID != "" {
-			doc.SimpleLicensingTextsByID[spdxID] = slt
-		}
-	case TypeWithAdditionOperator:
-		wao := r.parser.ParseWithAdditionOperator(elemMap)
-		doc.WithAdditionOperators = append(doc.WithAdditionOperators, wao)
-		if spdxID != "" {
-			doc.WithAdditionOperatorsByID[spdxID] = wao
-		}
+func (r *Reader) handleLicensingElements(doc *Document, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.AnyLicenseInfo:
+		doc.AnyLicenseInfos = append(doc.AnyLicenseInfos, o)
+		addToIndex(doc.AnyLicenseInfosByID, o.SpdxID, o)
+	case *spdx.ConjunctiveLicenseSet:
+		doc.ConjunctiveLicenseSets = append(doc.ConjunctiveLicenseSets, o)
+		addToIndex(doc.ConjunctiveLicenseSetsByID, o.SpdxID, o)
+	case *spdx.CustomLicense:
+		doc.CustomLicenses = append(doc.CustomLicenses, o)
+		addToIndex(doc.CustomLicensesByID, o.SpdxID, o)
+	case *spdx.LicenseAddition:
+		// LicenseAddition is abstract; keep it with the custom additions.
+		return r.handleLicensingElements(doc, &spdx.CustomLicenseAddition{LicenseAddition: *o})
+	case *spdx.CustomLicenseAddition:
+		doc.CustomLicenseAdditions = append(doc.CustomLicenseAdditions, o)
+		addToIndex(doc.CustomLicenseAdditionsByID, o.SpdxID, o)
+	case *spdx.DisjunctiveLicenseSet:
+		doc.DisjunctiveLicenseSets = append(doc.DisjunctiveLicenseSets, o)
+		addToIndex(doc.DisjunctiveLicenseSetsByID, o.SpdxID, o)
+	case *spdx.IndividualLicensingInfo:
+		doc.IndividualLicensingInfos = append(doc.IndividualLicensingInfos, o)
+		addToIndex(doc.IndividualLicensingInfosByID, o.SpdxID, o)
+	case *spdx.ListedLicense:
+		doc.ListedLicenses = append(doc.ListedLicenses, o)
+		addToIndex(doc.ListedLicensesByID, o.SpdxID, o)
+	case *spdx.ListedLicenseException:
+		doc.ListedLicenseExceptions = append(doc.ListedLicenseExceptions, o)
+		addToIndex(doc.ListedLicenseExceptionsByID, o.SpdxID, o)
+	case *spdx.LicenseExpression:
+		doc.LicenseExpressions = append(doc.LicenseExpressions, o)
+		addToIndex(doc.LicenseExpressionsByID, o.SpdxID, o)
+	case *spdx.OrLaterOperator:
+		doc.OrLaterOperators = append(doc.OrLaterOperators, o)
+		addToIndex(doc.OrLaterOperatorsByID, o.SpdxID, o)
+	case *spdx.SimpleLicensingText:
+		doc.SimpleLicensingTexts = append(doc.SimpleLicensingTexts, o)
+		addToIndex(doc.SimpleLicensingTextsByID, o.SpdxID, o)
+	case *spdx.WithAdditionOperator:
+		doc.WithAdditionOperators = append(doc.WithAdditionOperators, o)
+		addToIndex(doc.WithAdditionOperatorsByID, o.SpdxID, o)
 	default:
 		return false
 	}
 	return true
 }
 
-func (r *Reader) handleSecurityElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	switch elemType {
-	case TypeVulnerability:
-		vuln := r.parser.ParseVulnerability(elemMap)
-		doc.Vulnerabilities = append(doc.Vulnerabilities, vuln)
-		if vuln.SpdxID != "" {
-			doc.VulnerabilitiesByID[vuln.SpdxID] = vuln
-		}
-	case TypeCvssV2VulnAssessment:
-		cvss2 := r.parser.ParseCvssV2VulnAssessmentRelationship(elemMap)
-		doc.CvssV2VulnAssessments = append(doc.CvssV2VulnAssessments, cvss2)
-		if cvss2.SpdxID != "" {
-			doc.CvssV2VulnAssessmentsByID[cvss2.SpdxID] = cvss2
-		}
-	case TypeCvssV3VulnAssessment:
-		cvss3 := r.parser.ParseCvssV3VulnAssessmentRelationship(elemMap)
-		doc.CvssV3VulnAssessments = append(doc.CvssV3VulnAssessments, cvss3)
-		if cvss3.SpdxID != "" {
-			doc.CvssV3VulnAssessmentsByID[cvss3.SpdxID] = cvss3
-		}
-	case TypeCvssV4VulnAssessment:
-		cvss4 := r.parser.ParseCvssV4VulnAssessmentRelationship(elemMap)
-		doc.CvssV4VulnAssessments = append(doc.CvssV4VulnAssessments, cvss4)
-		if cvss4.SpdxID != "" {
-			doc.CvssV4VulnAssessmentsByID[cvss4.SpdxID] = cvss4
-		}
-	case TypeEpssVulnAssessment:
-		epss := r.parser.ParseEpssVulnAssessmentRelationship(elemMap)
-		doc.EpssVulnAssessments = append(doc.EpssVulnAssessments, epss)
-		if epss.SpdxID != "" {
-			doc.EpssVulnAssessmentsByID[epss.SpdxID] = epss
-		}
-	case TypeSsvcVulnAssessment:
-		ssvc := r.parser.ParseSsvcVulnAssessmentRelationship(elemMap)
-		doc.SsvcVulnAssessments = append(doc.SsvcVulnAssessments, ssvc)
-		if ssvc.SpdxID != "" {
-			doc.SsvcVulnAssessmentsByID[ssvc.SpdxID] = ssvc
-		}
-	case TypeExploitCatalogVulnAssessment:
-		ec := r.parser.ParseExploitCatalogVulnAssessmentRelationship(elemMap)
-		doc.ExploitCatalogVulnAssessments = append(doc.ExploitCatalogVulnAssessments, ec)
-		if ec.SpdxID != "" {
-			doc.ExploitCatalogVulnAssessmentsByID[ec.SpdxID] = ec
-		}
-	case TypeVexAffectedVulnAssessment:
-		vexAffected := r.parser.ParseVexAffectedVulnAssessmentRelationship(elemMap)
-		doc.VexAffectedVulnAssessments = append(doc.VexAffectedVulnAssessments, vexAffected)
-		if vexAffected.SpdxID != "" {
-			doc.VexAffectedVulnAssessmentsByID[vexAffected.SpdxID] = vexAffected
-		}
-	case TypeVexFixedVulnAssessment:
-		vexFixed := r.parser.ParseVexFixedVulnAssessmentRelationship(elemMap)
-		doc.VexFixedVulnAssessments = append(doc.VexFixedVulnAssessments, vexFixed)
-		if vexFixed.SpdxID != "" {
-			doc.VexFixedVulnAssessmentsByID[vexFixed.SpdxID] = vexFixed
-		}
-	case TypeVexNotAffectedVulnAssessment:
-		vexNotAffected := r.parser.ParseVexNotAffectedVulnAssessmentRelationship(elemMap)
-		doc.VexNotAffectedVulnAssessments = append(doc.VexNotAffectedVulnAssessments, vexNotAffected)
-		if vexNotAffected.SpdxID != "" {
-			doc.VexNotAffectedVulnAssessmentsByID[vexNotAffected.SpdxID] = vexNotAffected
-		}
-	case TypeVexUnderInvestigationVulnAssessment:
-		vexUnderInvestigation := r.parser.ParseVexUnderInvestigationVulnAssessmentRelationship(elemMap)
-		doc.VexUnderInvestigationVulnAssessments = append(doc.VexUnderInvestigationVulnAssessments, vexUnderInvestigation)
-		if vexUnderInvestigation.SpdxID != "" {
-			doc.VexUnderInvestigationVulnAssessmentsByID[vexUnderInvestigation.SpdxID] = vexUnderInvestigation
-		}
+func (r *Reader) handleSecurityElements(doc *Document, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.Vulnerability:
+		doc.Vulnerabilities = append(doc.Vulnerabilities, o)
+		addToIndex(doc.VulnerabilitiesByID, o.SpdxID, o)
+	case *spdx.CvssV2VulnAssessmentRelationship:
+		doc.CvssV2VulnAssessments = append(doc.CvssV2VulnAssessments, o)
+		addToIndex(doc.CvssV2VulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.CvssV3VulnAssessmentRelationship:
+		doc.CvssV3VulnAssessments = append(doc.CvssV3VulnAssessments, o)
+		addToIndex(doc.CvssV3VulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.CvssV4VulnAssessmentRelationship:
+		doc.CvssV4VulnAssessments = append(doc.CvssV4VulnAssessments, o)
+		addToIndex(doc.CvssV4VulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.EpssVulnAssessmentRelationship:
+		doc.EpssVulnAssessments = append(doc.EpssVulnAssessments, o)
+		addToIndex(doc.EpssVulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.SsvcVulnAssessmentRelationship:
+		doc.SsvcVulnAssessments = append(doc.SsvcVulnAssessments, o)
+		addToIndex(doc.SsvcVulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.ExploitCatalogVulnAssessmentRelationship:
+		doc.ExploitCatalogVulnAssessments = append(doc.ExploitCatalogVulnAssessments, o)
+		addToIndex(doc.ExploitCatalogVulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.VexAffectedVulnAssessmentRelationship:
+		doc.VexAffectedVulnAssessments = append(doc.VexAffectedVulnAssessments, o)
+		addToIndex(doc.VexAffectedVulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.VexFixedVulnAssessmentRelationship:
+		doc.VexFixedVulnAssessments = append(doc.VexFixedVulnAssessments, o)
+		addToIndex(doc.VexFixedVulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.VexNotAffectedVulnAssessmentRelationship:
+		doc.VexNotAffectedVulnAssessments = append(doc.VexNotAffectedVulnAssessments, o)
+		addToIndex(doc.VexNotAffectedVulnAssessmentsByID, o.SpdxID, o)
+	case *spdx.VexUnderInvestigationVulnAssessmentRelationship:
+		doc.VexUnderInvestigationVulnAssessments = append(doc.VexUnderInvestigationVulnAssessments, o)
+		addToIndex(doc.VexUnderInvestigationVulnAssessmentsByID, o.SpdxID, o)
 	default:
 		return false
 	}
 	return true
 }
 
-func (r *Reader) handleAiElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	spdxID := r.parser.H.GetString(elemMap, "spdxId")
-
-	switch elemType {
-	case TypeAIPackage:
-		aiPkg := r.parser.ParseAIPackage(elemMap)
-		doc.AiPackages = append(doc.AiPackages, aiPkg)
-		if spdxID != "" {
-			doc.AiPackagesByID[spdxID] = aiPkg
-		}
-	case TypeEnergyConsumption:
-		ec := r.parser.ParseEnergyConsumption(elemMap)
-		doc.EnergyConsumptions = append(doc.EnergyConsumptions, ec)
+// handleAiElements files AI objects. Energy consumption objects are not
+// elements but may carry an ID.
+func (r *Reader) handleAiElements(doc *Document, spdxID string, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.AIPackage:
+		doc.AiPackages = append(doc.AiPackages, o)
+		addToIndex(doc.AiPackagesByID, o.SpdxID, o)
+	case *spdx.EnergyConsumption:
+		doc.EnergyConsumptions = append(doc.EnergyConsumptions, o)
 		if spdxID != "" {
-			doc.EnergyConsumptionsByID[spdxID] = ec
+			doc.EnergyConsumptionsByID[spdxID] = o
 		}
-	case TypeEnergyConsumptionDescription:
-		ecd := r.parser.ParseEnergyConsumptionDescription(elemMap)
-		doc.EnergyConsumptionDescriptions = append(doc.EnergyConsumptionDescriptions, ecd)
+	case *spdx.EnergyConsumptionDescription:
+		doc.EnergyConsumptionDescriptions = append(doc.EnergyConsumptionDescriptions, o)
 		if spdxID != "" {
-			doc.EnergyConsumptionDescriptionsByID[spdxID] = ecd
+			doc.EnergyConsumptionDescriptionsByID[spdxID] = o
 		}
 	default:
 		return false
@@ -510,32 +662,22 @@ func (r *Reader) handleAiElements(doc *Document, elemMap map[string]interface{},
 	return true
 }
 
-func (r *Reader) handleDatasetElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	spdxID := r.parser.H.GetString(elemMap, "spdxId")
-
-	switch elemType {
-	case TypeDataset: // Note: uses TypeDataset but parses into DatasetPackage
-		datasetPkg := r.parser.ParseDatasetPackage(elemMap)
-		doc.DatasetPackages = append(doc.DatasetPackages, datasetPkg)
-		if spdxID != "" {
-			doc.DatasetPackagesByID[spdxID] = datasetPkg
-		}
+func (r *Reader) handleDatasetElements(doc *Document, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.DatasetPackage:
+		doc.DatasetPackages = append(doc.DatasetPackages, o)
+		addToIndex(doc.DatasetPackagesByID, o.SpdxID, o)
 	default:
 		return false
 	}
 	return true
 }
 
-func (r *Reader) handleBuildElements(doc *Document, elemMap map[string]interface{}, elemType ElementType) bool {
-	spdxID := r.parser.H.GetString(elemMap, "spdxId")
-
-	switch elemType {
-	case TypeBuild:
-		build := r.parser.ParseBuild(elemMap)
-		doc.Builds = append(doc.Builds, build)
-		if spdxID != "" {
-			doc.BuildsByID[spdxID] = build
-		}
+func (r *Reader) handleBuildElements(doc *Document, obj interface{}) bool {
+	switch o := obj.(type) {
+	case *spdx.Build:
+		doc.Builds = append(doc.Builds, o)
+		addToIndex(doc.BuildsByID, o.SpdxID, o)
 	default:
 		return false
 	}
@@ -586,3 +728,31 @@ func (r *Reader) Compact(data []byte, context interface{}) (interface{}, error)
 
 	return compacted, nil
 }
+
+// Canonicalize returns the RDF dataset of the document in canonical N-Quads
+// form, per the RDFC-1.0 (URDNA2015) algorithm: the same for any JSON-LD
+// serialization of the same graph, whatever the order of its elements and
+// properties, its whitespace, or whether nodes are nested or referenced.
+// Signatures computed over it survive re-serialization by other tools.
+//
+// The elements with the IDs in exclude are left out, along with
+// the statements referring to them and the blank nodes only they refer
+// to, e.g. signatures embedded in the document. Documents using terms their
+// context does not define cannot be canonicalized.
+func (r *Reader) Canonicalize(data []byte, exclude ...string) ([]byte, error) {
+	var rawDoc interface{}
+	if err := json.Unmarshal(data, &rawDoc); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+
+	var skip func(string) bool
+	if len(exclude) > 0 {
+		skip = func(iri string) bool { return slices.Contains(exclude, iri) }
+	}
+	canonical, err := r.processor.Canonicalize(rawDoc, skip)
+	if err != nil {
+		return nil, fmt.Errorf("canonicalizing JSON-LD: %w", err)
+	}
+
+	return []byte(canonical), nil
+}
@@ -1,9 +1,19 @@
 package parse_test
 
 import (
+	"bytes"
 	"errors"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
 	"github.com/interlynk-io/spdx-zen/parse"
 )
 
@@ -158,6 +168,46 @@ func TestReader_ReadFile(t *testing.T) {
 	})
 }
 
+func TestReader_ReadFS(t *testing.T) {
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`
+	fsys := fstest.MapFS{
+		"sboms/app.spdx.json": &fstest.MapFile{Data: watchDoc(app), ModTime: time.Unix(1, 0)},
+	}
+
+	doc, err := parse.NewReader().ReadFS(fsys, "sboms/app.spdx.json")
+	if err != nil {
+		t.Fatalf("ReadFS() error = %v", err)
+	}
+	if doc.GetPackageByID("urn:spdx:app") == nil {
+		t.Errorf("ReadFS() document lacks urn:spdx:app")
+	}
+	if _, err := parse.NewReader().ReadFS(fsys, "sboms/missing.json"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFS() of a missing file error = %v, want fs.ErrNotExist", err)
+	}
+
+	reader := parse.NewReader(parse.WithFS(fsys))
+	if _, err := reader.ReadFile("sboms/app.spdx.json"); err != nil {
+		t.Errorf("ReadFile() WithFS error = %v", err)
+	}
+	if _, err := reader.ReadFile("reader_test.go"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile() WithFS read a local file: %v", err)
+	}
+
+	w, err := reader.WatchFile("sboms/app.spdx.json")
+	if err != nil {
+		t.Fatalf("WatchFile() WithFS error = %v", err)
+	}
+	fsys["sboms/app.spdx.json"] = &fstest.MapFile{Data: watchDoc(app, lib), ModTime: time.Unix(2, 0)}
+	changes, err := w.Reload()
+	if err != nil {
+		t.Fatalf("Reload() WithFS error = %v", err)
+	}
+	if len(changes.Added) != 1 || changes.Added[0] != "urn:spdx:lib" {
+		t.Errorf("Reload() WithFS = %+v, want urn:spdx:lib added", changes)
+	}
+}
+
 func TestDocument_GetMethods(t *testing.T) {
 	docJSON := `{
 		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
@@ -234,6 +284,522 @@ func TestDocument_GetMethods(t *testing.T) {
 	})
 }
 
+func TestDocument_GetPrimaryComponent(t *testing.T) {
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`
+	model := `{"type": "ai_AIPackage", "spdxId": "urn:spdx:model", "name": "model"}`
+	file := `{"type": "software_File", "spdxId": "urn:spdx:file", "name": "main.go"}`
+
+	tests := []struct {
+		name      string
+		entries   []string
+		want      string
+		wantRoots []string
+	}{
+		{"sbom root", []string{app, lib, file,
+			`{"type": "software_Sbom", "spdxId": "urn:spdx:sbom", "rootElement": ["urn:spdx:file", "urn:spdx:lib"]}`,
+			`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "rootElement": ["urn:spdx:app"]}`,
+		}, "urn:spdx:lib", []string{"urn:spdx:file", "urn:spdx:lib"}},
+		{"document root", []string{app, lib,
+			`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "rootElement": ["urn:spdx:missing", "urn:spdx:app"]}`,
+		}, "urn:spdx:app", nil},
+		{"AI package root", []string{model,
+			`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "rootElement": ["urn:spdx:model"]}`,
+		}, "urn:spdx:model", nil},
+		{"describes", []string{app, lib,
+			`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc"}`,
+			`{"type": "Relationship", "spdxId": "urn:spdx:rel1", "from": "urn:spdx:app", "to": ["urn:spdx:app"], "relationshipType": "describes"}`,
+			`{"type": "Relationship", "spdxId": "urn:spdx:rel2", "from": "urn:spdx:doc", "to": ["urn:spdx:lib"], "relationshipType": "describes"}`,
+		}, "urn:spdx:lib", nil},
+		{"no subject", []string{app, `{"type": "SpdxDocument", "spdxId": "urn:spdx:doc"}`}, "", nil},
+		{"no document", []string{app}, "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, opts := range [][]parse.Option{nil, {parse.WithIndexes()}} {
+				doc, err := parse.NewReader(opts...).Read(watchDoc(tt.entries...))
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := ""
+				if pkg := doc.GetPrimaryComponent(); pkg != nil {
+					got = pkg.SpdxID
+				}
+				if got != tt.want {
+					t.Errorf("GetPrimaryComponent() = %q, want %q", got, tt.want)
+				}
+				if tt.wantRoots == nil {
+					continue
+				}
+				var roots []string
+				for _, elem := range doc.GetRootElements(&doc.Boms[0].ElementCollection) {
+					roots = append(roots, elem.GetSpdxID())
+				}
+				if !slices.Equal(roots, tt.wantRoots) {
+					t.Errorf("GetRootElements() = %v, want %v", roots, tt.wantRoots)
+				}
+			}
+		})
+	}
+}
+
+func TestDocument_GetCreators(t *testing.T) {
+	entries := []string{
+		`{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme"}`,
+		`{"type": "Person", "spdxId": "urn:spdx:jane", "name": "Jane Doe"}`,
+		`{"type": "Tool", "spdxId": "urn:spdx:scanner", "name": "scanner"}`,
+	}
+	ci := &spdx.CreationInfo{
+		CreatedBy: []spdx.Agent{
+			{Element: spdx.Element{SpdxID: "urn:spdx:jane"}},
+			{Element: spdx.Element{SpdxID: "urn:spdx:missing"}},
+		},
+		CreatedUsing: []spdx.Tool{
+			{Element: spdx.Element{SpdxID: "urn:spdx:scanner"}},
+			{Element: spdx.Element{SpdxID: "urn:spdx:gone"}},
+		},
+	}
+	for _, opts := range [][]parse.Option{nil, {parse.WithIndexes()}} {
+		doc, err := parse.NewReader(opts...).Read(watchDoc(entries...))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		creators := doc.GetCreators(doc.CreationInfo)
+		if len(creators) != 1 {
+			t.Fatalf("GetCreators(document) returned %d agents, want 1", len(creators))
+		}
+		if org, ok := creators[0].(*spdx.Organization); !ok || org.Name != "Acme" {
+			t.Errorf("GetCreators(document)[0] = %#v, want the Acme organization", creators[0])
+		}
+
+		creators = doc.GetCreators(ci)
+		if len(creators) != 2 {
+			t.Fatalf("GetCreators() returned %d agents, want 2", len(creators))
+		}
+		if p, ok := creators[0].(*spdx.Person); !ok || p.Name != "Jane Doe" {
+			t.Errorf("GetCreators()[0] = %#v, want Jane Doe", creators[0])
+		}
+		if a, ok := creators[1].(*spdx.Agent); !ok || a != &ci.CreatedBy[1] {
+			t.Errorf("GetCreators()[1] = %#v, want the unresolved reference", creators[1])
+		}
+
+		tools := doc.GetCreationTools(ci)
+		if len(tools) != 2 || tools[0].Name != "scanner" || tools[1] != &ci.CreatedUsing[1] {
+			t.Errorf("GetCreationTools() = %+v, want scanner and the unresolved reference", tools)
+		}
+		if got := doc.GetCreators(nil); got != nil {
+			t.Errorf("GetCreators(nil) = %v, want nil", got)
+		}
+	}
+}
+
+func TestDocument_Collections(t *testing.T) {
+	entries := []string{
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:file", "name": "main.go"}`,
+		`{"type": "Bundle", "spdxId": "urn:spdx:bundle", "element": ["urn:spdx:file"]}`,
+		`{"type": "software_Sbom", "spdxId": "urn:spdx:sbom", "rootElement": ["urn:spdx:app"], "element": ["urn:spdx:app", "urn:spdx:lib", "urn:spdx:missing"]}`,
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "rootElement": ["urn:spdx:sbom"], "element": ["urn:spdx:sbom", "urn:spdx:bundle", "urn:spdx:file"]}`,
+	}
+	for _, opts := range [][]parse.Option{nil, {parse.WithIndexes()}} {
+		doc, err := parse.NewReader(opts...).Read(watchDoc(entries...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(doc.Boms) != 1 {
+			t.Fatalf("got %d Boms, want 1", len(doc.Boms))
+		}
+
+		var elems []string
+		for _, elem := range doc.GetCollectionElements(&doc.Boms[0].ElementCollection) {
+			elems = append(elems, elem.GetSpdxID())
+		}
+		if want := []string{"urn:spdx:app", "urn:spdx:lib"}; !slices.Equal(elems, want) {
+			t.Errorf("GetCollectionElements() = %v, want %v", elems, want)
+		}
+		if got := doc.GetCollectionElements(nil); got != nil {
+			t.Errorf("GetCollectionElements(nil) = %v, want nil", got)
+		}
+
+		tests := []struct {
+			id   string
+			want []string
+		}{
+			{"urn:spdx:app", []string{"urn:spdx:sbom"}},
+			{"urn:spdx:file", []string{"urn:spdx:doc", "urn:spdx:bundle"}},
+			{"urn:spdx:sbom", []string{"urn:spdx:doc"}},
+			{"urn:spdx:doc", nil},
+		}
+		for _, tt := range tests {
+			var got []string
+			for _, c := range doc.GetCollectionsContaining(tt.id) {
+				got = append(got, c.GetSpdxID())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetCollectionsContaining(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestDocument_NormalizePURLs(t *testing.T) {
+	input := watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:django", "name": "django",
+		  "software_packageUrl": "pkg:PyPI/Django_Rest@3.0?b=2&a=1",
+		  "externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "packageUrl", "identifier": "pkg:GITHUB/Django/Django"}]}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib", "software_packageUrl": "pkg:npm/lib@1.0.0"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:bad", "name": "bad", "software_packageUrl": "lib@1.0.0"}`,
+	)
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(opts...).Read(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := doc.NormalizePURLs(); err == nil {
+				t.Error("NormalizePURLs() did not report the invalid package URL")
+			}
+			django := doc.GetPackageByID("urn:spdx:django")
+			if django.PackageUrl != "pkg:pypi/django-rest@3.0?a=1&b=2" || django.GetPURL() != "pkg:github/django/django" {
+				t.Errorf("django package URLs = %q, %q", django.PackageUrl, django.GetPURL())
+			}
+			if bad := doc.GetPackageByID("urn:spdx:bad"); bad.PackageUrl != "lib@1.0.0" {
+				t.Errorf("invalid package URL = %q, want it unchanged", bad.PackageUrl)
+			}
+			if raw, ok := doc.GetElementByID("urn:spdx:django").(map[string]interface{}); ok && raw["software_packageUrl"] != django.PackageUrl {
+				t.Errorf("raw packageUrl = %v, want %q", raw["software_packageUrl"], django.PackageUrl)
+			}
+		})
+	}
+}
+
+func TestDocument_GetPackagesOlderThan(t *testing.T) {
+	doc, err := parse.NewReader().Read(watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:a", "name": "lodash", "software_packageVersion": "4.9.0", "software_packageUrl": "pkg:npm/lodash@4.9.0"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:b", "name": "lodash", "software_packageVersion": "4.17.21", "software_packageUrl": "pkg:npm/lodash@4.17.21"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:c", "name": "lodash", "software_packageVersion": "4.17.21-rc.1"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:d", "name": "lodash"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:e", "name": "Django", "software_packageVersion": "3.0rc1", "software_packageUrl": "pkg:pypi/Django@3.0rc1"}`,
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name, version string
+		want          []string
+	}{
+		{"lodash", "4.17.21", []string{"urn:spdx:a", "urn:spdx:c"}},
+		{"pkg:npm/lodash", "4.17.21", []string{"urn:spdx:a"}},
+		{"pkg:pypi/django", "3.0", []string{"urn:spdx:e"}},
+		{"lodash", "4.0.0", nil},
+		{"pkg:", "1.0.0", nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, pkg := range doc.GetPackagesOlderThan(tt.name, tt.version) {
+			got = append(got, pkg.SpdxID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("GetPackagesOlderThan(%q, %q) = %v, want %v", tt.name, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestDocument_NoAssertionLicenses(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{
+				"type": "software_Package",
+				"spdxId": "SPDXRef-Package-1",
+				"name": "package-a"
+			},
+			{
+				"type": "Relationship",
+				"spdxId": "SPDXRef-Rel-1",
+				"from": "SPDXRef-Package-1",
+				"to": ["expandedlicensing_NoAssertionLicense"],
+				"relationshipType": "hasConcludedLicense"
+			},
+			{
+				"type": "Relationship",
+				"spdxId": "SPDXRef-Rel-2",
+				"from": "SPDXRef-Package-1",
+				"to": ["NONE"],
+				"relationshipType": "HAS_DECLARED_LICENSE"
+			},
+			{
+				"type": "Relationship",
+				"spdxId": "SPDXRef-Rel-3",
+				"from": "SPDXRef-Package-1",
+				"to": ["NoneElement"],
+				"relationshipType": "dependsOn"
+			}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	info := doc.GetLicensesFor("SPDXRef-Package-1")
+	if len(info.ConcludedLicenses) != 1 || !info.ConcludedLicenses[0].IsNoAssertion() {
+		t.Errorf("expected NoAssertionLicense as concluded license, got %+v", info.ConcludedLicenses)
+	}
+	if len(info.DeclaredLicenses) != 1 || !info.DeclaredLicenses[0].IsNone() {
+		t.Errorf("expected NoneLicense as declared license, got %+v", info.DeclaredLicenses)
+	}
+
+	deps := doc.GetRelationshipsTo(spdx.NoneElementIRI)
+	if len(deps) != 1 {
+		t.Errorf("expected 1 relationship to NoneElement, got %d", len(deps))
+	}
+}
+
+func TestDocument_GetAffectedPackagesByVulnID(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{"type": "software_Package", "spdxId": "SPDXRef-Package-1", "name": "app"},
+			{"type": "software_Package", "spdxId": "SPDXRef-Package-2", "name": "lib"},
+			{"type": "software_File", "spdxId": "SPDXRef-File-1", "name": "main.go"},
+			{
+				"type": "security_Vulnerability",
+				"spdxId": "SPDXRef-Vuln-1",
+				"name": "Log4Shell",
+				"externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "cve", "identifier": "CVE-2021-44228"}]
+			},
+			{"type": "security_Vulnerability", "spdxId": "SPDXRef-Vuln-2", "name": "CVE-2024-0001"},
+			{
+				"type": "Relationship",
+				"spdxId": "SPDXRef-Rel-1",
+				"from": "SPDXRef-Vuln-1",
+				"to": ["SPDXRef-Package-1", "SPDXRef-File-1"],
+				"relationshipType": "affects"
+			},
+			{
+				"type": "security_VexFixedVulnAssessmentRelationship",
+				"spdxId": "SPDXRef-VEX-1",
+				"from": "SPDXRef-Vuln-1",
+				"to": ["SPDXRef-Package-2"],
+				"relationshipType": "fixedIn"
+			},
+			{
+				"type": "security_VexNotAffectedVulnAssessmentRelationship",
+				"spdxId": "SPDXRef-VEX-2",
+				"from": "SPDXRef-Vuln-2",
+				"to": ["SPDXRef-Package-1"],
+				"relationshipType": "doesNotAffect",
+				"security_justificationType": "componentNotPresent"
+			}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	tests := []struct {
+		id   string
+		want []string // package name and relationship type
+	}{
+		{"cve-2021-44228", []string{"app affects", "lib fixedIn"}},
+		{"SPDXRef-Vuln-1", []string{"app affects", "lib fixedIn"}},
+		{"CVE-2024-0001", []string{"app doesNotAffect"}},
+		{"CVE-2000-0000", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			var got []string
+			for _, ap := range doc.GetAffectedPackagesByVulnID(tt.id) {
+				got = append(got, ap.Package.Name+" "+string(ap.RelationshipType))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("GetAffectedPackagesByVulnID(%q) = %v, want %v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocument_SeverityFilters(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{"type": "software_Package", "spdxId": "SPDXRef-Package-1", "name": "app"},
+			{"type": "security_Vulnerability", "spdxId": "SPDXRef-Vuln-1", "name": "CVE-2024-0001"},
+			{"type": "security_Vulnerability", "spdxId": "SPDXRef-Vuln-2", "name": "CVE-2024-0002"},
+			{"type": "security_Vulnerability", "spdxId": "SPDXRef-Vuln-3", "name": "CVE-2024-0003"},
+			{
+				"type": "security_CvssV3VulnAssessmentRelationship",
+				"spdxId": "SPDXRef-CVSS-1",
+				"from": "SPDXRef-Vuln-1",
+				"to": ["SPDXRef-Package-1"],
+				"relationshipType": "hasAssessmentFor",
+				"security_score": 7.5,
+				"security_severity": "high",
+				"security_vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"
+			},
+			{
+				"type": "security_CvssV4VulnAssessmentRelationship",
+				"spdxId": "SPDXRef-CVSS-2",
+				"from": "SPDXRef-Vuln-1",
+				"to": ["SPDXRef-Package-1"],
+				"relationshipType": "hasAssessmentFor",
+				"security_score": 9.3,
+				"security_severity": "critical",
+				"security_vectorString": "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"
+			},
+			{
+				"type": "security_CvssV2VulnAssessmentRelationship",
+				"spdxId": "SPDXRef-CVSS-3",
+				"from": "SPDXRef-Vuln-2",
+				"to": ["SPDXRef-Package-1"],
+				"relationshipType": "hasAssessmentFor",
+				"security_score": 7.0,
+				"security_vectorString": "AV:N/AC:L/Au:N/C:P/I:P/A:P"
+			}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	names := func(vulns []*spdx.Vulnerability) []string {
+		var out []string
+		for _, v := range vulns {
+			out = append(out, v.Name)
+		}
+		return out
+	}
+
+	severityTests := []struct {
+		severity spdx.CvssSeverityType
+		want     []string
+	}{
+		{spdx.CvssSeverityTypeCritical, []string{"CVE-2024-0001"}},
+		{spdx.CvssSeverityTypeHigh, []string{"CVE-2024-0002"}},
+		{spdx.CvssSeverityTypeLow, nil},
+		{"", []string{"CVE-2024-0003"}},
+	}
+	for _, tt := range severityTests {
+		if got := names(doc.GetVulnerabilitiesBySeverity(tt.severity)); !slices.Equal(got, tt.want) {
+			t.Errorf("GetVulnerabilitiesBySeverity(%q) = %v, want %v", tt.severity, got, tt.want)
+		}
+	}
+
+	scoreTests := []struct {
+		minScore float64
+		want     []string
+	}{
+		{9.3, []string{"CVE-2024-0001"}},
+		{7.0, []string{"CVE-2024-0001", "CVE-2024-0002"}},
+		{0, []string{"CVE-2024-0001", "CVE-2024-0002"}},
+	}
+	for _, tt := range scoreTests {
+		if got := names(doc.GetVulnerabilitiesAboveScore(tt.minScore)); !slices.Equal(got, tt.want) {
+			t.Errorf("GetVulnerabilitiesAboveScore(%v) = %v, want %v", tt.minScore, got, tt.want)
+		}
+	}
+
+	if score, ok := doc.GetVulnerabilityScore("SPDXRef-Vuln-3"); ok {
+		t.Errorf("GetVulnerabilityScore of unscored vulnerability = %v", score)
+	}
+}
+
+func TestDocument_Iterators(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{"type": "SpdxDocument", "spdxId": "SPDXRef-DOCUMENT", "name": "Test SBOM"},
+			{"type": "software_Package", "spdxId": "SPDXRef-Package-1", "name": "package-a"},
+			{"type": "software_File", "spdxId": "SPDXRef-File-1", "name": "main.go"},
+			{"type": "security_Vulnerability", "spdxId": "SPDXRef-Vuln-1", "name": "CVE-2024-0001"},
+			{"type": "Person", "spdxId": "SPDXRef-Person-1", "name": "Jane"},
+			{
+				"type": "Relationship",
+				"spdxId": "SPDXRef-Rel-1",
+				"from": "SPDXRef-Package-1",
+				"to": ["SPDXRef-File-1"],
+				"relationshipType": "contains"
+			}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	elements := 0
+	for range doc.AllElements() {
+		elements++
+	}
+	if elements != 6 {
+		t.Errorf("AllElements() yielded %d elements, want 6", elements)
+	}
+
+	artifacts := 0
+	for range doc.AllArtifacts() {
+		artifacts++
+	}
+	if artifacts != 3 {
+		t.Errorf("AllArtifacts() yielded %d elements, want 3", artifacts)
+	}
+
+	softwareArtifacts := 0
+	for range doc.AllSoftwareArtifacts() {
+		softwareArtifacts++
+	}
+	if softwareArtifacts != 2 {
+		t.Errorf("AllSoftwareArtifacts() yielded %d elements, want 2", softwareArtifacts)
+	}
+
+	// Early termination must be honored
+	seen := 0
+	for range doc.AllElements() {
+		seen++
+		break
+	}
+	if seen != 1 {
+		t.Errorf("expected iteration to stop after 1 element, got %d", seen)
+	}
+}
+
+// TestDocument_AllElementsCoversSlices guards against element slices added
+// to Document without being visited by AllElements.
+func TestDocument_AllElementsCoversSlices(t *testing.T) {
+	elemType := reflect.TypeOf((*spdx.ElementInterface)(nil)).Elem()
+	doc := &parse.Document{SpdxDocument: &spdx.SpdxDocument{}}
+	want := 1 // the SpdxDocument
+	v := reflect.ValueOf(doc).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if !f.IsExported() || f.Name == "Graph" || f.Type.Kind() != reflect.Slice || !f.Type.Elem().Implements(elemType) {
+			continue
+		}
+		item := reflect.ValueOf(&spdx.Package{})
+		if f.Type.Elem().Kind() == reflect.Ptr {
+			item = reflect.New(f.Type.Elem().Elem())
+		}
+		v.Field(i).Set(reflect.Append(v.Field(i), item))
+		want++
+	}
+
+	got := 0
+	for range doc.AllElements() {
+		got++
+	}
+	if got != want {
+		t.Errorf("AllElements() yielded %d elements, want %d, one for the SpdxDocument and each element slice", got, want)
+	}
+}
+
 // Helper function
 func containsString(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > 0 && containsStringHelper(s, substr))
@@ -247,3 +813,163 @@ func containsStringHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestReader_ParsesAllProperties(t *testing.T) {
+	docJSON := `{
+		"@graph": [
+			{
+				"type": "ai_AIPackage",
+				"spdxId": "AI-1",
+				"name": "model",
+				"software_packageVersion": "2.0",
+				"ai_autonomyType": "yes",
+				"ai_safetyRiskAssessment": "HIGH",
+				"ai_domain": ["vision"],
+				"ai_hyperparameter": [{"type": "DictionaryEntry", "key": "epochs", "value": "10"}],
+				"externalRef": [{"type": "ExternalRef", "externalRefType": "documentation", "locator": ["https://example.com/docs"]}],
+				"verifiedUsing": [{"type": "Hash", "algorithm": "sha256", "hashValue": "abc123"}],
+				"suppliedBy": "NoAssertionElement"
+			},
+			{
+				"type": "expandedlicensing_ListedLicense",
+				"spdxId": "MIT",
+				"simplelicensing_licenseText": "MIT License ...",
+				"expandedlicensing_isOsiApproved": true
+			},
+			{
+				"type": "LicenseExpression",
+				"spdxId": "Expr-1",
+				"licenseExpression": "MIT OR Apache-2.0"
+			}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	if len(doc.AiPackages) != 1 {
+		t.Fatalf("expected 1 AI package, got %d", len(doc.AiPackages))
+	}
+	ai := doc.AiPackages[0]
+	if ai.PackageVersion != "2.0" || ai.AutonomyType != spdx.PresenceTypeYes {
+		t.Errorf("AI package = version %q, autonomy %q", ai.PackageVersion, ai.AutonomyType)
+	}
+	if ai.SafetyRiskAssessment != spdx.SafetyRiskAssessmentTypeHigh {
+		t.Errorf("SafetyRiskAssessment = %q, want UPPER_SNAKE spelling normalized", ai.SafetyRiskAssessment)
+	}
+	if len(ai.Domain) != 1 || len(ai.Hyperparameter) != 1 || ai.Hyperparameter[0].Key != "epochs" {
+		t.Errorf("AI fields not parsed: domain %v, hyperparameter %v", ai.Domain, ai.Hyperparameter)
+	}
+	if len(ai.ExternalRef) != 1 || ai.ExternalRef[0].ExternalRefType != spdx.ExternalRefTypeDocumentation {
+		t.Errorf("external refs not parsed: %v", ai.ExternalRef)
+	}
+	if hashes := ai.Hashes(); len(hashes) != 1 || hashes[0].HashValue != "abc123" {
+		t.Errorf("verifiedUsing not parsed as a Hash: %v", ai.VerifiedUsing)
+	}
+	if ai.SuppliedBy == nil || ai.SuppliedBy.SpdxID != spdx.NoAssertionElementIRI {
+		t.Errorf("suppliedBy = %v, want normalized NoAssertionElement", ai.SuppliedBy)
+	}
+
+	lic := doc.ListedLicensesByID["MIT"]
+	if lic == nil {
+		t.Fatal("expected compact expandedlicensing_ListedLicense to be parsed")
+	}
+	if lic.LicenseText == "" || !lic.IsOsiApproved {
+		t.Errorf("listed license = %+v", lic)
+	}
+
+	// Legacy type names and unprefixed properties are still accepted.
+	if expr := doc.LicenseExpressionsByID["Expr-1"]; expr == nil || expr.LicenseExpression != "MIT OR Apache-2.0" {
+		t.Errorf("license expression = %+v", expr)
+	}
+}
+
+// TestReader_GoldenFixtures reads the example documents generated by
+// spdx-gen -fixtures-out and checks that the element each one describes is
+// parsed and passes validation.
+func TestReader_GoldenFixtures(t *testing.T) {
+	files, err := filepath.Glob("testdata/golden/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no golden fixtures found")
+	}
+
+	for _, file := range files {
+		// Individuals describe the vocabulary, not document content, and are
+		// not collected by the reader.
+		if strings.HasPrefix(filepath.Base(file), "IndividualElement.") {
+			continue
+		}
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			doc, err := parse.NewReader().ReadFile(file)
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+
+			// The described element is named after its class.
+			class := strings.SplitN(filepath.Base(file), ".", 2)[0]
+			if i := strings.Index(class, "_"); i >= 0 {
+				class = class[i+1:]
+			}
+			id := "https://example.com/spdx/" + class
+
+			var found spdx.ElementInterface
+			for e := range doc.AllElements() {
+				if e.GetSpdxID() == id {
+					found = e
+				}
+			}
+			if found == nil {
+				t.Fatalf("element %s not parsed", id)
+			}
+			if v, ok := found.(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestReader_WithLogger(t *testing.T) {
+	data := []byte(strings.Replace(lenientDocJSON,
+		`{"type": "acme_Unknown"`,
+		`{"type": "Person", "spdxId": "urn:spdx:acme"}, {"type": "acme_Unknown"`, 1))
+	want := []struct {
+		level slog.Level
+		msg   string
+		attr  string
+	}{
+		{slog.LevelDebug, "skipping graph entry that is not an object", `"index":0`},
+		{slog.LevelDebug, "reading element type under its compact name", `"type":"LicenseExpression"`},
+		{slog.LevelWarn, "duplicate SPDX ID, the later element replaces the earlier one in ElementsByID", `"spdxId":"urn:spdx:acme"`},
+		{slog.LevelWarn, "skipping element of unknown type", `"type":"acme_Unknown"`},
+		{slog.LevelDebug, "read SPDX document", `"elements":11`},
+	}
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			if _, err := parse.NewReader(append(opts, parse.WithLogger(logger))...).Read(data); err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			logged := buf.String()
+			for _, w := range want {
+				found := false
+				for _, line := range strings.Split(logged, "\n") {
+					if strings.Contains(line, `"level":"`+w.level.String()+`"`) && strings.Contains(line, `"msg":"`+w.msg+`"`) && strings.Contains(line, w.attr) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("no %s record %q with %s in:\n%s", w.level, w.msg, w.attr, logged)
+				}
+			}
+		})
+	}
+}
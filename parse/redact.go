@@ -0,0 +1,293 @@
+package parse
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// RedactOption configures Redact.
+type RedactOption interface {
+	apply(*redactor)
+}
+
+type redactOptionFunc func(*redactor)
+
+func (f redactOptionFunc) apply(r *redactor) {
+	f(r)
+}
+
+// redactor holds the settings of Redact.
+type redactor struct {
+	pathPrefixes []*regexp.Regexp
+	keepComments bool
+}
+
+// WithPathPrefixes removes the given path prefixes, such as the checkout
+// directory of a build machine, from the paths Redact rewrites, along with
+// the path separator following them.
+func WithPathPrefixes(prefixes ...string) RedactOption {
+	return redactOptionFunc(func(r *redactor) {
+		for _, p := range prefixes {
+			if p != "" {
+				r.pathPrefixes = append(r.pathPrefixes, regexp.MustCompile(regexp.QuoteMeta(p)+`[/\\]?`))
+			}
+		}
+	})
+}
+
+// WithComments keeps the comments of elements and creation info, which
+// Redact removes by default.
+func WithComments() RedactOption {
+	return redactOptionFunc(func(r *redactor) {
+		r.keepComments = true
+	})
+}
+
+// RedactionAction is what Redact did to a property.
+type RedactionAction string
+
+// Actions of a Redaction.
+const (
+	RedactionRemoved       RedactionAction = "removed"
+	RedactionPseudonymized RedactionAction = "pseudonymized"
+	RedactionRewritten     RedactionAction = "rewritten"
+)
+
+// RedactionManifest lists what Redact changed in a document, in the order
+// of AllElements. It holds no redacted values, so it can be distributed
+// with the document.
+type RedactionManifest struct {
+	Redactions []Redaction
+}
+
+// Redaction is a property of an element redacted by Redact.
+type Redaction struct {
+	SpdxID string
+	Type   string
+	// Property is the JSON-LD name of the property, as in "name" or
+	// "creationInfo.comment".
+	Property string
+	Action   RedactionAction
+	// Value is the pseudonym or pseudonymous ID, or the rewritten path or
+	// statement, or empty for removed values.
+	Value string
+}
+
+// homeDirectory matches the home directories of users, which name them,
+// in Unix, macOS and Windows paths.
+var homeDirectory = regexp.MustCompile(`(?:/home|/Users|[A-Za-z]:\\Users)[/\\][^/\\\s]+`)
+
+// Redact removes personal and internal information from the document in
+// place before it is distributed outside the organization, and returns a
+// manifest of what it redacted. The raw elements in ElementsByID are
+// updated to match, and RawOf no longer returns the JSON of the redacted
+// elements.
+//
+// The names of Person elements are replaced by the pseudonyms "Person 1",
+// "Person 2" and so on, the same for the same name, and their SPDX IDs,
+// which often hold names or email addresses, by the pseudonymous IDs
+// "<document namespace>#person-1" and so on, in every reference to them.
+// Their email external identifiers, summaries and descriptions are removed.
+// In the statements of annotations, the names, email addresses and former
+// IDs of the persons are replaced by their pseudonyms and new IDs; other
+// free text, such as the names of organizations, is left to the comments
+// Redact removes. The comments of elements and of creation info are removed
+// unless WithComments is given. In the names of files and the download
+// locations and source info of packages, the home directories of users
+// become "~" and the prefixes set WithPathPrefixes are removed.
+//
+// The manifest identifies persons by their new IDs only.
+//
+//	manifest, err := doc.Redact(parse.WithPathPrefixes("/builds/acme/app"))
+//	...
+//	for _, r := range manifest.Redactions {
+//	    log.Printf("%s %s.%s", r.Action, r.SpdxID, r.Property)
+//	}
+//	err = doc.WriteFile("sbom.public.spdx.json")
+func (d *Document) Redact(opts ...RedactOption) (*RedactionManifest, error) {
+	r := &redactor{}
+	for _, opt := range opts {
+		opt.apply(r)
+	}
+
+	// Persons get their pseudonyms and IDs first, so that annotations
+	// preceding them lose their names as well.
+	pseudonyms := make(map[string]string)
+	ids := make(map[string]string)
+	var mentions [][2]string
+	newID := personIDs(d)
+	for elem := range d.AllElements() {
+		person, ok := elem.(*spdx.Person)
+		if !ok {
+			continue
+		}
+		pseudonym := ""
+		if person.Name != "" {
+			if pseudonym, ok = pseudonyms[person.Name]; !ok {
+				pseudonym = "Person " + strconv.Itoa(len(pseudonyms)+1)
+				pseudonyms[person.Name] = pseudonym
+			}
+			mentions = append(mentions, [2]string{person.Name, pseudonym})
+		}
+		id := person.SpdxID
+		if id != "" && !strings.HasPrefix(id, "_:") && !spdx.IsNoAssertion(id) && !spdx.IsNone(id) {
+			ids[id] = newID()
+			mentions = append(mentions, [2]string{id, ids[id]})
+		}
+		for _, ei := range person.ExternalIdentifier {
+			if ei.ExternalIdentifierType == spdx.ExternalIdentifierTypeEmail && ei.Identifier != "" && pseudonym != "" {
+				mentions = append(mentions, [2]string{ei.Identifier, pseudonym})
+			}
+		}
+	}
+	mentioned := mentionReplacer(mentions)
+
+	manifest := &RedactionManifest{}
+	var changed []spdx.AnyElement
+	for elem := range d.AllElements() {
+		e, ok := elem.(spdx.AnyElement)
+		if !ok {
+			continue
+		}
+		redacted := len(manifest.Redactions)
+		id := elem.GetSpdxID()
+		if newID, ok := ids[id]; ok {
+			id = newID
+		}
+		record := func(property string, action RedactionAction, value string) {
+			manifest.Redactions = append(manifest.Redactions, Redaction{
+				SpdxID: id, Type: typeName(elem), Property: property, Action: action, Value: value,
+			})
+		}
+
+		if person, ok := e.(*spdx.Person); ok {
+			if id != person.SpdxID {
+				record("spdxId", RedactionPseudonymized, id)
+			}
+			if person.Name != "" {
+				person.Name = pseudonyms[person.Name]
+				record("name", RedactionPseudonymized, person.Name)
+			}
+			n := len(person.ExternalIdentifier)
+			person.ExternalIdentifier = slices.DeleteFunc(person.ExternalIdentifier, func(ei spdx.ExternalIdentifier) bool {
+				return ei.ExternalIdentifierType == spdx.ExternalIdentifierTypeEmail
+			})
+			if len(person.ExternalIdentifier) < n {
+				record("externalIdentifier", RedactionRemoved, "")
+			}
+			if person.Summary != "" {
+				person.Summary = ""
+				record("summary", RedactionRemoved, "")
+			}
+			if person.Description != "" {
+				person.Description = ""
+				record("description", RedactionRemoved, "")
+			}
+		}
+		if a, ok := e.(*spdx.Annotation); ok && mentioned != nil {
+			if s := mentioned.Replace(a.Statement); s != a.Statement {
+				a.Statement = s
+				record("statement", RedactionRewritten, s)
+			}
+		}
+
+		if !r.keepComments {
+			el := spdx.AsElement(e)
+			if el.Comment != "" {
+				el.Comment = ""
+				record("comment", RedactionRemoved, "")
+			}
+			if el.CreationInfo.Comment != "" {
+				el.CreationInfo.Comment = ""
+				record("creationInfo.comment", RedactionRemoved, "")
+			}
+		}
+
+		rewrite := func(property string, value *string) {
+			if p := r.redactPath(*value); p != *value {
+				*value = p
+				record(property, RedactionRewritten, p)
+			}
+		}
+		if f, ok := spdx.AsFile(e); ok {
+			rewrite("name", &f.Name)
+		}
+		if pkg, ok := spdx.AsPackage(e); ok {
+			rewrite("downloadLocation", &pkg.DownloadLocation)
+			rewrite("sourceInfo", &pkg.SourceInfo)
+		}
+
+		if len(manifest.Redactions) > redacted && elem.GetSpdxID() != "" {
+			changed = append(changed, e)
+		}
+	}
+
+	if !r.keepComments && d.CreationInfo != nil && d.CreationInfo.Comment != "" {
+		d.CreationInfo.Comment = ""
+		manifest.Redactions = append(manifest.Redactions, Redaction{Type: "CreationInfo", Property: "comment", Action: RedactionRemoved})
+	}
+
+	if err := d.UpdateElements(changed...); err != nil {
+		return nil, err
+	}
+	for _, e := range changed {
+		delete(d.raw, e.GetSpdxID())
+	}
+	if len(ids) > 0 {
+		d.RewriteIDs(func(spdxID string) string {
+			if newID, ok := ids[spdxID]; ok {
+				return newID
+			}
+			return spdxID
+		})
+	}
+	return manifest, nil
+}
+
+// personIDs returns a function returning the pseudonymous IDs of persons
+// in turn: the namespace of the document, which is its ID without fragment,
+// followed by "#person-1", "#person-2" and so on, skipping IDs in use.
+func personIDs(d *Document) func() string {
+	base := "urn:spdx:redacted"
+	if d.SpdxDocument != nil && d.SpdxDocument.SpdxID != "" {
+		base, _, _ = strings.Cut(d.SpdxDocument.SpdxID, "#")
+	}
+	n := 0
+	return func() string {
+		for {
+			n++
+			id := base + "#person-" + strconv.Itoa(n)
+			if _, ok := d.ElementsByID[id]; !ok {
+				return id
+			}
+		}
+	}
+}
+
+// mentionReplacer returns a replacer of the given old and new strings,
+// longest first so that a name does not break up an ID or address holding
+// it, or nil if there are none.
+func mentionReplacer(mentions [][2]string) *strings.Replacer {
+	if len(mentions) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(mentions, func(a, b [2]string) int { return len(b[0]) - len(a[0]) })
+	var oldnew []string
+	for _, m := range mentions {
+		oldnew = append(oldnew, m[0], m[1])
+	}
+	return strings.NewReplacer(oldnew...)
+}
+
+// redactPath rewrites the home directories and the configured prefixes of
+// the paths in s.
+func (r *redactor) redactPath(s string) string {
+	for _, prefix := range r.pathPrefixes {
+		s = prefix.ReplaceAllLiteralString(s, "")
+	}
+	return homeDirectory.ReplaceAllLiteralString(s, "~")
+}
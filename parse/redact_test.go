@@ -0,0 +1,147 @@
+package parse_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_Redact(t *testing.T) {
+	input := watchDoc(
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "comment": "internal build 42"}`,
+		`{"type": "Person", "spdxId": "urn:spdx:jane", "name": "Jane Doe",
+		  "externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "email", "identifier": "jane@acme.example"},
+		                         {"type": "ExternalIdentifier", "externalIdentifierType": "other", "identifier": "employee-7"}]}`,
+		`{"type": "Person", "spdxId": "urn:spdx:jane-2", "name": "Jane Doe"}`,
+		`{"type": "Person", "spdxId": "urn:spdx:john", "name": "John Roe"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app",
+		  "software_downloadLocation": "file:///home/jane/src/app", "software_sourceInfo": "built in /builds/acme/app/out"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "name": "/builds/acme/app/cmd/main.go"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:util", "name": "C:\\Users\\jane\\app\\util.go"}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(opts...).Read(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			manifest, err := doc.Redact(parse.WithPathPrefixes("/builds/acme/app"))
+			if err != nil {
+				t.Fatalf("Redact() error = %v", err)
+			}
+
+			want := []parse.Redaction{
+				{SpdxID: "urn:spdx:doc", Type: "SpdxDocument", Property: "comment", Action: parse.RedactionRemoved},
+				{SpdxID: "urn:spdx:doc#person-1", Type: "Person", Property: "spdxId", Action: parse.RedactionPseudonymized, Value: "urn:spdx:doc#person-1"},
+				{SpdxID: "urn:spdx:doc#person-1", Type: "Person", Property: "name", Action: parse.RedactionPseudonymized, Value: "Person 1"},
+				{SpdxID: "urn:spdx:doc#person-1", Type: "Person", Property: "externalIdentifier", Action: parse.RedactionRemoved},
+				{SpdxID: "urn:spdx:doc#person-2", Type: "Person", Property: "spdxId", Action: parse.RedactionPseudonymized, Value: "urn:spdx:doc#person-2"},
+				{SpdxID: "urn:spdx:doc#person-2", Type: "Person", Property: "name", Action: parse.RedactionPseudonymized, Value: "Person 1"},
+				{SpdxID: "urn:spdx:doc#person-3", Type: "Person", Property: "spdxId", Action: parse.RedactionPseudonymized, Value: "urn:spdx:doc#person-3"},
+				{SpdxID: "urn:spdx:doc#person-3", Type: "Person", Property: "name", Action: parse.RedactionPseudonymized, Value: "Person 2"},
+				{SpdxID: "urn:spdx:app", Type: "Package", Property: "downloadLocation", Action: parse.RedactionRewritten, Value: "file://~/src/app"},
+				{SpdxID: "urn:spdx:app", Type: "Package", Property: "sourceInfo", Action: parse.RedactionRewritten, Value: "built in out"},
+				{SpdxID: "urn:spdx:main", Type: "File", Property: "name", Action: parse.RedactionRewritten, Value: "cmd/main.go"},
+				{SpdxID: "urn:spdx:util", Type: "File", Property: "name", Action: parse.RedactionRewritten, Value: `~\app\util.go`},
+			}
+			if !reflect.DeepEqual(manifest.Redactions, want) {
+				t.Errorf("Redactions = %+v\nwant %+v", manifest.Redactions, want)
+			}
+
+			jane := doc.GetPersonByID("urn:spdx:doc#person-1")
+			if jane == nil || jane.Name != "Person 1" || len(jane.ExternalIdentifier) != 1 || jane.ExternalIdentifier[0].Identifier != "employee-7" {
+				t.Errorf("jane = %+v, want a pseudonym and no email", jane)
+			}
+			if doc.SpdxDocument.Comment != "" {
+				t.Errorf("SpdxDocument.Comment = %q, want empty", doc.SpdxDocument.Comment)
+			}
+			if raw, ok := doc.GetElementByID("urn:spdx:doc#person-3").(map[string]interface{}); ok && raw["name"] != "Person 2" {
+				t.Errorf("raw name = %v, want Person 2", raw["name"])
+			}
+		})
+	}
+}
+
+func TestDocument_Redact_PersonIDs(t *testing.T) {
+	const jane = "mailto:jane.doe@acme.example"
+	input := watchDoc(
+		`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc"}`,
+		`{"type": "Annotation", "spdxId": "urn:spdx:note", "annotationType": "review", "subject": "urn:spdx:app",
+		  "statement": "Approved by Jane Doe <jane.doe@acme.example> (`+jane+`)"}`,
+		`{"type": "Person", "spdxId": "`+jane+`", "name": "Jane Doe",
+		  "summary": "Release manager", "description": "Jane Doe reviews the releases of app.",
+		  "externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "email", "identifier": "jane.doe@acme.example"}]}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "originatedBy": ["`+jane+`"]}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel", "from": "urn:spdx:app", "to": ["`+jane+`"], "relationshipType": "hasDistributionArtifact"}`,
+		`{"type": "Organization", "spdxId": "urn:spdx:doc#person-1", "name": "Taken"}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			doc, err := parse.NewReader(append(opts, parse.WithRawElements())...).Read(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			manifest, err := doc.Redact()
+			if err != nil {
+				t.Fatalf("Redact() error = %v", err)
+			}
+
+			const id = "urn:spdx:doc#person-2"
+			want := []parse.Redaction{
+				{SpdxID: "urn:spdx:note", Type: "Annotation", Property: "statement", Action: parse.RedactionRewritten, Value: "Approved by Person 1 <Person 1> (" + id + ")"},
+				{SpdxID: id, Type: "Person", Property: "spdxId", Action: parse.RedactionPseudonymized, Value: id},
+				{SpdxID: id, Type: "Person", Property: "name", Action: parse.RedactionPseudonymized, Value: "Person 1"},
+				{SpdxID: id, Type: "Person", Property: "externalIdentifier", Action: parse.RedactionRemoved},
+				{SpdxID: id, Type: "Person", Property: "summary", Action: parse.RedactionRemoved},
+				{SpdxID: id, Type: "Person", Property: "description", Action: parse.RedactionRemoved},
+			}
+			if !reflect.DeepEqual(manifest.Redactions, want) {
+				t.Errorf("Redactions = %+v\nwant %+v", manifest.Redactions, want)
+			}
+
+			if doc.GetPersonByID(jane) != nil || doc.GetPersonByID(id) == nil {
+				t.Errorf("person not moved from %s to %s", jane, id)
+			}
+			if got := doc.Packages[0].OriginatedBy; len(got) != 1 || got[0].SpdxID != id {
+				t.Errorf("originatedBy = %v, want %s", got, id)
+			}
+			if got := doc.Relationships[0].To; len(got) != 1 || got[0].SpdxID != id {
+				t.Errorf("relationship to = %v, want %s", got, id)
+			}
+			for _, redacted := range []string{id, "urn:spdx:note"} {
+				if _, ok := doc.RawOf(redacted); ok {
+					t.Errorf("RawOf(%q) returns the JSON read", redacted)
+				}
+			}
+
+			data, err := doc.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, leaked := range []string{string(data), fmt.Sprint(manifest.Redactions)} {
+				if strings.Contains(strings.ToLower(leaked), "jane") {
+					t.Errorf("redacted output names Jane:\n%s", leaked)
+				}
+			}
+		})
+	}
+}
+
+func TestDocument_Redact_WithComments(t *testing.T) {
+	doc, err := parse.NewReader().Read(watchDoc(`{"type": "SpdxDocument", "spdxId": "urn:spdx:doc", "comment": "public"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := doc.Redact(parse.WithComments())
+	if err != nil {
+		t.Fatalf("Redact() error = %v", err)
+	}
+	if len(manifest.Redactions) != 0 || doc.SpdxDocument.Comment != "public" {
+		t.Errorf("Redact(WithComments()) = %+v, comment %q; want no redactions", manifest.Redactions, doc.SpdxDocument.Comment)
+	}
+}
@@ -0,0 +1,174 @@
+package parse
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
+)
+
+// ParseFunc converts a raw JSON-LD element map into an SPDX element.
+type ParseFunc func(elemMap map[string]interface{}) (spdx.AnyElement, error)
+
+// TypeInfo describes an element type registered with a Registry.
+type TypeInfo struct {
+	// Type is the JSON-LD type string, e.g. "acme_Firmware".
+	Type ElementType
+
+	// New returns a zero value of the element type. It is optional.
+	New func() spdx.AnyElement
+
+	// Parse converts a raw element map into the element type. It is required.
+	Parse ParseFunc
+}
+
+// Registry maps element types that the reader does not know about to the
+// functions that parse them. It lets downstream packages add support for
+// custom profiles without changing the reader.
+//
+// Custom element types typically embed one of the model types so that they
+// satisfy spdx.AnyElement:
+//
+//	type Firmware struct {
+//	    spdx.Package
+//	    BootLoader string
+//	}
+//
+//	parse.RegisterType(parse.TypeInfo{
+//	    Type: "acme_Firmware",
+//	    Parse: func(m map[string]interface{}) (spdx.AnyElement, error) {
+//	        fw := &Firmware{Package: *parse.ParsePackage(m)}
+//	        fw.BootLoader, _ = m["acme_bootLoader"].(string)
+//	        return fw, nil
+//	    },
+//	})
+//
+// A Registry is safe for concurrent use.
+type Registry struct {
+	mu    sync.RWMutex
+	types map[ElementType]TypeInfo
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		types: make(map[ElementType]TypeInfo),
+	}
+}
+
+// Register adds an element type to the registry. It returns an error if the
+// type is empty, has no parse function, is handled by the reader itself, or
+// is already registered.
+func (r *Registry) Register(info TypeInfo) error {
+	if info.Type == "" {
+		return fmt.Errorf("registering element type: type is empty")
+	}
+	if info.Parse == nil {
+		return fmt.Errorf("registering element type %q: parse function is nil", info.Type)
+	}
+	if info.Type.IsBuiltin() {
+		return fmt.Errorf("registering element type %q: type is built in", info.Type)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.types[info.Type]; exists {
+		return fmt.Errorf("registering element type %q: already registered", info.Type)
+	}
+	r.types[info.Type] = info
+	return nil
+}
+
+// MustRegister is like Register but panics on error. It is intended for use
+// in package init functions.
+func (r *Registry) MustRegister(info TypeInfo) {
+	if err := r.Register(info); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup returns the registration for the given element type.
+func (r *Registry) Lookup(t ElementType) (TypeInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.types[t]
+	return info, ok
+}
+
+// New returns a zero value of the given element type, if the type was
+// registered with a constructor.
+func (r *Registry) New(t ElementType) (spdx.AnyElement, bool) {
+	info, ok := r.Lookup(t)
+	if !ok || info.New == nil {
+		return nil, false
+	}
+	return info.New(), true
+}
+
+// Types returns the registered element types in sorted order.
+func (r *Registry) Types() []ElementType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]ElementType, 0, len(r.types))
+	for t := range r.types {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the registry used by readers that were not given
+// one with WithRegistry.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// RegisterType adds an element type to the default registry.
+func RegisterType(info TypeInfo) error {
+	return defaultRegistry.Register(info)
+}
+
+// Parsers for the base classes, so custom types can reuse the reader's
+// handling of inherited properties.
+
+var baseParser = parser.NewElementParser()
+
+// ParseElement parses the common Element properties from a raw element map.
+func ParseElement(elemMap map[string]interface{}) spdx.Element {
+	if elem := baseParser.ParseElement(elemMap); elem != nil {
+		return *elem
+	}
+	return spdx.Element{}
+}
+
+// ParseArtifact parses the Artifact properties from a raw element map.
+func ParseArtifact(elemMap map[string]interface{}) *spdx.Artifact {
+	return baseParser.ParseArtifact(elemMap)
+}
+
+// ParsePackage parses the software Package properties from a raw element map.
+func ParsePackage(elemMap map[string]interface{}) *spdx.Package {
+	return baseParser.ParsePackage(elemMap)
+}
+
+// ParseFile parses the software File properties from a raw element map.
+func ParseFile(elemMap map[string]interface{}) *spdx.File {
+	return baseParser.ParseFile(elemMap)
+}
+
+// ParseRelationship parses the Relationship properties from a raw element map.
+func ParseRelationship(elemMap map[string]interface{}) *spdx.Relationship {
+	return baseParser.ParseRelationship(elemMap)
+}
+
+// ParseAgent parses the Agent properties from a raw element map.
+func ParseAgent(elemMap map[string]interface{}) *spdx.Agent {
+	return baseParser.ParseAgent(elemMap)
+}
@@ -0,0 +1,196 @@
+package parse_test
+
+import (
+	"errors"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// firmware is a custom element type from a hypothetical "acme" profile.
+type firmware struct {
+	spdx.Package
+	BootLoader string
+}
+
+func parseFirmware(m map[string]interface{}) (spdx.AnyElement, error) {
+	fw := &firmware{Package: *parse.ParsePackage(m)}
+	fw.BootLoader, _ = m["acme_bootLoader"].(string)
+	if fw.BootLoader == "" {
+		return nil, errors.New("missing boot loader")
+	}
+	return fw, nil
+}
+
+func TestRegistry_Register(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    parse.TypeInfo
+		wantErr bool
+	}{
+		{
+			name: "custom type",
+			info: parse.TypeInfo{Type: "acme_Firmware", Parse: parseFirmware},
+		},
+		{
+			name:    "empty type",
+			info:    parse.TypeInfo{Parse: parseFirmware},
+			wantErr: true,
+		},
+		{
+			name:    "nil parse function",
+			info:    parse.TypeInfo{Type: "acme_Other"},
+			wantErr: true,
+		},
+		{
+			name:    "built-in type",
+			info:    parse.TypeInfo{Type: parse.TypeSoftwarePackage, Parse: parseFirmware},
+			wantErr: true,
+		},
+		{
+			name:    "model type without a named constant",
+			info:    parse.TypeInfo{Type: "dataset_DatasetPackage", Parse: parseFirmware},
+			wantErr: true,
+		},
+		{
+			name:    "legacy type name",
+			info:    parse.TypeInfo{Type: parse.TypeListedLicense, Parse: parseFirmware},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := parse.NewRegistry()
+			err := reg.Register(tt.info)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("duplicate type", func(t *testing.T) {
+		reg := parse.NewRegistry()
+		info := parse.TypeInfo{
+			Type:  "acme_Firmware",
+			New:   func() spdx.AnyElement { return &firmware{} },
+			Parse: parseFirmware,
+		}
+		if err := reg.Register(info); err != nil {
+			t.Fatalf("Register() error = %v", err)
+		}
+		if err := reg.Register(info); err == nil {
+			t.Error("expected error registering type twice")
+		}
+
+		if types := reg.Types(); len(types) != 1 || types[0] != "acme_Firmware" {
+			t.Errorf("Types() = %v, want [acme_Firmware]", types)
+		}
+		if _, ok := reg.New("acme_Firmware"); !ok {
+			t.Error("expected New() to construct registered type")
+		}
+	})
+}
+
+func TestReader_RegisteredTypes(t *testing.T) {
+	reg := parse.NewRegistry()
+	reg.MustRegister(parse.TypeInfo{Type: "acme_Firmware", Parse: parseFirmware})
+
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{"type": "SpdxDocument", "spdxId": "SPDXRef-DOCUMENT", "name": "Test SBOM"},
+			{
+				"type": "acme_Firmware",
+				"spdxId": "SPDXRef-Firmware-1",
+				"name": "bios",
+				"software_packageVersion": "2.1",
+				"acme_bootLoader": "grub"
+			},
+			{"type": "acme_Unknown", "spdxId": "SPDXRef-Unknown-1"}
+		]
+	}`
+
+	doc, err := parse.NewReader(parse.WithRegistry(reg)).Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	if len(doc.Extensions) != 1 {
+		t.Fatalf("expected 1 extension element, got %d", len(doc.Extensions))
+	}
+	fw, ok := doc.ExtensionsByID["SPDXRef-Firmware-1"].(*firmware)
+	if !ok {
+		t.Fatalf("expected *firmware, got %T", doc.ExtensionsByID["SPDXRef-Firmware-1"])
+	}
+	if fw.Name != "bios" || fw.PackageVersion != "2.1" || fw.BootLoader != "grub" {
+		t.Errorf("unexpected firmware fields: %+v", fw)
+	}
+	if pkg, ok := spdx.AsPackage(fw); !ok || pkg.PackageVersion != "2.1" {
+		t.Error("expected AsPackage to succeed for firmware")
+	}
+
+	found := false
+	for elem := range doc.AllElements() {
+		if elem.GetSpdxID() == "SPDXRef-Firmware-1" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected AllElements() to include extension element")
+	}
+
+	t.Run("parse error", func(t *testing.T) {
+		bad := `{"@graph": [{"type": "acme_Firmware", "spdxId": "SPDXRef-Firmware-2"}]}`
+		_, err := parse.NewReader(parse.WithRegistry(reg)).Read([]byte(bad))
+		if err == nil || !containsString(err.Error(), "SPDXRef-Firmware-2") {
+			t.Errorf("expected error naming the element, got %v", err)
+		}
+	})
+
+	t.Run("default registry ignores unregistered types", func(t *testing.T) {
+		doc, err := parse.NewReader().Read([]byte(docJSON))
+		if err != nil {
+			t.Fatalf("failed to parse document: %v", err)
+		}
+		if len(doc.Extensions) != 0 {
+			t.Errorf("expected no extension elements, got %d", len(doc.Extensions))
+		}
+	})
+}
+
+func TestElementType_Profiles(t *testing.T) {
+	tests := []struct {
+		typ       parse.ElementType
+		core      bool
+		software  bool
+		licensing bool
+		security  bool
+	}{
+		{parse.TypeRelationship, true, false, false, false},
+		{parse.TypeSoftwarePackage, false, true, false, false},
+		{parse.TypeListedLicense, false, false, true, false},
+		{parse.TypeSimpleLicensingExpression, false, false, true, false},
+		{parse.TypeVexAffectedVulnAssessment, false, false, false, true},
+		{parse.TypeAIPackage, false, false, false, false},
+		{"acme_Firmware", false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.typ), func(t *testing.T) {
+			if got := tt.typ.IsCore(); got != tt.core {
+				t.Errorf("IsCore() = %v, want %v", got, tt.core)
+			}
+			if got := tt.typ.IsSoftware(); got != tt.software {
+				t.Errorf("IsSoftware() = %v, want %v", got, tt.software)
+			}
+			if got := tt.typ.IsLicensing(); got != tt.licensing {
+				t.Errorf("IsLicensing() = %v, want %v", got, tt.licensing)
+			}
+			if got := tt.typ.IsSecurity(); got != tt.security {
+				t.Errorf("IsSecurity() = %v, want %v", got, tt.security)
+			}
+		})
+	}
+}
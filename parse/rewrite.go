@@ -0,0 +1,236 @@
+package parse
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// IDMapper returns the replacement for an SPDX ID. Returning the ID
+// unchanged leaves it as is.
+type IDMapper func(spdxID string) string
+
+// NamespaceMapper returns an IDMapper that moves IDs under oldBase to
+// newBase, keeping the rest of the ID. IDs outside oldBase are unchanged.
+func NamespaceMapper(oldBase, newBase string) IDMapper {
+	return func(spdxID string) string {
+		if oldBase == "" || !strings.HasPrefix(spdxID, oldBase) {
+			return spdxID
+		}
+		return newBase + strings.TrimPrefix(spdxID, oldBase)
+	}
+}
+
+// RenameNamespace moves every element of the document from the oldBase
+// namespace to newBase. It is shorthand for RewriteIDs(NamespaceMapper(...)).
+//
+//	doc.RenameNamespace("https://vendor.example/sbom/", "https://acme.example/sbom/")
+func (d *Document) RenameNamespace(oldBase, newBase string) {
+	d.RewriteIDs(NamespaceMapper(oldBase, newBase))
+}
+
+// RewriteIDs rewrites the SPDX IDs of all elements defined in or imported by
+// the document and updates every reference to them: relationship endpoints,
+// creation info agents and tools, collection members, annotation subjects,
+// imports and the raw elements in ElementsByID. Namespace map entries are
+// passed through mapID as well. The ID indexes are rebuilt under the new keys.
+//
+// Blank nodes and the NoAssertion/None individuals are never rewritten.
+func (d *Document) RewriteIDs(mapID IDMapper) {
+	if mapID == nil {
+		return
+	}
+
+	rewrite := func(id string) string {
+		if id == "" || strings.HasPrefix(id, "_:") || spdx.IsNoAssertion(id) || spdx.IsNone(id) {
+			return id
+		}
+		return mapID(id)
+	}
+
+	// Compute the replacements once so that every occurrence of an ID is
+	// rewritten consistently, even when mapID is not idempotent.
+	table := make(map[string]string)
+	addID := func(id string) {
+		if newID := rewrite(id); newID != id {
+			table[id] = newID
+		}
+	}
+	for id := range d.ElementsByID {
+		addID(id)
+	}
+	if d.SpdxDocument != nil {
+		addID(d.SpdxDocument.SpdxID)
+		for _, imp := range d.SpdxDocument.Import {
+			addID(imp.ExternalSpdxId)
+		}
+	}
+
+	w := &idRewriter{
+		table:     table,
+		namespace: rewrite,
+		seen:      make(map[seenKey]bool),
+	}
+
+	for _, raw := range d.ElementsByID {
+		w.rewriteRaw(raw)
+	}
+
+	v := reflect.ValueOf(d).Elem()
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		if t.Field(i).Name == "ElementsByID" {
+			w.rekey(v.Field(i))
+			continue
+		}
+		w.visit(v.Field(i))
+	}
+	if d.provenance != nil {
+		offsets := make(map[string]int64, len(d.provenance.offsets))
+		for id, offset := range d.provenance.offsets {
+			offsets[w.replace(id)] = offset
+		}
+		d.provenance.offsets = offsets
+	}
+	if d.raw != nil {
+		raw := make(map[string]json.RawMessage, len(d.raw))
+		for id, data := range d.raw {
+			raw[w.replace(id)] = data
+		}
+		d.raw = raw
+	}
+}
+
+var (
+	elementType      = reflect.TypeOf(spdx.Element{})
+	externalMapType  = reflect.TypeOf(spdx.ExternalMap{})
+	namespaceMapType = reflect.TypeOf(spdx.NamespaceMap{})
+)
+
+// idRewriter walks the typed document and replaces IDs found in table.
+type idRewriter struct {
+	table     map[string]string
+	namespace func(string) string
+	seen      map[seenKey]bool
+}
+
+// seenKey identifies a visited pointer. The type is part of the key because
+// a struct and its first embedded field share an address.
+type seenKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+func (w *idRewriter) replace(s string) string {
+	if newID, ok := w.table[s]; ok {
+		return newID
+	}
+	return s
+}
+
+func (w *idRewriter) visit(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		// Elements are shared between the typed slices and the ByID maps,
+		// so each one must be rewritten exactly once.
+		key := seenKey{ptr: v.Pointer(), typ: v.Type()}
+		if w.seen[key] {
+			return
+		}
+		w.seen[key] = true
+		w.visit(v.Elem())
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			w.visit(v.Elem())
+		}
+
+	case reflect.Struct:
+		w.rewriteStruct(v)
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				w.visit(v.Field(i))
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			w.visit(v.Index(i))
+		}
+
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			w.visit(iter.Value())
+		}
+		w.rekey(v)
+	}
+}
+
+// rewriteStruct replaces the ID-valued fields of the model types.
+func (w *idRewriter) rewriteStruct(v reflect.Value) {
+	var field reflect.Value
+	var fn func(string) string
+
+	switch v.Type() {
+	case elementType:
+		field, fn = v.FieldByName("SpdxID"), w.replace
+	case externalMapType:
+		field, fn = v.FieldByName("ExternalSpdxId"), w.replace
+	case namespaceMapType:
+		field, fn = v.FieldByName("Namespace"), w.namespace
+	default:
+		return
+	}
+
+	if field.CanSet() {
+		field.SetString(fn(field.String()))
+	}
+}
+
+// rekey replaces a string-keyed map with one whose keys are rewritten.
+func (w *idRewriter) rekey(v reflect.Value) {
+	if v.Kind() != reflect.Map || v.IsNil() || v.Type().Key().Kind() != reflect.String || !v.CanSet() {
+		return
+	}
+
+	m := reflect.MakeMapWithSize(v.Type(), v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		key := reflect.ValueOf(w.replace(iter.Key().String())).Convert(v.Type().Key())
+		m.SetMapIndex(key, iter.Value())
+	}
+	v.Set(m)
+}
+
+// rewriteRaw replaces IDs in the string values of a raw JSON-LD element.
+func (w *idRewriter) rewriteRaw(raw interface{}) {
+	switch r := raw.(type) {
+	case map[string]interface{}:
+		for k, val := range r {
+			if s, ok := val.(string); ok {
+				if k == "namespace" {
+					r[k] = w.namespace(s)
+				} else {
+					r[k] = w.replace(s)
+				}
+				continue
+			}
+			w.rewriteRaw(val)
+		}
+	case []interface{}:
+		for i, val := range r {
+			if s, ok := val.(string); ok {
+				r[i] = w.replace(s)
+				continue
+			}
+			w.rewriteRaw(val)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package parse_test
+
+import (
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_RenameNamespace(t *testing.T) {
+	docJSON := `{
+		"@context": "https://spdx.org/rdf/3.0.1/spdx-context.json",
+		"@graph": [
+			{
+				"type": "CreationInfo",
+				"@id": "_:creationinfo",
+				"specVersion": "3.0.1",
+				"created": "2024-01-01T00:00:00Z",
+				"createdBy": ["https://vendor.example/sbom/Person-1"]
+			},
+			{
+				"type": "SpdxDocument",
+				"spdxId": "https://vendor.example/sbom/DOCUMENT",
+				"creationInfo": "_:creationinfo",
+				"rootElement": ["https://vendor.example/sbom/Package-1"],
+				"element": ["https://vendor.example/sbom/Package-1", "https://vendor.example/sbom/Package-2"],
+				"import": [{"type": "ExternalMap", "externalSpdxId": "https://other.example/sbom/lib"}],
+				"namespaceMap": [{"type": "NamespaceMap", "prefix": "v", "namespace": "https://vendor.example/sbom/"}]
+			},
+			{"type": "Person", "spdxId": "https://vendor.example/sbom/Person-1", "name": "Jane"},
+			{"type": "software_Package", "spdxId": "https://vendor.example/sbom/Package-1", "name": "app"},
+			{"type": "software_Package", "spdxId": "https://vendor.example/sbom/Package-2", "name": "lib"},
+			{
+				"type": "Relationship",
+				"spdxId": "https://vendor.example/sbom/Rel-1",
+				"from": "https://vendor.example/sbom/Package-1",
+				"to": ["https://vendor.example/sbom/Package-2", "https://other.example/sbom/lib", "NoneElement"],
+				"relationshipType": "dependsOn"
+			},
+			{
+				"type": "Annotation",
+				"spdxId": "https://vendor.example/sbom/Annotation-1",
+				"subject": "https://vendor.example/sbom/Package-2",
+				"annotationType": "review",
+				"statement": "ok"
+			}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	doc.RenameNamespace("https://vendor.example/sbom/", "https://acme.example/sbom/")
+
+	const (
+		newDoc = "https://acme.example/sbom/DOCUMENT"
+		newPkg = "https://acme.example/sbom/Package-1"
+		newDep = "https://acme.example/sbom/Package-2"
+	)
+
+	if doc.GetSpdxID() != newDoc {
+		t.Errorf("document ID = %q, want %q", doc.GetSpdxID(), newDoc)
+	}
+	if got := doc.SpdxDocument.RootElement[0].SpdxID; got != newPkg {
+		t.Errorf("root element = %q, want %q", got, newPkg)
+	}
+	if got := doc.SpdxDocument.NamespaceMap[0].Namespace; got != "https://acme.example/sbom/" {
+		t.Errorf("namespace map = %q, want new namespace", got)
+	}
+	if got := doc.SpdxDocument.Import[0].ExternalSpdxId; got != "https://other.example/sbom/lib" {
+		t.Errorf("import outside namespace was rewritten to %q", got)
+	}
+
+	if doc.GetPackageByID(newPkg) == nil {
+		t.Error("expected package to be indexed under its new ID")
+	}
+	if doc.GetPackageByID("https://vendor.example/sbom/Package-1") != nil {
+		t.Error("expected old package ID to be gone from the index")
+	}
+	if doc.GetElementByID(newDep) == nil {
+		t.Error("expected raw element to be indexed under its new ID")
+	}
+
+	deps := doc.GetDependenciesFor(newPkg)
+	if len(deps) != 1 || deps[0].SpdxID != newDep {
+		t.Errorf("GetDependenciesFor() = %v, want [%s]", deps, newDep)
+	}
+	rel := doc.Relationships[0]
+	if rel.From.SpdxID != newPkg {
+		t.Errorf("relationship from = %q, want %q", rel.From.SpdxID, newPkg)
+	}
+	if rel.To[2].SpdxID != spdx.NoneElementIRI {
+		t.Errorf("individual was rewritten to %q", rel.To[2].SpdxID)
+	}
+
+	if got := doc.Annotations[0].Subject.SpdxID; got != newDep {
+		t.Errorf("annotation subject = %q, want %q", got, newDep)
+	}
+	if ann := doc.GetAnnotationsFor(newDep); len(ann) != 1 {
+		t.Errorf("expected 1 annotation for %s, got %d", newDep, len(ann))
+	}
+
+	if doc.CreationInfo == nil || len(doc.CreationInfo.CreatedBy) != 1 {
+		t.Fatal("expected creation info with one agent")
+	}
+	if got := doc.CreationInfo.CreatedBy[0].SpdxID; got != "https://acme.example/sbom/Person-1" {
+		t.Errorf("creation info agent = %q, want new ID", got)
+	}
+}
+
+func TestDocument_RewriteIDs(t *testing.T) {
+	docJSON := `{
+		"@graph": [
+			{"type": "software_Package", "spdxId": "A", "name": "a"},
+			{"type": "software_Package", "spdxId": "B", "name": "b"},
+			{"type": "Relationship", "spdxId": "R", "from": "A", "to": ["B"], "relationshipType": "dependsOn"}
+		]
+	}`
+
+	doc, err := parse.NewReader().Read([]byte(docJSON))
+	if err != nil {
+		t.Fatalf("failed to parse document: %v", err)
+	}
+
+	// Swapping IDs must not rewrite any occurrence twice.
+	doc.RewriteIDs(func(id string) string {
+		switch id {
+		case "A":
+			return "B"
+		case "B":
+			return "A"
+		}
+		return id
+	})
+
+	if pkg := doc.GetPackageByID("A"); pkg == nil || pkg.Name != "b" {
+		t.Errorf("expected package b under ID A, got %+v", pkg)
+	}
+	rel := doc.Relationships[0]
+	if rel.From.SpdxID != "B" || rel.To[0].SpdxID != "A" {
+		t.Errorf("relationship = %s -> %s, want B -> A", rel.From.SpdxID, rel.To[0].SpdxID)
+	}
+}
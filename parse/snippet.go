@@ -0,0 +1,43 @@
+package parse
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// ExtractSnippet returns the content of a snippet of the document, as
+// Snippet.Extract does, from its file in fsys: the file of the document
+// that is the snippet's snippetFromFile, at its name taken as a path
+// relative to the root of fsys.
+//
+//	code, err := doc.ExtractSnippet(os.DirFS("checkout"), "urn:spdx:snippet-1")
+func (d *Document) ExtractSnippet(fsys fs.FS, spdxID string) ([]byte, error) {
+	snippet := d.GetSnippetByID(spdxID)
+	if snippet == nil {
+		return nil, fmt.Errorf("snippet %q is not in the document", spdxID)
+	}
+	file := d.GetFileByID(snippet.SnippetFromFile.SpdxID)
+	if file == nil {
+		return nil, fmt.Errorf("file %q of snippet %q is not in the document", snippet.SnippetFromFile.SpdxID, spdxID)
+	}
+	name := strings.TrimPrefix(path.Clean("/"+file.Name), "/")
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("opening file: %w", err)
+	}
+	defer f.Close()
+
+	r, ok := f.(io.ReaderAt)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading file: %w", err)
+		}
+		r = bytes.NewReader(data)
+	}
+	return snippet.Extract(r)
+}
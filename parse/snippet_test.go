@@ -0,0 +1,38 @@
+package parse_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestDocument_ExtractSnippet(t *testing.T) {
+	doc, err := parse.NewReader().Read(watchDoc(
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "name": "./cmd/app/main.go"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:gone", "name": "gone.go"}`,
+		`{"type": "software_Snippet", "spdxId": "urn:spdx:func", "software_snippetFromFile": "urn:spdx:main",
+		  "software_lineRange": {"type": "PositiveIntegerRange", "beginIntegerRange": 3, "endIntegerRange": 5}}`,
+		`{"type": "software_Snippet", "spdxId": "urn:spdx:orphan", "software_snippetFromFile": "urn:spdx:missing",
+		  "software_byteRange": {"type": "PositiveIntegerRange", "beginIntegerRange": 1, "endIntegerRange": 2}}`,
+		`{"type": "software_Snippet", "spdxId": "urn:spdx:unread", "software_snippetFromFile": "urn:spdx:gone",
+		  "software_byteRange": {"type": "PositiveIntegerRange", "beginIntegerRange": 1, "endIntegerRange": 2}}`,
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{"cmd/app/main.go": {Data: []byte("package main\n\nfunc main() {\n\tprintln(\"hi\")\n}\n")}}
+
+	got, err := doc.ExtractSnippet(fsys, "urn:spdx:func")
+	if err != nil {
+		t.Fatalf("ExtractSnippet() error = %v", err)
+	}
+	if want := "func main() {\n\tprintln(\"hi\")\n}\n"; string(got) != want {
+		t.Errorf("ExtractSnippet() = %q, want %q", got, want)
+	}
+	for _, id := range []string{"urn:spdx:orphan", "urn:spdx:unread", "urn:spdx:missing"} {
+		if _, err := doc.ExtractSnippet(fsys, id); err == nil {
+			t.Errorf("ExtractSnippet(%q) error = nil, want an error", id)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package parse
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ElementStore holds the elements of the documents read by readers
+// WithElementStore by their fingerprint, the digest of their JSON, so that
+// elements appearing unchanged in many documents, such as the packages of
+// shared dependencies and their licenses, are held once and referenced by
+// every document instead of each holding its own copy. It suits analyses
+// of a fleet of SBOMs in one process:
+//
+//	store := parse.NewElementStore()
+//	reader := parse.NewReader(parse.WithStreaming(), parse.WithElementStore(store))
+//	for _, path := range paths {
+//	    doc, err := reader.ReadFile(path)
+//	    ...
+//	}
+//	stats := store.Stats()
+//	fmt.Printf("%d elements held for %d read\n", stats.Elements, stats.References)
+//
+// The documents share the stored elements, so modifying an element of one
+// document, including through RenameNamespace, Redact, ReduceToLite and
+// NormalizeTimestamps, modifies it in all of them; copy an element before
+// changing it. Readers WithNormalizedTimestamps normalize the elements
+// before storing them, and share them only with readers normalizing them
+// the same way. Elements
+// of registered types and of types the model does not define are not
+// stored.
+//
+// An ElementStore is safe for concurrent use.
+type ElementStore struct {
+	mu    sync.Mutex
+	elems map[[sha256.Size]byte]*storedElement
+	// maps holds the addresses of the stored JSON maps.
+	maps map[uintptr]bool
+	refs int
+}
+
+// storedElement is an element held by an ElementStore.
+type storedElement struct {
+	obj interface{}
+	// elemMap is the JSON map of the element, for documents not read
+	// WithStreaming, or nil if only such documents have read it.
+	elemMap map[string]interface{}
+}
+
+// ElementStoreStats counts the elements of an ElementStore.
+type ElementStoreStats struct {
+	// Elements is the number of distinct elements the store holds.
+	Elements int
+	// References is the number of elements of read documents that
+	// reference them: those the store took in plus those it shared.
+	References int
+}
+
+// NewElementStore creates an empty element store.
+func NewElementStore() *ElementStore {
+	return &ElementStore{elems: make(map[[sha256.Size]byte]*storedElement), maps: make(map[uintptr]bool)}
+}
+
+// WithElementStore makes the reader share the elements of the documents
+// it reads through store, which several readers may share.
+func WithElementStore(store *ElementStore) Option {
+	return optionFunc(func(r *Reader) {
+		r.store = store
+	})
+}
+
+// Len returns the number of distinct elements the store holds.
+func (s *ElementStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.elems)
+}
+
+// Stats returns the counts of the elements of the store.
+func (s *ElementStore) Stats() ElementStoreStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return ElementStoreStats{Elements: len(s.elems), References: s.refs}
+}
+
+// intern returns the stored copy of an element parsed into obj, and its
+// JSON map, storing them if the store holds no copy of the element.
+// elemMap is nil for elements decoded WithStreaming. The timestamps of the
+// element are normalized to f, if it is not nil, before it is stored, as
+// the documents sharing it must not modify it afterwards.
+func (s *ElementStore) intern(fp [sha256.Size]byte, obj interface{}, elemMap map[string]interface{}, f *TimestampFormat) (interface{}, map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refs++
+	e, ok := s.elems[fp]
+	if !ok {
+		if f != nil {
+			normalizeTimes(reflect.ValueOf(obj), *f, make(map[seenKey]bool))
+		}
+		e = &storedElement{obj: obj}
+		s.elems[fp] = e
+	}
+	if e.elemMap == nil && elemMap != nil {
+		if f != nil {
+			formatRawTimes(elemMap, *f)
+		}
+		e.elemMap = elemMap
+		s.maps[reflect.ValueOf(elemMap).Pointer()] = true
+	}
+	if elemMap == nil {
+		return e.obj, nil
+	}
+	return e.obj, e.elemMap
+}
+
+// holdsMap reports whether the store holds a JSON map.
+func (s *ElementStore) holdsMap(m map[string]interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.maps[reflect.ValueOf(m).Pointer()]
+}
+
+// fingerprint returns the SHA-256 digest of the JSON of an element decoded
+// into v, with the keys of its objects sorted and without insignificant
+// whitespace, which copies of an element share however they are
+// formatted. Elements whose timestamps are normalized to f, if it is not
+// nil, have other fingerprints than those normalized otherwise or not at
+// all.
+func fingerprint(v interface{}, f *TimestampFormat) ([sha256.Size]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	h := sha256.New()
+	if f != nil {
+		fmt.Fprintf(h, "timestamps %d %t\n", f.precision(), f.KeepZone)
+	}
+	h.Write(data)
+	var fp [sha256.Size]byte
+	h.Sum(fp[:0])
+	return fp, nil
+}
+
+// internMap returns the stored copies of an element parsed from elemMap
+// into obj, if the reader has a store.
+func (r *Reader) internMap(obj interface{}, elemMap map[string]interface{}) (interface{}, map[string]interface{}) {
+	if r.store == nil {
+		return obj, elemMap
+	}
+	fp, err := fingerprint(elemMap, r.timestamps)
+	if err != nil {
+		return obj, elemMap
+	}
+	return r.store.intern(fp, obj, elemMap, r.timestamps)
+}
+
+// internJSON returns the stored copy of an element decoded from data into
+// obj, if the reader has a store.
+func (r *Reader) internJSON(obj interface{}, data []byte) interface{} {
+	if r.store == nil {
+		return obj
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return obj
+	}
+	fp, err := fingerprint(v, r.timestamps)
+	if err != nil {
+		return obj
+	}
+	obj, _ = r.store.intern(fp, obj, nil, r.timestamps)
+	return obj
+}
@@ -0,0 +1,130 @@
+package parse_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestWithElementStore(t *testing.T) {
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib", "software_packageVersion": "1.0"}`
+	// The same package, formatted differently.
+	libAgain := `{
+		"software_packageVersion": "1.0",
+		"name": "lib",
+		"spdxId": "urn:spdx:lib",
+		"type": "software_Package"
+	}`
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`
+	tool := `{"type": "software_Package", "spdxId": "urn:spdx:tool", "name": "tool"}`
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			store := parse.NewElementStore()
+			reader := parse.NewReader(append(opts, parse.WithElementStore(store))...)
+			a, err := reader.Read(watchDoc(app, lib))
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := reader.Read(watchDoc(tool, libAgain))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if a.GetPackageByID("urn:spdx:lib") != b.GetPackageByID("urn:spdx:lib") {
+				t.Error("the documents hold separate copies of the same package, want one shared")
+			}
+			if a.GetPackageByID("urn:spdx:app") == nil || b.GetPackageByID("urn:spdx:tool") == nil {
+				t.Error("packages of only one document are missing")
+			}
+			if _, ok := a.ElementsByID["urn:spdx:lib"].(map[string]interface{}); mode == "maps" && !ok {
+				t.Errorf("ElementsByID[lib] = %T, want its JSON map", a.ElementsByID["urn:spdx:lib"])
+			}
+			if got := b.ElementsByID["urn:spdx:lib"]; mode == "streaming" && got != interface{}(a.GetPackageByID("urn:spdx:lib")) {
+				t.Errorf("ElementsByID[lib] = %p, want the shared package", got)
+			}
+
+			// The documents also share their creation info: app, tool, lib
+			// and it are held for the six elements read.
+			want := parse.ElementStoreStats{Elements: 4, References: 6}
+			if got := store.Stats(); got != want {
+				t.Errorf("Stats() = %+v, want %+v", got, want)
+			}
+			if got := store.Len(); got != want.Elements {
+				t.Errorf("Len() = %d, want %d", got, want.Elements)
+			}
+		})
+	}
+}
+
+func TestWithElementStore_SharedAcrossModes(t *testing.T) {
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`
+	store := parse.NewElementStore()
+	streamed, err := parse.NewReader(parse.WithStreaming(), parse.WithElementStore(store)).Read(watchDoc(lib))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapped, err := parse.NewReader(parse.WithElementStore(store)).Read(watchDoc(lib))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if streamed.GetPackageByID("urn:spdx:lib") != mapped.GetPackageByID("urn:spdx:lib") {
+		t.Error("readers sharing a store hold separate copies of the same package")
+	}
+	if _, ok := mapped.ElementsByID["urn:spdx:lib"].(map[string]interface{}); !ok {
+		t.Errorf("ElementsByID[lib] = %T, want its JSON map", mapped.ElementsByID["urn:spdx:lib"])
+	}
+}
+
+func TestWithElementStore_NormalizedTimestamps(t *testing.T) {
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "builtTime": "2024-05-01T10:00:00.123456+02:00"}`
+	seconds := parse.WithNormalizedTimestamps(parse.TimestampFormat{})
+	millis := parse.WithNormalizedTimestamps(parse.TimestampFormat{Precision: time.Millisecond})
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			store := parse.NewElementStore()
+			read := func(format parse.Option) *parse.Document {
+				doc, err := parse.NewReader(append(opts, parse.WithElementStore(store), format)...).Read(watchDoc(app))
+				if err != nil {
+					t.Fatal(err)
+				}
+				return doc
+			}
+
+			// Readers sharing the store concurrently, as the race
+			// detector checks.
+			var wg sync.WaitGroup
+			docs := make([]*parse.Document, 8)
+			for i := range docs {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					docs[i] = read(seconds)
+				}()
+			}
+			wg.Wait()
+			ms := read(millis)
+
+			want := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+			for _, doc := range docs {
+				if got := doc.GetPackageByID("urn:spdx:app").BuiltTime; !got.Equal(want) || got.Location() != time.UTC {
+					t.Errorf("BuiltTime = %v, want %v", got, want)
+				}
+				if doc.GetPackageByID("urn:spdx:app") != docs[0].GetPackageByID("urn:spdx:app") {
+					t.Error("readers normalizing timestamps the same way hold separate copies")
+				}
+			}
+			if got, want := ms.GetPackageByID("urn:spdx:app").BuiltTime, want.Add(123*time.Millisecond); !got.Equal(want) {
+				t.Errorf("BuiltTime read with millisecond precision = %v, want %v", got, want)
+			}
+			if mode == "maps" {
+				if got := ms.ElementsByID["urn:spdx:app"].(map[string]interface{})["builtTime"]; got != "2024-05-01T08:00:00.123Z" {
+					t.Errorf("raw builtTime = %v, want it with millisecond precision", got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package parse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
+)
+
+// decode reads a document from the token stream of dec, as parse does
+// from a decoded interface{} tree. The graph is read one element at a
+// time into a reused buffer, and each element is decoded straight into its
+// typed struct. Only elements left to the registry, which parses JSON
+// maps, are decoded into a map. Readers WithPooling also reuse the buffers
+// across documents. The limits of the reader are checked on each value as
+// it is read.
+func (r *Reader) decode(dec *json.Decoder, source string) (*Document, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	if tok != json.Delim('{') {
+		return nil, fmt.Errorf("document is not a JSON object")
+	}
+
+	doc := newDocument()
+	doc.typed = true
+	r.startProvenance(doc, source)
+	r.startRaw(doc)
+	buf := r.getBuffers()
+	defer r.putBuffers(buf)
+	graph, elements := false, 0
+	positions := make(map[string]int64)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		switch tok {
+		case "@context":
+			var ctx interface{}
+			if err := dec.Decode(&buf.raw); err != nil {
+				return nil, fmt.Errorf("parsing JSON: %w", err)
+			}
+			if err := r.limits.checkJSON(buf.raw, 1); err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(buf.raw, &ctx); err != nil {
+				return nil, fmt.Errorf("parsing JSON: %w", err)
+			}
+			doc.Context = r.parseContext(ctx)
+		case "@graph":
+			if tok, err := dec.Token(); err != nil {
+				return nil, fmt.Errorf("parsing JSON: %w", err)
+			} else if tok != json.Delim('[') {
+				return nil, fmt.Errorf("document does not contain @graph array")
+			}
+			p := r.startProgress(PhaseElements, 0)
+			for dec.More() {
+				p.update(elements)
+				elements++
+				if err := r.limits.checkElements(elements); err != nil {
+					return nil, err
+				}
+				if err := dec.Decode(&buf.raw); err != nil {
+					return nil, fmt.Errorf("parsing JSON: %w", err)
+				}
+				if err := r.limits.checkJSON(buf.raw, 2); err != nil {
+					return nil, err
+				}
+				// Entries that are not objects are skipped, as parse does.
+				if buf.raw[0] != '{' {
+					r.logger.Debug("skipping graph entry that is not an object", "index", elements-1)
+					continue
+				}
+				offset := dec.InputOffset() - int64(len(buf.raw))
+				if err := r.decodeElement(doc, buf.decoder, buf.raw, offset, positions); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, fmt.Errorf("parsing JSON: %w", err)
+			}
+			p.finish(elements)
+			graph = true
+		default:
+			if err := dec.Decode(&buf.raw); err != nil {
+				return nil, fmt.Errorf("parsing JSON: %w", err)
+			}
+			if err := r.limits.checkJSON(buf.raw, 1); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("parsing JSON: invalid data after top-level value")
+	}
+	if !graph {
+		return nil, fmt.Errorf("document does not contain @graph array")
+	}
+
+	doc.orderGraph(positions)
+	r.normalizeTimestamps(doc)
+	r.indexDocument(doc)
+	doc.finishProvenance()
+	r.logger.Debug("read SPDX document", "elements", elements)
+	return doc, nil
+}
+
+// decodeElement decodes a graph element read at offset, files it into the
+// document and calls the handlers of its type, as categorizeElement does
+// for JSON maps. The offset is recorded in positions under the ID of the
+// element.
+func (r *Reader) decodeElement(doc *Document, d *parser.Decoder, data []byte, offset int64, positions map[string]int64) error {
+	obj, head, ok, err := d.Decode(data)
+	if err != nil {
+		return fmt.Errorf("parsing JSON: %w", err)
+	}
+	if ok {
+		obj = r.internJSON(obj, data)
+	}
+	elemType := ElementType(head.Type)
+	r.logCoercedType(elemType, head.SpdxID)
+	if head.SpdxID != "" {
+		r.checkDuplicate(doc, head.SpdxID)
+		positions[head.SpdxID] = offset
+		if doc.provenance != nil {
+			doc.provenance.offsets[head.SpdxID] = offset
+		}
+		if doc.raw != nil {
+			doc.raw[head.SpdxID] = append(json.RawMessage(nil), data...)
+		}
+	}
+	if ok && r.fileElement(doc, obj, head.SpdxID) {
+		if head.SpdxID != "" {
+			doc.ElementsByID[head.SpdxID] = obj
+		}
+		if !r.handles(elemType) {
+			return nil
+		}
+		var elemMap map[string]interface{}
+		if err := json.Unmarshal(data, &elemMap); err != nil {
+			return fmt.Errorf("parsing JSON: %w", err)
+		}
+		return r.callHandlers(elemType, head.SpdxID, obj, elemMap)
+	}
+
+	r.logUnfiled(elemType, head.SpdxID, ok)
+	var elemMap map[string]interface{}
+	if _, registered := r.lookup(elemType); registered || !ok || r.handles(elemType) {
+		if err := json.Unmarshal(data, &elemMap); err != nil {
+			return fmt.Errorf("parsing JSON: %w", err)
+		}
+	}
+	n := len(doc.Extensions)
+	if elemMap != nil {
+		if err := r.handleRegisteredElements(doc, elemMap, elemType); err != nil {
+			return err
+		}
+	}
+	if head.SpdxID != "" {
+		switch {
+		case len(doc.Extensions) > n:
+			doc.ElementsByID[head.SpdxID] = doc.Extensions[n]
+		case ok:
+			doc.ElementsByID[head.SpdxID] = obj
+		default:
+			doc.ElementsByID[head.SpdxID] = elemMap
+		}
+	}
+	if !ok {
+		obj = nil
+	}
+	return r.callHandlers(elemType, head.SpdxID, obj, elemMap)
+}
+
+// decodeBuffers holds the buffers decode uses for a document.
+type decodeBuffers struct {
+	decoder *parser.Decoder
+	raw     json.RawMessage
+}
+
+// maxPooledElement is the capacity of the largest element buffer kept for
+// reuse.
+const maxPooledElement = 1 << 20
+
+// getBuffers returns buffers for decoding a document, taken from the pool
+// of the reader if it has one.
+func (r *Reader) getBuffers() *decodeBuffers {
+	if r.pool != nil {
+		if buf, ok := r.pool.Get().(*decodeBuffers); ok {
+			return buf
+		}
+	}
+	return &decodeBuffers{decoder: r.parser.NewDecoder()}
+}
+
+// putBuffers returns the buffers of a decoded document to the pool of the
+// reader, if it has one.
+func (r *Reader) putBuffers(buf *decodeBuffers) {
+	if r.pool == nil {
+		return
+	}
+	buf.decoder.Reset()
+	if cap(buf.raw) > maxPooledElement {
+		buf.raw = nil
+	}
+	r.pool.Put(buf)
+}
+
+// lookup returns the registry entry of a type, if the reader has a
+// registry.
+func (r *Reader) lookup(t ElementType) (TypeInfo, bool) {
+	if r.registry == nil {
+		return TypeInfo{}, false
+	}
+	return r.registry.Lookup(t)
+}
@@ -0,0 +1,218 @@
+package parse_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// lenientDocJSON exercises the leniency of the reader: legacy type names,
+// unprefixed properties, single values for lists, inline references,
+// escapes, duplicate keys and values of the wrong type.
+const lenientDocJSON = `{
+	"spdxVersion": "ignored",
+	"@graph": [
+		"_:not-an-object",
+		{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z", "createdBy": "urn:spdx:acme"},
+		{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme \"Labs\" é", "creationInfo": "_:ci"},
+		{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "first", "name": "app", "packageVersion": "1.0",
+		 "software_primaryPurpose": "APPLICATION", "suppliedBy": {"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme"},
+		 "verifiedUsing": [{"type": "Hash", "algorithm": "SHA256", "hashValue": "ab12"}, "not-an-object"],
+		 "software_copyrightText": 42, "builtTime": "not a time", "releaseTime": "2024-01-02T03:04:05Z"},
+		{"type": "Relationship", "spdxId": "urn:spdx:rel", "from": "urn:spdx:app", "to": ["NOASSERTION"], "relationshipType": "hasConcludedLicense"},
+		{"type": "LicenseExpression", "spdxId": "urn:spdx:expr", "licenseExpression": "MIT OR Apache-2.0"},
+		{"type": "security_CvssV3VulnAssessmentRelationship", "spdxId": "urn:spdx:cvss", "security_score": 9.8, "security_severity": "critical",
+		 "from": "urn:spdx:vuln", "to": "urn:spdx:app", "relationshipType": "hasAssessmentFor"},
+		{"type": "ai_EnergyConsumption", "spdxId": "urn:spdx:energy", "ai_trainingEnergyConsumption": [{"ai_energyQuantity": 1.5, "energyUnit": "kilowattHour"}]},
+		{"type": "software_Snippet", "spdxId": "urn:spdx:snippet", "byteRange": {"beginIntegerRange": 1, "endIntegerRange": 20}},
+		{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown", "name": "kept raw"}
+	],
+	"@context": ["https://spdx.org/rdf/3.0.1/spdx-context.jsonld"]
+}`
+
+// TestReader_WithStreaming checks that streamed documents equal those read
+// through JSON maps, apart from the typed elements in ElementsByID.
+func TestReader_WithStreaming(t *testing.T) {
+	inputs := map[string][]byte{"lenient": []byte(lenientDocJSON)}
+	files, err := filepath.Glob("testdata/golden/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs[filepath.Base(file)] = data
+	}
+
+	for name, data := range inputs {
+		t.Run(name, func(t *testing.T) {
+			want, err := parse.NewReader().Read(data)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			got, err := parse.NewReader(parse.WithStreaming()).Read(data)
+			if err != nil {
+				t.Fatalf("Read() with streaming error = %v", err)
+			}
+			assertSameDocument(t, got, want)
+
+			streamed, err := parse.NewReader(parse.WithStreaming()).FromReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("FromReader() with streaming error = %v", err)
+			}
+			assertSameDocument(t, streamed, want)
+		})
+	}
+
+	doc, err := parse.NewReader(parse.WithStreaming()).Read([]byte(lenientDocJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg, ok := doc.GetElementByID("urn:spdx:app").(*spdx.Package); !ok || pkg != doc.PackagesByID["urn:spdx:app"] {
+		t.Errorf("ElementsByID holds %T, want the typed package", doc.GetElementByID("urn:spdx:app"))
+	}
+	if raw, ok := doc.GetElementByID("urn:spdx:unknown").(map[string]interface{}); !ok || raw["name"] != "kept raw" {
+		t.Errorf("ElementsByID holds %v for an unknown type, want its JSON map", doc.GetElementByID("urn:spdx:unknown"))
+	}
+	if org := doc.OrganizationsByID["urn:spdx:acme"]; org == nil || org.Name != "Acme \"Labs\" é" {
+		t.Errorf("escaped name = %v", org)
+	}
+}
+
+func TestReader_WithStreaming_Errors(t *testing.T) {
+	tests := []struct {
+		input       string
+		errContains string
+	}{
+		{`{invalid}`, "parsing JSON"},
+		{`[]`, "document is not a JSON object"},
+		{`{"@context": "x"}`, "does not contain @graph array"},
+		{`{"@graph": {}}`, "does not contain @graph array"},
+		{`{"@graph": [{"type": "Person"`, "parsing JSON"},
+		{`{"@graph": []} {}`, "invalid data after top-level value"},
+	}
+	reader := parse.NewReader(parse.WithStreaming())
+	for _, tt := range tests {
+		if _, err := reader.Read([]byte(tt.input)); err == nil || !strings.Contains(err.Error(), tt.errContains) {
+			t.Errorf("Read(%s) error = %v, want %q", tt.input, err, tt.errContains)
+		}
+	}
+}
+
+func TestReader_WithPooling(t *testing.T) {
+	docs := [][]byte{[]byte(lenientDocJSON), []byte(lazyDocJSON), benchmarkDocument(t, 50)}
+	want := make([]*parse.Document, len(docs))
+	for i, data := range docs {
+		doc, err := parse.NewReader().Read(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[i] = doc
+	}
+
+	// Documents read one after the other and concurrently reuse each
+	// other's buffers, and must not see each other's contents.
+	reader := parse.NewReader(parse.WithPooling())
+	got := make([][]*parse.Document, 4)
+	var wg sync.WaitGroup
+	for g := range got {
+		wg.Go(func() {
+			for range 3 {
+				for _, data := range docs {
+					doc, err := reader.Read(data)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					got[g] = append(got[g], doc)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	for _, docs := range got {
+		for i, doc := range docs {
+			assertSameDocument(t, doc, want[i%len(want)])
+		}
+	}
+}
+
+// assertSameDocument compares every exported field of two documents but
+// ElementsByID, whose keys only are compared.
+func assertSameDocument(t *testing.T, got, want *parse.Document) {
+	t.Helper()
+	gv, wv := reflect.ValueOf(got).Elem(), reflect.ValueOf(want).Elem()
+	for i := 0; i < gv.NumField(); i++ {
+		f := gv.Type().Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		if f.Name == "ElementsByID" {
+			for id := range want.ElementsByID {
+				if _, ok := got.ElementsByID[id]; !ok {
+					t.Errorf("ElementsByID lacks %s", id)
+				}
+			}
+			if len(got.ElementsByID) != len(want.ElementsByID) {
+				t.Errorf("ElementsByID has %d elements, want %d", len(got.ElementsByID), len(want.ElementsByID))
+			}
+			continue
+		}
+		if !reflect.DeepEqual(gv.Field(i).Interface(), wv.Field(i).Interface()) {
+			t.Errorf("%s = %#v, want %#v", f.Name, gv.Field(i).Interface(), wv.Field(i).Interface())
+		}
+	}
+}
+
+func BenchmarkReader_Read(b *testing.B) {
+	data := benchmarkDocument(b, 2000)
+	for _, bm := range []struct {
+		name string
+		opts []parse.Option
+	}{
+		{"maps", nil},
+		{"streaming", []parse.Option{parse.WithStreaming()}},
+		{"pooled", []parse.Option{parse.WithPooling()}},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			reader := parse.NewReader(bm.opts...)
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for b.Loop() {
+				if _, err := reader.Read(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkDocument returns a document with n packages, each with a hash,
+// an external identifier and a dependency on the previous package.
+func benchmarkDocument(tb testing.TB, n int) []byte {
+	tb.Helper()
+	var buf bytes.Buffer
+	buf.WriteString(`{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [`)
+	buf.WriteString(`{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]}`)
+	for i := range n {
+		id := "urn:spdx:pkg-" + strconv.Itoa(i)
+		buf.WriteString(`,{"type": "software_Package", "spdxId": "` + id + `", "name": "library", "creationInfo": "_:ci",` +
+			`"software_packageVersion": "1.2.3", "software_packageUrl": "pkg:golang/example.com/library@1.2.3",` +
+			`"software_downloadLocation": "https://example.com/library.tar.gz", "software_primaryPurpose": "library",` +
+			`"verifiedUsing": [{"type": "Hash", "algorithm": "sha256", "hashValue": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"}],` +
+			`"externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "cpe23", "identifier": "cpe:2.3:a:example:library:1.2.3:*:*:*:*:*:*:*"}]}`)
+		buf.WriteString(`,{"type": "Relationship", "spdxId": "` + id + `-rel", "creationInfo": "_:ci", "from": "urn:spdx:app", "to": ["` + id + `"], "relationshipType": "dependsOn"}`)
+	}
+	buf.WriteString(`]}`)
+	return buf.Bytes()
+}
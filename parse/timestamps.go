@@ -0,0 +1,283 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// TimestampFormat says how timestamps are normalized, so that documents
+// produced by different tools, which write the same times in different
+// time zones and precisions, compare equal.
+type TimestampFormat struct {
+	// Precision is the precision timestamps are truncated to and written
+	// with, with as many fractional digits as it needs: three for
+	// time.Millisecond. The zero value is time.Second, the precision of
+	// the SPDX DateTime type.
+	Precision time.Duration
+
+	// KeepZone keeps the time zone offset of each timestamp instead of
+	// converting it to UTC.
+	KeepZone bool
+}
+
+// timestampProperties are the JSON-LD names of the properties of type
+// DateTime.
+var timestampProperties = map[string]bool{
+	"build_buildEndTime":           true,
+	"build_buildStartTime":         true,
+	"builtTime":                    true,
+	"created":                      true,
+	"endTime":                      true,
+	"releaseTime":                  true,
+	"security_actionStatementTime": true,
+	"security_impactStatementTime": true,
+	"security_modifiedTime":        true,
+	"security_publishedTime":       true,
+	"security_withdrawnTime":       true,
+	"startTime":                    true,
+	"validUntilTime":               true,
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+func (f TimestampFormat) precision() time.Duration {
+	if f.Precision <= 0 {
+		return time.Second
+	}
+	return f.Precision
+}
+
+// normalize truncates t to the precision and, unless KeepZone is set,
+// converts it to UTC.
+func (f TimestampFormat) normalize(t time.Time) time.Time {
+	t = t.Truncate(f.precision())
+	if !f.KeepZone {
+		t = t.UTC()
+	}
+	return t
+}
+
+// layout returns the time layout of the precision.
+func (f TimestampFormat) layout() string {
+	digits := 0
+	for q := time.Second; q > f.precision() && digits < 9; q /= 10 {
+		digits++
+	}
+	if digits == 0 {
+		return time.RFC3339
+	}
+	return "2006-01-02T15:04:05." + strings.Repeat("0", digits) + "Z07:00"
+}
+
+// format normalizes a timestamp string, leaving strings that are not
+// RFC 3339 timestamps unchanged.
+func (f TimestampFormat) format(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return f.normalize(t).Format(f.layout())
+}
+
+// WithNormalizedTimestamps makes the reader normalize the timestamps of
+// the documents it reads, as Document.NormalizeTimestamps does.
+//
+//	reader := parse.NewReader(parse.WithNormalizedTimestamps(parse.TimestampFormat{}))
+func WithNormalizedTimestamps(f TimestampFormat) Option {
+	return optionFunc(func(r *Reader) {
+		r.timestamps = &f
+	})
+}
+
+// normalizeTimestamps normalizes the timestamps of a document the reader
+// read, if it was created WithNormalizedTimestamps.
+func (r *Reader) normalizeTimestamps(doc *Document) {
+	switch {
+	case r.timestamps == nil:
+	case r.store == nil:
+		doc.NormalizeTimestamps(*r.timestamps)
+	default:
+		// The elements in the store were normalized as they were stored
+		// and are shared; only those the document holds alone are
+		// normalized here.
+		seen := make(map[seenKey]bool)
+		for _, ext := range doc.Extensions {
+			normalizeTimes(reflect.ValueOf(ext), *r.timestamps, seen)
+		}
+		for _, raw := range doc.ElementsByID {
+			if m, ok := raw.(map[string]interface{}); ok && !r.store.holdsMap(m) {
+				formatRawTimes(m, *r.timestamps)
+			}
+		}
+	}
+}
+
+// WithTimestampFormat writes the timestamps of the document normalized to
+// f, with exactly the fractional digits of its precision, leaving the
+// document itself unchanged.
+//
+//	data, err := doc.Bytes(parse.WithTimestampFormat(parse.TimestampFormat{Precision: time.Millisecond}))
+func WithTimestampFormat(f TimestampFormat) WriteOption {
+	return writeOptionFunc(func(w *writer) {
+		w.timestamps = &f
+	})
+}
+
+// NormalizeTimestamps normalizes every timestamp of the document in place:
+// the creation times, build, release and validity times of artifacts,
+// relationship start and end times and the times of vulnerabilities and
+// their assessments. The timestamps are truncated to the precision of f
+// and converted to UTC unless f.KeepZone is set. The raw elements in
+// ElementsByID are rewritten with exactly the fractional digits of the
+// precision; the typed times are written as time.Time is, without
+// trailing zeros, unless the document is written WithTimestampFormat.
+func (d *Document) NormalizeTimestamps(f TimestampFormat) {
+	seen := make(map[seenKey]bool)
+	visit := func(obj interface{}) {
+		normalizeTimes(reflect.ValueOf(obj), f, seen)
+	}
+	for elem := range d.AllElements() {
+		visit(elem)
+	}
+	visit(d.CreationInfo)
+	for _, raw := range d.ElementsByID {
+		if _, ok := raw.(spdx.AnyElement); ok {
+			visit(raw)
+			continue
+		}
+		formatRawTimes(raw, f)
+	}
+}
+
+// normalizeTimes normalizes the time.Time values reachable from v.
+func normalizeTimes(v reflect.Value, f TimestampFormat, seen map[seenKey]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		key := seenKey{ptr: v.Pointer(), typ: v.Type()}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		normalizeTimes(v.Elem(), f, seen)
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			normalizeTimes(v.Elem(), f, seen)
+		}
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			if t := v.Interface().(time.Time); !t.IsZero() && v.CanSet() {
+				v.Set(reflect.ValueOf(f.normalize(t)))
+			}
+			return
+		}
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).IsExported() {
+				normalizeTimes(v.Field(i), f, seen)
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			normalizeTimes(v.Index(i), f, seen)
+		}
+	}
+}
+
+// formatRawTimes normalizes the timestamp properties of a raw JSON value,
+// at any depth.
+func formatRawTimes(raw interface{}, f TimestampFormat) {
+	switch v := raw.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if s, ok := val.(string); ok && timestampProperties[key] {
+				v[key] = f.format(s)
+				continue
+			}
+			formatRawTimes(val, f)
+		}
+	case []interface{}:
+		for _, val := range v {
+			formatRawTimes(val, f)
+		}
+	}
+}
+
+// formatTimestamps rewrites the timestamp properties of encoded JSON,
+// keeping the order of the properties.
+func formatTimestamps(data []byte, f TimestampFormat) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	type container struct {
+		object bool
+		n      int // tokens written, keys and values
+		key    string
+	}
+	var stack []container
+	var buf bytes.Buffer
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return buf.Bytes(), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if delim, ok := tok.(json.Delim); ok && (delim == '}' || delim == ']') {
+			buf.WriteByte(byte(delim))
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		var top *container
+		isKey := false
+		if len(stack) > 0 {
+			top = &stack[len(stack)-1]
+			isKey = top.object && top.n%2 == 0
+			switch {
+			case top.object && !isKey:
+				buf.WriteByte(':')
+			case top.n > 0:
+				buf.WriteByte(',')
+			}
+			top.n++
+		}
+
+		switch v := tok.(type) {
+		case json.Delim:
+			buf.WriteByte(byte(v))
+			stack = append(stack, container{object: v == '{'})
+		case string:
+			if isKey {
+				top.key = v
+			} else if top != nil && top.object && timestampProperties[top.key] {
+				v = f.format(v)
+			}
+			s, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			buf.Write(s)
+		case json.Number:
+			buf.WriteString(v.String())
+		case bool:
+			buf.WriteString(strconv.FormatBool(v))
+		case nil:
+			buf.WriteString("null")
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package parse_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+func TestReader_WithNormalizedTimestamps(t *testing.T) {
+	data := []byte(`{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [
+		{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T12:30:15.123456+02:00", "createdBy": ["urn:spdx:acme"]},
+		{"type": "software_Package", "spdxId": "urn:spdx:app", "creationInfo": "_:ci", "name": "app", "releaseTime": "2024-04-30T23:59:59.9Z"},
+		{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown", "created": "2024-05-01T08:00:00-04:00"}
+	]}`)
+
+	tests := []struct {
+		name    string
+		format  parse.TimestampFormat
+		created time.Time
+		release string
+		raw     string
+	}{
+		{
+			name:    "utc seconds",
+			created: time.Date(2024, 5, 1, 10, 30, 15, 0, time.UTC),
+			release: "2024-04-30T23:59:59Z",
+			raw:     "2024-05-01T12:00:00Z",
+		},
+		{
+			name:    "keep zone milliseconds",
+			format:  parse.TimestampFormat{Precision: time.Millisecond, KeepZone: true},
+			created: time.Date(2024, 5, 1, 10, 30, 15, 123000000, time.UTC),
+			release: "2024-04-30T23:59:59.900Z",
+			raw:     "2024-05-01T08:00:00.000-04:00",
+		},
+	}
+
+	for _, tt := range tests {
+		for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+			t.Run(tt.name+"/"+mode, func(t *testing.T) {
+				doc, err := parse.NewReader(append(opts, parse.WithNormalizedTimestamps(tt.format))...).Read(data)
+				if err != nil {
+					t.Fatal(err)
+				}
+				created := doc.CreationInfo.Created
+				if !created.Equal(tt.created) {
+					t.Errorf("created = %v, want %v", created, tt.created)
+				}
+				if _, offset := created.Zone(); tt.format.KeepZone != (offset != 0) {
+					t.Errorf("created = %v, KeepZone %v", created, tt.format.KeepZone)
+				}
+				if raw := doc.ElementsByID["urn:spdx:unknown"].(map[string]interface{}); raw["created"] != tt.raw {
+					t.Errorf("raw created = %v, want %s", raw["created"], tt.raw)
+				}
+
+				out, err := doc.Bytes(parse.WithTimestampFormat(tt.format))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !strings.Contains(string(out), `"releaseTime":"`+tt.release+`"`) {
+					t.Errorf("written releaseTime is not %s in %s", tt.release, out)
+				}
+			})
+		}
+	}
+}
+
+func TestDocument_Bytes_WithTimestampFormat(t *testing.T) {
+	doc, err := parse.NewReader().Read(watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "creationInfo": "_:ci", "name": "app", "builtTime": "2024-04-30T20:00:00.5-05:00", "description": "built \"2024-04-30T20:00:00.5-05:00\""}`,
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := doc.Bytes(parse.WithTimestampFormat(parse.TimestampFormat{}), parse.WithIndent("", "  "))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`"builtTime": "2024-05-01T01:00:00Z"`,
+		`"created": "2024-05-01T00:00:00Z"`,
+		`"description": "built \"2024-04-30T20:00:00.5-05:00\""`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("written document does not contain %s:\n%s", want, out)
+		}
+	}
+	if got := doc.GetPackageByID("urn:spdx:app").BuiltTime; got.Nanosecond() == 0 {
+		t.Errorf("Bytes() changed the document: builtTime = %v", got)
+	}
+	if _, err := parse.NewReader().Read(out); err != nil {
+		t.Errorf("reading the written document: %v", err)
+	}
+}
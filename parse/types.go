@@ -1,5 +1,10 @@
 package parse
 
+import (
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
+)
+
 // ElementType represents the type of an SPDX element in JSON-LD format.
 type ElementType string
 
@@ -100,34 +105,18 @@ func (t ElementType) String() string {
 
 // IsCore returns true if this is a core SPDX element type.
 func (t ElementType) IsCore() bool {
-	switch t {
-	case TypeElement, TypeSpdxDocument, TypeRelationship, TypeLifecycleScopedRelationship, TypeAnnotation,
-		TypeBom, TypeBundle, TypeExternalMap, TypeExternalIdentifier, TypeExternalRef,
-		TypeHash, TypeCreationInfo, TypeAgent, TypePerson, TypeOrganization,
-		TypeSoftwareAgent, TypeTool, TypeArtifact, TypeNamespaceMap,
-		TypeDictionaryEntry, TypePositiveIntegerRange, TypeIntegrityMethod,
-		TypePackageVerificationCode:
-		return true
-	}
-	return false
+	return t.profile() == "Core"
 }
 
 // IsSoftware returns true if this is a software-related element type.
 func (t ElementType) IsSoftware() bool {
-	switch t {
-	case TypeSoftwarePackage, TypeSoftwareFile, TypeSoftwareSnippet,
-		TypeSoftwareSbom, TypeSoftwareArtifact:
-		return true
-	}
-	return false
+	return t.profile() == "Software"
 }
 
 // IsLicensing returns true if this is a licensing-related element type.
 func (t ElementType) IsLicensing() bool {
-	switch t {
-	case TypeAnyLicenseInfo, TypeLicense, TypeListedLicense, TypeCustomLicense,
-		TypeLicenseExpression, TypeSimpleLicensingExpression, TypeConjunctiveLicenseSet, TypeDisjunctiveLicenseSet,
-		TypeWithAdditionOperator, TypeLicenseAddition, TypeSimpleLicensingText, TypeOrLaterOperator, TypeListedLicenseException:
+	switch t.profile() {
+	case "SimpleLicensing", "ExpandedLicensing":
 		return true
 	}
 	return false
@@ -135,11 +124,20 @@ func (t ElementType) IsLicensing() bool {
 
 // IsSecurity returns true if this is a security-related element type.
 func (t ElementType) IsSecurity() bool {
-	switch t {
-	case TypeVulnerability, TypeVulnAssessmentRelationship, TypeCvssV2VulnAssessment,
-		TypeCvssV3VulnAssessment, TypeCvssV4VulnAssessment, TypeEpssVulnAssessment,
-		TypeSsvcVulnAssessment, TypeVexVulnAssessment, TypeExploitCatalogVulnAssessment:
-		return true
-	}
-	return false
+	return t.profile() == "Security"
+}
+
+// IsBuiltin returns true if the reader parses this element type itself,
+// which it does for every class of the model. Such types cannot be
+// registered with a Registry.
+func (t ElementType) IsBuiltin() bool {
+	_, ok := spdx.LookupType(parser.CompactType(string(t)))
+	return ok
+}
+
+// profile returns the profile declaring the type, or "" if the type is not
+// part of the model.
+func (t ElementType) profile() string {
+	info, _ := spdx.LookupType(parser.CompactType(string(t)))
+	return info.Profile
 }
@@ -0,0 +1,508 @@
+package parse
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse/internal/parser"
+)
+
+// Watcher keeps a Document in step with the changing JSON of an SPDX
+// document, such as an SBOM that a build regenerates. Each new version is
+// compared with the previous one entry by entry, and only the graph entries
+// whose JSON changed are parsed: unchanged elements are kept as they are,
+// elements that changed are updated in place, so that pointers to them
+// stay valid, new elements are appended to their slices and removed ones
+// are dropped from the slices and indexes.
+//
+// The Document is modified by Update, Reload and Run. Reads that may run
+// concurrently with them must go through View.
+type Watcher struct {
+	r    *Reader
+	path string
+
+	mu      sync.RWMutex
+	doc     *Document
+	sum     [sha256.Size]byte
+	entries []*watchEntry
+	decoder *parser.Decoder
+	modTime time.Time
+	size    int64
+}
+
+// watchEntry is a graph entry of the current version of a document.
+type watchEntry struct {
+	sum [sha256.Size]byte
+	id  string
+	// obj is the object filed into the document for the entry, or nil if
+	// the document does not keep it.
+	obj interface{}
+}
+
+// Changes lists the SPDX IDs of the elements that an update added, changed
+// and removed. Graph entries without an ID are not listed.
+type Changes struct {
+	Added   []string
+	Updated []string
+	Removed []string
+}
+
+// Empty reports whether the update changed no element with an ID.
+func (c *Changes) Empty() bool {
+	return len(c.Added) == 0 && len(c.Updated) == 0 && len(c.Removed) == 0
+}
+
+// Watch reads SPDX JSON-LD data into a document that Update keeps up to
+// date with later versions of the data, for callers notified of changes by
+// other means than the file system.
+func (r *Reader) Watch(data []byte) (*Watcher, error) {
+	w := r.newWatcher()
+	if _, err := w.Update(data); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// WatchFile reads an SPDX JSON-LD file into a document that Reload and Run
+// keep up to date with the file.
+func (r *Reader) WatchFile(filePath string) (*Watcher, error) {
+	w := r.newWatcher()
+	w.path = filePath
+	if _, err := w.Reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (r *Reader) newWatcher() *Watcher {
+	doc := newDocument()
+	doc.typed = r.streaming
+	r.startRaw(doc)
+	return &Watcher{r: r, doc: doc, decoder: r.parser.NewDecoder()}
+}
+
+// Document returns the watched document. It is the same Document for the
+// life of the watcher.
+func (w *Watcher) Document() *Document {
+	return w.doc
+}
+
+// View calls fn with the watched document, which no update modifies until
+// fn returns.
+func (w *Watcher) View(fn func(*Document)) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	fn(w.doc)
+}
+
+// Run calls Reload every interval until ctx is done, and calls onChange
+// with the changes of every reload that changed the document or failed.
+// It returns the error of ctx.
+func (w *Watcher) Run(ctx context.Context, interval time.Duration, onChange func(*Changes, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			changes, err := w.Reload()
+			if err != nil || !changes.Empty() {
+				onChange(changes, err)
+			}
+		}
+	}
+}
+
+// Reload updates the document from its file, if the file changed since it
+// was last read. Files are read with the file reader of the Reader; their
+// modification time and size are taken from the file system of the
+// Reader, the local one unless set WithFS, when available.
+func (w *Watcher) Reload() (*Changes, error) {
+	if w.path == "" {
+		return nil, fmt.Errorf("watcher has no file")
+	}
+	info, statErr := w.r.fileStat(w.path)
+	if statErr == nil {
+		if info.Mode().IsRegular() {
+			if err := w.r.limits.checkSize(info.Size()); err != nil {
+				return nil, err
+			}
+		}
+		w.mu.RLock()
+		same := info.ModTime().Equal(w.modTime) && info.Size() == w.size
+		w.mu.RUnlock()
+		if same {
+			return &Changes{}, nil
+		}
+	}
+
+	data, err := w.r.fileRead(w.path)
+	if err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+	changes, err := w.Update(data)
+	if err != nil {
+		return nil, err
+	}
+	if statErr == nil {
+		w.mu.Lock()
+		w.modTime, w.size = info.ModTime(), info.Size()
+		w.mu.Unlock()
+	}
+	return changes, nil
+}
+
+// Update brings the document in line with a new version of its JSON and
+// returns what changed. If the new version fails to parse, the document
+// is left unchanged.
+func (w *Watcher) Update(data []byte) (*Changes, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sum := sha256.Sum256(data)
+	if w.entries != nil && sum == w.sum {
+		return &Changes{}, nil
+	}
+	if err := w.r.limits.checkSize(int64(len(data))); err != nil {
+		return nil, err
+	}
+	if err := w.r.limits.checkJSON(data, 0); err != nil {
+		return nil, err
+	}
+	var raw struct {
+		Context json.RawMessage   `json:"@context"`
+		Graph   []json.RawMessage `json:"@graph"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing JSON: %w", err)
+	}
+	if raw.Graph == nil {
+		return nil, fmt.Errorf("document does not contain @graph array")
+	}
+	if err := w.r.limits.checkElements(len(raw.Graph)); err != nil {
+		return nil, err
+	}
+	var context []string
+	if raw.Context != nil {
+		var ctx interface{}
+		if err := json.Unmarshal(raw.Context, &ctx); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		context = w.r.parseContext(ctx)
+	}
+
+	// Match the entries of the new version with unchanged entries of the
+	// current one by their JSON, and parse the others.
+	bySum := make(map[[sha256.Size]byte][]*watchEntry, len(w.entries))
+	for _, e := range w.entries {
+		bySum[e.sum] = append(bySum[e.sum], e)
+	}
+	entries := make([]*watchEntry, 0, len(raw.Graph))
+	var pending []*watchElement
+	for _, elem := range raw.Graph {
+		// Entries that are not objects are skipped, as Read does.
+		if len(elem) == 0 || elem[0] != '{' {
+			continue
+		}
+		sum := sha256.Sum256(elem)
+		if same := bySum[sum]; len(same) > 0 {
+			entries = append(entries, same[0])
+			bySum[sum] = same[1:]
+			continue
+		}
+		p, err := w.parse(elem)
+		if err != nil {
+			return nil, err
+		}
+		p.entry.sum = sum
+		entries = append(entries, p.entry)
+		pending = append(pending, p)
+	}
+
+	// Entries left over were changed or removed. Those replaced by a new
+	// element with the same ID and Go type are updated in place; the
+	// others are removed.
+	gone := make(map[string]*watchEntry)
+	removed := make(map[interface{}]struct{})
+	for _, same := range bySum {
+		for _, e := range same {
+			if e.obj != nil {
+				addFiled(removed, reflect.ValueOf(e.obj))
+			}
+			if e.id != "" {
+				gone[e.id] = e
+			}
+		}
+	}
+	changes := &Changes{}
+	for _, p := range pending {
+		old, ok := gone[p.entry.id]
+		switch {
+		case !ok:
+			if p.entry.id != "" {
+				changes.Added = append(changes.Added, p.entry.id)
+			}
+			continue
+		case p.kept && reflect.TypeOf(old.obj) == reflect.TypeOf(p.obj) && reflect.TypeOf(p.obj).Kind() == reflect.Ptr:
+			p.replaces = old
+			keep := make(map[interface{}]struct{})
+			addFiled(keep, reflect.ValueOf(old.obj))
+			for k := range keep {
+				delete(removed, k)
+			}
+		}
+		delete(gone, p.entry.id)
+		changes.Updated = append(changes.Updated, p.entry.id)
+	}
+	for id := range gone {
+		changes.Removed = append(changes.Removed, id)
+		delete(w.doc.ElementsByID, id)
+		delete(w.doc.raw, id)
+	}
+	slices.Sort(changes.Removed)
+	if len(removed) > 0 {
+		pruneDocument(w.doc, removed)
+	}
+
+	for _, p := range pending {
+		if p.replaces != nil {
+			w.replace(p)
+		} else {
+			w.file(p)
+		}
+	}
+	positions := make(map[string]int64, len(entries))
+	for i, e := range entries {
+		if e.id != "" {
+			positions[e.id] = int64(i)
+		}
+	}
+	w.doc.orderGraph(positions)
+	w.doc.Context = context
+	w.r.normalizeTimestamps(w.doc)
+	if w.entries == nil {
+		w.r.indexDocument(w.doc)
+	}
+	w.entries, w.sum = entries, sum
+	return changes, nil
+}
+
+// watchElement is a graph entry of a new version of a document, parsed but
+// not yet filed into the document.
+type watchElement struct {
+	entry *watchEntry
+	// obj is the parsed model object, or nil for types the model does not
+	// define.
+	obj interface{}
+	// kept reports whether the document keeps objects of the type of obj.
+	kept bool
+	// ext is the element parsed by the registry for types that are not
+	// kept, or nil.
+	ext spdx.AnyElement
+	// raw is the value ElementsByID holds for the element.
+	raw interface{}
+	// json is the JSON of the entry.
+	json json.RawMessage
+	// replaces is the entry of the current version that the element
+	// updates in place, or nil.
+	replaces *watchEntry
+}
+
+// parse parses a graph entry as Read would, without filing it.
+func (w *Watcher) parse(data []byte) (*watchElement, error) {
+	var elemMap map[string]interface{}
+	p := &watchElement{entry: &watchEntry{}, json: append(json.RawMessage(nil), data...)}
+	var elemType ElementType
+	if w.r.streaming {
+		obj, head, ok, err := w.decoder.Decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		if ok {
+			p.obj = obj
+		}
+		p.entry.id, elemType = head.SpdxID, ElementType(head.Type)
+	} else {
+		if err := json.Unmarshal(data, &elemMap); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		if obj, ok := w.r.parser.Parse(elemMap); ok {
+			p.obj = obj
+		}
+		p.entry.id, _ = elemMap["spdxId"].(string)
+		elemType = w.r.getElementType(elemMap)
+	}
+	// Filing into a scratch document tells whether the document keeps
+	// objects of the type.
+	p.kept = p.obj != nil && w.r.fileElement(newDocument(), p.obj, p.entry.id)
+
+	if !p.kept {
+		if info, ok := w.r.lookup(elemType); ok || (w.r.streaming && p.obj == nil) {
+			if elemMap == nil {
+				if err := json.Unmarshal(data, &elemMap); err != nil {
+					return nil, fmt.Errorf("parsing JSON: %w", err)
+				}
+			}
+			if ok {
+				elem, err := info.Parse(elemMap)
+				if err != nil {
+					return nil, fmt.Errorf("parsing %s element %q: %w", elemType, p.entry.id, err)
+				}
+				p.ext = elem
+			}
+		}
+	}
+
+	// ElementsByID holds what Read would file there.
+	switch {
+	case !w.r.streaming:
+		p.raw = elemMap
+	case p.kept:
+		p.raw = p.obj
+	case p.ext != nil:
+		p.raw = p.ext
+	case p.obj != nil:
+		p.raw = p.obj
+	default:
+		p.raw = elemMap
+	}
+	return p, nil
+}
+
+// file adds a new element to the document.
+func (w *Watcher) file(p *watchElement) {
+	doc, id := w.doc, p.entry.id
+	switch {
+	case p.kept:
+		w.r.fileElement(doc, p.obj, id)
+		p.entry.obj = p.obj
+		if rel, ok := p.obj.(*spdx.Relationship); ok {
+			doc.indexRelationship(rel)
+		}
+	case p.ext != nil:
+		doc.Extensions = append(doc.Extensions, p.ext)
+		if extID := p.ext.GetSpdxID(); extID != "" {
+			doc.ExtensionsByID[extID] = p.ext
+		}
+		p.entry.obj = p.ext
+	}
+	if id != "" {
+		doc.ElementsByID[id] = p.raw
+		if doc.raw != nil {
+			doc.raw[id] = p.json
+		}
+	}
+}
+
+// replace updates an element of the document in place with its new
+// version.
+func (w *Watcher) replace(p *watchElement) {
+	doc, old := w.doc, p.replaces.obj
+	rel, isRel := old.(*spdx.Relationship)
+	if isRel {
+		doc.unindexRelationship(rel)
+	}
+	reflect.ValueOf(old).Elem().Set(reflect.ValueOf(p.obj).Elem())
+	if isRel {
+		doc.indexRelationship(rel)
+	}
+	p.entry.obj = old
+	if doc.typed {
+		doc.ElementsByID[p.entry.id] = old
+	} else {
+		doc.ElementsByID[p.entry.id] = p.raw
+	}
+	if doc.raw != nil {
+		doc.raw[p.entry.id] = p.json
+	}
+}
+
+// addFiled adds to set the values the reader may file for an object: the
+// object itself and, for pointers to structs, pointers to their embedded
+// structs, as Sboms are filed into Boms.
+func addFiled(set map[interface{}]struct{}, v reflect.Value) {
+	if !v.Comparable() {
+		return
+	}
+	set[v.Interface()] = struct{}{}
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return
+	}
+	elem := v.Elem()
+	for i := 0; i < elem.NumField(); i++ {
+		if f := elem.Type().Field(i); f.Anonymous && f.Type.Kind() == reflect.Struct {
+			addFiled(set, elem.Field(i).Addr())
+		}
+	}
+}
+
+// pruneDocument removes the given objects from the typed slices, the
+// singleton fields and the indexes of a document. ElementsByID is left to
+// the caller.
+func pruneDocument(doc *Document, removed map[interface{}]struct{}) {
+	isRemoved := func(v reflect.Value) bool {
+		if !v.Comparable() {
+			return false
+		}
+		_, ok := removed[v.Interface()]
+		return ok
+	}
+	prune := func(s reflect.Value) reflect.Value {
+		n := 0
+		for i := 0; i < s.Len(); i++ {
+			if !isRemoved(s.Index(i)) {
+				s.Index(n).Set(s.Index(i))
+				n++
+			}
+		}
+		for i := n; i < s.Len(); i++ {
+			s.Index(i).SetZero()
+		}
+		if n == 0 {
+			return reflect.Zero(s.Type())
+		}
+		return s.Slice(0, n)
+	}
+
+	v := reflect.ValueOf(doc).Elem()
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if !t.Field(i).IsExported() || t.Field(i).Name == "ElementsByID" {
+			continue
+		}
+		switch f.Kind() {
+		case reflect.Ptr:
+			if !f.IsNil() && isRemoved(f) {
+				f.SetZero()
+			}
+		case reflect.Slice:
+			f.Set(prune(f))
+		case reflect.Map:
+			iter := f.MapRange()
+			for iter.Next() {
+				val := iter.Value()
+				if val.Kind() != reflect.Slice {
+					if isRemoved(val) {
+						f.SetMapIndex(iter.Key(), reflect.Value{})
+					}
+					continue
+				}
+				kept := prune(reflect.ValueOf(val.Interface()))
+				if kept.Len() == 0 {
+					f.SetMapIndex(iter.Key(), reflect.Value{})
+				} else {
+					f.SetMapIndex(iter.Key(), kept)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package parse_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// watchDoc returns a document with the given graph entries after its
+// creation info.
+func watchDoc(entries ...string) []byte {
+	return []byte(`{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": [` +
+		`{"type": "CreationInfo", "@id": "_:ci", "specVersion": "3.0.1", "created": "2024-05-01T00:00:00Z", "createdBy": ["urn:spdx:acme"]},` +
+		strings.Join(entries, ",") + `]}`)
+}
+
+func TestWatcher_Update(t *testing.T) {
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0"}`
+	appV2 := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "2.0"}`
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`
+	libNew := `{"type": "software_Package", "spdxId": "urn:spdx:lib-new", "name": "lib"}`
+	org := `{"type": "Organization", "spdxId": "urn:spdx:acme", "name": "Acme"}`
+	rel := `{"type": "Relationship", "spdxId": "urn:spdx:rel", "from": "urn:spdx:app", "to": ["urn:spdx:lib"], "relationshipType": "dependsOn"}`
+	relV2 := `{"type": "Relationship", "spdxId": "urn:spdx:rel", "from": "urn:spdx:app", "to": ["urn:spdx:lib-new"], "relationshipType": "dependsOn"}`
+	unknown := `{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown", "name": "kept raw"}`
+	unknownV2 := `{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown", "name": "changed"}`
+
+	v1 := watchDoc(app, lib, org, rel, unknown)
+	v2 := watchDoc(appV2, org, relV2, unknownV2, libNew)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			reader := parse.NewReader(opts...)
+			w, err := reader.Watch(v1)
+			if err != nil {
+				t.Fatalf("Watch() error = %v", err)
+			}
+			doc := w.Document()
+			want, err := reader.Read(v1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSameDocument(t, doc, want)
+			pkg := doc.PackagesByID["urn:spdx:app"]
+
+			changes, err := w.Update(v2)
+			if err != nil {
+				t.Fatalf("Update() error = %v", err)
+			}
+			wantChanges := &parse.Changes{
+				Added:   []string{"urn:spdx:lib-new"},
+				Updated: []string{"urn:spdx:app", "urn:spdx:rel", "urn:spdx:unknown"},
+				Removed: []string{"urn:spdx:lib"},
+			}
+			if !reflect.DeepEqual(changes, wantChanges) {
+				t.Errorf("Update() = %+v, want %+v", changes, wantChanges)
+			}
+			want, err = reader.Read(v2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertSameDocument(t, doc, want)
+			if doc.PackagesByID["urn:spdx:app"] != pkg || pkg.PackageVersion != "2.0" {
+				t.Errorf("changed package not updated in place")
+			}
+
+			if changes, err := w.Update(v2); err != nil || !changes.Empty() {
+				t.Errorf("Update() of the same version = %+v, %v, want no changes", changes, err)
+			}
+			if _, err := w.Update([]byte(`{"@graph": [{"type": "Person",`)); err == nil {
+				t.Errorf("Update() of invalid JSON succeeded")
+			}
+			assertSameDocument(t, doc, want)
+		})
+	}
+}
+
+func TestWatcher_Run(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sbom.spdx.json")
+	lib := `{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`
+	if err := os.WriteFile(path, watchDoc(lib), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	w, err := parse.NewReader().WatchFile(path)
+	if err != nil {
+		t.Fatalf("WatchFile() error = %v", err)
+	}
+	if changes, err := w.Reload(); err != nil || !changes.Empty() {
+		t.Errorf("Reload() of an unchanged file = %+v, %v", changes, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates := make(chan *parse.Changes, 1)
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx, 10*time.Millisecond, func(c *parse.Changes, err error) {
+			if err != nil {
+				t.Error(err)
+			}
+			updates <- c
+		})
+	}()
+
+	// The new version is renamed into place, so that no reload sees it
+	// half written.
+	app := `{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`
+	if err := os.WriteFile(path+".tmp", watchDoc(lib, app), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case c := <-updates:
+		if len(c.Added) != 1 || c.Added[0] != "urn:spdx:app" {
+			t.Errorf("Run() reported %+v, want urn:spdx:app added", c)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not report the change")
+	}
+	w.View(func(doc *parse.Document) {
+		if doc.GetPackageByID("urn:spdx:app") == nil {
+			t.Errorf("added package not in the document")
+		}
+	})
+
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+}
+
+// TestWatcher_GoldenFixtures checks that documents grown from and shrunk
+// back to an empty graph equal those read in one go.
+func TestWatcher_GoldenFixtures(t *testing.T) {
+	empty := []byte(`{"@graph": []}`)
+	files, err := filepath.Glob("testdata/golden/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, opts := range [][]parse.Option{nil, {parse.WithStreaming()}} {
+			reader := parse.NewReader(opts...)
+			w, err := reader.Watch(empty)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, version := range [][]byte{data, empty} {
+				if _, err := w.Update(version); err != nil {
+					t.Fatalf("%s: Update() error = %v", file, err)
+				}
+				want, err := reader.Read(version)
+				if err != nil {
+					t.Fatal(err)
+				}
+				assertSameDocument(t, w.Document(), want)
+			}
+		}
+	}
+}
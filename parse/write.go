@@ -0,0 +1,191 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// WriteOption configures how a Document is written.
+type WriteOption interface {
+	apply(*writer)
+}
+
+type writeOptionFunc func(*writer)
+
+func (f writeOptionFunc) apply(w *writer) {
+	f(w)
+}
+
+// writer holds the settings of Bytes and WriteFile.
+type writer struct {
+	prefix, indent string
+	timestamps     *TimestampFormat
+}
+
+// WithIndent indents the written JSON as json.MarshalIndent does, with
+// each line starting with prefix and nested values indented by indent.
+func WithIndent(prefix, indent string) WriteOption {
+	return writeOptionFunc(func(w *writer) {
+		w.prefix, w.indent = prefix, indent
+	})
+}
+
+// Bytes encodes the document as SPDX JSON-LD, the inverse of Reader.Read.
+// The graph holds the SpdxDocument, the creation info and the kept objects
+// of the document, including those added with AddElements, followed by
+// the entries of ElementsByID of the types the document does not keep, so
+// that they survive a load, edit and save. The elements are written in the
+// order of Graph, those not in it after them. References to the
+// individuals of the specification, such as NoneElement, are written as
+// their compact terms.
+//
+//	doc, err := reader.ReadFile("sbom.spdx.json")
+//	doc.GetPackageByID("urn:spdx:app").PackageVersion = "2.0"
+//	data, err := doc.Bytes(parse.WithIndent("", "  "))
+func (d *Document) Bytes(opts ...WriteOption) ([]byte, error) {
+	var w writer
+	for _, opt := range opts {
+		opt.apply(&w)
+	}
+
+	var context interface{} = spdx.ContextURL
+	switch len(d.Context) {
+	case 0:
+	case 1:
+		context = d.Context[0]
+	default:
+		context = d.Context
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"@context": context, "@graph": d.graph()})
+	if err != nil {
+		return nil, fmt.Errorf("encoding document: %w", err)
+	}
+	if w.timestamps != nil {
+		if data, err = formatTimestamps(data, *w.timestamps); err != nil {
+			return nil, fmt.Errorf("encoding document: %w", err)
+		}
+	}
+	if w.prefix == "" && w.indent == "" {
+		return data, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, w.prefix, w.indent); err != nil {
+		return nil, fmt.Errorf("encoding document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteFile writes the document as SPDX JSON-LD to the file at filePath,
+// the inverse of Reader.ReadFile. The file is created if needed and
+// truncated otherwise.
+func (d *Document) WriteFile(filePath string, opts ...WriteOption) error {
+	data, err := d.Bytes(opts...)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filePath, data, 0o644); err != nil {
+		return fmt.Errorf("writing file: %w", err)
+	}
+	return nil
+}
+
+// graph returns the entries of the @graph of the document.
+func (d *Document) graph() []interface{} {
+	var graph []interface{}
+	written := make(map[string]bool)
+	// The elements still in the document are written in the order of
+	// Graph, and the others after them.
+	keyable := func(elem spdx.ElementInterface) bool {
+		return elem != nil && reflect.TypeOf(elem).Comparable()
+	}
+	inGraph := make(map[spdx.ElementInterface]bool)
+	for elem := range d.AllElements() {
+		if keyable(elem) {
+			inGraph[elem] = false
+		}
+	}
+	for _, elem := range d.Graph {
+		if !keyable(elem) {
+			continue
+		}
+		if done, ok := inGraph[elem]; ok && !done {
+			graph = append(graph, elem)
+			written[elem.GetSpdxID()] = true
+			inGraph[elem] = true
+		}
+	}
+	for elem := range d.AllElements() {
+		if keyable(elem) && inGraph[elem] {
+			continue
+		}
+		graph = append(graph, elem)
+		written[elem.GetSpdxID()] = true
+	}
+	if d.CreationInfo != nil {
+		graph = append(graph, d.CreationInfo)
+	}
+	graph = appendObjects(graph, d.ExternalMaps, nil, nil)
+	graph = appendObjects(graph, d.DictionaryEntries, nil, nil)
+	graph = appendObjects(graph, d.Hashes, nil, nil)
+	graph = appendObjects(graph, d.PackageVerificationCodes, nil, nil)
+	graph = appendObjects(graph, d.EnergyConsumptions, d.EnergyConsumptionsByID, written)
+	graph = appendObjects(graph, d.EnergyConsumptionDescriptions, d.EnergyConsumptionDescriptionsByID, written)
+
+	var rest []string
+	for id := range d.ElementsByID {
+		if !written[id] {
+			rest = append(rest, id)
+		}
+	}
+	slices.Sort(rest)
+	for _, id := range rest {
+		graph = append(graph, d.ElementsByID[id])
+	}
+	return graph
+}
+
+// appendObjects appends the non-element objects of a slice of the
+// document to graph. The objects that byID indexes by an SPDX ID, which
+// their types do not carry, are written with it and marked in written.
+func appendObjects[T any](graph []interface{}, objs []*T, byID map[string]*T, written map[string]bool) []interface{} {
+	ids := make(map[*T]string, len(byID))
+	for id, o := range byID {
+		ids[o] = id
+	}
+	for _, o := range objs {
+		id, ok := ids[o]
+		if !ok {
+			graph = append(graph, o)
+			continue
+		}
+		graph = append(graph, identified{id: id, obj: o})
+		written[id] = true
+	}
+	return graph
+}
+
+// identified is a non-element object written with an SPDX ID.
+type identified struct {
+	id  string
+	obj interface{}
+}
+
+func (o identified) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(o.obj)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	fields["spdxId"], _ = json.Marshal(o.id)
+	return json.Marshal(fields)
+}
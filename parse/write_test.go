@@ -0,0 +1,204 @@
+package parse_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// TestDocument_Bytes checks that well-formed documents written and read
+// back equal those read from the original.
+func TestDocument_Bytes(t *testing.T) {
+	files, err := filepath.Glob("testdata/golden/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs := map[string][]byte{"lazy": []byte(lazyDocJSON)}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs[filepath.Base(file)] = data
+	}
+	for name, data := range inputs {
+		for _, opts := range [][]parse.Option{nil, {parse.WithStreaming()}} {
+			reader := parse.NewReader(opts...)
+			want, err := reader.Read(data)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			out, err := want.Bytes()
+			if err != nil {
+				t.Fatalf("%s: Bytes() error = %v", name, err)
+			}
+			got, err := reader.Read(out)
+			if err != nil {
+				t.Fatalf("%s: reading written document: %v\n%s", name, err, out)
+			}
+			t.Run(name, func(t *testing.T) { assertSameDocument(t, got, want) })
+		}
+	}
+}
+
+func TestDocument_WriteFile(t *testing.T) {
+	reader := parse.NewReader()
+	doc, err := reader.Read(watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "software_packageVersion": "1.0"}`,
+		`{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown", "name": "kept raw"}`,
+		`{"type": "ai_EnergyConsumption", "spdxId": "urn:spdx:energy", "ai_trainingEnergyConsumption": [{"ai_energyQuantity": 1.5, "energyUnit": "kilowattHour"}]}`,
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.GetPackageByID("urn:spdx:app").PackageVersion = "2.0"
+
+	path := filepath.Join(t.TempDir(), "sbom.spdx.json")
+	if err := doc.WriteFile(path, parse.WithIndent("", "  ")); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "\n  \"@graph\": [") {
+		t.Errorf("WriteFile() did not indent the document:\n%s", data)
+	}
+	saved, err := reader.Read(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkg := saved.GetPackageByID("urn:spdx:app"); pkg == nil || pkg.PackageVersion != "2.0" {
+		t.Errorf("saved package = %+v, want version 2.0", pkg)
+	}
+	if _, ok := saved.ElementsByID["urn:spdx:unknown"]; !ok {
+		t.Errorf("saved document lost the element of an unknown type")
+	}
+	if saved.EnergyConsumptionsByID["urn:spdx:energy"] == nil {
+		t.Errorf("saved document lost the SPDX ID of an energy consumption")
+	}
+
+	if err := doc.WriteFile(filepath.Join(path, "missing", "sbom.json")); err == nil {
+		t.Errorf("WriteFile() to a missing directory succeeded")
+	}
+}
+
+// TestDocument_Bytes_Individuals checks that references to the individuals
+// of the specification, which are read as their IRIs, are written as their
+// compact terms.
+func TestDocument_Bytes_Individuals(t *testing.T) {
+	data := watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app", "suppliedBy": "NoAssertionElement"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:deps", "from": "urn:spdx:app", "to": ["NoneElement"], "relationshipType": "dependsOn"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:license", "from": "urn:spdx:app", "to": ["https://spdx.org/rdf/3.0.1/terms/ExpandedLicensing/NoAssertionLicense"], "relationshipType": "hasConcludedLicense"}`,
+	)
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			reader := parse.NewReader(opts...)
+			doc, err := reader.Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := doc.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, term := range []string{spdx.NoAssertionElementTerm, spdx.NoneElementTerm, spdx.NoAssertionLicenseTerm} {
+				if !strings.Contains(string(out), `"`+term+`"`) {
+					t.Errorf("written document does not reference %s:\n%s", term, out)
+				}
+			}
+			for _, iri := range []string{spdx.NoAssertionElementIRI, spdx.NoneElementIRI, spdx.NoAssertionLicenseIRI} {
+				if strings.Contains(string(out), iri) {
+					t.Errorf("written document references %s:\n%s", iri, out)
+				}
+			}
+
+			written, err := reader.Read(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if pkg := written.GetPackageByID("urn:spdx:app"); pkg == nil || pkg.SuppliedBy == nil || pkg.SuppliedBy.SpdxID != spdx.NoAssertionElementIRI {
+				t.Errorf("suppliedBy of the written package = %+v, want %s", pkg, spdx.NoAssertionElementIRI)
+			}
+			if got := written.GetRelationshipsTo(spdx.NoneElementIRI); len(got) != 1 {
+				t.Errorf("written document has %d relationships to %s, want 1", len(got), spdx.NoneElementIRI)
+			}
+			if got := written.GetRelationshipsTo(spdx.NoAssertionLicenseIRI); len(got) != 1 {
+				t.Errorf("written document has %d relationships to %s, want 1", len(got), spdx.NoAssertionLicenseIRI)
+			}
+		})
+	}
+}
+
+func TestDocument_Graph(t *testing.T) {
+	data := watchDoc(
+		`{"type": "Person", "spdxId": "urn:spdx:jane", "name": "Jane"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`,
+		`{"type": "acme_Unknown", "spdxId": "urn:spdx:unknown"}`,
+		`{"type": "Relationship", "spdxId": "urn:spdx:rel", "from": "urn:spdx:app", "to": ["urn:spdx:main"], "relationshipType": "contains"}`,
+		`{"type": "software_File", "spdxId": "urn:spdx:main", "name": "main.go"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`,
+	)
+	want := []string{"urn:spdx:jane", "urn:spdx:app", "urn:spdx:rel", "urn:spdx:main", "urn:spdx:lib"}
+
+	for mode, opts := range map[string][]parse.Option{"maps": nil, "streaming": {parse.WithStreaming()}} {
+		t.Run(mode, func(t *testing.T) {
+			reader := parse.NewReader(opts...)
+			doc, err := reader.Read(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := graphIDs(doc); !slices.Equal(got, want) {
+				t.Errorf("Graph = %v, want %v", got, want)
+			}
+			if doc.Graph[1] != spdx.ElementInterface(doc.Packages[0]) {
+				t.Errorf("Graph[1] = %p, want the package the document holds", doc.Graph[1])
+			}
+
+			if err := doc.AddElements(spdx.NewPackage("urn:spdx:added", "added", "1.0", spdx.CreationInfo{})); err != nil {
+				t.Fatal(err)
+			}
+			out, err := doc.Bytes()
+			if err != nil {
+				t.Fatal(err)
+			}
+			written, err := reader.Read(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := graphIDs(written), append(want, "urn:spdx:added"); !slices.Equal(got, want) {
+				t.Errorf("Graph of the written document = %v, want %v", got, want)
+			}
+		})
+	}
+
+	w, err := parse.NewReader().Watch(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Update(watchDoc(
+		`{"type": "software_Package", "spdxId": "urn:spdx:lib", "name": "lib"}`,
+		`{"type": "software_Package", "spdxId": "urn:spdx:app", "name": "app"}`,
+	)); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := graphIDs(w.Document()), []string{"urn:spdx:lib", "urn:spdx:app"}; !slices.Equal(got, want) {
+		t.Errorf("Graph of the watched document = %v, want %v", got, want)
+	}
+}
+
+// graphIDs returns the IDs of the Graph of a document.
+func graphIDs(doc *parse.Document) []string {
+	var ids []string
+	for _, elem := range doc.Graph {
+		ids = append(ids, elem.GetSpdxID())
+	}
+	return ids
+}
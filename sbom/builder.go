@@ -0,0 +1,287 @@
+// Package sbom builds SPDX 3.0 documents from scratch, for producers such as
+// the Go module, lockfile and binary importers in its subpackages.
+//
+//	b := sbom.NewBuilder("https://acme.example/sbom/app", "app")
+//	app := b.AddPackage("app", "1.0.0", "pkg:golang/acme.example/app@1.0.0")
+//	lib := b.AddPackage("lib", "2.1.0", "pkg:golang/acme.example/lib@2.1.0")
+//	b.AddRoot(app)
+//	b.Relate(app, spdx.RelationshipTypeDependsOn, lib)
+//	doc, err := b.Document()
+//
+// The document is returned as a parse.Document, as if read from JSON-LD, so
+// that it can be queried and extended like any other.
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// dataLicense is the license of SPDX documents, CC0-1.0.
+const dataLicense = "https://spdx.org/licenses/CC0-1.0"
+
+// Option configures a Builder.
+type Option interface {
+	apply(*Builder)
+}
+
+type optionFunc func(*Builder)
+
+func (f optionFunc) apply(b *Builder) { f(b) }
+
+// WithOrganization sets the organization creating the document, which is
+// otherwise a software agent named "spdx-zen".
+func WithOrganization(name string) Option {
+	return optionFunc(func(b *Builder) {
+		b.creatorName = name
+		b.creatorOrg = true
+	})
+}
+
+// WithTool adds a tool used to create the document, such as the program
+// calling the builder.
+func WithTool(name string) Option {
+	return optionFunc(func(b *Builder) {
+		b.tools = append(b.tools, name)
+	})
+}
+
+// WithCreated sets the creation time of the document, which is otherwise
+// the time NewBuilder is called. Fixed times make the output reproducible.
+func WithCreated(t time.Time) Option {
+	return optionFunc(func(b *Builder) {
+		b.created = t
+	})
+}
+
+// Builder collects the elements of a new SPDX document.
+type Builder struct {
+	namespace string
+	name      string
+
+	creatorName string
+	creatorOrg  bool
+	tools       []string
+	created     time.Time
+
+	ci       spdx.CreationInfo
+	elements []spdx.AnyElement
+	byID     map[string]spdx.AnyElement
+	roots    []string
+}
+
+// NewBuilder creates a builder for a document with the given name whose
+// SPDX ID is namespace. The IDs of elements are fragments of the namespace,
+// e.g. "https://acme.example/sbom/app#package-lib-1a2b3c4d5e6f".
+func NewBuilder(namespace, name string, opts ...Option) *Builder {
+	b := &Builder{
+		namespace:   strings.TrimSuffix(namespace, "#"),
+		name:        name,
+		creatorName: "spdx-zen",
+		created:     time.Now(),
+		byID:        make(map[string]spdx.AnyElement),
+	}
+	for _, opt := range opts {
+		opt.apply(b)
+	}
+
+	b.ci = spdx.NewCreationInfo(nil)
+	b.ci.Created = b.created.UTC().Truncate(time.Second)
+	creatorID := b.ID("agent", b.creatorName)
+	b.ci.CreatedBy = []spdx.Agent{{Element: spdx.Element{SpdxID: creatorID}}}
+	if b.creatorOrg {
+		org := &spdx.Organization{}
+		org.SpdxID = creatorID
+		org.Name = b.creatorName
+		org.CreationInfo = b.ci
+		b.Add(org)
+	} else {
+		agent := &spdx.SoftwareAgent{}
+		agent.SpdxID = creatorID
+		agent.Name = b.creatorName
+		agent.CreationInfo = b.ci
+		b.Add(agent)
+	}
+	for _, name := range b.tools {
+		id := b.ID("tool", name)
+		b.ci.CreatedUsing = append(b.ci.CreatedUsing, spdx.Tool{Element: spdx.Element{SpdxID: id}})
+		b.Add(spdx.NewTool(id, name, b.ci))
+	}
+	return b
+}
+
+// CreationInfo returns the creation info of the document, for elements
+// created by the caller.
+func (b *Builder) CreationInfo() spdx.CreationInfo {
+	return b.ci
+}
+
+// ID returns the SPDX ID of an element of the given kind identified by
+// key, e.g. a package URL. The ID is stable: the same kind and key give the
+// same ID.
+func (b *Builder) ID(kind, key string) string {
+	var label strings.Builder
+	for _, r := range key {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_':
+			label.WriteRune(r)
+		default:
+			label.WriteByte('-')
+		}
+	}
+	return b.hashID(kind, strings.Trim(label.String(), "-"), key)
+}
+
+// hashID returns an ID made of a kind, a readable label and a digest of
+// the key telling apart elements with the same label.
+func (b *Builder) hashID(kind, label, key string) string {
+	sum := sha256.Sum256([]byte(kind + "\x00" + key))
+	if label != "" {
+		kind += "-" + label
+	}
+	return fmt.Sprintf("%s#%s-%s", b.namespace, kind, hex.EncodeToString(sum[:])[:12])
+}
+
+// Add adds elements to the document. Elements with the SPDX ID of an
+// element already added are ignored.
+func (b *Builder) Add(elems ...spdx.AnyElement) {
+	for _, elem := range elems {
+		id := elem.GetSpdxID()
+		if _, ok := b.byID[id]; ok {
+			continue
+		}
+		b.byID[id] = elem
+		b.elements = append(b.elements, elem)
+	}
+}
+
+// Get returns the element added with the given SPDX ID, or nil.
+func (b *Builder) Get(spdxID string) spdx.AnyElement {
+	return b.byID[spdxID]
+}
+
+// AddPackage adds a package with the given name, version and package URL,
+// or returns the package already added for them. The package URL is set
+// both as the package's packageUrl and as an external identifier. The
+// package is identified by its package URL if it has one, or else by name
+// and version.
+func (b *Builder) AddPackage(name, version, purl string) *spdx.Package {
+	key := purl
+	if key == "" {
+		key = name + "@" + version
+	}
+	id := b.ID("package", key)
+	if pkg, ok := b.byID[id].(*spdx.Package); ok {
+		return pkg
+	}
+	pkg := spdx.NewPackage(id, name, version, b.ci)
+	if purl != "" {
+		pkg.PackageUrl = purl
+		pkg.WithPURL(purl)
+	}
+	pkg.PrimaryPurpose = spdx.SoftwarePurposeLibrary
+	b.Add(pkg)
+	return pkg
+}
+
+// AddRoot makes elements root elements of the document, the elements it
+// describes.
+func (b *Builder) AddRoot(elems ...spdx.AnyElement) {
+	for _, elem := range elems {
+		b.roots = append(b.roots, elem.GetSpdxID())
+	}
+}
+
+// Relate adds a relationship of the given type from one element to others,
+// or returns the relationship already added between them.
+func (b *Builder) Relate(from spdx.AnyElement, relType spdx.RelationshipType, to ...spdx.AnyElement) *spdx.Relationship {
+	key, refs := relationshipKey(from, relType, to)
+	id := b.hashID("relationship", string(relType), key)
+	if rel, ok := b.byID[id].(*spdx.Relationship); ok {
+		return rel
+	}
+	rel := spdx.NewRelationship(id, spdx.Element{SpdxID: from.GetSpdxID()}, refs, relType, b.ci)
+	b.Add(rel)
+	return rel
+}
+
+// RelateScoped adds a relationship like Relate that holds in a lifecycle
+// scope only, such as a dependency needed to run tests, or returns the
+// relationship already added between the elements in that scope.
+func (b *Builder) RelateScoped(from spdx.AnyElement, relType spdx.RelationshipType, scope spdx.LifecycleScopeType, to ...spdx.AnyElement) *spdx.LifecycleScopedRelationship {
+	key, refs := relationshipKey(from, relType, to)
+	id := b.hashID("relationship", string(relType)+"-"+string(scope), key+" "+string(scope))
+	if rel, ok := b.byID[id].(*spdx.LifecycleScopedRelationship); ok {
+		return rel
+	}
+	rel := &spdx.LifecycleScopedRelationship{
+		Relationship: *spdx.NewRelationship(id, spdx.Element{SpdxID: from.GetSpdxID()}, refs, relType, b.ci),
+		Scope:        scope,
+	}
+	b.Add(rel)
+	return rel
+}
+
+// relationshipKey returns the key identifying a relationship between
+// elements and the references to its targets.
+func relationshipKey(from spdx.AnyElement, relType spdx.RelationshipType, to []spdx.AnyElement) (string, []spdx.Element) {
+	key := from.GetSpdxID() + " " + string(relType)
+	refs := make([]spdx.Element, len(to))
+	for i, elem := range to {
+		refs[i] = spdx.Element{SpdxID: elem.GetSpdxID()}
+		key += " " + elem.GetSpdxID()
+	}
+	return key, refs
+}
+
+// Document returns the document built so far: an SpdxDocument listing the
+// added elements, with the core and software profiles and the build
+// profile if it has builds, and the elements themselves.
+func (b *Builder) Document() (*parse.Document, error) {
+	data, err := b.JSON()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := parse.NewReader().Read(data)
+	if err != nil {
+		return nil, fmt.Errorf("reading document: %w", err)
+	}
+	return doc, nil
+}
+
+// JSON returns the document built so far, as returned by Document, encoded
+// as SPDX 3.0 JSON-LD.
+func (b *Builder) JSON() ([]byte, error) {
+	sd := spdx.NewSpdxDocument(b.namespace, b.name, b.ci)
+	sd.ProfileConformance = []spdx.ProfileIdentifierType{spdx.ProfileIdentifierTypeCore, spdx.ProfileIdentifierTypeSoftware}
+	sd.DataLicense = &spdx.AnyLicenseInfo{Element: spdx.Element{SpdxID: dataLicense}}
+	graph := make([]interface{}, 0, len(b.elements)+1)
+	graph = append(graph, sd)
+	hasBuild := false
+	for _, elem := range b.elements {
+		if _, ok := elem.(*spdx.Build); ok {
+			hasBuild = true
+		}
+		sd.Elements = append(sd.Elements, spdx.Element{SpdxID: elem.GetSpdxID()})
+		graph = append(graph, elem)
+	}
+	if hasBuild {
+		sd.ProfileConformance = append(sd.ProfileConformance, spdx.ProfileIdentifierTypeBuild)
+	}
+	for _, id := range b.roots {
+		sd.RootElement = append(sd.RootElement, spdx.Element{SpdxID: id})
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"@context": spdx.ContextURL, "@graph": graph})
+	if err != nil {
+		return nil, fmt.Errorf("encoding document: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,126 @@
+package sbom_test
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+func TestBuilder_Document(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	b := sbom.NewBuilder("https://acme.example/sbom/app", "app", sbom.WithTool("acme-sbom"), sbom.WithCreated(created))
+
+	app := b.AddPackage("app", "1.0.0", "pkg:golang/acme.example/app@1.0.0")
+	lib := b.AddPackage("lib", "2.1.0", "pkg:golang/acme.example/lib@2.1.0")
+	if again := b.AddPackage("lib", "2.1.0", "pkg:golang/acme.example/lib@2.1.0"); again != lib {
+		t.Error("AddPackage added the same package twice")
+	}
+	b.AddRoot(app)
+	rel := b.Relate(app, spdx.RelationshipTypeDependsOn, lib)
+	if again := b.Relate(app, spdx.RelationshipTypeDependsOn, lib); again != rel {
+		t.Error("Relate added the same relationship twice")
+	}
+	scoped := b.RelateScoped(app, spdx.RelationshipTypeDependsOn, spdx.LifecycleScopeTypeTest, lib)
+	if again := b.RelateScoped(app, spdx.RelationshipTypeDependsOn, spdx.LifecycleScopeTypeTest, lib); again != scoped {
+		t.Error("RelateScoped added the same relationship twice")
+	}
+	if b.ID("package", "x") != b.ID("package", "x") {
+		t.Error("ID is not stable")
+	}
+
+	doc, err := b.Document()
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+	if doc.SpdxDocument == nil || doc.SpdxDocument.SpdxID != "https://acme.example/sbom/app" {
+		t.Fatalf("SpdxDocument = %+v", doc.SpdxDocument)
+	}
+	if roots := doc.SpdxDocument.RootElement; len(roots) != 1 || roots[0].SpdxID != app.SpdxID {
+		t.Errorf("root elements = %v, want the app package", roots)
+	}
+	if len(doc.Packages) != 2 || len(doc.Relationships) != 1 {
+		t.Errorf("packages = %d, relationships = %d, want 2 and 1", len(doc.Packages), len(doc.Relationships))
+	}
+	if pkg := doc.GetPackageByID(lib.SpdxID); pkg == nil || pkg.GetPURL() != "pkg:golang/acme.example/lib@2.1.0" {
+		t.Errorf("lib package = %+v", pkg)
+	}
+	if len(doc.LifecycleScopedRelationships) != 1 || doc.LifecycleScopedRelationships[0].Scope != spdx.LifecycleScopeTypeTest {
+		t.Errorf("lifecycle scoped relationships = %v", doc.LifecycleScopedRelationships)
+	}
+	deps := doc.GetRelationshipsFrom(app.SpdxID)
+	if len(deps) != 1 || deps[0].To[0].SpdxID != lib.SpdxID {
+		t.Errorf("relationships from app = %v", deps)
+	}
+
+	ci := doc.SpdxDocument.CreationInfo
+	if !ci.Created.Equal(created) || len(ci.CreatedBy) != 1 || len(ci.CreatedUsing) != 1 {
+		t.Errorf("creation info = %+v", ci)
+	}
+	if len(doc.SoftwareAgents) != 1 || doc.SoftwareAgents[0].Name != "spdx-zen" {
+		t.Errorf("software agents = %v, want spdx-zen", doc.SoftwareAgents)
+	}
+}
+
+func TestBuilder_AddFiles(t *testing.T) {
+	fsys := fstest.MapFS{
+		"dist/app":            {Data: []byte("\x7fELF binary"), Mode: 0o755},
+		"dist/config.json":    {Data: []byte(`{"debug": false}`)},
+		"dist/README":         {Data: []byte("hello\n")},
+		"dist/docs/notes.txt": {Data: []byte("notes")},
+		"other/skipped.txt":   {Data: []byte("not under the root")},
+	}
+
+	b := sbom.NewBuilder("https://acme.example/sbom/app", "app")
+	pkg := b.AddPackage("app", "1.0.0", "")
+	files, err := b.AddFiles(pkg, fsys, "dist")
+	if err != nil {
+		t.Fatalf("AddFiles: %v", err)
+	}
+
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name)
+	}
+	want := []string{"README", "app", "config.json", "docs/notes.txt"}
+	if !slices.Equal(names, want) {
+		t.Fatalf("files = %v, want %v", names, want)
+	}
+
+	readme := files[0]
+	hashes := readme.Hashes()
+	if len(hashes) != 2 || hashes[0].Algorithm != spdx.HashAlgorithmSha256 || hashes[1].Algorithm != spdx.HashAlgorithmSha512 {
+		t.Fatalf("hashes = %v, want SHA-256 and SHA-512", readme.VerifiedUsing)
+	}
+	if hashes[0].HashValue != "5891b5b522d5df086d0ff0b110fbd9d21bb4fc7163af34d08286a2e846f6be03" {
+		t.Errorf("SHA-256 = %s", hashes[0].HashValue)
+	}
+	if readme.ContentType != "text/plain; charset=utf-8" || files[2].ContentType != "application/json" {
+		t.Errorf("content types = %q, %q", readme.ContentType, files[2].ContentType)
+	}
+	if files[1].PrimaryPurpose != spdx.SoftwarePurposeExecutable || readme.PrimaryPurpose != "" {
+		t.Errorf("purposes = %q, %q", files[1].PrimaryPurpose, readme.PrimaryPurpose)
+	}
+
+	doc, err := b.Document()
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+	if len(doc.Files) != 4 {
+		t.Errorf("document files = %d, want 4", len(doc.Files))
+	}
+	if got := doc.GetFileByID(readme.SpdxID); got == nil || len(got.Hashes()) != 2 {
+		t.Errorf("file in document = %+v", got)
+	}
+	rels := doc.GetRelationshipsFrom(pkg.SpdxID)
+	if len(rels) != 1 || rels[0].RelationshipType != spdx.RelationshipTypeContains || len(rels[0].To) != 4 {
+		t.Errorf("relationships from package = %v", rels)
+	}
+
+	if _, err := b.AddFiles(pkg, fsys, "missing"); err == nil {
+		t.Error("AddFiles succeeded for a missing root")
+	}
+}
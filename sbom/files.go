@@ -0,0 +1,113 @@
+package sbom
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"path"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// sniffLen is the number of bytes http.DetectContentType looks at.
+const sniffLen = 512
+
+// AddFiles adds a File for every regular file in the tree of fsys rooted at
+// root, named by its slash-separated path relative to root, with its
+// SHA-256 and SHA-512 hashes and content type. The content type comes from
+// the file extension, or else from the content. Files with an executable
+// mode bit set have the executable purpose.
+//
+// If pkg is not nil, a contains relationship from it to the files is added
+// as well. The files are returned in lexical order.
+func (b *Builder) AddFiles(pkg spdx.AnyElement, fsys fs.FS, root string) ([]*spdx.File, error) {
+	var files []*spdx.File
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel := name
+		if root != "." {
+			rel = name[len(root)+1:]
+		}
+		file, err := b.AddFile(fsys, name, rel)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if info.Mode()&0o111 != 0 {
+			file.PrimaryPurpose = spdx.SoftwarePurposeExecutable
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scanning files: %w", err)
+	}
+
+	if pkg != nil && len(files) > 0 {
+		to := make([]spdx.AnyElement, len(files))
+		for i, f := range files {
+			to[i] = f
+		}
+		b.Relate(pkg, spdx.RelationshipTypeContains, to...)
+	}
+	return files, nil
+}
+
+// AddFile adds a File for the file of fsys with the given name, named rel,
+// with its hashes and content type as for AddFiles, or returns the File
+// already added for rel.
+func (b *Builder) AddFile(fsys fs.FS, name, rel string) (*spdx.File, error) {
+	id := b.ID("file", rel)
+	if file, ok := b.byID[id].(*spdx.File); ok {
+		return file, nil
+	}
+
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h256, h512 := sha256.New(), sha512.New()
+	head := &prefixWriter{n: sniffLen}
+	if _, err := io.Copy(io.MultiWriter(h256, h512, head), f); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+
+	file := spdx.NewFile(id, rel, b.ci)
+	file.FileKind = spdx.FileKindTypeFile
+	file.ContentType = mime.TypeByExtension(path.Ext(rel))
+	if file.ContentType == "" {
+		file.ContentType = http.DetectContentType(head.buf)
+	}
+	file.AddHash(spdx.HashAlgorithmSha256, hex.EncodeToString(h256.Sum(nil)))
+	file.AddHash(spdx.HashAlgorithmSha512, hex.EncodeToString(h512.Sum(nil)))
+	b.Add(file)
+	return file, nil
+}
+
+// prefixWriter keeps the first n bytes written to it.
+type prefixWriter struct {
+	buf []byte
+	n   int
+}
+
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	if rest := w.n - len(w.buf); rest > 0 {
+		w.buf = append(w.buf, p[:min(rest, len(p))]...)
+	}
+	return len(p), nil
+}
@@ -0,0 +1,311 @@
+// Package git records the provenance of builds from git work trees in
+// SPDX 3.0 documents, as Build elements of the Build profile:
+//
+//	info, err := git.Read(ctx, ".")
+//	b := sbom.NewBuilder(namespace, "app")
+//	app := b.AddPackage("app", "1.0.0", "")
+//	build, err := git.Record(b, info, []spdx.AnyElement{app}, git.WithCI())
+//
+// The build has as input the source at the commit, identified by its gitoid
+// content identifier, generates the outputs, and is invoked by the builder,
+// such as the CI system running it, and by the person who started it.
+package git
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// Info is the state of a git work tree.
+type Info struct {
+	Commit     string    // hexadecimal object name of the HEAD commit
+	Branch     string    // checked-out branch, or "" if HEAD is detached
+	Remote     string    // URL of the remote of the branch, or of origin
+	CommitTime time.Time // committer time of the HEAD commit
+	Modified   bool      // whether the work tree has uncommitted changes
+}
+
+// Read returns the state of the git work tree containing dir, as reported
+// by the git command. Remote URLs are returned without their credentials.
+func Read(ctx context.Context, dir string) (*Info, error) {
+	run := func(args ...string) (string, error) {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = dir
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return "", fmt.Errorf("git %s: %w: %s", args[0], err, msg)
+			}
+			return "", fmt.Errorf("git %s: %w", args[0], err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+
+	info := &Info{}
+	var err error
+	if info.Commit, err = run("rev-parse", "HEAD"); err != nil {
+		return nil, err
+	}
+	// symbolic-ref fails, as expected, if HEAD is detached.
+	info.Branch, _ = run("symbolic-ref", "--short", "-q", "HEAD")
+
+	remote := "origin"
+	if info.Branch != "" {
+		if r, err := run("config", "--get", "branch."+info.Branch+".remote"); err == nil && r != "" {
+			remote = r
+		}
+	}
+	if u, err := run("remote", "get-url", remote); err == nil {
+		info.Remote = redact(u)
+	}
+
+	committed, err := run("show", "-s", "--format=%cI", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+	if info.CommitTime, err = time.Parse(time.RFC3339, committed); err != nil {
+		return nil, fmt.Errorf("parsing commit time: %w", err)
+	}
+	status, err := run("status", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+	info.Modified = status != ""
+	return info, nil
+}
+
+// redact removes the user information, which may hold an access token,
+// from a remote URL. Remotes such as "git@host:repo" are kept as they are.
+func redact(remote string) string {
+	u, err := url.Parse(remote)
+	if err != nil || u.User == nil || u.Scheme == "" {
+		return remote
+	}
+	u.User = nil
+	return u.String()
+}
+
+// Option configures Record.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	buildType string
+	buildID   string
+	builder   string
+	actor     string
+	start     time.Time
+	end       time.Time
+	getenv    func(string) string
+}
+
+// WithBuildType sets the type of the build, a URI. It is otherwise that of
+// the CI system recognized by WithCI, or "https://git-scm.com/".
+func WithBuildType(uri string) Option {
+	return optionFunc(func(c *config) {
+		c.buildType = uri
+	})
+}
+
+// WithBuildID sets the identifier of the build, such as a CI job URL.
+func WithBuildID(id string) Option {
+	return optionFunc(func(c *config) {
+		c.buildID = id
+	})
+}
+
+// WithBuilder sets the name of the software agent that invoked the build.
+func WithBuilder(name string) Option {
+	return optionFunc(func(c *config) {
+		c.builder = name
+	})
+}
+
+// WithActor sets the name of the person who started the build.
+func WithActor(name string) Option {
+	return optionFunc(func(c *config) {
+		c.actor = name
+	})
+}
+
+// WithTimes sets the start and end times of the build.
+func WithTimes(start, end time.Time) Option {
+	return optionFunc(func(c *config) {
+		c.start = start
+		c.end = end
+	})
+}
+
+// WithCI fills in what the build does not set from the environment of the
+// CI system running it, for GitHub Actions and GitLab CI: the build type,
+// the run or job URL as build ID, the CI system as builder, the user who
+// triggered the run as actor, and the branch of CI checkouts with a
+// detached HEAD.
+func WithCI() Option {
+	return optionFunc(func(c *config) {
+		c.getenv = os.Getenv
+	})
+}
+
+// ci is the environment of a CI system.
+type ci struct {
+	name      string
+	buildType string
+	buildID   string
+	branch    string
+	actor     string
+}
+
+// detectCI returns the CI system whose environment getenv reads, or nil.
+func detectCI(getenv func(string) string) *ci {
+	switch {
+	case getenv("GITHUB_ACTIONS") == "true":
+		branch := getenv("GITHUB_HEAD_REF") // set for pull requests only
+		if branch == "" && getenv("GITHUB_REF_TYPE") == "branch" {
+			branch = getenv("GITHUB_REF_NAME")
+		}
+		return &ci{
+			name:      "GitHub Actions",
+			buildType: "https://actions.github.io/buildtypes/workflow/v1",
+			buildID:   fmt.Sprintf("%s/%s/actions/runs/%s/attempts/%s", getenv("GITHUB_SERVER_URL"), getenv("GITHUB_REPOSITORY"), getenv("GITHUB_RUN_ID"), getenv("GITHUB_RUN_ATTEMPT")),
+			branch:    branch,
+			actor:     getenv("GITHUB_ACTOR"),
+		}
+	case getenv("GITLAB_CI") == "true":
+		return &ci{
+			name:      "GitLab CI",
+			buildType: "https://docs.gitlab.com/ee/ci/",
+			buildID:   getenv("CI_JOB_URL"),
+			branch:    getenv("CI_COMMIT_BRANCH"),
+			actor:     getenv("GITLAB_USER_LOGIN"),
+		}
+	}
+	return nil
+}
+
+// Record adds a Build of the work tree described by info to b and returns
+// it. The build records the remote and branch as its config source URI,
+// e.g. "git+https://github.com/acme/app@refs/heads/main", and the commit
+// as its config source digest. It has as input a source package for the
+// commit, with a gitoid content identifier, and generates the outputs.
+// The build is invoked by a software agent for the builder and a person
+// for the actor, if known.
+func Record(b *sbom.Builder, info *Info, outputs []spdx.AnyElement, opts ...Option) (*spdx.Build, error) {
+	if info.Commit == "" {
+		return nil, errors.New("no commit to record")
+	}
+	c := &config{}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	branch := info.Branch
+	if c.getenv != nil {
+		if env := detectCI(c.getenv); env != nil {
+			c.buildType = or(c.buildType, env.buildType)
+			c.buildID = or(c.buildID, env.buildID)
+			c.builder = or(c.builder, env.name)
+			c.actor = or(c.actor, env.actor)
+			branch = or(branch, env.branch)
+		}
+	}
+
+	alg := spdx.HashAlgorithmSha1
+	if len(info.Commit) == 64 {
+		alg = spdx.HashAlgorithmSha256
+	}
+	gitoid := fmt.Sprintf("gitoid:commit:%s:%s", alg, info.Commit)
+
+	name := info.Remote
+	if name == "" {
+		name = "source"
+	}
+	src := b.AddPackage(name, info.Commit, "")
+	src.PrimaryPurpose = spdx.SoftwarePurposeSource
+	src.ContentIdentifier = []spdx.ContentIdentifier{{
+		ContentIdentifierType:  spdx.ContentIdentifierTypeGitoid,
+		ContentIdentifierValue: gitoid,
+	}}
+	if info.Remote != "" {
+		src.DownloadLocation = "git+" + info.Remote + "@" + info.Commit
+	}
+	var notes []string
+	if branch != "" {
+		notes = append(notes, "branch "+branch)
+	}
+	if info.Modified {
+		notes = append(notes, "with uncommitted changes")
+	}
+	src.SourceInfo = strings.Join(notes, ", ")
+
+	build := &spdx.Build{
+		BuildType:      or(c.buildType, "https://git-scm.com/"),
+		BuildId:        c.buildID,
+		BuildStartTime: c.start,
+		BuildEndTime:   c.end,
+	}
+	build.SpdxID = b.ID("build", gitoid+" "+c.buildID)
+	build.CreationInfo = b.CreationInfo()
+	if info.Remote != "" {
+		uri := "git+" + info.Remote
+		if branch != "" {
+			uri += "@refs/heads/" + branch
+		}
+		build.ConfigSourceUri = []string{uri}
+	}
+	digest := spdx.NewHash(alg, info.Commit)
+	digest.Comment = "git commit"
+	build.ConfigSourceDigest = []spdx.Hash{digest}
+	if !info.CommitTime.IsZero() {
+		build.Parameter = append(build.Parameter, spdx.DictionaryEntry{Key: "commitTime", Value: info.CommitTime.UTC().Format(time.RFC3339)})
+	}
+	b.Add(build)
+
+	b.Relate(build, spdx.RelationshipTypeHasInput, src)
+	if len(outputs) > 0 {
+		b.Relate(build, spdx.RelationshipTypeGenerates, outputs...)
+	}
+	var invokers []spdx.AnyElement
+	if c.builder != "" {
+		agent := &spdx.SoftwareAgent{}
+		agent.SpdxID = b.ID("agent", c.builder)
+		agent.Name = c.builder
+		agent.CreationInfo = b.CreationInfo()
+		invokers = append(invokers, agent)
+	}
+	if c.actor != "" {
+		person := &spdx.Person{}
+		person.SpdxID = b.ID("person", c.actor)
+		person.Name = c.actor
+		person.CreationInfo = b.CreationInfo()
+		invokers = append(invokers, person)
+	}
+	if len(invokers) > 0 {
+		b.Add(invokers...)
+		b.Relate(build, spdx.RelationshipTypeInvokedBy, invokers...)
+	}
+	return build, nil
+}
+
+func or(a, b string) string {
+	if a != "" {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,150 @@
+package gobuild
+
+import (
+	"bytes"
+	"debug/buildinfo"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// executableMagic are the prefixes of the executable formats Go builds:
+// ELF, PE, Mach-O (32- and 64-bit, both byte orders, and universal),
+// XCOFF and WebAssembly.
+var executableMagic = [][]byte{
+	[]byte("\x7fELF"),
+	[]byte("MZ"),
+	[]byte("\xfe\xed\xfa\xce"), []byte("\xce\xfa\xed\xfe"),
+	[]byte("\xfe\xed\xfa\xcf"), []byte("\xcf\xfa\xed\xfe"),
+	[]byte("\xca\xfe\xba\xbe"),
+	[]byte("\x01\xdf"), []byte("\x01\xf7"),
+	[]byte("\x00asm"),
+}
+
+// ScanFile returns an SBOM of the Go binary at the given path, as Scan
+// does for a tree with that one file. It fails if the file is not a Go
+// binary with build information.
+func ScanFile(name string, opts ...Option) (*parse.Document, error) {
+	if _, err := buildinfo.ReadFile(name); err != nil {
+		return nil, fmt.Errorf("reading build information: %w", err)
+	}
+	c := newConfig(opts)
+	base := filepath.Base(name)
+	b := sbom.NewBuilder(c.documentNamespace(base), base, c.builder...)
+	if _, err := scanFile(b, os.DirFS(filepath.Dir(name)), base, base); err != nil {
+		return nil, err
+	}
+	return b.Document()
+}
+
+// Scan returns an SBOM of the Go binaries in the tree of fsys rooted at
+// root. Every binary is a File, named by its slash-separated path relative
+// to root, with its hashes and the executable purpose, and the
+// distribution artifact of its program, described as by Describe. The
+// programs are the roots of the document. Files that are not Go binaries,
+// or were built without build information, are skipped.
+func Scan(fsys fs.FS, root string, opts ...Option) (*parse.Document, error) {
+	c := newConfig(opts)
+	b := sbom.NewBuilder(c.documentNamespace(root), root, c.builder...)
+	err := fs.WalkDir(fsys, root, func(name string, d fs.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() {
+			return err
+		}
+		rel := path.Base(name)
+		if name != root {
+			rel = name[len(root)+1:]
+			if root == "." {
+				rel = name
+			}
+		}
+		_, err = scanFile(b, fsys, name, rel)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scanning binaries: %w", err)
+	}
+	return b.Document()
+}
+
+// scanFile adds the file of fsys with the given name and its program to b,
+// if it is a Go binary, and returns the program.
+func scanFile(b *sbom.Builder, fsys fs.FS, name, rel string) (*spdx.Package, error) {
+	info, err := readBuildInfo(fsys, name)
+	if err != nil || info == nil {
+		return nil, err
+	}
+	file, err := b.AddFile(fsys, name, rel)
+	if err != nil {
+		return nil, err
+	}
+	file.PrimaryPurpose = spdx.SoftwarePurposeExecutable
+	prog := Describe(b, info)
+	b.Relate(prog, spdx.RelationshipTypeHasDistributionArtifact, file)
+	b.AddRoot(prog)
+	return prog, nil
+}
+
+// readBuildInfo returns the build information of the file of fsys with the
+// given name, or nil if it is not a Go binary with build information.
+func readBuildInfo(fsys fs.FS, name string) (*buildinfo.BuildInfo, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	magic := make([]byte, 4)
+	n, err := io.ReadFull(f, magic)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, fmt.Errorf("reading %s: %w", name, err)
+	}
+	executable := false
+	for _, m := range executableMagic {
+		if bytes.HasPrefix(magic[:n], m) {
+			executable = true
+		}
+	}
+	if !executable {
+		return nil, nil
+	}
+
+	ra, ok := f.(io.ReaderAt)
+	if !ok {
+		data, err := io.ReadAll(f)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", name, err)
+		}
+		ra = bytes.NewReader(append(magic[:n], data...))
+	}
+	info, err := buildinfo.Read(ra)
+	if err != nil {
+		// Not built by Go, or without build information.
+		return nil, nil
+	}
+	return info, nil
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	return c
+}
+
+// documentNamespace returns the namespace set by WithNamespace, or one
+// derived from name.
+func (c *config) documentNamespace(name string) string {
+	if c.namespace != "" {
+		return c.namespace
+	}
+	return "https://spdx.org/spdxdocs/gobuild/" + url.PathEscape(name)
+}
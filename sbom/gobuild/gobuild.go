@@ -0,0 +1,243 @@
+// Package gobuild generates SPDX 3.0 SBOMs of Go programs from the build
+// information the Go toolchain embeds in them. A program can publish its
+// own SBOM:
+//
+//	http.Handle("/sbom", gobuild.Handler())
+//
+// and the Go binaries in a directory can be audited:
+//
+//	doc, err := gobuild.Scan(os.DirFS("/usr/local/bin"), ".")
+//
+// The document describes the program as a package with its main module,
+// the modules linked into it, the Go standard library, and a Build with the
+// build settings, such as the target platform, build flags and VCS
+// revision.
+package gobuild
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strings"
+	"sync"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+	"github.com/interlynk-io/spdx-zen/sbom/gomod"
+)
+
+// buildType is the type of the builds of Go programs: go build.
+const buildType = "https://pkg.go.dev/cmd/go#hdr-Compile_packages_and_dependencies"
+
+// Option configures the document generated from build information.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	namespace string
+	builder   []sbom.Option
+}
+
+// WithNamespace sets the SPDX ID of the document, which is otherwise
+// derived from the program's package path.
+func WithNamespace(namespace string) Option {
+	return optionFunc(func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithBuilderOptions sets options of the sbom.Builder creating the
+// document, such as its creation time.
+func WithBuilderOptions(opts ...sbom.Option) Option {
+	return optionFunc(func(c *config) {
+		c.builder = append(c.builder, opts...)
+	})
+}
+
+// Self returns an SBOM of the running program. It fails if the program was
+// built without module support.
+func Self(opts ...Option) (*parse.Document, error) {
+	b, err := self(opts)
+	if err != nil {
+		return nil, err
+	}
+	return b.Document()
+}
+
+func self(opts []Option) (*sbom.Builder, error) {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return nil, fmt.Errorf("no build information in the running program")
+	}
+	return newBuilder(info, opts), nil
+}
+
+// Handler returns an HTTP handler serving the SBOM of the running program
+// as SPDX 3.0 JSON-LD. The SBOM is generated on the first request.
+func Handler(opts ...Option) http.Handler {
+	var once sync.Once
+	var data []byte
+	var err error
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			var b *sbom.Builder
+			if b, err = self(opts); err == nil {
+				data, err = b.JSON()
+			}
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/spdx+json")
+		w.Write(data)
+	})
+}
+
+// Generate returns an SBOM of the program built with the given build
+// information.
+func Generate(info *debug.BuildInfo, opts ...Option) (*parse.Document, error) {
+	return newBuilder(info, opts).Document()
+}
+
+func newBuilder(info *debug.BuildInfo, opts []Option) *sbom.Builder {
+	c := newConfig(opts)
+	name := programPath(info)
+	namespace := c.namespace
+	if namespace == "" {
+		namespace = "https://spdx.org/spdxdocs/" + name
+	}
+	b := sbom.NewBuilder(namespace, name, c.builder...)
+	b.AddRoot(Describe(b, info))
+	return b
+}
+
+// Describe adds the program built with the given build information to b,
+// with the modules linked into it and its build, and returns the package
+// of the program. The program depends on the modules and the standard
+// library, and the build generates it.
+func Describe(b *sbom.Builder, info *debug.BuildInfo) *spdx.Package {
+	name := programPath(info)
+	version := moduleVersion(info.Main.Version)
+	purl := "pkg:golang/" + info.Main.Path
+	if info.Main.Path == "" {
+		purl = "pkg:golang/" + name
+	}
+	if version != "" {
+		purl += "@" + version
+	}
+	if sub, ok := strings.CutPrefix(name, info.Main.Path+"/"); ok && info.Main.Path != "" {
+		purl += "#" + sub
+	}
+	prog := b.AddPackage(name, version, purl)
+	prog.PrimaryPurpose = spdx.SoftwarePurposeApplication
+
+	var deps []spdx.AnyElement
+	// GoVersion is e.g. "go1.22.1" or "go1.22.1 X:boringcrypto".
+	goVersion, _, _ := strings.Cut(info.GoVersion, " ")
+	if goVersion = strings.TrimPrefix(goVersion, "go"); goVersion != "" {
+		stdlib := b.AddPackage("stdlib", goVersion, "pkg:golang/stdlib@"+goVersion)
+		stdlib.Summary = "Go standard library"
+		deps = append(deps, stdlib)
+	}
+	for _, m := range info.Deps {
+		var pkg *spdx.Package
+		switch r := m.Replace; {
+		case r == nil:
+			pkg = gomod.AddModule(b, m.Path, m.Version, m.Sum)
+		case r.Version == "":
+			pkg = b.AddPackage(m.Path, "", "")
+			pkg.SourceInfo = "replaced by the local directory " + r.Path
+		default:
+			pkg = gomod.AddModule(b, r.Path, r.Version, r.Sum)
+			pkg.SourceInfo = fmt.Sprintf("replaces %s %s", m.Path, m.Version)
+		}
+		deps = append(deps, pkg)
+	}
+	if len(deps) > 0 {
+		b.Relate(prog, spdx.RelationshipTypeDependsOn, deps...)
+	}
+
+	if len(info.Settings) > 0 {
+		build := newBuild(b, prog, info.Settings)
+		if rev := setting(info.Settings, "vcs.revision"); rev != "" {
+			prog.SourceInfo = fmt.Sprintf("built from %s revision %s", setting(info.Settings, "vcs"), rev)
+			if setting(info.Settings, "vcs.modified") == "true" {
+				prog.SourceInfo += " with local modifications"
+			}
+		}
+		b.Add(build)
+		b.Relate(build, spdx.RelationshipTypeGenerates, prog)
+	}
+	return prog
+}
+
+// newBuild returns the build of a program with the given build settings.
+// Environment variables, such as GOOS, are the environment of the build and
+// other settings, such as -ldflags and vcs.revision, its parameters. A git
+// revision is the digest of the build's source.
+func newBuild(b *sbom.Builder, prog *spdx.Package, settings []debug.BuildSetting) *spdx.Build {
+	build := &spdx.Build{BuildType: buildType}
+	build.SpdxID = b.ID("build", prog.GetPURL())
+	build.CreationInfo = b.CreationInfo()
+	for _, s := range settings {
+		entry := spdx.DictionaryEntry{Key: s.Key, Value: s.Value}
+		if s.Key == strings.ToUpper(s.Key) {
+			build.Environment = append(build.Environment, entry)
+		} else {
+			build.Parameter = append(build.Parameter, entry)
+		}
+	}
+	if setting(settings, "vcs") == "git" {
+		rev := setting(settings, "vcs.revision")
+		var alg spdx.HashAlgorithm
+		switch len(rev) {
+		case 40:
+			alg = spdx.HashAlgorithmSha1
+		case 64:
+			alg = spdx.HashAlgorithmSha256
+		}
+		if alg != "" {
+			h := spdx.NewHash(alg, rev)
+			h.Comment = "git commit"
+			build.ConfigSourceDigest = append(build.ConfigSourceDigest, h)
+		}
+	}
+	return build
+}
+
+// programPath returns the package path of the program, or of its main
+// module if unknown.
+func programPath(info *debug.BuildInfo) string {
+	if info.Path != "" {
+		return info.Path
+	}
+	if info.Main.Path != "" {
+		return info.Main.Path
+	}
+	return "program"
+}
+
+// moduleVersion returns the version of a module, or "" for the "(devel)"
+// version of main modules built from a working directory.
+func moduleVersion(v string) string {
+	if v == "(devel)" {
+		return ""
+	}
+	return v
+}
+
+func setting(settings []debug.BuildSetting, key string) string {
+	for _, s := range settings {
+		if s.Key == key {
+			return s.Value
+		}
+	}
+	return ""
+}
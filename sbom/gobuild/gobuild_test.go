@@ -0,0 +1,148 @@
+package gobuild_test
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"testing"
+	"testing/fstest"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom/gobuild"
+)
+
+func TestGenerate(t *testing.T) {
+	info := &debug.BuildInfo{
+		GoVersion: "go1.22.1",
+		Path:      "acme.example/app/cmd/server",
+		Main:      debug.Module{Path: "acme.example/app", Version: "v1.4.0"},
+		Deps: []*debug.Module{
+			{Path: "golang.org/x/text", Version: "v0.14.0", Sum: "h1:o2whT0Y2rsad4vZdugSjvk60wbmEDuIUvdugOrNIC7U="},
+			{Path: "acme.example/lib", Version: "v1.0.0", Replace: &debug.Module{Path: "../lib"}},
+		},
+		Settings: []debug.BuildSetting{
+			{Key: "-trimpath", Value: "true"},
+			{Key: "GOOS", Value: "linux"},
+			{Key: "GOARCH", Value: "amd64"},
+			{Key: "vcs", Value: "git"},
+			{Key: "vcs.revision", Value: "0123456789abcdef0123456789abcdef01234567"},
+			{Key: "vcs.modified", Value: "true"},
+		},
+	}
+	doc, err := gobuild.Generate(info)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	prog := doc.GetPackageByID(doc.SpdxDocument.RootElement[0].SpdxID)
+	if prog.Name != "acme.example/app/cmd/server" || prog.GetPURL() != "pkg:golang/acme.example/app@v1.4.0#cmd/server" {
+		t.Errorf("program = %s %s", prog.Name, prog.GetPURL())
+	}
+	if prog.SourceInfo != "built from git revision 0123456789abcdef0123456789abcdef01234567 with local modifications" {
+		t.Errorf("source info = %q", prog.SourceInfo)
+	}
+	assertDependsOn(t, doc, prog, "acme.example/lib", "golang.org/x/text", "stdlib")
+	if text := doc.GetPackageByName("golang.org/x/text"); len(text) != 1 || len(text[0].Hashes()) != 1 {
+		t.Errorf("golang.org/x/text = %v", text)
+	}
+
+	if len(doc.Builds) != 1 {
+		t.Fatalf("builds = %d, want 1", len(doc.Builds))
+	}
+	build := doc.Builds[0]
+	if len(build.Environment) != 2 || len(build.Parameter) != 4 {
+		t.Errorf("environment = %v, parameters = %v", build.Environment, build.Parameter)
+	}
+	if len(build.ConfigSourceDigest) != 1 || build.ConfigSourceDigest[0].Algorithm != spdx.HashAlgorithmSha1 {
+		t.Errorf("config source digest = %v", build.ConfigSourceDigest)
+	}
+	generated := doc.GetRelationshipsFrom(build.SpdxID)
+	if len(generated) != 1 || generated[0].RelationshipType != spdx.RelationshipTypeGenerates || generated[0].To[0].SpdxID != prog.SpdxID {
+		t.Errorf("relationships from the build = %v", generated)
+	}
+	if !slices.Contains(doc.SpdxDocument.ProfileConformance, spdx.ProfileIdentifierTypeBuild) {
+		t.Errorf("profiles = %v, want the build profile", doc.SpdxDocument.ProfileConformance)
+	}
+}
+
+func TestSelf(t *testing.T) {
+	doc, err := gobuild.Self()
+	if err != nil {
+		t.Fatalf("Self: %v", err)
+	}
+	if len(doc.SpdxDocument.RootElement) != 1 || doc.GetPackagesWithPURL() == nil {
+		t.Errorf("document = %+v", doc.SpdxDocument)
+	}
+
+	rec := httptest.NewRecorder()
+	gobuild.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/sbom", nil))
+	if rec.Code != 200 || rec.Header().Get("Content-Type") != "application/spdx+json" {
+		t.Fatalf("response %d %q", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	if _, err := parse.NewReader().Read(rec.Body.Bytes()); err != nil {
+		t.Errorf("reading the served SBOM: %v", err)
+	}
+}
+
+func assertDependsOn(t *testing.T, doc *parse.Document, pkg *spdx.Package, names ...string) {
+	t.Helper()
+	var got []string
+	for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+		for _, to := range rel.To {
+			got = append(got, doc.GetPackageByID(to.SpdxID).Name)
+		}
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, names) {
+		t.Errorf("%s depends on %v, want %v", pkg.Name, got, names)
+	}
+}
+
+func TestScan(t *testing.T) {
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"bin/tool":       {Data: data, Mode: 0o755},
+		"bin/not-go":     {Data: []byte("\x7fELF not a Go binary"), Mode: 0o755},
+		"bin/README.txt": {Data: []byte("tools")},
+	}
+
+	doc, err := gobuild.Scan(fsys, "bin")
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+	if len(doc.Files) != 1 || doc.Files[0].Name != "tool" || doc.Files[0].PrimaryPurpose != spdx.SoftwarePurposeExecutable {
+		t.Fatalf("files = %v, want the Go binary only", doc.Files)
+	}
+	if len(doc.SpdxDocument.RootElement) != 1 {
+		t.Fatalf("root elements = %v", doc.SpdxDocument.RootElement)
+	}
+	prog := doc.GetPackageByID(doc.SpdxDocument.RootElement[0].SpdxID)
+	artifacts := doc.GetRelationshipsByType(spdx.RelationshipTypeHasDistributionArtifact)
+	if len(artifacts) != 1 || artifacts[0].From.SpdxID != prog.SpdxID || artifacts[0].To[0].SpdxID != doc.Files[0].SpdxID {
+		t.Errorf("distribution artifacts = %v", artifacts)
+	}
+	if doc.GetPackageByName("stdlib") == nil {
+		t.Error("no standard library package")
+	}
+
+	if _, err := gobuild.ScanFile(exe); err != nil {
+		t.Errorf("ScanFile: %v", err)
+	}
+	notGo := filepath.Join(t.TempDir(), "script.sh")
+	if err := os.WriteFile(notGo, []byte("#!/bin/sh\n"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gobuild.ScanFile(notGo); err == nil {
+		t.Error("ScanFile succeeded for a shell script")
+	}
+}
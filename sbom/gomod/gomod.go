@@ -0,0 +1,246 @@
+// Package gomod generates SPDX 3.0 SBOMs of Go modules from their go.mod
+// and go.sum files:
+//
+//	doc, err := gomod.Generate(".", gomod.WithModCache("/home/me/go/pkg/mod"))
+//
+// The document describes the main module, a package for every module of
+// its build list with its package URL, proxy download location and go.sum
+// hash, and dependsOn relationships between them.
+package gomod
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// proxyURL is the Go module proxy download locations point to.
+const proxyURL = "https://proxy.golang.org"
+
+// Option configures Generate.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	modCache  string
+	namespace string
+	builder   []sbom.Option
+}
+
+// WithModCache sets the module cache directory, as printed by
+// "go env GOMODCACHE". The go.mod files of the dependencies cached there
+// give the dependsOn relationships between them; without a module cache,
+// only those of the main module are known.
+func WithModCache(dir string) Option {
+	return optionFunc(func(c *config) {
+		c.modCache = dir
+	})
+}
+
+// WithNamespace sets the SPDX ID of the document, which is otherwise
+// derived from the module path.
+func WithNamespace(namespace string) Option {
+	return optionFunc(func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithBuilderOptions sets options of the sbom.Builder creating the
+// document, such as its creation time.
+func WithBuilderOptions(opts ...sbom.Option) Option {
+	return optionFunc(func(c *config) {
+		c.builder = append(c.builder, opts...)
+	})
+}
+
+// Generate returns an SBOM of the Go module in dir, which must contain a
+// go.mod file. The go.sum file is optional; without it, the packages have
+// no hashes.
+//
+// The main module is the root of the document; it depends on the modules
+// it requires directly. Indirect requirements are reached through the
+// go.mod files of the module cache, if one is set, and depended on by the
+// main module otherwise. Replacements are applied: modules replaced by
+// local directories have neither version nor package URL.
+func Generate(dir string, opts ...Option) (*parse.Document, error) {
+	c := &config{}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return nil, fmt.Errorf("reading go.mod: %w", err)
+	}
+	mf, err := ParseModFile(data)
+	if err != nil {
+		return nil, err
+	}
+	sums := map[string]string{}
+	data, err = os.ReadFile(filepath.Join(dir, "go.sum"))
+	switch {
+	case err == nil:
+		sums = ParseSum(data)
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, fmt.Errorf("reading go.sum: %w", err)
+	}
+
+	namespace := c.namespace
+	if namespace == "" {
+		namespace = "https://spdx.org/spdxdocs/" + mf.Module
+	}
+	b := sbom.NewBuilder(namespace, mf.Module, c.builder...)
+	root := b.AddPackage(mf.Module, "", "pkg:golang/"+mf.Module)
+	root.PrimaryPurpose = spdx.SoftwarePurposeApplication
+	b.AddRoot(root)
+
+	// The build list: the selected version of every required module.
+	pkgs := make(map[string]*spdx.Package, len(mf.Require))
+	var direct, indirect []string
+	for _, req := range mf.Require {
+		pkgs[req.Path] = modulePackage(b, mf, req.Path, req.Version, sums)
+		if req.Indirect {
+			indirect = append(indirect, req.Path)
+		} else {
+			direct = append(direct, req.Path)
+		}
+	}
+
+	required := make(map[string]bool)
+	if c.modCache != "" {
+		for _, req := range mf.Require {
+			// Replaced modules are cached under their replacement.
+			pkg := pkgs[req.Path]
+			if pkg.PackageVersion == "" {
+				continue
+			}
+			deps, err := cachedRequires(c.modCache, pkg.Name, pkg.PackageVersion)
+			if err != nil {
+				return nil, err
+			}
+			var to []spdx.AnyElement
+			for _, path := range deps {
+				if dep, ok := pkgs[path]; ok && dep != pkg {
+					to = append(to, dep)
+					required[path] = true
+				}
+			}
+			if len(to) > 0 {
+				b.Relate(pkg, spdx.RelationshipTypeDependsOn, to...)
+			}
+		}
+	}
+
+	if to := packagesOf(pkgs, direct, nil); len(to) > 0 {
+		b.Relate(root, spdx.RelationshipTypeDependsOn, to...)
+	}
+	if to := packagesOf(pkgs, indirect, required); len(to) > 0 {
+		rel := b.Relate(root, spdx.RelationshipTypeDependsOn, to...)
+		rel.Comment = "indirect requirements"
+	}
+	return b.Document()
+}
+
+// modulePackage adds the package of a required module, after applying the
+// replacements of the main module.
+func modulePackage(b *sbom.Builder, mf *ModFile, path, version string, sums map[string]string) *spdx.Package {
+	for _, r := range mf.Replace {
+		if r.Old != path || (r.OldVersion != "" && r.OldVersion != version) {
+			continue
+		}
+		if r.NewVersion == "" {
+			pkg := b.AddPackage(path, "", "")
+			pkg.SourceInfo = "replaced by the local directory " + r.New
+			return pkg
+		}
+		pkg := AddModule(b, r.New, r.NewVersion, sums[r.New+"@"+r.NewVersion])
+		pkg.SourceInfo = fmt.Sprintf("replaces %s %s", path, version)
+		return pkg
+	}
+	return AddModule(b, path, version, sums[path+"@"+version])
+}
+
+// AddModule adds the package of a module version to b, with its package
+// URL, proxy download location and, if sum is a go.sum hash such as
+// "h1:...", its hash.
+func AddModule(b *sbom.Builder, path, version, sum string) *spdx.Package {
+	pkg := b.AddPackage(path, version, "pkg:golang/"+path+"@"+version)
+	pkg.DownloadLocation = fmt.Sprintf("%s/%s/@v/%s.zip", proxyURL, escapePath(path), escapePath(version))
+
+	h1, ok := strings.CutPrefix(sum, "h1:")
+	if !ok {
+		return pkg
+	}
+	digest, err := base64.StdEncoding.DecodeString(h1)
+	if err != nil {
+		return pkg
+	}
+	h := spdx.NewHash(spdx.HashAlgorithmSha256, hex.EncodeToString(digest))
+	h.Comment = "go.sum h1 hash: the SHA-256 of the module's file list and file hashes"
+	pkg.VerifiedUsing = append(pkg.VerifiedUsing, &h)
+	return pkg
+}
+
+// cachedRequires returns the paths of the modules required by the go.mod
+// file of a module version in the module cache, or nil if it is not
+// cached.
+func cachedRequires(modCache, path, version string) ([]string, error) {
+	file := filepath.Join(modCache, "cache", "download", filepath.FromSlash(escapePath(path)), "@v", escapePath(version)+".mod")
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading go.mod of %s@%s: %w", path, version, err)
+	}
+	mf, err := ParseModFile(data)
+	if err != nil {
+		return nil, fmt.Errorf("go.mod of %s@%s: %w", path, version, err)
+	}
+	paths := make([]string, len(mf.Require))
+	for i, req := range mf.Require {
+		paths[i] = req.Path
+	}
+	return paths, nil
+}
+
+// packagesOf returns the packages of the given modules, except those in
+// skip.
+func packagesOf(pkgs map[string]*spdx.Package, paths []string, skip map[string]bool) []spdx.AnyElement {
+	var elems []spdx.AnyElement
+	for _, path := range paths {
+		if !skip[path] {
+			elems = append(elems, pkgs[path])
+		}
+	}
+	return elems
+}
+
+// escapePath escapes a module path or version as the module proxy and
+// cache do, replacing upper-case letters by "!" and the lower-case letter.
+func escapePath(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
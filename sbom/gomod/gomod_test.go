@@ -0,0 +1,187 @@
+package gomod_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom/gomod"
+)
+
+const goMod = `module acme.example/app
+
+go 1.22
+
+require (
+	github.com/BurntSushi/toml v1.3.2
+	golang.org/x/text v0.14.0 // indirect
+	golang.org/x/tools v0.17.0
+	acme.example/fork v1.0.0
+	acme.example/local v0.0.0 // indirect
+)
+
+require "golang.org/x/mod" v0.14.0 // indirect
+
+replace acme.example/fork v1.0.0 => github.com/acme/fork v1.0.1
+
+replace acme.example/local => ../local
+`
+
+const goSum = `github.com/BurntSushi/toml v1.3.2 h1:o7IhLm0Msx3BaB+n3Ag7L8EVlByGnpq14C4YWiu/gL8=
+github.com/BurntSushi/toml v1.3.2/go.mod h1:CxXYINrC8qIiEnFrOxCa7Jy5BFHlXnUU2pbicEuybxQ=
+golang.org/x/tools v0.17.0 h1:FvmRgNOcs3kOa+T20R1uhfP9F6HgG2mfxDv1vrx1Htc=
+`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseModFile(t *testing.T) {
+	mf, err := gomod.ParseModFile([]byte(goMod))
+	if err != nil {
+		t.Fatalf("ParseModFile: %v", err)
+	}
+	if mf.Module != "acme.example/app" || mf.Go != "1.22" {
+		t.Errorf("module %q, go %q", mf.Module, mf.Go)
+	}
+	want := []gomod.Require{
+		{Path: "github.com/BurntSushi/toml", Version: "v1.3.2"},
+		{Path: "golang.org/x/text", Version: "v0.14.0", Indirect: true},
+		{Path: "golang.org/x/tools", Version: "v0.17.0"},
+		{Path: "acme.example/fork", Version: "v1.0.0"},
+		{Path: "acme.example/local", Version: "v0.0.0", Indirect: true},
+		{Path: "golang.org/x/mod", Version: "v0.14.0", Indirect: true},
+	}
+	if !slices.Equal(mf.Require, want) {
+		t.Errorf("requires = %+v, want %+v", mf.Require, want)
+	}
+	wantReplace := []gomod.Replace{
+		{Old: "acme.example/fork", OldVersion: "v1.0.0", New: "github.com/acme/fork", NewVersion: "v1.0.1"},
+		{Old: "acme.example/local", New: "../local"},
+	}
+	if !slices.Equal(mf.Replace, wantReplace) {
+		t.Errorf("replaces = %+v, want %+v", mf.Replace, wantReplace)
+	}
+
+	for _, bad := range []string{"go 1.22\n", "module a\nrequire b\n", "module \"a\n"} {
+		if _, err := gomod.ParseModFile([]byte(bad)); err == nil {
+			t.Errorf("ParseModFile(%q) succeeded", bad)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), goMod)
+	writeFile(t, filepath.Join(dir, "go.sum"), goSum)
+
+	// x/tools requires x/mod and x/text; x/mod requires nothing cached.
+	cache := t.TempDir()
+	writeFile(t, filepath.Join(cache, "cache", "download", "golang.org", "x", "tools", "@v", "v0.17.0.mod"),
+		"module golang.org/x/tools\n\nrequire (\n\tgolang.org/x/mod v0.14.0\n\tgolang.org/x/sys v0.16.0\n)\n\nrequire golang.org/x/text v0.13.0 // indirect\n")
+	writeFile(t, filepath.Join(cache, "cache", "download", "github.com", "!burnt!sushi", "toml", "@v", "v1.3.2.mod"),
+		"module github.com/BurntSushi/toml\n")
+
+	doc, err := gomod.Generate(dir, gomod.WithModCache(cache))
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if doc.SpdxDocument == nil || doc.SpdxDocument.Name != "acme.example/app" {
+		t.Fatalf("SpdxDocument = %+v", doc.SpdxDocument)
+	}
+	if len(doc.Packages) != 7 {
+		t.Fatalf("packages = %d, want 7", len(doc.Packages))
+	}
+
+	byName := make(map[string]*spdx.Package)
+	for _, pkg := range doc.Packages {
+		byName[pkg.Name] = pkg
+	}
+	root := byName["acme.example/app"]
+	if len(doc.SpdxDocument.RootElement) != 1 || doc.SpdxDocument.RootElement[0].SpdxID != root.SpdxID {
+		t.Errorf("root elements = %v, want the main module", doc.SpdxDocument.RootElement)
+	}
+
+	toml := byName["github.com/BurntSushi/toml"]
+	if toml.GetPURL() != "pkg:golang/github.com/BurntSushi/toml@v1.3.2" || toml.PackageVersion != "v1.3.2" {
+		t.Errorf("toml package = %+v", toml)
+	}
+	if toml.DownloadLocation != "https://proxy.golang.org/github.com/!burnt!sushi/toml/@v/v1.3.2.zip" {
+		t.Errorf("download location = %q", toml.DownloadLocation)
+	}
+	hashes := toml.Hashes()
+	if len(hashes) != 1 || hashes[0].Algorithm != spdx.HashAlgorithmSha256 ||
+		hashes[0].HashValue != "a3b2212e6d0cb31dc1681fa7dc083b2fc115941c869e9ab5e02e185a2bbf80bf" {
+		t.Errorf("toml hashes = %v", toml.VerifiedUsing)
+	}
+	if len(byName["golang.org/x/text"].Hashes()) != 0 {
+		t.Error("x/text has a hash without a go.sum line")
+	}
+
+	fork := byName["github.com/acme/fork"]
+	if fork == nil || fork.PackageVersion != "v1.0.1" || fork.SourceInfo != "replaces acme.example/fork v1.0.0" {
+		t.Errorf("replaced package = %+v", fork)
+	}
+	local := byName["acme.example/local"]
+	if local == nil || local.PackageVersion != "" || local.GetPURL() != "" || local.SourceInfo == "" {
+		t.Errorf("locally replaced package = %+v", local)
+	}
+
+	assertDependsOn(t, doc, root, "github.com/BurntSushi/toml", "golang.org/x/tools", "github.com/acme/fork", "acme.example/local")
+	assertDependsOn(t, doc, byName["golang.org/x/tools"], "golang.org/x/mod", "golang.org/x/text")
+	assertDependsOn(t, doc, toml)
+}
+
+func TestGenerate_WithoutModCache(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), goMod)
+
+	doc, err := gomod.Generate(dir, gomod.WithNamespace("https://acme.example/sbom/app"))
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if doc.SpdxDocument.SpdxID != "https://acme.example/sbom/app" {
+		t.Errorf("document ID = %q", doc.SpdxDocument.SpdxID)
+	}
+	var root *spdx.Package
+	for _, pkg := range doc.Packages {
+		if pkg.Name == "acme.example/app" {
+			root = pkg
+		}
+	}
+	assertDependsOn(t, doc, root, "github.com/BurntSushi/toml", "golang.org/x/tools", "github.com/acme/fork",
+		"golang.org/x/text", "acme.example/local", "golang.org/x/mod")
+
+	if _, err := gomod.Generate(t.TempDir()); err == nil {
+		t.Error("Generate succeeded without a go.mod file")
+	}
+}
+
+// assertDependsOn checks that the package depends on exactly the packages
+// with the given names.
+func assertDependsOn(t *testing.T, doc *parse.Document, pkg *spdx.Package, names ...string) {
+	t.Helper()
+	var got []string
+	for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+		if rel.RelationshipType != spdx.RelationshipTypeDependsOn {
+			continue
+		}
+		for _, to := range rel.To {
+			got = append(got, doc.GetPackageByID(to.SpdxID).Name)
+		}
+	}
+	slices.Sort(got)
+	slices.Sort(names)
+	if !slices.Equal(got, names) {
+		t.Errorf("%s depends on %v, want %v", pkg.Name, got, names)
+	}
+}
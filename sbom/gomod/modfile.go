@@ -0,0 +1,170 @@
+package gomod
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ModFile is the part of a go.mod file that describes the dependencies of
+// a module.
+type ModFile struct {
+	Module  string // module path
+	Go      string // go directive, e.g. "1.22"
+	Require []Require
+	Replace []Replace
+}
+
+// Require is a require directive.
+type Require struct {
+	Path     string
+	Version  string
+	Indirect bool // marked "// indirect"
+}
+
+// Replace is a replace directive. OldVersion is empty if all versions of
+// Old are replaced, and NewVersion is empty if New is a local directory.
+type Replace struct {
+	Old        string
+	OldVersion string
+	New        string
+	NewVersion string
+}
+
+// ParseModFile parses the contents of a go.mod file. Directives other than
+// module, go, require and replace are ignored.
+func ParseModFile(data []byte) (*ModFile, error) {
+	mf := &ModFile{}
+	block := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		tokens, comment, err := splitLine(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("go.mod:%d: %w", line, err)
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		verb := block
+		switch {
+		case block != "" && tokens[0] == ")":
+			block = ""
+			continue
+		case block == "" && len(tokens) == 2 && tokens[1] == "(":
+			block = tokens[0]
+			continue
+		case block == "":
+			verb, tokens = tokens[0], tokens[1:]
+		}
+
+		if err := mf.add(verb, tokens, comment); err != nil {
+			return nil, fmt.Errorf("go.mod:%d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading go.mod: %w", err)
+	}
+	if mf.Module == "" {
+		return nil, fmt.Errorf("go.mod has no module directive")
+	}
+	return mf, nil
+}
+
+func (mf *ModFile) add(verb string, args []string, comment string) error {
+	switch verb {
+	case "module":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: module path")
+		}
+		mf.Module = args[0]
+	case "go":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: go version")
+		}
+		mf.Go = args[0]
+	case "require":
+		if len(args) != 2 {
+			return fmt.Errorf("usage: require module/path v1.2.3")
+		}
+		indirect := comment == "indirect" || strings.HasPrefix(comment, "indirect;")
+		mf.Require = append(mf.Require, Require{Path: args[0], Version: args[1], Indirect: indirect})
+	case "replace":
+		arrow := -1
+		for i, arg := range args {
+			if arg == "=>" {
+				arrow = i
+			}
+		}
+		if arrow < 1 || arrow > 2 || len(args)-arrow-1 < 1 || len(args)-arrow-1 > 2 {
+			return fmt.Errorf("usage: replace module/path [v1.2.3] => other/module v1.4 or local/dir")
+		}
+		r := Replace{Old: args[0], New: args[arrow+1]}
+		if arrow == 2 {
+			r.OldVersion = args[1]
+		}
+		if len(args) == arrow+3 {
+			r.NewVersion = args[arrow+2]
+		}
+		mf.Replace = append(mf.Replace, r)
+	}
+	return nil
+}
+
+// splitLine returns the tokens of a go.mod line, unquoting quoted ones, and
+// the text of its trailing comment.
+func splitLine(line string) (tokens []string, comment string, err error) {
+	for {
+		line = strings.TrimLeft(line, " \t")
+		switch {
+		case line == "":
+			return tokens, comment, nil
+		case strings.HasPrefix(line, "//"):
+			return tokens, strings.TrimSpace(line[2:]), nil
+		case line[0] == '"' || line[0] == '`':
+			end := 1
+			for end < len(line) && line[end] != line[0] {
+				if line[0] == '"' && line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(line) {
+				return nil, "", fmt.Errorf("unterminated quoted string")
+			}
+			tok, err := strconv.Unquote(line[:end+1])
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid quoted string %s", line[:end+1])
+			}
+			tokens = append(tokens, tok)
+			line = line[end+1:]
+		default:
+			end := strings.IndexAny(line, " \t")
+			if end < 0 {
+				end = len(line)
+			}
+			if i := strings.Index(line[:end], "//"); i > 0 {
+				end = i
+			}
+			tokens = append(tokens, line[:end])
+			line = line[end:]
+		}
+	}
+}
+
+// ParseSum parses the contents of a go.sum file into the h1 hashes of
+// module contents by "path@version". The hashes of go.mod files are
+// skipped.
+func ParseSum(data []byte) map[string]string {
+	sums := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		sums[fields[0]+"@"+fields[1]] = fields[2]
+	}
+	return sums
+}
@@ -0,0 +1,67 @@
+package maven
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+)
+
+// ParseGradleLockfile parses a gradle.lockfile, which lists each locked
+// artifact with the configurations it is locked in, such as
+//
+//	com.google.guava:guava:32.1.2-jre=compileClasspath,runtimeClasspath
+//	junit:junit:4.13.2=testCompileClasspath,testRuntimeClasspath
+//
+// Artifacts of a runtime classpath are runtime dependencies of the
+// project, other artifacts of a test configuration test dependencies, and
+// other artifacts build dependencies.
+func ParseGradleLockfile(data []byte) (*Project, error) {
+	set := artifactSet{}
+	p := &Project{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		coords, configurations, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected coordinates=configurations", num)
+		}
+		if coords == "empty" {
+			// Configurations without locked artifacts.
+			continue
+		}
+		parts := strings.Split(coords, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("line %d: invalid artifact %q", num, coords)
+		}
+		a := set.get(Artifact{GroupID: parts[0], ArtifactID: parts[1], Version: parts[2]})
+		p.Dependencies = addDependency(p.Dependencies, Dependency{Artifact: a, Scope: gradleScope(configurations)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	p.Artifacts = set.sorted()
+	return p, nil
+}
+
+// gradleScope returns the lifecycle scope of an artifact locked in a
+// comma-separated list of configurations.
+func gradleScope(configurations string) spdx.LifecycleScopeType {
+	scope := spdx.LifecycleScopeTypeTest
+	for _, c := range strings.Split(configurations, ",") {
+		c = strings.TrimSpace(c)
+		test := strings.HasPrefix(c, "test")
+		switch {
+		case !test && strings.HasSuffix(strings.ToLower(c), "runtimeclasspath"):
+			return spdx.LifecycleScopeTypeRuntime
+		case !test:
+			scope = spdx.LifecycleScopeTypeBuild
+		}
+	}
+	return scope
+}
@@ -0,0 +1,255 @@
+// Package maven generates SPDX 3.0 SBOMs of Java projects from the
+// dependency trees printed by Maven or the lockfiles written by Gradle:
+//
+//	// mvn dependency:tree -DoutputFile=deps.txt
+//	project, err := maven.ParseDependencyTree(data)
+//	doc, err := project.Document()
+//
+// Every artifact becomes an SPDX package with its Maven package URL. The
+// dependencies of an artifact are LifecycleScopedRelationships whose scope
+// tells apart the dependencies needed at runtime from those needed only to
+// build or test it.
+package maven
+
+import (
+	"net/url"
+	"sort"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// Project is the dependency graph of a Maven or Gradle project.
+type Project struct {
+	// Modules are the modules of the project, one for each tree in the
+	// output of mvn dependency:tree. Gradle lockfiles record no modules.
+	Modules []*Artifact
+
+	// Artifacts are the artifacts the modules depend on, directly or not,
+	// sorted by coordinates.
+	Artifacts []*Artifact
+
+	// Dependencies are the dependencies of a project without modules.
+	// Gradle lockfiles record no dependencies between artifacts: every
+	// artifact is a dependency of the project.
+	Dependencies []Dependency
+}
+
+// Artifact is a Maven artifact.
+type Artifact struct {
+	GroupID    string
+	ArtifactID string
+	Version    string
+	Type       string // packaging type, "jar" if not recorded
+	Classifier string
+
+	Dependencies []Dependency
+}
+
+// Dependency is a dependency on an artifact in a lifecycle scope.
+type Dependency struct {
+	Artifact *Artifact
+
+	// Scope is the scope of the dependency: runtime for the compile,
+	// runtime and system scopes of Maven, build for the provided scope and
+	// test for the test scope. It is empty if unknown.
+	Scope    spdx.LifecycleScopeType
+	Optional bool
+}
+
+// Name returns the name of the artifact, "groupId:artifactId".
+func (a *Artifact) Name() string {
+	return a.GroupID + ":" + a.ArtifactID
+}
+
+// PURL returns the Maven package URL of the artifact.
+func (a *Artifact) PURL() string {
+	purl := "pkg:maven/" + url.PathEscape(a.GroupID) + "/" + url.PathEscape(a.ArtifactID)
+	if a.Version != "" {
+		purl += "@" + url.PathEscape(a.Version)
+	}
+	// Qualifiers are sorted by key.
+	var qualifiers []string
+	if a.Classifier != "" {
+		qualifiers = append(qualifiers, "classifier="+url.QueryEscape(a.Classifier))
+	}
+	if a.Type != "" && a.Type != "jar" {
+		qualifiers = append(qualifiers, "type="+url.QueryEscape(a.Type))
+	}
+	for i, q := range qualifiers {
+		if i == 0 {
+			purl += "?" + q
+		} else {
+			purl += "&" + q
+		}
+	}
+	return purl
+}
+
+// scopes maps Maven dependency scopes to SPDX lifecycle scopes.
+var scopes = map[string]spdx.LifecycleScopeType{
+	"compile":  spdx.LifecycleScopeTypeRuntime,
+	"runtime":  spdx.LifecycleScopeTypeRuntime,
+	"system":   spdx.LifecycleScopeTypeRuntime,
+	"provided": spdx.LifecycleScopeTypeBuild,
+	"test":     spdx.LifecycleScopeTypeTest,
+}
+
+// artifactSet collects the artifacts of a project by coordinates.
+type artifactSet map[string]*Artifact
+
+func (s artifactSet) get(a Artifact) *Artifact {
+	if a.Type == "" {
+		a.Type = "jar"
+	}
+	key := a.GroupID + ":" + a.ArtifactID + ":" + a.Type + ":" + a.Classifier + ":" + a.Version
+	if existing, ok := s[key]; ok {
+		return existing
+	}
+	s[key] = &a
+	return &a
+}
+
+// sorted returns the artifacts sorted by coordinates.
+func (s artifactSet) sorted(exclude ...*Artifact) []*Artifact {
+	arts := make([]*Artifact, 0, len(s))
+next:
+	for _, a := range s {
+		for _, x := range exclude {
+			if a == x {
+				continue next
+			}
+		}
+		arts = append(arts, a)
+	}
+	sort.Slice(arts, func(i, j int) bool {
+		return arts[i].PURL() < arts[j].PURL()
+	})
+	return arts
+}
+
+// addDependency appends dep to deps unless it is already there.
+func addDependency(deps []Dependency, dep Dependency) []Dependency {
+	for _, d := range deps {
+		if d.Artifact == dep.Artifact && d.Scope == dep.Scope {
+			return deps
+		}
+	}
+	return append(deps, dep)
+}
+
+// Option configures the document generated from a project.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	name      string
+	version   string
+	namespace string
+	builder   []sbom.Option
+}
+
+// WithProject sets the name and version of the project. Projects with
+// modules are otherwise named after their first module, and projects
+// without modules, as read from Gradle lockfiles, "project".
+func WithProject(name, version string) Option {
+	return optionFunc(func(c *config) {
+		c.name = name
+		c.version = version
+	})
+}
+
+// WithNamespace sets the SPDX ID of the document, which is otherwise
+// derived from the project name.
+func WithNamespace(namespace string) Option {
+	return optionFunc(func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithBuilderOptions sets options of the sbom.Builder creating the
+// document, such as its creation time.
+func WithBuilderOptions(opts ...sbom.Option) Option {
+	return optionFunc(func(c *config) {
+		c.builder = append(c.builder, opts...)
+	})
+}
+
+// Document returns an SBOM of the project. Its modules are the roots of
+// the document or, if it has none, a package for the project that depends
+// on the dependencies of the project. Dependencies in the same scope are
+// one relationship.
+func (p *Project) Document(opts ...Option) (*parse.Document, error) {
+	c := &config{}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	project := c.name
+	if project == "" && len(p.Modules) > 0 {
+		project = p.Modules[0].Name()
+	}
+	if project == "" {
+		project = "project"
+	}
+	namespace := c.namespace
+	if namespace == "" {
+		namespace = "https://spdx.org/spdxdocs/maven/" + url.PathEscape(project)
+	}
+
+	b := sbom.NewBuilder(namespace, project, c.builder...)
+	elems := make(map[*Artifact]*spdx.Package)
+	add := func(a *Artifact) *spdx.Package {
+		pkg := b.AddPackage(a.Name(), a.Version, a.PURL())
+		elems[a] = pkg
+		return pkg
+	}
+	for _, m := range p.Modules {
+		root := add(m)
+		root.PrimaryPurpose = spdx.SoftwarePurposeApplication
+		b.AddRoot(root)
+	}
+	for _, a := range p.Artifacts {
+		add(a)
+	}
+
+	relate := func(from *spdx.Package, deps []Dependency) {
+		byScope := make(map[spdx.LifecycleScopeType][]spdx.AnyElement)
+		var order []spdx.LifecycleScopeType
+		for _, dep := range deps {
+			elem, ok := elems[dep.Artifact]
+			if !ok || elem == from {
+				continue
+			}
+			if _, seen := byScope[dep.Scope]; !seen {
+				order = append(order, dep.Scope)
+			}
+			byScope[dep.Scope] = append(byScope[dep.Scope], elem)
+		}
+		for _, scope := range order {
+			if scope == "" {
+				b.Relate(from, spdx.RelationshipTypeDependsOn, byScope[scope]...)
+			} else {
+				b.RelateScoped(from, spdx.RelationshipTypeDependsOn, scope, byScope[scope]...)
+			}
+		}
+	}
+	for _, m := range p.Modules {
+		relate(elems[m], m.Dependencies)
+	}
+	for _, a := range p.Artifacts {
+		relate(elems[a], a.Dependencies)
+	}
+	if len(p.Modules) == 0 {
+		root := b.AddPackage(project, c.version, "")
+		root.PrimaryPurpose = spdx.SoftwarePurposeApplication
+		b.AddRoot(root)
+		relate(root, p.Dependencies)
+	}
+	return b.Document()
+}
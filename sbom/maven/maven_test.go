@@ -0,0 +1,174 @@
+package maven_test
+
+import (
+	"slices"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom/maven"
+)
+
+const dependencyTree = `[INFO] Scanning for projects...
+[INFO]
+[INFO] --- maven-dependency-plugin:3.6.0:tree (default-cli) @ app ---
+[INFO] com.acme:app:jar:1.0.0
+[INFO] +- com.google.guava:guava:jar:32.1.2-jre:compile
+[INFO] |  +- com.google.guava:failureaccess:jar:1.0.1:compile
+[INFO] |  \- (org.checkerframework:checker-qual:jar:3.33.0:compile - omitted for conflict with 3.37.0)
+[INFO] +- org.checkerframework:checker-qual:jar:3.37.0:compile
+[INFO] +- io.netty:netty-transport-native-epoll:jar:linux-x86_64:4.1.100.Final:runtime (optional)
+[INFO] +- jakarta.servlet:jakarta.servlet-api:jar:6.0.0:provided
+[INFO] \- junit:junit:jar:4.13.2:test
+[INFO]    \- org.hamcrest:hamcrest-core:jar:1.3:test
+[INFO] ------------------------------------------------------------------------
+[INFO] BUILD SUCCESS
+`
+
+const gradleLockfile = `# This is a Gradle generated file for dependency locking.
+# Manual edits can break the build and are not advised.
+# This file is expected to be part of source control.
+com.google.guava:guava:32.1.2-jre=compileClasspath,runtimeClasspath
+junit:junit:4.13.2=testCompileClasspath,testRuntimeClasspath
+org.projectlombok:lombok:1.18.30=annotationProcessor,compileClasspath
+empty=testAnnotationProcessor
+`
+
+func TestParseDependencyTree(t *testing.T) {
+	p, err := maven.ParseDependencyTree([]byte(dependencyTree))
+	if err != nil {
+		t.Fatalf("ParseDependencyTree: %v", err)
+	}
+	if len(p.Modules) != 1 || p.Modules[0].PURL() != "pkg:maven/com.acme/app@1.0.0" {
+		t.Fatalf("modules = %v", p.Modules)
+	}
+
+	var purls []string
+	for _, a := range p.Artifacts {
+		purls = append(purls, a.PURL())
+	}
+	want := []string{
+		"pkg:maven/com.google.guava/failureaccess@1.0.1",
+		"pkg:maven/com.google.guava/guava@32.1.2-jre",
+		"pkg:maven/io.netty/netty-transport-native-epoll@4.1.100.Final?classifier=linux-x86_64",
+		"pkg:maven/jakarta.servlet/jakarta.servlet-api@6.0.0",
+		"pkg:maven/junit/junit@4.13.2",
+		"pkg:maven/org.checkerframework/checker-qual@3.33.0",
+		"pkg:maven/org.checkerframework/checker-qual@3.37.0",
+		"pkg:maven/org.hamcrest/hamcrest-core@1.3",
+	}
+	if !slices.Equal(purls, want) {
+		t.Errorf("artifacts = %v, want %v", purls, want)
+	}
+
+	scopes := make(map[string]spdx.LifecycleScopeType)
+	for _, dep := range p.Modules[0].Dependencies {
+		scopes[dep.Artifact.ArtifactID] = dep.Scope
+		if dep.Optional != (dep.Artifact.ArtifactID == "netty-transport-native-epoll") {
+			t.Errorf("%s optional = %v", dep.Artifact.ArtifactID, dep.Optional)
+		}
+	}
+	wantScopes := map[string]spdx.LifecycleScopeType{
+		"guava":                        spdx.LifecycleScopeTypeRuntime,
+		"checker-qual":                 spdx.LifecycleScopeTypeRuntime,
+		"netty-transport-native-epoll": spdx.LifecycleScopeTypeRuntime,
+		"jakarta.servlet-api":          spdx.LifecycleScopeTypeBuild,
+		"junit":                        spdx.LifecycleScopeTypeTest,
+	}
+	if len(scopes) != len(wantScopes) {
+		t.Errorf("module dependencies = %v, want %v", scopes, wantScopes)
+	}
+	for name, scope := range wantScopes {
+		if scopes[name] != scope {
+			t.Errorf("scope of %s = %q, want %q", name, scopes[name], scope)
+		}
+	}
+
+	for _, a := range p.Artifacts {
+		if a.ArtifactID == "guava" && len(a.Dependencies) != 1 {
+			t.Errorf("guava dependencies = %v, want failureaccess only", a.Dependencies)
+		}
+	}
+
+	if _, err := maven.ParseDependencyTree([]byte("com.acme:app:jar:1.0.0\n+- junit:junit:jar:4.13.2:bogus\n")); err == nil {
+		t.Error("ParseDependencyTree accepted an unknown scope")
+	}
+}
+
+func TestParseGradleLockfile(t *testing.T) {
+	p, err := maven.ParseGradleLockfile([]byte(gradleLockfile))
+	if err != nil {
+		t.Fatalf("ParseGradleLockfile: %v", err)
+	}
+	if len(p.Modules) != 0 || len(p.Artifacts) != 3 {
+		t.Errorf("modules = %d, artifacts = %d, want 0 and 3", len(p.Modules), len(p.Artifacts))
+	}
+	got := make(map[string]spdx.LifecycleScopeType)
+	for _, dep := range p.Dependencies {
+		got[dep.Artifact.ArtifactID] = dep.Scope
+	}
+	want := map[string]spdx.LifecycleScopeType{
+		"guava":  spdx.LifecycleScopeTypeRuntime,
+		"junit":  spdx.LifecycleScopeTypeTest,
+		"lombok": spdx.LifecycleScopeTypeBuild,
+	}
+	for name, scope := range want {
+		if got[name] != scope {
+			t.Errorf("scope of %s = %q, want %q", name, got[name], scope)
+		}
+	}
+}
+
+func TestProject_Document(t *testing.T) {
+	p, err := maven.ParseDependencyTree([]byte(dependencyTree))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := p.Document()
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+	if doc.SpdxDocument.Name != "com.acme:app" || len(doc.Packages) != 9 {
+		t.Errorf("document %q with %d packages", doc.SpdxDocument.Name, len(doc.Packages))
+	}
+	if len(doc.Relationships) != 0 {
+		t.Errorf("relationships = %d, want only scoped relationships", len(doc.Relationships))
+	}
+
+	app := doc.GetPackageByID(doc.SpdxDocument.RootElement[0].SpdxID)
+	assertDependsOn(t, doc, app, spdx.LifecycleScopeTypeRuntime,
+		"com.google.guava:guava", "io.netty:netty-transport-native-epoll", "org.checkerframework:checker-qual")
+	assertDependsOn(t, doc, app, spdx.LifecycleScopeTypeBuild, "jakarta.servlet:jakarta.servlet-api")
+	assertDependsOn(t, doc, app, spdx.LifecycleScopeTypeTest, "junit:junit")
+
+	gradle, err := maven.ParseGradleLockfile([]byte(gradleLockfile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err = gradle.Document(maven.WithProject("acme-service", "2.0.0"))
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+	root := doc.GetPackageByID(doc.SpdxDocument.RootElement[0].SpdxID)
+	if root.Name != "acme-service" || root.PackageVersion != "2.0.0" {
+		t.Errorf("root = %s %s", root.Name, root.PackageVersion)
+	}
+	assertDependsOn(t, doc, root, spdx.LifecycleScopeTypeTest, "junit:junit")
+}
+
+func assertDependsOn(t *testing.T, doc *parse.Document, pkg *spdx.Package, scope spdx.LifecycleScopeType, names ...string) {
+	t.Helper()
+	var got []string
+	for _, rel := range doc.LifecycleScopedRelationships {
+		if rel.From.SpdxID != pkg.SpdxID || rel.Scope != scope || rel.RelationshipType != spdx.RelationshipTypeDependsOn {
+			continue
+		}
+		for _, to := range rel.To {
+			got = append(got, doc.GetPackageByID(to.SpdxID).Name)
+		}
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, names) {
+		t.Errorf("%s depends in the %s scope on %v, want %v", pkg.Name, scope, got, names)
+	}
+}
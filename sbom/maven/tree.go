@@ -0,0 +1,114 @@
+package maven
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ParseDependencyTree parses the output of mvn dependency:tree, with or
+// without Maven's log prefixes, such as
+//
+//	[INFO] com.acme:app:jar:1.0.0
+//	[INFO] +- com.google.guava:guava:jar:32.1.2-jre:compile
+//	[INFO] |  \- com.google.guava:failureaccess:jar:1.0.1:compile
+//	[INFO] \- junit:junit:jar:4.13.2:test
+//
+// The output of a multi-module build has a tree for each module. Artifacts
+// omitted by Maven for a version conflict, as printed by the verbose
+// option, are not dependencies; those omitted as duplicates are.
+func ParseDependencyTree(data []byte) (*Project, error) {
+	set := artifactSet{}
+	p := &Project{}
+	var stack []*Artifact
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		if strings.HasPrefix(line, "[") {
+			level, rest, ok := strings.Cut(line[1:], "]")
+			if !ok || level != "INFO" {
+				continue
+			}
+			line = strings.TrimPrefix(rest, " ")
+		}
+
+		depth := 0
+		for len(line) >= 3 {
+			indent := line[:3]
+			if indent != "|  " && indent != "   " && indent != "+- " && indent != "\\- " {
+				break
+			}
+			depth++
+			line = line[3:]
+			if indent == "+- " || indent == "\\- " {
+				break
+			}
+		}
+
+		if depth == 0 {
+			// A module starts a tree; other lines are Maven's log.
+			if parts := strings.Split(line, ":"); len(parts) == 4 && !strings.ContainsAny(line, " \t") {
+				m := set.get(Artifact{GroupID: parts[0], ArtifactID: parts[1], Type: parts[2], Version: parts[3]})
+				p.Modules = append(p.Modules, m)
+				stack = []*Artifact{m}
+			}
+			continue
+		}
+		if depth > len(stack) {
+			return nil, fmt.Errorf("line %d: dependency outside of a tree", num)
+		}
+
+		a, dep, omitted, err := parseTreeNode(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", num, err)
+		}
+		stack = stack[:depth]
+		art := set.get(a)
+		stack = append(stack, art)
+		if omitted {
+			continue
+		}
+		dep.Artifact = art
+		parent := stack[depth-1]
+		parent.Dependencies = addDependency(parent.Dependencies, dep)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	p.Artifacts = set.sorted(p.Modules...)
+	return p, nil
+}
+
+// parseTreeNode parses a node of a dependency tree, such as
+// "org.slf4j:slf4j-api:jar:2.0.9:compile (optional)" or, in verbose output,
+// "(org.slf4j:slf4j-api:jar:2.0.7:compile - omitted for conflict with 2.0.9)".
+// It reports whether the node is omitted for a version conflict.
+func parseTreeNode(text string) (a Artifact, dep Dependency, omitted bool, err error) {
+	if inner, ok := strings.CutPrefix(text, "("); ok {
+		inner, _, _ = strings.Cut(inner, ")")
+		var note string
+		inner, note, _ = strings.Cut(inner, " - ")
+		omitted = strings.HasPrefix(note, "omitted for conflict")
+		text = inner
+	}
+	coords, rest, _ := strings.Cut(text, " ")
+	dep.Optional = strings.Contains(rest, "(optional)")
+
+	parts := strings.Split(coords, ":")
+	switch len(parts) {
+	case 5:
+		a = Artifact{GroupID: parts[0], ArtifactID: parts[1], Type: parts[2], Version: parts[3]}
+	case 6:
+		a = Artifact{GroupID: parts[0], ArtifactID: parts[1], Type: parts[2], Classifier: parts[3], Version: parts[4]}
+	default:
+		return a, dep, false, fmt.Errorf("invalid artifact %q", coords)
+	}
+	scope := parts[len(parts)-1]
+	var known bool
+	if dep.Scope, known = scopes[scope]; !known {
+		return a, dep, false, fmt.Errorf("unknown scope %q of %s", scope, coords)
+	}
+	return a, dep, omitted, nil
+}
@@ -0,0 +1,291 @@
+// Package npm generates SPDX 3.0 SBOMs of JavaScript projects from their
+// package-lock.json, yarn.lock or pnpm-lock.yaml lockfiles:
+//
+//	doc, err := npm.Generate(".")
+//
+// or, for a lockfile read by the caller:
+//
+//	lock, err := npm.ParsePnpmLock(data)
+//	doc, err := lock.Document()
+//
+// Every installed package becomes an SPDX package with its npm package URL,
+// download location and integrity hash, with dependsOn relationships
+// following the dependencies recorded in the lockfile.
+package npm
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// Lockfile is the dependency graph recorded in a lockfile.
+type Lockfile struct {
+	// Name and Version are those of the project, if the lockfile records
+	// them.
+	Name    string
+	Version string
+
+	// Packages are the installed packages, sorted by name and version.
+	Packages []*Package
+
+	// Dependencies are the direct dependencies of the project, or nil if
+	// the lockfile does not record them, as in yarn.lock files before
+	// Yarn 2.
+	Dependencies []*Package
+}
+
+// Package is a package installed from the lockfile. Packages installed in
+// several places with the same name and version are one Package.
+type Package struct {
+	Name         string
+	Version      string
+	Resolved     string // URL the package is downloaded from, if recorded
+	Integrity    string // Subresource Integrity hashes, e.g. "sha512-..."
+	Dependencies []*Package
+}
+
+// PURL returns the npm package URL of the package, or "" if its version
+// is not a registry version, as for packages from git or local
+// directories.
+func (p *Package) PURL() string {
+	if p.Version == "" || p.Version[0] < '0' || p.Version[0] > '9' {
+		return ""
+	}
+	name := url.PathEscape(p.Name)
+	if scope, rest, ok := strings.Cut(p.Name, "/"); ok && strings.HasPrefix(scope, "@") {
+		name = "%40" + url.PathEscape(scope[1:]) + "/" + url.PathEscape(rest)
+	}
+	return "pkg:npm/" + name + "@" + url.PathEscape(p.Version)
+}
+
+// packageSet collects the packages of a lockfile by name and version.
+type packageSet map[string]*Package
+
+func (s packageSet) get(name, version string) *Package {
+	key := name + "@" + version
+	pkg, ok := s[key]
+	if !ok {
+		pkg = &Package{Name: name, Version: version}
+		s[key] = pkg
+	}
+	return pkg
+}
+
+// addDependency records a dependency of pkg, once.
+func addDependency(pkg, dep *Package) {
+	for _, d := range pkg.Dependencies {
+		if d == dep {
+			return
+		}
+	}
+	pkg.Dependencies = append(pkg.Dependencies, dep)
+}
+
+// sorted returns the packages sorted by name and version.
+func (s packageSet) sorted() []*Package {
+	pkgs := make([]*Package, 0, len(s))
+	for _, pkg := range s {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].Name != pkgs[j].Name {
+			return pkgs[i].Name < pkgs[j].Name
+		}
+		return pkgs[i].Version < pkgs[j].Version
+	})
+	return pkgs
+}
+
+// Option configures the document generated from a lockfile.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	name      string
+	version   string
+	namespace string
+	builder   []sbom.Option
+}
+
+// WithProject sets the name and version of the project, overriding those
+// recorded in the lockfile.
+func WithProject(name, version string) Option {
+	return optionFunc(func(c *config) {
+		c.name = name
+		c.version = version
+	})
+}
+
+// WithNamespace sets the SPDX ID of the document, which is otherwise
+// derived from the project name.
+func WithNamespace(namespace string) Option {
+	return optionFunc(func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithBuilderOptions sets options of the sbom.Builder creating the
+// document, such as its creation time.
+func WithBuilderOptions(opts ...sbom.Option) Option {
+	return optionFunc(func(c *config) {
+		c.builder = append(c.builder, opts...)
+	})
+}
+
+// Generate returns an SBOM of the JavaScript project in dir from its
+// package-lock.json, npm-shrinkwrap.json, pnpm-lock.yaml or yarn.lock, tried
+// in that order. The name and version of the project are read from its
+// package.json, if any.
+func Generate(dir string, opts ...Option) (*parse.Document, error) {
+	parsers := []struct {
+		file  string
+		parse func([]byte) (*Lockfile, error)
+	}{
+		{"package-lock.json", ParsePackageLock},
+		{"npm-shrinkwrap.json", ParsePackageLock},
+		{"pnpm-lock.yaml", ParsePnpmLock},
+		{"yarn.lock", ParseYarnLock},
+	}
+	for _, p := range parsers {
+		data, err := os.ReadFile(filepath.Join(dir, p.file))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p.file, err)
+		}
+		lock, err := p.parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.file, err)
+		}
+
+		var manifest struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		}
+		data, err = os.ReadFile(filepath.Join(dir, "package.json"))
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(data, &manifest); err != nil {
+				return nil, fmt.Errorf("package.json: %w", err)
+			}
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, fmt.Errorf("reading package.json: %w", err)
+		}
+		if manifest.Name != "" {
+			lock.Name, lock.Version = manifest.Name, manifest.Version
+		}
+		return lock.Document(opts...)
+	}
+	return nil, fmt.Errorf("no lockfile in %s", dir)
+}
+
+// Document returns an SBOM of the lockfile. The project is the root of the
+// document; it depends on its direct dependencies or, if the lockfile does
+// not record them, on the packages no other package depends on.
+func (l *Lockfile) Document(opts ...Option) (*parse.Document, error) {
+	c := &config{name: l.Name, version: l.Version}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	if c.name == "" {
+		c.name = "project"
+	}
+	namespace := c.namespace
+	if namespace == "" {
+		namespace = "https://spdx.org/spdxdocs/npm/" + url.PathEscape(c.name)
+	}
+
+	b := sbom.NewBuilder(namespace, c.name, c.builder...)
+	root := b.AddPackage(c.name, c.version, (&Package{Name: c.name, Version: c.version}).PURL())
+	root.PrimaryPurpose = spdx.SoftwarePurposeApplication
+	b.AddRoot(root)
+
+	elems := make(map[*Package]*spdx.Package, len(l.Packages))
+	for _, p := range l.Packages {
+		pkg := b.AddPackage(p.Name, p.Version, p.PURL())
+		pkg.DownloadLocation = p.Resolved
+		addIntegrity(pkg, p.Integrity)
+		elems[p] = pkg
+	}
+
+	required := make(map[*Package]bool)
+	for _, p := range l.Packages {
+		var to []spdx.AnyElement
+		for _, dep := range p.Dependencies {
+			if elem, ok := elems[dep]; ok && dep != p {
+				to = append(to, elem)
+				required[dep] = true
+			}
+		}
+		if len(to) > 0 {
+			b.Relate(elems[p], spdx.RelationshipTypeDependsOn, to...)
+		}
+	}
+
+	direct := l.Dependencies
+	if direct == nil {
+		for _, p := range l.Packages {
+			if !required[p] {
+				direct = append(direct, p)
+			}
+		}
+	}
+	var to []spdx.AnyElement
+	for _, dep := range direct {
+		if elem, ok := elems[dep]; ok {
+			to = append(to, elem)
+		}
+	}
+	if len(to) > 0 {
+		b.Relate(root, spdx.RelationshipTypeDependsOn, to...)
+	}
+	return b.Document()
+}
+
+// sriAlgorithms maps the algorithms of Subresource Integrity hashes to SPDX
+// hash algorithms.
+var sriAlgorithms = map[string]spdx.HashAlgorithm{
+	"sha1":   spdx.HashAlgorithmSha1,
+	"sha256": spdx.HashAlgorithmSha256,
+	"sha384": spdx.HashAlgorithmSha384,
+	"sha512": spdx.HashAlgorithmSha512,
+}
+
+// addIntegrity adds the hashes of a Subresource Integrity value, such as
+// "sha512-<base64>", to the package.
+func addIntegrity(pkg *spdx.Package, integrity string) {
+	for _, sri := range strings.Fields(integrity) {
+		alg, b64, ok := strings.Cut(sri, "-")
+		if !ok {
+			continue
+		}
+		algorithm, ok := sriAlgorithms[alg]
+		if !ok {
+			continue
+		}
+		digest, err := base64.StdEncoding.DecodeString(b64)
+		if err != nil {
+			continue
+		}
+		pkg.AddHash(algorithm, hex.EncodeToString(digest))
+	}
+}
@@ -0,0 +1,416 @@
+package npm_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom/npm"
+)
+
+const packageLock = `{
+	"name": "acme-app",
+	"version": "1.0.0",
+	"lockfileVersion": 3,
+	"packages": {
+		"": {
+			"name": "acme-app",
+			"version": "1.0.0",
+			"dependencies": {"@acme/ui": "^2.0.0", "lodash": "^4.17.0"},
+			"devDependencies": {"debug": "^4.0.0"}
+		},
+		"node_modules/@acme/ui": {
+			"version": "2.1.0",
+			"resolved": "https://registry.npmjs.org/@acme/ui/-/ui-2.1.0.tgz",
+			"integrity": "sha512-3q2+7w==",
+			"dependencies": {"ms": "^1.0.0", "lodash": "^4.0.0"}
+		},
+		"node_modules/@acme/ui/node_modules/ms": {"version": "1.0.0"},
+		"node_modules/debug": {"version": "4.3.4", "dev": true, "dependencies": {"ms": "2.1.2"}},
+		"node_modules/lodash": {"version": "4.17.21"},
+		"node_modules/ms": {"version": "2.1.2", "dev": true}
+	}
+}`
+
+const packageLockV1 = `{
+	"name": "legacy-app",
+	"version": "0.1.0",
+	"lockfileVersion": 1,
+	"requires": true,
+	"dependencies": {
+		"debug": {
+			"version": "4.3.4",
+			"requires": {"ms": "2.1.2"},
+			"dependencies": {"ms": {"version": "2.1.2"}}
+		}
+	}
+}`
+
+const pnpmLockV9 = `lockfileVersion: '9.0'
+
+importers:
+
+  .:
+    dependencies:
+      '@acme/ui':
+        specifier: ^2.0.0
+        version: 2.1.0(react@18.2.0)
+      react:
+        specifier: ^18.0.0
+        version: 18.2.0
+
+packages:
+
+  '@acme/ui@2.1.0':
+    resolution: {integrity: sha512-3q2+7w==}
+    peerDependencies:
+      react: '>=17'
+
+  loose-envify@1.4.0:
+    resolution: {integrity: sha512-3q2+7w==, tarball: https://registry.npmjs.org/loose-envify/-/loose-envify-1.4.0.tgz}
+    hasBin: true
+
+  react@18.2.0:
+    resolution: {integrity: sha512-3q2+7w==}
+    engines: {node: '>=0.10.0'}
+
+snapshots:
+
+  '@acme/ui@2.1.0(react@18.2.0)':
+    dependencies:
+      react: 18.2.0
+
+  loose-envify@1.4.0: {}
+
+  react@18.2.0:
+    dependencies:
+      loose-envify: 1.4.0
+`
+
+const pnpmLockV5 = `lockfileVersion: 5.4
+
+specifiers:
+  react: ^18.0.0
+
+dependencies:
+  react: 18.2.0
+
+packages:
+
+  /loose-envify/1.4.0:
+    resolution: {integrity: sha512-3q2+7w==}
+    dev: false
+
+  /react/18.2.0:
+    resolution: {integrity: sha512-3q2+7w==}
+    dependencies:
+      loose-envify: 1.4.0
+    dev: false
+`
+
+const yarnClassicLock = `# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@acme/ui@^2.0.0":
+  version "2.1.0"
+  resolved "https://registry.yarnpkg.com/@acme/ui/-/ui-2.1.0.tgz#0123abcd"
+  integrity sha512-3q2+7w==
+  dependencies:
+    lodash "^4.0.0"
+
+lodash@^4.0.0, lodash@^4.17.0:
+  version "4.17.21"
+  resolved "https://registry.yarnpkg.com/lodash/-/lodash-4.17.21.tgz#679591c5"
+  integrity sha512-3q2+7w==
+`
+
+const yarnBerryLock = `# This file is generated by running "yarn install" inside your project.
+
+__metadata:
+  version: 6
+  cacheKey: 8
+
+"@acme/ui@npm:^2.0.0":
+  version: 2.1.0
+  resolution: "@acme/ui@npm:2.1.0"
+  dependencies:
+    lodash: ^4.0.0
+  checksum: 0123abcd
+  languageName: node
+  linkType: hard
+
+"acme-app@workspace:.":
+  version: 0.0.0-use.local
+  resolution: "acme-app@workspace:."
+  dependencies:
+    "@acme/ui": ^2.0.0
+  languageName: unknown
+  linkType: soft
+
+"lodash@npm:^4.0.0":
+  version: 4.17.21
+  resolution: "lodash@npm:4.17.21"
+  languageName: node
+  linkType: hard
+`
+
+// dependencies returns the name@version of the packages each package of
+// the lockfile depends on, with the project under "".
+func dependencies(l *npm.Lockfile) map[string][]string {
+	deps := make(map[string][]string)
+	for _, dep := range l.Dependencies {
+		deps[""] = append(deps[""], dep.Name+"@"+dep.Version)
+	}
+	for _, pkg := range l.Packages {
+		for _, dep := range pkg.Dependencies {
+			key := pkg.Name + "@" + pkg.Version
+			deps[key] = append(deps[key], dep.Name+"@"+dep.Version)
+		}
+	}
+	for _, d := range deps {
+		slices.Sort(d)
+	}
+	return deps
+}
+
+func TestParsers(t *testing.T) {
+	tests := []struct {
+		name     string
+		parse    func([]byte) (*npm.Lockfile, error)
+		data     string
+		project  string
+		packages int
+		want     map[string][]string
+	}{
+		{
+			name:     "package-lock v3",
+			parse:    npm.ParsePackageLock,
+			data:     packageLock,
+			project:  "acme-app",
+			packages: 5,
+			want: map[string][]string{
+				"":               {"@acme/ui@2.1.0", "debug@4.3.4", "lodash@4.17.21"},
+				"@acme/ui@2.1.0": {"lodash@4.17.21", "ms@1.0.0"},
+				"debug@4.3.4":    {"ms@2.1.2"},
+			},
+		},
+		{
+			name:     "package-lock v1",
+			parse:    npm.ParsePackageLock,
+			data:     packageLockV1,
+			project:  "legacy-app",
+			packages: 2,
+			want: map[string][]string{
+				"debug@4.3.4": {"ms@2.1.2"},
+			},
+		},
+		{
+			name:     "pnpm v9",
+			parse:    npm.ParsePnpmLock,
+			data:     pnpmLockV9,
+			packages: 3,
+			want: map[string][]string{
+				"":               {"@acme/ui@2.1.0", "react@18.2.0"},
+				"@acme/ui@2.1.0": {"react@18.2.0"},
+				"react@18.2.0":   {"loose-envify@1.4.0"},
+			},
+		},
+		{
+			name:     "pnpm v5",
+			parse:    npm.ParsePnpmLock,
+			data:     pnpmLockV5,
+			packages: 2,
+			want: map[string][]string{
+				"":             {"react@18.2.0"},
+				"react@18.2.0": {"loose-envify@1.4.0"},
+			},
+		},
+		{
+			name:     "yarn classic",
+			parse:    npm.ParseYarnLock,
+			data:     yarnClassicLock,
+			packages: 2,
+			want: map[string][]string{
+				"@acme/ui@2.1.0": {"lodash@4.17.21"},
+			},
+		},
+		{
+			name:     "yarn berry",
+			parse:    npm.ParseYarnLock,
+			data:     yarnBerryLock,
+			project:  "acme-app",
+			packages: 2,
+			want: map[string][]string{
+				"":               {"@acme/ui@2.1.0"},
+				"@acme/ui@2.1.0": {"lodash@4.17.21"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := tt.parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if l.Name != tt.project {
+				t.Errorf("project = %q, want %q", l.Name, tt.project)
+			}
+			if len(l.Packages) != tt.packages {
+				t.Errorf("packages = %d, want %d", len(l.Packages), tt.packages)
+			}
+			got := dependencies(l)
+			for key, want := range tt.want {
+				if !slices.Equal(got[key], want) {
+					t.Errorf("dependencies of %q = %v, want %v", key, got[key], want)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("dependencies = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockfile_Document(t *testing.T) {
+	l, err := npm.ParseYarnLock([]byte(yarnClassicLock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := l.Document(npm.WithProject("acme-app", "1.0.0"))
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+
+	byName := make(map[string]*spdx.Package)
+	for _, pkg := range doc.Packages {
+		byName[pkg.Name] = pkg
+	}
+	ui := byName["@acme/ui"]
+	if ui == nil || ui.GetPURL() != "pkg:npm/%40acme/ui@2.1.0" {
+		t.Fatalf("@acme/ui package = %+v", ui)
+	}
+	if ui.DownloadLocation != "https://registry.yarnpkg.com/@acme/ui/-/ui-2.1.0.tgz" {
+		t.Errorf("download location = %q", ui.DownloadLocation)
+	}
+	if hashes := ui.Hashes(); len(hashes) != 1 || hashes[0].Algorithm != spdx.HashAlgorithmSha512 || hashes[0].HashValue != "deadbeef" {
+		t.Errorf("hashes = %v", ui.VerifiedUsing)
+	}
+
+	// Yarn 1 records no direct dependencies: the project depends on the
+	// packages nothing else depends on.
+	root := byName["acme-app"]
+	assertDependsOn(t, doc, root, "@acme/ui")
+	assertDependsOn(t, doc, ui, "lodash")
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"name": "acme-app", "version": "1.2.0"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "pnpm-lock.yaml"), []byte(pnpmLockV9), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := npm.Generate(dir)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if doc.SpdxDocument.Name != "acme-app" || len(doc.Packages) != 4 {
+		t.Errorf("document %q with %d packages", doc.SpdxDocument.Name, len(doc.Packages))
+	}
+	root := doc.GetPackageByID(doc.SpdxDocument.RootElement[0].SpdxID)
+	if root.GetPURL() != "pkg:npm/acme-app@1.2.0" {
+		t.Errorf("root purl = %q", root.GetPURL())
+	}
+	assertDependsOn(t, doc, root, "@acme/ui", "react")
+
+	if _, err := npm.Generate(t.TempDir()); err == nil {
+		t.Error("Generate succeeded without a lockfile")
+	}
+}
+
+// invalidFlowCollections are YAML flow collections closed with the wrong
+// bracket.
+var invalidFlowCollections = []string{
+	"a: [}",
+	"a: [1, }",
+	"a: {b: [x}]}",
+	"a: {b: ]}",
+}
+
+func TestParsePnpmLock_InvalidFlowCollections(t *testing.T) {
+	for _, data := range invalidFlowCollections {
+		if _, err := npm.ParsePnpmLock([]byte(data)); err == nil {
+			t.Errorf("ParsePnpmLock(%q) succeeded", data)
+		}
+		berry := "__metadata:\n  version: 6\n\"lodash@npm:^4.17.0\":\n  version: 4.17.21\n  " + data + "\n"
+		if _, err := npm.ParseYarnLock([]byte(berry)); err == nil {
+			t.Errorf("ParseYarnLock of %q succeeded", data)
+		}
+	}
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "pnpm-lock.yaml"), []byte(invalidFlowCollections[0]), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := npm.Generate(dir); err == nil {
+		t.Error("Generate succeeded for an invalid pnpm-lock.yaml")
+	}
+}
+
+func TestParseYarnLock_EmptyDescriptors(t *testing.T) {
+	for _, data := range []string{
+		"\"\":\n  version \"1\"\n",
+		"lodash@^4.17.0, :\n  version \"4.17.21\"\n",
+		"__metadata:\n  version: 6\n\"\":\n  version: 1\n",
+		"__metadata:\n  version: 6\n\"lodash@npm:^4.17.0, \":\n  version: 4.17.21\n",
+	} {
+		if _, err := npm.ParseYarnLock([]byte(data)); err == nil {
+			t.Errorf("ParseYarnLock(%q) succeeded", data)
+		}
+	}
+}
+
+// FuzzParseYarnLock checks that the Yarn lockfile parsers return for any
+// input, without panicking.
+func FuzzParseYarnLock(f *testing.F) {
+	f.Add([]byte(yarnClassicLock))
+	f.Add([]byte(yarnBerryLock))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = npm.ParseYarnLock(data)
+	})
+}
+
+// FuzzParsePnpmLock checks that the YAML parser behind the pnpm and Yarn 2+
+// lockfile parsers returns for any input, without panicking.
+func FuzzParsePnpmLock(f *testing.F) {
+	f.Add([]byte(pnpmLockV9))
+	f.Add([]byte(pnpmLockV5))
+	f.Add([]byte(yarnBerryLock))
+	for _, data := range invalidFlowCollections {
+		f.Add([]byte(data))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = npm.ParsePnpmLock(data)
+	})
+}
+
+func assertDependsOn(t *testing.T, doc *parse.Document, pkg *spdx.Package, names ...string) {
+	t.Helper()
+	var got []string
+	for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+		for _, to := range rel.To {
+			got = append(got, doc.GetPackageByID(to.SpdxID).Name)
+		}
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, names) {
+		t.Errorf("%s depends on %v, want %v", pkg.Name, got, names)
+	}
+}
@@ -0,0 +1,182 @@
+package npm
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// lockEntry is an entry of the packages of a package-lock.json file of
+// lockfileVersion 2 or 3, keyed by its install path.
+type lockEntry struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Resolved             string            `json:"resolved"`
+	Integrity            string            `json:"integrity"`
+	Link                 bool              `json:"link"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+}
+
+// legacyEntry is an entry of the dependency tree of a package-lock.json
+// file of lockfileVersion 1.
+type legacyEntry struct {
+	Version      string                  `json:"version"`
+	Resolved     string                  `json:"resolved"`
+	Integrity    string                  `json:"integrity"`
+	Requires     map[string]string       `json:"requires"`
+	Dependencies map[string]*legacyEntry `json:"dependencies"`
+}
+
+// ParsePackageLock parses a package-lock.json or npm-shrinkwrap.json file.
+// Dependencies are resolved as Node.js resolves them, from the nearest
+// node_modules directory up. Files of lockfileVersion 1 record no direct
+// dependencies of the project.
+func ParsePackageLock(data []byte) (*Lockfile, error) {
+	var lock struct {
+		Name         string                  `json:"name"`
+		Version      string                  `json:"version"`
+		Packages     map[string]*lockEntry   `json:"packages"`
+		Dependencies map[string]*legacyEntry `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("decoding package lock: %w", err)
+	}
+
+	entries := lock.Packages
+	legacy := entries == nil
+	if legacy {
+		entries = make(map[string]*lockEntry)
+		flattenLegacy(entries, "", lock.Dependencies)
+	}
+
+	l := &Lockfile{Name: lock.Name, Version: lock.Version}
+	set := packageSet{}
+	pkgs := make(map[string]*Package, len(entries))
+	for path, e := range entries {
+		if path == "" || e.Link {
+			continue
+		}
+		name := e.Name
+		if name == "" {
+			name = installedName(path)
+		}
+		pkg := set.get(name, e.Version)
+		pkg.Resolved = e.Resolved
+		pkg.Integrity = e.Integrity
+		pkgs[path] = pkg
+	}
+	// Links point to the install path of the package, e.g. of a workspace.
+	for path, e := range entries {
+		if e.Link {
+			if pkg, ok := pkgs[e.Resolved]; ok {
+				pkgs[path] = pkg
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(entries))
+	for path := range entries {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		e := entries[path]
+		if e.Link {
+			continue
+		}
+		var deps []*Package
+		for _, names := range []map[string]string{e.Dependencies, e.OptionalDependencies, e.PeerDependencies, rootDev(path, e)} {
+			for _, name := range sortedKeys(names) {
+				if dep, ok := resolve(pkgs, path, name); ok {
+					deps = append(deps, dep)
+				}
+			}
+		}
+		if path != "" {
+			for _, dep := range deps {
+				addDependency(pkgs[path], dep)
+			}
+			continue
+		}
+		if e.Name != "" && l.Name == "" {
+			l.Name, l.Version = e.Name, e.Version
+		}
+		if !legacy {
+			l.Dependencies = append([]*Package{}, deps...)
+		}
+	}
+	l.Packages = set.sorted()
+	return l, nil
+}
+
+// rootDev returns the development dependencies of the project entry, which
+// are installed; those of other packages are not.
+func rootDev(path string, e *lockEntry) map[string]string {
+	if path != "" {
+		return nil
+	}
+	return e.DevDependencies
+}
+
+// flattenLegacy adds the entries of a lockfileVersion 1 dependency tree
+// under their install paths.
+func flattenLegacy(entries map[string]*lockEntry, parent string, deps map[string]*legacyEntry) {
+	for name, dep := range deps {
+		path := "node_modules/" + name
+		if parent != "" {
+			path = parent + "/" + path
+		}
+		entries[path] = &lockEntry{
+			Version:      dep.Version,
+			Resolved:     dep.Resolved,
+			Integrity:    dep.Integrity,
+			Dependencies: dep.Requires,
+		}
+		flattenLegacy(entries, path, dep.Dependencies)
+	}
+}
+
+// installedName returns the package name of an install path such as
+// "node_modules/a/node_modules/@scope/b".
+func installedName(path string) string {
+	if i := strings.LastIndex(path, "node_modules/"); i >= 0 {
+		return path[i+len("node_modules/"):]
+	}
+	return path
+}
+
+// resolve finds the package a dependency of the package installed at path
+// resolves to, looking in the node_modules directories of path and its
+// ancestors.
+func resolve(pkgs map[string]*Package, path, name string) (*Package, bool) {
+	for {
+		key := "node_modules/" + name
+		if path != "" {
+			key = path + "/" + key
+		}
+		if pkg, ok := pkgs[key]; ok {
+			return pkg, true
+		}
+		if path == "" {
+			return nil, false
+		}
+		if i := strings.LastIndex(path, "/node_modules/"); i >= 0 {
+			path = path[:i]
+		} else {
+			path = ""
+		}
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,152 @@
+package npm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParsePnpmLock parses a pnpm-lock.yaml file of lockfileVersion 5, 6 or 9.
+// The direct dependencies of the project are those of its root importer,
+// development dependencies included. Packages installed with different
+// peer dependencies are one Package.
+func ParsePnpmLock(data []byte) (*Lockfile, error) {
+	doc, err := parseYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding pnpm lock: %w", err)
+	}
+	version, _ := doc["lockfileVersion"].(string)
+	if version == "" {
+		return nil, fmt.Errorf("pnpm lock has no lockfileVersion")
+	}
+	v5 := version[0] == '5'
+
+	set := packageSet{}
+	pkgs := make(map[string]*Package)
+	packages := mapping(doc["packages"])
+	for key, v := range packages {
+		name, ver := pnpmKey(key, v5)
+		if name == "" {
+			continue
+		}
+		pkg := set.get(name, ver)
+		entry := mapping(v)
+		resolution := mapping(entry["resolution"])
+		if integrity, ok := resolution["integrity"].(string); ok {
+			pkg.Integrity = integrity
+		}
+		if tarball, ok := resolution["tarball"].(string); ok {
+			pkg.Resolved = tarball
+		}
+		pkgs[key] = pkg
+	}
+	// Since lockfileVersion 9, the dependencies of the packages are in
+	// snapshots, by package key with peer dependencies.
+	snapshots := mapping(doc["snapshots"])
+	for key := range snapshots {
+		name, ver := pnpmKey(key, false)
+		if name != "" {
+			pkgs[key] = set.get(name, ver)
+		}
+	}
+
+	lookup := func(name, ref string) (*Package, bool) {
+		ref = strings.TrimPrefix(ref, "npm:")
+		var candidates []string
+		switch {
+		case strings.HasPrefix(ref, "link:") || strings.HasPrefix(ref, "file:"):
+			return nil, false
+		case strings.HasPrefix(ref, "/"):
+			candidates = []string{ref}
+		case v5:
+			candidates = []string{"/" + name + "/" + ref}
+		default:
+			// An aliased dependency refers to "real@version".
+			candidates = []string{name + "@" + ref, "/" + name + "@" + ref, ref, "/" + ref}
+		}
+		for _, key := range candidates {
+			if pkg, ok := pkgs[key]; ok {
+				return pkg, true
+			}
+		}
+		return nil, false
+	}
+
+	for _, entries := range []map[string]interface{}{packages, snapshots} {
+		for _, key := range sortedMapKeys(entries) {
+			pkg, ok := pkgs[key]
+			if !ok {
+				continue
+			}
+			entry := mapping(entries[key])
+			for _, field := range []string{"dependencies", "optionalDependencies"} {
+				deps := mapping(entry[field])
+				for _, name := range sortedMapKeys(deps) {
+					ref, _ := deps[name].(string)
+					if dep, ok := lookup(name, ref); ok {
+						addDependency(pkg, dep)
+					}
+				}
+			}
+		}
+	}
+
+	// The root importer is "." in importers since lockfileVersion 6 and at
+	// the top level of lockfiles of a single project.
+	root := doc
+	if importers := mapping(doc["importers"]); importers != nil {
+		root = mapping(importers["."])
+	}
+	l := &Lockfile{Dependencies: []*Package{}}
+	for _, field := range []string{"dependencies", "devDependencies", "optionalDependencies"} {
+		deps := mapping(root[field])
+		for _, name := range sortedMapKeys(deps) {
+			ref, _ := deps[name].(string)
+			if spec := mapping(deps[name]); spec != nil {
+				ref, _ = spec["version"].(string)
+			}
+			if dep, ok := lookup(name, ref); ok {
+				l.Dependencies = append(l.Dependencies, dep)
+			}
+		}
+	}
+	l.Packages = set.sorted()
+	return l, nil
+}
+
+// pnpmKey returns the name and version of a package key such as
+// "/@scope/name@1.0.0(peer@2.0.0)", "name@1.0.0" or, in lockfileVersion
+// 5, "/name/1.0.0_peer@2.0.0".
+func pnpmKey(key string, v5 bool) (name, version string) {
+	key = strings.TrimPrefix(key, "/")
+	if v5 {
+		i := strings.LastIndex(key, "/")
+		if i <= 0 {
+			return "", ""
+		}
+		name, version = key[:i], key[i+1:]
+		version, _, _ = strings.Cut(version, "_")
+		return name, version
+	}
+	key, _, _ = strings.Cut(key, "(")
+	i := strings.LastIndex(key, "@")
+	if i <= 0 {
+		return "", ""
+	}
+	return key[:i], key[i+1:]
+}
+
+// mapping returns v if it is a YAML mapping, or nil.
+func mapping(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+func sortedMapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
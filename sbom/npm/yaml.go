@@ -0,0 +1,291 @@
+package npm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yamlLine is a line of a YAML document without its indentation and
+// comment.
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+// parseYAML parses the subset of YAML that pnpm and Yarn write lockfiles
+// in: block mappings and sequences, flow mappings and sequences, and plain
+// and quoted scalars, which are all decoded as strings. Mappings are
+// returned as map[string]interface{} and sequences as []interface{}.
+func parseYAML(data []byte) (map[string]interface{}, error) {
+	var lines []yamlLine
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(stripComment(line), " \t\r")
+		text := strings.TrimLeft(line, " ")
+		if text == "" || text == "---" {
+			continue
+		}
+		lines = append(lines, yamlLine{num: i + 1, indent: len(line) - len(text), text: text})
+	}
+	if len(lines) == 0 {
+		return map[string]interface{}{}, nil
+	}
+
+	p := &yamlParser{lines: lines}
+	v, err := p.block(lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", lines[p.pos].num)
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document is not a mapping")
+	}
+	return m, nil
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+// block parses the mapping or sequence starting at the current line, whose
+// entries have the given indentation.
+func (p *yamlParser) block(indent int) (interface{}, error) {
+	if t := p.lines[p.pos].text; t == "-" || strings.HasPrefix(t, "- ") {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) mapping(indent int) (map[string]interface{}, error) {
+	m := make(map[string]interface{})
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent {
+		line := p.lines[p.pos]
+		key, rest, err := splitKey(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.num, err)
+		}
+		p.pos++
+		if rest != "" {
+			if m[key], err = parseFlow(rest); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.num, err)
+			}
+			continue
+		}
+		if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+			if m[key], err = p.block(p.lines[p.pos].indent); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		m[key] = ""
+	}
+	return m, nil
+}
+
+func (p *yamlParser) sequence(indent int) ([]interface{}, error) {
+	var s []interface{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent {
+		line := p.lines[p.pos]
+		if line.text != "-" && !strings.HasPrefix(line.text, "- ") {
+			break
+		}
+		p.pos++
+		v, err := parseFlow(strings.TrimSpace(strings.TrimPrefix(line.text, "-")))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.num, err)
+		}
+		s = append(s, v)
+	}
+	return s, nil
+}
+
+// splitKey splits a mapping entry into its key and the text of its value.
+func splitKey(text string) (key, rest string, err error) {
+	if text[0] == '"' || text[0] == '\'' {
+		key, n, err := quoted(text)
+		if err != nil {
+			return "", "", err
+		}
+		rest = strings.TrimSpace(text[n:])
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", fmt.Errorf("missing ':' after key")
+		}
+		return key, strings.TrimSpace(rest[1:]), nil
+	}
+	if i := strings.Index(text, ": "); i >= 0 {
+		return text[:i], strings.TrimSpace(text[i+2:]), nil
+	}
+	if strings.HasSuffix(text, ":") {
+		return text[:len(text)-1], "", nil
+	}
+	return "", "", fmt.Errorf("expected a mapping entry")
+}
+
+// parseFlow parses a scalar or a flow collection.
+func parseFlow(text string) (interface{}, error) {
+	v, n, err := flowValue(text, false)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(text[n:]) != "" {
+		return nil, fmt.Errorf("unexpected %q", text[n:])
+	}
+	return v, nil
+}
+
+// flowValue parses the value at the start of text and returns it with the
+// number of bytes it takes. In a flow collection, plain scalars end at ','
+// and the closing bracket.
+func flowValue(text string, inFlow bool) (interface{}, int, error) {
+	i := len(text) - len(strings.TrimLeft(text, " "))
+	if i == len(text) {
+		return "", i, nil
+	}
+	switch text[i] {
+	case '"', '\'':
+		s, n, err := quoted(text[i:])
+		return s, i + n, err
+	case '{', '[':
+		return flowCollection(text, i)
+	}
+	end := len(text)
+	if inFlow {
+		if j := strings.IndexAny(text[i:], ",]}"); j >= 0 {
+			end = i + j
+		}
+	}
+	return strings.TrimSpace(text[i:end]), end, nil
+}
+
+// flowCollection parses the flow mapping or sequence starting at text[i].
+func flowCollection(text string, i int) (interface{}, int, error) {
+	isMap := text[i] == '{'
+	closing := byte(']')
+	if isMap {
+		closing = '}'
+	}
+	m := make(map[string]interface{})
+	var s []interface{}
+	i++
+	for {
+		i += len(text[i:]) - len(strings.TrimLeft(text[i:], " "))
+		if i >= len(text) {
+			return nil, 0, fmt.Errorf("unterminated flow collection")
+		}
+		if text[i] == closing {
+			i++
+			break
+		}
+
+		if isMap {
+			var k string
+			if text[i] == '"' || text[i] == '\'' {
+				key, n, err := quoted(text[i:])
+				if err != nil {
+					return nil, 0, err
+				}
+				k = key
+				i += n
+			} else {
+				// A plain key ends at a ':' followed by a space or the end of
+				// the entry, as in "{tarball: https://...}".
+				j := i
+				for j < len(text) && text[j] != ',' && text[j] != '}' &&
+					(text[j] != ':' || (j+1 < len(text) && !strings.ContainsRune(" ,}", rune(text[j+1])))) {
+					j++
+				}
+				k = strings.TrimSpace(text[i:j])
+				i = j
+			}
+			i += len(text[i:]) - len(strings.TrimLeft(text[i:], " "))
+			var v interface{} = ""
+			if i < len(text) && text[i] == ':' {
+				i++
+				value, n, err := flowValue(text[i:], true)
+				if err != nil {
+					return nil, 0, err
+				}
+				v = value
+				i += n
+			}
+			m[k] = v
+		} else {
+			v, n, err := flowValue(text[i:], true)
+			if err != nil {
+				return nil, 0, err
+			}
+			s = append(s, v)
+			i += n
+		}
+
+		// Each entry ends at a comma or the closing bracket; anything else,
+		// such as the bracket of another collection, is an error rather
+		// than an entry that takes no input.
+		i += len(text[i:]) - len(strings.TrimLeft(text[i:], " "))
+		switch {
+		case i >= len(text):
+			return nil, 0, fmt.Errorf("unterminated flow collection")
+		case text[i] == ',':
+			i++
+		case text[i] != closing:
+			return nil, 0, fmt.Errorf("unexpected %q in flow collection", text[i])
+		}
+	}
+	if isMap {
+		return m, i, nil
+	}
+	return s, i, nil
+}
+
+// quoted decodes the single- or double-quoted scalar at the start of text
+// and returns it with the number of bytes it takes.
+func quoted(text string) (string, int, error) {
+	q := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case q == '"' && text[i] == '\\':
+			i++
+		case text[i] == q && q == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++
+		case text[i] == q:
+			if q == '\'' {
+				return strings.ReplaceAll(text[1:i], "''", "'"), i + 1, nil
+			}
+			s, err := strconv.Unquote(text[:i+1])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid quoted scalar %s", text[:i+1])
+			}
+			return s, i + 1, nil
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted scalar")
+}
+
+// stripComment removes a comment from a line, unless the '#' is quoted or
+// part of a plain scalar.
+func stripComment(line string) string {
+	var q byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case q != 0:
+			if c == '\\' && q == '"' {
+				i++
+			} else if c == q {
+				q = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.ContainsRune(" :-[{,", rune(line[i-1])) {
+				q = c
+			}
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
@@ -0,0 +1,221 @@
+package npm
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yarnEntry is an entry of a yarn.lock file, for the descriptors such as
+// "lodash@^4.17.0" that resolve to it.
+type yarnEntry struct {
+	descriptors  []string
+	version      string
+	resolved     string
+	integrity    string
+	dependencies map[string]string
+	workspace    bool
+}
+
+// ParseYarnLock parses a yarn.lock file, written by Yarn 1 in its own format
+// or by later versions in YAML. Yarn 1 does not record the direct
+// dependencies of the project; later versions record them in the entry of
+// the root workspace.
+func ParseYarnLock(data []byte) (*Lockfile, error) {
+	var entries []*yarnEntry
+	var err error
+	if bytes.Contains(data, []byte("\n__metadata:")) || bytes.HasPrefix(data, []byte("__metadata:")) {
+		entries, err = parseBerryLock(data)
+	} else {
+		entries, err = parseClassicLock(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decoding yarn lock: %w", err)
+	}
+
+	set := packageSet{}
+	byDescriptor := make(map[string]*Package)
+	pkgs := make(map[*yarnEntry]*Package, len(entries))
+	for _, e := range entries {
+		if e.workspace || len(e.descriptors) == 0 {
+			continue
+		}
+		pkg := set.get(descriptorName(e.descriptors[0]), e.version)
+		pkg.Resolved = e.resolved
+		pkg.Integrity = e.integrity
+		pkgs[e] = pkg
+		for _, d := range e.descriptors {
+			byDescriptor[d] = pkg
+		}
+	}
+	lookup := func(name, rng string) (*Package, bool) {
+		if pkg, ok := byDescriptor[name+"@"+rng]; ok {
+			return pkg, true
+		}
+		pkg, ok := byDescriptor[name+"@npm:"+rng]
+		return pkg, ok
+	}
+
+	l := &Lockfile{}
+	for _, e := range entries {
+		var deps []*Package
+		for _, name := range sortedKeys(e.dependencies) {
+			if dep, ok := lookup(name, e.dependencies[name]); ok {
+				deps = append(deps, dep)
+			}
+		}
+		if e.workspace {
+			if strings.HasSuffix(e.descriptors[0], "@workspace:.") {
+				l.Name = descriptorName(e.descriptors[0])
+				l.Dependencies = append([]*Package{}, deps...)
+			}
+			continue
+		}
+		for _, dep := range deps {
+			addDependency(pkgs[e], dep)
+		}
+	}
+	l.Packages = set.sorted()
+	return l, nil
+}
+
+// descriptorName returns the package name of a descriptor such as
+// "@scope/name@^1.0.0".
+func descriptorName(descriptor string) string {
+	if descriptor == "" {
+		return ""
+	}
+	if i := strings.Index(descriptor[1:], "@"); i >= 0 {
+		return descriptor[:i+1]
+	}
+	return descriptor
+}
+
+// parseBerryLock parses a yarn.lock file written by Yarn 2 or later.
+func parseBerryLock(data []byte) ([]*yarnEntry, error) {
+	doc, err := parseYAML(data)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*yarnEntry
+	for _, key := range sortedMapKeys(doc) {
+		if key == "__metadata" {
+			continue
+		}
+		fields := mapping(doc[key])
+		e := &yarnEntry{dependencies: make(map[string]string)}
+		for _, d := range strings.Split(key, ",") {
+			d = strings.TrimSpace(d)
+			if d == "" {
+				return nil, fmt.Errorf("entry %q has an empty descriptor", key)
+			}
+			e.descriptors = append(e.descriptors, d)
+		}
+		e.version, _ = fields["version"].(string)
+		resolution, _ := fields["resolution"].(string)
+		e.workspace = strings.Contains(resolution, "@workspace:")
+		if e.workspace {
+			e.descriptors = []string{resolution}
+		}
+		for _, field := range []string{"dependencies", "optionalDependencies"} {
+			deps := mapping(fields[field])
+			for name, v := range deps {
+				e.dependencies[name], _ = v.(string)
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// parseClassicLock parses a yarn.lock file written by Yarn 1, such as
+//
+//	"lodash@^4.17.0", lodash@^4.17.21:
+//	  version "4.17.21"
+//	  resolved "https://registry.yarnpkg.com/lodash/-/lodash-4.17.21.tgz#679591c5"
+//	  integrity sha512-...
+//	  dependencies:
+//	    foo "^1.0.0"
+func parseClassicLock(data []byte) ([]*yarnEntry, error) {
+	var entries []*yarnEntry
+	var e *yarnEntry
+	var deps map[string]string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for num := 1; scanner.Scan(); num++ {
+		line := strings.TrimRight(scanner.Text(), " \r")
+		text := strings.TrimLeft(line, " ")
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		indent := len(line) - len(text)
+
+		switch {
+		case indent == 0:
+			if !strings.HasSuffix(text, ":") {
+				return nil, fmt.Errorf("line %d: expected an entry", num)
+			}
+			e = &yarnEntry{dependencies: make(map[string]string)}
+			for _, d := range strings.Split(strings.TrimSuffix(text, ":"), ",") {
+				d, err := unquoteYarn(strings.TrimSpace(d))
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", num, err)
+				}
+				if d == "" {
+					return nil, fmt.Errorf("line %d: empty descriptor", num)
+				}
+				e.descriptors = append(e.descriptors, d)
+			}
+			entries = append(entries, e)
+			deps = nil
+		case e == nil:
+			return nil, fmt.Errorf("line %d: field outside of an entry", num)
+		case strings.HasSuffix(text, ":") && !strings.Contains(text, " "):
+			deps = nil
+			if field := strings.TrimSuffix(text, ":"); field == "dependencies" || field == "optionalDependencies" {
+				deps = e.dependencies
+			}
+		default:
+			key, value, _ := strings.Cut(text, " ")
+			key, err := unquoteYarn(key)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", num, err)
+			}
+			value, err = unquoteYarn(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", num, err)
+			}
+			if indent > 2 {
+				if deps != nil {
+					deps[key] = value
+				}
+				continue
+			}
+			deps = nil
+			switch key {
+			case "version":
+				e.version = value
+			case "resolved":
+				e.resolved, _, _ = strings.Cut(value, "#")
+			case "integrity":
+				e.integrity = value
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func unquoteYarn(s string) (string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		return s, nil
+	}
+	u, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted string %s", s)
+	}
+	return u, nil
+}
@@ -0,0 +1,59 @@
+package python
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// pipfileEntry is a package of a Pipfile.lock file.
+type pipfileEntry struct {
+	Version string   `json:"version"` // e.g. "==2.31.0"
+	Hashes  []string `json:"hashes"`
+	File    string   `json:"file"`
+	Git     string   `json:"git"`
+	Ref     string   `json:"ref"`
+}
+
+// ParsePipfileLock parses a Pipfile.lock file. Its packages, development
+// packages included, are direct dependencies of the project: Pipfile.lock
+// files record no dependencies between packages.
+func ParsePipfileLock(data []byte) (*Lockfile, error) {
+	var lock struct {
+		Default map[string]pipfileEntry `json:"default"`
+		Develop map[string]pipfileEntry `json:"develop"`
+	}
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("decoding Pipfile lock: %w", err)
+	}
+
+	set := packageSet{}
+	l := &Lockfile{Dependencies: []*Package{}}
+	for _, entries := range []map[string]pipfileEntry{lock.Default, lock.Develop} {
+		names := make([]string, 0, len(entries))
+		for name := range entries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			e := entries[name]
+			version := strings.TrimPrefix(strings.TrimPrefix(e.Version, "==="), "==")
+			pkg := set.get(name, version)
+			for _, h := range e.Hashes {
+				addHash(pkg, h)
+			}
+			switch {
+			case e.Git != "" && e.Ref != "":
+				pkg.Location = "git+" + e.Git + "@" + e.Ref
+			case e.Git != "":
+				pkg.Location = "git+" + e.Git
+			case e.File != "":
+				pkg.Location = e.File
+			}
+			l.Dependencies = appendOnce(l.Dependencies, pkg)
+		}
+	}
+	l.Packages = set.sorted()
+	return l, nil
+}
@@ -0,0 +1,93 @@
+package python
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ParsePoetryLock parses a poetry.lock file. The hashes of the files of a
+// package are read from its files array or, in lockfiles written before
+// Poetry 1.2, from the [metadata.files] table. Poetry lockfiles do not
+// record the direct dependencies of the project, which are in its
+// pyproject.toml.
+func ParsePoetryLock(data []byte) (*Lockfile, error) {
+	doc, err := parseTOML(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding poetry lock: %w", err)
+	}
+	tables, _ := doc["package"].([]interface{})
+
+	set := packageSet{}
+	byName := make(map[string]*Package)
+	pkgs := make(map[*Package]map[string]interface{}, len(tables))
+	for _, t := range tables {
+		entry, _ := t.(map[string]interface{})
+		name, _ := entry["name"].(string)
+		version, _ := entry["version"].(string)
+		if name == "" {
+			continue
+		}
+		pkg := set.get(name, version)
+		addFileHashes(pkg, entry["files"])
+		if source, ok := entry["source"].(map[string]interface{}); ok {
+			pkg.Location = sourceLocation(source)
+		}
+		if _, ok := byName[normalize(name)]; !ok {
+			byName[normalize(name)] = pkg
+		}
+		pkgs[pkg], _ = entry["dependencies"].(map[string]interface{})
+	}
+
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	files, _ := metadata["files"].(map[string]interface{})
+	for name, f := range files {
+		if pkg, ok := byName[normalize(name)]; ok {
+			addFileHashes(pkg, f)
+		}
+	}
+
+	l := &Lockfile{Packages: set.sorted()}
+	for _, pkg := range l.Packages {
+		deps := pkgs[pkg]
+		names := make([]string, 0, len(deps))
+		for name := range deps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if dep, ok := byName[normalize(name)]; ok {
+				addDependency(pkg, dep)
+			}
+		}
+	}
+	return l, nil
+}
+
+// addFileHashes adds the hashes of an array of files such as
+// [{file = "requests-2.31.0.tar.gz", hash = "sha256:..."}] to pkg.
+func addFileHashes(pkg *Package, files interface{}) {
+	arr, _ := files.([]interface{})
+	for _, f := range arr {
+		file, _ := f.(map[string]interface{})
+		if hash, _ := file["hash"].(string); hash != "" {
+			addHash(pkg, hash)
+		}
+	}
+}
+
+// sourceLocation returns the location of a package installed from a git
+// repository or a URL, as recorded in its source table.
+func sourceLocation(source map[string]interface{}) string {
+	kind, _ := source["type"].(string)
+	u, _ := source["url"].(string)
+	switch kind {
+	case "git":
+		if ref, _ := source["resolved_reference"].(string); ref != "" {
+			return "git+" + u + "@" + ref
+		}
+		return "git+" + u
+	case "url":
+		return u
+	}
+	return ""
+}
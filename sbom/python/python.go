@@ -0,0 +1,307 @@
+// Package python generates SPDX 3.0 SBOMs of Python projects from their
+// poetry.lock, Pipfile.lock or requirements.txt files:
+//
+//	doc, err := python.Generate(".")
+//
+// or, for a file read by the caller:
+//
+//	lock, err := python.ParsePoetryLock(data)
+//	doc, err := lock.Document()
+//
+// Every package becomes an SPDX package with its PyPI package URL, version
+// and the hashes of its distribution files, with dependsOn relationships
+// following the dependencies recorded in the file.
+package python
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom"
+)
+
+// Lockfile is the dependency graph recorded in a lockfile or requirements
+// file.
+type Lockfile struct {
+	// Packages are the packages, sorted by name and version.
+	Packages []*Package
+
+	// Dependencies are the direct dependencies of the project, or nil if
+	// the file does not record them, as in poetry.lock files.
+	Dependencies []*Package
+}
+
+// Package is a package of a lockfile or requirements file.
+type Package struct {
+	Name    string
+	Version string // pinned version, or "" if the version is not pinned
+
+	// Location is the URL the package is installed from, for packages not
+	// installed from an index.
+	Location string
+
+	// Hashes are the hashes of the distribution files of the package as
+	// "<algorithm>:<hex digest>", e.g. "sha256:...".
+	Hashes []string
+
+	Dependencies []*Package
+}
+
+// PURL returns the PyPI package URL of the package, which has no version if
+// the version of the package is not pinned.
+func (p *Package) PURL() string {
+	name := strings.ReplaceAll(strings.ToLower(p.Name), "_", "-")
+	purl := "pkg:pypi/" + url.PathEscape(name)
+	if p.Version != "" {
+		purl += "@" + url.PathEscape(p.Version)
+	}
+	return purl
+}
+
+var separators = regexp.MustCompile(`[-_.]+`)
+
+// normalize returns the normalized form of a project name, under which
+// names differing in case and separators are the same project, as defined
+// by PEP 503.
+func normalize(name string) string {
+	return separators.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+// packageSet collects the packages of a file by name and version.
+type packageSet map[string]*Package
+
+func (s packageSet) get(name, version string) *Package {
+	key := normalize(name) + "@" + version
+	pkg, ok := s[key]
+	if !ok {
+		pkg = &Package{Name: name, Version: version}
+		s[key] = pkg
+	}
+	return pkg
+}
+
+// addHash records a hash of pkg, once.
+func addHash(pkg *Package, hash string) {
+	for _, h := range pkg.Hashes {
+		if h == hash {
+			return
+		}
+	}
+	pkg.Hashes = append(pkg.Hashes, hash)
+}
+
+// addDependency records a dependency of pkg, once.
+func addDependency(pkg, dep *Package) {
+	for _, d := range pkg.Dependencies {
+		if d == dep {
+			return
+		}
+	}
+	pkg.Dependencies = append(pkg.Dependencies, dep)
+}
+
+// sorted returns the packages sorted by name and version.
+func (s packageSet) sorted() []*Package {
+	pkgs := make([]*Package, 0, len(s))
+	for _, pkg := range s {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		if ni, nj := normalize(pkgs[i].Name), normalize(pkgs[j].Name); ni != nj {
+			return ni < nj
+		}
+		return pkgs[i].Version < pkgs[j].Version
+	})
+	return pkgs
+}
+
+// Option configures the document generated from a lockfile.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	name      string
+	version   string
+	namespace string
+	builder   []sbom.Option
+}
+
+// WithProject sets the name and version of the project.
+func WithProject(name, version string) Option {
+	return optionFunc(func(c *config) {
+		c.name = name
+		c.version = version
+	})
+}
+
+// WithNamespace sets the SPDX ID of the document, which is otherwise
+// derived from the project name.
+func WithNamespace(namespace string) Option {
+	return optionFunc(func(c *config) {
+		c.namespace = namespace
+	})
+}
+
+// WithBuilderOptions sets options of the sbom.Builder creating the
+// document, such as its creation time.
+func WithBuilderOptions(opts ...sbom.Option) Option {
+	return optionFunc(func(c *config) {
+		c.builder = append(c.builder, opts...)
+	})
+}
+
+// Generate returns an SBOM of the Python project in dir from its
+// poetry.lock, Pipfile.lock or requirements.txt, tried in that order. The
+// name and version of the project are read from its pyproject.toml, if
+// any; options override them.
+func Generate(dir string, opts ...Option) (*parse.Document, error) {
+	parsers := []struct {
+		file  string
+		parse func([]byte) (*Lockfile, error)
+	}{
+		{"poetry.lock", ParsePoetryLock},
+		{"Pipfile.lock", ParsePipfileLock},
+		{"requirements.txt", ParseRequirements},
+	}
+	for _, p := range parsers {
+		data, err := os.ReadFile(filepath.Join(dir, p.file))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", p.file, err)
+		}
+		lock, err := p.parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p.file, err)
+		}
+
+		data, err = os.ReadFile(filepath.Join(dir, "pyproject.toml"))
+		switch {
+		case err == nil:
+			name, version, err := projectMetadata(data)
+			if err != nil {
+				return nil, fmt.Errorf("pyproject.toml: %w", err)
+			}
+			if name != "" {
+				opts = append([]Option{WithProject(name, version)}, opts...)
+			}
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, fmt.Errorf("reading pyproject.toml: %w", err)
+		}
+		return lock.Document(opts...)
+	}
+	return nil, fmt.Errorf("no lockfile or requirements file in %s", dir)
+}
+
+// projectMetadata returns the name and version of a project from its
+// pyproject.toml, in its [project] table or, for Poetry projects, in its
+// [tool.poetry] table.
+func projectMetadata(data []byte) (name, version string, err error) {
+	doc, err := parseTOML(data)
+	if err != nil {
+		return "", "", err
+	}
+	tool, _ := doc["tool"].(map[string]interface{})
+	for _, t := range []interface{}{doc["project"], tool["poetry"]} {
+		t, _ := t.(map[string]interface{})
+		if name, _ = t["name"].(string); name != "" {
+			version, _ = t["version"].(string)
+			return name, version, nil
+		}
+	}
+	return "", "", nil
+}
+
+// Document returns an SBOM of the lockfile. The project is the root of the
+// document; it depends on its direct dependencies or, if the lockfile does
+// not record them, on the packages no other package depends on.
+func (l *Lockfile) Document(opts ...Option) (*parse.Document, error) {
+	c := &config{}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	if c.name == "" {
+		c.name = "project"
+	}
+	namespace := c.namespace
+	if namespace == "" {
+		namespace = "https://spdx.org/spdxdocs/pypi/" + url.PathEscape(c.name)
+	}
+
+	b := sbom.NewBuilder(namespace, c.name, c.builder...)
+	root := b.AddPackage(c.name, c.version, (&Package{Name: c.name, Version: c.version}).PURL())
+	root.PrimaryPurpose = spdx.SoftwarePurposeApplication
+	b.AddRoot(root)
+
+	elems := make(map[*Package]*spdx.Package, len(l.Packages))
+	for _, p := range l.Packages {
+		pkg := b.AddPackage(p.Name, p.Version, p.PURL())
+		pkg.DownloadLocation = p.Location
+		for _, h := range p.Hashes {
+			alg, digest, ok := strings.Cut(h, ":")
+			if algorithm, known := hashAlgorithms[alg]; ok && known {
+				pkg.AddHash(algorithm, strings.ToLower(digest))
+			}
+		}
+		elems[p] = pkg
+	}
+
+	required := make(map[*Package]bool)
+	for _, p := range l.Packages {
+		var to []spdx.AnyElement
+		for _, dep := range p.Dependencies {
+			if elem, ok := elems[dep]; ok && dep != p {
+				to = append(to, elem)
+				required[dep] = true
+			}
+		}
+		if len(to) > 0 {
+			b.Relate(elems[p], spdx.RelationshipTypeDependsOn, to...)
+		}
+	}
+
+	direct := l.Dependencies
+	if direct == nil {
+		for _, p := range l.Packages {
+			if !required[p] {
+				direct = append(direct, p)
+			}
+		}
+	}
+	var to []spdx.AnyElement
+	for _, dep := range direct {
+		if elem, ok := elems[dep]; ok {
+			to = append(to, elem)
+		}
+	}
+	if len(to) > 0 {
+		b.Relate(root, spdx.RelationshipTypeDependsOn, to...)
+	}
+	return b.Document()
+}
+
+// hashAlgorithms maps the algorithms of hashes in Python lockfiles, as
+// supported by pip, to SPDX hash algorithms.
+var hashAlgorithms = map[string]spdx.HashAlgorithm{
+	"md5":    spdx.HashAlgorithmMd5,
+	"sha1":   spdx.HashAlgorithmSha1,
+	"sha224": spdx.HashAlgorithmSha224,
+	"sha256": spdx.HashAlgorithmSha256,
+	"sha384": spdx.HashAlgorithmSha384,
+	"sha512": spdx.HashAlgorithmSha512,
+}
@@ -0,0 +1,289 @@
+package python_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sbom/python"
+)
+
+const requirements = `# pip-compile output
+--index-url https://pypi.org/simple
+-r base.txt
+
+certifi==2023.7.22 \
+    --hash=sha256:92d6037539857d8206b8f6ae472e8b77db8058fec5937a1ef3f54304089edbb9
+requests[security]==2.31.0 ; python_version >= "3.8"  # via app
+Django>=4.2,<5
+./vendor/local-pkg
+my_tool @ https://example.com/my_tool-1.0.tar.gz
+`
+
+const poetryLock = `# This file is automatically @generated by Poetry 1.8.2 and should not be changed by hand.
+
+[[package]]
+name = "certifi"
+version = "2023.7.22"
+description = "Python package for providing Mozilla's CA Bundle."
+optional = false
+python-versions = ">=3.6"
+files = [
+    {file = "certifi-2023.7.22-py3-none-any.whl", hash = "sha256:92D6037539857d8206b8f6ae472e8b77db8058fec5937a1ef3f54304089edbb9"},
+    {file = "certifi-2023.7.22.tar.gz", hash = "sha256:539cc1d13202e33ca466e88b2807e29f4c13049d6d87031a3c110744495cb082"},
+]
+
+[[package]]
+name = "requests"
+version = "2.31.0"
+description = """
+Python HTTP for Humans.\
+"""
+optional = false
+python-versions = ">=3.7"
+files = []
+
+[package.dependencies]
+certifi = ">=2017.4.17"
+urllib3 = {version = ">=1.21.1,<3", markers = "python_version >= \"3.7\""}
+
+[package.extras]
+socks = ["PySocks (>=1.5.6,!=1.5.7)"]
+
+[[package]]
+name = "urllib3"
+version = "2.0.7"
+description = 'HTTP library'
+optional = false
+python-versions = ">=3.7"
+files = []
+
+[package.source]
+type = "git"
+url = "https://github.com/urllib3/urllib3.git"
+reference = "main"
+resolved_reference = "0123abcd"
+
+[metadata]
+lock-version = "2.0"
+python-versions = "^3.8"
+content-hash = "abc"
+`
+
+const poetryLockV1 = `[[package]]
+name = "six"
+version = "1.16.0"
+category = "main"
+
+[metadata]
+lock-version = "1.1"
+
+[metadata.files]
+six = [
+    {file = "six-1.16.0.tar.gz", hash = "sha256:1e61c37477a1626458e36f7b1d82aa5c9b094fa4802892072e49de9c60c4c926"},
+]
+`
+
+const pipfileLock = `{
+	"_meta": {"hash": {"sha256": "abc"}, "pipfile-spec": 6},
+	"default": {
+		"requests": {
+			"hashes": ["sha256:58cd2187c01e70e6e26505bca751777aa9f2ee0b7f4300988b709f44e013003f"],
+			"index": "pypi",
+			"markers": "python_version >= '3.7'",
+			"version": "==2.31.0"
+		},
+		"tool": {"git": "https://github.com/acme/tool.git", "ref": "0123abcd"}
+	},
+	"develop": {
+		"pytest": {"hashes": [], "version": "==7.4.3"}
+	}
+}`
+
+// dependencies returns the name@version of the packages each package of
+// the lockfile depends on, with the project under "".
+func dependencies(l *python.Lockfile) map[string][]string {
+	deps := make(map[string][]string)
+	for _, dep := range l.Dependencies {
+		deps[""] = append(deps[""], dep.Name+"@"+dep.Version)
+	}
+	for _, pkg := range l.Packages {
+		for _, dep := range pkg.Dependencies {
+			key := pkg.Name + "@" + pkg.Version
+			deps[key] = append(deps[key], dep.Name+"@"+dep.Version)
+		}
+	}
+	for _, d := range deps {
+		slices.Sort(d)
+	}
+	return deps
+}
+
+func TestParsers(t *testing.T) {
+	tests := []struct {
+		name   string
+		parse  func([]byte) (*python.Lockfile, error)
+		data   string
+		purls  []string
+		hashes map[string]int
+		want   map[string][]string
+	}{
+		{
+			name:  "requirements.txt",
+			parse: python.ParseRequirements,
+			data:  requirements,
+			purls: []string{
+				"pkg:pypi/certifi@2023.7.22",
+				"pkg:pypi/django",
+				"pkg:pypi/my-tool",
+				"pkg:pypi/requests@2.31.0",
+			},
+			hashes: map[string]int{"certifi": 1},
+			want: map[string][]string{
+				"": {"Django@", "certifi@2023.7.22", "my_tool@", "requests@2.31.0"},
+			},
+		},
+		{
+			name:  "poetry.lock",
+			parse: python.ParsePoetryLock,
+			data:  poetryLock,
+			purls: []string{
+				"pkg:pypi/certifi@2023.7.22",
+				"pkg:pypi/requests@2.31.0",
+				"pkg:pypi/urllib3@2.0.7",
+			},
+			hashes: map[string]int{"certifi": 2},
+			want: map[string][]string{
+				"requests@2.31.0": {"certifi@2023.7.22", "urllib3@2.0.7"},
+			},
+		},
+		{
+			name:   "poetry.lock 1.1",
+			parse:  python.ParsePoetryLock,
+			data:   poetryLockV1,
+			purls:  []string{"pkg:pypi/six@1.16.0"},
+			hashes: map[string]int{"six": 1},
+			want:   map[string][]string{},
+		},
+		{
+			name:  "Pipfile.lock",
+			parse: python.ParsePipfileLock,
+			data:  pipfileLock,
+			purls: []string{
+				"pkg:pypi/pytest@7.4.3",
+				"pkg:pypi/requests@2.31.0",
+				"pkg:pypi/tool",
+			},
+			hashes: map[string]int{"requests": 1},
+			want: map[string][]string{
+				"": {"pytest@7.4.3", "requests@2.31.0", "tool@"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := tt.parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			var purls []string
+			for _, pkg := range l.Packages {
+				purls = append(purls, pkg.PURL())
+				if len(pkg.Hashes) != tt.hashes[pkg.Name] {
+					t.Errorf("%s hashes = %v, want %d", pkg.Name, pkg.Hashes, tt.hashes[pkg.Name])
+				}
+			}
+			if !slices.Equal(purls, tt.purls) {
+				t.Errorf("purls = %v, want %v", purls, tt.purls)
+			}
+			got := dependencies(l)
+			for key, want := range tt.want {
+				if !slices.Equal(got[key], want) {
+					t.Errorf("dependencies of %q = %v, want %v", key, got[key], want)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("dependencies = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLockfile_Document(t *testing.T) {
+	l, err := python.ParsePoetryLock([]byte(poetryLock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := l.Document(python.WithProject("acme", "1.0.0"))
+	if err != nil {
+		t.Fatalf("Document: %v", err)
+	}
+
+	byName := make(map[string]*spdx.Package)
+	for _, pkg := range doc.Packages {
+		byName[pkg.Name] = pkg
+	}
+	certifi := byName["certifi"]
+	if certifi == nil || certifi.GetPURL() != "pkg:pypi/certifi@2023.7.22" {
+		t.Fatalf("certifi package = %+v", certifi)
+	}
+	hashes := certifi.Hashes()
+	if len(hashes) != 2 || hashes[0].Algorithm != spdx.HashAlgorithmSha256 ||
+		hashes[0].HashValue != "92d6037539857d8206b8f6ae472e8b77db8058fec5937a1ef3f54304089edbb9" {
+		t.Errorf("hashes = %v", certifi.VerifiedUsing)
+	}
+	if loc := byName["urllib3"].DownloadLocation; loc != "git+https://github.com/urllib3/urllib3.git@0123abcd" {
+		t.Errorf("urllib3 download location = %q", loc)
+	}
+
+	// Poetry records no direct dependencies: the project depends on the
+	// packages nothing else depends on.
+	assertDependsOn(t, doc, byName["acme"], "requests")
+	assertDependsOn(t, doc, byName["requests"], "certifi", "urllib3")
+}
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	pyproject := "[project]\nname = \"acme\"\nversion = \"0.3.0\"\ndependencies = [\n  \"requests>=2\",\n]\n"
+	if err := os.WriteFile(filepath.Join(dir, "pyproject.toml"), []byte(pyproject), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte(requirements), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := python.Generate(dir)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if doc.SpdxDocument.Name != "acme" || len(doc.Packages) != 5 {
+		t.Errorf("document %q with %d packages", doc.SpdxDocument.Name, len(doc.Packages))
+	}
+	root := doc.GetPackageByID(doc.SpdxDocument.RootElement[0].SpdxID)
+	if root.GetPURL() != "pkg:pypi/acme@0.3.0" {
+		t.Errorf("root purl = %q", root.GetPURL())
+	}
+	assertDependsOn(t, doc, root, "Django", "certifi", "my_tool", "requests")
+
+	if _, err := python.Generate(t.TempDir()); err == nil {
+		t.Error("Generate succeeded without a lockfile")
+	}
+}
+
+func assertDependsOn(t *testing.T, doc *parse.Document, pkg *spdx.Package, names ...string) {
+	t.Helper()
+	var got []string
+	for _, rel := range doc.GetRelationshipsFrom(pkg.SpdxID) {
+		for _, to := range rel.To {
+			got = append(got, doc.GetPackageByID(to.SpdxID).Name)
+		}
+	}
+	slices.Sort(got)
+	if !slices.Equal(got, names) {
+		t.Errorf("%s depends on %v, want %v", pkg.Name, got, names)
+	}
+}
@@ -0,0 +1,147 @@
+package python
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// requirementName matches the project name at the start of a requirement.
+var requirementName = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?`)
+
+// ParseRequirements parses a pip requirements file, such as one written by
+// pip freeze or pip-compile. Requirements pinned with == or === have their
+// version, and their --hash options are their hashes. Options other than
+// --hash, references to other files, and requirements of unnamed paths or
+// URLs are ignored. Requirements files record no dependencies between
+// packages: every requirement is a direct dependency of the project.
+func ParseRequirements(data []byte) (*Lockfile, error) {
+	set := packageSet{}
+	l := &Lockfile{Dependencies: []*Package{}}
+	for _, line := range logicalLines(string(data)) {
+		text := strings.TrimSpace(stripRequirementComment(line.text))
+		if text == "" || strings.HasPrefix(text, "-") {
+			continue
+		}
+		spec, options := text, ""
+		if i := strings.Index(text, " -"); i >= 0 {
+			spec, options = text[:i], text[i:]
+		}
+		name, version, location, ok := parseRequirement(spec)
+		if !ok {
+			continue
+		}
+
+		pkg := set.get(name, version)
+		if location != "" {
+			pkg.Location = location
+		}
+		fields := strings.Fields(options)
+		for i := 0; i < len(fields); i++ {
+			var hash string
+			switch {
+			case strings.HasPrefix(fields[i], "--hash="):
+				hash = strings.TrimPrefix(fields[i], "--hash=")
+			case fields[i] == "--hash" && i+1 < len(fields):
+				i++
+				hash = fields[i]
+			default:
+				continue
+			}
+			if !strings.Contains(hash, ":") {
+				return nil, fmt.Errorf("line %d: invalid hash %q", line.num, hash)
+			}
+			addHash(pkg, hash)
+		}
+		l.Dependencies = appendOnce(l.Dependencies, pkg)
+	}
+	l.Packages = set.sorted()
+	return l, nil
+}
+
+// parseRequirement returns the name and pinned version of a requirement
+// such as "requests[security]==2.31.0; python_version >= '3.8'", or its
+// location for a direct reference such as "pkg @ https://...". It reports
+// false for requirements without a name.
+func parseRequirement(spec string) (name, version, location string, ok bool) {
+	name = requirementName.FindString(spec)
+	if name == "" {
+		return "", "", "", false
+	}
+	rest := strings.TrimSpace(spec[len(name):])
+	if strings.HasPrefix(rest, "[") {
+		i := strings.Index(rest, "]")
+		if i < 0 {
+			return "", "", "", false
+		}
+		rest = strings.TrimSpace(rest[i+1:])
+	}
+	if strings.HasPrefix(rest, "@") {
+		location, _, _ = strings.Cut(strings.TrimSpace(rest[1:]), " ")
+		return name, "", strings.TrimSuffix(location, ";"), true
+	}
+	rest, _, _ = strings.Cut(rest, ";")
+	rest = strings.TrimSpace(rest)
+	if rest != "" && !strings.ContainsAny(rest[:1], "<>=!~(") {
+		// A path or URL, such as "./pkg" or "https://...".
+		return "", "", "", false
+	}
+	rest = strings.Trim(rest, "() ")
+	if strings.Contains(rest, ",") || strings.Contains(rest, "*") {
+		return name, "", "", true
+	}
+	for _, op := range []string{"===", "=="} {
+		if v, found := strings.CutPrefix(rest, op); found {
+			return name, strings.TrimSpace(v), "", true
+		}
+	}
+	return name, "", "", true
+}
+
+// requirementLine is a line of a requirements file, with its continuations
+// joined.
+type requirementLine struct {
+	num  int
+	text string
+}
+
+// logicalLines splits a requirements file into lines, joining lines ending
+// with a backslash to the next.
+func logicalLines(data string) []requirementLine {
+	var lines []requirementLine
+	continued := false
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if !continued {
+			lines = append(lines, requirementLine{num: i + 1})
+		}
+		cur := &lines[len(lines)-1]
+		text, ok := strings.CutSuffix(line, `\`)
+		cur.text += text
+		if continued = ok; ok {
+			cur.text += " "
+		}
+	}
+	return lines
+}
+
+// stripRequirementComment removes a comment, which starts at a '#' at the
+// start of a line or after whitespace, from a line.
+func stripRequirementComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// appendOnce appends pkg to pkgs unless it is already there.
+func appendOnce(pkgs []*Package, pkg *Package) []*Package {
+	for _, p := range pkgs {
+		if p == pkg {
+			return pkgs
+		}
+	}
+	return append(pkgs, pkg)
+}
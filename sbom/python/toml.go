@@ -0,0 +1,363 @@
+package python
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseTOML parses the subset of TOML that Poetry writes lockfiles and
+// pyproject.toml files in: tables, arrays of tables, dotted keys, strings,
+// arrays and inline tables. Strings are decoded as strings, other scalars
+// as their literal text, tables as map[string]interface{} and arrays as
+// []interface{}.
+func parseTOML(data []byte) (map[string]interface{}, error) {
+	p := &tomlParser{s: string(data), line: 1}
+	root := make(map[string]interface{})
+	cur := root
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return root, nil
+		}
+
+		var err error
+		switch {
+		case strings.HasPrefix(p.s[p.i:], "[["):
+			p.i += 2
+			var path []string
+			if path, err = p.keys(); err == nil {
+				err = p.expect("]]")
+			}
+			if err == nil {
+				cur, err = appendTable(root, path)
+			}
+		case p.s[p.i] == '[':
+			p.i++
+			var path []string
+			if path, err = p.keys(); err == nil {
+				err = p.expect("]")
+			}
+			if err == nil {
+				cur, err = table(root, path)
+			}
+		default:
+			var path []string
+			var v interface{}
+			if path, err = p.keys(); err == nil {
+				err = p.expect("=")
+			}
+			if err == nil {
+				v, err = p.value()
+			}
+			if err == nil {
+				var t map[string]interface{}
+				if t, err = table(cur, path[:len(path)-1]); err == nil {
+					t[path[len(path)-1]] = v
+				}
+			}
+		}
+		if err == nil {
+			err = p.endOfLine()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", p.line, err)
+		}
+	}
+}
+
+// table returns the table at path under t, creating missing tables. A key
+// holding an array of tables refers to its last table.
+func table(t map[string]interface{}, path []string) (map[string]interface{}, error) {
+	for _, key := range path {
+		switch v := t[key].(type) {
+		case nil:
+			next := make(map[string]interface{})
+			t[key] = next
+			t = next
+		case map[string]interface{}:
+			t = v
+		case []interface{}:
+			var last map[string]interface{}
+			if len(v) > 0 {
+				last, _ = v[len(v)-1].(map[string]interface{})
+			}
+			if last == nil {
+				return nil, fmt.Errorf("key %s is not a table", key)
+			}
+			t = last
+		default:
+			return nil, fmt.Errorf("key %s is not a table", key)
+		}
+	}
+	return t, nil
+}
+
+// appendTable appends a new table to the array of tables at path.
+func appendTable(root map[string]interface{}, path []string) (map[string]interface{}, error) {
+	parent, err := table(root, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	key := path[len(path)-1]
+	arr, _ := parent[key].([]interface{})
+	if parent[key] != nil && arr == nil {
+		return nil, fmt.Errorf("key %s is not an array of tables", key)
+	}
+	t := make(map[string]interface{})
+	parent[key] = append(arr, t)
+	return t, nil
+}
+
+type tomlParser struct {
+	s    string
+	i    int
+	line int
+}
+
+func (p *tomlParser) eof() bool { return p.i >= len(p.s) }
+
+// skipSpace skips blanks and comments and, if newlines is set, line breaks.
+func (p *tomlParser) skipSpace(newlines bool) {
+	for !p.eof() {
+		switch c := p.s[p.i]; {
+		case c == ' ' || c == '\t' || c == '\r':
+			p.i++
+		case c == '\n' && newlines:
+			p.i++
+			p.line++
+		case c == '#':
+			for !p.eof() && p.s[p.i] != '\n' {
+				p.i++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (p *tomlParser) expect(tok string) error {
+	p.skipSpace(false)
+	if !strings.HasPrefix(p.s[p.i:], tok) {
+		return fmt.Errorf("expected %q", tok)
+	}
+	p.i += len(tok)
+	return nil
+}
+
+func (p *tomlParser) endOfLine() error {
+	p.skipSpace(false)
+	if p.eof() {
+		return nil
+	}
+	if p.s[p.i] != '\n' {
+		return fmt.Errorf("unexpected %q", p.s[p.i])
+	}
+	return nil
+}
+
+// keys parses a possibly dotted key.
+func (p *tomlParser) keys() ([]string, error) {
+	var path []string
+	for {
+		p.skipSpace(false)
+		if p.eof() {
+			return nil, fmt.Errorf("expected a key")
+		}
+		var key string
+		if c := p.s[p.i]; c == '"' || c == '\'' {
+			s, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			key = s
+		} else {
+			start := p.i
+			for !p.eof() && isBareKeyChar(p.s[p.i]) {
+				p.i++
+			}
+			if p.i == start {
+				return nil, fmt.Errorf("expected a key")
+			}
+			key = p.s[start:p.i]
+		}
+		path = append(path, key)
+		p.skipSpace(false)
+		if p.eof() || p.s[p.i] != '.' {
+			return path, nil
+		}
+		p.i++
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) value() (interface{}, error) {
+	p.skipSpace(false)
+	if p.eof() {
+		return nil, fmt.Errorf("expected a value")
+	}
+	switch p.s[p.i] {
+	case '"', '\'':
+		return p.str()
+	case '[':
+		p.i++
+		var arr []interface{}
+		for {
+			p.skipSpace(true)
+			if p.eof() {
+				return nil, fmt.Errorf("unterminated array")
+			}
+			if p.s[p.i] == ']' {
+				p.i++
+				return arr, nil
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+			p.skipSpace(true)
+			if !p.eof() && p.s[p.i] == ',' {
+				p.i++
+			}
+		}
+	case '{':
+		p.i++
+		t := make(map[string]interface{})
+		for {
+			p.skipSpace(false)
+			if p.eof() {
+				return nil, fmt.Errorf("unterminated inline table")
+			}
+			if p.s[p.i] == '}' {
+				p.i++
+				return t, nil
+			}
+			path, err := p.keys()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			parent, err := table(t, path[:len(path)-1])
+			if err != nil {
+				return nil, err
+			}
+			parent[path[len(path)-1]] = v
+			p.skipSpace(false)
+			if !p.eof() && p.s[p.i] == ',' {
+				p.i++
+			}
+		}
+	}
+	start := p.i
+	for !p.eof() && !strings.ContainsRune(" \t\r\n,]}#", rune(p.s[p.i])) {
+		p.i++
+	}
+	if p.i == start {
+		return nil, fmt.Errorf("expected a value")
+	}
+	return p.s[start:p.i], nil
+}
+
+// str parses a basic or literal string, either of which may be multi-line.
+func (p *tomlParser) str() (string, error) {
+	q := p.s[p.i]
+	delim := string(q)
+	if strings.HasPrefix(p.s[p.i:], strings.Repeat(delim, 3)) {
+		delim = strings.Repeat(delim, 3)
+	}
+	p.i += len(delim)
+	multiline := len(delim) == 3
+	if multiline && strings.HasPrefix(p.s[p.i:], "\n") {
+		p.i++
+		p.line++
+	}
+
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", fmt.Errorf("unterminated string")
+		}
+		if strings.HasPrefix(p.s[p.i:], delim) {
+			p.i += len(delim)
+			return b.String(), nil
+		}
+		c := p.s[p.i]
+		switch {
+		case c == '\n' && !multiline:
+			return "", fmt.Errorf("unterminated string")
+		case c == '\\' && q == '"':
+			if err := p.escape(&b, multiline); err != nil {
+				return "", err
+			}
+			continue
+		case c == '\n':
+			p.line++
+		}
+		b.WriteByte(c)
+		p.i++
+	}
+}
+
+// escape decodes the escape sequence at the current position of a basic
+// string.
+func (p *tomlParser) escape(b *strings.Builder, multiline bool) error {
+	p.i++
+	if p.eof() {
+		return fmt.Errorf("unterminated string")
+	}
+	c := p.s[p.i]
+	p.i++
+	switch c {
+	case 'b':
+		b.WriteByte('\b')
+	case 't':
+		b.WriteByte('\t')
+	case 'n':
+		b.WriteByte('\n')
+	case 'f':
+		b.WriteByte('\f')
+	case 'r':
+		b.WriteByte('\r')
+	case '"', '\\':
+		b.WriteByte(c)
+	case 'u', 'U':
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		if p.i+n > len(p.s) {
+			return fmt.Errorf("invalid escape sequence")
+		}
+		r, err := strconv.ParseUint(p.s[p.i:p.i+n], 16, 32)
+		if err != nil {
+			return fmt.Errorf("invalid escape sequence")
+		}
+		b.WriteRune(rune(r))
+		p.i += n
+	case ' ', '\t', '\r', '\n':
+		// A line-ending backslash trims the following whitespace.
+		if !multiline {
+			return fmt.Errorf("invalid escape sequence")
+		}
+		p.i--
+		for !p.eof() && strings.ContainsRune(" \t\r\n", rune(p.s[p.i])) {
+			if p.s[p.i] == '\n' {
+				p.line++
+			}
+			p.i++
+		}
+	default:
+		return fmt.Errorf("invalid escape sequence \\%c", c)
+	}
+	return nil
+}
@@ -0,0 +1,340 @@
+// Package scan finds the known vulnerabilities of the packages of an SBOM
+// and records them in it as SPDX security elements.
+//
+//	doc, err := parse.NewReader().ReadFile("sbom.spdx.json")
+//	...
+//	result, err := scan.NewScanner().Scan(ctx, doc)
+//
+// The package URLs of the packages are queried in batches against one or
+// more advisory sources, OSV.dev by default.
+package scan
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultBatchSize is the number of package URLs sent to a source at once.
+const DefaultBatchSize = 100
+
+// Option configures a Scanner.
+type Option interface {
+	apply(*Scanner)
+}
+
+type optionFunc func(*Scanner)
+
+func (f optionFunc) apply(s *Scanner) { f(s) }
+
+// WithSources sets the advisory sources queried, in order. The default is
+// NewOSVSource(enrich.NewOSVClient()).
+func WithSources(sources ...Source) Option {
+	return optionFunc(func(s *Scanner) {
+		s.sources = sources
+	})
+}
+
+// WithBatchSize sets the number of package URLs sent to a source at once.
+func WithBatchSize(n int) Option {
+	return optionFunc(func(s *Scanner) {
+		if n > 0 {
+			s.batchSize = n
+		}
+	})
+}
+
+// WithIDPrefix sets the prefix of the SPDX IDs of added elements. By
+// default they are fragments of the SPDX document ID, as with
+// enrich.WithIDPrefix.
+func WithIDPrefix(prefix string) Option {
+	return optionFunc(func(s *Scanner) {
+		s.idPrefix = prefix
+	})
+}
+
+// Scanner scans the packages of documents against advisory sources.
+type Scanner struct {
+	sources   []Source
+	batchSize int
+	idPrefix  string
+	now       func() time.Time
+}
+
+// NewScanner creates a scanner with the given options.
+func NewScanner(opts ...Option) *Scanner {
+	s := &Scanner{batchSize: DefaultBatchSize, now: time.Now}
+	for _, opt := range opts {
+		opt.apply(s)
+	}
+	if s.sources == nil {
+		s.sources = []Source{NewOSVSource(enrich.NewOSVClient())}
+	}
+	return s
+}
+
+// Result describes what a scan found and added to a document.
+type Result struct {
+	// Packages is the number of packages scanned: those with a versioned
+	// package URL.
+	Packages int
+
+	// Vulnerabilities is the number of Vulnerability elements added, and
+	// Assessments the number of VEX affected assessments added.
+	Vulnerabilities int
+	Assessments     int
+
+	// Findings are the advisories found, one per package and issue.
+	Findings []Finding
+}
+
+// Finding is an advisory affecting a package of the document.
+type Finding struct {
+	Package       *spdx.Package
+	PURL          string
+	Advisory      Advisory
+	Vulnerability *spdx.Vulnerability
+}
+
+// Scan queries the sources for every package of the document that has a
+// versioned package URL and records the advisories found: a Vulnerability
+// per issue, unless the document already has one known by an ID or alias
+// of the advisory, and for each affected package a
+// hasAssociatedVulnerability relationship and a VEX affected assessment
+// naming the fixed versions. Advisories of several sources sharing an ID
+// or alias are merged. Packages already assessed for a vulnerability are
+// not assessed again.
+//
+// The added elements take the creation info of the document. Nothing is
+// added unless all sources were queried successfully.
+func (s *Scanner) Scan(ctx context.Context, doc *parse.Document) (*Result, error) {
+	ci, err := s.creationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	var purls []string
+	for _, pkg := range doc.Packages {
+		if purl := pkg.PURL(); purl != "" && strings.Contains(purl, "@") {
+			result.Packages++
+			if !slices.Contains(purls, purl) {
+				purls = append(purls, purl)
+			}
+		}
+	}
+
+	found := make(map[string][]Advisory)
+	for _, src := range s.sources {
+		for start := 0; start < len(purls); start += s.batchSize {
+			batch := purls[start:min(start+s.batchSize, len(purls))]
+			advisories, err := src.Query(ctx, batch)
+			if err != nil {
+				return nil, fmt.Errorf("scanning with %s: %w", src.Name(), err)
+			}
+			for purl, as := range advisories {
+				for _, a := range as {
+					a.Sources = []string{src.Name()}
+					found[purl] = mergeAdvisory(found[purl], a)
+				}
+			}
+		}
+	}
+
+	for _, pkg := range doc.Packages {
+		purl := pkg.PURL()
+		for _, a := range found[purl] {
+			vuln, added, err := s.vulnerability(doc, &a, ci)
+			if err != nil {
+				return result, err
+			}
+			if added {
+				result.Vulnerabilities++
+			}
+			assessed, err := s.assess(doc, &a, vuln, pkg, purl, ci)
+			if err != nil {
+				return result, err
+			}
+			if assessed {
+				result.Assessments++
+			}
+			result.Findings = append(result.Findings, Finding{Package: pkg, PURL: purl, Advisory: a, Vulnerability: vuln})
+		}
+	}
+	return result, nil
+}
+
+// mergeAdvisory adds a to advisories, merging it into an advisory sharing
+// an ID or alias.
+func mergeAdvisory(advisories []Advisory, a Advisory) []Advisory {
+	ids := a.ids()
+	for i := range advisories {
+		m := &advisories[i]
+		if !slices.ContainsFunc(m.ids(), func(id string) bool {
+			return slices.ContainsFunc(ids, func(other string) bool { return strings.EqualFold(id, other) })
+		}) {
+			continue
+		}
+		for _, id := range ids {
+			if !slices.ContainsFunc(m.ids(), func(known string) bool { return strings.EqualFold(known, id) }) {
+				m.Aliases = append(m.Aliases, id)
+			}
+		}
+		m.References = appendNew(m.References, a.References...)
+		m.FixedVersions = appendNew(m.FixedVersions, a.FixedVersions...)
+		m.Sources = appendNew(m.Sources, a.Sources...)
+		if m.Summary == "" {
+			m.Summary = a.Summary
+		}
+		if m.Details == "" {
+			m.Details = a.Details
+		}
+		return advisories
+	}
+	return append(advisories, a)
+}
+
+func (a *Advisory) ids() []string {
+	return append([]string{a.ID}, a.Aliases...)
+}
+
+func appendNew(list []string, items ...string) []string {
+	for _, item := range items {
+		if !slices.Contains(list, item) {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// vulnerability returns the vulnerability of the document known by an ID
+// of the advisory, adding one if there is none.
+func (s *Scanner) vulnerability(doc *parse.Document, a *Advisory, ci spdx.CreationInfo) (*spdx.Vulnerability, bool, error) {
+	for _, id := range a.ids() {
+		if vulns := doc.GetVulnerabilitiesByIdentifier(id); len(vulns) > 0 {
+			return vulns[0], false, nil
+		}
+	}
+
+	v := &spdx.Vulnerability{
+		PublishedTime: a.Published,
+		ModifiedTime:  a.Modified,
+	}
+	v.SpdxID = s.newID(doc, "vuln", a.ID)
+	v.Name = a.ID
+	v.Summary = a.Summary
+	v.Description = a.Details
+	v.CreationInfo = ci
+	for _, id := range a.ids() {
+		typ := spdx.ExternalIdentifierTypeSecurityOther
+		if strings.HasPrefix(strings.ToUpper(id), "CVE-") {
+			typ = spdx.ExternalIdentifierTypeCve
+		}
+		v.ExternalIdentifier = append(v.ExternalIdentifier, spdx.ExternalIdentifier{ExternalIdentifierType: typ, Identifier: id})
+	}
+	for _, ref := range a.References {
+		v.ExternalRef = append(v.ExternalRef, spdx.ExternalRef{ExternalRefType: spdx.ExternalRefTypeSecurityAdvisory, Locator: []string{ref}})
+	}
+	if err := doc.AddElements(v); err != nil {
+		return nil, false, err
+	}
+	return v, true, nil
+}
+
+// assess adds the relationship and VEX assessment between a vulnerability
+// and a package it affects, unless the package is already assessed for it.
+func (s *Scanner) assess(doc *parse.Document, a *Advisory, vuln *spdx.Vulnerability, pkg *spdx.Package, purl string, ci spdx.CreationInfo) (bool, error) {
+	if hasVEXAssessment(doc, vuln.SpdxID, pkg.SpdxID) {
+		return false, nil
+	}
+	var elems []spdx.AnyElement
+
+	if !slices.ContainsFunc(doc.GetRelationshipsFrom(pkg.SpdxID), func(rel *spdx.Relationship) bool {
+		return rel.RelationshipType == spdx.RelationshipTypeHasAssociatedVulnerability &&
+			slices.ContainsFunc(rel.To, func(to spdx.Element) bool { return to.SpdxID == vuln.SpdxID })
+	}) {
+		rel := &spdx.Relationship{
+			From:             spdx.Element{SpdxID: pkg.SpdxID},
+			To:               []spdx.Element{{SpdxID: vuln.SpdxID}},
+			RelationshipType: spdx.RelationshipTypeHasAssociatedVulnerability,
+		}
+		rel.SpdxID = s.newID(doc, "rel", a.ID, shortHash(pkg.SpdxID))
+		rel.CreationInfo = ci
+		elems = append(elems, rel)
+	}
+
+	action := "No fixed version is known; see the advisory for mitigations."
+	if len(a.FixedVersions) > 0 {
+		action = "Upgrade to version " + strings.Join(a.FixedVersions, " or ") + " or later."
+	}
+	vex := &spdx.VexAffectedVulnAssessmentRelationship{ActionStatement: action}
+	vex.SpdxID = s.newID(doc, "vex", a.ID, shortHash(pkg.SpdxID))
+	vex.CreationInfo = ci
+	vex.From = spdx.Element{SpdxID: vuln.SpdxID}
+	vex.To = []spdx.Element{{SpdxID: pkg.SpdxID}}
+	vex.RelationshipType = spdx.RelationshipTypeAffects
+	vex.StatusNotes = "Reported by " + strings.Join(a.Sources, " and ") + " for " + purl
+	elems = append(elems, vex)
+
+	return true, doc.AddElements(elems...)
+}
+
+// hasVEXAssessment reports whether the document has a VEX assessment of the
+// vulnerability for the element.
+func hasVEXAssessment(doc *parse.Document, vulnID, elemID string) bool {
+	covers := func(rel *spdx.VulnAssessmentRelationship) bool {
+		return rel.From.SpdxID == vulnID && slices.ContainsFunc(rel.To, func(to spdx.Element) bool { return to.SpdxID == elemID })
+	}
+	return slices.ContainsFunc(doc.VexAffectedVulnAssessments, func(a *spdx.VexAffectedVulnAssessmentRelationship) bool {
+		return covers(&a.VulnAssessmentRelationship)
+	}) || slices.ContainsFunc(doc.VexFixedVulnAssessments, func(a *spdx.VexFixedVulnAssessmentRelationship) bool {
+		return covers(&a.VulnAssessmentRelationship)
+	}) || slices.ContainsFunc(doc.VexNotAffectedVulnAssessments, func(a *spdx.VexNotAffectedVulnAssessmentRelationship) bool {
+		return covers(&a.VulnAssessmentRelationship)
+	}) || slices.ContainsFunc(doc.VexUnderInvestigationVulnAssessments, func(a *spdx.VexUnderInvestigationVulnAssessmentRelationship) bool {
+		return covers(&a.VulnAssessmentRelationship)
+	})
+}
+
+// newID returns the SPDX ID of an added element, e.g. "vuln" and
+// "CVE-2024-0001" give "<prefix>vuln-CVE-2024-0001".
+func (s *Scanner) newID(doc *parse.Document, kind string, parts ...string) string {
+	prefix := s.idPrefix
+	if prefix == "" {
+		prefix = doc.GetSpdxID() + "#"
+		if prefix == "#" {
+			prefix = "urn:spdx-zen:scan:"
+		}
+	}
+	return prefix + kind + "-" + strings.Join(parts, "-")
+}
+
+// shortHash returns a short digest of an SPDX ID, for IDs derived from it.
+func shortHash(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// creationInfo returns the creation info of added elements: that of the
+// document, created now.
+func (s *Scanner) creationInfo(doc *parse.Document) (spdx.CreationInfo, error) {
+	var ci *spdx.CreationInfo
+	switch {
+	case doc.SpdxDocument != nil && !doc.SpdxDocument.CreationInfo.Created.IsZero():
+		ci = doc.SpdxDocument.CreationInfo.Copy()
+	case doc.CreationInfo != nil:
+		ci = doc.CreationInfo.Copy()
+	default:
+		return spdx.CreationInfo{}, fmt.Errorf("document has no creation info")
+	}
+	ci.Created = s.now().UTC().Truncate(time.Second)
+	return *ci, nil
+}
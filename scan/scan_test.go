@@ -0,0 +1,178 @@
+package scan_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/scan"
+)
+
+const sbom = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{
+			"type": "CreationInfo",
+			"@id": "_:creationinfo",
+			"specVersion": "3.0.1",
+			"created": "2024-01-01T00:00:00Z",
+			"createdBy": ["urn:spdx:org-acme"]
+		},
+		{"type": "Organization", "spdxId": "urn:spdx:org-acme", "name": "Acme", "creationInfo": "_:creationinfo"},
+		{"type": "SpdxDocument", "spdxId": "https://acme.example/sbom", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-lodash", "name": "lodash", "creationInfo": "_:creationinfo", "software_packageUrl": "pkg:npm/lodash@4.17.20"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-lodash-old", "name": "lodash", "creationInfo": "_:creationinfo", "software_packageUrl": "pkg:npm/lodash@4.17.15"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-log4j", "name": "log4j-core", "creationInfo": "_:creationinfo", "software_packageUrl": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-unversioned", "name": "left-pad", "creationInfo": "_:creationinfo", "software_packageUrl": "pkg:npm/left-pad"}
+	]
+}`
+
+// advisoryServer serves a small OSV.dev and GitHub advisories API: OSV knows
+// the lodash advisory, GitHub knows it too and also the log4j one.
+func advisoryServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+	osvVulns := map[string]enrich.OSVVulnerability{
+		"GHSA-35jh-r3h4-6jhm": {
+			ID:      "GHSA-35jh-r3h4-6jhm",
+			Summary: "Command injection in lodash",
+			Aliases: []string{"CVE-2021-23337"},
+			Affected: []enrich.OSVAffected{{
+				Package: enrich.OSVPackage{Ecosystem: "npm", Name: "lodash", Purl: "pkg:npm/lodash"},
+				Ranges:  []enrich.OSVRange{{Type: "SEMVER", Events: []map[string]string{{"introduced": "0"}, {"fixed": "4.17.21"}}}},
+			}},
+		},
+	}
+	gitHub := []map[string]interface{}{
+		{
+			"ghsa_id":  "GHSA-35jh-r3h4-6jhm",
+			"cve_id":   "CVE-2021-23337",
+			"html_url": "https://github.com/advisories/GHSA-35jh-r3h4-6jhm",
+			"summary":  "Command injection in lodash",
+			"vulnerabilities": []interface{}{map[string]interface{}{
+				"package":               map[string]string{"ecosystem": "npm", "name": "lodash"},
+				"first_patched_version": "4.17.21",
+			}},
+		},
+		{
+			"ghsa_id": "GHSA-jfh8-c2jp-5v3q",
+			"cve_id":  "CVE-2021-44228",
+			"summary": "Remote code injection in Log4j",
+			"vulnerabilities": []interface{}{map[string]interface{}{
+				"package":               map[string]string{"ecosystem": "maven", "name": "org.apache.logging.log4j:log4j-core"},
+				"first_patched_version": "2.15.0",
+			}},
+		},
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/querybatch":
+			var req struct {
+				Queries []struct {
+					Package enrich.OSVPackage `json:"package"`
+				} `json:"queries"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			var results []interface{}
+			for _, q := range req.Queries {
+				*requests = append(*requests, "osv "+q.Package.Purl)
+				var vulns []interface{}
+				if strings.HasPrefix(q.Package.Purl, "pkg:npm/lodash@") {
+					vulns = append(vulns, map[string]string{"id": "GHSA-35jh-r3h4-6jhm"})
+				}
+				results = append(results, map[string]interface{}{"vulns": vulns})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"results": results})
+		case strings.HasPrefix(r.URL.Path, "/v1/vulns/"):
+			_ = json.NewEncoder(w).Encode(osvVulns[strings.TrimPrefix(r.URL.Path, "/v1/vulns/")])
+		case r.URL.Path == "/advisories":
+			eco, affects := r.URL.Query().Get("ecosystem"), r.URL.Query().Get("affects")
+			*requests = append(*requests, "github "+eco+" "+affects)
+			var page []interface{}
+			for _, a := range gitHub {
+				pkg := a["vulnerabilities"].([]interface{})[0].(map[string]interface{})["package"].(map[string]string)
+				if pkg["ecosystem"] == eco && strings.Contains(affects, pkg["name"]+"@") {
+					page = append(page, a)
+				}
+			}
+			_ = json.NewEncoder(w).Encode(page)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestScanner_Scan(t *testing.T) {
+	var requests []string
+	srv := advisoryServer(t, &requests)
+	defer srv.Close()
+
+	doc, err := parse.NewReader().Read([]byte(sbom))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	scanner := scan.NewScanner(scan.WithSources(
+		scan.NewOSVSource(enrich.NewOSVClient(enrich.WithBaseURL(srv.URL))),
+		scan.NewGitHubSource(enrich.NewGitHubClient(enrich.WithBaseURL(srv.URL), enrich.WithRateLimit(0, 0))),
+	))
+	result, err := scanner.Scan(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("Scan: %v", err)
+	}
+
+	// The two lodash versions go to GitHub in separate queries.
+	wantRequests := []string{
+		"osv pkg:npm/lodash@4.17.20",
+		"osv pkg:npm/lodash@4.17.15",
+		"osv pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1",
+		"github npm lodash@4.17.20",
+		"github npm lodash@4.17.15",
+		"github maven org.apache.logging.log4j:log4j-core@2.14.1",
+	}
+	if !slices.Equal(requests, wantRequests) {
+		t.Errorf("requests = %q, want %q", requests, wantRequests)
+	}
+
+	if result.Packages != 3 || result.Vulnerabilities != 2 || result.Assessments != 3 || len(result.Findings) != 3 {
+		t.Errorf("result = %d packages, %d vulnerabilities, %d assessments, %d findings; want 3, 2, 3, 3",
+			result.Packages, result.Vulnerabilities, result.Assessments, len(result.Findings))
+	}
+
+	// The lodash advisory of both sources is merged into one vulnerability.
+	lodash := doc.GetVulnerabilitiesByIdentifier("CVE-2021-23337")
+	if len(lodash) != 1 || lodash[0].Name != "GHSA-35jh-r3h4-6jhm" {
+		t.Fatalf("lodash vulnerabilities = %v", lodash)
+	}
+	affected := doc.GetAffectedPackagesByVulnID("CVE-2021-23337")
+	if len(affected) != 2 {
+		t.Fatalf("lodash affects %d packages, want 2", len(affected))
+	}
+	vex := doc.VexAffectedVulnAssessments[0]
+	if vex.ActionStatement != "Upgrade to version 4.17.21 or later." {
+		t.Errorf("action statement = %q", vex.ActionStatement)
+	}
+	if vex.StatusNotes != "Reported by OSV.dev and GitHub Advisories for pkg:npm/lodash@4.17.20" {
+		t.Errorf("status notes = %q", vex.StatusNotes)
+	}
+
+	if got := doc.GetAffectedPackagesByVulnID("CVE-2021-44228"); len(got) != 1 || got[0].Package.SpdxID != "urn:spdx:pkg-log4j" {
+		t.Errorf("log4j findings = %v", got)
+	}
+
+	// A second scan finds the same advisories but adds nothing.
+	requests = nil
+	result, err = scanner.Scan(context.Background(), doc)
+	if err != nil {
+		t.Fatalf("second Scan: %v", err)
+	}
+	if result.Vulnerabilities != 0 || result.Assessments != 0 || len(result.Findings) != 3 {
+		t.Errorf("second scan = %+v", result)
+	}
+}
@@ -0,0 +1,259 @@
+package scan
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/enrich"
+)
+
+// Source is an advisory database that a Scanner queries.
+type Source interface {
+	// Name names the source in the status notes of added assessments,
+	// e.g. "OSV.dev".
+	Name() string
+
+	// Query returns the advisories affecting each of the packages with the
+	// given versioned package URLs, keyed by package URL. Packages without
+	// advisories may be missing from the map.
+	Query(ctx context.Context, purls []string) (map[string][]Advisory, error)
+}
+
+// Advisory is a vulnerability affecting a package, as reported by a Source.
+type Advisory struct {
+	// ID is the ID of the advisory in its source, e.g. "GHSA-35jh-r3h4-6jhm",
+	// and Aliases other IDs of the same issue, e.g. its CVE ID.
+	ID      string
+	Aliases []string
+
+	Summary   string
+	Details   string
+	Published time.Time
+	Modified  time.Time
+
+	// References are URLs of more information.
+	References []string
+
+	// FixedVersions are the versions of the package fixing the issue.
+	FixedVersions []string
+
+	// Sources are the names of the sources reporting the advisory.
+	Sources []string
+}
+
+// osvSource queries OSV.dev.
+type osvSource struct {
+	client *enrich.OSVClient
+}
+
+// NewOSVSource returns a source querying OSV.dev with the given client, in
+// one batch query per call.
+func NewOSVSource(client *enrich.OSVClient) Source {
+	return osvSource{client: client}
+}
+
+func (osvSource) Name() string { return "OSV.dev" }
+
+func (s osvSource) Query(ctx context.Context, purls []string) (map[string][]Advisory, error) {
+	results, err := s.client.QueryBatch(ctx, purls)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string][]Advisory)
+	for i, vulns := range results {
+		purl := purls[i]
+		for j := range vulns {
+			v := &vulns[j]
+			if !v.Withdrawn.IsZero() {
+				continue
+			}
+			a := Advisory{
+				ID:            v.ID,
+				Aliases:       v.Aliases,
+				Summary:       v.Summary,
+				Details:       v.Details,
+				Published:     v.Published,
+				Modified:      v.Modified,
+				FixedVersions: v.FixedVersions(purl),
+			}
+			for _, ref := range v.References {
+				a.References = append(a.References, ref.URL)
+			}
+			out[purl] = append(out[purl], a)
+		}
+	}
+	return out, nil
+}
+
+// gitHubSource queries the GitHub Advisory Database.
+type gitHubSource struct {
+	client *enrich.GitHubClient
+}
+
+// NewGitHubSource returns a source querying the GitHub Advisory Database
+// with the given client. Package URLs are grouped into one query per
+// ecosystem; types that GitHub does not cover are skipped.
+func NewGitHubSource(client *enrich.GitHubClient) Source {
+	return gitHubSource{client: client}
+}
+
+func (gitHubSource) Name() string { return "GitHub Advisories" }
+
+// gitHubEcosystems maps package URL types to GitHub ecosystems.
+var gitHubEcosystems = map[string]string{
+	"cargo":    "rust",
+	"composer": "composer",
+	"gem":      "rubygems",
+	"github":   "actions",
+	"golang":   "go",
+	"hex":      "erlang",
+	"maven":    "maven",
+	"npm":      "npm",
+	"nuget":    "nuget",
+	"pub":      "pub",
+	"pypi":     "pip",
+	"swift":    "swift",
+}
+
+func (s gitHubSource) Query(ctx context.Context, purls []string) (map[string][]Advisory, error) {
+	// GitHub reports which package an advisory affects but not which
+	// version, so each query names a package at most once: the rounds of
+	// an ecosystem hold the first, second, ... version of each package.
+	type pkg struct {
+		purl, name, version string
+	}
+	rounds := make(map[string][][]pkg)
+	var ecosystems []string
+	for _, purl := range purls {
+		p, ok := parsePURL(purl)
+		eco := gitHubEcosystems[p.typ]
+		if !ok || eco == "" || p.version == "" {
+			continue
+		}
+		name := p.gitHubName()
+		if _, ok := rounds[eco]; !ok {
+			ecosystems = append(ecosystems, eco)
+		}
+		r := 0
+		for ; r < len(rounds[eco]); r++ {
+			taken := false
+			for _, q := range rounds[eco][r] {
+				taken = taken || strings.EqualFold(q.name, name)
+			}
+			if !taken {
+				break
+			}
+		}
+		if r == len(rounds[eco]) {
+			rounds[eco] = append(rounds[eco], nil)
+		}
+		rounds[eco][r] = append(rounds[eco][r], pkg{purl: purl, name: name, version: p.version})
+	}
+
+	out := make(map[string][]Advisory)
+	for _, eco := range ecosystems {
+		for _, round := range rounds[eco] {
+			affects := make([]string, len(round))
+			for i, p := range round {
+				affects[i] = p.name + "@" + p.version
+			}
+			advisories, err := s.client.Advisories(ctx, eco, affects)
+			if err != nil {
+				return nil, err
+			}
+			for _, ga := range advisories {
+				if ga.WithdrawnAt != nil {
+					continue
+				}
+				for _, p := range round {
+					if a, ok := gitHubAdvisory(&ga, eco, p.name); ok {
+						out[p.purl] = append(out[p.purl], a)
+					}
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// gitHubAdvisory converts a GitHub advisory for the named package, and
+// reports whether the advisory lists the package.
+func gitHubAdvisory(ga *enrich.GitHubAdvisory, ecosystem, name string) (Advisory, bool) {
+	a := Advisory{
+		ID:         ga.GHSAID,
+		Summary:    ga.Summary,
+		Details:    ga.Description,
+		Published:  ga.PublishedAt,
+		Modified:   ga.UpdatedAt,
+		References: ga.References,
+	}
+	if ga.HTMLURL != "" {
+		a.References = append([]string{ga.HTMLURL}, a.References...)
+	}
+	if ga.CVEID != "" {
+		a.Aliases = append(a.Aliases, ga.CVEID)
+	}
+	for _, id := range ga.Identifiers {
+		if !strings.EqualFold(id.Value, ga.GHSAID) && !strings.EqualFold(id.Value, ga.CVEID) {
+			a.Aliases = append(a.Aliases, id.Value)
+		}
+	}
+	var found bool
+	for _, v := range ga.Vulnerabilities {
+		if !strings.EqualFold(v.Package.Ecosystem, ecosystem) || !strings.EqualFold(v.Package.Name, name) {
+			continue
+		}
+		found = true
+		if v.FirstPatchedVersion != "" {
+			a.FixedVersions = append(a.FixedVersions, v.FirstPatchedVersion)
+		}
+	}
+	return a, found
+}
+
+// purl holds the components of a package URL used for queries.
+type purl struct {
+	typ, namespace, name, version string
+}
+
+// parsePURL splits a package URL of the form
+// pkg:type/namespace/name@version?qualifiers#subpath.
+func parsePURL(s string) (purl, bool) {
+	rest, ok := strings.CutPrefix(s, "pkg:")
+	if !ok {
+		return purl{}, false
+	}
+	if i := strings.IndexAny(rest, "?#"); i >= 0 {
+		rest = rest[:i]
+	}
+	var p purl
+	if i := strings.LastIndex(rest, "@"); i >= 0 {
+		p.version, _ = url.PathUnescape(rest[i+1:])
+		rest = rest[:i]
+	}
+	typ, path, ok := strings.Cut(strings.Trim(rest, "/"), "/")
+	if !ok || path == "" {
+		return purl{}, false
+	}
+	p.typ = strings.ToLower(typ)
+	if i := strings.LastIndex(path, "/"); i >= 0 {
+		p.namespace, _ = url.PathUnescape(path[:i])
+		path = path[i+1:]
+	}
+	p.name, _ = url.PathUnescape(path)
+	return p, true
+}
+
+// gitHubName returns the package name GitHub uses, e.g. "@babel/core" for
+// npm and "org.apache.logging.log4j:log4j-core" for Maven.
+func (p purl) gitHubName() string {
+	switch {
+	case p.namespace == "":
+		return p.name
+	case p.typ == "maven":
+		return p.namespace + ":" + p.name
+	}
+	return p.namespace + "/" + p.name
+}
@@ -0,0 +1,304 @@
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// VulnerabilityGroup is a set of Vulnerability elements of a document that
+// describe the same issue under different or repeated IDs, e.g. a CVE and
+// the GitHub advisory for it.
+type VulnerabilityGroup struct {
+	// Primary is the element kept when the group is merged: the first one
+	// with a CVE ID, or the first one of the group.
+	Primary *spdx.Vulnerability
+
+	// Vulnerabilities are all elements of the group in document order,
+	// including Primary.
+	Vulnerabilities []*spdx.Vulnerability
+
+	// Aliases are the advisory IDs of the group, e.g. "CVE-2021-44228" and
+	// "GHSA-jfh8-c2jp-5v3q", sorted and without duplicates.
+	Aliases []string
+}
+
+// advisoryID matches vulnerability names that are advisory IDs, such as
+// "CVE-2021-44228", "GHSA-jfh8-c2jp-5v3q" or "RHSA-2021:5132", and not
+// descriptive names such as "Log4Shell".
+var advisoryID = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*-[0-9A-Za-z]+([-:._][0-9A-Za-z]+)*$`)
+
+// vulnerabilityAliases returns the advisory IDs of a vulnerability: its cve
+// and securityOther external identifiers and its name, if it is an ID.
+func vulnerabilityAliases(v *spdx.Vulnerability) []string {
+	var ids []string
+	if advisoryID.MatchString(v.Name) {
+		ids = append(ids, v.Name)
+	}
+	for _, ei := range v.ExternalIdentifier {
+		switch ei.ExternalIdentifierType {
+		case spdx.ExternalIdentifierTypeCve, spdx.ExternalIdentifierTypeSecurityOther:
+			if ei.Identifier != "" {
+				ids = append(ids, ei.Identifier)
+			}
+		}
+	}
+	return ids
+}
+
+// CorrelateVulnerabilities groups the vulnerabilities of the document that
+// share an advisory ID, from their cve and securityOther external
+// identifiers or their names. IDs are compared case-insensitively, and
+// aliases are transitive: A and B sharing a CVE and B and C sharing a GHSA
+// ID put all three in one group. Only groups of two or more vulnerabilities
+// are returned, in the order of their first vulnerability.
+func CorrelateVulnerabilities(doc *parse.Document) []*VulnerabilityGroup {
+	vulns := doc.Vulnerabilities
+	parent := make([]int, len(vulns))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	owner := make(map[string]int)
+	for i, v := range vulns {
+		for _, id := range vulnerabilityAliases(v) {
+			key := strings.ToUpper(id)
+			if j, ok := owner[key]; ok {
+				// Keep the earliest vulnerability as the root.
+				a, b := find(i), find(j)
+				parent[max(a, b)] = min(a, b)
+				continue
+			}
+			owner[key] = i
+		}
+	}
+
+	byRoot := make(map[int]*VulnerabilityGroup)
+	var groups []*VulnerabilityGroup
+	for i, v := range vulns {
+		root := find(i)
+		g, ok := byRoot[root]
+		if !ok {
+			g = &VulnerabilityGroup{}
+			byRoot[root] = g
+			groups = append(groups, g)
+		}
+		g.Vulnerabilities = append(g.Vulnerabilities, v)
+	}
+
+	var dups []*VulnerabilityGroup
+	for _, g := range groups {
+		if len(g.Vulnerabilities) < 2 {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, v := range g.Vulnerabilities {
+			for _, id := range vulnerabilityAliases(v) {
+				if key := strings.ToUpper(id); !seen[key] {
+					seen[key] = true
+					g.Aliases = append(g.Aliases, id)
+				}
+				if g.Primary == nil && strings.HasPrefix(strings.ToUpper(id), "CVE-") {
+					g.Primary = v
+				}
+			}
+		}
+		if g.Primary == nil {
+			g.Primary = g.Vulnerabilities[0]
+		}
+		slices.Sort(g.Aliases)
+		dups = append(dups, g)
+	}
+	return dups
+}
+
+// LinkVulnerabilityAliases adds the aliases of each group found by
+// CorrelateVulnerabilities to the external identifiers of every
+// vulnerability of the group that lacks them, so that each entry lists all
+// IDs of the issue. The vulnerabilities are updated in place and the number
+// updated is returned.
+func LinkVulnerabilityAliases(doc *parse.Document) (int, error) {
+	var updated []spdx.AnyElement
+	for _, g := range CorrelateVulnerabilities(doc) {
+		for _, v := range g.Vulnerabilities {
+			if addAliases(v, g.Aliases) {
+				updated = append(updated, v)
+			}
+		}
+	}
+	if err := doc.UpdateElements(updated...); err != nil {
+		return 0, err
+	}
+	return len(updated), nil
+}
+
+// addAliases adds the aliases that are not yet external identifiers of v,
+// and reports whether it added any.
+func addAliases(v *spdx.Vulnerability, aliases []string) bool {
+	var changed bool
+	for _, id := range aliases {
+		if slices.ContainsFunc(v.ExternalIdentifier, func(ei spdx.ExternalIdentifier) bool {
+			return strings.EqualFold(ei.Identifier, id)
+		}) {
+			continue
+		}
+		typ := spdx.ExternalIdentifierTypeSecurityOther
+		if strings.HasPrefix(strings.ToUpper(id), "CVE-") {
+			typ = spdx.ExternalIdentifierTypeCve
+		}
+		v.ExternalIdentifier = append(v.ExternalIdentifier, spdx.ExternalIdentifier{
+			ExternalIdentifierType: typ,
+			Identifier:             id,
+		})
+		changed = true
+	}
+	return changed
+}
+
+// MergeVulnerabilities returns a copy of doc in which each group found by
+// CorrelateVulnerabilities is merged into its primary vulnerability. The
+// primary takes the aliases of the group as external identifiers, the
+// external references of the other entries and their summary or
+// description when it has none. References to the other entries, such as
+// the endpoints of assessments and relationships, are rewritten to the
+// primary, and the other entries are dropped. doc is not modified.
+func MergeVulnerabilities(doc *parse.Document) (*parse.Document, error) {
+	return MergeVulnerabilitiesWithProgress(doc, nil)
+}
+
+// MergeVulnerabilitiesWithProgress is MergeVulnerabilities reporting its
+// progress to progress, if not nil: through the elements of doc in the
+// parse.PhaseMerge phase, then through the phases of reading the merged
+// document.
+func MergeVulnerabilitiesWithProgress(doc *parse.Document, progress parse.ProgressFunc) (*parse.Document, error) {
+	groups := CorrelateVulnerabilities(doc)
+	replaced := make(map[string]string)
+	merged := make(map[string]*spdx.Vulnerability)
+	for _, g := range groups {
+		primary := g.Primary.Copy()
+		addAliases(primary, g.Aliases)
+		for _, v := range g.Vulnerabilities {
+			if v == g.Primary {
+				continue
+			}
+			replaced[v.SpdxID] = primary.SpdxID
+			for _, ref := range v.ExternalRef {
+				if !slices.ContainsFunc(primary.ExternalRef, func(r spdx.ExternalRef) bool {
+					return slices.Equal(r.Locator, ref.Locator)
+				}) {
+					primary.ExternalRef = append(primary.ExternalRef, ref)
+				}
+			}
+			if primary.Summary == "" {
+				primary.Summary = v.Summary
+			}
+			if primary.Description == "" {
+				primary.Description = v.Description
+			}
+		}
+		merged[primary.SpdxID] = primary
+	}
+
+	total := 0
+	if progress != nil {
+		for range doc.AllElements() {
+			total++
+		}
+		progress(parse.Progress{Phase: parse.PhaseMerge, Total: total})
+	}
+	var graph []interface{}
+	done := 0
+	for elem := range doc.AllElements() {
+		done++
+		if progress != nil && done%parse.ProgressStep == 0 {
+			progress(parse.Progress{Phase: parse.PhaseMerge, Done: done, Total: total})
+		}
+		id := elem.GetSpdxID()
+		if _, ok := replaced[id]; ok {
+			continue
+		}
+		if v, ok := merged[id]; ok {
+			graph = append(graph, v)
+			continue
+		}
+		graph = append(graph, elem)
+	}
+	if progress != nil {
+		progress(parse.Progress{Phase: parse.PhaseMerge, Done: done, Total: done})
+	}
+	if len(replaced) > 0 {
+		// The dropped entries are no longer defined, so RewriteIDs would not
+		// know them: replace the references in the encoded graph instead.
+		data, err := json.Marshal(graph)
+		if err != nil {
+			return nil, fmt.Errorf("encoding merged document: %w", err)
+		}
+		graph = nil
+		if err := json.Unmarshal(data, &graph); err != nil {
+			return nil, fmt.Errorf("decoding merged document: %w", err)
+		}
+		for i := range graph {
+			graph[i] = replaceRefs(graph[i], replaced)
+		}
+	}
+	var opts []parse.Option
+	if progress != nil {
+		opts = append(opts, parse.WithProgress(progress))
+	}
+	out, err := readGraph(doc, graph, "merged document", opts...)
+	if err != nil {
+		return nil, err
+	}
+	if sd := out.SpdxDocument; sd != nil {
+		sd.Elements = uniqueRefs(sd.Elements)
+		sd.RootElement = uniqueRefs(sd.RootElement)
+		if err := out.UpdateElements(sd); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// replaceRefs replaces the strings of a decoded JSON value that are keys of
+// ids with their values.
+func replaceRefs(v interface{}, ids map[string]string) interface{} {
+	switch v := v.(type) {
+	case string:
+		if newID, ok := ids[v]; ok {
+			return newID
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = replaceRefs(v[i], ids)
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = replaceRefs(v[k], ids)
+		}
+	}
+	return v
+}
+
+// uniqueRefs drops repeated element references, keeping the first.
+func uniqueRefs(refs []spdx.Element) []spdx.Element {
+	seen := make(map[string]bool, len(refs))
+	return slices.DeleteFunc(refs, func(ref spdx.Element) bool {
+		if seen[ref.SpdxID] {
+			return true
+		}
+		seen[ref.SpdxID] = true
+		return false
+	})
+}
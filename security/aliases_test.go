@@ -0,0 +1,185 @@
+package security_test
+
+import (
+	"slices"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+const sbomWithAliases = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{
+			"type": "CreationInfo",
+			"@id": "_:creationinfo",
+			"specVersion": "3.0.1",
+			"created": "2024-01-01T00:00:00Z",
+			"createdBy": ["urn:spdx:org-acme"]
+		},
+		{"type": "Organization", "spdxId": "urn:spdx:org-acme", "name": "Acme", "creationInfo": "_:creationinfo"},
+		{
+			"type": "SpdxDocument",
+			"spdxId": "urn:spdx:doc",
+			"creationInfo": "_:creationinfo",
+			"element": ["urn:spdx:pkg-log4j", "urn:spdx:vuln-ghsa", "urn:spdx:vuln-cve", "urn:spdx:vuln-log4shell", "urn:spdx:vuln-other"]
+		},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-log4j", "name": "log4j-core", "creationInfo": "_:creationinfo"},
+		{
+			"type": "security_Vulnerability",
+			"spdxId": "urn:spdx:vuln-ghsa",
+			"name": "GHSA-jfh8-c2jp-5v3q",
+			"creationInfo": "_:creationinfo",
+			"externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "cve", "identifier": "cve-2021-44228"}],
+			"externalRef": [{"type": "ExternalRef", "externalRefType": "securityAdvisory", "locator": ["https://github.com/advisories/GHSA-jfh8-c2jp-5v3q"]}]
+		},
+		{
+			"type": "security_Vulnerability",
+			"spdxId": "urn:spdx:vuln-cve",
+			"name": "CVE-2021-44228",
+			"creationInfo": "_:creationinfo",
+			"description": "Apache Log4j2 JNDI features do not protect against attacker controlled LDAP endpoints."
+		},
+		{
+			"type": "security_Vulnerability",
+			"spdxId": "urn:spdx:vuln-log4shell",
+			"name": "Log4Shell",
+			"creationInfo": "_:creationinfo",
+			"externalIdentifier": [{"type": "ExternalIdentifier", "externalIdentifierType": "securityOther", "identifier": "GHSA-JFH8-C2JP-5V3Q"}]
+		},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-other", "name": "CVE-2024-0001", "creationInfo": "_:creationinfo"},
+		{
+			"type": "Relationship",
+			"spdxId": "urn:spdx:rel-ghsa",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:pkg-log4j",
+			"to": ["urn:spdx:vuln-ghsa"],
+			"relationshipType": "hasAssociatedVulnerability"
+		},
+		{
+			"type": "security_VexAffectedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-log4shell",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-log4shell",
+			"to": ["urn:spdx:pkg-log4j"],
+			"relationshipType": "affects",
+			"security_actionStatement": "Upgrade to 2.17.1"
+		}
+	]
+}`
+
+func TestCorrelateVulnerabilities(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithAliases))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	groups := security.CorrelateVulnerabilities(doc)
+	if len(groups) != 1 {
+		t.Fatalf("groups = %d, want 1", len(groups))
+	}
+	g := groups[0]
+	if len(g.Vulnerabilities) != 3 {
+		t.Errorf("group has %d vulnerabilities, want 3", len(g.Vulnerabilities))
+	}
+	// The GHSA entry comes first and carries the CVE ID as an identifier.
+	if g.Primary.SpdxID != "urn:spdx:vuln-ghsa" {
+		t.Errorf("primary = %s, want urn:spdx:vuln-ghsa", g.Primary.SpdxID)
+	}
+	want := []string{"GHSA-jfh8-c2jp-5v3q", "cve-2021-44228"}
+	if !slices.Equal(g.Aliases, want) {
+		t.Errorf("aliases = %v, want %v", g.Aliases, want)
+	}
+}
+
+func TestLinkVulnerabilityAliases(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithAliases))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	n, err := security.LinkVulnerabilityAliases(doc)
+	if err != nil {
+		t.Fatalf("LinkVulnerabilityAliases: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("updated %d vulnerabilities, want 3", n)
+	}
+	for _, id := range []string{"urn:spdx:vuln-ghsa", "urn:spdx:vuln-cve", "urn:spdx:vuln-log4shell"} {
+		if got := len(doc.VulnerabilitiesByID[id].ExternalIdentifier); got != 2 {
+			t.Errorf("%s has %d external identifiers, want 2", id, got)
+		}
+	}
+	if got := len(doc.VulnerabilitiesByID["urn:spdx:vuln-other"].ExternalIdentifier); got != 0 {
+		t.Errorf("unrelated vulnerability has %d external identifiers, want 0", got)
+	}
+
+	if n, _ := security.LinkVulnerabilityAliases(doc); n != 0 {
+		t.Errorf("second run updated %d vulnerabilities, want 0", n)
+	}
+}
+
+func TestMergeVulnerabilities(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithAliases))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	merged, err := security.MergeVulnerabilities(doc)
+	if err != nil {
+		t.Fatalf("MergeVulnerabilities: %v", err)
+	}
+	if len(doc.Vulnerabilities) != 4 {
+		t.Errorf("source document changed: %d vulnerabilities", len(doc.Vulnerabilities))
+	}
+	if len(merged.Vulnerabilities) != 2 {
+		t.Fatalf("merged document has %d vulnerabilities, want 2", len(merged.Vulnerabilities))
+	}
+
+	v := merged.VulnerabilitiesByID["urn:spdx:vuln-ghsa"]
+	if v == nil {
+		t.Fatal("primary vulnerability missing")
+	}
+	if len(v.ExternalIdentifier) != 2 || len(v.ExternalRef) != 1 || v.Description == "" {
+		t.Errorf("primary = %+v, want the identifiers, references and description of the group", v)
+	}
+
+	vex := merged.VexAffectedVulnAssessmentsByID["urn:spdx:vex-log4shell"]
+	if vex == nil || vex.From.SpdxID != "urn:spdx:vuln-ghsa" {
+		t.Errorf("VEX assessment = %+v, want it rewritten to the primary", vex)
+	}
+	if got := merged.GetRelationshipsTo("urn:spdx:vuln-ghsa"); len(got) != 1 {
+		t.Errorf("relationships to primary = %d, want 1", len(got))
+	}
+
+	members := merged.SpdxDocument.Elements
+	if len(members) != 3 {
+		t.Errorf("document lists %v, want each element once", members)
+	}
+	if slices.ContainsFunc(members, func(e spdx.Element) bool { return e.SpdxID == "urn:spdx:vuln-cve" }) {
+		t.Error("merged entry still listed in the document")
+	}
+}
+
+func TestMergeVulnerabilitiesWithProgress(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithAliases))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+	var phases []parse.Phase
+	var last parse.Progress
+	if _, err := security.MergeVulnerabilitiesWithProgress(doc, func(p parse.Progress) {
+		if len(phases) == 0 || phases[len(phases)-1] != p.Phase {
+			phases = append(phases, p.Phase)
+		}
+		last = p
+	}); err != nil {
+		t.Fatalf("MergeVulnerabilitiesWithProgress: %v", err)
+	}
+	want := []parse.Phase{parse.PhaseMerge, parse.PhaseDecode, parse.PhaseElements, parse.PhaseIndexes}
+	if !slices.Equal(phases, want) || last.Done != last.Total {
+		t.Errorf("phases = %v ending with %+v, want %v", phases, last, want)
+	}
+}
@@ -0,0 +1,147 @@
+package security
+
+import (
+	"crypto"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// The payload type of in-toto attestations, the type of in-toto
+// statements, and the predicate type of SPDX 3.0 documents.
+const (
+	InTotoPayloadType = "application/vnd.in-toto+json"
+	StatementType     = "https://in-toto.io/Statement/v1"
+	SPDXPredicateType = "https://spdx.dev/Document/v3.0"
+)
+
+// ErrSubjectMismatch is returned when an attestation is not about the
+// expected subject.
+var ErrSubjectMismatch = errors.New("attestation is not about the subject")
+
+// Statement is an in-toto statement: a predicate, here an SPDX document,
+// about subjects identified by their digests.
+type Statement struct {
+	Type          string          `json:"_type"`
+	Subject       []Subject       `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+// Subject is an artifact an attestation is about, such as a container
+// image, with its digests by algorithm, e.g. {"sha256": "ab12..."}.
+type Subject struct {
+	Name   string            `json:"name,omitempty"`
+	Digest map[string]string `json:"digest"`
+}
+
+// ParseSubject returns the subject of a reference ending in a digest, as
+// container images are referenced, e.g.
+// "registry.acme.example/app@sha256:ab12...": the name is the part before
+// the "@".
+func ParseSubject(ref string) (Subject, error) {
+	name, digest, ok := strings.Cut(ref, "@")
+	if !ok {
+		name, digest = "", ref
+	}
+	alg, value, ok := strings.Cut(digest, ":")
+	if !ok || alg == "" {
+		return Subject{}, fmt.Errorf("reference %q has no digest", ref)
+	}
+	if _, err := hex.DecodeString(value); err != nil || value == "" {
+		return Subject{}, fmt.Errorf("reference %q has an invalid digest", ref)
+	}
+	return Subject{Name: name, Digest: map[string]string{alg: strings.ToLower(value)}}, nil
+}
+
+// matches reports whether s and other share a digest.
+func (s Subject) matches(other Subject) bool {
+	for alg, value := range other.Digest {
+		if v, ok := s.Digest[alg]; ok && strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attest packages the serialized document data as the predicate of an
+// in-toto statement about subjects, and signs it in a DSSE envelope with
+// signer, identified by keyID if not empty, as registries expect SBOMs
+// attached to images.
+func Attest(data []byte, subjects []Subject, signer crypto.Signer, keyID string) (*Envelope, error) {
+	if len(subjects) == 0 {
+		return nil, errors.New("attestation has no subject")
+	}
+	for _, s := range subjects {
+		if len(s.Digest) == 0 {
+			return nil, fmt.Errorf("subject %q has no digest", s.Name)
+		}
+	}
+	if !json.Valid(data) {
+		return nil, errors.New("document is not valid JSON")
+	}
+	payload, err := json.Marshal(Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: SPDXPredicateType,
+		Predicate:     data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding statement: %w", err)
+	}
+	env := &Envelope{PayloadType: InTotoPayloadType, Payload: payload}
+	if err := env.Sign(signer, keyID); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// VerifyAttestation decodes an attestation made by Attest in DSSE JSON
+// form, verifies it with pub and reads the document it carries. If subject
+// has digests, the statement must be about an artifact with one of them,
+// or ErrSubjectMismatch is returned. The document is only read once the
+// signature is verified.
+func VerifyAttestation(data []byte, pub crypto.PublicKey, subject Subject) (*Statement, *parse.Document, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, nil, fmt.Errorf("decoding envelope: %w", err)
+	}
+	if env.PayloadType != InTotoPayloadType {
+		return nil, nil, fmt.Errorf("unexpected payload type %q", env.PayloadType)
+	}
+	if err := env.Verify(pub); err != nil {
+		return nil, nil, err
+	}
+
+	var st Statement
+	if err := json.Unmarshal(env.Payload, &st); err != nil {
+		return nil, nil, fmt.Errorf("decoding statement: %w", err)
+	}
+	if st.Type != StatementType {
+		return nil, nil, fmt.Errorf("unexpected statement type %q", st.Type)
+	}
+	if st.PredicateType != SPDXPredicateType {
+		return nil, nil, fmt.Errorf("unexpected predicate type %q", st.PredicateType)
+	}
+	if len(subject.Digest) > 0 {
+		found := false
+		for _, s := range st.Subject {
+			if s.matches(subject) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, ErrSubjectMismatch
+		}
+	}
+	doc, err := parse.NewReader().Read(st.Predicate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading attested document: %w", err)
+	}
+	return &st, doc, nil
+}
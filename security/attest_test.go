@@ -0,0 +1,95 @@
+package security_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+const imageDigest = "sha256:6c3c624b58dbbcd3c0dd82b4c53f04194d1247c6eebdaab7c610cf7d66709b3b"
+
+func TestParseSubject(t *testing.T) {
+	tests := []struct {
+		ref     string
+		name    string
+		wantErr bool
+	}{
+		{"registry.acme.example/app@" + imageDigest, "registry.acme.example/app", false},
+		{imageDigest, "", false},
+		{"registry.acme.example/app:1.0", "", true},
+		{"registry.acme.example/app@sha256:xyz", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			s, err := security.ParseSubject(tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseSubject = %+v, want an error", s)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseSubject: %v", err)
+			}
+			if s.Name != tt.name || s.Digest["sha256"] != imageDigest[len("sha256:"):] {
+				t.Errorf("ParseSubject = %+v", s)
+			}
+		})
+	}
+}
+
+func TestAttest(t *testing.T) {
+	subject, err := security.ParseSubject("registry.acme.example/app@" + imageDigest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	env, err := security.Attest([]byte(sbomWithVEX), []security.Subject{subject}, key, "acme-release")
+	if err != nil {
+		t.Fatalf("Attest: %v", err)
+	}
+	if env.PayloadType != security.InTotoPayloadType {
+		t.Errorf("payload type = %q", env.PayloadType)
+	}
+	data, err := json.Marshal(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, doc, err := security.VerifyAttestation(data, key.Public(), subject)
+	if err != nil {
+		t.Fatalf("VerifyAttestation: %v", err)
+	}
+	if st.PredicateType != security.SPDXPredicateType || len(st.Subject) != 1 || st.Subject[0].Name != subject.Name {
+		t.Errorf("statement = %+v", st)
+	}
+	if doc.GetSpdxID() != "urn:spdx:doc" {
+		t.Errorf("attested document = %q", doc.GetSpdxID())
+	}
+	if _, _, err := security.VerifyAttestation(data, key.Public(), security.Subject{}); err != nil {
+		t.Errorf("VerifyAttestation of any subject: %v", err)
+	}
+
+	other := security.Subject{Digest: map[string]string{"sha256": "00"}}
+	if _, _, err := security.VerifyAttestation(data, key.Public(), other); !errors.Is(err, security.ErrSubjectMismatch) {
+		t.Errorf("VerifyAttestation of another subject: err = %v, want ErrSubjectMismatch", err)
+	}
+	if _, _, err := security.VerifyAttestation(data, otherKey.Public(), subject); !errors.Is(err, security.ErrInvalidSignature) {
+		t.Errorf("VerifyAttestation with another key: err = %v, want ErrInvalidSignature", err)
+	}
+
+	if _, err := security.Attest([]byte(sbomWithVEX), nil, key, ""); err == nil {
+		t.Error("Attest without subject succeeded")
+	}
+	if _, err := security.Attest([]byte("{"), []security.Subject{subject}, key, ""); err == nil {
+		t.Error("Attest of invalid JSON succeeded")
+	}
+}
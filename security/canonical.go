@@ -0,0 +1,55 @@
+package security
+
+import (
+	"crypto"
+	"fmt"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// CanonicalContentType is the content type of the canonical form of
+// documents that signatures made by SignCanonical and EmbedSignature cover:
+// N-Quads, as the RDFC-1.0 algorithm orders and labels them.
+const CanonicalContentType = "application/n-quads"
+
+// Canonicalize returns the canonical form of the serialized document data:
+// its RDF statements in N-Quads, as the RDFC-1.0 (URDNA2015) algorithm
+// labels blank nodes and orders them. It is the same for any serialization
+// of the same graph.
+func Canonicalize(data []byte) ([]byte, error) {
+	return canonicalize(data)
+}
+
+func canonicalize(data []byte, exclude ...string) ([]byte, error) {
+	canonical, err := parse.NewReader().Canonicalize(data, exclude...)
+	if err != nil {
+		return nil, fmt.Errorf("canonicalizing document: %w", err)
+	}
+	return canonical, nil
+}
+
+// SignCanonical signs the canonical form of the serialized document data
+// with signer and returns the signature as a detached JWS, with the key ID
+// keyID if not empty. Unlike a signature of data itself, it still verifies
+// once the document is serialized again, by this package or another tool,
+// with its elements and properties in another order, other whitespace, or
+// nodes nested rather than referenced.
+func SignCanonical(data []byte, signer crypto.Signer, keyID string) (string, error) {
+	payload, err := Canonicalize(data)
+	if err != nil {
+		return "", err
+	}
+	return signJWS(payload, signer, keyID, CanonicalContentType, true)
+}
+
+// VerifyCanonical verifies a signature made by SignCanonical of the
+// serialized document data, or of another serialization of its graph, with
+// pub. It returns an error wrapping ErrInvalidSignature if the signature is
+// not valid.
+func VerifyCanonical(jws string, data []byte, pub crypto.PublicKey) error {
+	payload, err := Canonicalize(data)
+	if err != nil {
+		return err
+	}
+	return VerifyDetachedJWS(jws, payload, pub)
+}
@@ -0,0 +1,164 @@
+//go:build !nojsonld
+
+package security_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+// reserialize encodes data again as another tool might: indented, with the
+// elements of its graph in reverse order.
+func reserialize(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	graph, _ := doc["@graph"].([]interface{})
+	slices.Reverse(graph)
+	out, err := json.MarshalIndent(doc, "", "\t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// injectAnnotation adds an annotation to the graph of the document data.
+func injectAnnotation(t *testing.T, data []byte, ann map[string]interface{}) []byte {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	doc["@graph"] = append(doc["@graph"].([]interface{}), ann)
+	out, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestSignCanonical(t *testing.T) {
+	data := []byte(sbomWithVEX)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	for _, tt := range testSigners(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			jws, err := security.SignCanonical(data, tt.signer, "acme-release")
+			if err != nil {
+				t.Fatalf("SignCanonical: %v", err)
+			}
+			if err := security.VerifyCanonical(jws, data, tt.signer.Public()); err != nil {
+				t.Errorf("VerifyCanonical: %v", err)
+			}
+			if err := security.VerifyCanonical(jws, reserialize(t, data), tt.signer.Public()); err != nil {
+				t.Errorf("VerifyCanonical of the document serialized again: %v", err)
+			}
+			if err := security.VerifyCanonical(jws, data, otherKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("VerifyCanonical with another key: err = %v, want ErrInvalidSignature", err)
+			}
+			modified := bytes.Replace(data, []byte(`"Upgrade lib"`), []byte(`"Ignore"`), 1)
+			if err := security.VerifyCanonical(jws, modified, tt.signer.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("VerifyCanonical of a modified document: err = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+}
+
+func TestCanonicalize(t *testing.T) {
+	want, err := security.Canonicalize([]byte(sbomWithVEX))
+	if err != nil {
+		t.Fatalf("Canonicalize: %v", err)
+	}
+	got, err := security.Canonicalize(reserialize(t, []byte(sbomWithVEX)))
+	if err != nil {
+		t.Fatalf("Canonicalize of the document serialized again: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("canonical forms differ:\n%s\n%s", got, want)
+	}
+	if _, err := security.Canonicalize([]byte("{")); err == nil {
+		t.Error("Canonicalize of invalid JSON succeeded")
+	}
+}
+
+func TestEmbedSignature(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithVEX))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	data, err := security.EmbedSignature(doc, ecKey, "acme-release")
+	if err != nil {
+		t.Fatalf("EmbedSignature: %v", err)
+	}
+	signed, err := security.ReadEmbeddedSignature(data, ecKey.Public())
+	if err != nil {
+		t.Fatalf("ReadEmbeddedSignature: %v", err)
+	}
+	if len(signed.Annotations) != len(doc.Annotations) || len(signed.Packages) != len(doc.Packages) {
+		t.Errorf("signed document has %d annotations and %d packages", len(signed.Annotations), len(signed.Packages))
+	}
+	if _, err := security.ReadEmbeddedSignature(data, otherKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+		t.Errorf("ReadEmbeddedSignature with another key: err = %v, want ErrInvalidSignature", err)
+	}
+
+	// A second signer countersigns the document as read; both signatures
+	// verify.
+	data, err = security.EmbedSignature(signed, edKey, "acme-qa")
+	if err != nil {
+		t.Fatalf("EmbedSignature again: %v", err)
+	}
+	for _, pub := range []crypto.PublicKey{ecKey.Public(), edKey.Public()} {
+		if _, err := security.ReadEmbeddedSignature(data, pub); err != nil {
+			t.Errorf("ReadEmbeddedSignature with %T: %v", pub, err)
+		}
+	}
+
+	if _, err := security.ReadEmbeddedSignature(reserialize(t, data), ecKey.Public()); err != nil {
+		t.Errorf("ReadEmbeddedSignature of the document serialized again: %v", err)
+	}
+
+	modified := bytes.Replace(data, []byte(`"not_affected"`), []byte(`"affected"`), 1)
+	if bytes.Equal(modified, data) {
+		modified = bytes.Replace(data, []byte(`"name":"`), []byte(`"name":"x`), 1)
+	}
+	if _, err := security.ReadEmbeddedSignature(modified, ecKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+		t.Errorf("ReadEmbeddedSignature of a modified document: err = %v, want ErrInvalidSignature", err)
+	}
+	// Annotations added to the signed document are not mistaken for
+	// signatures, whatever their content type.
+	for _, id := range []string{"urn:spdx:doc#approval", "urn:spdx:doc#signature-9"} {
+		injected := injectAnnotation(t, data, map[string]interface{}{
+			"type":           "Annotation",
+			"spdxId":         id,
+			"annotationType": "other",
+			"contentType":    security.JWSContentType,
+			"statement":      "approved by the security team",
+			"subject":        "urn:spdx:doc",
+			"creationInfo": map[string]interface{}{
+				"type": "CreationInfo", "specVersion": "3.0.1", "created": "2024-01-01T00:00:00Z", "createdBy": []string{"urn:spdx:org-acme"},
+			},
+		})
+		if _, err := security.ReadEmbeddedSignature(injected, ecKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+			t.Errorf("ReadEmbeddedSignature with the annotation %s added: err = %v, want ErrInvalidSignature", id, err)
+		}
+	}
+	if _, err := security.ReadEmbeddedSignature([]byte(sbomWithVEX), ecKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+		t.Errorf("ReadEmbeddedSignature of an unsigned document: err = %v, want ErrInvalidSignature", err)
+	}
+}
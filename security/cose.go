@@ -0,0 +1,357 @@
+package security
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// COSE header labels and the CBOR tag of COSE_Sign1 messages, from RFC 9052.
+const (
+	coseAlg         = 1
+	coseContentType = 3
+	coseKID         = 4
+	coseSign1Tag    = 18
+)
+
+// SignCOSE signs payload, typically a serialized document, with signer and
+// returns a tagged COSE_Sign1 message carrying it, for constrained
+// environments that handle CBOR rather than JSON. The key ID keyID, if not
+// empty, is an unprotected header. The keys and algorithms are those of
+// SignJWS.
+func SignCOSE(payload []byte, signer crypto.Signer, keyID string) ([]byte, error) {
+	return signCOSE(payload, signer, keyID, false)
+}
+
+// SignDetachedCOSE is SignCOSE leaving the payload out of the message, to
+// be carried alongside it.
+func SignDetachedCOSE(payload []byte, signer crypto.Signer, keyID string) ([]byte, error) {
+	return signCOSE(payload, signer, keyID, true)
+}
+
+func signCOSE(payload []byte, signer crypto.Signer, keyID string, detached bool) ([]byte, error) {
+	alg, err := algorithmFor(signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	var protected cborEncoder
+	protected.head(cborMap, 2)
+	protected.int(coseAlg)
+	protected.int(alg.cose)
+	protected.int(coseContentType)
+	protected.text(PayloadType)
+
+	sig, err := alg.sign(signer, sigStructure(protected.Bytes(), payload))
+	if err != nil {
+		return nil, fmt.Errorf("signing: %w", err)
+	}
+
+	var msg cborEncoder
+	msg.head(cborTag, coseSign1Tag)
+	msg.head(cborArray, 4)
+	msg.bytes(protected.Bytes())
+	if keyID != "" {
+		msg.head(cborMap, 1)
+		msg.int(coseKID)
+		msg.bytes([]byte(keyID))
+	} else {
+		msg.head(cborMap, 0)
+	}
+	if detached {
+		msg.WriteByte(cborNull)
+	} else {
+		msg.bytes(payload)
+	}
+	msg.bytes(sig)
+	return msg.Bytes(), nil
+}
+
+// sigStructure returns the Sig_structure signed by a COSE_Sign1 message,
+// without external additional authenticated data.
+func sigStructure(protected, payload []byte) []byte {
+	var e cborEncoder
+	e.head(cborArray, 4)
+	e.text("Signature1")
+	e.bytes(protected)
+	e.bytes(nil)
+	e.bytes(payload)
+	return e.Bytes()
+}
+
+// VerifyCOSE verifies a COSE_Sign1 message, tagged or not, with pub and
+// returns its payload, or an error wrapping ErrInvalidSignature if the
+// signature is not valid. The algorithm of the message must be that of
+// pub.
+func VerifyCOSE(msg []byte, pub crypto.PublicKey) ([]byte, error) {
+	m, err := decodeSign1(msg)
+	if err != nil {
+		return nil, err
+	}
+	if m.payload == nil {
+		return nil, errors.New("COSE message is detached")
+	}
+	if err := m.verify(m.payload, pub); err != nil {
+		return nil, err
+	}
+	return m.payload, nil
+}
+
+// VerifyDetachedCOSE verifies a detached COSE_Sign1 message of payload
+// with pub, as VerifyCOSE does.
+func VerifyDetachedCOSE(msg, payload []byte, pub crypto.PublicKey) error {
+	m, err := decodeSign1(msg)
+	if err != nil {
+		return err
+	}
+	if m.payload != nil {
+		return errors.New("COSE message is not detached")
+	}
+	return m.verify(payload, pub)
+}
+
+type sign1 struct {
+	protected []byte
+	alg       int64
+	payload   []byte // nil if detached
+	signature []byte
+}
+
+func decodeSign1(msg []byte) (*sign1, error) {
+	d := &cborDecoder{data: msg}
+	v, err := d.value(0)
+	if err == nil && len(d.data) > 0 {
+		err = errors.New("trailing data")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decoding COSE message: %w", err)
+	}
+	if t, ok := v.(cborTagged); ok {
+		if t.tag != coseSign1Tag {
+			return nil, fmt.Errorf("decoding COSE message: unexpected tag %d", t.tag)
+		}
+		v = t.value
+	}
+	items, ok := v.([]interface{})
+	if !ok || len(items) != 4 {
+		return nil, errors.New("decoding COSE message: not a COSE_Sign1 message")
+	}
+	m := &sign1{}
+	var okPayload bool
+	m.protected, ok = items[0].([]byte)
+	m.signature, okPayload = items[3].([]byte)
+	if !ok || !okPayload {
+		return nil, errors.New("decoding COSE message: not a COSE_Sign1 message")
+	}
+	switch p := items[2].(type) {
+	case nil:
+	case []byte:
+		m.payload = p
+	default:
+		return nil, errors.New("decoding COSE message: payload is not a byte string")
+	}
+	pd := &cborDecoder{data: m.protected}
+	headers, err := pd.value(0)
+	if err != nil {
+		return nil, fmt.Errorf("decoding COSE headers: %w", err)
+	}
+	h, _ := headers.(map[interface{}]interface{})
+	if m.alg, ok = h[int64(coseAlg)].(int64); !ok {
+		return nil, errors.New("decoding COSE headers: no algorithm")
+	}
+	return m, nil
+}
+
+func (m *sign1) verify(payload []byte, pub crypto.PublicKey) error {
+	alg, err := algorithmFor(pub)
+	if err != nil {
+		return err
+	}
+	if m.alg != alg.cose {
+		return fmt.Errorf("%w: algorithm %d, want %d", ErrInvalidSignature, m.alg, alg.cose)
+	}
+	if !alg.verify(pub, sigStructure(m.protected, payload), m.signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// CBOR major types, and the encoding of null.
+const (
+	cborUint  = 0
+	cborNeg   = 1
+	cborBytes = 2
+	cborText  = 3
+	cborArray = 4
+	cborMap   = 5
+	cborTag   = 6
+
+	cborNull = 0xf6
+)
+
+// cborEncoder writes the few CBOR items of COSE_Sign1 messages.
+type cborEncoder struct {
+	bytes.Buffer
+}
+
+func (e *cborEncoder) head(major byte, n uint64) {
+	switch {
+	case n < 24:
+		e.WriteByte(major<<5 | byte(n))
+	case n <= 0xff:
+		e.WriteByte(major<<5 | 24)
+		e.WriteByte(byte(n))
+	case n <= 0xffff:
+		e.WriteByte(major<<5 | 25)
+		e.Write(binary.BigEndian.AppendUint16(nil, uint16(n)))
+	case n <= 0xffffffff:
+		e.WriteByte(major<<5 | 26)
+		e.Write(binary.BigEndian.AppendUint32(nil, uint32(n)))
+	default:
+		e.WriteByte(major<<5 | 27)
+		e.Write(binary.BigEndian.AppendUint64(nil, n))
+	}
+}
+
+func (e *cborEncoder) int(n int64) {
+	if n < 0 {
+		e.head(cborNeg, uint64(-1-n))
+		return
+	}
+	e.head(cborUint, uint64(n))
+}
+
+func (e *cborEncoder) bytes(b []byte) {
+	e.head(cborBytes, uint64(len(b)))
+	e.Write(b)
+}
+
+func (e *cborEncoder) text(s string) {
+	e.head(cborText, uint64(len(s)))
+	e.WriteString(s)
+}
+
+type cborTagged struct {
+	tag   uint64
+	value interface{}
+}
+
+// cborDecoder reads definite-length CBOR items: integers as int64, byte
+// strings as []byte, text strings as string, arrays as []interface{}, maps
+// as map[interface{}]interface{}, tags as cborTagged, and null as nil.
+type cborDecoder struct {
+	data []byte
+}
+
+// maxCBORDepth bounds the nesting of decoded items.
+const maxCBORDepth = 16
+
+func (d *cborDecoder) head() (byte, uint64, error) {
+	if len(d.data) == 0 {
+		return 0, 0, errors.New("unexpected end of data")
+	}
+	major, info := d.data[0]>>5, d.data[0]&0x1f
+	d.data = d.data[1:]
+	if info < 24 {
+		return major, uint64(info), nil
+	}
+	size := 0
+	switch info {
+	case 24:
+		size = 1
+	case 25:
+		size = 2
+	case 26:
+		size = 4
+	case 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported additional information %d", info)
+	}
+	if len(d.data) < size {
+		return 0, 0, errors.New("unexpected end of data")
+	}
+	var n uint64
+	for _, b := range d.data[:size] {
+		n = n<<8 | uint64(b)
+	}
+	d.data = d.data[size:]
+	return major, n, nil
+}
+
+func (d *cborDecoder) value(depth int) (interface{}, error) {
+	if depth > maxCBORDepth {
+		return nil, errors.New("items nested too deeply")
+	}
+	if len(d.data) > 0 && d.data[0] == cborNull {
+		d.data = d.data[1:]
+		return nil, nil
+	}
+	major, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case cborUint, cborNeg:
+		if n > 1<<63-1 {
+			return nil, errors.New("integer out of range")
+		}
+		if major == cborNeg {
+			return -1 - int64(n), nil
+		}
+		return int64(n), nil
+	case cborBytes, cborText:
+		if uint64(len(d.data)) < n {
+			return nil, errors.New("unexpected end of data")
+		}
+		b := d.data[:n]
+		d.data = d.data[n:]
+		if major == cborText {
+			return string(b), nil
+		}
+		return b, nil
+	case cborArray:
+		if uint64(len(d.data)) < n {
+			return nil, errors.New("unexpected end of data")
+		}
+		items := make([]interface{}, 0, n)
+		for i := uint64(0); i < n; i++ {
+			v, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, v)
+		}
+		return items, nil
+	case cborMap:
+		if uint64(len(d.data)) < 2*n {
+			return nil, errors.New("unexpected end of data")
+		}
+		m := make(map[interface{}]interface{}, n)
+		for i := uint64(0); i < n; i++ {
+			k, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			switch k.(type) {
+			case int64, string:
+			default:
+				return nil, errors.New("unsupported map key")
+			}
+			v, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	case cborTag:
+		v, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return cborTagged{tag: n, value: v}, nil
+	}
+	return nil, fmt.Errorf("unsupported item of major type %d", major)
+}
@@ -0,0 +1,60 @@
+package security_test
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+func TestSignCOSE(t *testing.T) {
+	payload := []byte(sbomWithVEX)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	for _, tt := range testSigners(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := security.SignCOSE(payload, tt.signer, "acme-release")
+			if err != nil {
+				t.Fatalf("SignCOSE: %v", err)
+			}
+			// A COSE_Sign1 tag and an array of four items.
+			if !bytes.HasPrefix(msg, []byte{0xd2, 0x84}) {
+				t.Errorf("message starts with % x", msg[:2])
+			}
+			got, err := security.VerifyCOSE(msg, tt.signer.Public())
+			if err != nil {
+				t.Fatalf("VerifyCOSE: %v", err)
+			}
+			if !bytes.Equal(got, payload) {
+				t.Error("VerifyCOSE returned another payload")
+			}
+			if _, err := security.VerifyCOSE(msg, otherKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("VerifyCOSE with another key: err = %v, want ErrInvalidSignature", err)
+			}
+			if _, err := security.VerifyCOSE(msg[:len(msg)-1], tt.signer.Public()); err == nil {
+				t.Error("VerifyCOSE of a truncated message succeeded")
+			}
+
+			detached, err := security.SignDetachedCOSE(payload, tt.signer, "")
+			if err != nil {
+				t.Fatalf("SignDetachedCOSE: %v", err)
+			}
+			if len(detached) >= len(payload) {
+				t.Errorf("detached message of %d bytes carries its payload", len(detached))
+			}
+			if err := security.VerifyDetachedCOSE(detached, payload, tt.signer.Public()); err != nil {
+				t.Errorf("VerifyDetachedCOSE: %v", err)
+			}
+			modified := append(slices.Clone(payload), ' ')
+			if err := security.VerifyDetachedCOSE(detached, modified, tt.signer.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("VerifyDetachedCOSE of a modified payload: err = %v, want ErrInvalidSignature", err)
+			}
+			if _, err := security.VerifyCOSE(detached, tt.signer.Public()); err == nil {
+				t.Error("VerifyCOSE of a detached message succeeded")
+			}
+		})
+	}
+}
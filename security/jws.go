@@ -0,0 +1,330 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// JWSContentType is the content type of the annotations carrying the
+// embedded signatures of documents: JWS in compact serialization.
+const JWSContentType = "application/jose"
+
+// algorithm is a signature algorithm of JOSE and COSE.
+type algorithm struct {
+	jose string
+	cose int64
+	hash crypto.Hash // zero for Ed25519, which signs the message itself
+	size int         // size of the ECDSA coordinates, in bytes
+}
+
+// algorithmFor returns the algorithm of signatures by pub: EdDSA, ES256,
+// ES384 or ES512 by curve, or RS256 with PKCS #1 v1.5 padding.
+func algorithmFor(pub crypto.PublicKey) (algorithm, error) {
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		return algorithm{jose: "EdDSA", cose: -8}, nil
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			return algorithm{jose: "ES256", cose: -7, hash: crypto.SHA256, size: 32}, nil
+		case elliptic.P384():
+			return algorithm{jose: "ES384", cose: -35, hash: crypto.SHA384, size: 48}, nil
+		case elliptic.P521():
+			return algorithm{jose: "ES512", cose: -36, hash: crypto.SHA512, size: 66}, nil
+		}
+		return algorithm{}, fmt.Errorf("unsupported curve %s", pub.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return algorithm{jose: "RS256", cose: -257, hash: crypto.SHA256}, nil
+	}
+	return algorithm{}, fmt.Errorf("unsupported key type %T", pub)
+}
+
+func (a algorithm) digest(msg []byte) []byte {
+	h := a.hash.New()
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+// sign signs msg with signer. ECDSA signatures are the concatenated
+// coordinates R and S, as JOSE and COSE encode them.
+func (a algorithm) sign(signer crypto.Signer, msg []byte) ([]byte, error) {
+	if a.hash == 0 {
+		return signer.Sign(rand.Reader, msg, crypto.Hash(0))
+	}
+	sig, err := signer.Sign(rand.Reader, a.digest(msg), a.hash)
+	if err != nil || a.size == 0 {
+		return sig, err
+	}
+	var rs struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(sig, &rs); err != nil {
+		return nil, fmt.Errorf("decoding ECDSA signature: %w", err)
+	}
+	raw := make([]byte, 2*a.size)
+	rs.R.FillBytes(raw[:a.size])
+	rs.S.FillBytes(raw[a.size:])
+	return raw, nil
+}
+
+// verify reports whether sig is a signature of msg by pub, made by sign.
+func (a algorithm) verify(pub crypto.PublicKey, msg, sig []byte) bool {
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, msg, sig)
+	case *ecdsa.PublicKey:
+		if len(sig) != 2*a.size {
+			return false
+		}
+		r := new(big.Int).SetBytes(sig[:a.size])
+		s := new(big.Int).SetBytes(sig[a.size:])
+		return ecdsa.Verify(pub, a.digest(msg), r, s)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, a.hash, a.digest(msg), sig) == nil
+	}
+	return false
+}
+
+type jwsHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Cty string `json:"cty,omitempty"`
+}
+
+var b64 = base64.RawURLEncoding
+
+// SignJWS signs payload, typically a serialized document, with signer and
+// returns the JWS in compact serialization, with the key ID keyID if not
+// empty. Ed25519, ECDSA and RSA keys are supported, as EdDSA, ES256, ES384,
+// ES512 and RS256.
+func SignJWS(payload []byte, signer crypto.Signer, keyID string) (string, error) {
+	return signJWS(payload, signer, keyID, PayloadType, false)
+}
+
+// SignDetachedJWS is SignJWS leaving the payload out of the JWS, to be
+// carried alongside it, as RFC 7515 describes in appendix F.
+func SignDetachedJWS(payload []byte, signer crypto.Signer, keyID string) (string, error) {
+	return signJWS(payload, signer, keyID, PayloadType, true)
+}
+
+func signJWS(payload []byte, signer crypto.Signer, keyID, contentType string, detached bool) (string, error) {
+	alg, err := algorithmFor(signer.Public())
+	if err != nil {
+		return "", err
+	}
+	header, err := json.Marshal(jwsHeader{Alg: alg.jose, Kid: keyID, Cty: strings.TrimPrefix(contentType, "application/")})
+	if err != nil {
+		return "", fmt.Errorf("encoding header: %w", err)
+	}
+	input := b64.EncodeToString(header) + "." + b64.EncodeToString(payload)
+	sig, err := alg.sign(signer, []byte(input))
+	if err != nil {
+		return "", fmt.Errorf("signing: %w", err)
+	}
+	if detached {
+		input = b64.EncodeToString(header) + "."
+	}
+	return input + "." + b64.EncodeToString(sig), nil
+}
+
+// VerifyJWS verifies a JWS in compact serialization with pub and returns
+// its payload, or an error wrapping ErrInvalidSignature if the signature
+// is not valid. The algorithm of the JWS must be that of pub.
+func VerifyJWS(jws string, pub crypto.PublicKey) ([]byte, error) {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed JWS")
+	}
+	payload, err := b64.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("decoding JWS payload: %w", err)
+	}
+	if err := verifyJWS(parts, payload, pub); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// VerifyDetachedJWS verifies a detached JWS of payload with pub, as
+// VerifyJWS does.
+func VerifyDetachedJWS(jws string, payload []byte, pub crypto.PublicKey) error {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed JWS")
+	}
+	if parts[1] != "" {
+		return errors.New("JWS is not detached")
+	}
+	return verifyJWS(parts, payload, pub)
+}
+
+func verifyJWS(parts []string, payload []byte, pub crypto.PublicKey) error {
+	data, err := b64.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("decoding JWS header: %w", err)
+	}
+	var header jwsHeader
+	if err := json.Unmarshal(data, &header); err != nil {
+		return fmt.Errorf("decoding JWS header: %w", err)
+	}
+	sig, err := b64.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("decoding JWS signature: %w", err)
+	}
+	alg, err := algorithmFor(pub)
+	if err != nil {
+		return err
+	}
+	if header.Alg != alg.jose {
+		return fmt.Errorf("%w: algorithm %q, want %q", ErrInvalidSignature, header.Alg, alg.jose)
+	}
+	input := parts[0] + "." + b64.EncodeToString(payload)
+	if !alg.verify(pub, []byte(input), sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// EmbedSignature signs doc with signer and adds the signature, a detached
+// JWS, to doc as an Annotation of its SpdxDocument, with the key ID keyID
+// if not empty. It returns the signed document encoded as JSON-LD.
+//
+// The signature covers the canonical form of the document without its
+// embedded signatures, so that a document may carry several, and it still
+// verifies once the document is serialized again by another tool, as long
+// as its graph is the same. Elements referring to the creation info of doc
+// are given a copy of it.
+func EmbedSignature(doc *parse.Document, signer crypto.Signer, keyID string) ([]byte, error) {
+	if doc.SpdxDocument == nil {
+		return nil, errors.New("document has no SpdxDocument")
+	}
+	data, err := encodeGraph(doc, documentGraph(doc))
+	if err != nil {
+		return nil, fmt.Errorf("encoding document: %w", err)
+	}
+	payload, err := signedPayload(doc, data)
+	if err != nil {
+		return nil, err
+	}
+	jws, err := signJWS(payload, signer, keyID, CanonicalContentType, true)
+	if err != nil {
+		return nil, err
+	}
+	creationInfo, err := documentCreationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+	docID := doc.SpdxDocument.SpdxID
+	id := docID + "#signature"
+	for n := 2; doc.ElementsByID[id] != nil; n++ {
+		id = fmt.Sprintf("%s#signature-%d", docID, n)
+	}
+	ann := &spdx.Annotation{
+		Element:        spdx.Element{SpdxID: id, CreationInfo: *creationInfo},
+		AnnotationType: spdx.AnnotationTypeOther,
+		ContentType:    JWSContentType,
+		Statement:      jws,
+		Subject:        spdx.Element{SpdxID: docID},
+	}
+	if err := doc.AddElements(ann); err != nil {
+		return nil, err
+	}
+	return encodeGraph(doc, documentGraph(doc))
+}
+
+// ReadEmbeddedSignature reads a document signed by EmbedSignature and
+// returns it if one of its embedded signatures verifies with pub, or else
+// an error wrapping ErrInvalidSignature. Elements added to the document
+// once signed, other than the signatures EmbedSignature adds, invalidate
+// its signatures.
+func ReadEmbeddedSignature(data []byte, pub crypto.PublicKey) (*parse.Document, error) {
+	doc, err := parse.NewReader().Read(data)
+	if err != nil {
+		return nil, fmt.Errorf("reading signed document: %w", err)
+	}
+	sigs := embeddedSignatures(doc)
+	if len(sigs) == 0 {
+		return nil, fmt.Errorf("%w: document has no embedded signature", ErrInvalidSignature)
+	}
+	payload, err := signedPayload(doc, data)
+	if err != nil {
+		return nil, err
+	}
+	for _, ann := range sigs {
+		if err := VerifyDetachedJWS(ann.Statement, payload, pub); err == nil {
+			return doc, nil
+		}
+	}
+	return nil, ErrInvalidSignature
+}
+
+// embeddedSignatures returns the annotations of doc carrying signatures of
+// its SpdxDocument, as EmbedSignature adds them: detached JWS annotating
+// the SpdxDocument, with the IDs EmbedSignature gives them. Other
+// annotations are part of the signed payload, so that adding any to a
+// signed document invalidates its signatures.
+func embeddedSignatures(doc *parse.Document) []*spdx.Annotation {
+	if doc.SpdxDocument == nil {
+		return nil
+	}
+	docID := doc.SpdxDocument.SpdxID
+	var sigs []*spdx.Annotation
+	for _, ann := range doc.Annotations {
+		if ann.ContentType == JWSContentType && ann.Subject.SpdxID == docID &&
+			isSignatureID(docID, ann.SpdxID) && strings.Count(ann.Statement, ".") == 2 && strings.Contains(ann.Statement, "..") {
+			sigs = append(sigs, ann)
+		}
+	}
+	return sigs
+}
+
+// isSignatureID reports whether id is of the form EmbedSignature gives the
+// signatures of the SpdxDocument docID: docID#signature or
+// docID#signature-n.
+func isSignatureID(docID, id string) bool {
+	rest, ok := strings.CutPrefix(id, docID+"#signature")
+	if !ok {
+		return false
+	}
+	if rest == "" {
+		return true
+	}
+	n, ok := strings.CutPrefix(rest, "-")
+	return ok && n != "" && strings.Trim(n, "0123456789") == ""
+}
+
+// signedPayload returns the canonical form of data, the encoding of doc,
+// without the embedded signatures of doc.
+func signedPayload(doc *parse.Document, data []byte) ([]byte, error) {
+	var skip []string
+	for _, ann := range embeddedSignatures(doc) {
+		skip = append(skip, ann.SpdxID)
+	}
+	return canonicalize(data, skip...)
+}
+
+// documentGraph returns the elements of doc. Elements referring to the
+// creation info of the document, which the reader keeps apart, are given a
+// copy of it, so that the graph encodes the same once read again.
+func documentGraph(doc *parse.Document) []interface{} {
+	graph := make([]interface{}, 0, len(doc.ElementsByID))
+	for elem := range doc.AllElements() {
+		if ci := elem.GetCreationInfo(); doc.CreationInfo != nil && ci.Created.IsZero() && ci.SpecVersion == "" {
+			*ci = *doc.CreationInfo.Copy()
+		}
+		graph = append(graph, elem)
+	}
+	return graph
+}
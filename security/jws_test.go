@@ -0,0 +1,78 @@
+package security_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+func testSigners(t *testing.T) []struct {
+	name   string
+	signer crypto.Signer
+} {
+	t.Helper()
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	p256, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	p384, _ := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	return []struct {
+		name   string
+		signer crypto.Signer
+	}{
+		{"EdDSA", edKey},
+		{"ES256", p256},
+		{"ES384", p384},
+		{"RS256", rsaKey},
+	}
+}
+
+func TestSignJWS(t *testing.T) {
+	payload := []byte(sbomWithVEX)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+	for _, tt := range testSigners(t) {
+		t.Run(tt.name, func(t *testing.T) {
+			jws, err := security.SignJWS(payload, tt.signer, "acme-release")
+			if err != nil {
+				t.Fatalf("SignJWS: %v", err)
+			}
+			got, err := security.VerifyJWS(jws, tt.signer.Public())
+			if err != nil {
+				t.Fatalf("VerifyJWS: %v", err)
+			}
+			if !bytes.Equal(got, payload) {
+				t.Error("VerifyJWS returned another payload")
+			}
+			if _, err := security.VerifyJWS(jws, otherKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("VerifyJWS with another key: err = %v, want ErrInvalidSignature", err)
+			}
+
+			detached, err := security.SignDetachedJWS(payload, tt.signer, "")
+			if err != nil {
+				t.Fatalf("SignDetachedJWS: %v", err)
+			}
+			if !strings.Contains(detached, "..") {
+				t.Errorf("detached JWS %q carries its payload", detached)
+			}
+			if err := security.VerifyDetachedJWS(detached, payload, tt.signer.Public()); err != nil {
+				t.Errorf("VerifyDetachedJWS: %v", err)
+			}
+			modified := append(slices.Clone(payload), ' ')
+			if err := security.VerifyDetachedJWS(detached, modified, tt.signer.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("VerifyDetachedJWS of a modified payload: err = %v, want ErrInvalidSignature", err)
+			}
+			if err := security.VerifyDetachedJWS(jws, payload, tt.signer.Public()); err == nil {
+				t.Error("VerifyDetachedJWS of an enveloped JWS succeeded")
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/markdown"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Report summarizes the security content of a document: its
+// vulnerabilities by severity, their EPSS scores, those listed in the KEV
+// catalog and the VEX assessments still open. It is built by NewReport and
+// rendered with WriteJSON or WriteMarkdown.
+type Report struct {
+	DocumentID   string    `json:"documentId,omitempty"`
+	DocumentName string    `json:"documentName,omitempty"`
+	Generated    time.Time `json:"generated"`
+
+	// Vulnerabilities is the number of Vulnerability elements.
+	Vulnerabilities int `json:"vulnerabilities"`
+
+	// Severity counts the vulnerabilities by their highest CVSS severity.
+	Severity SeverityCounts `json:"severity"`
+
+	EPSS EPSSDistribution `json:"epss"`
+
+	// KEV lists the vulnerabilities marked exploited by a KEV exploit
+	// catalog assessment.
+	KEV []ReportVulnerability `json:"kev"`
+
+	// Unresolved lists the products whose current VEX status for a
+	// vulnerability is affected or under investigation.
+	Unresolved []UnresolvedVEX `json:"unresolved"`
+}
+
+// SeverityCounts counts vulnerabilities by CVSS severity. Unknown counts
+// those without a CVSS assessment.
+type SeverityCounts struct {
+	Critical int `json:"critical"`
+	High     int `json:"high"`
+	Medium   int `json:"medium"`
+	Low      int `json:"low"`
+	None     int `json:"none"`
+	Unknown  int `json:"unknown"`
+}
+
+// EPSSDistribution summarizes the EPSS probabilities of the
+// vulnerabilities, taking the highest probability of each.
+type EPSSDistribution struct {
+	// Scored is the number of vulnerabilities with an EPSS assessment.
+	Scored int `json:"scored"`
+
+	// Max is the highest probability, and Mean the mean over the scored
+	// vulnerabilities.
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+
+	// Buckets count the scored vulnerabilities by probability.
+	Buckets []EPSSBucket `json:"buckets"`
+}
+
+// EPSSBucket counts the vulnerabilities with an EPSS probability in
+// [Min, Max), or [Min, 1] for the last bucket.
+type EPSSBucket struct {
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+	Count int     `json:"count"`
+}
+
+// epssBuckets are the bounds of the EPSS buckets of a report.
+var epssBuckets = []float64{0, 0.01, 0.1, 0.5, 1}
+
+// ReportVulnerability identifies a vulnerability in a report.
+type ReportVulnerability struct {
+	SpdxID   string                `json:"spdxId"`
+	Name     string                `json:"name,omitempty"`
+	Severity spdx.CvssSeverityType `json:"severity,omitempty"`
+}
+
+// UnresolvedVEX is a product with an open VEX status for a vulnerability.
+type UnresolvedVEX struct {
+	Vulnerability ReportVulnerability `json:"vulnerability"`
+	Product       string              `json:"product"`
+	Status        VEXStatus           `json:"status"`
+	Since         time.Time           `json:"since"`
+}
+
+// NewReport builds the security posture report of a document.
+func NewReport(doc *parse.Document) *Report {
+	r := &Report{
+		DocumentID:      doc.GetSpdxID(),
+		DocumentName:    doc.GetName(),
+		Generated:       time.Now().UTC().Truncate(time.Second),
+		Vulnerabilities: len(doc.Vulnerabilities),
+		KEV:             []ReportVulnerability{},
+		Unresolved:      []UnresolvedVEX{},
+	}
+	for i := 0; i+1 < len(epssBuckets); i++ {
+		r.EPSS.Buckets = append(r.EPSS.Buckets, EPSSBucket{Min: epssBuckets[i], Max: epssBuckets[i+1]})
+	}
+
+	epss := make(map[string]float64)
+	for _, a := range doc.EpssVulnAssessments {
+		if p, ok := epss[a.From.SpdxID]; !ok || a.Probability > p {
+			epss[a.From.SpdxID] = a.Probability
+		}
+	}
+	kev := make(map[string]bool)
+	for _, a := range doc.ExploitCatalogVulnAssessments {
+		if a.CatalogType == spdx.ExploitCatalogTypeKev && a.Exploited {
+			kev[a.From.SpdxID] = true
+		}
+	}
+
+	var sum float64
+	for _, v := range doc.Vulnerabilities {
+		rv := ReportVulnerability{SpdxID: v.SpdxID, Name: v.Name, Severity: doc.GetVulnerabilitySeverity(v.SpdxID)}
+		r.Severity.add(rv.Severity)
+
+		if p, ok := epss[v.SpdxID]; ok {
+			r.EPSS.Scored++
+			sum += p
+			r.EPSS.Max = max(r.EPSS.Max, p)
+			for i := range r.EPSS.Buckets {
+				if b := &r.EPSS.Buckets[i]; p < b.Max || i == len(r.EPSS.Buckets)-1 {
+					b.Count++
+					break
+				}
+			}
+		}
+		if kev[v.SpdxID] {
+			r.KEV = append(r.KEV, rv)
+		}
+
+		tl := VulnerabilityTimeline(doc, v.SpdxID)
+		// The last transition of each product gives its current status.
+		var products []string
+		last := make(map[string]StatusTransition)
+		for _, tr := range tl.Transitions {
+			if _, ok := last[tr.Product]; !ok {
+				products = append(products, tr.Product)
+			}
+			last[tr.Product] = tr
+		}
+		for _, product := range products {
+			tr := last[product]
+			if tr.To == VEXStatusAffected || tr.To == VEXStatusUnderInvestigation {
+				r.Unresolved = append(r.Unresolved, UnresolvedVEX{Vulnerability: rv, Product: product, Status: tr.To, Since: tr.Time})
+			}
+		}
+	}
+	if r.EPSS.Scored > 0 {
+		r.EPSS.Mean = sum / float64(r.EPSS.Scored)
+	}
+	return r
+}
+
+func (c *SeverityCounts) add(s spdx.CvssSeverityType) {
+	switch s {
+	case spdx.CvssSeverityTypeCritical:
+		c.Critical++
+	case spdx.CvssSeverityTypeHigh:
+		c.High++
+	case spdx.CvssSeverityTypeMedium:
+		c.Medium++
+	case spdx.CvssSeverityTypeLow:
+		c.Low++
+	case spdx.CvssSeverityTypeNone:
+		c.None++
+	default:
+		c.Unknown++
+	}
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(r); err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+	return nil
+}
+
+// WriteMarkdown writes the report as a Markdown document.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	title := r.DocumentName
+	if title == "" {
+		title = r.DocumentID
+	}
+	fmt.Fprintf(&b, "# Security posture: %s\n\n", title)
+	fmt.Fprintf(&b, "Generated %s. %d vulnerabilities, %d in the KEV catalog, %d unresolved VEX statuses.\n\n",
+		r.Generated.Format(time.RFC3339), r.Vulnerabilities, len(r.KEV), len(r.Unresolved))
+
+	b.WriteString("## Severity\n\n| Severity | Count |\n| --- | ---: |\n")
+	for _, row := range []struct {
+		name  string
+		count int
+	}{
+		{"Critical", r.Severity.Critical},
+		{"High", r.Severity.High},
+		{"Medium", r.Severity.Medium},
+		{"Low", r.Severity.Low},
+		{"None", r.Severity.None},
+		{"Unknown", r.Severity.Unknown},
+	} {
+		fmt.Fprintf(&b, "| %s | %d |\n", row.name, row.count)
+	}
+
+	fmt.Fprintf(&b, "\n## EPSS\n\n%d vulnerabilities scored", r.EPSS.Scored)
+	if r.EPSS.Scored > 0 {
+		fmt.Fprintf(&b, ", highest %.5f, mean %.5f", r.EPSS.Max, r.EPSS.Mean)
+	}
+	b.WriteString(".\n\n| Probability | Count |\n| --- | ---: |\n")
+	for i, bucket := range r.EPSS.Buckets {
+		end := ")"
+		if i == len(r.EPSS.Buckets)-1 {
+			end = "]"
+		}
+		fmt.Fprintf(&b, "| [%g, %g%s | %d |\n", bucket.Min, bucket.Max, end, bucket.Count)
+	}
+
+	b.WriteString("\n## Known exploited vulnerabilities\n\n")
+	if len(r.KEV) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		b.WriteString("| Vulnerability | Severity |\n| --- | --- |\n")
+		for _, v := range r.KEV {
+			fmt.Fprintf(&b, "| %s | %s |\n", markdown.Cell(v.label()), v.Severity)
+		}
+	}
+
+	b.WriteString("\n## Unresolved VEX\n\n")
+	if len(r.Unresolved) == 0 {
+		b.WriteString("None.\n")
+	} else {
+		b.WriteString("| Vulnerability | Product | Status | Since |\n| --- | --- | --- | --- |\n")
+		for _, u := range r.Unresolved {
+			since := ""
+			if !u.Since.IsZero() {
+				since = u.Since.Format(time.DateOnly)
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %s |\n", markdown.Cell(u.Vulnerability.label()), markdown.Cell(u.Product), u.Status, since)
+		}
+	}
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("writing report: %w", err)
+	}
+	return nil
+}
+
+func (v ReportVulnerability) label() string {
+	if v.Name != "" {
+		return v.Name
+	}
+	return v.SpdxID
+}
@@ -0,0 +1,130 @@
+package security_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+const scoredAssessments = `"@graph": [
+		{
+			"type": "security_CvssV3VulnAssessmentRelationship",
+			"spdxId": "urn:spdx:cvss3-1",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "hasAssessmentFor",
+			"security_score": 7.5,
+			"security_severity": "high",
+			"security_vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:N/A:N"
+		},
+		{
+			"type": "security_CvssV4VulnAssessmentRelationship",
+			"spdxId": "urn:spdx:cvss4-1",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "hasAssessmentFor",
+			"security_score": 9.3,
+			"security_severity": "critical",
+			"security_vectorString": "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"
+		},
+		{
+			"type": "security_CvssV2VulnAssessmentRelationship",
+			"spdxId": "urn:spdx:cvss2-2",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-2",
+			"to": ["urn:spdx:pkg-lib"],
+			"relationshipType": "hasAssessmentFor",
+			"security_score": 5.0,
+			"security_vectorString": "AV:N/AC:L/Au:N/C:P/I:N/A:N"
+		},
+		{
+			"type": "security_EpssVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:epss-1",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "hasAssessmentFor",
+			"security_probability": 0.6,
+			"security_percentile": 0.99
+		},
+		{
+			"type": "security_EpssVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:epss-2",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-2",
+			"to": ["urn:spdx:pkg-lib"],
+			"relationshipType": "hasAssessmentFor",
+			"security_probability": 0.002,
+			"security_percentile": 0.3
+		},
+		{
+			"type": "security_ExploitCatalogVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:kev-1",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "hasAssessmentFor",
+			"security_catalogType": "kev",
+			"security_exploited": true,
+			"security_locator": "https://www.cisa.gov/known-exploited-vulnerabilities-catalog"
+		},`
+
+func TestNewReport(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(strings.Replace(sbomWithVEXHistory, `"@graph": [`, scoredAssessments, 1)))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	r := security.NewReport(doc)
+	if r.Vulnerabilities != 2 {
+		t.Errorf("vulnerabilities = %d, want 2", r.Vulnerabilities)
+	}
+	want := security.SeverityCounts{Critical: 1, Medium: 1}
+	if r.Severity != want {
+		t.Errorf("severity = %+v, want %+v", r.Severity, want)
+	}
+	if r.EPSS.Scored != 2 || r.EPSS.Max != 0.6 || r.EPSS.Buckets[0].Count != 1 || r.EPSS.Buckets[3].Count != 1 {
+		t.Errorf("EPSS = %+v", r.EPSS)
+	}
+	if len(r.KEV) != 1 || r.KEV[0].SpdxID != "urn:spdx:vuln-1" {
+		t.Errorf("KEV = %+v", r.KEV)
+	}
+	// app is fixed; lib is still affected, its not-affected assessment
+	// having been withdrawn.
+	if len(r.Unresolved) != 1 || r.Unresolved[0].Product != "urn:spdx:pkg-lib" || r.Unresolved[0].Status != security.VEXStatusAffected {
+		t.Errorf("unresolved = %+v", r.Unresolved)
+	}
+
+	var buf bytes.Buffer
+	if err := r.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+	var decoded security.Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("decoding JSON report: %v", err)
+	}
+	if decoded.Severity != r.Severity || len(decoded.Unresolved) != 1 {
+		t.Errorf("decoded report = %+v", decoded)
+	}
+
+	buf.Reset()
+	if err := r.WriteMarkdown(&buf); err != nil {
+		t.Fatalf("WriteMarkdown: %v", err)
+	}
+	for _, want := range []string{
+		"| Critical | 1 |",
+		"| [0.5, 1] | 1 |",
+		"| CVE-2024-0001 | critical |",
+		"| CVE-2024-0001 | urn:spdx:pkg-lib | affected | 2024-02-01 |",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Markdown report lacks %q:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// PayloadType is the DSSE payload type of signed SPDX JSON-LD documents.
+const PayloadType = "application/spdx+json"
+
+// ErrInvalidSignature is returned when no signature of an envelope verifies
+// with the given key.
+var ErrInvalidSignature = errors.New("no valid signature")
+
+// Envelope is a Dead Simple Signing Envelope (DSSE) carrying a signed
+// document, as produced by SignVEX. It encodes to the standard DSSE JSON
+// form, with the payload and signatures base64-encoded.
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     []byte      `json:"payload"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a signature of a DSSE envelope.
+type Signature struct {
+	KeyID string `json:"keyid,omitempty"`
+	Sig   []byte `json:"sig"`
+}
+
+// SignVEX signs a document, typically one returned by ExtractVEX, so that
+// it can be published and trusted independently of the SBOM it was taken
+// from. The document is encoded as compact JSON-LD and wrapped in a DSSE
+// envelope with one signature by signer, identified by keyID if not empty.
+//
+// Ed25519, ECDSA and RSA keys are supported; ECDSA and RSA sign a SHA-256
+// digest, RSA with PKCS #1 v1.5 padding.
+func SignVEX(doc *parse.Document, signer crypto.Signer, keyID string) (*Envelope, error) {
+	graph := make([]interface{}, 0, len(doc.ElementsByID))
+	for elem := range doc.AllElements() {
+		graph = append(graph, elem)
+	}
+	payload, err := encodeGraph(doc, graph)
+	if err != nil {
+		return nil, fmt.Errorf("encoding document: %w", err)
+	}
+	env := &Envelope{PayloadType: PayloadType, Payload: payload}
+	if err := env.Sign(signer, keyID); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+// Sign adds a signature of the envelope by signer.
+func (e *Envelope) Sign(signer crypto.Signer, keyID string) error {
+	msg := e.pae()
+	var (
+		sig []byte
+		err error
+	)
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		sig, err = signer.Sign(rand.Reader, msg, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		digest := sha256.Sum256(msg)
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return fmt.Errorf("unsupported key type %T", signer.Public())
+	}
+	if err != nil {
+		return fmt.Errorf("signing envelope: %w", err)
+	}
+	e.Signatures = append(e.Signatures, Signature{KeyID: keyID, Sig: sig})
+	return nil
+}
+
+// Verify checks that at least one signature of the envelope is valid for
+// pub, returning ErrInvalidSignature if none is.
+func (e *Envelope) Verify(pub crypto.PublicKey) error {
+	msg := e.pae()
+	digest := sha256.Sum256(msg)
+	for _, s := range e.Signatures {
+		var ok bool
+		switch pub := pub.(type) {
+		case ed25519.PublicKey:
+			ok = ed25519.Verify(pub, msg, s.Sig)
+		case *ecdsa.PublicKey:
+			ok = ecdsa.VerifyASN1(pub, digest[:], s.Sig)
+		case *rsa.PublicKey:
+			ok = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], s.Sig) == nil
+		default:
+			return fmt.Errorf("unsupported key type %T", pub)
+		}
+		if ok {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+// pae returns the DSSE pre-authentication encoding of the envelope, the
+// message that is signed.
+func (e *Envelope) pae() []byte {
+	b := []byte("DSSEv1 ")
+	b = strconv.AppendInt(b, int64(len(e.PayloadType)), 10)
+	b = append(b, ' ')
+	b = append(b, e.PayloadType...)
+	b = append(b, ' ')
+	b = strconv.AppendInt(b, int64(len(e.Payload)), 10)
+	b = append(b, ' ')
+	return append(b, e.Payload...)
+}
+
+// ReadSignedVEX decodes a DSSE envelope in JSON form, verifies it with pub
+// and reads the signed document. The document is only read once a
+// signature is verified.
+func ReadSignedVEX(data []byte, pub crypto.PublicKey) (*parse.Document, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("decoding envelope: %w", err)
+	}
+	if env.PayloadType != PayloadType {
+		return nil, fmt.Errorf("unexpected payload type %q", env.PayloadType)
+	}
+	if err := env.Verify(pub); err != nil {
+		return nil, err
+	}
+	doc, err := parse.NewReader().Read(env.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("reading signed document: %w", err)
+	}
+	return doc, nil
+}
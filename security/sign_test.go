@@ -0,0 +1,74 @@
+package security_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+func TestSignVEX(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithVEX))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+	vex, err := security.ExtractVEX(doc)
+	if err != nil {
+		t.Fatalf("ExtractVEX: %v", err)
+	}
+
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	tests := []struct {
+		name   string
+		signer crypto.Signer
+	}{
+		{"ed25519", edKey},
+		{"ecdsa", ecKey},
+		{"rsa", rsaKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, err := security.SignVEX(vex, tt.signer, "acme-"+tt.name)
+			if err != nil {
+				t.Fatalf("SignVEX: %v", err)
+			}
+			if len(env.Signatures) != 1 || env.Signatures[0].KeyID != "acme-"+tt.name {
+				t.Errorf("signatures = %+v", env.Signatures)
+			}
+			data, err := json.Marshal(env)
+			if err != nil {
+				t.Fatalf("encoding envelope: %v", err)
+			}
+
+			signed, err := security.ReadSignedVEX(data, tt.signer.Public())
+			if err != nil {
+				t.Fatalf("ReadSignedVEX: %v", err)
+			}
+			if !slices.Equal(elementIDs(signed), elementIDs(vex)) {
+				t.Errorf("signed document has %v, want %v", elementIDs(signed), elementIDs(vex))
+			}
+
+			if _, err := security.ReadSignedVEX(data, otherKey.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("ReadSignedVEX with another key: err = %v, want ErrInvalidSignature", err)
+			}
+
+			env.Payload = append(env.Payload[:len(env.Payload)-1:len(env.Payload)-1], ' ', '}')
+			if err := env.Verify(tt.signer.Public()); !errors.Is(err, security.ErrInvalidSignature) {
+				t.Errorf("Verify of a modified payload: err = %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+}
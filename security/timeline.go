@@ -0,0 +1,156 @@
+package security
+
+import (
+	"slices"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// VEXStatus is the status of a product for a vulnerability, as stated by a
+// VEX assessment. The values are those of OpenVEX and CSAF.
+type VEXStatus string
+
+// VEX statuses, one per VEX assessment class.
+const (
+	VEXStatusUnderInvestigation VEXStatus = "under_investigation"
+	VEXStatusAffected           VEXStatus = "affected"
+	VEXStatusFixed              VEXStatus = "fixed"
+	VEXStatusNotAffected        VEXStatus = "not_affected"
+)
+
+// TimelineEntry is a VEX assessment of a vulnerability.
+type TimelineEntry struct {
+	// Time is when the assessment was last changed: its modified time, or
+	// else its published time, or else the time its element was created.
+	Time time.Time
+
+	Status VEXStatus
+
+	// Assessment is the VEX assessment element, e.g. a
+	// *spdx.VexAffectedVulnAssessmentRelationship.
+	Assessment spdx.ElementInterface
+
+	// VEX holds the properties common to all VEX assessments, including the
+	// products in To and the status notes.
+	VEX *spdx.VexVulnAssessmentRelationship
+
+	// Withdrawn reports whether the assessment has a withdrawn time. Withdrawn
+	// assessments are listed but cause no transitions.
+	Withdrawn bool
+}
+
+// StatusTransition is a change of the VEX status of a product. The first
+// assessment of a product is a transition from the empty status.
+type StatusTransition struct {
+	Time    time.Time
+	Product string // SPDX ID
+	From    VEXStatus
+	To      VEXStatus
+}
+
+// Timeline is the history of the VEX assessments of a vulnerability.
+type Timeline struct {
+	Vulnerability *spdx.Vulnerability
+
+	// Entries are the assessments of the vulnerability, oldest first.
+	Entries []TimelineEntry
+
+	// Transitions are the status changes of each product in time order,
+	// e.g. under_investigation to affected to fixed.
+	Transitions []StatusTransition
+}
+
+// Status returns the current status of a product, from its last
+// transition, or "" if no assessment covers it.
+func (t *Timeline) Status(productID string) VEXStatus {
+	for i := len(t.Transitions) - 1; i >= 0; i-- {
+		if t.Transitions[i].Product == productID {
+			return t.Transitions[i].To
+		}
+	}
+	return ""
+}
+
+// VulnerabilityTimeline returns the timeline of the VEX assessments of the
+// vulnerability with the given ID, or nil if the document has no such
+// vulnerability. Assessments with the same time keep their document order.
+func VulnerabilityTimeline(doc *parse.Document, vulnID string) *Timeline {
+	vuln := doc.VulnerabilitiesByID[vulnID]
+	if vuln == nil {
+		return nil
+	}
+
+	t := &Timeline{Vulnerability: vuln}
+	add := func(elem spdx.ElementInterface, vex *spdx.VexVulnAssessmentRelationship, status VEXStatus) {
+		if vex.From.SpdxID != vulnID {
+			return
+		}
+		t.Entries = append(t.Entries, TimelineEntry{
+			Time:       assessmentTime(vex),
+			Status:     status,
+			Assessment: elem,
+			VEX:        vex,
+			Withdrawn:  !vex.WithdrawnTime.IsZero(),
+		})
+	}
+	for _, a := range doc.VexUnderInvestigationVulnAssessments {
+		add(a, &a.VexVulnAssessmentRelationship, VEXStatusUnderInvestigation)
+	}
+	for _, a := range doc.VexAffectedVulnAssessments {
+		add(a, &a.VexVulnAssessmentRelationship, VEXStatusAffected)
+	}
+	for _, a := range doc.VexFixedVulnAssessments {
+		add(a, &a.VexVulnAssessmentRelationship, VEXStatusFixed)
+	}
+	for _, a := range doc.VexNotAffectedVulnAssessments {
+		add(a, &a.VexVulnAssessmentRelationship, VEXStatusNotAffected)
+	}
+	slices.SortStableFunc(t.Entries, func(a, b TimelineEntry) int {
+		return a.Time.Compare(b.Time)
+	})
+
+	current := make(map[string]VEXStatus)
+	for _, e := range t.Entries {
+		if e.Withdrawn {
+			continue
+		}
+		for _, to := range e.VEX.To {
+			product := to.SpdxID
+			if prev, ok := current[product]; ok && prev == e.Status {
+				continue
+			}
+			t.Transitions = append(t.Transitions, StatusTransition{
+				Time:    e.Time,
+				Product: product,
+				From:    current[product],
+				To:      e.Status,
+			})
+			current[product] = e.Status
+		}
+	}
+	return t
+}
+
+// Timelines returns the timelines of all vulnerabilities of the document
+// that have VEX assessments, in document order.
+func Timelines(doc *parse.Document) []*Timeline {
+	var timelines []*Timeline
+	for _, v := range doc.Vulnerabilities {
+		if t := VulnerabilityTimeline(doc, v.SpdxID); t != nil && len(t.Entries) > 0 {
+			timelines = append(timelines, t)
+		}
+	}
+	return timelines
+}
+
+func assessmentTime(a *spdx.VexVulnAssessmentRelationship) time.Time {
+	switch {
+	case !a.ModifiedTime.IsZero():
+		return a.ModifiedTime
+	case !a.PublishedTime.IsZero():
+		return a.PublishedTime
+	}
+	return a.CreationInfo.Created
+}
@@ -0,0 +1,122 @@
+package security_test
+
+import (
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+const sbomWithVEXHistory = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{
+			"type": "CreationInfo",
+			"@id": "_:creationinfo",
+			"specVersion": "3.0.1",
+			"created": "2024-01-01T00:00:00Z",
+			"createdBy": ["urn:spdx:org-acme"]
+		},
+		{"type": "Organization", "spdxId": "urn:spdx:org-acme", "name": "Acme", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-app", "name": "app", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-lib", "name": "lib", "creationInfo": "_:creationinfo"},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-1", "name": "CVE-2024-0001", "creationInfo": "_:creationinfo"},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-2", "name": "CVE-2024-0002", "creationInfo": "_:creationinfo"},
+		{
+			"type": "security_VexFixedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-fixed",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "fixedIn",
+			"security_publishedTime": "2024-03-01T00:00:00Z"
+		},
+		{
+			"type": "security_VexAffectedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-affected",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app", "urn:spdx:pkg-lib"],
+			"relationshipType": "affects",
+			"security_actionStatement": "Upgrade lib",
+			"security_publishedTime": "2024-02-01T00:00:00Z"
+		},
+		{
+			"type": "security_VexUnderInvestigationVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-investigating",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "underInvestigationFor",
+			"security_publishedTime": "2024-01-15T00:00:00Z"
+		},
+		{
+			"type": "security_VexNotAffectedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-withdrawn",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-lib"],
+			"relationshipType": "doesNotAffect",
+			"security_publishedTime": "2024-02-15T00:00:00Z",
+			"security_withdrawnTime": "2024-02-20T00:00:00Z"
+		}
+	]
+}`
+
+func TestVulnerabilityTimeline(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithVEXHistory))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	tl := security.VulnerabilityTimeline(doc, "urn:spdx:vuln-1")
+	if tl == nil {
+		t.Fatal("no timeline")
+	}
+	wantEntries := []string{"urn:spdx:vex-investigating", "urn:spdx:vex-affected", "urn:spdx:vex-withdrawn", "urn:spdx:vex-fixed"}
+	if len(tl.Entries) != len(wantEntries) {
+		t.Fatalf("entries = %d, want %d", len(tl.Entries), len(wantEntries))
+	}
+	for i, want := range wantEntries {
+		if got := tl.Entries[i].Assessment.GetSpdxID(); got != want {
+			t.Errorf("entry %d = %s, want %s", i, got, want)
+		}
+	}
+	if !tl.Entries[2].Withdrawn {
+		t.Error("withdrawn assessment not marked")
+	}
+
+	tests := []struct {
+		product string
+		from    security.VEXStatus
+		to      security.VEXStatus
+	}{
+		{"urn:spdx:pkg-app", "", security.VEXStatusUnderInvestigation},
+		{"urn:spdx:pkg-app", security.VEXStatusUnderInvestigation, security.VEXStatusAffected},
+		{"urn:spdx:pkg-lib", "", security.VEXStatusAffected},
+		{"urn:spdx:pkg-app", security.VEXStatusAffected, security.VEXStatusFixed},
+	}
+	if len(tl.Transitions) != len(tests) {
+		t.Fatalf("transitions = %+v, want %d", tl.Transitions, len(tests))
+	}
+	for i, tt := range tests {
+		got := tl.Transitions[i]
+		if got.Product != tt.product || got.From != tt.from || got.To != tt.to {
+			t.Errorf("transition %d = %+v, want %s: %q -> %q", i, got, tt.product, tt.from, tt.to)
+		}
+	}
+
+	if got := tl.Status("urn:spdx:pkg-app"); got != security.VEXStatusFixed {
+		t.Errorf("app status = %q, want fixed", got)
+	}
+	if got := tl.Status("urn:spdx:pkg-lib"); got != security.VEXStatusAffected {
+		t.Errorf("lib status = %q, want affected", got)
+	}
+
+	if security.VulnerabilityTimeline(doc, "urn:spdx:missing") != nil {
+		t.Error("timeline for a missing vulnerability")
+	}
+	if got := security.Timelines(doc); len(got) != 1 || got[0].Vulnerability.SpdxID != "urn:spdx:vuln-1" {
+		t.Errorf("Timelines = %v, want only the assessed vulnerability", got)
+	}
+}
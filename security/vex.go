@@ -0,0 +1,230 @@
+// Package security provides vulnerability and VEX helpers for SPDX 3.0
+// documents read with the parse package.
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// ExtractVEX returns a new document holding only the security content of
+// doc: its vulnerabilities, the affects, doesNotAffect and fixedIn
+// relationships from them, its VEX, CVSS, EPSS, SSVC and exploit catalog
+// assessments, and the products, agents and tools they refer to. Other
+// elements, including the relationships between the products, are left
+// out, so that VEX updates can be published separately from the SBOM they
+// apply to.
+//
+// The new SpdxDocument is identified by the ID of the source document with
+// a "-vex" suffix, has the vulnerabilities as its root elements and takes
+// the creation info of the source document with the current time.
+// References to elements that doc does not define are kept as they are.
+func ExtractVEX(doc *parse.Document) (*parse.Document, error) {
+	creationInfo, err := documentCreationInfo(doc)
+	if err != nil {
+		return nil, err
+	}
+	creationInfo.Created = time.Now().UTC().Truncate(time.Second)
+
+	x := newExtraction(doc)
+	for _, v := range doc.Vulnerabilities {
+		x.add(v)
+		for _, rel := range doc.GetRelationshipsFrom(v.SpdxID) {
+			switch rel.RelationshipType {
+			case spdx.RelationshipTypeAffects, spdx.RelationshipTypeDoesNotAffect, spdx.RelationshipTypeFixedIn:
+				x.addRelationship(rel)
+			}
+		}
+	}
+	for _, a := range doc.CvssV2VulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.CvssV3VulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.CvssV4VulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.EpssVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.SsvcVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.ExploitCatalogVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.VexAffectedVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.VexFixedVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.VexNotAffectedVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	for _, a := range doc.VexUnderInvestigationVulnAssessments {
+		x.addAssessment(a, &a.VulnAssessmentRelationship)
+	}
+	x.addCreationInfo(creationInfo)
+
+	id := doc.GetSpdxID()
+	if id == "" {
+		id = "urn:spdx:vex"
+	} else {
+		id += "-vex"
+	}
+	name := "VEX"
+	if doc.GetName() != "" {
+		name = doc.GetName() + " VEX"
+	}
+	vexDoc := &spdx.SpdxDocument{
+		ElementCollection: spdx.ElementCollection{
+			Element: spdx.Element{
+				SpdxID:       id,
+				Name:         name,
+				CreationInfo: *creationInfo,
+			},
+			ProfileConformance: []spdx.ProfileIdentifierType{spdx.ProfileIdentifierTypeCore, spdx.ProfileIdentifierTypeSecurity},
+		},
+		DataLicense: doc.GetDataLicense(),
+	}
+	for _, elem := range x.elements {
+		ref := spdx.Element{SpdxID: elem.GetSpdxID()}
+		vexDoc.Elements = append(vexDoc.Elements, ref)
+		if _, ok := elem.(*spdx.Vulnerability); ok {
+			vexDoc.RootElement = append(vexDoc.RootElement, ref)
+		}
+	}
+
+	graph := make([]interface{}, 0, len(x.elements)+1)
+	graph = append(graph, vexDoc)
+	for _, elem := range x.elements {
+		graph = append(graph, elem)
+	}
+	return readGraph(doc, graph, "VEX document")
+}
+
+// readGraph encodes graph as a JSON-LD document with the context of doc and
+// reads it back with a reader with the given options.
+func readGraph(doc *parse.Document, graph []interface{}, what string, opts ...parse.Option) (*parse.Document, error) {
+	data, err := encodeGraph(doc, graph)
+	if err != nil {
+		return nil, fmt.Errorf("encoding %s: %w", what, err)
+	}
+	out, err := parse.NewReader(opts...).Read(data)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", what, err)
+	}
+	return out, nil
+}
+
+// encodeGraph encodes graph as a JSON-LD document with the context of doc.
+func encodeGraph(doc *parse.Document, graph []interface{}) ([]byte, error) {
+	var context interface{} = spdx.ContextURL
+	switch len(doc.Context) {
+	case 0:
+	case 1:
+		context = doc.Context[0]
+	default:
+		context = doc.Context
+	}
+	return json.Marshal(map[string]interface{}{"@context": context, "@graph": graph})
+}
+
+// documentCreationInfo returns a copy of the creation info of the document.
+func documentCreationInfo(doc *parse.Document) (*spdx.CreationInfo, error) {
+	switch {
+	case doc.SpdxDocument != nil && !doc.SpdxDocument.CreationInfo.Created.IsZero():
+		return doc.SpdxDocument.CreationInfo.Copy(), nil
+	case doc.CreationInfo != nil:
+		return doc.CreationInfo.Copy(), nil
+	}
+	return nil, fmt.Errorf("document has no creation info")
+}
+
+// extraction collects the elements of a VEX document and the elements they
+// refer to, in the order they are added.
+type extraction struct {
+	byID     map[string]spdx.ElementInterface
+	skip     string
+	included map[string]bool
+	elements []spdx.ElementInterface
+}
+
+func newExtraction(doc *parse.Document) *extraction {
+	x := &extraction{
+		byID:     make(map[string]spdx.ElementInterface),
+		skip:     doc.GetSpdxID(),
+		included: make(map[string]bool),
+	}
+	for elem := range doc.AllElements() {
+		if id := elem.GetSpdxID(); id != "" {
+			x.byID[id] = elem
+		}
+	}
+	return x
+}
+
+// add includes an element and the agents and tools of its creation info.
+func (x *extraction) add(elem spdx.ElementInterface) {
+	id := elem.GetSpdxID()
+	if x.included[id] {
+		return
+	}
+	x.included[id] = true
+	x.elements = append(x.elements, elem)
+	x.addCreationInfo(elem.GetCreationInfo())
+}
+
+// addAssessment includes an assessment with the vulnerability, products
+// and supplier it refers to.
+func (x *extraction) addAssessment(elem spdx.ElementInterface, rel *spdx.VulnAssessmentRelationship) {
+	x.add(elem)
+	x.ref(rel.From.SpdxID)
+	for _, to := range rel.To {
+		x.ref(to.SpdxID)
+	}
+	if rel.AssessedElement != nil {
+		x.ref(rel.AssessedElement.SpdxID)
+	}
+	if rel.SuppliedBy != nil {
+		x.ref(rel.SuppliedBy.SpdxID)
+	}
+}
+
+// addRelationship includes a relationship with the elements it relates.
+func (x *extraction) addRelationship(rel *spdx.Relationship) {
+	x.add(rel)
+	x.ref(rel.From.SpdxID)
+	for _, to := range rel.To {
+		x.ref(to.SpdxID)
+	}
+}
+
+func (x *extraction) addCreationInfo(ci *spdx.CreationInfo) {
+	if ci == nil {
+		return
+	}
+	for _, agent := range ci.CreatedBy {
+		x.ref(agent.SpdxID)
+	}
+	for _, tool := range ci.CreatedUsing {
+		x.ref(tool.SpdxID)
+	}
+}
+
+// ref includes the element with the given ID if the source document
+// defines it.
+func (x *extraction) ref(id string) {
+	if id == "" || id == x.skip {
+		return
+	}
+	if elem, ok := x.byID[id]; ok {
+		x.add(elem)
+	}
+}
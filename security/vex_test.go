@@ -0,0 +1,267 @@
+package security_test
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+const sbomWithVEX = `{
+	"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld",
+	"@graph": [
+		{
+			"type": "CreationInfo",
+			"@id": "_:creationinfo",
+			"specVersion": "3.0.1",
+			"created": "2024-01-01T00:00:00Z",
+			"createdBy": ["urn:spdx:org-acme"],
+			"createdUsing": ["urn:spdx:tool-scanner"]
+		},
+		{"type": "Organization", "spdxId": "urn:spdx:org-acme", "name": "Acme", "creationInfo": "_:creationinfo"},
+		{"type": "Organization", "spdxId": "urn:spdx:org-other", "name": "Other", "creationInfo": "_:creationinfo"},
+		{"type": "Tool", "spdxId": "urn:spdx:tool-scanner", "name": "scanner", "creationInfo": "_:creationinfo"},
+		{
+			"type": "SpdxDocument",
+			"spdxId": "urn:spdx:doc",
+			"name": "acme-app",
+			"creationInfo": "_:creationinfo",
+			"rootElement": ["urn:spdx:pkg-app"]
+		},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-app", "name": "app", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-lib", "name": "lib", "creationInfo": "_:creationinfo"},
+		{"type": "software_Package", "spdxId": "urn:spdx:pkg-unrelated", "name": "unrelated", "creationInfo": "_:creationinfo"},
+		{"type": "software_File", "spdxId": "urn:spdx:file-main", "name": "main.go", "creationInfo": "_:creationinfo"},
+		{
+			"type": "Relationship",
+			"spdxId": "urn:spdx:rel-depends",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:pkg-app",
+			"to": ["urn:spdx:pkg-lib"],
+			"relationshipType": "dependsOn"
+		},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-1", "name": "CVE-2024-0001", "creationInfo": "_:creationinfo"},
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-2", "name": "CVE-2024-0002", "creationInfo": "_:creationinfo"},
+		{
+			"type": "security_VexAffectedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-affected",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["urn:spdx:pkg-lib"],
+			"relationshipType": "affects",
+			"suppliedBy": "urn:spdx:org-acme",
+			"security_actionStatement": "Upgrade lib"
+		},
+		{
+			"type": "security_VexNotAffectedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-not-affected",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-2",
+			"to": ["urn:spdx:pkg-app"],
+			"relationshipType": "doesNotAffect",
+			"security_assessedElement": "urn:spdx:file-main",
+			"security_justificationType": "vulnerableCodeNotPresent"
+		},
+		{
+			"type": "security_VexFixedVulnAssessmentRelationship",
+			"spdxId": "urn:spdx:vex-fixed",
+			"creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-1",
+			"to": ["https://other.example/spdx/pkg"],
+			"relationshipType": "fixedIn"
+		}
+	]
+}`
+
+func elementIDs(doc *parse.Document) []string {
+	var ids []string
+	for elem := range doc.AllElements() {
+		ids = append(ids, elem.GetSpdxID())
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func TestExtractVEX(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(sbomWithVEX))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	vex, err := security.ExtractVEX(doc)
+	if err != nil {
+		t.Fatalf("ExtractVEX: %v", err)
+	}
+
+	want := []string{
+		"urn:spdx:doc-vex",
+		"urn:spdx:file-main",
+		"urn:spdx:org-acme",
+		"urn:spdx:pkg-app",
+		"urn:spdx:pkg-lib",
+		"urn:spdx:tool-scanner",
+		"urn:spdx:vex-affected",
+		"urn:spdx:vex-fixed",
+		"urn:spdx:vex-not-affected",
+		"urn:spdx:vuln-1",
+		"urn:spdx:vuln-2",
+	}
+	got := elementIDs(vex)
+	if len(got) != len(want) {
+		t.Fatalf("elements = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("elements = %v, want %v", got, want)
+		}
+	}
+
+	if vex.GetName() != "acme-app VEX" {
+		t.Errorf("name = %q, want %q", vex.GetName(), "acme-app VEX")
+	}
+	if len(vex.SpdxDocument.RootElement) != 2 {
+		t.Errorf("root elements = %d, want the 2 vulnerabilities", len(vex.SpdxDocument.RootElement))
+	}
+	if len(vex.SpdxDocument.Elements) != len(want)-1 {
+		t.Errorf("document lists %d elements, want %d", len(vex.SpdxDocument.Elements), len(want)-1)
+	}
+	if len(vex.Relationships) != 0 {
+		t.Errorf("relationships = %d, want 0", len(vex.Relationships))
+	}
+
+	affected := vex.VexAffectedVulnAssessmentsByID["urn:spdx:vex-affected"]
+	if affected == nil {
+		t.Fatal("affected assessment missing")
+	}
+	if affected.ActionStatement != "Upgrade lib" {
+		t.Errorf("action statement = %q, want %q", affected.ActionStatement, "Upgrade lib")
+	}
+	notAffected := vex.VexNotAffectedVulnAssessmentsByID["urn:spdx:vex-not-affected"]
+	if notAffected == nil || notAffected.JustificationType != spdx.VexJustificationTypeVulnerableCodeNotPresent {
+		t.Errorf("not affected assessment = %+v", notAffected)
+	}
+	fixed := vex.VexFixedVulnAssessmentsByID["urn:spdx:vex-fixed"]
+	if fixed == nil || len(fixed.To) != 1 || fixed.To[0].SpdxID != "https://other.example/spdx/pkg" {
+		t.Errorf("fixed assessment = %+v, want the external product kept", fixed)
+	}
+}
+
+func TestExtractVEX_Relationships(t *testing.T) {
+	sbom := strings.Replace(sbomWithVEX, `"@graph": [`, `"@graph": [
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-3", "name": "CVE-2024-0003", "creationInfo": "_:creationinfo"},
+		{
+			"type": "Relationship", "spdxId": "urn:spdx:rel-affects", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "affects"
+		},
+		{
+			"type": "Relationship", "spdxId": "urn:spdx:rel-fixed", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["https://other.example/spdx/pkg"], "relationshipType": "fixedIn"
+		},
+		{
+			"type": "Relationship", "spdxId": "urn:spdx:rel-found-by", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:tool-scanner"], "relationshipType": "foundBy"
+		},`, 1)
+	doc, err := parse.NewReader().Read([]byte(sbom))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	vex, err := security.ExtractVEX(doc)
+	if err != nil {
+		t.Fatalf("ExtractVEX: %v", err)
+	}
+	var rels []string
+	for _, rel := range vex.Relationships {
+		rels = append(rels, rel.SpdxID)
+	}
+	if want := []string{"urn:spdx:rel-affects", "urn:spdx:rel-fixed"}; !slices.Equal(rels, want) {
+		t.Errorf("relationships = %v, want %v", rels, want)
+	}
+	if vex.GetPackageByID("urn:spdx:pkg-unrelated") == nil {
+		t.Error("affected package missing")
+	}
+	affected := vex.GetAffectedPackagesByVulnID("CVE-2024-0003")
+	if len(affected) != 1 || affected[0].Package.SpdxID != "urn:spdx:pkg-unrelated" || affected[0].RelationshipType != spdx.RelationshipTypeAffects {
+		t.Errorf("affected packages = %+v", affected)
+	}
+}
+
+func TestExtractVEX_ScoreAssessments(t *testing.T) {
+	sbom := strings.Replace(sbomWithVEX, `"@graph": [`, `"@graph": [
+		{"type": "security_Vulnerability", "spdxId": "urn:spdx:vuln-3", "name": "CVE-2024-0003", "creationInfo": "_:creationinfo"},
+		{
+			"type": "security_CvssV2VulnAssessmentRelationship", "spdxId": "urn:spdx:cvss2", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "hasAssessmentFor",
+			"security_score": 7.5, "security_vectorString": "AV:N/AC:L/Au:N/C:P/I:P/A:P"
+		},
+		{
+			"type": "security_CvssV3VulnAssessmentRelationship", "spdxId": "urn:spdx:cvss3", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "hasAssessmentFor",
+			"security_score": 9.8, "security_severity": "critical", "security_vectorString": "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+		},
+		{
+			"type": "security_CvssV4VulnAssessmentRelationship", "spdxId": "urn:spdx:cvss4", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "hasAssessmentFor",
+			"security_score": 9.3, "security_severity": "critical",
+			"security_vectorString": "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"
+		},
+		{
+			"type": "security_EpssVulnAssessmentRelationship", "spdxId": "urn:spdx:epss", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "hasAssessmentFor",
+			"security_probability": 0.5, "security_percentile": 0.9
+		},
+		{
+			"type": "security_SsvcVulnAssessmentRelationship", "spdxId": "urn:spdx:ssvc", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "hasAssessmentFor",
+			"security_decisionType": "act"
+		},
+		{
+			"type": "security_ExploitCatalogVulnAssessmentRelationship", "spdxId": "urn:spdx:kev", "creationInfo": "_:creationinfo",
+			"from": "urn:spdx:vuln-3", "to": ["urn:spdx:pkg-unrelated"], "relationshipType": "hasAssessmentFor",
+			"security_catalogType": "kev", "security_exploited": true, "security_locator": "https://example.com/kev"
+		},`, 1)
+	doc, err := parse.NewReader().Read([]byte(sbom))
+	if err != nil {
+		t.Fatalf("reading SBOM: %v", err)
+	}
+
+	vex, err := security.ExtractVEX(doc)
+	if err != nil {
+		t.Fatalf("ExtractVEX: %v", err)
+	}
+	if a := vex.CvssV2VulnAssessmentsByID["urn:spdx:cvss2"]; a == nil || a.Score != 7.5 {
+		t.Errorf("CVSS v2 assessment = %+v", a)
+	}
+	if a := vex.CvssV3VulnAssessmentsByID["urn:spdx:cvss3"]; a == nil || a.Severity != spdx.CvssSeverityTypeCritical {
+		t.Errorf("CVSS v3 assessment = %+v", a)
+	}
+	if a := vex.CvssV4VulnAssessmentsByID["urn:spdx:cvss4"]; a == nil || a.Score != 9.3 {
+		t.Errorf("CVSS v4 assessment = %+v", a)
+	}
+	if a := vex.EpssVulnAssessmentsByID["urn:spdx:epss"]; a == nil || a.Percentile != 0.9 {
+		t.Errorf("EPSS assessment = %+v", a)
+	}
+	if a := vex.SsvcVulnAssessmentsByID["urn:spdx:ssvc"]; a == nil || a.DecisionType != spdx.SsvcDecisionTypeAct {
+		t.Errorf("SSVC assessment = %+v", a)
+	}
+	if a := vex.ExploitCatalogVulnAssessmentsByID["urn:spdx:kev"]; a == nil || !a.Exploited {
+		t.Errorf("exploit catalog assessment = %+v", a)
+	}
+	if vex.GetPackageByID("urn:spdx:pkg-unrelated") == nil || vex.VulnerabilitiesByID["urn:spdx:vuln-3"] == nil {
+		t.Error("assessed package or vulnerability left out")
+	}
+}
+
+func TestExtractVEX_NoCreationInfo(t *testing.T) {
+	doc, err := parse.NewReader().Read([]byte(`{"@context": "https://spdx.org/rdf/3.0.1/spdx-context.jsonld", "@graph": []}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := security.ExtractVEX(doc); err == nil {
+		t.Error("ExtractVEX succeeded without creation info")
+	}
+}
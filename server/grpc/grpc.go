@@ -0,0 +1,345 @@
+// Package grpc serves the SBOMService of docs/sbom_service.proto, the
+// Ingest, Query, Diff and ResolveVEX operations of a server.Server, to gRPC
+// clients. It implements the gRPC protocol over HTTP/2 and the protocol
+// buffer encoding of the service's messages with the standard library, so
+// that the module does not depend on grpc-go, and clients use bindings
+// generated from the proto file in any language.
+//
+// NewHandler returns an http.Handler for the service. gRPC clients require
+// HTTP/2, which an http.Server speaks over TLS, or over cleartext
+// connections if its Protocols allow unencrypted HTTP/2:
+//
+//	s := server.NewServer()
+//	mux := http.NewServeMux()
+//	mux.Handle("/", s)
+//	mux.Handle("/"+grpc.ServiceName+"/", grpc.NewHandler(s))
+//
+//	var protocols http.Protocols
+//	protocols.SetHTTP1(true)
+//	protocols.SetUnencryptedHTTP2(true)
+//	srv := &http.Server{Addr: ":8080", Handler: mux, Protocols: &protocols}
+//	log.Fatal(srv.ListenAndServe())
+//
+// Errors wrapping server.ErrNotFound, server.ErrInvalidArgument and
+// server.ErrTooLarge are returned with the NOT_FOUND, INVALID_ARGUMENT and
+// RESOURCE_EXHAUSTED codes. The handler honors the grpc-timeout of a call,
+// but supports no compression.
+package grpc
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/server"
+)
+
+// ServiceName is the full name of the service, which prefixes the paths of
+// its methods.
+const ServiceName = "spdx.service.v1.SBOMService"
+
+// DefaultMaxMessageSize is the largest request message a handler accepts,
+// in bytes, unless it is given WithMaxMessageSize. Documents are ingested
+// in chunks, each a message, up to the maximum document size of the server.
+const DefaultMaxMessageSize = 4 << 20
+
+// Option configures a handler returned by NewHandler.
+type Option interface {
+	apply(*handler)
+}
+
+type optionFunc func(*handler)
+
+func (f optionFunc) apply(h *handler) { f(h) }
+
+// WithMaxMessageSize sets the largest request message the handler accepts,
+// in bytes. Calls sending larger messages fail with RESOURCE_EXHAUSTED.
+func WithMaxMessageSize(n int) Option {
+	return optionFunc(func(h *handler) {
+		if n > 0 {
+			h.maxSize = n
+		}
+	})
+}
+
+// NewHandler returns an HTTP handler serving the SBOMService with the
+// operations of s.
+func NewHandler(s *server.Server, opts ...Option) http.Handler {
+	h := &handler{srv: s, maxSize: DefaultMaxMessageSize}
+	for _, opt := range opts {
+		opt.apply(h)
+	}
+	return h
+}
+
+type handler struct {
+	srv     *server.Server
+	maxSize int
+}
+
+// code is a gRPC status code.
+type code int
+
+// The status codes the handler returns.
+const (
+	codeOK                code = 0
+	codeCanceled          code = 1
+	codeUnknown           code = 2
+	codeInvalidArgument   code = 3
+	codeDeadlineExceeded  code = 4
+	codeNotFound          code = 5
+	codeResourceExhausted code = 8
+	codeUnimplemented     code = 12
+	codeInternal          code = 13
+)
+
+// statusError is an error with the status code it is returned with.
+type statusError struct {
+	code code
+	msg  string
+}
+
+func (e *statusError) Error() string { return e.msg }
+
+func errorf(c code, format string, args ...interface{}) error {
+	return &statusError{code: c, msg: fmt.Sprintf(format, args...)}
+}
+
+// errorCode returns the status code of an error of an operation.
+func errorCode(err error) code {
+	var se *statusError
+	switch {
+	case errors.As(err, &se):
+		return se.code
+	case errors.Is(err, server.ErrNotFound):
+		return codeNotFound
+	case errors.Is(err, server.ErrInvalidArgument):
+		return codeInvalidArgument
+	case errors.Is(err, server.ErrTooLarge):
+		return codeResourceExhausted
+	case errors.Is(err, context.DeadlineExceeded):
+		return codeDeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codeCanceled
+	}
+	return codeUnknown
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "application/grpc" && ct != "application/grpc+proto" {
+		http.Error(w, "unsupported content type "+strconv.Quote(ct), http.StatusUnsupportedMediaType)
+		return
+	}
+	if enc := r.Header.Get("Grpc-Encoding"); enc != "" && enc != "identity" {
+		w.Header().Set("Grpc-Accept-Encoding", "identity")
+		writeStatus(w, errorf(codeUnimplemented, "unsupported encoding %q", enc))
+		return
+	}
+
+	ctx := r.Context()
+	if t := r.Header.Get("Grpc-Timeout"); t != "" {
+		d, err := parseTimeout(t)
+		if err != nil {
+			writeStatus(w, errorf(codeInternal, "malformed grpc-timeout %q", t))
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d)
+		defer cancel()
+	}
+
+	rd := &frameReader{r: r.Body, maxSize: h.maxSize}
+	var (
+		resp []byte
+		err  error
+	)
+	switch r.URL.Path {
+	case "/" + ServiceName + "/Ingest":
+		resp, err = h.ingest(ctx, rd)
+	case "/" + ServiceName + "/Query":
+		resp, err = unary(ctx, rd, decodeQueryRequest, h.query)
+	case "/" + ServiceName + "/Diff":
+		resp, err = unary(ctx, rd, decodeDiffRequest, h.diff)
+	case "/" + ServiceName + "/ResolveVEX":
+		resp, err = unary(ctx, rd, decodeResolveVEXRequest, h.resolveVEX)
+	default:
+		err = errorf(codeUnimplemented, "unknown method %s", r.URL.Path)
+	}
+	if err != nil {
+		writeStatus(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+	w.WriteHeader(http.StatusOK)
+	w.Write(frame(resp))
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(codeOK)))
+}
+
+// writeStatus responds to a call that failed before sending a message in
+// headers only, as gRPC allows.
+func writeStatus(w http.ResponseWriter, err error) {
+	w.Header().Set("Content-Type", "application/grpc")
+	w.Header().Set("Grpc-Status", strconv.Itoa(int(errorCode(err))))
+	w.Header().Set("Grpc-Message", encodeMessage(err.Error()))
+	w.WriteHeader(http.StatusOK)
+}
+
+// encodeMessage percent-encodes the bytes of a status message that are not
+// printable ASCII, and percent signs.
+func encodeMessage(msg string) string {
+	var b strings.Builder
+	for i := 0; i < len(msg); i++ {
+		if c := msg[i]; c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+		} else {
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// parseTimeout parses a grpc-timeout: up to 8 digits and a unit.
+func parseTimeout(s string) (time.Duration, error) {
+	if len(s) < 2 || len(s) > 9 {
+		return 0, fmt.Errorf("invalid timeout %q", s)
+	}
+	units := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second, 'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond}
+	unit, ok := units[s[len(s)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid timeout unit in %q", s)
+	}
+	n, err := strconv.ParseUint(s[:len(s)-1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q", s)
+	}
+	if time.Duration(n) > math.MaxInt64/unit {
+		return math.MaxInt64, nil
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// frameReader reads the length-prefixed messages of a call.
+type frameReader struct {
+	r       io.Reader
+	maxSize int
+}
+
+// next returns the next message, or io.EOF after the last.
+func (fr *frameReader) next() ([]byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(fr.r, prefix[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errorf(codeInternal, "reading message: %v", err)
+	}
+	if prefix[0] != 0 {
+		return nil, errorf(codeInternal, "compressed message without an encoding")
+	}
+	n := binary.BigEndian.Uint32(prefix[1:])
+	if uint64(n) > uint64(fr.maxSize) {
+		return nil, errorf(codeResourceExhausted, "message larger than %d bytes", fr.maxSize)
+	}
+	msg := make([]byte, n)
+	if _, err := io.ReadFull(fr.r, msg); err != nil {
+		return nil, errorf(codeInternal, "reading message: %v", err)
+	}
+	return msg, nil
+}
+
+// frame returns msg with its length prefix.
+func frame(msg []byte) []byte {
+	b := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(b[1:], uint32(len(msg)))
+	return append(b, msg...)
+}
+
+// unary reads the single request message of a unary call, decodes it and
+// calls the method with it.
+func unary[T any](ctx context.Context, fr *frameReader, decode func([]byte) (T, error), method func(context.Context, T) ([]byte, error)) ([]byte, error) {
+	msg, err := fr.next()
+	if err == io.EOF {
+		return nil, errorf(codeInternal, "missing request message")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := fr.next(); err != io.EOF {
+		if err != nil {
+			return nil, err
+		}
+		return nil, errorf(codeInternal, "more than one request message")
+	}
+	req, err := decode(msg)
+	if err != nil {
+		return nil, errorf(codeInternal, "decoding request: %v", err)
+	}
+	return method(ctx, req)
+}
+
+// chunkReader reads the chunks of the IngestRequest messages of a call.
+type chunkReader struct {
+	fr    *frameReader
+	chunk []byte
+}
+
+func (cr *chunkReader) Read(p []byte) (int, error) {
+	for len(cr.chunk) == 0 {
+		msg, err := cr.fr.next()
+		if err != nil {
+			return 0, err
+		}
+		if cr.chunk, err = decodeIngestRequest(msg); err != nil {
+			return 0, errorf(codeInternal, "decoding request: %v", err)
+		}
+	}
+	n := copy(p, cr.chunk)
+	cr.chunk = cr.chunk[n:]
+	return n, nil
+}
+
+func (h *handler) ingest(ctx context.Context, fr *frameReader) ([]byte, error) {
+	sum, added, err := h.srv.Ingest(ctx, &chunkReader{fr: fr})
+	if err != nil {
+		return nil, err
+	}
+	return encodeIngestResponse(sum, added), nil
+}
+
+func (h *handler) query(ctx context.Context, req queryRequest) ([]byte, error) {
+	matches, err := h.srv.Query(ctx, req.purl, req.vuln, req.documents...)
+	if err != nil {
+		return nil, err
+	}
+	return encodeQueryResponse(matches), nil
+}
+
+func (h *handler) diff(ctx context.Context, req diffRequest) ([]byte, error) {
+	d, err := h.srv.Diff(ctx, req.from, req.to)
+	if err != nil {
+		return nil, err
+	}
+	return encodeDiffResponse(d), nil
+}
+
+func (h *handler) resolveVEX(ctx context.Context, req resolveVEXRequest) ([]byte, error) {
+	resolutions, err := h.srv.ResolveVEX(ctx, req.document, req.vuln, req.product)
+	if err != nil {
+		return nil, err
+	}
+	return encodeResolveVEXResponse(resolutions), nil
+}
@@ -0,0 +1,379 @@
+package grpc_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/server"
+	"github.com/interlynk-io/spdx-zen/server/grpc"
+)
+
+// newClient serves h over unencrypted HTTP/2 and returns a client speaking
+// it and the URL of the service.
+func newClient(t *testing.T, h http.Handler) (*http.Client, string) {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(h)
+	srv.Config.Protocols = new(http.Protocols)
+	srv.Config.Protocols.SetUnencryptedHTTP2(true)
+	srv.Start()
+	t.Cleanup(srv.Close)
+
+	var protocols http.Protocols
+	protocols.SetUnencryptedHTTP2(true)
+	client := &http.Client{Transport: &http.Transport{Protocols: &protocols}}
+	t.Cleanup(client.CloseIdleConnections)
+	return client, srv.URL + "/" + grpc.ServiceName + "/"
+}
+
+// field returns a length-delimited protocol buffer field.
+func field(num int, s string) []byte {
+	b := binary.AppendUvarint(nil, uint64(num)<<3|2)
+	b = binary.AppendUvarint(b, uint64(len(s)))
+	return append(b, s...)
+}
+
+// frame returns msg with its gRPC length prefix.
+func frame(msg []byte) []byte {
+	b := make([]byte, 5, 5+len(msg))
+	binary.BigEndian.PutUint32(b[1:], uint32(len(msg)))
+	return append(b, msg...)
+}
+
+// response is the outcome of a call.
+type response struct {
+	messages [][]byte
+	status   string
+	message  string
+}
+
+// call sends body, a sequence of framed messages, to a method.
+func call(t *testing.T, client *http.Client, url string, body []byte, header ...string) response {
+	t.Helper()
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/grpc")
+	req.Header.Set("TE", "trailers")
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.ProtoMajor != 2 || resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/grpc" {
+		t.Fatalf("response %s %s, content type %q", resp.Proto, resp.Status, resp.Header.Get("Content-Type"))
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r response
+	for len(data) > 0 {
+		if len(data) < 5 || data[0] != 0 || int(binary.BigEndian.Uint32(data[1:])) > len(data)-5 {
+			t.Fatalf("invalid response frame %q", data)
+		}
+		n := 5 + int(binary.BigEndian.Uint32(data[1:]))
+		r.messages, data = append(r.messages, data[5:n]), data[n:]
+	}
+	r.status, r.message = resp.Trailer.Get("Grpc-Status"), resp.Trailer.Get("Grpc-Message")
+	if r.status == "" {
+		r.status, r.message = resp.Header.Get("Grpc-Status"), resp.Header.Get("Grpc-Message")
+	}
+	return r
+}
+
+// reply returns the single message of a successful call.
+func (r response) reply(t *testing.T) message {
+	t.Helper()
+	if r.status != "0" || len(r.messages) != 1 {
+		t.Fatalf("status %s %q with %d messages", r.status, r.message, len(r.messages))
+	}
+	return decodeMessage(t, r.messages[0])
+}
+
+// message holds the fields of a protocol buffer message: the decimal
+// numbers of varints and the contents of length-delimited fields.
+type message map[int][]string
+
+func decodeMessage(t *testing.T, b []byte) message {
+	t.Helper()
+	m := make(message)
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			t.Fatalf("invalid tag in %q", b)
+		}
+		b = b[n:]
+		v, n := binary.Uvarint(b)
+		if n <= 0 {
+			t.Fatalf("invalid value in %q", b)
+		}
+		b = b[n:]
+		switch tag & 7 {
+		case 0:
+			m[int(tag>>3)] = append(m[int(tag>>3)], strconv.FormatUint(v, 10))
+		case 2:
+			if v > uint64(len(b)) {
+				t.Fatalf("truncated field %d", tag>>3)
+			}
+			m[int(tag>>3)], b = append(m[int(tag>>3)], string(b[:v])), b[v:]
+		default:
+			t.Fatalf("unexpected wire type %d", tag&7)
+		}
+	}
+	return m
+}
+
+func (m message) get(field int) string {
+	if len(m[field]) == 0 {
+		return ""
+	}
+	return m[field][0]
+}
+
+func (m message) messages(t *testing.T, field int) []message {
+	t.Helper()
+	var ms []message
+	for _, s := range m[field] {
+		ms = append(ms, decodeMessage(t, []byte(s)))
+	}
+	return ms
+}
+
+// ingest streams a document in chunks of size bytes.
+func ingest(t *testing.T, client *http.Client, url string, doc []byte, size int) response {
+	t.Helper()
+	var body []byte
+	for chunk := range slices.Chunk(doc, size) {
+		body = append(body, frame(field(1, string(chunk)))...)
+	}
+	return call(t, client, url+"Ingest", body)
+}
+
+func TestHandler_Ingest(t *testing.T) {
+	client, url := newClient(t, grpc.NewHandler(server.NewServer(server.WithMaxDocumentSize(32<<10)), grpc.WithMaxMessageSize(4<<10)))
+	doc := testsbom.JSON(t, "1.0.0")
+
+	resp := ingest(t, client, url, doc, 1000).reply(t)
+	sum := decodeMessage(t, []byte(resp.get(1)))
+	if resp.get(2) != "1" || len(sum.get(1)) != 64 || sum.get(2) != "https://acme.example/sbom/app-1.0.0" || sum.get(3) != "app" {
+		t.Errorf("IngestResponse = %q, summary %q", resp, sum)
+	}
+	if sum.get(6) != strconv.Itoa(len(doc)) || sum.get(8) != "4" || sum.get(10) != "5" || sum.get(11) != "1" || !slices.Equal(sum[7], []string{"core", "software"}) {
+		t.Errorf("summary counts = %q", sum)
+	}
+	created := decodeMessage(t, []byte(sum.get(4)))
+	if created.get(1) != strconv.FormatInt(testsbom.Created.Unix(), 10) || created.get(2) != "" {
+		t.Errorf("created = %q", created)
+	}
+	if again := ingest(t, client, url, doc, len(doc)/3+1).reply(t); again.get(2) != "" || decodeMessage(t, []byte(again.get(1))).get(1) != sum.get(1) {
+		t.Errorf("second IngestResponse = %q", again)
+	}
+
+	tests := []struct {
+		name   string
+		doc    []byte
+		size   int
+		status string
+	}{
+		{"invalid document", []byte(`{"@graph": 1}`), 100, "3"},
+		{"no chunks", nil, 100, "3"},
+		{"message too large", doc, 5 << 10, "8"},
+		{"document too large", bytes.Repeat([]byte(" "), 40<<10), 4000, "8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if r := ingest(t, client, url, tt.doc, tt.size); r.status != tt.status || len(r.messages) != 0 || r.message == "" {
+				t.Errorf("status %s %q, want %s", r.status, r.message, tt.status)
+			}
+		})
+	}
+}
+
+func TestHandler_Query(t *testing.T) {
+	client, url := newClient(t, grpc.NewHandler(server.NewServer()))
+	first := decodeMessage(t, []byte(ingest(t, client, url, testsbom.JSON(t, "1.0.0"), 4096).reply(t).get(1))).get(1)
+	second := decodeMessage(t, []byte(ingest(t, client, url, testsbom.Upgrade(t), 4096).reply(t).get(1))).get(1)
+
+	tests := []struct {
+		name string
+		req  []byte
+		want []string
+	}{
+		{"purl", field(1, "pkg:npm/lib"), []string{"lib 2.1.0 " + first, "lib 2.2.0 " + second}},
+		{"one document", slices.Concat(field(1, "pkg:npm/lib"), field(3, second)), []string{"lib 2.2.0 " + second}},
+		{"vulnerability and purl", slices.Concat(field(1, "pkg:npm/leaf"), field(2, "CVE-2024-1234")), []string{"leaf 0.3.0 " + first + " affects"}},
+		{"no match", field(1, "pkg:npm/parser@2.0.0"), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, m := range call(t, client, url+"Query", frame(tt.req)).reply(t).messages(t, 1) {
+				s := m.get(3) + " " + m.get(4) + " " + m.get(1)
+				if m.get(6) != "" {
+					s += " " + m.get(7)
+				}
+				got = append(got, s)
+			}
+			if slices.Sort(got); !slices.Equal(got, tt.want) {
+				t.Errorf("packages = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	for req, status := range map[string]string{
+		"":                          "3",
+		string(field(3, "unknown")): "3",
+		string(slices.Concat(field(1, "pkg:npm/lib"), field(3, "unknown"))): "5",
+	} {
+		if r := call(t, client, url+"Query", frame([]byte(req))); r.status != status {
+			t.Errorf("Query %q: status %s %q, want %s", req, r.status, r.message, status)
+		}
+	}
+}
+
+func TestHandler_Diff(t *testing.T) {
+	client, url := newClient(t, grpc.NewHandler(server.NewServer()))
+	from := decodeMessage(t, []byte(ingest(t, client, url, testsbom.JSON(t, "1.0.0"), 4096).reply(t).get(1))).get(1)
+	to := decodeMessage(t, []byte(ingest(t, client, url, testsbom.Upgrade(t), 4096).reply(t).get(1))).get(1)
+
+	d := call(t, client, url+"Diff", frame(slices.Concat(field(1, from), field(2, to)))).reply(t)
+	if d.get(1) != from || d.get(2) != to {
+		t.Errorf("diff of %q and %q", d.get(1), d.get(2))
+	}
+	names := func(field int) []string {
+		var names []string
+		for _, m := range d.messages(t, field) {
+			names = append(names, m.get(3))
+		}
+		return names
+	}
+	if added, removed := names(3), names(4); !slices.Equal(added, []string{"parser"}) || !slices.Equal(removed, []string{"leaf", "mock"}) {
+		t.Errorf("added %q, removed %q", added, removed)
+	}
+	var changed []string
+	for _, c := range d.messages(t, 5) {
+		changed = append(changed, c.get(1)+" "+c.get(2)+" "+c.get(3)+" "+c.get(6))
+	}
+	if !slices.Equal(changed, []string{"app 1.0.0 1.1.0 upgrade", "lib 2.1.0 2.2.0 upgrade"}) {
+		t.Errorf("changed = %q", changed)
+	}
+
+	if r := call(t, client, url+"Diff", frame(slices.Concat(field(1, from), field(2, "unknown")))); r.status != "5" {
+		t.Errorf("Diff with an unknown document: status %s %q", r.status, r.message)
+	}
+}
+
+func TestHandler_ResolveVEX(t *testing.T) {
+	client, url := newClient(t, grpc.NewHandler(server.NewServer()))
+	id := decodeMessage(t, []byte(ingest(t, client, url, testsbom.Upgrade(t), 4096).reply(t).get(1))).get(1)
+	// The assessment of app has no time of its own, and dates from the
+	// creation of the document.
+	created := strconv.FormatInt(testsbom.Created.AddDate(0, 1, 0).Unix(), 10)
+	fixed := strconv.FormatInt(time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC).Unix(), 10)
+
+	tests := []struct {
+		product string
+		want    []string
+	}{
+		{"", []string{"app not_affected " + created, "lib fixed " + fixed}},
+		{"pkg:npm/lib@2.2.0", []string{"lib fixed " + fixed}},
+		{"pkg:npm/parser", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.product, func(t *testing.T) {
+			req := slices.Concat(field(1, id), field(2, "CVE-2024-1234"), field(3, tt.product))
+			var got []string
+			for _, r := range call(t, client, url+"ResolveVEX", frame(req)).reply(t).messages(t, 1) {
+				s := r.get(4) + " " + r.get(6)
+				if r.get(7) != "" {
+					s += " " + decodeMessage(t, []byte(r.get(7))).get(1)
+				}
+				if r.get(1) != id || r.get(3) == "" {
+					t.Errorf("resolution %q", r)
+				}
+				got = append(got, s)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("resolutions = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if r := call(t, client, url+"ResolveVEX", frame(field(1, id))); r.status != "3" {
+		t.Errorf("ResolveVEX without a vulnerability: status %s %q", r.status, r.message)
+	}
+}
+
+func TestHandler_Protocol(t *testing.T) {
+	client, url := newClient(t, grpc.NewHandler(server.NewServer()))
+	query := frame(field(1, "pkg:npm/lib"))
+	compressed := slices.Clone(query)
+	compressed[0] = 1
+
+	tests := []struct {
+		name    string
+		method  string
+		body    []byte
+		header  []string
+		status  string
+		message string
+	}{
+		{"ok", "Query", query, nil, "0", ""},
+		{"timeout", "Query", query, []string{"Grpc-Timeout", "10S"}, "0", ""},
+		{"unknown method", "Delete", query, nil, "12", "unknown method /" + grpc.ServiceName + "/Delete"},
+		{"no message", "Query", nil, nil, "13", "missing request message"},
+		{"two messages", "Query", slices.Concat(query, query), nil, "13", "more than one request message"},
+		{"truncated message", "Query", query[:len(query)-1], nil, "13", "reading message: unexpected EOF"},
+		{"invalid message", "Query", frame([]byte{0x0a, 0x05, 'p'}), nil, "13", "decoding request: field 1: truncated"},
+		{"invalid UTF-8", "Query", frame(field(1, "pkg:npm/\xff")), nil, "13", "decoding request: field 1: invalid UTF-8"},
+		{"wrong wire type", "Query", frame([]byte{0x08, 0x01}), nil, "13", "decoding request: field 1: wire type 0, want 2"},
+		{"unknown fields", "Query", frame(slices.Concat([]byte{0x20, 0x01, 0x2d, 1, 2, 3, 4}, field(1, "pkg:npm/lib"), field(9, "x"))), nil, "0", ""},
+		{"compressed", "Query", compressed, nil, "13", "compressed message without an encoding"},
+		{"encoding", "Query", query, []string{"Grpc-Encoding", "gzip"}, "12", `unsupported encoding "gzip"`},
+		{"malformed timeout", "Query", query, []string{"Grpc-Timeout", "10"}, "13", `malformed grpc-timeout "10"`},
+		{"message encoding", "Diff", frame(slices.Concat(field(1, "ü%"), field(2, "x"))), nil, "5", `not found: no document "%C3%BC%25"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := call(t, client, url+tt.method, tt.body, tt.header...)
+			if r.status != tt.status || (tt.message != "" && r.message != tt.message) {
+				t.Errorf("status %s %q, want %s %q", r.status, r.message, tt.status, tt.message)
+			}
+		})
+	}
+
+	for _, tt := range []struct {
+		method, contentType string
+		code                int
+	}{
+		{"GET", "application/grpc", http.StatusMethodNotAllowed},
+		{"POST", "application/json", http.StatusUnsupportedMediaType},
+		{"POST", "application/grpc+json", http.StatusUnsupportedMediaType},
+	} {
+		req, err := http.NewRequest(tt.method, url+"Query", bytes.NewReader(query))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", tt.contentType)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != tt.code {
+			t.Errorf("%s %s: %s, want %d", tt.method, tt.contentType, resp.Status, tt.code)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package grpc
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/interlynk-io/spdx-zen/server"
+)
+
+// The protocol buffer wire types of the fields.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// encoder appends the fields of a message in the protocol buffer encoding.
+// As in proto3, fields with default values are omitted, except the elements
+// of repeated fields.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) tag(field, wire int) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(field)<<3|uint64(wire))
+}
+
+func (e *encoder) varint(field int, v uint64) {
+	if v != 0 {
+		e.tag(field, wireVarint)
+		e.buf = binary.AppendUvarint(e.buf, v)
+	}
+}
+
+func (e *encoder) int(field int, v int) {
+	e.varint(field, uint64(v))
+}
+
+func (e *encoder) bool(field int, v bool) {
+	if v {
+		e.varint(field, 1)
+	}
+}
+
+func (e *encoder) string(field int, s string) {
+	if s != "" {
+		e.message(field, []byte(s))
+	}
+}
+
+// message appends a length-delimited field, even if it is empty.
+func (e *encoder) message(field int, b []byte) {
+	e.tag(field, wireBytes)
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// timestamp appends a google.protobuf.Timestamp, unless t is zero.
+func (e *encoder) timestamp(field int, t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	var ts encoder
+	ts.varint(1, uint64(t.Unix()))
+	ts.int(2, t.Nanosecond())
+	e.message(field, ts.buf)
+}
+
+// decodeFields calls fn with the field number, wire type and value of each
+// field of a message: the number of a varint, or the content of a
+// length-delimited field. Fixed-size fields are skipped.
+func decodeFields(b []byte, fn func(field, wire int, v uint64, data []byte) error) error {
+	for len(b) > 0 {
+		tag, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errors.New("invalid field tag")
+		}
+		b = b[n:]
+		if tag>>3 == 0 || tag>>3 > 1<<29-1 {
+			return fmt.Errorf("invalid field number %d", tag>>3)
+		}
+		field, wire := int(tag>>3), int(tag&7)
+		var (
+			v    uint64
+			data []byte
+		)
+		switch wire {
+		case wireVarint:
+			if v, n = binary.Uvarint(b); n <= 0 {
+				return fmt.Errorf("field %d: invalid varint", field)
+			}
+			b = b[n:]
+		case wireFixed64, wireFixed32:
+			size := 8
+			if wire == wireFixed32 {
+				size = 4
+			}
+			if len(b) < size {
+				return fmt.Errorf("field %d: truncated", field)
+			}
+			b = b[size:]
+			continue
+		case wireBytes:
+			if v, n = binary.Uvarint(b); n <= 0 || v > uint64(len(b)-n) {
+				return fmt.Errorf("field %d: truncated", field)
+			}
+			data, b = b[n:n+int(v)], b[n+int(v):]
+		default:
+			return fmt.Errorf("field %d: unsupported wire type %d", field, wire)
+		}
+		if err := fn(field, wire, v, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stringField returns the value of a string field.
+func stringField(field, wire int, data []byte) (string, error) {
+	if wire != wireBytes {
+		return "", fmt.Errorf("field %d: wire type %d, want %d", field, wire, wireBytes)
+	}
+	if !utf8.Valid(data) {
+		return "", fmt.Errorf("field %d: invalid UTF-8", field)
+	}
+	return string(data), nil
+}
+
+// decodeIngestRequest returns the chunk of an IngestRequest.
+func decodeIngestRequest(b []byte) ([]byte, error) {
+	var chunk []byte
+	err := decodeFields(b, func(field, wire int, _ uint64, data []byte) error {
+		if field != 1 {
+			return nil
+		}
+		if wire != wireBytes {
+			return fmt.Errorf("field %d: wire type %d, want %d", field, wire, wireBytes)
+		}
+		chunk = data
+		return nil
+	})
+	return chunk, err
+}
+
+type queryRequest struct {
+	purl, vuln string
+	documents  []string
+}
+
+func decodeQueryRequest(b []byte) (queryRequest, error) {
+	var req queryRequest
+	err := decodeFields(b, func(field, wire int, _ uint64, data []byte) error {
+		var err error
+		switch field {
+		case 1:
+			req.purl, err = stringField(field, wire, data)
+		case 2:
+			req.vuln, err = stringField(field, wire, data)
+		case 3:
+			var doc string
+			doc, err = stringField(field, wire, data)
+			req.documents = append(req.documents, doc)
+		}
+		return err
+	})
+	return req, err
+}
+
+type diffRequest struct {
+	from, to string
+}
+
+func decodeDiffRequest(b []byte) (diffRequest, error) {
+	var req diffRequest
+	err := decodeFields(b, func(field, wire int, _ uint64, data []byte) error {
+		var err error
+		switch field {
+		case 1:
+			req.from, err = stringField(field, wire, data)
+		case 2:
+			req.to, err = stringField(field, wire, data)
+		}
+		return err
+	})
+	return req, err
+}
+
+type resolveVEXRequest struct {
+	document, vuln, product string
+}
+
+func decodeResolveVEXRequest(b []byte) (resolveVEXRequest, error) {
+	var req resolveVEXRequest
+	err := decodeFields(b, func(field, wire int, _ uint64, data []byte) error {
+		var err error
+		switch field {
+		case 1:
+			req.document, err = stringField(field, wire, data)
+		case 2:
+			req.vuln, err = stringField(field, wire, data)
+		case 3:
+			req.product, err = stringField(field, wire, data)
+		}
+		return err
+	})
+	return req, err
+}
+
+func encodeIngestResponse(sum *server.Summary, added bool) []byte {
+	var s encoder
+	s.string(1, sum.ID)
+	s.string(2, sum.SpdxID)
+	s.string(3, sum.Name)
+	s.timestamp(4, sum.Created)
+	s.timestamp(5, sum.Uploaded)
+	s.int(6, sum.Size)
+	for _, p := range sum.Profiles {
+		s.message(7, []byte(p))
+	}
+	s.int(8, sum.Packages)
+	s.int(9, sum.Files)
+	s.int(10, sum.Relationships)
+	s.int(11, sum.Vulnerabilities)
+
+	var e encoder
+	e.message(1, s.buf)
+	e.bool(2, added)
+	return e.buf
+}
+
+func encodePackageMatch(m server.PackageMatch) []byte {
+	var e encoder
+	e.string(1, m.Document)
+	e.string(2, m.SpdxID)
+	e.string(3, m.Name)
+	e.string(4, m.Version)
+	e.string(5, m.PURL)
+	e.string(6, m.Vulnerability)
+	e.string(7, string(m.Status))
+	return e.buf
+}
+
+func encodeQueryResponse(matches []server.PackageMatch) []byte {
+	var e encoder
+	for _, m := range matches {
+		e.message(1, encodePackageMatch(m))
+	}
+	return e.buf
+}
+
+func encodeDiffResponse(d *server.DocumentDiff) []byte {
+	var e encoder
+	e.string(1, d.From)
+	e.string(2, d.To)
+	for _, m := range d.Added {
+		e.message(3, encodePackageMatch(m))
+	}
+	for _, m := range d.Removed {
+		e.message(4, encodePackageMatch(m))
+	}
+	for _, c := range d.Changed {
+		var ch encoder
+		ch.string(1, c.Name)
+		ch.string(2, c.FromVersion)
+		ch.string(3, c.ToVersion)
+		ch.string(4, c.FromPURL)
+		ch.string(5, c.ToPURL)
+		ch.string(6, c.Change)
+		e.message(5, ch.buf)
+	}
+	return e.buf
+}
+
+func encodeResolveVEXResponse(resolutions []server.VEXResolution) []byte {
+	var e encoder
+	for _, r := range resolutions {
+		var res encoder
+		res.string(1, r.Document)
+		res.string(2, r.Vulnerability)
+		res.string(3, r.Product)
+		res.string(4, r.Name)
+		res.string(5, r.PURL)
+		res.string(6, string(r.Status))
+		res.timestamp(7, r.Time)
+		e.message(1, res.buf)
+	}
+	return e.buf
+}
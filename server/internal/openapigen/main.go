@@ -0,0 +1,28 @@
+// Command openapigen writes the OpenAPI specification of the server
+// package, for go generate.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/interlynk-io/spdx-zen/server"
+)
+
+func main() {
+	out := flag.String("out", "", "file to write the specification to; stdout if empty")
+	flag.Parse()
+
+	data, err := server.OpenAPI()
+	if err != nil {
+		log.Fatalf("generating specification: %v", err)
+	}
+	if *out == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatalf("writing specification: %v", err)
+	}
+}
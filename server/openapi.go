@@ -0,0 +1,448 @@
+package server
+
+//go:generate go run ./internal/openapigen -out ../docs/openapi.json
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/graphql"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+// endpoint is an operation of the HTTP API. The endpoints both route the
+// requests of a Server and describe its API in the OpenAPI specification,
+// so the two cannot drift apart.
+type endpoint struct {
+	method      string
+	path        string
+	operationID string
+	summary     string
+	params      []parameter
+	body        *content
+	responses   []response
+	handler     http.HandlerFunc
+}
+
+// parameter is a path or query parameter of an endpoint.
+type parameter struct {
+	name        string
+	in          string
+	description string
+	required    bool
+	repeated    bool
+}
+
+// content is a request or response body: a value of the Go type it is
+// encoded from, or of an object type for SPDX documents.
+type content struct {
+	mediaType   string
+	description string
+	value       interface{}
+}
+
+// response is a response of an endpoint. A response without content has
+// no body.
+type response struct {
+	status      int
+	description string
+	content     *content
+}
+
+// spdxDocument stands for an SPDX 3.0 JSON-LD document in the specification.
+type spdxDocument map[string]interface{}
+
+// apiError is the body of error responses.
+type apiError struct {
+	Error string `json:"error"`
+}
+
+func jsonContent(v interface{}) *content {
+	return &content{mediaType: "application/json", value: v}
+}
+
+func documentContent(description string) *content {
+	return &content{mediaType: "application/spdx+json", description: description, value: spdxDocument(nil)}
+}
+
+func errorResponse(status int, description string) response {
+	return response{status: status, description: description, content: jsonContent(apiError{})}
+}
+
+var (
+	idParameter   = parameter{name: "id", in: "path", description: "The ID of a stored document, the SHA-256 digest of its content.", required: true}
+	purlParameter = parameter{name: "purl", in: "query", description: "A package URL the packages match; without a version it matches every version."}
+	vulnParameter = parameter{name: "vuln", in: "query", description: "An ID or alias of a vulnerability affecting, not affecting or fixed in the packages."}
+	notFound      = errorResponse(http.StatusNotFound, "No document has the ID.")
+	badRequest    = errorResponse(http.StatusBadRequest, "The request is invalid.")
+)
+
+// endpoints returns the endpoints of the server.
+func (s *Server) endpoints() []endpoint {
+	return []endpoint{
+		{
+			method: "POST", path: "/documents", operationID: "uploadDocument",
+			summary: "Upload a document",
+			body:    documentContent("An SPDX 3.0 JSON-LD document."),
+			responses: []response{
+				{status: http.StatusCreated, description: "The document was stored.", content: jsonContent(Summary{})},
+				{status: http.StatusOK, description: "The document was stored already.", content: jsonContent(Summary{})},
+				errorResponse(http.StatusBadRequest, "The document cannot be parsed."),
+				errorResponse(http.StatusRequestEntityTooLarge, "The document is larger than the server accepts."),
+			},
+			handler: s.upload,
+		},
+		{
+			method: "GET", path: "/documents", operationID: "listDocuments",
+			summary: "List the documents",
+			responses: []response{
+				{status: http.StatusOK, description: "The stored documents, in upload order.", content: jsonContent([]Summary(nil))},
+			},
+			handler: s.list,
+		},
+		{
+			method: "GET", path: "/documents/{id}", operationID: "getDocument",
+			summary: "Summarize a document",
+			params:  []parameter{idParameter},
+			responses: []response{
+				{status: http.StatusOK, description: "The summary of the document.", content: jsonContent(Summary{})},
+				notFound,
+			},
+			handler: s.withDocument(s.summary),
+		},
+		{
+			method: "DELETE", path: "/documents/{id}", operationID: "deleteDocument",
+			summary: "Remove a document",
+			params:  []parameter{idParameter},
+			responses: []response{
+				{status: http.StatusNoContent, description: "The document was removed."},
+				notFound,
+			},
+			handler: s.remove,
+		},
+		{
+			method: "GET", path: "/documents/{id}/validation", operationID: "validateDocument",
+			summary: "Validate a document",
+			params:  []parameter{idParameter},
+			responses: []response{
+				{status: http.StatusOK, description: "The constraint violations of the document.", content: jsonContent(Validation{})},
+				notFound,
+			},
+			handler: s.withDocument(s.validation),
+		},
+		{
+			method: "GET", path: "/documents/{id}/packages", operationID: "queryDocumentPackages",
+			summary: "Query the packages of a document",
+			params:  []parameter{idParameter, purlParameter, vulnParameter},
+			responses: []response{
+				{status: http.StatusOK, description: "The matching packages.", content: jsonContent([]PackageMatch(nil))},
+				notFound,
+			},
+			handler: s.withDocument(s.documentPackages),
+		},
+		{
+			method: "GET", path: "/documents/{id}/subgraph", operationID: "getSubgraph",
+			summary: "Retrieve a subgraph as SPDX JSON-LD",
+			params: []parameter{
+				idParameter,
+				{name: "element", in: "query", description: "The SPDX ID of the element the subgraph is reachable from.", required: true},
+				{name: "depth", in: "query", description: "The number of relationships to follow; all of them if absent."},
+				{name: "type", in: "query", description: "The types of the relationships to follow; all types if absent.", repeated: true},
+			},
+			responses: []response{
+				{status: http.StatusOK, description: "The subgraph.", content: documentContent("An SPDX 3.0 JSON-LD document of the subgraph.")},
+				badRequest,
+				errorResponse(http.StatusNotFound, "No document has the ID, or it has no element with the SPDX ID."),
+			},
+			handler: s.withDocument(s.subgraph),
+		},
+		{
+			method: "GET", path: "/documents/{id}/diff", operationID: "diffDocuments",
+			summary: "Compare the packages of two documents",
+			params: []parameter{
+				idParameter,
+				{name: "to", in: "query", description: "The ID of the document to compare with.", required: true},
+			},
+			responses: []response{
+				{status: http.StatusOK, description: "The difference between the packages of the documents.", content: jsonContent(DocumentDiff{})},
+				badRequest,
+				errorResponse(http.StatusNotFound, "No document has one of the IDs."),
+			},
+			handler: s.withDocument(s.diff),
+		},
+		{
+			method: "GET", path: "/documents/{id}/vex", operationID: "resolveVEX",
+			summary: "Resolve the VEX status of products",
+			params: []parameter{
+				idParameter,
+				{name: "vuln", in: "query", description: "An ID or alias of the vulnerability.", required: true},
+				{name: "product", in: "query", description: "The SPDX ID or package URL of the product; all assessed products if absent."},
+			},
+			responses: []response{
+				{status: http.StatusOK, description: "The VEX status of each product.", content: jsonContent([]VEXResolution(nil))},
+				badRequest,
+				notFound,
+			},
+			handler: s.withDocument(s.vex),
+		},
+		{
+			method: "GET", path: "/documents/{id}/graphql", operationID: "queryDocumentGraphQL",
+			summary: "Query a document with GraphQL",
+			params: []parameter{
+				idParameter,
+				{name: "query", in: "query", description: "The GraphQL query.", required: true},
+				{name: "operationName", in: "query", description: "The operation of the query to execute."},
+				{name: "variables", in: "query", description: "The variables of the query, as a JSON object."},
+			},
+			responses: []response{
+				{status: http.StatusOK, description: "The result of the query.", content: jsonContent(graphql.Response{})},
+				{status: http.StatusBadRequest, description: "The query is invalid.", content: jsonContent(graphql.Response{})},
+				notFound,
+			},
+			handler: s.withDocument(s.graphql),
+		},
+		{
+			method: "POST", path: "/documents/{id}/graphql", operationID: "postDocumentGraphQL",
+			summary: "Query a document with GraphQL",
+			params:  []parameter{idParameter},
+			body:    jsonContent(graphql.Request{}),
+			responses: []response{
+				{status: http.StatusOK, description: "The result of the query.", content: jsonContent(graphql.Response{})},
+				{status: http.StatusBadRequest, description: "The query is invalid.", content: jsonContent(graphql.Response{})},
+				{status: http.StatusRequestEntityTooLarge, description: "The request is larger than the server accepts.", content: jsonContent(graphql.Response{})},
+				notFound,
+			},
+			handler: s.withDocument(s.graphql),
+		},
+		{
+			method: "GET", path: "/packages", operationID: "queryPackages",
+			summary: "Query the packages of all documents",
+			params:  []parameter{purlParameter, vulnParameter},
+			responses: []response{
+				{status: http.StatusOK, description: "The matching packages.", content: jsonContent([]PackageMatch(nil))},
+			},
+			handler: s.packages,
+		},
+		{
+			method: "POST", path: "/validate", operationID: "validate",
+			summary: "Validate a document without storing it",
+			body:    documentContent("An SPDX 3.0 JSON-LD document."),
+			responses: []response{
+				{status: http.StatusOK, description: "The constraint violations of the document.", content: jsonContent(Validation{})},
+				errorResponse(http.StatusBadRequest, "The document cannot be parsed."),
+				errorResponse(http.StatusRequestEntityTooLarge, "The document is larger than the server accepts."),
+			},
+			handler: s.validate,
+		},
+		{
+			method: "GET", path: "/openapi.json", operationID: "getOpenAPI",
+			summary: "Retrieve this specification",
+			responses: []response{
+				{status: http.StatusOK, description: "The OpenAPI 3 specification of the API.", content: &content{mediaType: "application/json", value: map[string]interface{}(nil)}},
+			},
+			handler: s.openAPI,
+		},
+	}
+}
+
+func (s *Server) openAPI(w http.ResponseWriter, r *http.Request) {
+	data, err := OpenAPI()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// OpenAPI returns the OpenAPI 3 specification of the HTTP API of a Server,
+// as JSON, for generating clients. The operations and their parameters are
+// those the server routes, and the schemas are derived from the Go types
+// of the request and response bodies. docs/openapi.json holds a copy,
+// written by go generate.
+func OpenAPI() ([]byte, error) {
+	g := &openAPIGenerator{schemas: make(map[string]interface{})}
+	paths := make(map[string]map[string]interface{})
+	for _, ep := range (&Server{}).endpoints() {
+		if paths[ep.path] == nil {
+			paths[ep.path] = make(map[string]interface{})
+		}
+		paths[ep.path][strings.ToLower(ep.method)] = g.operation(ep)
+	}
+	spec := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "SPDX SBOM service",
+			"description": "Stores, validates and queries SPDX 3.0 documents.",
+			"version":     "1.0.0",
+		},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": g.schemas},
+	}
+	data, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// openAPIGenerator collects the schemas of the Go types of an API.
+type openAPIGenerator struct {
+	schemas map[string]interface{}
+}
+
+func (g *openAPIGenerator) operation(ep endpoint) map[string]interface{} {
+	op := map[string]interface{}{
+		"operationId": ep.operationID,
+		"summary":     ep.summary,
+	}
+	if len(ep.params) > 0 {
+		var params []interface{}
+		for _, p := range ep.params {
+			schema := map[string]interface{}{"type": "string"}
+			if p.repeated {
+				schema = map[string]interface{}{"type": "array", "items": schema}
+			}
+			param := map[string]interface{}{
+				"name":        p.name,
+				"in":          p.in,
+				"description": p.description,
+				"schema":      schema,
+			}
+			if p.required {
+				param["required"] = true
+			}
+			params = append(params, param)
+		}
+		op["parameters"] = params
+	}
+	if ep.body != nil {
+		body := map[string]interface{}{"required": true, "content": g.content(ep.body)}
+		if ep.body.description != "" {
+			body["description"] = ep.body.description
+		}
+		op["requestBody"] = body
+	}
+	responses := make(map[string]interface{})
+	for _, r := range ep.responses {
+		resp := map[string]interface{}{"description": r.description}
+		if r.content != nil {
+			resp["content"] = g.content(r.content)
+		}
+		responses[strconv.Itoa(r.status)] = resp
+	}
+	op["responses"] = responses
+	return op
+}
+
+func (g *openAPIGenerator) content(c *content) map[string]interface{} {
+	return map[string]interface{}{
+		c.mediaType: map[string]interface{}{"schema": g.schema(reflect.TypeOf(c.value))},
+	}
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage(nil))
+	documentType   = reflect.TypeOf(spdxDocument(nil))
+)
+
+// enums are the values of the string types with a known set of values.
+var enums = map[reflect.Type][]string{
+	reflect.TypeOf(security.VEXStatus("")): {
+		string(security.VEXStatusUnderInvestigation),
+		string(security.VEXStatusAffected),
+		string(security.VEXStatusFixed),
+		string(security.VEXStatusNotAffected),
+	},
+}
+
+// schema returns the schema of values of a Go type as encoding/json
+// encodes them. Named struct types are added to the component schemas and
+// referred to by name, qualified with their package name unless they are
+// of this package.
+func (g *openAPIGenerator) schema(t reflect.Type) map[string]interface{} {
+	switch t {
+	case timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case rawMessageType:
+		return map[string]interface{}{}
+	case documentType:
+		return map[string]interface{}{"type": "object", "description": "An SPDX 3.0 JSON-LD document."}
+	}
+	if values, ok := enums[t]; ok {
+		return map[string]interface{}{"type": "string", "enum": values}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return g.schema(t.Elem())
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": g.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": g.schema(t.Elem())}
+	case reflect.Struct:
+		name := g.schemaName(t)
+		if _, ok := g.schemas[name]; !ok {
+			g.schemas[name] = nil // placeholder for recursive types
+			g.schemas[name] = g.structSchema(t)
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]interface{}{}
+}
+
+func (g *openAPIGenerator) schemaName(t reflect.Type) string {
+	name := t.Name()
+	if t.PkgPath() == reflect.TypeOf(Summary{}).PkgPath() {
+		if name == "apiError" {
+			return "Error"
+		}
+		return name
+	}
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	return pkg + "." + name
+}
+
+// structSchema returns the object schema of a struct type, with a property
+// per encoded field. Fields without omitempty or omitzero are required.
+func (g *openAPIGenerator) structSchema(t reflect.Type) map[string]interface{} {
+	props := make(map[string]interface{})
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		props[name] = g.schema(f.Type)
+		if !strings.Contains(opts, "omitempty") && !strings.Contains(opts, "omitzero") {
+			required = append(required, name)
+		}
+	}
+	schema := map[string]interface{}{"type": "object", "properties": props}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
@@ -0,0 +1,101 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/server"
+)
+
+func TestOpenAPI(t *testing.T) {
+	data, err := server.OpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	docs, err := os.ReadFile("../docs/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, docs) {
+		t.Error("docs/openapi.json is out of date; run go generate ./server")
+	}
+
+	var spec struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string                     `json:"operationId"`
+			Responses   map[string]json.RawMessage `json:"responses"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+	if spec.OpenAPI != "3.0.3" {
+		t.Errorf("openapi = %q", spec.OpenAPI)
+	}
+
+	for path, methods := range map[string][]string{
+		"/documents":                 {"get", "post"},
+		"/documents/{id}":            {"delete", "get"},
+		"/documents/{id}/subgraph":   {"get"},
+		"/documents/{id}/graphql":    {"get", "post"},
+		"/documents/{id}/vex":        {"get"},
+		"/packages":                  {"get"},
+		"/validate":                  {"post"},
+		"/openapi.json":              {"get"},
+		"/documents/{id}/validation": {"get"},
+		"/documents/{id}/diff":       {"get"},
+		"/documents/{id}/packages":   {"get"},
+	} {
+		for _, method := range methods {
+			op, ok := spec.Paths[path][method]
+			if !ok {
+				t.Errorf("%s %s is missing", method, path)
+				continue
+			}
+			if op.OperationID == "" || len(op.Responses) == 0 {
+				t.Errorf("%s %s = %+v, want an operation ID and responses", method, path, op)
+			}
+		}
+	}
+
+	// Every schema referred to is defined.
+	for _, ref := range strings.Split(string(data), `"$ref": "#/components/schemas/`)[1:] {
+		name := ref[:strings.IndexByte(ref, '"')]
+		if _, ok := spec.Components.Schemas[name]; !ok {
+			t.Errorf("schema %q is referred to but not defined", name)
+		}
+	}
+	for _, name := range []string{"Summary", "PackageMatch", "VEXResolution", "Error", "graphql.Response"} {
+		if _, ok := spec.Components.Schemas[name]; !ok {
+			t.Errorf("schema %q is missing", name)
+		}
+	}
+
+	var summary struct {
+		Required   []string                   `json:"required"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	if err := json.Unmarshal(spec.Components.Schemas["Summary"], &summary); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(summary.Required, ",") != "id,uploaded,size,packages,files,relationships,vulnerabilities" {
+		t.Errorf("Summary required = %v", summary.Required)
+	}
+	if got := string(summary.Properties["created"]); !strings.Contains(got, `"format":"date-time"`) && !strings.Contains(got, `"format": "date-time"`) {
+		t.Errorf("Summary created = %s, want a date-time", got)
+	}
+
+	rec := do(t, server.NewServer(), "GET", "/openapi.json", nil)
+	if rec.Code != http.StatusOK || !bytes.Equal(rec.Body.Bytes(), data) {
+		t.Errorf("GET /openapi.json = %d, want the specification", rec.Code)
+	}
+}
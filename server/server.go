@@ -0,0 +1,543 @@
+// Package server provides an HTTP service for SPDX 3.0 documents: clients
+// upload documents, validate them, query their packages by package URL or
+// vulnerability, and retrieve subgraphs of them.
+//
+//	srv := server.NewServer()
+//	log.Fatal(http.ListenAndServe(":8080", srv))
+//
+// Documents are kept in memory, identified by the SHA-256 digest of their
+// content, and all responses are JSON. The endpoints are:
+//
+//	POST   /documents                   upload a document
+//	GET    /documents                   list the documents
+//	GET    /documents/{id}              summarize a document
+//	DELETE /documents/{id}              remove a document
+//	GET    /documents/{id}/validation   validate a document
+//	GET    /documents/{id}/packages     query the packages of a document
+//	GET    /documents/{id}/subgraph     retrieve a subgraph as SPDX JSON-LD
+//	GET    /documents/{id}/diff?to={id} compare the packages of two documents
+//	GET    /documents/{id}/vex          resolve the VEX status of products
+//	GET    /documents/{id}/graphql      query a document with GraphQL
+//	POST   /documents/{id}/graphql      query a document with GraphQL
+//	GET    /packages                    query the packages of all documents
+//	POST   /validate                    validate a document without storing it
+//	GET    /openapi.json                describe the API in OpenAPI 3
+//
+// Packages are queried with the purl parameter, a package URL that matches
+// any version of the package if it has none, and the vuln parameter, an ID
+// or alias of a vulnerability affecting them. The vex endpoint takes the
+// vuln parameter and, optionally, a product SPDX ID or package URL. The
+// graphql endpoints serve the API of the graphql package. OpenAPI returns
+// the specification served at /openapi.json, for generating clients in
+// other languages.
+//
+// The operations behind the endpoints, such as Ingest, Query, Diff and
+// ResolveVEX, are methods of the Server as well, for use by other
+// transports. docs/sbom_service.proto defines them as a gRPC service, which
+// the server/grpc package serves.
+package server
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/graphql"
+	"github.com/interlynk-io/spdx-zen/internal/purl"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultMaxDocumentSize is the largest document accepted, in bytes.
+const DefaultMaxDocumentSize = 32 << 20
+
+// Option configures a Server.
+type Option interface {
+	apply(*Server)
+}
+
+type optionFunc func(*Server)
+
+func (f optionFunc) apply(s *Server) { f(s) }
+
+// WithReader sets the reader that parses uploaded documents. The default is
+// parse.NewReader().
+func WithReader(r *parse.Reader) Option {
+	return optionFunc(func(s *Server) {
+		s.reader = r
+	})
+}
+
+// WithMaxDocumentSize sets the largest document accepted, in bytes.
+func WithMaxDocumentSize(n int64) Option {
+	return optionFunc(func(s *Server) {
+		if n > 0 {
+			s.maxSize = n
+		}
+	})
+}
+
+// WithLogger sets the logger the server reports to: rejected uploads as
+// warnings, and stored documents and validation problems at debug level.
+// Unless WithReader is given, the default reader logs to it as well. By
+// default nothing is logged.
+func WithLogger(logger *slog.Logger) Option {
+	return optionFunc(func(s *Server) {
+		s.logger = logger
+	})
+}
+
+// Server is an http.Handler serving the SBOM API. It is safe for
+// concurrent use.
+type Server struct {
+	reader  *parse.Reader
+	maxSize int64
+	mux     *http.ServeMux
+	now     func() time.Time
+	logger  *slog.Logger
+
+	mu   sync.RWMutex
+	docs map[string]*entry
+}
+
+// entry is a stored document.
+type entry struct {
+	id       string
+	doc      *parse.Document
+	size     int
+	uploaded time.Time
+}
+
+// NewServer creates a server with no documents and the given options.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		maxSize: DefaultMaxDocumentSize,
+		now:     time.Now,
+		docs:    make(map[string]*entry),
+		logger:  slog.New(slog.DiscardHandler),
+	}
+	for _, opt := range opts {
+		opt.apply(s)
+	}
+	if s.reader == nil {
+		s.reader = parse.NewReader(parse.WithLogger(s.logger))
+	}
+
+	s.mux = http.NewServeMux()
+	for _, ep := range s.endpoints() {
+		s.mux.HandleFunc(ep.method+" "+ep.path, ep.handler)
+	}
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Add stores a parsed document, as if uploaded with the given content, and
+// returns its ID.
+func (s *Server) Add(doc *parse.Document, data []byte) string {
+	e, _ := s.store(doc, data)
+	return e.id
+}
+
+// store stores a document unless one with the same content is stored, and
+// returns the stored entry and whether it was added.
+func (s *Server) store(doc *parse.Document, data []byte) (*entry, bool) {
+	sum := sha256.Sum256(data)
+	id := hex.EncodeToString(sum[:])
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.docs[id]; ok {
+		return e, false
+	}
+	e := &entry{id: id, doc: doc, size: len(data), uploaded: s.now().UTC()}
+	s.docs[id] = e
+	s.logger.Debug("stored document", "id", id, "size", len(data), "elements", len(doc.ElementsByID))
+	return e, true
+}
+
+// Document returns the stored document with the given ID, or nil.
+func (s *Server) Document(id string) *parse.Document {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if e, ok := s.docs[id]; ok {
+		return e.doc
+	}
+	return nil
+}
+
+// Summary describes a stored document.
+type Summary struct {
+	ID              string                       `json:"id"`
+	SpdxID          string                       `json:"spdxId,omitempty"`
+	Name            string                       `json:"name,omitempty"`
+	Created         time.Time                    `json:"created,omitzero"`
+	Uploaded        time.Time                    `json:"uploaded"`
+	Size            int                          `json:"size"`
+	Profiles        []spdx.ProfileIdentifierType `json:"profiles,omitempty"`
+	Packages        int                          `json:"packages"`
+	Files           int                          `json:"files"`
+	Relationships   int                          `json:"relationships"`
+	Vulnerabilities int                          `json:"vulnerabilities"`
+}
+
+func (e *entry) summary() *Summary {
+	doc := e.doc
+	sum := &Summary{
+		ID:              e.id,
+		SpdxID:          doc.GetSpdxID(),
+		Name:            doc.GetName(),
+		Uploaded:        e.uploaded,
+		Size:            e.size,
+		Profiles:        doc.GetProfiles(),
+		Packages:        len(doc.Packages),
+		Files:           len(doc.Files),
+		Relationships:   len(doc.Relationships) + len(doc.LifecycleScopedRelationships),
+		Vulnerabilities: len(doc.Vulnerabilities),
+	}
+	if doc.SpdxDocument != nil {
+		sum.Created = doc.SpdxDocument.CreationInfo.Created
+	}
+	return sum
+}
+
+// readBody reads and parses the document in the request body, writing an
+// error response and returning nil if it cannot.
+func (s *Server) readBody(w http.ResponseWriter, r *http.Request) (*parse.Document, []byte) {
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.maxSize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("document larger than %d bytes", tooLarge.Limit))
+			return nil, nil
+		}
+		writeError(w, http.StatusBadRequest, fmt.Errorf("reading document: %w", err))
+		return nil, nil
+	}
+	doc, err := s.reader.Read(data)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return nil, nil
+	}
+	return doc, data
+}
+
+func (s *Server) upload(w http.ResponseWriter, r *http.Request) {
+	sum, added, err := s.Ingest(r.Context(), r.Body)
+	if err != nil {
+		s.logger.Warn("rejected upload", "error", err)
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	status := http.StatusOK
+	if added {
+		status = http.StatusCreated
+	}
+	w.Header().Set("Location", "/documents/"+sum.ID)
+	writeJSON(w, status, sum)
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	sums := make([]*Summary, 0, len(s.docs))
+	for _, e := range s.docs {
+		sums = append(sums, e.summary())
+	}
+	s.mu.RUnlock()
+	slices.SortFunc(sums, func(a, b *Summary) int {
+		return cmp.Or(a.Uploaded.Compare(b.Uploaded), strings.Compare(a.ID, b.ID))
+	})
+	writeJSON(w, http.StatusOK, sums)
+}
+
+// withDocument adapts a handler of a stored document, responding with 404
+// Not Found if there is none with the ID in the path.
+func (s *Server) withDocument(h func(http.ResponseWriter, *http.Request, *entry)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.RLock()
+		e, ok := s.docs[r.PathValue("id")]
+		s.mu.RUnlock()
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Errorf("%w: no document %q", ErrNotFound, r.PathValue("id")))
+			return
+		}
+		h(w, r, e)
+	}
+}
+
+func (s *Server) summary(w http.ResponseWriter, r *http.Request, e *entry) {
+	writeJSON(w, http.StatusOK, e.summary())
+}
+
+func (s *Server) graphql(w http.ResponseWriter, r *http.Request, e *entry) {
+	graphql.NewHandler(e.doc, graphql.WithMaxRequestSize(s.maxSize)).ServeHTTP(w, r)
+}
+
+func (s *Server) remove(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	s.mu.Lock()
+	_, ok := s.docs[id]
+	delete(s.docs, id)
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%w: no document %q", ErrNotFound, id))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Validation is the result of validating a document against the
+// constraints of the SPDX model.
+type Validation struct {
+	Valid  bool      `json:"valid"`
+	Errors []Problem `json:"errors"`
+}
+
+// Problem is a constraint violation of an element.
+type Problem struct {
+	Element  string `json:"element"`
+	Type     string `json:"type,omitempty"`
+	Property string `json:"property,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Validate validates every element of doc with its generated Validate
+// method.
+func Validate(doc *parse.Document) *Validation {
+	return ValidateWithProgress(doc, nil)
+}
+
+// ValidateWithProgress is Validate reporting its progress through the
+// elements of doc to progress, if not nil, in the parse.PhaseValidate
+// phase.
+func ValidateWithProgress(doc *parse.Document, progress parse.ProgressFunc) *Validation {
+	total := 0
+	if progress != nil {
+		for range doc.AllElements() {
+			total++
+		}
+		progress(parse.Progress{Phase: parse.PhaseValidate, Total: total})
+	}
+	v := &Validation{Errors: []Problem{}}
+	done := 0
+	for elem := range doc.AllElements() {
+		done++
+		if progress != nil && done%parse.ProgressStep == 0 {
+			progress(parse.Progress{Phase: parse.PhaseValidate, Done: done, Total: total})
+		}
+		validator, ok := elem.(interface{ Validate() error })
+		if !ok {
+			continue
+		}
+		err := validator.Validate()
+		if err == nil {
+			continue
+		}
+		errs := []error{err}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			errs = joined.Unwrap()
+		}
+		for _, err := range errs {
+			p := Problem{Element: elem.GetSpdxID(), Message: err.Error()}
+			var ve *spdx.ValidationError
+			if errors.As(err, &ve) {
+				p.Type, p.Property, p.Message = ve.Type, ve.Property, ve.Message
+			}
+			v.Errors = append(v.Errors, p)
+		}
+	}
+	v.Valid = len(v.Errors) == 0
+	if progress != nil {
+		progress(parse.Progress{Phase: parse.PhaseValidate, Done: done, Total: done})
+	}
+	return v
+}
+
+func (s *Server) validation(w http.ResponseWriter, r *http.Request, e *entry) {
+	writeJSON(w, http.StatusOK, s.logValidation(e.id, Validate(e.doc)))
+}
+
+func (s *Server) validate(w http.ResponseWriter, r *http.Request) {
+	if doc, _ := s.readBody(w, r); doc != nil {
+		writeJSON(w, http.StatusOK, s.logValidation("", Validate(doc)))
+	}
+}
+
+// logValidation logs the problems of a validated document, identified by
+// its ID if it is stored, and returns the validation.
+func (s *Server) logValidation(id string, v *Validation) *Validation {
+	for _, p := range v.Errors {
+		s.logger.Debug("validation problem", "document", id, "element", p.Element, "type", p.Type, "property", p.Property, "message", p.Message)
+	}
+	s.logger.Debug("validated document", "document", id, "valid", v.Valid, "problems", len(v.Errors))
+	return v
+}
+
+// PackageMatch is a package found by a query.
+type PackageMatch struct {
+	Document string `json:"document"`
+	SpdxID   string `json:"spdxId"`
+	Name     string `json:"name"`
+	Version  string `json:"version,omitempty"`
+	PURL     string `json:"purl,omitempty"`
+
+	// Vulnerability and Status are set for queries by vulnerability: the
+	// SPDX ID of the vulnerability, and the type of the relationship or VEX
+	// assessment linking it to the package: affects, doesNotAffect or
+	// fixedIn.
+	Vulnerability string                `json:"vulnerability,omitempty"`
+	Status        spdx.RelationshipType `json:"status,omitempty"`
+}
+
+// query is a package query.
+type query struct {
+	purl string
+	vuln string
+}
+
+// find returns the packages of the document matching the query.
+func (q query) find(e *entry) []PackageMatch {
+	var matches []PackageMatch
+	add := func(pkg *spdx.Package, vuln string, status spdx.RelationshipType) {
+		if q.purl != "" && !purl.Match(q.purl, pkg.PURL()) {
+			return
+		}
+		m := newMatch(e.id, pkg)
+		m.Vulnerability, m.Status = vuln, status
+		matches = append(matches, m)
+	}
+	if q.vuln != "" {
+		for _, a := range e.doc.GetAffectedPackagesByVulnID(q.vuln) {
+			add(a.Package, a.Vulnerability.SpdxID, a.RelationshipType)
+		}
+		return matches
+	}
+	for _, pkg := range e.doc.Packages {
+		add(pkg, "", "")
+	}
+	return matches
+}
+
+func newMatch(document string, pkg *spdx.Package) PackageMatch {
+	return PackageMatch{
+		Document: document,
+		SpdxID:   pkg.SpdxID,
+		Name:     pkg.Name,
+		Version:  pkg.PackageVersion,
+		PURL:     pkg.PURL(),
+	}
+}
+
+func (s *Server) documentPackages(w http.ResponseWriter, r *http.Request, e *entry) {
+	s.query(w, r, e.id)
+}
+
+func (s *Server) packages(w http.ResponseWriter, r *http.Request) {
+	s.query(w, r)
+}
+
+func (s *Server) query(w http.ResponseWriter, r *http.Request, documents ...string) {
+	matches, err := s.Query(r.Context(), r.URL.Query().Get("purl"), r.URL.Query().Get("vuln"), documents...)
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, matches)
+}
+
+func (s *Server) diff(w http.ResponseWriter, r *http.Request, e *entry) {
+	to := r.URL.Query().Get("to")
+	if to == "" {
+		writeError(w, http.StatusBadRequest, errors.New("missing to parameter"))
+		return
+	}
+	d, err := s.Diff(r.Context(), e.id, to)
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, d)
+}
+
+func (s *Server) vex(w http.ResponseWriter, r *http.Request, e *entry) {
+	resolutions, err := s.ResolveVEX(r.Context(), e.id, r.URL.Query().Get("vuln"), r.URL.Query().Get("product"))
+	if err != nil {
+		writeError(w, errorStatus(err), err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resolutions)
+}
+
+func (s *Server) subgraph(w http.ResponseWriter, r *http.Request, e *entry) {
+	root := r.URL.Query().Get("element")
+	if root == "" {
+		writeError(w, http.StatusBadRequest, errors.New("missing element parameter"))
+		return
+	}
+	depth := -1
+	if d := r.URL.Query().Get("depth"); d != "" {
+		n, err := strconv.Atoi(d)
+		if err != nil || n < 0 {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid depth %q", d))
+			return
+		}
+		depth = n
+	}
+	var types []spdx.RelationshipType
+	for _, t := range r.URL.Query()["type"] {
+		relType := spdx.RelationshipType(t)
+		if !relType.IsValid() {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid relationship type %q", t))
+			return
+		}
+		types = append(types, relType)
+	}
+
+	data, err := Subgraph(e.doc, root, depth, types...)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, errNoElement) {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/spdx+json")
+	w.Write(data)
+}
+
+// errorStatus returns the HTTP status of an error of an operation.
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrInvalidArgument):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, apiError{Error: err.Error()})
+}
@@ -0,0 +1,239 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/server"
+)
+
+func do(t *testing.T, h http.Handler, method, target string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(method, target, bytes.NewReader(body)))
+	return rec
+}
+
+func decode[T any](t *testing.T, rec *httptest.ResponseRecorder) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(rec.Body.Bytes(), &v); err != nil {
+		t.Fatalf("decoding %s: %v", rec.Body, err)
+	}
+	return v
+}
+
+func TestServer_Documents(t *testing.T) {
+	srv := server.NewServer()
+	data := testsbom.JSON(t, "1.0.0")
+
+	rec := do(t, srv, "POST", "/documents", data)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("upload: %d %s", rec.Code, rec.Body)
+	}
+	sum := decode[server.Summary](t, rec)
+	if sum.Name != "app" || sum.Packages != 4 || sum.Vulnerabilities != 1 || rec.Header().Get("Location") != "/documents/"+sum.ID {
+		t.Errorf("summary = %+v, location %q", sum, rec.Header().Get("Location"))
+	}
+	if srv.Document(sum.ID) == nil {
+		t.Error("document not stored")
+	}
+	if rec := do(t, srv, "POST", "/documents", data); rec.Code != http.StatusOK {
+		t.Errorf("upload again: %d, want 200", rec.Code)
+	}
+
+	if sums := decode[[]server.Summary](t, do(t, srv, "GET", "/documents", nil)); len(sums) != 1 || sums[0].ID != sum.ID {
+		t.Errorf("list = %+v", sums)
+	}
+	if rec := do(t, srv, "GET", "/documents/"+sum.ID, nil); rec.Code != http.StatusOK {
+		t.Errorf("get: %d", rec.Code)
+	}
+	if rec := do(t, srv, "DELETE", "/documents/"+sum.ID, nil); rec.Code != http.StatusNoContent {
+		t.Errorf("delete: %d", rec.Code)
+	}
+	if rec := do(t, srv, "GET", "/documents/"+sum.ID, nil); rec.Code != http.StatusNotFound {
+		t.Errorf("get after delete: %d, want 404", rec.Code)
+	}
+
+	if rec := do(t, srv, "POST", "/documents", []byte("{not json")); rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `"error"`) {
+		t.Errorf("upload of invalid JSON: %d %s", rec.Code, rec.Body)
+	}
+	small := server.NewServer(server.WithMaxDocumentSize(16))
+	if rec := do(t, small, "POST", "/documents", data); rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("upload of large document: %d, want 413", rec.Code)
+	}
+}
+
+func TestServer_Validate(t *testing.T) {
+	srv := server.NewServer()
+	if v := decode[server.Validation](t, do(t, srv, "POST", "/validate", testsbom.JSON(t, "1.0.0"))); !v.Valid || len(v.Errors) != 0 {
+		t.Errorf("validation = %+v, want valid", v)
+	}
+
+	doc, err := parse.NewReader().Read(testsbom.JSON(t, "1.0.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.Relationships[0].RelationshipType = "dependsOnEverything"
+	v := server.Validate(doc)
+	if v.Valid || len(v.Errors) != 1 {
+		t.Fatalf("validation = %+v, want one error", v)
+	}
+	if p := v.Errors[0]; p.Element != doc.Relationships[0].SpdxID || p.Type != "Relationship" || p.Property != "relationshipType" {
+		t.Errorf("problem = %+v", p)
+	}
+
+	var reports []parse.Progress
+	server.ValidateWithProgress(doc, func(p parse.Progress) { reports = append(reports, p) })
+	if n := len(reports); n != 2 || reports[0].Done != 0 || reports[1].Done != reports[1].Total || reports[1].Total == 0 {
+		t.Errorf("progress = %+v, want a start and an end", reports)
+	}
+}
+
+func TestServer_WithLogger(t *testing.T) {
+	var buf bytes.Buffer
+	srv := server.NewServer(server.WithLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+	id := decode[server.Summary](t, do(t, srv, "POST", "/documents", testsbom.JSON(t, "1.0.0"))).ID
+	do(t, srv, "GET", "/documents/"+id+"/validation", nil)
+	do(t, srv, "POST", "/documents", []byte("{not json"))
+
+	logged := buf.String()
+	for _, want := range []string{
+		`level=DEBUG msg="stored document" id=` + id,
+		`level=DEBUG msg="read SPDX document"`,
+		`level=DEBUG msg="validated document" document=` + id + ` valid=true problems=0`,
+		`level=WARN msg="rejected upload"`,
+	} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log lacks %s:\n%s", want, logged)
+		}
+	}
+}
+
+func TestServer_Packages(t *testing.T) {
+	srv := server.NewServer()
+	id := decode[server.Summary](t, do(t, srv, "POST", "/documents", testsbom.JSON(t, "1.0.0"))).ID
+
+	tests := []struct {
+		target string
+		want   []string
+	}{
+		{"/packages?purl=pkg:npm/lib", []string{"lib"}},
+		{"/packages?purl=pkg:npm/lib@2.1.0", []string{"lib"}},
+		{"/packages?purl=pkg:NPM/lib@2.1.0", []string{"lib"}},
+		{"/packages?purl=pkg:npm/lib@1.0.0", nil},
+		{"/packages?vuln=cve-2024-1234", []string{"leaf"}},
+		{"/packages?vuln=CVE-2024-1234&purl=pkg:npm/lib", nil},
+		{"/documents/" + id + "/packages?purl=pkg:golang/acme.example/app", []string{"app"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			rec := do(t, srv, "GET", tt.target, nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%d %s", rec.Code, rec.Body)
+			}
+			var got []string
+			for _, m := range decode[[]server.PackageMatch](t, rec) {
+				if m.Document != id {
+					t.Errorf("match in document %q, want %q", m.Document, id)
+				}
+				got = append(got, m.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("packages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if rec := do(t, srv, "GET", "/packages", nil); rec.Code != http.StatusBadRequest {
+		t.Errorf("query without parameters: %d, want 400", rec.Code)
+	}
+}
+
+func TestServer_Subgraph(t *testing.T) {
+	srv := server.NewServer()
+	id := decode[server.Summary](t, do(t, srv, "POST", "/documents", testsbom.JSON(t, "1.0.0"))).ID
+	doc := srv.Document(id)
+	app := doc.GetPackageByName("app")[0]
+	lib := doc.GetPackageByName("lib")[0]
+
+	tests := []struct {
+		root          *spdx.Package
+		query         string
+		want          []string
+		relationships int
+	}{
+		{app, "", []string{"app", "lib", "mock", "leaf"}, 3},
+		{app, "depth=1", []string{"app", "lib", "mock"}, 1},
+		{lib, "depth=1", []string{"lib", "leaf"}, 2},
+		{lib, "depth=0", []string{"lib"}, 0},
+		{app, "type=contains", []string{"app"}, 0},
+		{app, "type=contains&type=dependsOn", []string{"app", "lib", "mock", "leaf"}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.root.Name+"?"+tt.query, func(t *testing.T) {
+			rec := do(t, srv, "GET", "/documents/"+id+"/subgraph?element="+url.QueryEscape(tt.root.SpdxID)+"&"+tt.query, nil)
+			if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/spdx+json" {
+				t.Fatalf("%d %q %s", rec.Code, rec.Header().Get("Content-Type"), rec.Body)
+			}
+			sub, err := parse.NewReader().Read(rec.Body.Bytes())
+			if err != nil {
+				t.Fatalf("reading subgraph: %v", err)
+			}
+			var got []string
+			for _, pkg := range sub.Packages {
+				got = append(got, pkg.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("packages = %v, want %v", got, tt.want)
+			}
+			if len(sub.Relationships) != tt.relationships {
+				t.Errorf("relationships = %d, want %d", len(sub.Relationships), tt.relationships)
+			}
+			if len(sub.SpdxDocument.RootElement) != 1 || sub.SpdxDocument.RootElement[0].SpdxID != tt.root.SpdxID {
+				t.Errorf("root elements = %v", sub.SpdxDocument.RootElement)
+			}
+		})
+	}
+
+	for target, code := range map[string]int{
+		"/documents/" + id + "/subgraph":                                     http.StatusBadRequest,
+		"/documents/" + id + "/subgraph?element=urn:missing":                 http.StatusNotFound,
+		"/documents/" + id + "/subgraph?element=x&depth=-2":                  http.StatusBadRequest,
+		"/documents/" + id + "/subgraph?element=x&type=likes":                http.StatusBadRequest,
+		"/documents/unknown/subgraph?element=" + url.QueryEscape(lib.SpdxID): http.StatusNotFound,
+	} {
+		if rec := do(t, srv, "GET", target, nil); rec.Code != code {
+			t.Errorf("%s: %d, want %d", target, rec.Code, code)
+		}
+	}
+}
+
+func TestServer_GraphQL(t *testing.T) {
+	srv := server.NewServer()
+	id := decode[server.Summary](t, do(t, srv, "POST", "/documents", testsbom.JSON(t, "1.0.0"))).ID
+
+	rec := do(t, srv, "POST", "/documents/"+id+"/graphql", []byte(`{"query":"{ packages(name: \"lib\") { dependents { name } } }"}`))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("%d %s", rec.Code, rec.Body)
+	}
+	if got, want := strings.TrimSpace(rec.Body.String()), `{"data":{"packages":[{"dependents":[{"name":"app"}]}]}}`; got != want {
+		t.Errorf("body = %s, want %s", got, want)
+	}
+
+	if rec := do(t, srv, "GET", "/documents/"+id+"/graphql?query="+url.QueryEscape("{ document { name } }"), nil); rec.Code != http.StatusOK {
+		t.Errorf("GET: %d %s", rec.Code, rec.Body)
+	}
+	if rec := do(t, srv, "GET", "/documents/unknown/graphql?query="+url.QueryEscape("{ document { name } }"), nil); rec.Code != http.StatusNotFound {
+		t.Errorf("unknown document: %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
@@ -0,0 +1,271 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/purl"
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/security"
+)
+
+// Errors returned by the operations of a Server, wrapped with details. The
+// HTTP API responds to them with 404 Not Found, 400 Bad Request and 413
+// Request Entity Too Large, and the gRPC API with the NOT_FOUND,
+// INVALID_ARGUMENT and RESOURCE_EXHAUSTED codes.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrTooLarge        = errors.New("document too large")
+)
+
+// The methods below are the operations of the SBOMService of
+// docs/sbom_service.proto. They are independent of the transport: the HTTP
+// API of the Server calls them, and so does the gRPC API of the server/grpc
+// package.
+
+// Ingest reads, parses and stores the document read from r, and returns its
+// summary and whether it was added rather than stored already.
+func (s *Server) Ingest(ctx context.Context, r io.Reader) (*Summary, bool, error) {
+	data, err := io.ReadAll(io.LimitReader(r, s.maxSize+1))
+	if err != nil {
+		return nil, false, fmt.Errorf("reading document: %w", err)
+	}
+	if int64(len(data)) > s.maxSize {
+		return nil, false, fmt.Errorf("%w: larger than %d bytes", ErrTooLarge, s.maxSize)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+	doc, err := s.reader.Read(data)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	e, added := s.store(doc, data)
+	return e.summary(), added, nil
+}
+
+// Query returns the packages matching the package URL purl, which matches
+// any version of the package if it has none, and affected, not affected or
+// fixed by the vulnerability with the ID or alias vuln. Either may be
+// empty, but not both. The packages of the documents with the given IDs
+// are searched, or of all documents if none are given.
+func (s *Server) Query(ctx context.Context, purl, vuln string, documents ...string) ([]PackageMatch, error) {
+	if purl == "" && vuln == "" {
+		return nil, fmt.Errorf("%w: missing package URL or vulnerability", ErrInvalidArgument)
+	}
+	entries, err := s.entries(documents...)
+	if err != nil {
+		return nil, err
+	}
+	q := query{purl: purl, vuln: vuln}
+	matches := []PackageMatch{}
+	for _, e := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		matches = append(matches, q.find(e)...)
+	}
+	return matches, nil
+}
+
+// entries returns the stored documents with the given IDs, or all of them
+// ordered by ID.
+func (s *Server) entries(ids ...string) ([]*entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if len(ids) == 0 {
+		entries := make([]*entry, 0, len(s.docs))
+		for _, e := range s.docs {
+			entries = append(entries, e)
+		}
+		slices.SortFunc(entries, func(a, b *entry) int { return strings.Compare(a.id, b.id) })
+		return entries, nil
+	}
+	entries := make([]*entry, 0, len(ids))
+	for _, id := range ids {
+		e, ok := s.docs[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: no document %q", ErrNotFound, id)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// DocumentDiff is the difference between the packages of two documents.
+type DocumentDiff struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	// Added are the packages only the second document has, and Removed
+	// those only the first has.
+	Added   []PackageMatch `json:"added"`
+	Removed []PackageMatch `json:"removed"`
+
+	// Changed are the packages both documents have in a single, different
+	// version.
+	Changed []PackageChange `json:"changed"`
+}
+
+// PackageChange is a package whose version differs between two documents.
+type PackageChange struct {
+	Name        string `json:"name"`
+	FromVersion string `json:"fromVersion"`
+	ToVersion   string `json:"toVersion"`
+	FromPURL    string `json:"fromPurl,omitempty"`
+	ToPURL      string `json:"toPurl,omitempty"`
+
+	// Change is ChangeUpgrade or ChangeDowngrade, as the versions compare
+	// in the ecosystem of the package, or empty if they are equivalent,
+	// as "1.0" and "1.0.0" are.
+	Change string `json:"change,omitempty"`
+}
+
+// Kinds of PackageChange.
+const (
+	ChangeUpgrade   = "upgrade"
+	ChangeDowngrade = "downgrade"
+)
+
+// Diff compares the packages of the stored documents from and to. Packages
+// are the same if they have the same package URL, ignoring the version,
+// qualifiers and subpath, or if neither has one, the same name.
+func (s *Server) Diff(ctx context.Context, from, to string) (*DocumentDiff, error) {
+	entries, err := s.entries(from, to)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(entries[0].id, entries[0].doc, entries[1].id, entries[1].doc), nil
+}
+
+// Diff compares the packages of two documents, identified in the result by
+// fromID and toID, as Server.Diff does.
+func Diff(fromID string, from *parse.Document, toID string, to *parse.Document) *DocumentDiff {
+	d := &DocumentDiff{From: fromID, To: toID, Added: []PackageMatch{}, Removed: []PackageMatch{}, Changed: []PackageChange{}}
+	old, cur := packagesByKey(from), packagesByKey(to)
+	keys := make([]string, 0, len(old)+len(cur))
+	for key := range old {
+		keys = append(keys, key)
+	}
+	for key := range cur {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		a, b := old[key], cur[key]
+		if len(a) == 1 && len(b) == 1 {
+			if a[0].PackageVersion != b[0].PackageVersion {
+				change := PackageChange{
+					Name:        b[0].Name,
+					FromVersion: a[0].PackageVersion,
+					ToVersion:   b[0].PackageVersion,
+					FromPURL:    a[0].PURL(),
+					ToPURL:      b[0].PURL(),
+				}
+				switch b[0].CompareVersion(a[0].PackageVersion) {
+				case 1:
+					change.Change = ChangeUpgrade
+				case -1:
+					change.Change = ChangeDowngrade
+				}
+				d.Changed = append(d.Changed, change)
+			}
+			continue
+		}
+		for _, pkg := range a {
+			if !slices.ContainsFunc(b, sameVersion(pkg)) {
+				d.Removed = append(d.Removed, newMatch(fromID, pkg))
+			}
+		}
+		for _, pkg := range b {
+			if !slices.ContainsFunc(a, sameVersion(pkg)) {
+				d.Added = append(d.Added, newMatch(toID, pkg))
+			}
+		}
+	}
+	return d
+}
+
+// packagesByKey groups the packages of a document by package URL without
+// version, qualifiers and subpath, or by name.
+func packagesByKey(doc *parse.Document) map[string][]*spdx.Package {
+	byKey := make(map[string][]*spdx.Package)
+	for _, pkg := range doc.Packages {
+		key := "name:" + pkg.Name
+		if p := pkg.PURL(); p != "" {
+			key = purl.Base(p)
+		}
+		byKey[key] = append(byKey[key], pkg)
+	}
+	return byKey
+}
+
+func sameVersion(pkg *spdx.Package) func(*spdx.Package) bool {
+	return func(other *spdx.Package) bool {
+		return other.PackageVersion == pkg.PackageVersion
+	}
+}
+
+// VEXResolution is the current VEX status of a product for a vulnerability.
+type VEXResolution struct {
+	Document      string             `json:"document"`
+	Vulnerability string             `json:"vulnerability"`
+	Product       string             `json:"product"`
+	Name          string             `json:"name,omitempty"`
+	PURL          string             `json:"purl,omitempty"`
+	Status        security.VEXStatus `json:"status"`
+	Time          time.Time          `json:"time,omitzero"`
+}
+
+// ResolveVEX returns the current VEX status, from the latest assessment
+// that is not withdrawn, of each product of the stored document assessed
+// for the vulnerability with the ID or alias vuln. If product is not empty,
+// only the product with that SPDX ID or package URL is resolved; a package
+// URL without version matches every version. Resolutions are ordered by
+// vulnerability and product.
+func (s *Server) ResolveVEX(ctx context.Context, document, vuln, product string) ([]VEXResolution, error) {
+	if vuln == "" {
+		return nil, fmt.Errorf("%w: missing vulnerability", ErrInvalidArgument)
+	}
+	entries, err := s.entries(document)
+	if err != nil {
+		return nil, err
+	}
+	e := entries[0]
+
+	resolutions := []VEXResolution{}
+	for _, v := range e.doc.GetVulnerabilitiesByIdentifier(vuln) {
+		t := security.VulnerabilityTimeline(e.doc, v.SpdxID)
+		latest := make(map[string]security.StatusTransition)
+		for _, tr := range t.Transitions {
+			latest[tr.Product] = tr
+		}
+		for id, tr := range latest {
+			r := VEXResolution{Document: e.id, Vulnerability: v.SpdxID, Product: id, Status: tr.To, Time: tr.Time}
+			if pkg := e.doc.GetPackageByID(id); pkg != nil {
+				r.Name, r.PURL = pkg.Name, pkg.PURL()
+			} else if elem, ok := e.doc.ElementsByID[id].(spdx.ElementInterface); ok {
+				r.Name = elem.GetName()
+			}
+			if product != "" && product != id && !purl.Match(product, r.PURL) {
+				continue
+			}
+			resolutions = append(resolutions, r)
+		}
+	}
+	slices.SortFunc(resolutions, func(a, b VEXResolution) int {
+		return cmp.Or(strings.Compare(a.Vulnerability, b.Vulnerability), strings.Compare(a.Product, b.Product))
+	})
+	return resolutions, nil
+}
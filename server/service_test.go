@@ -0,0 +1,183 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/security"
+	"github.com/interlynk-io/spdx-zen/server"
+)
+
+func TestServer_Ingest(t *testing.T) {
+	ctx := context.Background()
+	srv := server.NewServer()
+	sum, added, err := srv.Ingest(ctx, bytes.NewReader(testsbom.JSON(t, "1.0.0")))
+	if err != nil || !added || sum.Packages != 4 {
+		t.Fatalf("Ingest = %+v, %v, %v", sum, added, err)
+	}
+	if again, added, err := srv.Ingest(ctx, bytes.NewReader(testsbom.JSON(t, "1.0.0"))); err != nil || added || again.ID != sum.ID {
+		t.Errorf("Ingest again = %+v, %v, %v", again, added, err)
+	}
+
+	tests := []struct {
+		name string
+		srv  *server.Server
+		data string
+		want error
+	}{
+		{"invalid", srv, "{", server.ErrInvalidArgument},
+		{"too large", server.NewServer(server.WithMaxDocumentSize(8)), `{"@graph": []}`, server.ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := tt.srv.Ingest(ctx, strings.NewReader(tt.data)); !errors.Is(err, tt.want) {
+				t.Errorf("Ingest error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_Query(t *testing.T) {
+	ctx := context.Background()
+	srv := server.NewServer()
+	first, _, err := srv.Ingest(ctx, bytes.NewReader(testsbom.JSON(t, "1.0.0")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _, err := srv.Ingest(ctx, bytes.NewReader(testsbom.Upgrade(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		purl      string
+		vuln      string
+		documents []string
+		want      []string
+	}{
+		{"all documents", "pkg:npm/lib", "", nil, []string{"lib 2.1.0", "lib 2.2.0"}},
+		{"one document", "pkg:npm/lib", "", []string{second.ID}, []string{"lib 2.2.0"}},
+		{"vulnerability", "", "CVE-2024-1234", nil, []string{"app 1.1.0", "leaf 0.3.0", "lib 2.2.0", "lib 2.2.0"}},
+		{"vulnerability and purl", "pkg:npm/leaf", "CVE-2024-1234", nil, []string{"leaf 0.3.0"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := srv.Query(ctx, tt.purl, tt.vuln, tt.documents...)
+			if err != nil {
+				t.Fatalf("Query: %v", err)
+			}
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Name+" "+m.Version)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("packages = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := srv.Query(ctx, "", ""); !errors.Is(err, server.ErrInvalidArgument) {
+		t.Errorf("Query without parameters: %v", err)
+	}
+	if _, err := srv.Query(ctx, "pkg:npm/lib", "", first.ID, "unknown"); !errors.Is(err, server.ErrNotFound) {
+		t.Errorf("Query of an unknown document: %v", err)
+	}
+}
+
+func TestServer_Diff(t *testing.T) {
+	ctx := context.Background()
+	srv := server.NewServer()
+	from, _, err := srv.Ingest(ctx, bytes.NewReader(testsbom.JSON(t, "1.0.0")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, _, err := srv.Ingest(ctx, bytes.NewReader(testsbom.Upgrade(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := srv.Diff(ctx, from.ID, to.ID)
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	if len(d.Added) != 1 || d.Added[0].Name != "parser" || d.Added[0].Document != to.ID {
+		t.Errorf("added = %+v", d.Added)
+	}
+	if len(d.Removed) != 2 || d.Removed[0].Name != "leaf" || d.Removed[1].Name != "mock" || d.Removed[0].Document != from.ID {
+		t.Errorf("removed = %+v", d.Removed)
+	}
+	var changed []string
+	for _, c := range d.Changed {
+		changed = append(changed, c.Name+" "+c.FromVersion+" "+c.ToVersion+" "+c.Change)
+	}
+	if strings.Join(changed, ", ") != "app 1.0.0 1.1.0 upgrade, lib 2.1.0 2.2.0 upgrade" {
+		t.Errorf("changed = %v", changed)
+	}
+
+	rec := do(t, srv, "GET", "/documents/"+from.ID+"/diff?to="+to.ID, nil)
+	if rec.Code != http.StatusOK || len(decode[server.DocumentDiff](t, rec).Changed) != 2 {
+		t.Errorf("diff endpoint: %d %s", rec.Code, rec.Body)
+	}
+	if _, err := srv.Diff(ctx, from.ID, "unknown"); !errors.Is(err, server.ErrNotFound) {
+		t.Errorf("Diff with an unknown document: %v", err)
+	}
+	if rec := do(t, srv, "GET", "/documents/"+from.ID+"/diff?to=unknown", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("diff endpoint with an unknown document: %d, want 404", rec.Code)
+	}
+}
+
+func TestServer_ResolveVEX(t *testing.T) {
+	ctx := context.Background()
+	srv := server.NewServer()
+	sum, _, err := srv.Ingest(ctx, bytes.NewReader(testsbom.Upgrade(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		product string
+		want    []string
+	}{
+		{"", []string{"app not_affected", "lib fixed"}},
+		{"pkg:npm/lib", []string{"lib fixed"}},
+		{"pkg:npm/lib@2.2.0", []string{"lib fixed"}},
+		{"pkg:npm/parser", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.product, func(t *testing.T) {
+			rec := do(t, srv, "GET", "/documents/"+sum.ID+"/vex?vuln=CVE-2024-1234&product="+url.QueryEscape(tt.product), nil)
+			if rec.Code != http.StatusOK {
+				t.Fatalf("%d %s", rec.Code, rec.Body)
+			}
+			var got []string
+			for _, r := range decode[[]server.VEXResolution](t, rec) {
+				got = append(got, r.Name+" "+string(r.Status))
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("resolutions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	resolutions, err := srv.ResolveVEX(ctx, sum.ID, "CVE-2024-1234", "pkg:npm/lib")
+	if err != nil || len(resolutions) != 1 {
+		t.Fatalf("ResolveVEX = %v, %v", resolutions, err)
+	}
+	if r := resolutions[0]; r.Status != security.VEXStatusFixed || !r.Time.Equal(time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("resolution = %+v", r)
+	}
+	if _, err := srv.ResolveVEX(ctx, sum.ID, "", ""); !errors.Is(err, server.ErrInvalidArgument) {
+		t.Errorf("ResolveVEX without a vulnerability: %v", err)
+	}
+}
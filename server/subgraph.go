@@ -0,0 +1,136 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+var errNoElement = errors.New("no such element")
+
+// Subgraph returns, as SPDX JSON-LD, a document holding the element of doc
+// with the given ID and what it leads to: the relationships from it, the
+// elements they lead to, and so on, up to depth relationships away, or
+// without limit if depth is negative. If types are given, only
+// relationships of those types are followed. The agents and tools that
+// created the elements are included as well.
+//
+// The new SpdxDocument has the element as its root, is identified by the ID
+// of doc with a "-subgraph" suffix and takes its creation info.
+func Subgraph(doc *parse.Document, id string, depth int, types ...spdx.RelationshipType) ([]byte, error) {
+	byID := make(map[string]spdx.ElementInterface)
+	for elem := range doc.AllElements() {
+		if elem != spdx.ElementInterface(doc.SpdxDocument) {
+			byID[elem.GetSpdxID()] = elem
+		}
+	}
+	root, ok := byID[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", errNoElement, id)
+	}
+
+	from := make(map[string][]*spdx.Relationship)
+	follow := func(elem spdx.ElementInterface, rel *spdx.Relationship) {
+		if len(types) == 0 || slices.Contains(types, rel.RelationshipType) {
+			from[rel.From.SpdxID] = append(from[rel.From.SpdxID], rel)
+			byID[rel.SpdxID] = elem
+		}
+	}
+	for _, rel := range doc.Relationships {
+		follow(rel, rel)
+	}
+	for _, rel := range doc.LifecycleScopedRelationships {
+		follow(rel, &rel.Relationship)
+	}
+
+	included := map[string]bool{id: true}
+	elements := []spdx.ElementInterface{root}
+	include := func(id string) bool {
+		elem, ok := byID[id]
+		if !ok || included[id] {
+			return false
+		}
+		included[id] = true
+		elements = append(elements, elem)
+		return true
+	}
+	frontier := []string{id}
+	for d := 0; len(frontier) > 0 && (depth < 0 || d < depth); d++ {
+		var next []string
+		for _, id := range frontier {
+			for _, rel := range from[id] {
+				include(rel.SpdxID)
+				for _, to := range rel.To {
+					if include(to.SpdxID) {
+						next = append(next, to.SpdxID)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	for i := 0; i < len(elements); i++ {
+		if ci := elements[i].GetCreationInfo(); ci != nil {
+			for _, agent := range ci.CreatedBy {
+				include(agent.SpdxID)
+			}
+			for _, tool := range ci.CreatedUsing {
+				include(tool.SpdxID)
+			}
+		}
+	}
+
+	sd, err := subgraphDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+	sd.RootElement = []spdx.Element{{SpdxID: id}}
+	graph := make([]interface{}, 0, len(elements)+1)
+	graph = append(graph, sd)
+	for _, elem := range elements {
+		sd.Elements = append(sd.Elements, spdx.Element{SpdxID: elem.GetSpdxID()})
+		graph = append(graph, elem)
+	}
+
+	var context interface{} = spdx.ContextURL
+	switch len(doc.Context) {
+	case 0:
+	case 1:
+		context = doc.Context[0]
+	default:
+		context = doc.Context
+	}
+	data, err := json.Marshal(map[string]interface{}{"@context": context, "@graph": graph})
+	if err != nil {
+		return nil, fmt.Errorf("encoding subgraph: %w", err)
+	}
+	return data, nil
+}
+
+// subgraphDocument returns the SpdxDocument of a subgraph of doc, without
+// elements.
+func subgraphDocument(doc *parse.Document) (*spdx.SpdxDocument, error) {
+	var ci *spdx.CreationInfo
+	switch {
+	case doc.SpdxDocument != nil && !doc.SpdxDocument.CreationInfo.Created.IsZero():
+		ci = doc.SpdxDocument.CreationInfo.Copy()
+	case doc.CreationInfo != nil:
+		ci = doc.CreationInfo.Copy()
+	default:
+		return nil, errors.New("document has no creation info")
+	}
+	id := doc.GetSpdxID()
+	if id == "" {
+		id = "urn:spdx:subgraph"
+	} else {
+		id += "-subgraph"
+	}
+	sd := spdx.NewSpdxDocument(id, doc.GetName(), *ci)
+	sd.ProfileConformance = doc.GetProfiles()
+	sd.DataLicense = doc.GetDataLicense()
+	return sd, nil
+}
@@ -0,0 +1,224 @@
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultFulcioURL is the base URL of the public Fulcio instance.
+const DefaultFulcioURL = "https://fulcio.sigstore.dev"
+
+// Option configures a client.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+func newConfig(baseURL string, opts []Option) config {
+	c := config{httpClient: http.DefaultClient, baseURL: baseURL}
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+	return c
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(c *config) {
+		c.httpClient = client
+	})
+}
+
+// WithBaseURL sets the base URL of the service, e.g. for a private
+// instance or a test server.
+func WithBaseURL(baseURL string) Option {
+	return optionFunc(func(c *config) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	})
+}
+
+// FulcioClient obtains signing certificates from a Fulcio certificate
+// authority.
+type FulcioClient struct {
+	config
+}
+
+// NewFulcioClient creates a Fulcio client with the given options.
+func NewFulcioClient(opts ...Option) *FulcioClient {
+	return &FulcioClient{config: newConfig(DefaultFulcioURL, opts)}
+}
+
+type fulcioRequest struct {
+	Credentials struct {
+		OIDCIdentityToken string `json:"oidcIdentityToken"`
+	} `json:"credentials"`
+	PublicKeyRequest struct {
+		PublicKey struct {
+			Algorithm string `json:"algorithm"`
+			Content   string `json:"content"`
+		} `json:"publicKey"`
+		ProofOfPossession []byte `json:"proofOfPossession"`
+	} `json:"publicKeyRequest"`
+}
+
+type fulcioChain struct {
+	Chain struct {
+		Certificates []string `json:"certificates"`
+	} `json:"chain"`
+}
+
+type fulcioResponse struct {
+	SignedCertificateEmbeddedSct *fulcioChain `json:"signedCertificateEmbeddedSct"`
+	SignedCertificateDetachedSct *fulcioChain `json:"signedCertificateDetachedSct"`
+}
+
+// Certificate requests a certificate of the public key of signer for the
+// identity of the OpenID Connect token idToken, and returns it followed by
+// its intermediates.
+func (c *FulcioClient) Certificate(ctx context.Context, signer crypto.Signer, idToken string) ([]*x509.Certificate, error) {
+	subject, err := tokenSubject(idToken)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("encoding public key: %w", err)
+	}
+	// The proof of possession is a signature of the subject of the token.
+	b, err := sign([]byte(subject), signer)
+	if err != nil {
+		return nil, err
+	}
+
+	var req fulcioRequest
+	req.Credentials.OIDCIdentityToken = idToken
+	req.PublicKeyRequest.PublicKey.Algorithm = keyAlgorithm(signer.Public())
+	req.PublicKeyRequest.PublicKey.Content = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pub}))
+	req.PublicKeyRequest.ProofOfPossession = b.MessageSignature.Signature
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("encoding request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/api/v2/signingCert", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("requesting certificate: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("requesting certificate: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	var out fulcioResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("decoding certificate: %w", err)
+	}
+	chain := out.SignedCertificateEmbeddedSct
+	if chain == nil {
+		chain = out.SignedCertificateDetachedSct
+	}
+	if chain == nil || len(chain.Chain.Certificates) == 0 {
+		return nil, errors.New("requesting certificate: no certificate in response")
+	}
+
+	var certs []*x509.Certificate
+	for _, p := range chain.Chain.Certificates {
+		block, _ := pem.Decode([]byte(p))
+		if block == nil {
+			return nil, errors.New("decoding certificate: no PEM certificate")
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("decoding certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// SignKeyless signs data with an ephemeral key, certified by Fulcio for
+// the identity of the OpenID Connect token idToken, and returns a bundle
+// with the certificate. The key is discarded once data is signed.
+func (c *FulcioClient) SignKeyless(ctx context.Context, data []byte, idToken string) (*Bundle, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generating key: %w", err)
+	}
+	certs, err := c.Certificate(ctx, key, idToken)
+	if err != nil {
+		return nil, err
+	}
+	b, err := sign(data, key)
+	if err != nil {
+		return nil, err
+	}
+	b.VerificationMaterial.Certificate = &X509Certificate{RawBytes: certs[0].Raw}
+	return b, nil
+}
+
+// tokenSubject returns the subject of an OpenID Connect token that Fulcio
+// certifies: its email address, or else its subject claim. The token is
+// not verified here, but by Fulcio.
+func tokenSubject(idToken string) (string, error) {
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return "", errors.New("identity token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("decoding identity token: %w", err)
+	}
+	var claims struct {
+		Subject string `json:"sub"`
+		Email   string `json:"email"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("decoding identity token: %w", err)
+	}
+	if claims.Email != "" {
+		return claims.Email, nil
+	}
+	if claims.Subject == "" {
+		return "", errors.New("identity token has no subject")
+	}
+	return claims.Subject, nil
+}
+
+// keyAlgorithm returns the Fulcio name of the algorithm of pub.
+func keyAlgorithm(pub crypto.PublicKey) string {
+	switch pub.(type) {
+	case *ecdsa.PublicKey:
+		return "ECDSA"
+	case *rsa.PublicKey:
+		return "RSA"
+	}
+	return "ED25519"
+}
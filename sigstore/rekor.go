@@ -0,0 +1,414 @@
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// DefaultRekorURL is the base URL of the public Rekor instance.
+const DefaultRekorURL = "https://rekor.sigstore.dev"
+
+// RekorEntryContentType is the content type of the annotations recording
+// log entries in documents: the JSON form of a LogEntry.
+const RekorEntryContentType = "application/vnd.dev.sigstore.rekor.entry+json"
+
+// TlogEntry is an entry in a transparency log, in the JSON form of the
+// protobuf-specs TransparencyLogEntry message that bundles carry.
+type TlogEntry struct {
+	LogIndex          int64             `json:"logIndex,string"`
+	LogID             LogID             `json:"logId"`
+	KindVersion       KindVersion       `json:"kindVersion"`
+	IntegratedTime    int64             `json:"integratedTime,string"`
+	InclusionPromise  *InclusionPromise `json:"inclusionPromise,omitempty"`
+	InclusionProof    *InclusionProof   `json:"inclusionProof,omitempty"`
+	CanonicalizedBody []byte            `json:"canonicalizedBody"`
+}
+
+// LogID identifies a log by the SHA-256 digest of its public key.
+type LogID struct {
+	KeyID []byte `json:"keyId"`
+}
+
+// KindVersion is the type of an entry, e.g. "hashedrekord" "0.0.1".
+type KindVersion struct {
+	Kind    string `json:"kind"`
+	Version string `json:"version"`
+}
+
+// InclusionPromise is the signed entry timestamp of an entry: the promise
+// of the log, signed with its key, to include the entry.
+type InclusionPromise struct {
+	SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+}
+
+// InclusionProof proves that an entry is in the Merkle tree of the log
+// with the root hash RootHash, from the hashes of the sibling subtrees on
+// its path to the root.
+type InclusionProof struct {
+	LogIndex   int64      `json:"logIndex,string"`
+	RootHash   []byte     `json:"rootHash"`
+	TreeSize   int64      `json:"treeSize,string"`
+	Hashes     [][]byte   `json:"hashes"`
+	Checkpoint Checkpoint `json:"checkpoint"`
+}
+
+// Checkpoint is a signed note of the log committing to its size and
+// root hash.
+type Checkpoint struct {
+	Envelope string `json:"envelope"`
+}
+
+// LogEntry is an entry of Rekor with its UUID, by which the log serves
+// it.
+type LogEntry struct {
+	UUID string `json:"uuid"`
+	TlogEntry
+}
+
+// RekorClient records signatures in a Rekor transparency log.
+type RekorClient struct {
+	config
+}
+
+// NewRekorClient creates a Rekor client with the given options.
+func NewRekorClient(opts ...Option) *RekorClient {
+	return &RekorClient{config: newConfig(DefaultRekorURL, opts)}
+}
+
+type hashedRekord struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Spec       hashedRekordSpec `json:"spec"`
+}
+
+type hashedRekordSpec struct {
+	Signature struct {
+		Content   []byte `json:"content"`
+		PublicKey struct {
+			Content []byte `json:"content"`
+		} `json:"publicKey"`
+	} `json:"signature"`
+	Data struct {
+		Hash struct {
+			Algorithm string `json:"algorithm"`
+			Value     string `json:"value"`
+		} `json:"hash"`
+	} `json:"data"`
+}
+
+type rekorEntry struct {
+	Body           []byte `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogID          string `json:"logID"`
+	LogIndex       int64  `json:"logIndex"`
+	Verification   struct {
+		InclusionProof *struct {
+			Checkpoint string   `json:"checkpoint"`
+			Hashes     []string `json:"hashes"`
+			LogIndex   int64    `json:"logIndex"`
+			RootHash   string   `json:"rootHash"`
+			TreeSize   int64    `json:"treeSize"`
+		} `json:"inclusionProof"`
+		SignedEntryTimestamp []byte `json:"signedEntryTimestamp"`
+	} `json:"verification"`
+}
+
+// Upload records the signature of data in b in the log, as a hashedrekord
+// entry, and adds the entry to the bundle. The key of the signature is the
+// certificate of b, for keyless signatures, or else pub.
+func (c *RekorClient) Upload(ctx context.Context, data []byte, b *Bundle, pub crypto.PublicKey) (*LogEntry, error) {
+	if b.MessageSignature == nil {
+		return nil, errors.New("bundle has no message signature")
+	}
+	var key []byte
+	certs, err := b.certificates()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(certs) > 0:
+		key = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certs[0].Raw})
+	case pub != nil:
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			return nil, fmt.Errorf("encoding public key: %w", err)
+		}
+		key = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	default:
+		return nil, errors.New("bundle has no certificate and no public key is given")
+	}
+
+	sum := sha256.Sum256(data)
+	rekord := hashedRekord{APIVersion: "0.0.1", Kind: "hashedrekord"}
+	rekord.Spec.Signature.Content = b.MessageSignature.Signature
+	rekord.Spec.Signature.PublicKey.Content = key
+	rekord.Spec.Data.Hash.Algorithm = "sha256"
+	rekord.Spec.Data.Hash.Value = hex.EncodeToString(sum[:])
+	body, err := json.Marshal(rekord)
+	if err != nil {
+		return nil, fmt.Errorf("encoding entry: %w", err)
+	}
+
+	entry, err := c.do(ctx, http.MethodPost, "/api/v1/log/entries", body)
+	if err != nil {
+		return nil, fmt.Errorf("uploading entry: %w", err)
+	}
+	b.VerificationMaterial.TlogEntries = append(b.VerificationMaterial.TlogEntries, entry.TlogEntry)
+	return entry, nil
+}
+
+// Entry fetches the entry of the log with the given UUID, with a proof of
+// its inclusion in the current tree.
+func (c *RekorClient) Entry(ctx context.Context, uuid string) (*LogEntry, error) {
+	entry, err := c.do(ctx, http.MethodGet, "/api/v1/log/entries/"+url.PathEscape(uuid), nil)
+	if err != nil {
+		return nil, fmt.Errorf("fetching entry %s: %w", uuid, err)
+	}
+	return entry, nil
+}
+
+func (c *RekorClient) do(ctx context.Context, method, path string, body []byte) (*LogEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	var entries map[string]rekorEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("decoding entry: %w", err)
+	}
+	if len(entries) > 1 {
+		return nil, fmt.Errorf("response has %d entries, want 1", len(entries))
+	}
+	for uuid, e := range entries {
+		return e.logEntry(uuid)
+	}
+	return nil, errors.New("response has no entry")
+}
+
+// logEntry converts an entry of the Rekor API to the bundle form.
+func (e rekorEntry) logEntry(uuid string) (*LogEntry, error) {
+	logID, err := hex.DecodeString(e.LogID)
+	if err != nil {
+		return nil, fmt.Errorf("decoding log ID: %w", err)
+	}
+	var rekord hashedRekord
+	if err := json.Unmarshal(e.Body, &rekord); err != nil {
+		return nil, fmt.Errorf("decoding entry body: %w", err)
+	}
+	entry := &LogEntry{UUID: uuid, TlogEntry: TlogEntry{
+		LogIndex:          e.LogIndex,
+		LogID:             LogID{KeyID: logID},
+		KindVersion:       KindVersion{Kind: rekord.Kind, Version: rekord.APIVersion},
+		IntegratedTime:    e.IntegratedTime,
+		CanonicalizedBody: e.Body,
+	}}
+	if e.Verification.SignedEntryTimestamp != nil {
+		entry.InclusionPromise = &InclusionPromise{SignedEntryTimestamp: e.Verification.SignedEntryTimestamp}
+	}
+	if p := e.Verification.InclusionProof; p != nil {
+		proof := &InclusionProof{LogIndex: p.LogIndex, TreeSize: p.TreeSize, Checkpoint: Checkpoint{Envelope: p.Checkpoint}}
+		if proof.RootHash, err = hex.DecodeString(p.RootHash); err != nil {
+			return nil, fmt.Errorf("decoding root hash: %w", err)
+		}
+		for _, h := range p.Hashes {
+			hash, err := hex.DecodeString(h)
+			if err != nil {
+				return nil, fmt.Errorf("decoding inclusion proof: %w", err)
+			}
+			proof.Hashes = append(proof.Hashes, hash)
+		}
+		entry.InclusionProof = proof
+	}
+	return entry, nil
+}
+
+// Verify checks that the entry is logged by the log with the public key
+// pub: that its inclusion promise is signed with pub and, if it has an
+// inclusion proof, that the proof leads to the root hash of a checkpoint
+// signed with pub. It returns an error wrapping ErrInvalidSignature if not.
+func (e *TlogEntry) Verify(pub crypto.PublicKey) error {
+	if e.InclusionPromise == nil {
+		return fmt.Errorf("%w: entry has no inclusion promise", ErrInvalidSignature)
+	}
+	promise, err := json.Marshal(struct {
+		Body           string `json:"body"`
+		IntegratedTime int64  `json:"integratedTime"`
+		LogID          string `json:"logID"`
+		LogIndex       int64  `json:"logIndex"`
+	}{base64.StdEncoding.EncodeToString(e.CanonicalizedBody), e.IntegratedTime, hex.EncodeToString(e.LogID.KeyID), e.LogIndex})
+	if err != nil {
+		return fmt.Errorf("encoding inclusion promise: %w", err)
+	}
+	if err := checkSignature(pub, promise, e.InclusionPromise.SignedEntryTimestamp); err != nil {
+		return fmt.Errorf("inclusion promise: %w", err)
+	}
+	if e.InclusionProof == nil {
+		return nil
+	}
+	if err := e.InclusionProof.verify(e.CanonicalizedBody); err != nil {
+		return err
+	}
+	return e.InclusionProof.verifyCheckpoint(pub)
+}
+
+// verify checks that the proof leads from the leaf of body to its root
+// hash, as RFC 9162 section 2.1.3.2 describes.
+func (p *InclusionProof) verify(body []byte) error {
+	if p.LogIndex < 0 || p.LogIndex >= p.TreeSize {
+		return fmt.Errorf("%w: index %d is not in a tree of size %d", ErrInvalidSignature, p.LogIndex, p.TreeSize)
+	}
+	hash := merkleHash([]byte{0}, body)
+	fn, sn := p.LogIndex, p.TreeSize-1
+	for _, sibling := range p.Hashes {
+		if sn == 0 {
+			return fmt.Errorf("%w: inclusion proof is too long", ErrInvalidSignature)
+		}
+		if fn&1 == 1 || fn == sn {
+			hash = merkleHash([]byte{1}, sibling, hash)
+			for fn&1 == 0 && fn != 0 {
+				fn, sn = fn>>1, sn>>1
+			}
+		} else {
+			hash = merkleHash([]byte{1}, hash, sibling)
+		}
+		fn, sn = fn>>1, sn>>1
+	}
+	if sn != 0 || !bytes.Equal(hash, p.RootHash) {
+		return fmt.Errorf("%w: inclusion proof does not lead to the root hash", ErrInvalidSignature)
+	}
+	return nil
+}
+
+func merkleHash(parts ...[]byte) []byte {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+// verifyCheckpoint checks that the checkpoint of the proof is a note
+// signed with pub committing to the size and root hash of the proof. The
+// note is its origin, the size and the base64 root hash on their own
+// lines, followed by a blank line and a signature line of the form
+// "— <name> <base64 of a 4-byte key hint and the signature>".
+func (p *InclusionProof) verifyCheckpoint(pub crypto.PublicKey) error {
+	text, sigs, ok := strings.Cut(p.Checkpoint.Envelope, "\n\n")
+	if !ok {
+		return fmt.Errorf("%w: malformed checkpoint", ErrInvalidSignature)
+	}
+	text += "\n"
+	lines := strings.Split(text, "\n")
+	if len(lines) < 4 {
+		return fmt.Errorf("%w: malformed checkpoint", ErrInvalidSignature)
+	}
+	size, err := strconv.ParseInt(lines[1], 10, 64)
+	if err != nil || size != p.TreeSize {
+		return fmt.Errorf("%w: checkpoint is not of a tree of size %d", ErrInvalidSignature, p.TreeSize)
+	}
+	if root, err := base64.StdEncoding.DecodeString(lines[2]); err != nil || !bytes.Equal(root, p.RootHash) {
+		return fmt.Errorf("%w: checkpoint is not of the root hash of the proof", ErrInvalidSignature)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(sigs), "\n") {
+		fields := strings.Fields(strings.TrimPrefix(line, "— "))
+		if len(fields) != 2 {
+			continue
+		}
+		sig, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(sig) <= 4 {
+			continue
+		}
+		if checkSignature(pub, []byte(text), sig[4:]) == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: checkpoint is not signed by the log", ErrInvalidSignature)
+}
+
+// logs reports whether the entry is a hashedrekord entry of the signature
+// sig of data.
+func (e *TlogEntry) logs(data, sig []byte) bool {
+	var rekord hashedRekord
+	if err := json.Unmarshal(e.CanonicalizedBody, &rekord); err != nil || rekord.Kind != "hashedrekord" {
+		return false
+	}
+	sum := sha256.Sum256(data)
+	return bytes.Equal(rekord.Spec.Signature.Content, sig) &&
+		rekord.Spec.Data.Hash.Algorithm == "sha256" && rekord.Spec.Data.Hash.Value == hex.EncodeToString(sum[:])
+}
+
+// RecordEntry records entry, the log entry of a signature of doc, in doc
+// as an Annotation of its SpdxDocument carrying the entry with its
+// inclusion proof, so that consumers of the document can audit that the
+// signature was logged.
+func RecordEntry(doc *parse.Document, entry *LogEntry) error {
+	if doc.SpdxDocument == nil {
+		return errors.New("document has no SpdxDocument")
+	}
+	creationInfo := doc.CreationInfo
+	if !doc.SpdxDocument.CreationInfo.Created.IsZero() {
+		creationInfo = &doc.SpdxDocument.CreationInfo
+	}
+	if creationInfo == nil {
+		return errors.New("document has no creation info")
+	}
+	statement, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding entry: %w", err)
+	}
+	docID := doc.SpdxDocument.SpdxID
+	ann := &spdx.Annotation{
+		Element:        spdx.Element{SpdxID: fmt.Sprintf("%s#rekor-%d", docID, entry.LogIndex), CreationInfo: *creationInfo.Copy()},
+		AnnotationType: spdx.AnnotationTypeOther,
+		ContentType:    RekorEntryContentType,
+		Statement:      string(statement),
+		Subject:        spdx.Element{SpdxID: docID},
+	}
+	return doc.AddElements(ann)
+}
+
+// RecordedEntries returns the log entries recorded in doc by RecordEntry.
+func RecordedEntries(doc *parse.Document) ([]*LogEntry, error) {
+	var entries []*LogEntry
+	for _, ann := range doc.Annotations {
+		if ann.ContentType != RekorEntryContentType {
+			continue
+		}
+		var entry LogEntry
+		if err := json.Unmarshal([]byte(ann.Statement), &entry); err != nil {
+			return nil, fmt.Errorf("decoding entry of %s: %w", ann.SpdxID, err)
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,278 @@
+package sigstore_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/parse"
+	"github.com/interlynk-io/spdx-zen/sigstore"
+)
+
+// fakeRekor is a transparency log keeping its entries in an RFC 6962
+// Merkle tree, which starts with a few unrelated entries.
+type fakeRekor struct {
+	key    *ecdsa.PrivateKey
+	logID  string
+	mu     sync.Mutex
+	leaves [][]byte
+}
+
+func newFakeRekor(t *testing.T) *fakeRekor {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(key.Public())
+	sum := sha256.Sum256(der)
+	r := &fakeRekor{key: key, logID: hex.EncodeToString(sum[:])}
+	for i := range 4 {
+		r.leaves = append(r.leaves, []byte(fmt.Sprintf(`{"entry":%d}`, i)))
+	}
+	return r
+}
+
+func hashChildren(l, r []byte) []byte {
+	h := sha256.Sum256(append(append([]byte{1}, l...), r...))
+	return h[:]
+}
+
+// treeHash is MTH of RFC 6962 section 2.1.
+func treeHash(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		h := sha256.Sum256(append([]byte{0}, leaves[0]...))
+		return h[:]
+	}
+	k := 1
+	for k*2 < len(leaves) {
+		k *= 2
+	}
+	return hashChildren(treeHash(leaves[:k]), treeHash(leaves[k:]))
+}
+
+// auditPath is PATH of RFC 6962 section 2.1.1.
+func auditPath(m int, leaves [][]byte) [][]byte {
+	if len(leaves) == 1 {
+		return nil
+	}
+	k := 1
+	for k*2 < len(leaves) {
+		k *= 2
+	}
+	if m < k {
+		return append(auditPath(m, leaves[:k]), treeHash(leaves[k:]))
+	}
+	return append(auditPath(m-k, leaves[k:]), treeHash(leaves[:k]))
+}
+
+func (r *fakeRekor) sign(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, r.key, sum[:])
+	if err != nil {
+		panic(err)
+	}
+	return sig
+}
+
+// entry returns the entry at index, with a proof against the current tree.
+func (r *fakeRekor) entry(index int) map[string]interface{} {
+	body := r.leaves[index]
+	integrated := int64(1714521600 + index)
+	promise, _ := json.Marshal(map[string]interface{}{
+		"body":           base64.StdEncoding.EncodeToString(body),
+		"integratedTime": integrated,
+		"logID":          r.logID,
+		"logIndex":       index,
+	})
+	var hashes []string
+	for _, h := range auditPath(index, r.leaves) {
+		hashes = append(hashes, hex.EncodeToString(h))
+	}
+	root := treeHash(r.leaves)
+	note := fmt.Sprintf("rekor.example - 1234\n%d\n%s\n", len(r.leaves), base64.StdEncoding.EncodeToString(root))
+	sig := append([]byte{0, 0, 0, 0}, r.sign([]byte(note))...)
+	return map[string]interface{}{
+		"body":           body,
+		"integratedTime": integrated,
+		"logID":          r.logID,
+		"logIndex":       index,
+		"verification": map[string]interface{}{
+			"signedEntryTimestamp": r.sign(promise),
+			"inclusionProof": map[string]interface{}{
+				"logIndex":   index,
+				"treeSize":   len(r.leaves),
+				"rootHash":   hex.EncodeToString(root),
+				"hashes":     hashes,
+				"checkpoint": note + "\n— rekor.example " + base64.StdEncoding.EncodeToString(sig) + "\n",
+			},
+		},
+	}
+}
+
+func (r *fakeRekor) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	index := -1
+	switch {
+	case req.Method == http.MethodPost && req.URL.Path == "/api/v1/log/entries":
+		body, _ := io.ReadAll(req.Body)
+		var rekord struct {
+			Kind string `json:"kind"`
+		}
+		if err := json.Unmarshal(body, &rekord); err != nil || rekord.Kind != "hashedrekord" {
+			http.Error(w, "unsupported entry", http.StatusBadRequest)
+			return
+		}
+		r.leaves = append(r.leaves, body)
+		index = len(r.leaves) - 1
+		w.WriteHeader(http.StatusCreated)
+	case req.Method == http.MethodGet && strings.HasPrefix(req.URL.Path, "/api/v1/log/entries/"):
+		uuid := strings.TrimPrefix(req.URL.Path, "/api/v1/log/entries/")
+		for i, leaf := range r.leaves {
+			if leafUUID(leaf) == uuid {
+				index = i
+			}
+		}
+		if index < 0 {
+			http.NotFound(w, req)
+			return
+		}
+	default:
+		http.NotFound(w, req)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{leafUUID(r.leaves[index]): r.entry(index)})
+}
+
+func leafUUID(leaf []byte) string {
+	h := sha256.Sum256(append([]byte{0}, leaf...))
+	return hex.EncodeToString(h[:])
+}
+
+func TestRekor(t *testing.T) {
+	data := testsbom.JSON(t, "1.0.0")
+	rekor := newFakeRekor(t)
+	srv := httptest.NewServer(rekor)
+	defer srv.Close()
+	client := sigstore.NewRekorClient(sigstore.WithBaseURL(srv.URL), sigstore.WithHTTPClient(srv.Client()))
+	ctx := context.Background()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	b, err := sigstore.Sign(data, key, "release-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := client.Upload(ctx, data, b, key.Public())
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if entry.LogIndex != 4 || entry.KindVersion.Kind != "hashedrekord" || len(b.VerificationMaterial.TlogEntries) != 1 {
+		t.Errorf("Upload = %+v, bundle has %d entries", entry, len(b.VerificationMaterial.TlogEntries))
+	}
+	if err := entry.Verify(rekor.key.Public()); err != nil {
+		t.Errorf("Verify entry: %v", err)
+	}
+	if err := entry.Verify(otherKey.Public()); !errors.Is(err, sigstore.ErrInvalidSignature) {
+		t.Errorf("Verify entry with another key: err = %v, want ErrInvalidSignature", err)
+	}
+	tampered := *entry
+	proof := *entry.InclusionProof
+	proof.Hashes = append([][]byte{make([]byte, 32)}, proof.Hashes[1:]...)
+	tampered.InclusionProof = &proof
+	if err := tampered.Verify(rekor.key.Public()); !errors.Is(err, sigstore.ErrInvalidSignature) {
+		t.Errorf("Verify entry with a tampered proof: err = %v, want ErrInvalidSignature", err)
+	}
+	if _, err := client.Upload(ctx, data, &sigstore.Bundle{}, key.Public()); err == nil {
+		t.Error("Upload of a bundle without signature succeeded")
+	}
+
+	// The bundle, with its entry, verifies once encoded and decoded.
+	encoded, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	logged, err := sigstore.ParseBundle(encoded)
+	if err != nil {
+		t.Fatalf("ParseBundle: %v", err)
+	}
+	unlogged, _ := sigstore.Sign(data, key, "release-key")
+	tests := []struct {
+		name string
+		v    sigstore.Verifier
+		b    *sigstore.Bundle
+		ok   bool
+	}{
+		{"logged", sigstore.Verifier{PublicKey: key.Public(), RekorKey: rekor.key.Public()}, logged, true},
+		{"log not required", sigstore.Verifier{PublicKey: key.Public()}, unlogged, true},
+		{"not logged", sigstore.Verifier{PublicKey: key.Public(), RekorKey: rekor.key.Public()}, unlogged, false},
+		{"other log", sigstore.Verifier{PublicKey: key.Public(), RekorKey: otherKey.Public()}, logged, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.v.Verify(data, tt.b)
+			if tt.ok && err != nil {
+				t.Errorf("Verify: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, sigstore.ErrInvalidSignature) {
+				t.Errorf("Verify: %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+
+	// Once the log has grown, the entry is proven included in the new tree.
+	for range 3 {
+		other, _ := sigstore.Sign([]byte(fmt.Sprint(time.Now().UnixNano())), key, "")
+		if _, err := client.Upload(ctx, data, other, key.Public()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fetched, err := client.Entry(ctx, entry.UUID)
+	if err != nil {
+		t.Fatalf("Entry: %v", err)
+	}
+	if fetched.InclusionProof.TreeSize != 8 {
+		t.Errorf("tree size = %d, want 8", fetched.InclusionProof.TreeSize)
+	}
+	if err := fetched.Verify(rekor.key.Public()); err != nil {
+		t.Errorf("Verify fetched entry: %v", err)
+	}
+	if _, err := client.Entry(ctx, "00"); err == nil {
+		t.Error("Entry of an unknown UUID succeeded")
+	}
+
+	doc, err := parse.NewReader().Read(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sigstore.RecordEntry(doc, entry); err != nil {
+		t.Fatalf("RecordEntry: %v", err)
+	}
+	recorded, err := sigstore.RecordedEntries(doc)
+	if err != nil {
+		t.Fatalf("RecordedEntries: %v", err)
+	}
+	if len(recorded) != 1 || recorded[0].UUID != entry.UUID {
+		t.Fatalf("RecordedEntries = %+v", recorded)
+	}
+	if err := recorded[0].Verify(rekor.key.Public()); err != nil {
+		t.Errorf("Verify recorded entry: %v", err)
+	}
+}
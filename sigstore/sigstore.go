@@ -0,0 +1,408 @@
+// Package sigstore signs serialized SPDX documents in the formats of
+// Sigstore and cosign, and verifies their signatures before the documents
+// are read.
+//
+// A document is signed with a key, or keyless, with a short-lived
+// certificate issued by Fulcio for an OpenID Connect identity:
+//
+//	bundle, err := sigstore.Sign(data, key, "release-key")
+//	bundle, err := sigstore.NewFulcioClient().SignKeyless(ctx, data, idToken)
+//
+// Signatures are carried in Sigstore bundles, which cosign verifies with
+// "cosign verify-blob --bundle", and bundles written by "cosign sign-blob
+// --bundle" are verified here:
+//
+//	v := &sigstore.Verifier{Roots: fulcioRoots, Subject: "release@acme.example", Issuer: "https://accounts.google.com", Time: signed}
+//	doc, identity, err := v.Read(data, bundle)
+//
+// Signatures can be recorded in the Rekor transparency log, and the entry,
+// with its inclusion proof, recorded back in the document:
+//
+//	entry, err := sigstore.NewRekorClient().Upload(ctx, data, bundle, key.Public())
+//	err = sigstore.RecordEntry(doc, entry)
+package sigstore
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// BundleMediaType is the media type of the bundles created by this package.
+const BundleMediaType = "application/vnd.dev.sigstore.bundle.v0.3+json"
+
+// ErrInvalidSignature is returned, wrapped, when the signature of a bundle
+// does not verify or its signer does not satisfy a Verifier.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// Bundle is a Sigstore bundle carrying the signature of a document and the
+// material to verify it. It encodes to the JSON form of the protobuf-specs
+// Bundle message.
+type Bundle struct {
+	MediaType            string               `json:"mediaType"`
+	VerificationMaterial VerificationMaterial `json:"verificationMaterial"`
+	MessageSignature     *MessageSignature    `json:"messageSignature,omitempty"`
+}
+
+// VerificationMaterial identifies the key of a bundle: a certificate for
+// keyless signatures, or a hint naming a public key.
+type VerificationMaterial struct {
+	// Certificate is the signing certificate, in bundles since v0.3, and
+	// X509CertificateChain the certificate and its intermediates, in
+	// earlier bundles.
+	Certificate          *X509Certificate      `json:"certificate,omitempty"`
+	X509CertificateChain *X509CertificateChain `json:"x509CertificateChain,omitempty"`
+	PublicKey            *PublicKeyIdentifier  `json:"publicKey,omitempty"`
+
+	// TlogEntries are the entries of the signature in transparency logs,
+	// as RekorClient.Upload adds them.
+	TlogEntries []TlogEntry `json:"tlogEntries,omitempty"`
+}
+
+// X509Certificate is a DER-encoded certificate.
+type X509Certificate struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+// X509CertificateChain is a certificate followed by its intermediates.
+type X509CertificateChain struct {
+	Certificates []X509Certificate `json:"certificates"`
+}
+
+// PublicKeyIdentifier names the public key of a signature.
+type PublicKeyIdentifier struct {
+	Hint string `json:"hint,omitempty"`
+}
+
+// MessageSignature is a signature of a message, with the digest of the
+// message.
+type MessageSignature struct {
+	MessageDigest *HashOutput `json:"messageDigest,omitempty"`
+	Signature     []byte      `json:"signature"`
+}
+
+// HashOutput is a digest and its algorithm, e.g. "SHA2_256".
+type HashOutput struct {
+	Algorithm string `json:"algorithm"`
+	Digest    []byte `json:"digest"`
+}
+
+// ParseBundle decodes a bundle in JSON form.
+func ParseBundle(data []byte) (*Bundle, error) {
+	var b Bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("decoding bundle: %w", err)
+	}
+	if !strings.HasPrefix(b.MediaType, "application/vnd.dev.sigstore.bundle") {
+		return nil, fmt.Errorf("unexpected media type %q", b.MediaType)
+	}
+	if b.MessageSignature == nil {
+		return nil, errors.New("bundle has no message signature")
+	}
+	return &b, nil
+}
+
+// BundleFromCosign returns a bundle of the files written by "cosign
+// sign-blob --output-signature --output-certificate": the base64-encoded
+// signature, and the PEM-encoded certificate of keyless signatures or nil.
+func BundleFromCosign(signature, certificate []byte) (*Bundle, error) {
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return nil, fmt.Errorf("decoding signature: %w", err)
+	}
+	b := &Bundle{MediaType: BundleMediaType, MessageSignature: &MessageSignature{Signature: sig}}
+	if len(certificate) > 0 {
+		// cosign may base64-encode the PEM certificate once more.
+		if der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(certificate))); err == nil {
+			certificate = der
+		}
+		block, _ := pem.Decode(certificate)
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, errors.New("no PEM certificate")
+		}
+		b.VerificationMaterial.Certificate = &X509Certificate{RawBytes: block.Bytes}
+	}
+	return b, nil
+}
+
+// ParsePublicKey decodes a PEM-encoded public key, such as a cosign.pub
+// file.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errors.New("no PEM public key")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// Sign signs data, typically a serialized document, with signer and
+// returns a bundle naming the key by hint, if not empty.
+//
+// Ed25519, ECDSA and RSA keys are supported; ECDSA and RSA sign a SHA-256
+// digest of data, RSA with PKCS #1 v1.5 padding, as cosign does.
+func Sign(data []byte, signer crypto.Signer, hint string) (*Bundle, error) {
+	b, err := sign(data, signer)
+	if err != nil {
+		return nil, err
+	}
+	if hint != "" {
+		b.VerificationMaterial.PublicKey = &PublicKeyIdentifier{Hint: hint}
+	}
+	return b, nil
+}
+
+func sign(data []byte, signer crypto.Signer) (*Bundle, error) {
+	digest := sha256.Sum256(data)
+	var (
+		sig []byte
+		err error
+	)
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		sig, err = signer.Sign(rand.Reader, data, crypto.Hash(0))
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		sig, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", signer.Public())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("signing: %w", err)
+	}
+	return &Bundle{
+		MediaType: BundleMediaType,
+		MessageSignature: &MessageSignature{
+			MessageDigest: &HashOutput{Algorithm: "SHA2_256", Digest: digest[:]},
+			Signature:     sig,
+		},
+	}, nil
+}
+
+// verifySignature reports whether sig is a signature of data by pub, made
+// as Sign makes them.
+func verifySignature(pub crypto.PublicKey, data, sig []byte) (bool, error) {
+	digest := sha256.Sum256(data)
+	switch pub := pub.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(pub, data, sig), nil
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(pub, digest[:], sig), nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil, nil
+	}
+	return false, fmt.Errorf("unsupported key type %T", pub)
+}
+
+// Verifier verifies the bundles of documents. It accepts signatures by
+// PublicKey, if set, and keyless signatures whose certificate chains to
+// Roots and was issued for Subject by Issuer, if they are set.
+type Verifier struct {
+	// PublicKey is the key of key-based signatures.
+	PublicKey crypto.PublicKey
+
+	// Roots are the trusted Fulcio root certificates, and Intermediates
+	// further intermediates to those of the bundle.
+	Roots         *x509.CertPool
+	Intermediates *x509.CertPool
+
+	// Subject is the identity of the signer, the email address or URI of
+	// the certificate, and Issuer the URL of the OpenID Connect provider
+	// that authenticated it. Either matches any value if empty.
+	Subject string
+	Issuer  string
+
+	// Time is the time at which certificates must be valid, the current
+	// time if zero. Fulcio certificates are valid for ten minutes, so that
+	// it is usually the signing time attested by a transparency log.
+	Time time.Time
+
+	// RekorKey is the public key of the transparency log. If set, the
+	// bundle must carry an entry of the log recording its signature, which
+	// verifies with RekorKey; the time the log attests is then the time
+	// certificates must be valid at, unless Time is set.
+	RekorKey crypto.PublicKey
+}
+
+// Identity is the signer of a keyless signature.
+type Identity struct {
+	Subject     string
+	Issuer      string
+	Certificate *x509.Certificate
+}
+
+// Verify checks that b holds a signature of data accepted by v, and
+// returns the identity of its signer for a keyless signature, or nil for a
+// key-based one. It returns an error wrapping ErrInvalidSignature if the
+// signature is not accepted.
+func (v *Verifier) Verify(data []byte, b *Bundle) (*Identity, error) {
+	if b.MessageSignature == nil {
+		return nil, fmt.Errorf("%w: bundle has no message signature", ErrInvalidSignature)
+	}
+	if d := b.MessageSignature.MessageDigest; d != nil {
+		sum := sha256.Sum256(data)
+		if d.Algorithm != "SHA2_256" || !bytes.Equal(d.Digest, sum[:]) {
+			return nil, fmt.Errorf("%w: digest does not match", ErrInvalidSignature)
+		}
+	}
+	at := v.Time
+	if v.RekorKey != nil {
+		logged, err := v.loggedTime(data, b)
+		if err != nil {
+			return nil, err
+		}
+		if at.IsZero() {
+			at = logged
+		}
+	}
+
+	certs, err := b.certificates()
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		if v.PublicKey == nil {
+			return nil, fmt.Errorf("%w: no public key for a key-based signature", ErrInvalidSignature)
+		}
+		return nil, checkSignature(v.PublicKey, data, b.MessageSignature.Signature)
+	}
+
+	if v.Roots == nil {
+		return nil, fmt.Errorf("%w: no roots for a keyless signature", ErrInvalidSignature)
+	}
+	leaf := certs[0]
+	intermediates := x509.NewCertPool()
+	if v.Intermediates != nil {
+		intermediates = v.Intermediates.Clone()
+	}
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+	if at.IsZero() {
+		at = time.Now()
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Roots:         v.Roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	id := identity(leaf)
+	if v.Subject != "" && id.Subject != v.Subject {
+		return nil, fmt.Errorf("%w: signed by %q, not %q", ErrInvalidSignature, id.Subject, v.Subject)
+	}
+	if v.Issuer != "" && id.Issuer != v.Issuer {
+		return nil, fmt.Errorf("%w: identity issued by %q, not %q", ErrInvalidSignature, id.Issuer, v.Issuer)
+	}
+	if err := checkSignature(leaf.PublicKey, data, b.MessageSignature.Signature); err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// loggedTime returns the time at which the log attests the signature of
+// data in b was recorded, by the first entry of b that verifies.
+func (v *Verifier) loggedTime(data []byte, b *Bundle) (time.Time, error) {
+	err := fmt.Errorf("%w: bundle has no entry of the transparency log", ErrInvalidSignature)
+	for _, e := range b.VerificationMaterial.TlogEntries {
+		if !e.logs(data, b.MessageSignature.Signature) {
+			continue
+		}
+		if err = e.Verify(v.RekorKey); err == nil {
+			return time.Unix(e.IntegratedTime, 0), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+func checkSignature(pub crypto.PublicKey, data, sig []byte) error {
+	ok, err := verifySignature(pub, data, sig)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	if !ok {
+		return fmt.Errorf("%w: signature does not verify", ErrInvalidSignature)
+	}
+	return nil
+}
+
+// Read verifies data with b, as Verify does, and reads the document once
+// it is verified.
+func (v *Verifier) Read(data []byte, b *Bundle) (*parse.Document, *Identity, error) {
+	id, err := v.Verify(data, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	doc, err := parse.NewReader().Read(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading signed document: %w", err)
+	}
+	return doc, id, nil
+}
+
+// certificates returns the certificate of the bundle and its
+// intermediates, if any.
+func (b *Bundle) certificates() ([]*x509.Certificate, error) {
+	var raw []X509Certificate
+	switch m := b.VerificationMaterial; {
+	case m.Certificate != nil:
+		raw = []X509Certificate{*m.Certificate}
+	case m.X509CertificateChain != nil:
+		raw = m.X509CertificateChain.Certificates
+	}
+	certs := make([]*x509.Certificate, 0, len(raw))
+	for _, r := range raw {
+		c, err := x509.ParseCertificate(r.RawBytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate: %w", err)
+		}
+		certs = append(certs, c)
+	}
+	return certs, nil
+}
+
+// Object identifiers of the Fulcio certificate extensions naming the OpenID
+// Connect issuer, as a DER-encoded string and, deprecated, as raw bytes.
+var (
+	oidIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	oidIssuer   = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+)
+
+// identity returns the subject and issuer of a Fulcio certificate.
+func identity(c *x509.Certificate) *Identity {
+	id := &Identity{Certificate: c}
+	switch {
+	case len(c.EmailAddresses) > 0:
+		id.Subject = c.EmailAddresses[0]
+	case len(c.URIs) > 0:
+		id.Subject = c.URIs[0].String()
+	}
+	for _, ext := range c.Extensions {
+		switch {
+		case ext.Id.Equal(oidIssuerV2):
+			var s string
+			if _, err := asn1.UnmarshalWithParams(ext.Value, &s, "utf8"); err == nil {
+				id.Issuer = s
+			}
+		case ext.Id.Equal(oidIssuer) && id.Issuer == "":
+			id.Issuer = string(ext.Value)
+		}
+	}
+	return id
+}
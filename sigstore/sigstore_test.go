@@ -0,0 +1,268 @@
+package sigstore_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/sigstore"
+)
+
+func TestSign(t *testing.T) {
+	data := testsbom.JSON(t, "1.0.0")
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	ecKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, otherKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	tests := []struct {
+		name   string
+		signer crypto.Signer
+	}{
+		{"ed25519", edKey},
+		{"ecdsa", ecKey},
+		{"rsa", rsaKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := sigstore.Sign(data, tt.signer, "release-key")
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			encoded, err := json.Marshal(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err = sigstore.ParseBundle(encoded)
+			if err != nil {
+				t.Fatalf("ParseBundle: %v", err)
+			}
+			if b.VerificationMaterial.PublicKey == nil || b.VerificationMaterial.PublicKey.Hint != "release-key" {
+				t.Errorf("verification material = %+v", b.VerificationMaterial)
+			}
+
+			v := &sigstore.Verifier{PublicKey: tt.signer.Public()}
+			doc, id, err := v.Read(data, b)
+			if err != nil {
+				t.Fatalf("Read: %v", err)
+			}
+			if id != nil || len(doc.Packages) != 4 {
+				t.Errorf("Read = %d packages, identity %v", len(doc.Packages), id)
+			}
+
+			other := &sigstore.Verifier{PublicKey: otherKey.Public()}
+			if _, err := other.Verify(data, b); !errors.Is(err, sigstore.ErrInvalidSignature) {
+				t.Errorf("Verify with another key: %v, want ErrInvalidSignature", err)
+			}
+			tampered := append([]byte(nil), data...)
+			tampered[len(tampered)-2] = ' '
+			if _, err := v.Verify(tampered, b); !errors.Is(err, sigstore.ErrInvalidSignature) {
+				t.Errorf("Verify of tampered data: %v, want ErrInvalidSignature", err)
+			}
+		})
+	}
+
+	// cosign sign-blob --key writes the bare signature.
+	b, _ := sigstore.Sign(data, ecKey, "")
+	cosign, err := sigstore.BundleFromCosign([]byte(base64.StdEncoding.EncodeToString(b.MessageSignature.Signature)+"\n"), nil)
+	if err != nil {
+		t.Fatalf("BundleFromCosign: %v", err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(ecKey.Public())
+	pub, err := sigstore.ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil {
+		t.Fatalf("ParsePublicKey: %v", err)
+	}
+	if _, err := (&sigstore.Verifier{PublicKey: pub}).Verify(data, cosign); err != nil {
+		t.Errorf("Verify of cosign signature: %v", err)
+	}
+	if _, err := (&sigstore.Verifier{}).Verify(data, cosign); !errors.Is(err, sigstore.ErrInvalidSignature) {
+		t.Errorf("Verify without key: %v, want ErrInvalidSignature", err)
+	}
+}
+
+// fakeFulcio issues certificates for the email of the tokens it receives,
+// signed by a test root.
+type fakeFulcio struct {
+	root   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	issued time.Time
+}
+
+func newFakeFulcio(t *testing.T) *fakeFulcio {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test fulcio root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, _ := x509.ParseCertificate(der)
+	return &fakeFulcio{root: root, key: key, issued: time.Now().Truncate(time.Second)}
+}
+
+func (f *fakeFulcio) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/v2/signingCert" {
+		http.NotFound(w, r)
+		return
+	}
+	var req struct {
+		Credentials struct {
+			OIDCIdentityToken string `json:"oidcIdentityToken"`
+		} `json:"credentials"`
+		PublicKeyRequest struct {
+			PublicKey struct {
+				Algorithm string `json:"algorithm"`
+				Content   string `json:"content"`
+			} `json:"publicKey"`
+			ProofOfPossession []byte `json:"proofOfPossession"`
+		} `json:"publicKeyRequest"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	block, _ := pem.Decode([]byte(req.PublicKeyRequest.PublicKey.Content))
+	if block == nil || req.PublicKeyRequest.PublicKey.Algorithm != "ECDSA" {
+		http.Error(w, "bad public key", http.StatusBadRequest)
+		return
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	const email = "release@acme.example"
+	digest := sha256.Sum256([]byte(email))
+	if !ecdsa.VerifyASN1(pub.(*ecdsa.PublicKey), digest[:], req.PublicKeyRequest.ProofOfPossession) {
+		http.Error(w, "bad proof of possession", http.StatusBadRequest)
+		return
+	}
+
+	issuer, _ := asn1.MarshalWithParams("https://accounts.example", "utf8")
+	tmpl := &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		NotBefore:      f.issued,
+		NotAfter:       f.issued.Add(10 * time.Minute),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses: []string{email},
+		ExtraExtensions: []pkix.Extension{
+			{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}, Value: issuer},
+		},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, f.root, pub, f.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	var resp struct {
+		SignedCertificateEmbeddedSct struct {
+			Chain struct {
+				Certificates []string `json:"certificates"`
+			} `json:"chain"`
+		} `json:"signedCertificateEmbeddedSct"`
+	}
+	resp.SignedCertificateEmbeddedSct.Chain.Certificates = []string{
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.root.Raw})),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// token returns an unsigned identity token with the given claims.
+func token(claims string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".sig"
+}
+
+func TestSignKeyless(t *testing.T) {
+	data := testsbom.JSON(t, "1.0.0")
+	fulcio := newFakeFulcio(t)
+	srv := httptest.NewServer(fulcio)
+	defer srv.Close()
+	client := sigstore.NewFulcioClient(sigstore.WithBaseURL(srv.URL), sigstore.WithHTTPClient(srv.Client()))
+
+	b, err := client.SignKeyless(context.Background(), data, token(`{"sub":"1234","email":"release@acme.example"}`))
+	if err != nil {
+		t.Fatalf("SignKeyless: %v", err)
+	}
+	if b.VerificationMaterial.Certificate == nil {
+		t.Fatal("bundle has no certificate")
+	}
+	if _, err := client.SignKeyless(context.Background(), data, token(`{"sub":"other"}`)); err == nil {
+		t.Error("SignKeyless for another subject succeeded")
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(fulcio.root)
+	signed := fulcio.issued.Add(time.Minute)
+	tests := []struct {
+		name string
+		v    sigstore.Verifier
+		ok   bool
+	}{
+		{"identity", sigstore.Verifier{Roots: roots, Subject: "release@acme.example", Issuer: "https://accounts.example", Time: signed}, true},
+		{"any identity", sigstore.Verifier{Roots: roots, Time: signed}, true},
+		{"other subject", sigstore.Verifier{Roots: roots, Subject: "eve@acme.example", Time: signed}, false},
+		{"other issuer", sigstore.Verifier{Roots: roots, Issuer: "https://token.actions.githubusercontent.com", Time: signed}, false},
+		{"expired", sigstore.Verifier{Roots: roots, Time: signed.Add(20 * time.Minute)}, false},
+		{"other roots", sigstore.Verifier{Roots: x509.NewCertPool(), Time: signed}, false},
+		{"no roots", sigstore.Verifier{Time: signed}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := tt.v.Verify(data, b)
+			if !tt.ok {
+				if !errors.Is(err, sigstore.ErrInvalidSignature) {
+					t.Errorf("Verify: %v, want ErrInvalidSignature", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if id.Subject != "release@acme.example" || id.Issuer != "https://accounts.example" {
+				t.Errorf("identity = %q from %q", id.Subject, id.Issuer)
+			}
+		})
+	}
+
+	// cosign sign-blob --output-certificate writes the PEM certificate
+	// base64-encoded.
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: b.VerificationMaterial.Certificate.RawBytes})
+	cosign, err := sigstore.BundleFromCosign([]byte(base64.StdEncoding.EncodeToString(b.MessageSignature.Signature)), []byte(base64.StdEncoding.EncodeToString(certPEM)))
+	if err != nil {
+		t.Fatalf("BundleFromCosign: %v", err)
+	}
+	if _, err := tests[0].v.Verify(data, cosign); err != nil {
+		t.Errorf("Verify of cosign signature: %v", err)
+	}
+}
@@ -0,0 +1,305 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// fileMagic starts the files of a FileKV.
+const fileMagic = "SPDXZKV1"
+
+// FileKV is a KV held in memory and persisted in a single file, in which
+// each batch is appended as a checksummed record and synced before Apply
+// returns. A record left incomplete by a crash is discarded when the file
+// is opened again.
+//
+// The file grows with every write: Compact rewrites it with the current
+// keys only.
+type FileKV struct {
+	path string
+
+	mu     sync.RWMutex
+	f      *os.File
+	values map[string][]byte
+	keys   []string // sorted keys of values, or nil if unknown
+}
+
+// OpenFileKV opens the FileKV persisted in the file at path, creating the
+// file if it does not exist.
+func OpenFileKV(path string) (*FileKV, error) {
+	kv := &FileKV{path: path, values: make(map[string][]byte)}
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := kv.load(f); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	kv.f = f
+	return kv, nil
+}
+
+// load reads the records of f, truncating it after the last complete one,
+// and leaves f at its end.
+func (kv *FileKV) load(f *os.File) error {
+	r := bufio.NewReader(f)
+	magic := make([]byte, len(fileMagic))
+	switch _, err := io.ReadFull(r, magic); {
+	case err == io.EOF:
+		if _, err := f.WriteString(fileMagic); err != nil {
+			return err
+		}
+		return f.Sync()
+	case err != nil:
+		return err
+	case string(magic) != fileMagic:
+		return errors.New("not a key-value store file")
+	}
+
+	offset := int64(len(fileMagic))
+	for {
+		n, err := kv.readRecord(r)
+		if err != nil {
+			break
+		}
+		offset += n
+	}
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+	_, err := f.Seek(offset, io.SeekStart)
+	return err
+}
+
+// A record is the length of its payload and the CRC-32 of the payload,
+// both four bytes long, then the payload: for each op, 0 and the key and
+// value for a put, or 1 and the key for a deletion, the key and value
+// prefixed by their length as uvarints.
+const recordHeader = 8
+
+// readRecord applies the next record of r and returns its size.
+func (kv *FileKV) readRecord(r io.Reader) (int64, error) {
+	var header [recordHeader]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, err
+	}
+	payload := make([]byte, binary.LittleEndian.Uint32(header[:4]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, err
+	}
+	if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(header[4:]) {
+		return 0, errors.New("corrupt record")
+	}
+	ops, err := decodeOps(payload)
+	if err != nil {
+		return 0, err
+	}
+	kv.apply(ops)
+	return int64(recordHeader + len(payload)), nil
+}
+
+func encodeOps(ops []Op) []byte {
+	buf := make([]byte, recordHeader)
+	for _, op := range ops {
+		if op.Delete {
+			buf = append(buf, 1)
+			buf = binary.AppendUvarint(buf, uint64(len(op.Key)))
+			buf = append(buf, op.Key...)
+			continue
+		}
+		buf = append(buf, 0)
+		buf = binary.AppendUvarint(buf, uint64(len(op.Key)))
+		buf = append(buf, op.Key...)
+		buf = binary.AppendUvarint(buf, uint64(len(op.Value)))
+		buf = append(buf, op.Value...)
+	}
+	payload := buf[recordHeader:]
+	binary.LittleEndian.PutUint32(buf[:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(buf[4:], crc32.ChecksumIEEE(payload))
+	return buf
+}
+
+func decodeOps(payload []byte) ([]Op, error) {
+	var ops []Op
+	field := func() ([]byte, error) {
+		n, size := binary.Uvarint(payload)
+		if size <= 0 || uint64(len(payload)-size) < n {
+			return nil, errors.New("corrupt record")
+		}
+		v := payload[size : size+int(n)]
+		payload = payload[size+int(n):]
+		return v, nil
+	}
+	for len(payload) > 0 {
+		kind := payload[0]
+		payload = payload[1:]
+		key, err := field()
+		if err != nil {
+			return nil, err
+		}
+		op := Op{Key: key, Delete: kind == 1}
+		if !op.Delete {
+			if op.Value, err = field(); err != nil {
+				return nil, err
+			}
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// apply applies ops to the values. The caller holds the write lock.
+func (kv *FileKV) apply(ops []Op) {
+	for _, op := range ops {
+		key := string(op.Key)
+		_, exists := kv.values[key]
+		if op.Delete {
+			delete(kv.values, key)
+		} else {
+			kv.values[key] = bytes.Clone(op.Value)
+			if kv.values[key] == nil {
+				kv.values[key] = []byte{}
+			}
+		}
+		if exists == op.Delete {
+			kv.keys = nil
+		}
+	}
+}
+
+// Get returns the value of key, or nil if it has none.
+func (kv *FileKV) Get(key []byte) ([]byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	if kv.f == nil {
+		return nil, os.ErrClosed
+	}
+	return kv.values[string(key)], nil
+}
+
+// Scan calls fn with the keys having the given prefix and their values, in
+// key order. The keys and values are those when Scan was called, and fn
+// may use the FileKV.
+func (kv *FileKV) Scan(prefix []byte, fn func(key, value []byte) error) error {
+	type pair struct {
+		key   string
+		value []byte
+	}
+	kv.mu.Lock()
+	if kv.f == nil {
+		kv.mu.Unlock()
+		return os.ErrClosed
+	}
+	if kv.keys == nil {
+		kv.keys = make([]string, 0, len(kv.values))
+		for key := range kv.values {
+			kv.keys = append(kv.keys, key)
+		}
+		slices.Sort(kv.keys)
+	}
+	var pairs []pair
+	i, _ := slices.BinarySearch(kv.keys, string(prefix))
+	for _, key := range kv.keys[i:] {
+		if !strings.HasPrefix(key, string(prefix)) {
+			break
+		}
+		pairs = append(pairs, pair{key, kv.values[key]})
+	}
+	kv.mu.Unlock()
+
+	for _, p := range pairs {
+		if err := fn([]byte(p.key), p.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply appends ops to the file as one record, syncs it, and applies them.
+func (kv *FileKV) Apply(ops []Op) error {
+	if len(ops) == 0 {
+		return nil
+	}
+	record := encodeOps(ops)
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.f == nil {
+		return os.ErrClosed
+	}
+	offset, err := kv.f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", kv.path, err)
+	}
+	if _, err = kv.f.Write(record); err == nil {
+		err = kv.f.Sync()
+	}
+	if err != nil {
+		// Drop what was written of the record, lest the records appended
+		// after it be discarded with it when the file is opened again.
+		kv.f.Truncate(offset)
+		kv.f.Seek(offset, io.SeekStart)
+		return fmt.Errorf("writing %s: %w", kv.path, err)
+	}
+	kv.apply(ops)
+	return nil
+}
+
+// Compact rewrites the file with a single record of the current keys, and
+// replaces the file with it once it is synced.
+func (kv *FileKV) Compact() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.f == nil {
+		return os.ErrClosed
+	}
+	ops := make([]Op, 0, len(kv.values))
+	for key, value := range kv.values {
+		ops = append(ops, Op{Key: []byte(key), Value: value})
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(kv.path), filepath.Base(kv.path)+".*")
+	if err != nil {
+		return fmt.Errorf("compacting %s: %w", kv.path, err)
+	}
+	_, err = tmp.WriteString(fileMagic)
+	if err == nil && len(ops) > 0 {
+		_, err = tmp.Write(encodeOps(ops))
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), kv.path)
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("compacting %s: %w", kv.path, err)
+	}
+	kv.f.Close()
+	kv.f = tmp
+	return nil
+}
+
+// Close closes the file. The FileKV may not be used afterwards.
+func (kv *FileKV) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.f == nil {
+		return os.ErrClosed
+	}
+	err := kv.f.Close()
+	kv.f = nil
+	return err
+}
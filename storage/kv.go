@@ -0,0 +1,328 @@
+package storage
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/interlynk-io/spdx-zen/internal/purl"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// KV is an ordered key-value store, such as FileKV or a bucket of an
+// embedded database like bbolt or badger adapted by the caller.
+type KV interface {
+	// Get returns the value of key, or nil if it has none.
+	Get(key []byte) ([]byte, error)
+
+	// Scan calls fn with the keys having the given prefix and their values,
+	// in key order, until fn returns an error, which Scan returns. fn must
+	// not retain key or value.
+	Scan(prefix []byte, fn func(key, value []byte) error) error
+
+	// Apply applies ops, in order and atomically.
+	Apply(ops []Op) error
+}
+
+// Op is a write to a KV: a put of Value in Key, or the deletion of Key.
+type Op struct {
+	Key    []byte
+	Value  []byte
+	Delete bool
+}
+
+// Keys of a KVStore are made of parts separated by NUL bytes, the first of
+// which is their kind:
+//
+//	doc, document             the content of the document
+//	elem, document, element   the kvElement of the element, as JSON
+//	index, value, document, element
+//	                          an empty key for each value of the element
+//	                          indexed under each of kvIndexes
+const (
+	docKey  = "doc"
+	elemKey = "elem"
+)
+
+// kvIndexes are the kinds of the index keys, most selective first.
+var kvIndexes = []string{"purl", "identifier", "license", "name", "type"}
+
+func kvKey(parts ...string) []byte {
+	return []byte(strings.Join(parts, "\x00"))
+}
+
+// kvElement is the value of an element key.
+type kvElement struct {
+	Type        string   `json:"type,omitempty"`
+	Name        string   `json:"name,omitempty"`
+	Version     string   `json:"version,omitempty"`
+	PURL        string   `json:"purl,omitempty"`
+	Identifiers []string `json:"identifiers,omitempty"`
+	Licenses    []string `json:"licenses,omitempty"`
+}
+
+// values returns the values of e indexed under kind.
+func (e *kvElement) values(kind string) []string {
+	switch kind {
+	case "purl":
+		if e.PURL != "" {
+			return []string{purl.Base(e.PURL)}
+		}
+	case "identifier":
+		return e.Identifiers
+	case "license":
+		return e.Licenses
+	case "name":
+		if e.Name != "" {
+			return []string{e.Name}
+		}
+	case "type":
+		if e.Type != "" {
+			return []string{e.Type}
+		}
+	}
+	return nil
+}
+
+// KVStore stores documents in a KV, for command-line tools and devices
+// without a database server: the content of each document, a record of
+// each of its elements with their external identifiers and licenses, and
+// index keys to find the elements by package URL, identifier, license,
+// name and type.
+type KVStore struct {
+	kv     KV
+	reader *parse.Reader
+
+	// mu serializes the writes, each reading keys before applying a batch.
+	mu sync.Mutex
+}
+
+// NewKVStore creates a store in kv.
+func NewKVStore(kv KV, opts ...Option) *KVStore {
+	return &KVStore{kv: kv, reader: newConfig(opts).reader}
+}
+
+// Store parses the document with the given content and stores it with its
+// index in one batch, unless it is stored already, and returns its ID.
+func (s *KVStore) Store(ctx context.Context, data []byte) (string, error) {
+	doc, err := s.reader.Read(data)
+	if err != nil {
+		return "", fmt.Errorf("reading document: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	id := documentID(data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, err := s.kv.Get(kvKey(docKey, id)); err != nil {
+		return "", fmt.Errorf("looking up document: %w", err)
+	} else if v != nil {
+		return id, nil
+	}
+
+	x := newIndex(doc)
+	elements := make(map[string]*kvElement, len(x.elements))
+	for _, row := range x.elements {
+		elements[row.spdxID] = &kvElement{Type: row.typ, Name: row.name, Version: row.version, PURL: row.purl}
+	}
+	for _, row := range x.identifiers {
+		if e, ok := elements[row.element]; ok {
+			e.Identifiers = append(e.Identifiers, row.identifier)
+		}
+	}
+	for _, row := range x.licenses {
+		if e, ok := elements[row.element]; ok && !slices.Contains(e.Licenses, row.license) {
+			e.Licenses = append(e.Licenses, row.license)
+		}
+	}
+
+	ops := []Op{{Key: kvKey(docKey, id), Value: data}}
+	for spdxID, e := range elements {
+		value, err := json.Marshal(e)
+		if err != nil {
+			return "", fmt.Errorf("storing element %s: %w", spdxID, err)
+		}
+		ops = append(ops, Op{Key: kvKey(elemKey, id, spdxID), Value: value})
+		for _, key := range e.indexKeys(id, spdxID) {
+			ops = append(ops, Op{Key: key, Value: []byte{}})
+		}
+	}
+	if err := s.kv.Apply(ops); err != nil {
+		return "", fmt.Errorf("storing document: %w", err)
+	}
+	return id, nil
+}
+
+// indexKeys returns the index keys of the element of the given document
+// and SPDX ID.
+func (e *kvElement) indexKeys(id, spdxID string) [][]byte {
+	var keys [][]byte
+	for _, kind := range kvIndexes {
+		for _, v := range e.values(kind) {
+			keys = append(keys, kvKey(kind, v, id, spdxID))
+		}
+	}
+	return keys
+}
+
+// Load returns the stored document with the given ID.
+func (s *KVStore) Load(ctx context.Context, id string) (*parse.Document, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	data, err := s.kv.Get(kvKey(docKey, id))
+	if err != nil {
+		return nil, fmt.Errorf("loading document: %w", err)
+	}
+	if data == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return s.reader.Read(data)
+}
+
+// Delete removes the stored document with the given ID and its index.
+func (s *KVStore) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, err := s.kv.Get(kvKey(docKey, id)); err != nil {
+		return fmt.Errorf("looking up document: %w", err)
+	} else if v == nil {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+
+	ops := []Op{{Key: kvKey(docKey, id), Delete: true}}
+	prefix := kvKey(elemKey, id, "")
+	err := s.kv.Scan(prefix, func(key, value []byte) error {
+		var e kvElement
+		if err := json.Unmarshal(value, &e); err != nil {
+			return fmt.Errorf("element %s: %w", key[len(prefix):], err)
+		}
+		ops = append(ops, Op{Key: bytes.Clone(key), Delete: true})
+		for _, key := range e.indexKeys(id, string(key[len(prefix):])) {
+			ops = append(ops, Op{Key: key, Delete: true})
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting document: %w", err)
+	}
+	if err := s.kv.Apply(ops); err != nil {
+		return fmt.Errorf("deleting document: %w", err)
+	}
+	return nil
+}
+
+// Query returns the elements of the stored documents matching q, ordered
+// by document and SPDX ID. The candidates are found with the most
+// selective index of the fields set, and their records matched against
+// the other fields.
+func (s *KVStore) Query(ctx context.Context, q Query) ([]Match, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	type candidate struct{ doc, spdxID string }
+	var candidates []candidate
+
+	kind, value := "", ""
+	for _, k := range kvIndexes {
+		if v := q.field(k); v != "" {
+			kind, value = k, v
+			break
+		}
+	}
+	var err error
+	switch {
+	case kind != "":
+		prefix := kvKey(kind, value, "")
+		err = s.kv.Scan(prefix, func(key, _ []byte) error {
+			doc, spdxID, ok := strings.Cut(string(key[len(prefix):]), "\x00")
+			if ok && (len(q.Documents) == 0 || slices.Contains(q.Documents, doc)) {
+				candidates = append(candidates, candidate{doc, spdxID})
+			}
+			return nil
+		})
+	default:
+		prefixes := [][]byte{kvKey(elemKey, "")}
+		if len(q.Documents) > 0 {
+			prefixes = prefixes[:0]
+			for _, doc := range q.Documents {
+				prefixes = append(prefixes, kvKey(elemKey, doc, ""))
+			}
+		}
+		elemPrefix := kvKey(elemKey, "")
+		for _, prefix := range prefixes {
+			err = s.kv.Scan(prefix, func(key, _ []byte) error {
+				if doc, spdxID, ok := strings.Cut(string(key[len(elemPrefix):]), "\x00"); ok {
+					candidates = append(candidates, candidate{doc, spdxID})
+				}
+				return nil
+			})
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying elements: %w", err)
+	}
+
+	var matches []Match
+	for _, c := range candidates {
+		value, err := s.kv.Get(kvKey(elemKey, c.doc, c.spdxID))
+		if err != nil {
+			return nil, fmt.Errorf("querying elements: %w", err)
+		}
+		if value == nil {
+			continue
+		}
+		var e kvElement
+		if err := json.Unmarshal(value, &e); err != nil {
+			return nil, fmt.Errorf("querying elements: element %s: %w", c.spdxID, err)
+		}
+		if !q.matches(&e) {
+			continue
+		}
+		matches = append(matches, Match{Document: c.doc, SpdxID: c.spdxID, Type: e.Type, Name: e.Name, Version: e.Version, PURL: e.PURL})
+	}
+	slices.SortFunc(matches, func(a, b Match) int {
+		return cmp.Or(strings.Compare(a.Document, b.Document), strings.Compare(a.SpdxID, b.SpdxID))
+	})
+	return matches, nil
+}
+
+// field returns the value of q indexed under kind.
+func (q *Query) field(kind string) string {
+	switch kind {
+	case "purl":
+		return purl.Base(q.PURL)
+	case "identifier":
+		return q.Identifier
+	case "license":
+		return q.License
+	case "name":
+		return q.Name
+	case "type":
+		return q.Type
+	}
+	return ""
+}
+
+// matches reports whether the element e matches every field of q but the
+// documents.
+func (q *Query) matches(e *kvElement) bool {
+	return (q.PURL == "" || purl.Match(q.PURL, e.PURL)) &&
+		(q.Name == "" || q.Name == e.Name) &&
+		(q.Type == "" || q.Type == e.Type) &&
+		(q.Identifier == "" || slices.Contains(e.Identifiers, q.Identifier)) &&
+		(q.License == "" || slices.Contains(e.Licenses, q.License))
+}
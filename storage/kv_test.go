@@ -0,0 +1,203 @@
+package storage_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/storage"
+)
+
+func TestKVStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "sboms.kv")
+	kv, err := storage.OpenFileKV(path)
+	if err != nil {
+		t.Fatalf("OpenFileKV: %v", err)
+	}
+	store := storage.NewKVStore(kv)
+
+	id, err := store.Store(ctx, testsbom.JSON(t, "1.0.0"))
+	if err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+	if again, err := store.Store(ctx, testsbom.JSON(t, "1.0.0")); err != nil || again != id {
+		t.Errorf("Store again = %q, %v, want %q", again, err, id)
+	}
+	other, err := store.Store(ctx, testsbom.JSON(t, "1.1.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopening the file restores the documents and their index.
+	if err := kv.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if kv, err = storage.OpenFileKV(path); err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+	defer kv.Close()
+	store = storage.NewKVStore(kv)
+
+	tests := []struct {
+		name string
+		q    storage.Query
+		want []string
+	}{
+		{"purl without version", storage.Query{PURL: "pkg:npm/lib"}, []string{"lib", "lib"}},
+		{"purl with version", storage.Query{PURL: "pkg:golang/acme.example/app@v1.1.0"}, []string{"app"}},
+		{"purl with qualifiers", storage.Query{PURL: "pkg:npm/lib@2.1.0?arch=arm64"}, nil},
+		{"name", storage.Query{Name: "app"}, []string{"app", "app", "app", "app"}},
+		{"name, type and document", storage.Query{Name: "app", Type: "software_Package", Documents: []string{id}}, []string{"app"}},
+		{"type", storage.Query{Type: "software_Package", Documents: []string{other}}, []string{"app", "leaf", "lib", "mock"}},
+		{"identifier", storage.Query{Identifier: "cpe:2.3:a:acme:lib:2.1.0:*:*:*:*:*:*:*", Documents: []string{id}}, []string{"lib"}},
+		{"license", storage.Query{License: "MIT"}, []string{"lib", "lib"}},
+		{"license and name", storage.Query{License: "MIT", Name: "app"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := store.Query(ctx, tt.q)
+			if err != nil {
+				t.Fatalf("Query: %v", err)
+			}
+			var got []string
+			for _, m := range matches {
+				got = append(got, m.Name)
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	all, err := store.Query(ctx, storage.Query{Documents: []string{id}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := func(a, b storage.Match) int { return strings.Compare(a.SpdxID, b.SpdxID) }
+	if len(all) == 0 || !slices.IsSortedFunc(all, byID) {
+		t.Errorf("elements of %s = %v, want them ordered by SPDX ID", id, all)
+	}
+
+	doc, err := store.Load(ctx, id)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if len(doc.Packages) != 4 || len(doc.LifecycleScopedRelationships) != 1 {
+		t.Errorf("loaded %d packages and %d scoped relationships", len(doc.Packages), len(doc.LifecycleScopedRelationships))
+	}
+
+	if err := store.Delete(ctx, id); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := store.Load(ctx, id); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("Load after Delete: %v, want ErrNotFound", err)
+	}
+	if err := store.Delete(ctx, id); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("Delete again: %v, want ErrNotFound", err)
+	}
+	err = kv.Scan(nil, func(key, _ []byte) error {
+		if slices.Contains(strings.Split(string(key), "\x00"), id) {
+			t.Errorf("key %q of the deleted document is kept", key)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if matches, _ := store.Query(ctx, storage.Query{PURL: "pkg:npm/lib"}); len(matches) != 1 || matches[0].Document != other {
+		t.Errorf("lib after Delete = %v, want only that of %s", matches, other)
+	}
+
+	if _, err := store.Store(ctx, []byte("{")); err == nil {
+		t.Error("Store of invalid JSON succeeded")
+	}
+}
+
+func TestFileKV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.kv")
+	kv, err := storage.OpenFileKV(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = kv.Apply([]storage.Op{
+		{Key: []byte("b"), Value: []byte("2")},
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("ab"), Value: []byte{}},
+		{Key: []byte("c"), Value: []byte("3")},
+	})
+	if err == nil {
+		err = kv.Apply([]storage.Op{{Key: []byte("c"), Delete: true}, {Key: []byte("b"), Value: []byte("two")}})
+	}
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	kv.Close()
+
+	// A record cut short by a crash is discarded.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{40, 0, 0, 0, 1, 2, 3, 4, 0, 1})
+	f.Close()
+
+	check := func(t *testing.T, kv *storage.FileKV) {
+		t.Helper()
+		var got []string
+		kv.Scan([]byte("a"), func(key, value []byte) error {
+			got = append(got, string(key)+"="+string(value))
+			return nil
+		})
+		if want := []string{"a=1", "ab="}; !slices.Equal(got, want) {
+			t.Errorf("Scan(a) = %q, want %q", got, want)
+		}
+		if v, _ := kv.Get([]byte("b")); string(v) != "two" {
+			t.Errorf("Get(b) = %q, want two", v)
+		}
+		if v, _ := kv.Get([]byte("ab")); v == nil {
+			t.Error("Get(ab) = nil, want an empty value")
+		}
+		if v, _ := kv.Get([]byte("c")); v != nil {
+			t.Errorf("Get(c) = %q after its deletion", v)
+		}
+	}
+
+	if kv, err = storage.OpenFileKV(path); err != nil {
+		t.Fatalf("reopening: %v", err)
+	}
+	check(t, kv)
+	if err := kv.Apply([]storage.Op{{Key: []byte("d"), Value: []byte("4")}}); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.Stat(path)
+	if err := kv.Compact(); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	after, _ := os.Stat(path)
+	if after.Size() >= before.Size() {
+		t.Errorf("Compact left %d bytes of %d", after.Size(), before.Size())
+	}
+	kv.Close()
+
+	if kv, err = storage.OpenFileKV(path); err != nil {
+		t.Fatalf("reopening after Compact: %v", err)
+	}
+	defer kv.Close()
+	check(t, kv)
+	if v, _ := kv.Get([]byte("d")); string(v) != "4" {
+		t.Errorf("Get(d) = %q after Compact, want 4", v)
+	}
+
+	if err := os.WriteFile(path, []byte("not a store"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := storage.OpenFileKV(path); err == nil {
+		t.Error("OpenFileKV of another file succeeded")
+	}
+}
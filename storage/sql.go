@@ -0,0 +1,352 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/interlynk-io/spdx-zen/internal/purl"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// Dialect is the SQL dialect of a database.
+type Dialect int
+
+// Supported dialects. They differ in their placeholders: "?" for SQLite
+// and "$1", "$2" and so on for Postgres.
+const (
+	SQLite Dialect = iota
+	Postgres
+)
+
+// String returns the name of the dialect.
+func (d Dialect) String() string {
+	switch d {
+	case SQLite:
+		return "SQLite"
+	case Postgres:
+		return "Postgres"
+	}
+	return "Dialect(" + strconv.Itoa(int(d)) + ")"
+}
+
+// schema creates the tables of a SQLStore. Every row of the element,
+// relationship, identifier and license tables belongs to a document.
+// Relationships have a row per target, with the lifecycle scope of
+// lifecycle-scoped relationships, and licenses a row per concluded or
+// declared license.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS spdx_documents (
+		id TEXT PRIMARY KEY,
+		spdx_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		created TEXT NOT NULL,
+		content TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS spdx_elements (
+		document_id TEXT NOT NULL,
+		spdx_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		name TEXT NOT NULL,
+		version TEXT NOT NULL,
+		purl TEXT NOT NULL,
+		purl_base TEXT NOT NULL,
+		PRIMARY KEY (document_id, spdx_id)
+	)`,
+	`CREATE TABLE IF NOT EXISTS spdx_relationships (
+		document_id TEXT NOT NULL,
+		spdx_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		from_id TEXT NOT NULL,
+		to_id TEXT NOT NULL,
+		scope TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS spdx_identifiers (
+		document_id TEXT NOT NULL,
+		element_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		identifier TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS spdx_licenses (
+		document_id TEXT NOT NULL,
+		element_id TEXT NOT NULL,
+		kind TEXT NOT NULL,
+		license TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS spdx_elements_purl_base ON spdx_elements (purl_base)`,
+	`CREATE INDEX IF NOT EXISTS spdx_elements_name ON spdx_elements (name)`,
+	`CREATE INDEX IF NOT EXISTS spdx_relationships_from ON spdx_relationships (document_id, from_id)`,
+	`CREATE INDEX IF NOT EXISTS spdx_relationships_to ON spdx_relationships (document_id, to_id)`,
+	`CREATE INDEX IF NOT EXISTS spdx_identifiers_identifier ON spdx_identifiers (identifier)`,
+	`CREATE INDEX IF NOT EXISTS spdx_licenses_license ON spdx_licenses (license)`,
+}
+
+// tables are the tables holding the rows of a document, the documents
+// table last.
+var tables = []string{"spdx_elements", "spdx_relationships", "spdx_identifiers", "spdx_licenses", "spdx_documents"}
+
+// Option configures a store.
+type Option interface {
+	apply(*config)
+}
+
+type optionFunc func(*config)
+
+func (f optionFunc) apply(c *config) { f(c) }
+
+type config struct {
+	reader *parse.Reader
+}
+
+// WithReader sets the reader that parses stored documents. The default is
+// parse.NewReader().
+func WithReader(r *parse.Reader) Option {
+	return optionFunc(func(c *config) {
+		c.reader = r
+	})
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{}
+	for _, opt := range opts {
+		opt.apply(c)
+	}
+	if c.reader == nil {
+		c.reader = parse.NewReader()
+	}
+	return c
+}
+
+// SQLStore stores documents in a relational database: their content in the
+// spdx_documents table, and their elements, relationships, external
+// identifiers and licenses in the spdx_elements, spdx_relationships,
+// spdx_identifiers and spdx_licenses tables, which may also be queried
+// directly.
+type SQLStore struct {
+	db      *sql.DB
+	dialect Dialect
+	reader  *parse.Reader
+}
+
+// NewSQLStore creates a store in the database db of the given dialect.
+func NewSQLStore(db *sql.DB, dialect Dialect, opts ...Option) *SQLStore {
+	return &SQLStore{db: db, dialect: dialect, reader: newConfig(opts).reader}
+}
+
+// Init creates the tables and indexes of the store, unless they exist.
+func (s *SQLStore) Init(ctx context.Context) error {
+	for _, stmt := range schema {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("creating schema: %w", err)
+		}
+	}
+	return nil
+}
+
+// rebind replaces the "?" placeholders of query with those of the dialect.
+func (s *SQLStore) rebind(query string) string {
+	if s.dialect != Postgres {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Store parses the document with the given content and stores it with its
+// index in one transaction, unless it is stored already, and returns its
+// ID.
+func (s *SQLStore) Store(ctx context.Context, data []byte) (string, error) {
+	doc, err := s.reader.Read(data)
+	if err != nil {
+		return "", fmt.Errorf("reading document: %w", err)
+	}
+	id := documentID(data)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	var n int
+	if err := tx.QueryRowContext(ctx, s.rebind("SELECT COUNT(*) FROM spdx_documents WHERE id = ?"), id).Scan(&n); err != nil {
+		return "", fmt.Errorf("looking up document: %w", err)
+	}
+	if n > 0 {
+		return id, nil
+	}
+
+	created := ""
+	if doc.SpdxDocument != nil && !doc.SpdxDocument.CreationInfo.Created.IsZero() {
+		created = doc.SpdxDocument.CreationInfo.Created.UTC().Format(time.RFC3339)
+	}
+	if _, err := tx.ExecContext(ctx, s.rebind("INSERT INTO spdx_documents (id, spdx_id, name, created, content) VALUES (?, ?, ?, ?, ?)"),
+		id, doc.GetSpdxID(), doc.GetName(), created, string(data)); err != nil {
+		return "", fmt.Errorf("storing document: %w", err)
+	}
+
+	x := newIndex(doc)
+	insert := func(table string, columns []string, n int, row func(i int) []interface{}) error {
+		if n == 0 {
+			return nil
+		}
+		query := fmt.Sprintf("INSERT INTO %s (document_id, %s) VALUES (?%s)", table, strings.Join(columns, ", "), strings.Repeat(", ?", len(columns)))
+		stmt, err := tx.PrepareContext(ctx, s.rebind(query))
+		if err != nil {
+			return fmt.Errorf("storing %s: %w", table, err)
+		}
+		defer stmt.Close()
+		for i := 0; i < n; i++ {
+			if _, err := stmt.ExecContext(ctx, append([]interface{}{id}, row(i)...)...); err != nil {
+				return fmt.Errorf("storing %s: %w", table, err)
+			}
+		}
+		return nil
+	}
+	err = insert("spdx_elements", []string{"spdx_id", "type", "name", "version", "purl", "purl_base"}, len(x.elements), func(i int) []interface{} {
+		e := x.elements[i]
+		return []interface{}{e.spdxID, e.typ, e.name, e.version, e.purl, purl.Base(e.purl)}
+	})
+	if err == nil {
+		err = insert("spdx_relationships", []string{"spdx_id", "type", "from_id", "to_id", "scope"}, len(x.relationships), func(i int) []interface{} {
+			r := x.relationships[i]
+			return []interface{}{r.spdxID, r.typ, r.from, r.to, r.scope}
+		})
+	}
+	if err == nil {
+		err = insert("spdx_identifiers", []string{"element_id", "type", "identifier"}, len(x.identifiers), func(i int) []interface{} {
+			r := x.identifiers[i]
+			return []interface{}{r.element, r.typ, r.identifier}
+		})
+	}
+	if err == nil {
+		err = insert("spdx_licenses", []string{"element_id", "kind", "license"}, len(x.licenses), func(i int) []interface{} {
+			r := x.licenses[i]
+			return []interface{}{r.element, r.kind, r.license}
+		})
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("storing document: %w", err)
+	}
+	return id, nil
+}
+
+// Load returns the stored document with the given ID.
+func (s *SQLStore) Load(ctx context.Context, id string) (*parse.Document, error) {
+	var content string
+	err := s.db.QueryRowContext(ctx, s.rebind("SELECT content FROM spdx_documents WHERE id = ?"), id).Scan(&content)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("loading document: %w", err)
+	}
+	return s.reader.Read([]byte(content))
+}
+
+// Delete removes the stored document with the given ID and its index.
+func (s *SQLStore) Delete(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var removed int64
+	for _, table := range tables {
+		column := "document_id"
+		if table == "spdx_documents" {
+			column = "id"
+		}
+		res, err := tx.ExecContext(ctx, s.rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", table, column)), id)
+		if err != nil {
+			return fmt.Errorf("deleting document: %w", err)
+		}
+		if table == "spdx_documents" {
+			if removed, err = res.RowsAffected(); err != nil {
+				return fmt.Errorf("deleting document: %w", err)
+			}
+		}
+	}
+	if removed == 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, id)
+	}
+	return tx.Commit()
+}
+
+// Query returns the elements of the stored documents matching q, ordered
+// by document and SPDX ID.
+func (s *SQLStore) Query(ctx context.Context, q Query) ([]Match, error) {
+	var where []string
+	var args []interface{}
+	add := func(cond string, values ...interface{}) {
+		where = append(where, cond)
+		args = append(args, values...)
+	}
+	if q.PURL != "" {
+		add("e.purl_base = ?", purl.Base(q.PURL))
+	}
+	if q.Name != "" {
+		add("e.name = ?", q.Name)
+	}
+	if q.Type != "" {
+		add("e.type = ?", q.Type)
+	}
+	if q.Identifier != "" {
+		add("EXISTS (SELECT 1 FROM spdx_identifiers i WHERE i.document_id = e.document_id AND i.element_id = e.spdx_id AND i.identifier = ?)", q.Identifier)
+	}
+	if q.License != "" {
+		add("EXISTS (SELECT 1 FROM spdx_licenses l WHERE l.document_id = e.document_id AND l.element_id = e.spdx_id AND l.license = ?)", q.License)
+	}
+	if len(q.Documents) > 0 {
+		values := make([]interface{}, len(q.Documents))
+		for i, id := range q.Documents {
+			values[i] = id
+		}
+		add("e.document_id IN (?"+strings.Repeat(", ?", len(values)-1)+")", values...)
+	}
+
+	query := "SELECT e.document_id, e.spdx_id, e.type, e.name, e.version, e.purl FROM spdx_elements e"
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += " ORDER BY e.document_id, e.spdx_id"
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying elements: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []Match
+	for rows.Next() {
+		var m Match
+		if err := rows.Scan(&m.Document, &m.SpdxID, &m.Type, &m.Name, &m.Version, &m.PURL); err != nil {
+			return nil, fmt.Errorf("querying elements: %w", err)
+		}
+		// The purl_base column narrows down the candidates; the version,
+		// qualifiers and subpath of the query are matched here.
+		if q.PURL != "" && !purl.Match(q.PURL, m.PURL) {
+			continue
+		}
+		matches = append(matches, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("querying elements: %w", err)
+	}
+	return matches, nil
+}
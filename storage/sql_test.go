@@ -0,0 +1,368 @@
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/interlynk-io/spdx-zen/internal/testsbom"
+	"github.com/interlynk-io/spdx-zen/storage"
+)
+
+func TestSQLStore(t *testing.T) {
+	for _, dialect := range []storage.Dialect{storage.SQLite, storage.Postgres} {
+		t.Run(fmt.Sprint(dialect), func(t *testing.T) {
+			ctx := context.Background()
+			db, fake := openFake(t)
+			store := storage.NewSQLStore(db, dialect)
+			if err := store.Init(ctx); err != nil {
+				t.Fatalf("Init: %v", err)
+			}
+
+			id, err := store.Store(ctx, testsbom.JSON(t, "1.0.0"))
+			if err != nil {
+				t.Fatalf("Store: %v", err)
+			}
+			if again, err := store.Store(ctx, testsbom.JSON(t, "1.0.0")); err != nil || again != id {
+				t.Errorf("Store again = %q, %v, want %q", again, err, id)
+			}
+			other, err := store.Store(ctx, testsbom.JSON(t, "1.1.0"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n := len(fake.tables["spdx_documents"]); n != 2 {
+				t.Errorf("documents = %d, want 2", n)
+			}
+			if placeholder := strings.Contains(fake.lastInsert, "$1"); placeholder != (dialect == storage.Postgres) {
+				t.Errorf("insert statement %q has the wrong placeholders", fake.lastInsert)
+			}
+
+			tests := []struct {
+				name string
+				q    storage.Query
+				want []string
+			}{
+				{"purl without version", storage.Query{PURL: "pkg:npm/lib"}, []string{"lib", "lib"}},
+				{"purl with version", storage.Query{PURL: "pkg:golang/acme.example/app@v1.1.0"}, []string{"app"}},
+				{"purl with qualifiers", storage.Query{PURL: "pkg:npm/lib@2.1.0?arch=arm64"}, nil},
+				{"purl written differently", storage.Query{PURL: "pkg:NPM/lib@2.1.0?arch=x64&os="}, []string{"lib", "lib"}},
+				{"name", storage.Query{Name: "app"}, []string{"app", "app", "app", "app"}},
+				{"name, type and document", storage.Query{Name: "app", Type: "software_Package", Documents: []string{id}}, []string{"app"}},
+				{"type", storage.Query{Type: "software_Package", Documents: []string{other}}, []string{"app", "leaf", "lib", "mock"}},
+				{"identifier", storage.Query{Identifier: "cpe:2.3:a:acme:lib:2.1.0:*:*:*:*:*:*:*", Documents: []string{id}}, []string{"lib"}},
+				{"license", storage.Query{License: "MIT"}, []string{"lib", "lib"}},
+				{"license and name", storage.Query{License: "MIT", Name: "app"}, nil},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					matches, err := store.Query(ctx, tt.q)
+					if err != nil {
+						t.Fatalf("Query: %v", err)
+					}
+					var got []string
+					for _, m := range matches {
+						got = append(got, m.Name)
+					}
+					slices.Sort(got)
+					if !slices.Equal(got, tt.want) {
+						t.Errorf("matches = %v, want %v", got, tt.want)
+					}
+				})
+			}
+
+			doc, err := store.Load(ctx, id)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			if len(doc.Packages) != 4 || len(doc.LifecycleScopedRelationships) != 1 {
+				t.Errorf("loaded %d packages and %d scoped relationships", len(doc.Packages), len(doc.LifecycleScopedRelationships))
+			}
+			var scopes []string
+			for _, row := range fake.tables["spdx_relationships"] {
+				if row["document_id"] == id && row["type"] == "dependsOn" {
+					scopes = append(scopes, row["scope"].(string))
+				}
+			}
+			if slices.Sort(scopes); !slices.Equal(scopes, []string{"", "", "test"}) {
+				t.Errorf("dependency scopes = %q", scopes)
+			}
+
+			if err := store.Delete(ctx, id); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if _, err := store.Load(ctx, id); !errors.Is(err, storage.ErrNotFound) {
+				t.Errorf("Load after Delete: %v, want ErrNotFound", err)
+			}
+			if err := store.Delete(ctx, id); !errors.Is(err, storage.ErrNotFound) {
+				t.Errorf("Delete again: %v, want ErrNotFound", err)
+			}
+			for table, rows := range fake.tables {
+				for _, row := range rows {
+					if row["document_id"] == id || row["id"] == id {
+						t.Errorf("%s keeps a row of the deleted document", table)
+					}
+				}
+			}
+
+			if _, err := store.Store(ctx, []byte("{")); err == nil {
+				t.Error("Store succeeded for invalid JSON")
+			}
+		})
+	}
+}
+
+func TestSQLStore_DuplicateIDs(t *testing.T) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(testsbom.JSON(t, "1.0.0"), &doc); err != nil {
+		t.Fatal(err)
+	}
+	graph := doc["@graph"].([]interface{})
+	for _, obj := range graph {
+		if elem := obj.(map[string]interface{}); elem["name"] == "lib" {
+			dup := maps.Clone(elem)
+			dup["name"] = "lib-copy"
+			graph = append(graph, dup)
+			break
+		}
+	}
+	doc["@graph"] = graph
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	db, _ := openFake(t)
+	store := storage.NewSQLStore(db, storage.SQLite)
+	if err := store.Init(ctx); err != nil {
+		t.Fatalf("Init: %v", err)
+	}
+	id, err := store.Store(ctx, data)
+	if err != nil {
+		t.Fatalf("Store: %v", err)
+	}
+	matches, err := store.Query(ctx, storage.Query{PURL: "pkg:npm/lib", Documents: []string{id}})
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Name != "lib" {
+		t.Errorf("matches = %+v, want the first lib", matches)
+	}
+}
+
+// fakeDB is an in-memory database/sql driver understanding the statements
+// of SQLStore, with "?" or "$n" placeholders. Transactions are not
+// isolated: statements take effect at once. Inserts enforce the primary
+// keys of the schema.
+type fakeDB struct {
+	mu         sync.Mutex
+	tables     map[string][]map[string]driver.Value
+	lastInsert string
+}
+
+var fakeDrivers atomic.Int64
+
+// primaryKeys are the columns of the primary keys of the tables.
+var primaryKeys = map[string][]string{
+	"spdx_documents": {"id"},
+	"spdx_elements":  {"document_id", "spdx_id"},
+}
+
+func openFake(t *testing.T) (*sql.DB, *fakeDB) {
+	fake := &fakeDB{tables: make(map[string][]map[string]driver.Value)}
+	name := fmt.Sprintf("fake%d", fakeDrivers.Add(1))
+	sql.Register(name, fakeDriver{fake})
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+type fakeDriver struct{ db *fakeDB }
+
+func (d fakeDriver) Open(string) (driver.Conn, error) { return fakeConn{d.db}, nil }
+
+type fakeConn struct{ db *fakeDB }
+
+func (c fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{c.db, query, placeholders.ReplaceAllString(query, "?")}, nil
+}
+func (c fakeConn) Close() error              { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+// fakeStmt is a statement as prepared, raw, and with "?" placeholders.
+type fakeStmt struct {
+	db    *fakeDB
+	raw   string
+	query string
+}
+
+var (
+	placeholders = regexp.MustCompile(`\$\d+`)
+	insertStmt   = regexp.MustCompile(`^INSERT INTO (\w+) \(([^)]*)\)`)
+	deleteStmt   = regexp.MustCompile(`^DELETE FROM (\w+) WHERE (\w+) = \?$`)
+	selectStmt   = regexp.MustCompile(`^SELECT (.+?) FROM (\w+)(?: e)?(?: WHERE (.+?))?(?: ORDER BY .*)?$`)
+	existsCond   = regexp.MustCompile(`^EXISTS \(SELECT 1 FROM (\w+) \w+ WHERE .* AND \w+\.(\w+) = \?\)$`)
+	columnCond   = regexp.MustCompile(`^(?:e\.)?(\w+) = \?$`)
+	inCond       = regexp.MustCompile(`^e\.(\w+) IN \(([?, ]+)\)$`)
+)
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	switch {
+	case strings.HasPrefix(s.query, "CREATE "):
+		return driver.RowsAffected(0), nil
+	case insertStmt.MatchString(s.query):
+		m := insertStmt.FindStringSubmatch(s.query)
+		row := make(map[string]driver.Value)
+		for i, col := range strings.Split(m[2], ", ") {
+			row[col] = args[i]
+		}
+		if key := primaryKeys[m[1]]; key != nil && slices.ContainsFunc(s.db.tables[m[1]], func(other map[string]driver.Value) bool {
+			return !slices.ContainsFunc(key, func(col string) bool { return other[col] != row[col] })
+		}) {
+			return nil, fmt.Errorf("UNIQUE constraint failed: %s", m[1])
+		}
+		s.db.tables[m[1]] = append(s.db.tables[m[1]], row)
+		s.db.lastInsert = s.raw
+		return driver.RowsAffected(1), nil
+	case deleteStmt.MatchString(s.query):
+		m := deleteStmt.FindStringSubmatch(s.query)
+		before := len(s.db.tables[m[1]])
+		s.db.tables[m[1]] = slices.DeleteFunc(s.db.tables[m[1]], func(row map[string]driver.Value) bool {
+			return row[m[2]] == args[0]
+		})
+		return driver.RowsAffected(before - len(s.db.tables[m[1]])), nil
+	}
+	return nil, fmt.Errorf("unexpected statement %q", s.query)
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+	m := selectStmt.FindStringSubmatch(s.query)
+	if m == nil {
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+	var columns []string
+	for _, col := range strings.Split(m[1], ", ") {
+		columns = append(columns, strings.TrimPrefix(col, "e."))
+	}
+
+	var matched []map[string]driver.Value
+	for _, row := range s.db.tables[m[2]] {
+		var conds []string
+		if m[3] != "" {
+			conds = splitConditions(m[3])
+		}
+		ok, err := s.db.match(row, conds, args)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, row)
+		}
+	}
+	if columns[0] == "COUNT(*)" {
+		return &fakeRows{columns: columns, rows: [][]driver.Value{{int64(len(matched))}}}, nil
+	}
+	slices.SortFunc(matched, func(a, b map[string]driver.Value) int {
+		return strings.Compare(fmt.Sprint(a["document_id"], a["spdx_id"]), fmt.Sprint(b["document_id"], b["spdx_id"]))
+	})
+	rows := &fakeRows{columns: columns}
+	for _, row := range matched {
+		values := make([]driver.Value, len(columns))
+		for i, col := range columns {
+			values[i] = row[col]
+		}
+		rows.rows = append(rows.rows, values)
+	}
+	return rows, nil
+}
+
+// splitConditions splits a WHERE clause at the ANDs outside parentheses.
+func splitConditions(where string) []string {
+	var conds []string
+	depth, start := 0, 0
+	for i := 0; i < len(where); i++ {
+		switch where[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 && strings.HasPrefix(where[i:], " AND ") {
+			conds = append(conds, where[start:i])
+			start = i + len(" AND ")
+		}
+	}
+	return append(conds, where[start:])
+}
+
+// match reports whether a row meets the conditions, consuming their
+// arguments in order.
+func (db *fakeDB) match(row map[string]driver.Value, conds []string, args []driver.Value) (bool, error) {
+	ok := true
+	for _, cond := range conds {
+		switch {
+		case existsCond.MatchString(cond):
+			m := existsCond.FindStringSubmatch(cond)
+			arg := args[0]
+			args = args[1:]
+			ok = ok && slices.ContainsFunc(db.tables[m[1]], func(other map[string]driver.Value) bool {
+				return other["document_id"] == row["document_id"] && other["element_id"] == row["spdx_id"] && other[m[2]] == arg
+			})
+		case inCond.MatchString(cond):
+			m := inCond.FindStringSubmatch(cond)
+			n := strings.Count(m[2], "?")
+			ok = ok && slices.Contains(args[:n], row[m[1]])
+			args = args[n:]
+		case columnCond.MatchString(cond):
+			m := columnCond.FindStringSubmatch(cond)
+			ok = ok && row[m[1]] == args[0]
+			args = args[1:]
+		default:
+			return false, fmt.Errorf("unexpected condition %q", cond)
+		}
+	}
+	return ok, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
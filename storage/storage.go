@@ -0,0 +1,177 @@
+// Package storage persists SPDX 3.0 documents and indexes their elements,
+// so that large numbers of SBOMs can be queried without reparsing them.
+//
+// SQLStore keeps them in a relational database, SQLite or Postgres, through
+// database/sql and a driver of the caller's choice:
+//
+//	db, err := sql.Open("sqlite", "sboms.db")
+//	store := storage.NewSQLStore(db, storage.SQLite)
+//	err = store.Init(ctx)
+//	id, err := store.Store(ctx, data)
+//	matches, err := store.Query(ctx, storage.Query{PURL: "pkg:npm/lodash"})
+//	doc, err := store.Load(ctx, matches[0].Document)
+//
+// KVStore keeps them in an ordered key-value store instead, for command-line
+// tools and devices without a database server: FileKV, a single file, or an
+// embedded database such as bbolt or badger behind the KV interface:
+//
+//	kv, err := storage.OpenFileKV("sboms.kv")
+//	defer kv.Close()
+//	store := storage.NewKVStore(kv)
+//
+// Documents are identified by the SHA-256 digest of their content, so
+// storing a document again has no effect.
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	spdx "github.com/interlynk-io/spdx-zen/model/v3.0.1"
+	"github.com/interlynk-io/spdx-zen/parse"
+)
+
+// ErrNotFound is returned, wrapped, when loading a document not stored.
+var ErrNotFound = errors.New("document not found")
+
+// Query selects elements of the stored documents. Every field that is set
+// must match.
+type Query struct {
+	// PURL is a package URL, which matches any version of the package if it
+	// has none, and any qualifiers and subpath if it has none.
+	PURL string
+
+	// Name is the name of the element and Type its compact JSON-LD type,
+	// e.g. "software_Package".
+	Name string
+	Type string
+
+	// Identifier is the identifier of an external identifier of the
+	// element, such as a CPE or an SWHID.
+	Identifier string
+
+	// License is the concluded or declared license of the element, e.g.
+	// "Apache-2.0" or "MIT OR Apache-2.0".
+	License string
+
+	// Documents are the IDs of the documents searched, or all if empty.
+	Documents []string
+}
+
+// Match is an element found by a query.
+type Match struct {
+	Document string
+	SpdxID   string
+	Type     string
+	Name     string
+	Version  string
+	PURL     string
+}
+
+// documentID returns the ID of a document with the given content.
+func documentID(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// index holds the rows describing a document.
+type index struct {
+	elements      []elementRow
+	relationships []relationshipRow
+	identifiers   []identifierRow
+	licenses      []licenseRow
+}
+
+type elementRow struct {
+	spdxID, typ, name, version, purl string
+}
+
+type relationshipRow struct {
+	spdxID, typ, from, to, scope string
+}
+
+type identifierRow struct {
+	element, typ, identifier string
+}
+
+type licenseRow struct {
+	element, kind, license string
+}
+
+// newIndex returns the rows describing the elements of doc, their external
+// identifiers, and its relationships and the licenses they give, one row
+// per target. An SPDX ID that several elements of doc share, which a valid
+// document does not have, is indexed for the first of them only.
+func newIndex(doc *parse.Document) *index {
+	x := &index{}
+	seen := make(map[string]bool)
+	for elem := range doc.AllElements() {
+		id := elem.GetSpdxID()
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		row := elementRow{spdxID: id, name: elem.GetName()}
+		if info, ok := spdx.TypeOf(elem); ok {
+			row.typ = info.Name
+		}
+		if pkg, ok := elem.(*spdx.Package); ok {
+			row.version = pkg.PackageVersion
+			row.purl = pkg.PackageUrl
+		}
+		for _, ei := range elem.GetExternalIdentifier() {
+			x.identifiers = append(x.identifiers, identifierRow{element: id, typ: string(ei.ExternalIdentifierType), identifier: ei.Identifier})
+			if row.purl == "" && ei.ExternalIdentifierType == spdx.ExternalIdentifierTypePackageUrl {
+				row.purl = ei.Identifier
+			}
+		}
+		x.elements = append(x.elements, row)
+	}
+
+	addRelationship := func(rel *spdx.Relationship, scope spdx.LifecycleScopeType) {
+		kind := ""
+		switch {
+		case rel.IsConcludedLicense():
+			kind = "concluded"
+		case rel.IsDeclaredLicense():
+			kind = "declared"
+		}
+		for _, to := range rel.To {
+			if kind != "" {
+				x.licenses = append(x.licenses, licenseRow{element: rel.From.SpdxID, kind: kind, license: licenseText(doc, to.SpdxID)})
+			}
+			x.relationships = append(x.relationships, relationshipRow{
+				spdxID: rel.SpdxID,
+				typ:    string(rel.RelationshipType),
+				from:   rel.From.SpdxID,
+				to:     to.SpdxID,
+				scope:  string(scope),
+			})
+		}
+	}
+	for _, rel := range doc.Relationships {
+		addRelationship(rel, "")
+	}
+	for _, rel := range doc.LifecycleScopedRelationships {
+		addRelationship(&rel.Relationship, rel.Scope)
+	}
+	return x
+}
+
+// licenseText returns the license ID of a license of the SPDX License List,
+// or else the license expression or name of the license of doc with the
+// given ID, or else the ID.
+func licenseText(doc *parse.Document, id string) string {
+	if name, ok := strings.CutPrefix(id, licenseListPrefix); ok {
+		return name
+	}
+	if l := doc.GetAnyLicenseInfoByID(id); l != nil && l.Name != "" {
+		return l.Name
+	}
+	return id
+}
+
+// licenseListPrefix is the prefix of the IRIs of listed licenses.
+const licenseListPrefix = "https://spdx.org/licenses/"